@@ -33,6 +33,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
 	s3manager "github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
@@ -53,7 +54,7 @@ type (
 
 var (
 	// map[string]*s3.Client, with one s3.Client a.k.a. "svc"
-	// per (profile, region, endpoint) triplet
+	// per (profile, region, endpoint, [bucket credentials]) tuple
 	clients sync.Map
 
 	s3Endpoint string
@@ -743,6 +744,7 @@ func (sessConf *sessConf) s3client(tag string) (*s3.Client, error) {
 	var (
 		endpoint = s3Endpoint
 		profile  = awsProfile
+		creds    aws.CredentialsProvider
 	)
 	if sessConf.bck != nil && sessConf.bck.Props != nil {
 		if sessConf.region == "" {
@@ -754,6 +756,14 @@ func (sessConf *sessConf) s3client(tag string) (*s3.Client, error) {
 		if sessConf.bck.Props.Extra.AWS.Profile != "" {
 			profile = sessConf.bck.Props.Extra.AWS.Profile
 		}
+		if akid := sessConf.bck.Props.Extra.AWS.AccessKeyID; akid != "" {
+			secret, digest, err := unsealCreds(sessConf.bck.Props.Extra.AWS.SecretAccessKey)
+			if err != nil {
+				return nil, err
+			}
+			creds = credentials.NewStaticCredentialsProvider(akid, secret, "")
+			profile += "@" + akid + "." + digest // (to cache separately)
+		}
 	}
 
 	cid := _cid(profile, sessConf.region, endpoint)
@@ -765,7 +775,7 @@ func (sessConf *sessConf) s3client(tag string) (*s3.Client, error) {
 	}
 
 	// slow path
-	cfg, err := loadConfig(endpoint, profile)
+	cfg, err := loadConfig(endpoint, profile, creds)
 	if err != nil {
 		return nil, err
 	}
@@ -821,14 +831,17 @@ func _cid(profile, region, endpoint string) string {
 	return sb.String()
 }
 
-// loadConfig create config using default creds from ~/.aws/credentials and environment variables.
-func loadConfig(endpoint, profile string) (aws.Config, error) {
+// loadConfig create config using default creds from ~/.aws/credentials and environment variables,
+// unless per-bucket static credentials are provided
+func loadConfig(endpoint, profile string, creds aws.CredentialsProvider) (aws.Config, error) {
+	opts := []func(*config.LoadOptions) error{config.WithHTTPClient(cmn.NewClient(cmn.TransportArgs{}))}
+	if creds != nil {
+		opts = append(opts, config.WithCredentialsProvider(creds))
+	} else {
+		opts = append(opts, config.WithSharedConfigProfile(profile))
+	}
 	// NOTE: The AWS SDK for Go v2, uses lower case header maps by default.
-	cfg, err := config.LoadDefaultConfig(
-		context.Background(),
-		config.WithHTTPClient(cmn.NewClient(cmn.TransportArgs{})),
-		config.WithSharedConfigProfile(profile),
-	)
+	cfg, err := config.LoadDefaultConfig(context.Background(), opts...)
 	if err != nil {
 		return cfg, err
 	}
@@ -24,6 +24,7 @@ import (
 	"os"
 	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
@@ -72,8 +73,13 @@ var (
 	azCleanErrRegex = regexp.MustCompile(`[^a-zA-Z0-9 ]+`)
 )
 
-// interface guard
-var _ core.Backend = (*azbp)(nil)
+var (
+	// per-bucket shared-key credentials (see cmn.ExtraPropsAzure), keyed by account and key digest
+	azCreds sync.Map
+
+	// interface guard
+	_ core.Backend = (*azbp)(nil)
+)
 
 func azProto() string {
 	return cos.Right(azDefaultProto, os.Getenv(azProtoEnvVar))
@@ -116,6 +122,38 @@ func NewAzure(t core.TargetPut, tstats stats.Tracker) (core.Backend, error) {
 	return bp, nil
 }
 
+// returns the bucket's own credentials and endpoint, if configured; otherwise, the defaults
+func (azbp *azbp) account(bck *meta.Bck) (*azblob.SharedKeyCredential, string, error) {
+	if bck.Props == nil {
+		return azbp.creds, azbp.u, nil
+	}
+	var (
+		extra = &bck.Props.Extra.Azure
+		creds = azbp.creds
+		u     = azbp.u
+	)
+	if extra.AccountName != "" {
+		key, digest, err := unsealCreds(extra.AccountKey)
+		if err != nil {
+			return nil, "", err
+		}
+		cid := extra.AccountName + "." + digest
+		if v, ok := azCreds.Load(cid); ok {
+			creds = v.(*azblob.SharedKeyCredential)
+		} else {
+			if creds, err = azblob.NewSharedKeyCredential(extra.AccountName, key); err != nil {
+				return nil, "", cmn.NewErrFailedTo(nil, azErrPrefix+": bucket]", "credentials", err)
+			}
+			azCreds.Store(cid, creds)
+		}
+		u = azProto() + extra.AccountName + azHost
+	}
+	if extra.Endpoint != "" {
+		u = extra.Endpoint
+	}
+	return creds, u, nil
+}
+
 // (compare w/ cmn/backend)
 func azEncodeEtag(etag azcore.ETag) string { return cmn.UnquoteCEV(string(etag)) }
 
@@ -212,11 +250,12 @@ func azureErrorToAISError(azureError error, bck *cmn.Bck, objName string) (int,
 //
 
 func (azbp *azbp) HeadBucket(ctx context.Context, bck *meta.Bck) (cos.StrKVs, int, error) {
-	var (
-		cloudBck = bck.RemoteBck()
-		cntURL   = azbp.u + "/" + cloudBck.Name
-	)
-	client, err := container.NewClientWithSharedKeyCredential(cntURL, azbp.creds, nil)
+	cloudBck := bck.RemoteBck()
+	creds, u, err := azbp.account(bck)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	client, err := container.NewClientWithSharedKeyCredential(u+"/"+cloudBck.Name, creds, nil)
 	if err != nil {
 		status, err := azureErrorToAISError(err, cloudBck, "")
 		return nil, status, err
@@ -251,11 +290,14 @@ func (azbp *azbp) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoRes) (
 	msg.PageSize = calcPageSize(msg.PageSize, bck.MaxPageSize())
 	var (
 		cloudBck = bck.RemoteBck()
-		cntURL   = azbp.u + "/" + cloudBck.Name
 		num      = int32(msg.PageSize)
 		opts     = container.ListBlobsFlatOptions{Prefix: apc.Ptr(msg.Prefix), MaxResults: &num}
 	)
-	client, err := container.NewClientWithSharedKeyCredential(cntURL, azbp.creds, nil)
+	creds, u, err := azbp.account(bck)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	client, err := container.NewClientWithSharedKeyCredential(u+"/"+cloudBck.Name, creds, nil)
 	if err != nil {
 		return azureErrorToAISError(err, cloudBck, "")
 	}
@@ -355,11 +397,12 @@ func (azbp *azbp) ListBuckets(cmn.QueryBcks) (bcks cmn.Bcks, _ int, _ error) {
 //
 
 func (azbp *azbp) HeadObj(ctx context.Context, lom *core.LOM, _ *http.Request) (*cmn.ObjAttrs, int, error) {
-	var (
-		cloudBck = lom.Bucket().RemoteBck()
-		blURL    = azbp.u + "/" + cloudBck.Name + "/" + lom.ObjName
-	)
-	client, err := blockblob.NewClientWithSharedKeyCredential(blURL, azbp.creds, nil)
+	cloudBck := lom.Bucket().RemoteBck()
+	creds, u, err := azbp.account(lom.Bck())
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	client, err := blockblob.NewClientWithSharedKeyCredential(u+"/"+cloudBck.Name+"/"+lom.ObjName, creds, nil)
 	if err != nil {
 		status, err := azureErrorToAISError(err, cloudBck, lom.ObjName)
 		return nil, status, err
@@ -418,11 +461,13 @@ func (azbp *azbp) GetObj(ctx context.Context, lom *core.LOM, owt cmn.OWT, _ *htt
 }
 
 func (azbp *azbp) GetObjReader(ctx context.Context, lom *core.LOM, offset, length int64) (res core.GetReaderResult) {
-	var (
-		cloudBck = lom.Bucket().RemoteBck()
-		blURL    = azbp.u + "/" + cloudBck.Name + "/" + lom.ObjName
-	)
-	client, err := blockblob.NewClientWithSharedKeyCredential(blURL, azbp.creds, nil)
+	cloudBck := lom.Bucket().RemoteBck()
+	creds, u, err := azbp.account(lom.Bck())
+	if err != nil {
+		res.ErrCode, res.Err = http.StatusBadRequest, err
+		return
+	}
+	client, err := blockblob.NewClientWithSharedKeyCredential(u+"/"+cloudBck.Name+"/"+lom.ObjName, creds, nil)
 	if err != nil {
 		res.ErrCode, res.Err = azureErrorToAISError(err, cloudBck, lom.ObjName)
 		return
@@ -476,7 +521,11 @@ func (azbp *azbp) GetObjReader(ctx context.Context, lom *core.LOM, offset, lengt
 func (azbp *azbp) PutObj(r io.ReadCloser, lom *core.LOM, _ *http.Request) (int, error) {
 	defer cos.Close(r)
 
-	client, err := azblob.NewClientWithSharedKeyCredential(azbp.u, azbp.creds, nil)
+	creds, u, err := azbp.account(lom.Bck())
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	client, err := azblob.NewClientWithSharedKeyCredential(u, creds, nil)
 	if err != nil {
 		return azureErrorToAISError(err, &cmn.Bck{Provider: apc.Azure}, "")
 	}
@@ -511,7 +560,11 @@ func (azbp *azbp) PutObj(r io.ReadCloser, lom *core.LOM, _ *http.Request) (int,
 //
 
 func (azbp *azbp) DeleteObj(lom *core.LOM) (int, error) {
-	client, err := azblob.NewClientWithSharedKeyCredential(azbp.u, azbp.creds, nil)
+	creds, u, err := azbp.account(lom.Bck())
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	client, err := azblob.NewClientWithSharedKeyCredential(u, creds, nil)
 	if err != nil {
 		return azureErrorToAISError(err, &cmn.Bck{Provider: apc.Azure}, "")
 	}
@@ -6,6 +6,7 @@ package backend
 
 import (
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -16,6 +17,7 @@ import (
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/stats"
+	"github.com/OneOfOne/xxhash"
 )
 
 type base struct {
@@ -176,6 +178,19 @@ func calcPageSize(pageSize, maxPageSize int64) int64 {
 	return min(pageSize, maxPageSize)
 }
 
+// per-bucket credentials (see cmn.ExtraProps):
+// - unseal the secret
+// - compute its digest to key cached clients (without logging the secret itself)
+//
+//nolint:unused // used by cloud backends (build tags)
+func unsealCreds(sealed string) (secret, digest string, err error) {
+	if secret, err = cmn.UnsealSecret(sealed, cmn.GCO.Get().Auth.Secret); err != nil {
+		return "", "", err
+	}
+	digest = strconv.FormatUint(xxhash.Checksum64S(cos.UnsafeB(secret), cos.MLCG32), 36)
+	return secret, digest, nil
+}
+
 //nolint:deadcode,unused // used by dummy backends
 func newErrInitBackend(provider string) error { return &cmn.ErrInitBackend{Provider: provider} }
 
@@ -13,7 +13,9 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
+	"sync"
 
 	"cloud.google.com/go/storage"
 	"github.com/NVIDIA/aistore/api/apc"
@@ -55,6 +57,10 @@ var (
 	//     The default scope is ScopeFullControl."
 	gcpClient *storage.Client
 
+	// per-bucket clients (buckets with their own credentials - see cmn.ExtraPropsGCP),
+	// keyed by credentials digest
+	gcpClients sync.Map
+
 	// context placeholder
 	gctx context.Context
 
@@ -91,14 +97,17 @@ func NewGCP(t core.TargetPut, tstats stats.Tracker) (_ core.Backend, err error)
 	bp.base.init(t.Snode(), tstats)
 
 	gctx = context.Background()
-	gcpClient, err = bp.createClient(gctx)
+	gcpClient, err = bp.createClient(gctx, projectID, nil)
 
 	return bp, err
 }
 
-func (gsbp *gsbp) createClient(ctx context.Context) (*storage.Client, error) {
+func (*gsbp) createClient(ctx context.Context, projectID string, credsJSON []byte) (*storage.Client, error) {
 	opts := []option.ClientOption{option.WithScopes(storage.ScopeFullControl)}
-	if gsbp.projectID == "" {
+	switch {
+	case credsJSON != nil:
+		opts = append(opts, option.WithCredentialsJSON(credsJSON))
+	case projectID == "":
 		opts = append(opts, option.WithoutAuthentication())
 	}
 	// create HTTP transport
@@ -106,7 +115,7 @@ func (gsbp *gsbp) createClient(ctx context.Context) (*storage.Client, error) {
 	if err != nil {
 		if strings.Contains(err.Error(), "credentials") {
 			details := fmt.Sprintf("%s Hint: check your %q and %q environment settings for project ID=%q.",
-				err, projectIDEnvVar, credPathEnvVar, gsbp.projectID)
+				err, projectIDEnvVar, credPathEnvVar, projectID)
 			return nil, errors.New(details)
 		}
 		return nil, cmn.NewErrFailedTo(nil, "gcp-backend: create", "http transport", err)
@@ -120,18 +129,58 @@ func (gsbp *gsbp) createClient(ctx context.Context) (*storage.Client, error) {
 	return client, nil
 }
 
+// returns the bucket's own client if the bucket has credentials, the default one otherwise
+func (gsbp *gsbp) client(bck *meta.Bck) (*storage.Client, error) {
+	if bck.Props == nil || bck.Props.Extra.GCP.Credentials == "" {
+		return gcpClient, nil
+	}
+	creds, digest, err := unsealCreds(bck.Props.Extra.GCP.Credentials)
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := gcpClients.Load(digest); ok {
+		return v.(*storage.Client), nil
+	}
+	projectID := bck.Props.Extra.GCP.ProjectID
+	if projectID == "" {
+		projectID = gsbp.projectID
+	}
+	client, err := gsbp.createClient(gctx, projectID, []byte(creds))
+	if err != nil {
+		return nil, err
+	}
+	if v, loaded := gcpClients.LoadOrStore(digest, client); loaded {
+		client.Close()
+		client = v.(*storage.Client)
+	}
+	return client, nil
+}
+
+// requester-pays buckets get billed to the configured user project (see cmn.ExtraPropsGCP)
+func gcpBucket(client *storage.Client, bck *meta.Bck) *storage.BucketHandle {
+	bh := client.Bucket(bck.RemoteBck().Name)
+	if bck.Props != nil && bck.Props.Extra.GCP.UserProject != "" {
+		bh = bh.UserProject(bck.Props.Extra.GCP.UserProject)
+	}
+	return bh
+}
+
 // as core.Backend --------------------------------------------------------------
 
 //
 // HEAD BUCKET
 //
 
-func (*gsbp) HeadBucket(ctx context.Context, bck *meta.Bck) (bckProps cos.StrKVs, ecode int, err error) {
+func (gsbp *gsbp) HeadBucket(ctx context.Context, bck *meta.Bck) (bckProps cos.StrKVs, ecode int, err error) {
 	if cmn.Rom.FastV(5, cos.SmoduleBackend) {
 		nlog.Infof("head_bucket %s", bck.Name)
 	}
 	cloudBck := bck.RemoteBck()
-	_, err = gcpClient.Bucket(cloudBck.Name).Attrs(ctx)
+	client, err := gsbp.client(bck)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	battrs, err := gcpBucket(client, bck).Attrs(ctx)
 	if err != nil {
 		ecode, err = gcpErrorToAISError(err, cloudBck)
 		return
@@ -144,6 +193,13 @@ func (*gsbp) HeadBucket(ctx context.Context, bck *meta.Bck) (bckProps cos.StrKVs
 	// GCP always generates a versionid for an object even if versioning is disabled.
 	// So, return that we can detect versionid change on getobj etc
 	bckProps[apc.HdrBucketVerEnabled] = "true"
+
+	// requester-pays, uniform bucket-level access, and the default (customer-managed) encryption key
+	bckProps[apc.HdrGCPRequesterPays] = strconv.FormatBool(battrs.RequesterPays)
+	bckProps[apc.HdrGCPUniformAccess] = strconv.FormatBool(battrs.UniformBucketLevelAccess.Enabled)
+	if battrs.Encryption != nil {
+		bckProps[apc.HdrGCPDefaultKMSKey] = battrs.Encryption.DefaultKMSKeyName
+	}
 	return
 }
 
@@ -151,7 +207,7 @@ func (*gsbp) HeadBucket(ctx context.Context, bck *meta.Bck) (bckProps cos.StrKVs
 // LIST OBJECTS
 //
 
-func (*gsbp) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoRes) (ecode int, err error) {
+func (gsbp *gsbp) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoRes) (ecode int, err error) {
 	var (
 		query    *storage.Query
 		h        = cmn.BackendHelpers.Google
@@ -168,8 +224,12 @@ func (*gsbp) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoRes) (ecode
 		query = &storage.Query{Delimiter: "/"}
 	}
 
+	client, err := gsbp.client(bck)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
 	var (
-		it    = gcpClient.Bucket(cloudBck.Name).Objects(gctx, query)
+		it    = gcpBucket(client, bck).Objects(gctx, query)
 		pager = iterator.NewPager(it, int(msg.PageSize), msg.ContinuationToken)
 		objs  = make([]*storage.ObjectAttrs, 0, msg.PageSize)
 	)
@@ -269,15 +329,20 @@ func (gsbp *gsbp) ListBuckets(_ cmn.QueryBcks) (bcks cmn.Bcks, ecode int, err er
 // HEAD OBJECT
 //
 
-func (*gsbp) HeadObj(ctx context.Context, lom *core.LOM, _ *http.Request) (oa *cmn.ObjAttrs, ecode int, err error) {
+func (gsbp *gsbp) HeadObj(ctx context.Context, lom *core.LOM, _ *http.Request) (oa *cmn.ObjAttrs, ecode int, err error) {
 	var (
 		attrs    *storage.ObjectAttrs
 		h        = cmn.BackendHelpers.Google
 		cloudBck = lom.Bck().RemoteBck()
 	)
-	attrs, err = gcpClient.Bucket(cloudBck.Name).Object(lom.ObjName).Attrs(ctx)
+	client, err := gsbp.client(lom.Bck())
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	bh := gcpBucket(client, lom.Bck())
+	attrs, err = bh.Object(lom.ObjName).Attrs(ctx)
 	if err != nil {
-		ecode, err = handleObjectError(ctx, gcpClient, err, cloudBck)
+		ecode, err = handleObjectError(ctx, bh, err, cloudBck)
 		return
 	}
 	oa = &cmn.ObjAttrs{}
@@ -332,13 +397,18 @@ func (gsbp *gsbp) GetObj(ctx context.Context, lom *core.LOM, owt cmn.OWT, _ *htt
 	return 0, err
 }
 
-func (*gsbp) GetObjReader(ctx context.Context, lom *core.LOM, offset, length int64) (res core.GetReaderResult) {
+func (gsbp *gsbp) GetObjReader(ctx context.Context, lom *core.LOM, offset, length int64) (res core.GetReaderResult) {
 	var (
 		attrs    *storage.ObjectAttrs
 		rc       *storage.Reader
 		cloudBck = lom.Bck().RemoteBck()
-		o        = gcpClient.Bucket(cloudBck.Name).Object(lom.ObjName)
 	)
+	client, err := gsbp.client(lom.Bck())
+	if err != nil {
+		res.ErrCode, res.Err = http.StatusBadRequest, err
+		return res
+	}
+	o := gcpBucket(client, lom.Bck()).Object(lom.ObjName)
 	attrs, res.Err = o.Attrs(ctx)
 	if res.Err != nil {
 		res.ErrCode, res.Err = gcpErrorToAISError(res.Err, cloudBck)
@@ -347,6 +417,7 @@ func (*gsbp) GetObjReader(ctx context.Context, lom *core.LOM, offset, length int
 	if length > 0 {
 		rc, res.Err = o.NewRangeReader(ctx, offset, length)
 		if res.Err != nil {
+			res.ErrCode, res.Err = gcpErrorToAISError(res.Err, cloudBck)
 			if res.ErrCode == http.StatusRequestedRangeNotSatisfiable {
 				res.Err = cmn.NewErrRangeNotSatisfiable(res.Err, nil, 0)
 			}
@@ -355,6 +426,7 @@ func (*gsbp) GetObjReader(ctx context.Context, lom *core.LOM, offset, length int
 	} else {
 		rc, res.Err = o.NewReader(ctx)
 		if res.Err != nil {
+			res.ErrCode, res.Err = gcpErrorToAISError(res.Err, cloudBck)
 			return res
 		}
 		// custom metadata
@@ -402,15 +474,26 @@ func setCustomGs(lom *core.LOM, attrs *storage.ObjectAttrs) (expCksum *cos.Cksum
 func (gsbp *gsbp) PutObj(r io.ReadCloser, lom *core.LOM, _ *http.Request) (ecode int, err error) {
 	var (
 		attrs    *storage.ObjectAttrs
+		client   *storage.Client
 		written  int64
 		cloudBck = lom.Bck().RemoteBck()
 		md       = make(cos.StrKVs, 2)
-		gcpObj   = gcpClient.Bucket(cloudBck.Name).Object(lom.ObjName)
-		wc       = gcpObj.NewWriter(gctx)
+	)
+	if client, err = gsbp.client(lom.Bck()); err != nil {
+		cos.Close(r)
+		return http.StatusBadRequest, err
+	}
+	var (
+		bh     = gcpBucket(client, lom.Bck())
+		gcpObj = bh.Object(lom.ObjName)
+		wc     = gcpObj.NewWriter(gctx)
 	)
 	md[gcpChecksumType], md[gcpChecksumVal] = lom.Checksum().Get()
 
 	wc.Metadata = md
+	if kms := lom.Bprops().Extra.GCP.KMSKeyName; kms != "" {
+		wc.KMSKeyName = kms // customer-managed encryption key (CMEK)
+	}
 	buf, slab := gsbp.t.PageMM().Alloc()
 	written, err = io.CopyBuffer(wc, r, buf)
 	slab.Free(buf)
@@ -424,7 +507,7 @@ func (gsbp *gsbp) PutObj(r io.ReadCloser, lom *core.LOM, _ *http.Request) (ecode
 	}
 	attrs, err = gcpObj.Attrs(gctx)
 	if err != nil {
-		ecode, err = handleObjectError(gctx, gcpClient, err, cloudBck)
+		ecode, err = handleObjectError(gctx, bh, err, cloudBck)
 		return
 	}
 	_ = setCustomGs(lom, attrs)
@@ -438,13 +521,15 @@ func (gsbp *gsbp) PutObj(r io.ReadCloser, lom *core.LOM, _ *http.Request) (ecode
 // DELETE OBJECT
 //
 
-func (*gsbp) DeleteObj(lom *core.LOM) (ecode int, err error) {
-	var (
-		cloudBck = lom.Bck().RemoteBck()
-		o        = gcpClient.Bucket(cloudBck.Name).Object(lom.ObjName)
-	)
-	if err = o.Delete(gctx); err != nil {
-		ecode, err = handleObjectError(gctx, gcpClient, err, cloudBck)
+func (gsbp *gsbp) DeleteObj(lom *core.LOM) (ecode int, err error) {
+	cloudBck := lom.Bck().RemoteBck()
+	client, err := gsbp.client(lom.Bck())
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	bh := gcpBucket(client, lom.Bck())
+	if err = bh.Object(lom.ObjName).Delete(gctx); err != nil {
+		ecode, err = handleObjectError(gctx, bh, err, cloudBck)
 		return
 	}
 	if cmn.Rom.FastV(5, cos.SmoduleBackend) {
@@ -486,10 +571,23 @@ func gcpErrorToAISError(gcpError error, bck *cmn.Bck) (int, error) {
 	if gcpError == storage.ErrObjectNotExist {
 		return http.StatusNotFound, err
 	}
-	apiErr, ok := gcpError.(*googleapi.Error)
-	if !ok {
+	var apiErr *googleapi.Error
+	if !errors.As(gcpError, &apiErr) {
 		return http.StatusInternalServerError, err
 	}
+	// instead of (misleading) "not found" or "bad request", tell the user what to configure
+	msg := strings.ToLower(apiErr.Error())
+	switch {
+	case strings.Contains(msg, "requester pays") || strings.Contains(msg, "user project"):
+		return apiErr.Code, fmt.Errorf("%s is a requester-pays bucket: set (or fix) bucket property %q to specify the billing project: %w",
+			bck.Cname(""), "extra.gcp.user_project", err)
+	case strings.Contains(msg, "cloud kms") || strings.Contains(msg, "kms key"):
+		return apiErr.Code, fmt.Errorf("%s: customer-managed encryption key is not accessible - check %q and "+
+			"the service account's Cloud KMS permissions: %w", bck.Cname(""), "extra.gcp.kms_key_name", err)
+	case strings.Contains(msg, "uniform bucket-level access"):
+		return apiErr.Code, fmt.Errorf("%s has uniform bucket-level access enabled (object ACLs are not supported): %w",
+			bck.Cname(""), err)
+	}
 	if apiErr.Code == http.StatusForbidden && strings.Contains(apiErr.Error(), "may not exist") {
 		// HACK: "not found or misspelled" vs  "service not paid for" (the latter less likely)
 		if cmn.Rom.FastV(4, cos.SmoduleBackend) {
@@ -505,13 +603,16 @@ func _gcpErr(gcpError error) error {
 	return errors.New(gcpErrPrefix + "[" + gcpError.Error() + "]")
 }
 
-func handleObjectError(ctx context.Context, gcpClient *storage.Client, objErr error, bck *cmn.Bck) (int, error) {
+func handleObjectError(ctx context.Context, bh *storage.BucketHandle, objErr error, bck *cmn.Bck) (int, error) {
 	if objErr != storage.ErrObjectNotExist {
+		if ecode, err := gcpErrorToAISError(objErr, bck); ecode != http.StatusInternalServerError {
+			return ecode, err
+		}
 		return http.StatusBadRequest, _gcpErr(objErr)
 	}
 
 	// Object does not exist but in GCP it doesn't necessarily mean that the bucket does.
-	if _, err := gcpClient.Bucket(bck.Name).Attrs(ctx); err != nil {
+	if _, err := bh.Attrs(ctx); err != nil {
 		return gcpErrorToAISError(err, bck)
 	}
 	return http.StatusNotFound, cos.NewErrNotFound(nil, _gcpErr(objErr).Error())
@@ -81,13 +81,15 @@ type (
 		txnID string // transaction UUID
 		bcks  []*meta.Bck
 
-		propsToUpdate *cmn.BpropsToSet // update existing props
-		revertProps   *cmn.BpropsToSet // props to revert
-		setProps      *cmn.Bprops      // new props to set
+		propsToUpdate *cmn.BpropsToSet  // update existing props
+		revertProps   *cmn.BpropsToSet  // props to revert
+		setProps      *cmn.Bprops       // new props to set
+		propsChange   *cmn.BpropsChange // to record (who and when; see bmodSetProps)
 
 		wait         bool
 		needReMirror bool
 		needReEC     bool
+		needReslice  bool // (subset of the above)
 		terminate    bool
 		singleTarget bool
 	}
@@ -527,6 +529,10 @@ func mergeRemoteBckProps(props *cmn.Bprops, header http.Header) *cmn.Bprops {
 		props.Extra.AWS.CloudRegion = header.Get(apc.HdrS3Region)
 		props.Extra.AWS.Endpoint = header.Get(apc.HdrS3Endpoint)
 		props.Extra.AWS.Profile = header.Get(apc.HdrS3Profile)
+	case apc.GCP:
+		props.Extra.GCP.DefaultKMSKey = header.Get(apc.HdrGCPDefaultKMSKey)
+		props.Extra.GCP.RequesterPays = cos.IsParseBool(header.Get(apc.HdrGCPRequesterPays))
+		props.Extra.GCP.UniformAccess = cos.IsParseBool(header.Get(apc.HdrGCPUniformAccess))
 	case apc.HTTP:
 		props.Extra.HTTP.OrigURLBck = header.Get(apc.HdrOrigURLBck)
 	}
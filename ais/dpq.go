@@ -38,18 +38,27 @@ type dpq struct {
 	fltPresence string // QparamFltPresence
 	etlName     string // QparamETLName
 	binfo       string // bucket info, with or without requirement to summarize remote obj-s
+	ttl         string // QparamTTL
+	priority    string // QparamPriority
+	conv        string // QparamConvert
 
 	skipVC        bool // QparamSkipVC (skip loading existing object's metadata)
+	etlBypass     bool // QparamETLBypass
 	isGFN         bool // QparamIsGFNRequest
+	ecRead        bool // QparamECRead
 	dontAddRemote bool // QparamDontAddRemote
 	silent        bool // QparamSilent
 	latestVer     bool // QparamLatestVer
+	federated     bool // QparamFederated
 	isS3          bool // special use: frontend S3 API
 }
 
 var _except = map[string]bool{
 	apc.QparamProxyID:        false,
 	apc.QparamDontHeadRemote: false,
+	apc.QparamAction:         false,
+	apc.QparamExportLocal:    false,
+	apc.QparamReqID:          false, // (see reqID)
 
 	// flows that utilize the following query parameters perform conventional r.URL.Query()
 	s3.QparamMptUploadID:   false,
@@ -119,6 +128,10 @@ func (dpq *dpq) parse(rawQuery string) (err error) {
 			}
 		case apc.QparamSkipVC:
 			dpq.skipVC = cos.IsParseBool(value)
+		case apc.QparamTTL:
+			dpq.ttl = value
+		case apc.QparamPriority:
+			dpq.priority = value
 		case apc.QparamUnixTime:
 			dpq.ptime = value
 		case apc.QparamUUID:
@@ -127,8 +140,15 @@ func (dpq *dpq) parse(rawQuery string) (err error) {
 			if err = dpq._arch(key, value); err != nil {
 				return
 			}
+		case apc.QparamConvert:
+			if err = apc.ValidateConvert(value); err != nil {
+				return
+			}
+			dpq.conv = value
 		case apc.QparamIsGFNRequest:
 			dpq.isGFN = cos.IsParseBool(value)
+		case apc.QparamECRead:
+			dpq.ecRead = cos.IsParseBool(value)
 		case apc.QparamOrigURL:
 			if dpq.origURL, err = url.QueryUnescape(value); err != nil {
 				return
@@ -151,10 +171,14 @@ func (dpq *dpq) parse(rawQuery string) (err error) {
 
 		case apc.QparamETLName:
 			dpq.etlName = value
+		case apc.QparamETLBypass:
+			dpq.etlBypass = cos.IsParseBool(value)
 		case apc.QparamSilent:
 			dpq.silent = cos.IsParseBool(value)
 		case apc.QparamLatestVer:
 			dpq.latestVer = cos.IsParseBool(value)
+		case apc.QparamFederated:
+			dpq.federated = cos.IsParseBool(value)
 
 		default:
 			// the key must be known or _except-ed
@@ -0,0 +1,237 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Bucket freeze (apc.ActFreezeBck) and thaw (apc.ActThawBck) - for external snapshot tooling:
+// - the primary marks the bucket as frozen (cmn.Bprops.Frozen) and metasyncs updated BMD;
+//   from this point on, proxies and targets reject (409) all operations that require
+//   apc.AccessWrite permissions, as well as transactions that'd write into the bucket
+// - next, the primary asks all targets to quiesce: each target waits for the bucket's in-flight
+//   writes and (non-idle) xactions to complete, flushes the bucket's cached object metadata
+//   (see apc.WriteDelayed), and responds
+// - the freeze returns when all targets are quiescent - at this point, the bucket's content
+//   on disk is consistent and can be captured
+// - otherwise (e.g., long-running copy into the bucket, global rebalance), the freeze fails
+//   and gets rolled back
+// - freezing frozen bucket is permitted and simply repeats the quiesce step
+// - reads are not affected; in particular, cold GETs of remote objects still populate the
+//   bucket's in-cluster content
+// - thaw clears the mark and resumes writes
+
+const (
+	freezeTimeout = time.Minute // max time to quiesce (target)
+	freezePoll    = 100 * time.Millisecond
+)
+
+// target
+type tfreezer struct {
+	t        *target
+	inflight sync.Map // bucket's cname => (*atomic.Int64) in-flight writes
+}
+
+/////////////////////////
+// proxy: freeze, thaw //
+/////////////////////////
+
+// POST {apc.ActFreezeBck|apc.ActThawBck} /v1/buckets/<bucket-name>
+func (p *proxy) freezeBck(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg, bck *meta.Bck, query url.Values) {
+	if p.forwardCP(w, r, msg, bck.Name) {
+		return
+	}
+	bckArgs := bctx{p: p, w: w, r: r, bck: bck, perms: apc.AceBckAdmin, msg: msg, query: query}
+	bckArgs.createAIS = false
+	bck, err := bckArgs.initAndTry()
+	if err != nil {
+		return
+	}
+	frozen, err := p._freeze(msg, bck)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if msg.Action == apc.ActThawBck {
+		nlog.Infoln(p.String(), msg.Action, bck.String())
+		return
+	}
+	if err := p.quiesceBck(bck); err != nil {
+		if frozen {
+			if _, errV := p._freeze(&apc.ActMsg{Action: apc.ActThawBck}, bck); errV != nil {
+				nlog.Errorln(p.String(), "failed to roll back", msg.Action, bck.String()+":", errV)
+			}
+		}
+		p.writeErr(w, r, err)
+		return
+	}
+	nlog.Infoln(p.String(), msg.Action, bck.String(), "- quiescent")
+}
+
+// returns true when BMD has been modified
+func (p *proxy) _freeze(msg *apc.ActMsg, bck *meta.Bck) (bool, error) {
+	ctx := &bmdModifier{
+		pre:   bmodFreeze,
+		final: p.bmodSync,
+		wait:  true, // (targets must see it prior to quiescing)
+		msg:   msg,
+		bcks:  []*meta.Bck{bck},
+	}
+	_, err := p.owner.bmd.modify(ctx)
+	return err == nil && !ctx.terminate, err
+}
+
+func bmodFreeze(ctx *bmdModifier, clone *bucketMD) error {
+	bck := ctx.bcks[0]
+	props, present := clone.Get(bck)
+	if !present {
+		return cmn.NewErrBckNotFound(bck.Bucket())
+	}
+	freeze := ctx.msg.Action == apc.ActFreezeBck
+	if freeze == (props.Frozen != 0) {
+		ctx.terminate = true // nothing to do
+		return nil
+	}
+	nprops := props.Clone()
+	nprops.Frozen = 0
+	if freeze {
+		nprops.Frozen = time.Now().UnixNano()
+	}
+	clone.set(bck, nprops)
+	return nil
+}
+
+func (p *proxy) quiesceBck(bck *meta.Bck) (err error) {
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodPost,
+		Path:   apc.URLPathBuckets.Join(bck.Name),
+		Query:  bck.NewQuery(),
+		Body:   cos.MustMarshal(p.newAmsgActVal(apc.ActFreezeBck, nil)),
+	}
+	args.to = core.Targets
+	args.timeout = apc.LongTimeout
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	for _, res := range results {
+		if res.err != nil {
+			err = res.toErr()
+			break
+		}
+	}
+	freeBcastRes(results)
+	return err
+}
+
+/////////////////////
+// target: quiesce //
+/////////////////////
+
+// POST {apc.ActFreezeBck} /v1/buckets/<bucket-name> (via primary)
+func (t *target) freezeBck(w http.ResponseWriter, r *http.Request, bck *meta.Bck, msg *aisMsg) {
+	if bck.Props.Frozen == 0 {
+		t.writeErrf(w, r, "%s: %s(%s) is not frozen (%s)", t, msg.Action, bck.Cname(""), t.owner.bmd.get())
+		return
+	}
+	if err := t.frz.quiesce(bck); err != nil {
+		t.writeErr(w, r, err, http.StatusConflict)
+		return
+	}
+	core.FlushBck(bck)
+	nlog.Infoln(t.String(), msg.Action, bck.Cname(""), "- quiescent")
+}
+
+//////////////
+// tfreezer //
+//////////////
+
+func (f *tfreezer) init(t *target) { f.t = t }
+
+func (f *tfreezer) _cnt(bck *meta.Bck) *atomic.Int64 {
+	key := bck.Cname("")
+	v, ok := f.inflight.Load(key)
+	if !ok {
+		v, _ = f.inflight.LoadOrStore(key, &atomic.Int64{})
+	}
+	return v.(*atomic.Int64)
+}
+
+// NOTE: count first, check next (and see quiesce)
+func (f *tfreezer) enter(bck *meta.Bck) error {
+	cnt := f._cnt(bck)
+	cnt.Inc()
+	if props, present := f.t.owner.bmd.get().Get(bck); present && props.Frozen != 0 {
+		cnt.Dec()
+		return cmn.NewErrBckFrozen(bck.Bucket())
+	}
+	return nil
+}
+
+func (f *tfreezer) exit(bck *meta.Bck) { f._cnt(bck).Dec() }
+
+// the bucket that a given transaction writes into, if any
+func (*tfreezer) txnBck(c *txnSrv) *meta.Bck {
+	switch c.msg.Action {
+	case apc.ActMakeNCopies, apc.ActECEncode, apc.ActMoveBck, apc.ActPromote, apc.ActDestroyBck,
+		apc.ActEvictRemoteBck:
+		return c.bck
+	case apc.ActCopyBck, apc.ActETLBck, apc.ActCopyObjects, apc.ActETLObjects, apc.ActArchive:
+		return c.bckTo
+	}
+	return nil
+}
+
+func (f *tfreezer) quiesce(bck *meta.Bck) error {
+	var busy string
+	for total := time.Duration(0); total < freezeTimeout; total += freezePoll {
+		if busy = f._busy(bck); busy == "" {
+			return nil
+		}
+		time.Sleep(freezePoll)
+	}
+	return cmn.NewErrBusy("bucket", bck.Cname(""), busy)
+}
+
+func (f *tfreezer) _busy(bck *meta.Bck) string {
+	if n := f._cnt(bck).Load(); n > 0 {
+		return strconv.FormatInt(n, 10) + " write(s) in progress"
+	}
+	for _, xctn := range xreg.GetAllBusy() {
+		dtor := xact.Table[xctn.Kind()]
+		if dtor.Rebalance || dtor.Resilver {
+			return xctn.Name() + " in progress"
+		}
+		if _, to := xctn.FromTo(); to != nil {
+			if to.Equal(bck, false, false) {
+				return xctn.Name() + " in progress"
+			}
+			continue // (reading from)
+		}
+		b := xctn.Bck()
+		if b == nil || !b.Equal(bck, false, false) {
+			continue
+		}
+		if dtor.Access != 0 && dtor.Access&^apc.AccessRO == 0 {
+			continue // read-only (e.g., summary)
+		}
+		return xctn.Name() + " in progress"
+	}
+	return ""
+}
@@ -70,8 +70,18 @@ func (g *fsprungroup) _postAdd(action string, mi *fs.Mountpath) {
 
 	fspathsConfigAddDel(mi.Path, true /*add*/)
 	go func() {
+		// move (via HRW) a proportional share of objects onto the added mountpath
 		if cmn.GCO.Get().Resilver.Enabled {
-			g.t.runResilver(res.Args{}, nil /*wg*/)
+			args := res.Args{Action: action}
+			// (a newly attached mountpath is empty, while re-enabled may contain stale objects and copies;
+			// and a resilver that's already running may have been interrupted by this one)
+			if action == apc.ActMountpathAttach && !g.t.res.IsActive(1 /*interval-of-inactivity multiplier*/) {
+				args.Ami = mi
+			}
+			nlog.Infof("%s: %q %s: starting to resilver", g.t, action, mi)
+			g.t.runResilver(args, nil /*wg*/)
+		} else {
+			nlog.Warningf("%s: %q %s: resilvering disabled - objects won't be moved onto the new mountpath", g.t, action, mi)
 		}
 		xreg.RenewMakeNCopies(cos.GenUUID(), action)
 	}()
@@ -118,7 +128,9 @@ func (g *fsprungroup) doDD(action string, flags uint64, mpath string, dontResilv
 
 	core.UncacheMountpath(rmi)
 
-	if noResil || dontResilver || !cmn.GCO.Get().Resilver.Enabled {
+	// detach: always drain the mountpath (unless explicitly asked not to) - its content
+	// won't be accessible after; disable: the content stays, to be resilvered upon re-enabling
+	if noResil || dontResilver || (!cmn.GCO.Get().Resilver.Enabled && action != apc.ActMountpathDetach) {
 		nlog.Infof("%s: %q %s: no resilvering (%t, %t, %t)", g.t, action, rmi,
 			noResil, !dontResilver, cmn.GCO.Get().Resilver.Enabled)
 		g.postDD(rmi, action, nil /*xaction*/, nil /*error*/) // ditto (compare with the one below)
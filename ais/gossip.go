@@ -0,0 +1,311 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"math/rand/v2"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// SWIM-style gossip (config.Keepalive.Gossip) - optional failure detection for (very) large clusters:
+// - every keepalive tick, each node probes `fanout` random peers; the probe carries the sender's
+//   liveness digest (for each node: time since the sender last heard from or about it), the response
+//   carries the peer's digest, and both sides merge the two (see heartBeat.heardAbout)
+// - when a direct probe fails, the node asks up to `fanout` other peers to probe on its behalf;
+//   if all indirect probes fail as well, the peer becomes suspected and gets reported to the primary
+// - the primary tracks suspicion levels (number of distinct reporters) and health-checks
+//   suspected nodes right away, without waiting for the next keepalive interval;
+//   as always, it is only the primary that removes nodes from the cluster map
+// - given fresh gossiped liveness, the primary skips pinging (and other nodes skip sending
+//   keepalives to the primary), which reduces the primary's per-interval load from O(N) to O(fanout)
+
+type (
+	gossipMsg struct {
+		Ages     map[string]int64 `json:"ages,omitempty"`     // node ID => milliseconds since last heard from (or about)
+		Suspects []string         `json:"suspects,omitempty"` // => primary
+		Probe    string           `json:"probe,omitempty"`    // indirect probe: ping this node on the sender's behalf
+	}
+	gossip struct {
+		h          *htrun
+		k          *keepalive
+		suspected  sync.Map // node ID => *gsuspect (primary only)
+		lastCheck  atomic.Int64
+		inProgress atomic.Bool
+	}
+	gsuspect struct {
+		reporters map[string]int64 // reporter ID => mono-time
+		mu        sync.Mutex
+	}
+)
+
+func newGossip(h *htrun, k *keepalive) *gossip { return &gossip{h: h, k: k} }
+
+// runs asynchronously upon keepalive tick
+func (g *gossip) round(config *cmn.Config) {
+	if !g.inProgress.CAS(false, true) {
+		return
+	}
+	defer g.inProgress.Store(false)
+
+	smap := g.h.owner.smap.get()
+	if !smap.isValid() || nlog.Stopping() {
+		return
+	}
+	peers := g.peers(smap)
+	if len(peers) == 0 {
+		return
+	}
+	rand.Shuffle(len(peers), func(i, j int) { peers[i], peers[j] = peers[j], peers[i] })
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		suspects []string
+		fanout   = min(int(config.Keepalive.Gossip.Fanout), len(peers))
+		timeout  = config.Timeout.CplaneOperation.D()
+		body     = cos.MustMarshal(g.digest(smap))
+	)
+	for i := 0; i < fanout; i++ {
+		wg.Add(1)
+		go func(si *meta.Snode) {
+			defer wg.Done()
+			if g.probe(si, body, smap, timeout) || g.probeIndirect(si, peers, fanout, smap, timeout) {
+				return
+			}
+			mu.Lock()
+			suspects = append(suspects, si.ID())
+			mu.Unlock()
+		}(peers[i])
+	}
+	wg.Wait()
+
+	if len(suspects) == 0 {
+		return
+	}
+	for _, sid := range suspects {
+		g.k.hb.expire(sid) // local keepalive won't be skipping it
+	}
+	if smap.isPrimary(g.h.si) {
+		g.suspect(suspects, g.h.SID(), smap)
+		return
+	}
+	nlog.Warningln(g.h.String()+": suspecting", suspects, "- reporting to primary")
+	msg := &gossipMsg{Suspects: suspects}
+	if !g.send(smap.Primary, cos.MustMarshal(msg), smap, timeout, false) {
+		nlog.Warningln(g.h.String()+": failed to report suspects to primary", smap.Primary.StringEx())
+	}
+}
+
+// all nodes other than self, excluding those in maintenance
+func (g *gossip) peers(smap *smapX) meta.Nodes {
+	peers := make(meta.Nodes, 0, smap.Count())
+	for _, nm := range []meta.NodeMap{smap.Pmap, smap.Tmap} {
+		for sid, si := range nm {
+			if sid != g.h.SID() && !si.InMaintOrDecomm() {
+				peers = append(peers, si)
+			}
+		}
+	}
+	return peers
+}
+
+func (g *gossip) probe(si *meta.Snode, body []byte, smap *smapX, timeout time.Duration) bool {
+	return g.send(si, body, smap, timeout, true /*digest*/)
+}
+
+// ask up to `fanout` other (randomly selected) peers to probe `si`
+func (g *gossip) probeIndirect(si *meta.Snode, peers meta.Nodes, fanout int, smap *smapX, timeout time.Duration) bool {
+	var (
+		ok    atomic.Bool
+		wg    sync.WaitGroup
+		body  = cos.MustMarshal(&gossipMsg{Probe: si.ID()})
+		start = rand.IntN(len(peers))
+		cnt   int
+	)
+	for i := range peers {
+		via := peers[(start+i)%len(peers)]
+		if via.ID() == si.ID() {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			if g.send(via, body, smap, timeout<<1, false) {
+				ok.Store(true)
+			}
+			wg.Done()
+		}()
+		if cnt++; cnt >= fanout {
+			break
+		}
+	}
+	wg.Wait()
+	return ok.Load()
+}
+
+func (g *gossip) send(si *meta.Snode, body []byte, smap *smapX, timeout time.Duration, digest bool) bool {
+	cargs := allocCargs()
+	{
+		cargs.si = si
+		cargs.req = cmn.HreqArgs{Method: http.MethodPost, Path: apc.URLPathGossip.S, Body: body}
+		cargs.timeout = timeout
+		if digest {
+			cargs.cresv = cresGS{} // -> gossipMsg
+		}
+	}
+	res := g.h.call(cargs, smap)
+	freeCargs(cargs)
+	ok := res.err == nil
+	if ok && digest {
+		g.merge(res.v.(*gossipMsg).Ages, smap)
+	}
+	freeCR(res)
+	return ok
+}
+
+func (g *gossip) digest(smap *smapX) *gossipMsg {
+	var (
+		now = mono.NanoTime()
+		msg = &gossipMsg{Ages: make(map[string]int64, smap.Count())}
+	)
+	for _, nm := range []meta.NodeMap{smap.Pmap, smap.Tmap} {
+		for sid, si := range nm {
+			switch {
+			case si.InMaintOrDecomm():
+			case sid == g.h.SID():
+				msg.Ages[sid] = 0
+			default:
+				if last := g.k.hb.lastHeard(sid); last > 0 {
+					msg.Ages[sid] = int64(time.Duration(now-last) / time.Millisecond)
+				}
+			}
+		}
+	}
+	return msg
+}
+
+func (g *gossip) merge(ages map[string]int64, smap *smapX) {
+	now := mono.NanoTime()
+	for sid, age := range ages {
+		if sid == g.h.SID() || age < 0 || smap.GetNode(sid) == nil || g.isSuspected(sid, now) {
+			continue
+		}
+		g.k.hb.heardAbout(sid, now-int64(time.Duration(age)*time.Millisecond))
+	}
+}
+
+//
+// primary
+//
+
+func (g *gossip) suspect(sids []string, reporter string, smap *smapX) {
+	now := mono.NanoTime()
+	for _, sid := range sids {
+		si := smap.GetNode(sid)
+		if si == nil || sid == g.h.SID() || si.InMaintOrDecomm() {
+			continue
+		}
+		v, _ := g.suspected.LoadOrStore(sid, &gsuspect{reporters: make(map[string]int64, 2)})
+		s := v.(*gsuspect)
+		s.mu.Lock()
+		s.reporters[reporter] = now
+		level := s.level(now, g.k.interval)
+		s.mu.Unlock()
+
+		nlog.Warningln(g.h.String()+":", reporter, "suspects", si.StringEx(), "- suspicion level", level)
+		g.k.hb.expire(sid)
+	}
+
+	// health-check suspected nodes now (compare w/ keepalive._run handling kaErrorMsg)
+	config := cmn.GCO.Get()
+	if last := g.lastCheck.Load(); mono.Since(last) < cmn.KeepaliveRetryDuration(config) {
+		return
+	}
+	g.lastCheck.Store(now)
+	go g.k.k.do(config)
+}
+
+// (suspicion expires in one keepalive interval unless reported again)
+func (g *gossip) isSuspected(sid string, now int64) bool {
+	v, ok := g.suspected.Load(sid)
+	if !ok {
+		return false
+	}
+	s := v.(*gsuspect)
+	s.mu.Lock()
+	level := s.level(now, g.k.interval)
+	s.mu.Unlock()
+	if level == 0 {
+		g.suspected.Delete(sid)
+	}
+	return level > 0
+}
+
+// number of distinct reporters within the last `window`
+func (s *gsuspect) level(now int64, window time.Duration) (n int) {
+	for reporter, at := range s.reporters {
+		if time.Duration(now-at) > window {
+			delete(s.reporters, reporter)
+			continue
+		}
+		n++
+	}
+	return n
+}
+
+//
+// handler
+//
+
+// POST /v1/gossip
+func (h *htrun) gossipHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		cmn.WriteErr405(w, r, http.MethodPost)
+		return
+	}
+	if err := h.isIntraCall(r.Header, false /*from primary*/); err != nil {
+		h.writeErr(w, r, err)
+		return
+	}
+	var (
+		msg      gossipMsg
+		g        = h.keepalive.gsp()
+		smap     = h.owner.smap.get()
+		callerID = r.Header.Get(apc.HdrCallerID)
+	)
+	if err := readJSON(w, r, &msg); err != nil {
+		return
+	}
+	switch {
+	case msg.Probe != "":
+		si := smap.GetNode(msg.Probe)
+		if si == nil {
+			h.writeErr(w, r, cos.NewErrNotFound(h, "node "+msg.Probe), http.StatusNotFound, Silent)
+			return
+		}
+		if _, _, err := h.reqHealth(si, cmn.Rom.CplaneOperation(), nil, smap); err != nil {
+			h.writeErr(w, r, err, http.StatusServiceUnavailable, Silent)
+		}
+	case len(msg.Suspects) > 0:
+		if !smap.isPrimary(h.si) {
+			h.writeErr(w, r, newErrNotPrimary(h.si, smap))
+			return
+		}
+		g.suspect(msg.Suspects, callerID, smap)
+	default:
+		h.keepalive.heardFrom(callerID)
+		g.merge(msg.Ages, smap)
+		h.writeJSON(w, r, g.digest(smap), "gossip")
+	}
+}
@@ -0,0 +1,154 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// Hedged intra-cluster requests
+// - broadcast is only as fast as its slowest responder
+// - to cut the tail, idempotent (GET-style) broadcasts - stats, sysinfo, list-objects -
+//   may enable `bcastArgs.hedge`, in which case:
+// - once the response from a given node takes longer than this node's recent p95 latency,
+//   we send a duplicate request to the node's intra-control endpoint (see hedgeURL below)
+// - the first successful response wins; the loser gets drained and discarded
+// - no hedging when there's not enough history (hedgeMinSamples) or when the request
+//   has a (non-replayable) body reader
+
+const (
+	hedgeSamples    = 128 // per node
+	hedgeMinSamples = 16
+	hedgeMinDelay   = 10 * time.Millisecond
+	hedgePercentile = 95
+)
+
+type (
+	latRing struct {
+		d [hedgeSamples]time.Duration
+		n int // total samples so far
+	}
+	hedger struct {
+		m  map[string]*latRing // by node ID
+		mu sync.Mutex
+	}
+)
+
+func (lr *latRing) add(d time.Duration) {
+	lr.d[lr.n%hedgeSamples] = d
+	lr.n++
+}
+
+func (lr *latRing) percentile(pct int) time.Duration {
+	var (
+		n   = min(lr.n, hedgeSamples)
+		tmp = make([]time.Duration, n)
+	)
+	copy(tmp, lr.d[:n])
+	sort.Slice(tmp, func(i, j int) bool { return tmp[i] < tmp[j] })
+	return tmp[(n*pct-1)/100]
+}
+
+func (hg *hedger) record(sid string, d time.Duration) {
+	hg.mu.Lock()
+	if hg.m == nil {
+		hg.m = make(map[string]*latRing, 16)
+	}
+	lr, ok := hg.m[sid]
+	if !ok {
+		lr = &latRing{}
+		hg.m[sid] = lr
+	}
+	lr.add(d)
+	hg.mu.Unlock()
+}
+
+// returns zero when there's no history (or not enough of it)
+func (hg *hedger) delay(sid string, timeout time.Duration) (d time.Duration) {
+	hg.mu.Lock()
+	if lr, ok := hg.m[sid]; ok && lr.n >= hedgeMinSamples {
+		d = max(lr.percentile(hedgePercentile), hedgeMinDelay)
+	}
+	hg.mu.Unlock()
+	if timeout > 0 && d >= timeout/2 {
+		d = 0 // won't help
+	}
+	return d
+}
+
+// hedges stay on the intra-cluster control network: same URL (and a new connection)
+// when the call goes via intra-control; otherwise, intra-control instead of intra-data or public
+func hedgeURL(si *meta.Snode) string { return si.URL(cmn.NetIntraControl) }
+
+func (h *htrun) callHedged(args *callArgs, smap *smapX) *callResult {
+	var (
+		sid     = args.si.ID()
+		timeout = args.timeout
+	)
+	if timeout == 0 {
+		timeout = cmn.Rom.CplaneOperation()
+	}
+	if args.req.Base == "" {
+		args.req.Base = args.si.ControlNet.URL // resolve prior to copying (compare w/ h.call)
+	}
+	delay := h.hedge.delay(sid, timeout)
+	if delay == 0 || args.req.BodyR != nil {
+		started := mono.NanoTime()
+		res := h.call(args, smap)
+		if res.err == nil {
+			h.hedge.record(sid, mono.Since(started))
+		}
+		return res
+	}
+
+	// both calls run on their own copies of the args (the caller may free its own upon return)
+	var (
+		pargs   = *args
+		hargs   = *args
+		ch      = make(chan *callResult, 2)
+		started = mono.NanoTime()
+	)
+	hargs.req.Base = hedgeURL(args.si)
+	go func() { ch <- h.call(&pargs, smap) }()
+
+	timer := time.NewTimer(delay)
+	select {
+	case res := <-ch:
+		timer.Stop()
+		if res.err == nil {
+			h.hedge.record(sid, mono.Since(started))
+		}
+		return res
+	case <-timer.C:
+	}
+
+	go func() { ch <- h.call(&hargs, smap) }()
+	if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+		nlog.Infoln(h.String(), "hedging", args.req.Method, args.req.Path, "to", args.si.StringEx(), "after", delay)
+	}
+	res := <-ch
+	if res.err != nil {
+		// first to respond has failed - wait for the other one
+		other := <-ch
+		if other.err != nil {
+			freeCR(other)
+			return res
+		}
+		freeCR(res)
+		res = other
+	} else {
+		go func() { freeCR(<-ch) }()
+	}
+	h.hedge.record(sid, mono.Since(started))
+	return res
+}
@@ -0,0 +1,93 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+func TestHedgeDelay(t *testing.T) {
+	var hg hedger
+	if d := hg.delay("t1", time.Second); d != 0 {
+		t.Fatalf("expected no hedging without history, got %v", d)
+	}
+	for i := range hedgeMinSamples - 1 {
+		hg.record("t1", time.Duration(i+1)*time.Millisecond)
+	}
+	if d := hg.delay("t1", time.Second); d != 0 {
+		t.Fatalf("expected no hedging with %d samples, got %v", hedgeMinSamples-1, d)
+	}
+	// 1ms, 2ms, ..., 100ms (and then some)
+	for i := range 2 * hedgeSamples {
+		hg.record("t1", time.Duration(i%100+1)*time.Millisecond)
+	}
+	d := hg.delay("t1", time.Second)
+	if d < 85*time.Millisecond || d > 100*time.Millisecond {
+		t.Fatalf("expected p95 delay in the [85ms, 100ms] range, got %v", d)
+	}
+	if d := hg.delay("t1", 150*time.Millisecond); d != 0 {
+		t.Fatalf("expected no hedging when p95 exceeds timeout/2, got %v", d)
+	}
+	// floor
+	for range hedgeSamples {
+		hg.record("t2", time.Microsecond)
+	}
+	if d := hg.delay("t2", time.Second); d != hedgeMinDelay {
+		t.Fatalf("expected %v, got %v", hedgeMinDelay, d)
+	}
+}
+
+// hedges go to the intra-control endpoint even when the call does not specify one
+func TestHedgeURL(t *testing.T) {
+	var (
+		ctrlCalls, pubCalls atomic.Int32
+		ctrl                = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			if ctrlCalls.Inc() == 1 {
+				time.Sleep(500 * time.Millisecond) // the one to hedge
+			}
+			w.Write([]byte("ok"))
+		}))
+		pub = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			pubCalls.Inc()
+			w.Write([]byte("ok"))
+		}))
+	)
+	defer ctrl.Close()
+	defer pub.Close()
+
+	p := newDiscoverServerPrimary()
+	si := newSnode("t1", apc.Target, meta.NetInfo{URL: pub.URL}, meta.NetInfo{URL: ctrl.URL}, meta.NetInfo{URL: ctrl.URL})
+	if u := hedgeURL(si); u != ctrl.URL {
+		t.Fatalf("expected hedge URL %s, got %s", ctrl.URL, u)
+	}
+	for range hedgeMinSamples {
+		p.hedge.record(si.ID(), time.Millisecond)
+	}
+
+	args := &callArgs{si: si, req: cmn.HreqArgs{Method: http.MethodGet, Path: apc.URLPathDae.S}, timeout: 5 * time.Second}
+	started := time.Now()
+	res := p.callHedged(args, p.owner.smap.get())
+	defer freeCR(res)
+	if res.err != nil {
+		t.Fatal(res.err)
+	}
+	if d := time.Since(started); d >= 500*time.Millisecond {
+		t.Errorf("expected the hedge to respond first, took %v", d)
+	}
+	if n := ctrlCalls.Load(); n != 2 {
+		t.Errorf("expected 2 intra-control calls, got %d", n)
+	}
+	if n := pubCalls.Load(); n != 0 {
+		t.Errorf("expected no public network calls, got %d", n)
+	}
+}
@@ -0,0 +1,151 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// REST API revision negotiation (apc.HdrAPIRev) - proxy only:
+// - no header: revision 1 (SDKs that predate negotiation)
+// - above apc.APIRevMax: negotiated down to the max (the client is expected to check
+//   `Capabilities.API` and gracefully degrade); below apc.APIRevMin: rejected
+// - the negotiated revision is returned to the client (response header) and is also
+//   made available to the handlers via the request header - see apiRevOf()
+// - control messages (apc.ActMsg) of older revisions get upgraded in place to the current
+//   shape, one revision at a time, so that the handlers only deal with the latest;
+//   to change a message: bump apc.APIRevMax and register the upgrade below
+// - object PUTs and bodies larger than apiXlatMaxBody pass through as is
+
+const apiXlatMaxBody = cos.MiB
+
+type apiXlat struct {
+	upgrade func(msg *apc.ActMsg) error
+	action  string
+	rev     int // upgrades from this revision to the next one
+}
+
+var apiXlats = [...]apiXlat{
+	{rev: 1, action: apc.ActMakeNCopies, upgrade: xlatMNC},
+}
+
+// returns false when the request has been rejected
+func xlatAPI(w http.ResponseWriter, r *http.Request) bool {
+	rev := 1
+	if s := r.Header.Get(apc.HdrAPIRev); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < apc.APIRevMin {
+			err = fmt.Errorf("unsupported API revision %q (supported: [%d, %d])", s, apc.APIRevMin, apc.APIRevMax)
+			cmn.WriteErr(w, r, err, http.StatusBadRequest)
+			return false
+		}
+		rev = min(n, apc.APIRevMax)
+	}
+	srev := strconv.Itoa(rev)
+	r.Header.Set(apc.HdrAPIRev, srev)
+	w.Header().Set(apc.HdrAPIRev, srev)
+
+	if rev < apc.APIRevMax && _xlatable(r) {
+		if err := _xlatBody(r, rev); err != nil {
+			cmn.WriteErr(w, r, err)
+			return false
+		}
+	}
+	return true
+}
+
+// negotiated API revision (handlers that need to respond with older shapes)
+func apiRevOf(r *http.Request) int {
+	if rev, err := strconv.Atoi(r.Header.Get(apc.HdrAPIRev)); err == nil {
+		return rev
+	}
+	return apc.APIRevMax
+}
+
+func _xlatable(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		return false
+	}
+	if r.ContentLength <= 0 || r.ContentLength > apiXlatMaxBody {
+		return false
+	}
+	path := r.URL.Path
+	switch {
+	case strings.HasPrefix(path, apc.URLPathBuckets.S), strings.HasPrefix(path, apc.URLPathClu.S),
+		strings.HasPrefix(path, apc.URLPathDae.S):
+		return true
+	case strings.HasPrefix(path, apc.URLPathObjects.S):
+		return r.Method != http.MethodPut // (object payload)
+	}
+	return false
+}
+
+func _xlatBody(r *http.Request, rev int) error {
+	body, err := io.ReadAll(r.Body)
+	cos.Close(r.Body)
+	if err != nil {
+		return err
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	var msg apc.ActMsg
+	if jsoniter.Unmarshal(body, &msg) != nil || msg.Action == "" {
+		return nil // not a control message (the handler will decide)
+	}
+	var changed bool
+	for i := range apiXlats {
+		xlat := &apiXlats[i]
+		if xlat.rev < rev || xlat.action != msg.Action {
+			continue
+		}
+		if err := xlat.upgrade(&msg); err != nil {
+			return fmt.Errorf("API revision %d => %d: %q: %v", xlat.rev, xlat.rev+1, msg.Action, err)
+		}
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	body = cos.MustMarshal(&msg)
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	r.ContentLength = int64(len(body))
+	r.Header.Set(cos.HdrContentLength, strconv.Itoa(len(body)))
+	if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+		nlog.Infoln("API revision", rev, "=>", apc.APIRevMax, msg.String())
+	}
+	return nil
+}
+
+//
+// upgrades
+//
+
+// rev 1 => 2: number of copies => apc.MNCMsg
+func xlatMNC(msg *apc.ActMsg) error {
+	switch msg.Value.(type) {
+	case map[string]any:
+		return nil // (already)
+	case nil:
+		return nil // (the handler will fail it)
+	}
+	mnc, err := _parseMNC(msg.Value)
+	if err != nil {
+		return err
+	}
+	msg.Value = mnc
+	return nil
+}
@@ -0,0 +1,77 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	jsoniter "github.com/json-iterator/go"
+)
+
+func TestAPIRevNegotiation(t *testing.T) {
+	newReq := func(rev string, msg *apc.ActMsg) *http.Request {
+		body := cos.MustMarshal(msg)
+		r := httptest.NewRequest(http.MethodPost, apc.URLPathBuckets.Join("abc"), bytes.NewReader(body))
+		if rev != "" {
+			r.Header.Set(apc.HdrAPIRev, rev)
+		}
+		return r
+	}
+	tests := []struct {
+		rev      string
+		expected int // 0: rejected
+	}{
+		{"", 1},
+		{"1", 1},
+		{strconv.Itoa(apc.APIRevMax), apc.APIRevMax},
+		{strconv.Itoa(apc.APIRevMax + 10), apc.APIRevMax},
+		{"0", 0},
+		{"abc", 0},
+	}
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		ok := xlatAPI(w, newReq(test.rev, &apc.ActMsg{Action: apc.ActCopyBck}))
+		if test.expected == 0 {
+			if ok || w.Code != http.StatusBadRequest {
+				t.Errorf("rev %q: expected %d, got %v (%d)", test.rev, http.StatusBadRequest, ok, w.Code)
+			}
+			continue
+		}
+		if !ok {
+			t.Fatalf("rev %q: unexpected rejection (%d)", test.rev, w.Code)
+		}
+		if got := w.Header().Get(apc.HdrAPIRev); got != strconv.Itoa(test.expected) {
+			t.Errorf("rev %q: expected negotiated %d, got %q", test.rev, test.expected, got)
+		}
+	}
+
+	// rev 1: make-n-copies with the number of copies
+	r := newReq("", &apc.ActMsg{Action: apc.ActMakeNCopies, Value: 3})
+	if !xlatAPI(httptest.NewRecorder(), r) {
+		t.Fatal("unexpected rejection")
+	}
+	var msg struct {
+		Value  apc.MNCMsg `json:"value"`
+		Action string     `json:"action"`
+	}
+	if err := jsoniter.NewDecoder(r.Body).Decode(&msg); err != nil {
+		t.Fatal(err)
+	}
+	if msg.Action != apc.ActMakeNCopies || msg.Value.Copies != 3 {
+		t.Fatalf("expected %s with 3 copies, got %+v", apc.ActMakeNCopies, msg)
+	}
+
+	// ditto, invalid
+	w := httptest.NewRecorder()
+	if xlatAPI(w, newReq("1", &apc.ActMsg{Action: apc.ActMakeNCopies, Value: 0})) {
+		t.Fatal("expected rejection")
+	}
+}
@@ -0,0 +1,291 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	ratomic "sync/atomic"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/hk"
+)
+
+// HTTPS server certificates:
+// - public listener(s) use net.http.server_crt (and server_key); intra-cluster control and
+//   data listeners use net.http.intra_server_crt (and intra_server_key), if configured -
+//   otherwise, the same certificate as public
+// - listeners obtain the current certificate via tls.Config.GetCertificate, so that rotation
+//   applies to all subsequent TLS handshakes with no restart and no downtime (established
+//   connections are not affected)
+// - rotation (apc.ActRotateCert) is cluster-wide or node-specific and either:
+//   a) uploads a new PEM-encoded certificate and key: each node validates the pair, overwrites
+//      the configured files (so that restarts pick it up), and swaps it in; or
+//   b) reloads the configured files, e.g. after they get renewed by external tooling
+// - health responses carry the earliest expiration (apc.HdrCertExpiry); details via
+//   apc.WhatCertInfo; housekeeping warns when the expiration is near
+
+const (
+	certExpiryWarn = 14 * 24 * time.Hour
+	certHkIval     = time.Hour
+)
+
+type (
+	srvCert struct {
+		tls.Certificate
+		info apc.CertInfo
+	}
+	certKeeper struct {
+		pub   ratomic.Pointer[srvCert]
+		intra ratomic.Pointer[srvCert]
+		mu    sync.Mutex // serializes rotations
+	}
+)
+
+// (apc.ActRotateCert)
+func (h *htrun) rotateCert(msg *apc.ActMsg) error {
+	config := cmn.GCO.Get()
+	if !config.Net.HTTP.UseHTTPS {
+		return fmt.Errorf("%s: HTTPS is not enabled (see net.http.use_https)", h)
+	}
+	val := &apc.ActValCert{}
+	if msg.Value != nil {
+		if err := cos.MorphMarshal(msg.Value, val); err != nil {
+			return fmt.Errorf(cmn.FmtErrMorphUnmarshal, h.si, msg.Action, msg.Value, err)
+		}
+	}
+	return g.certs.rotate(&config.Net.HTTP, val)
+}
+
+func certFiles(conf *cmn.HTTPConf, net string) (crt, key string) {
+	if net == apc.CertNetIntra && conf.IntraCert != "" {
+		return conf.IntraCert, conf.IntraCertKey
+	}
+	return conf.Certificate, conf.CertKey
+}
+
+func newSrvCert(net, source string, crtPEM, keyPEM []byte) (*srvCert, error) {
+	c, err := tls.X509KeyPair(crtPEM, keyPEM)
+	if err != nil {
+		return nil, err
+	}
+	leaf, err := x509.ParseCertificate(c.Certificate[0])
+	if err != nil {
+		return nil, err
+	}
+	c.Leaf = leaf
+	now := time.Now()
+	if now.After(leaf.NotAfter) {
+		return nil, fmt.Errorf("certificate %q expired on %s", leaf.Subject, leaf.NotAfter.Format(time.RFC3339))
+	}
+	sc := &srvCert{Certificate: c}
+	sc.info = apc.CertInfo{
+		Net:       net,
+		Subject:   leaf.Subject.String(),
+		Issuer:    leaf.Issuer.String(),
+		Serial:    leaf.SerialNumber.String(),
+		DNSNames:  leaf.DNSNames,
+		NotBefore: leaf.NotBefore,
+		NotAfter:  leaf.NotAfter,
+		Loaded:    now,
+		Source:    source,
+	}
+	return sc, nil
+}
+
+func loadSrvCert(conf *cmn.HTTPConf, net string) (*srvCert, error) {
+	crt, key := certFiles(conf, net)
+	crtPEM, err := os.ReadFile(crt)
+	if err != nil {
+		return nil, err
+	}
+	keyPEM, err := os.ReadFile(key)
+	if err != nil {
+		return nil, err
+	}
+	sc, err := newSrvCert(net, crt, crtPEM, keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("%s (%s, %s): %v", net, crt, key, err)
+	}
+	return sc, nil
+}
+
+////////////////
+// certKeeper //
+////////////////
+
+func (ck *certKeeper) init(conf *cmn.HTTPConf) error {
+	if err := ck.reload(conf, ""); err != nil {
+		return err
+	}
+	hk.Reg("server-cert"+hk.NameSuffix, ck.housekeep, certHkIval)
+	return nil
+}
+
+// (tls.Config.GetCertificate)
+func (ck *certKeeper) getPub(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return ck.get(&ck.pub)
+}
+
+func (ck *certKeeper) getIntra(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return ck.get(&ck.intra)
+}
+
+func (*certKeeper) get(p *ratomic.Pointer[srvCert]) (*tls.Certificate, error) {
+	sc := p.Load()
+	if sc == nil {
+		return nil, errors.New("server certificate not loaded")
+	}
+	return &sc.Certificate, nil
+}
+
+func (ck *certKeeper) rotate(conf *cmn.HTTPConf, val *apc.ActValCert) error {
+	switch val.Net {
+	case "", apc.CertNetPub, apc.CertNetIntra:
+	default:
+		return fmt.Errorf("invalid certificate net %q (expecting %q, %q, or empty for all listeners)",
+			val.Net, apc.CertNetPub, apc.CertNetIntra)
+	}
+	if val.Net != "" && conf.IntraCert == "" {
+		return fmt.Errorf("public and intra-cluster listeners share the same certificate (%s) - to rotate %q only, "+
+			"configure net.http.intra_server_crt and net.http.intra_server_key", conf.Certificate, val.Net)
+	}
+	if val.Cert == "" && val.Key == "" {
+		return ck.reload(conf, val.Net)
+	}
+	if val.Cert == "" || val.Key == "" {
+		return errors.New("both certificate and key must be provided (or neither, to reload from the configured files)")
+	}
+	return ck.upload(conf, val)
+}
+
+// load (or reload) from the configured files
+func (ck *certKeeper) reload(conf *cmn.HTTPConf, net string) error {
+	ck.mu.Lock()
+	defer ck.mu.Unlock()
+	if net == "" || net == apc.CertNetPub {
+		sc, err := loadSrvCert(conf, apc.CertNetPub)
+		if err != nil {
+			return err
+		}
+		ck.pub.Store(sc)
+		if conf.IntraCert == "" {
+			ck.intra.Store(sc)
+		}
+		ck.loaded(sc)
+	}
+	if conf.IntraCert != "" && (net == "" || net == apc.CertNetIntra) {
+		sc, err := loadSrvCert(conf, apc.CertNetIntra)
+		if err != nil {
+			return err
+		}
+		ck.intra.Store(sc)
+		ck.loaded(sc)
+	}
+	return nil
+}
+
+// validate, persist, and swap in
+func (ck *certKeeper) upload(conf *cmn.HTTPConf, val *apc.ActValCert) error {
+	nets := []string{apc.CertNetPub, apc.CertNetIntra}
+	switch {
+	case val.Net != "":
+		nets = []string{val.Net}
+	case conf.IntraCert == "":
+		nets = nets[:1] // (shared)
+	}
+	ck.mu.Lock()
+	defer ck.mu.Unlock()
+	for _, net := range nets {
+		crt, key := certFiles(conf, net)
+		sc, err := newSrvCert(net, crt, []byte(val.Cert), []byte(val.Key))
+		if err != nil {
+			return err
+		}
+		if err := writeCertFile(key, val.Key, 0o600); err != nil {
+			return err
+		}
+		if err := writeCertFile(crt, val.Cert, 0o644); err != nil {
+			return err
+		}
+		if net == apc.CertNetIntra {
+			ck.intra.Store(sc)
+		} else {
+			ck.pub.Store(sc)
+			if conf.IntraCert == "" {
+				ck.intra.Store(sc)
+			}
+		}
+		ck.loaded(sc)
+	}
+	return nil
+}
+
+// write-and-rename
+func writeCertFile(fqn, pem string, perm os.FileMode) error {
+	tmp := fqn + ".tmp"
+	if err := os.WriteFile(tmp, []byte(pem), perm); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, fqn); err != nil {
+		if errV := cos.RemoveFile(tmp); errV != nil {
+			nlog.Errorln("failed to remove", tmp, "[", errV, "]")
+		}
+		return err
+	}
+	return nil
+}
+
+func (*certKeeper) loaded(sc *srvCert) {
+	nlog.Infoln("loaded", sc.info.Net, "server certificate", sc.info.Subject, "serial", sc.info.Serial,
+		"expires", sc.info.NotAfter.Format(time.RFC3339))
+}
+
+func (ck *certKeeper) info() (out []*apc.CertInfo) {
+	pub, intra := ck.pub.Load(), ck.intra.Load()
+	if pub != nil {
+		out = append(out, &pub.info)
+	}
+	if intra != nil && intra != pub {
+		out = append(out, &intra.info)
+	}
+	return out
+}
+
+func (ck *certKeeper) expiry2hdr(hdr http.Header) {
+	var expiry time.Time
+	for _, info := range ck.info() {
+		if expiry.IsZero() || info.NotAfter.Before(expiry) {
+			expiry = info.NotAfter
+		}
+	}
+	if !expiry.IsZero() {
+		hdr.Set(apc.HdrCertExpiry, strconv.FormatInt(expiry.UnixNano(), 10))
+	}
+}
+
+func (ck *certKeeper) housekeep() time.Duration {
+	now := time.Now()
+	for _, info := range ck.info() {
+		switch left := info.NotAfter.Sub(now); {
+		case left <= 0:
+			nlog.Errorln(info.Net, "server certificate", info.Subject, "expired on", info.NotAfter.Format(time.RFC3339))
+		case left < certExpiryWarn:
+			nlog.Warningln(info.Net, "server certificate", info.Subject, "expires in", left.Round(time.Hour),
+				"- renew and rotate (see", apc.ActRotateCert+")")
+		}
+	}
+	return certHkIval
+}
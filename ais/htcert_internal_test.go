@@ -0,0 +1,106 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+func genCertPEM(t *testing.T, cn string, serial int64, notAfter time.Time) (crt, key string) {
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: cn},
+		DNSNames:     []string{cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &pk.PublicKey, pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kder, err := x509.MarshalECPrivateKey(pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	crt = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	key = string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: kder}))
+	return crt, key
+}
+
+func TestCertRotate(t *testing.T) {
+	var (
+		dir  = t.TempDir()
+		conf = &cmn.HTTPConf{
+			Certificate: filepath.Join(dir, "server.crt"),
+			CertKey:     filepath.Join(dir, "server.key"),
+		}
+		ck       = &certKeeper{}
+		expiry   = time.Now().Add(30 * 24 * time.Hour).Truncate(time.Second)
+		crt, key = genCertPEM(t, "ais.local", 1, expiry)
+	)
+	if err := os.WriteFile(conf.Certificate, []byte(crt), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(conf.CertKey, []byte(key), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ck.reload(conf, ""); err != nil {
+		t.Fatal(err)
+	}
+	if info := ck.info(); len(info) != 1 || info[0].Serial != "1" {
+		t.Fatalf("expected one (shared) certificate with serial 1, got %+v", info)
+	}
+	hdr := http.Header{}
+	ck.expiry2hdr(hdr)
+	if hdr.Get(apc.HdrCertExpiry) != strconv.FormatInt(expiry.UnixNano(), 10) {
+		t.Fatalf("expected expiry %v, got %q", expiry, hdr.Get(apc.HdrCertExpiry))
+	}
+
+	// upload: swapped in and persisted
+	crt2, key2 := genCertPEM(t, "ais.local", 2, expiry.Add(time.Hour))
+	if err := ck.rotate(conf, &apc.ActValCert{Cert: crt2, Key: key2}); err != nil {
+		t.Fatal(err)
+	}
+	if c, _ := ck.getIntra(nil); c.Leaf.SerialNumber.Int64() != 2 {
+		t.Fatalf("expected serial 2, got %d", c.Leaf.SerialNumber.Int64())
+	}
+	if b, _ := os.ReadFile(conf.Certificate); string(b) != crt2 {
+		t.Fatal("expected the uploaded certificate to be persisted")
+	}
+
+	// invalid: mismatched pair, expired, intra-only when shared
+	if err := ck.rotate(conf, &apc.ActValCert{Cert: crt, Key: key2}); err == nil {
+		t.Fatal("expected mismatched certificate and key to fail")
+	}
+	crt3, key3 := genCertPEM(t, "ais.local", 3, time.Now().Add(-time.Minute))
+	if err := ck.rotate(conf, &apc.ActValCert{Cert: crt3, Key: key3}); err == nil {
+		t.Fatal("expected expired certificate to fail")
+	}
+	if err := ck.rotate(conf, &apc.ActValCert{Net: apc.CertNetIntra}); err == nil {
+		t.Fatal("expected intra-only rotation to fail with shared certificate")
+	}
+	if c, _ := ck.getPub(nil); c.Leaf.SerialNumber.Int64() != 2 {
+		t.Fatalf("expected serial 2 to remain in use, got %d", c.Leaf.SerialNumber.Int64())
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"net/url"
 	"os"
 	rdebug "runtime/debug"
+	"strconv"
 	"strings"
 	"sync"
 	"syscall"
@@ -31,6 +32,7 @@ import (
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/ext/etl"
 	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/xact/xreg"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/tinylib/msgp/msgp"
@@ -59,14 +61,15 @@ type (
 	// cluster-wide control information - replicated, versioned, and synchronized
 	// usage: elect new primary, join cluster, ...
 	cluMeta struct {
-		Smap      *smapX             `json:"smap"`
-		BMD       *bucketMD          `json:"bmd"`
-		RMD       *rebMD             `json:"rmd"`
-		EtlMD     *etlMD             `json:"etlMD"`
-		Config    *globalConfig      `json:"config"`
-		SI        *meta.Snode        `json:"si"`
-		PrimeTime int64              `json:"prime_time"`
-		Flags     cos.NodeStateFlags `json:"flags"`
+		Smap      *smapX                `json:"smap"`
+		BMD       *bucketMD             `json:"bmd"`
+		RMD       *rebMD                `json:"rmd"`
+		EtlMD     *etlMD                `json:"etlMD"`
+		Config    *globalConfig         `json:"config"`
+		SI        *meta.Snode           `json:"si"`
+		Vote      *meta.ElectionHistory `json:"vote,omitempty"` // what=smapvote only
+		PrimeTime int64                 `json:"prime_time"`
+		Flags     cos.NodeStateFlags    `json:"flags"`
 	}
 
 	// extend control msg: ActionMsg with an extra information for node <=> node control plane communications
@@ -120,22 +123,26 @@ type (
 		cresv   cresv
 		si      *meta.Snode
 		req     cmn.HreqArgs
+		rid     string // client request ID (apc.HdrReqID), if any
 		timeout time.Duration
 	}
 
 	// bcastArgs: intra-cluster broadcast call args
 	bcastArgs struct {
-		cresv             cresv          // call result value (comment above)
-		smap              *smapX         // Smap to use
-		network           string         // one of the cmn.KnownNetworks
-		req               cmn.HreqArgs   // h.call args
-		nodes             []meta.NodeMap // broadcast destinations - map(s)
-		selected          meta.Nodes     // broadcast destinations - slice of selected few
-		timeout           time.Duration  // call timeout
-		to                int            // (all targets, all proxies, all nodes) enum
-		nodeCount         int            // m.b. greater or equal destination count
-		ignoreMaintenance bool           // do not skip nodes in maintenance mode
-		async             bool           // ignore results
+		cresv             cresv            // call result value (comment above)
+		smap              *smapX           // Smap to use
+		network           string           // one of the cmn.KnownNetworks
+		req               cmn.HreqArgs     // h.call args
+		rid               string           // client request ID to propagate (apc.HdrReqID)
+		nodes             []meta.NodeMap   // broadcast destinations - map(s)
+		selected          meta.Nodes       // broadcast destinations - slice of selected few
+		timeout           time.Duration    // call timeout
+		to                int              // (all targets, all proxies, all nodes) enum
+		nodeCount         int              // m.b. greater or equal destination count
+		ignoreMaintenance bool             // do not skip nodes in maintenance mode
+		async             bool             // ignore results
+		hedge             bool             // idempotent: ok to send duplicate upon delay (see callHedged)
+		resCh             chan *callResult // deliver results as they arrive (see bcastStream)
 	}
 
 	networkHandler struct {
@@ -176,6 +183,7 @@ type (
 		sync.Mutex
 		s             *http.Server
 		muxers        httpMuxers
+		qos           *cmn.QoSClass // accepted connections: DSCP marking and priority (see cmn.QoSConf)
 		sndRcvBufSize int
 	}
 
@@ -393,9 +401,18 @@ type (
 	cresEM struct{} // -> etl.CPUMemUsed
 	cresIC struct{} // -> icBundle
 	cresBM struct{} // -> bucketMD
-
-	cresLso   struct{} // -> cmn.LsoRes
-	cresBsumm struct{} // -> cmn.AllBsummResults
+	cresGS struct{} // -> gossipMsg
+	cresCF struct{} // -> cmn.Config
+	cresCH struct{} // -> cmn.ChangeFeed
+	cresLR struct{} // -> []*cmn.LongReq
+	cresNS struct{} // -> stats.Node
+	cresQT struct{} // -> cmn.Quarantine
+	cresNU struct{} // -> cmn.NsSizes
+
+	cresLso    struct{} // -> cmn.LsoRes
+	cresSample struct{} // -> cmn.SampleRes
+	cresBsumm  struct{} // -> cmn.AllBsummResults
+	cresLsumm  struct{} // -> cmn.LsoSumms
 )
 
 var (
@@ -409,7 +426,16 @@ var (
 	_ cresv = cresEM{}
 	_ cresv = cresIC{}
 	_ cresv = cresBM{}
+	_ cresv = cresGS{}
+	_ cresv = cresCF{}
+	_ cresv = cresCH{}
+	_ cresv = cresLR{}
+	_ cresv = cresNS{}
+	_ cresv = cresQT{}
+	_ cresv = cresNU{}
+	_ cresv = cresSample{}
 	_ cresv = cresBsumm{}
+	_ cresv = cresLsumm{}
 )
 
 func (res *callResult) read(body io.Reader, size int64) {
@@ -458,9 +484,36 @@ func (c cresIC) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jr
 func (cresBM) newV() any                              { return &bucketMD{} }
 func (c cresBM) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
 
+func (cresGS) newV() any                              { return &gossipMsg{} }
+func (c cresGS) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
+
+func (cresCF) newV() any                              { return &cmn.Config{} }
+func (c cresCF) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
+
+func (cresCH) newV() any                              { return &cmn.ChangeFeed{} }
+func (c cresCH) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
+
+func (cresLR) newV() any                              { return &[]*cmn.LongReq{} }
+func (c cresLR) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
+
+func (cresNS) newV() any                              { return &stats.Node{} }
+func (c cresNS) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
+
+func (cresQT) newV() any                              { return &cmn.Quarantine{} }
+func (c cresQT) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
+
+func (cresNU) newV() any                              { return &cmn.NsSizes{} }
+func (c cresNU) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
+
+func (cresSample) newV() any                              { return &cmn.SampleRes{} }
+func (c cresSample) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
+
 func (cresBsumm) newV() any                              { return &cmn.AllBsummResults{} }
 func (c cresBsumm) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
 
+func (cresLsumm) newV() any                              { return &cmn.LsoSumms{} }
+func (c cresLsumm) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
+
 ////////////////
 // nlogWriter //
 ////////////////
@@ -550,7 +603,7 @@ func (server *netServer) listen(addr string, logger *log.Logger, tlsConf *tls.Co
 	if timeout, isSet := cmn.ParseReadHeaderTimeout(); isSet { // optional env var
 		server.s.ReadHeaderTimeout = timeout
 	}
-	if server.sndRcvBufSize > 0 && !config.Net.HTTP.UseHTTPS {
+	if (server.sndRcvBufSize > 0 || server.qos != nil) && !config.Net.HTTP.UseHTTPS {
 		server.s.ConnState = server.connStateListener // setsockopt; see also cmn.NewTransport
 	}
 	server.s.TLSConfig = tlsConf
@@ -558,7 +611,7 @@ func (server *netServer) listen(addr string, logger *log.Logger, tlsConf *tls.Co
 retry:
 	if config.Net.HTTP.UseHTTPS {
 		tag = "HTTPS"
-		err = server.s.ListenAndServeTLS(config.Net.HTTP.Certificate, config.Net.HTTP.CertKey)
+		err = server.s.ListenAndServeTLS("", "") // (tls.Config.GetCertificate - see certKeeper)
 	} else {
 		err = server.s.ListenAndServe()
 	}
@@ -601,7 +654,7 @@ func (server *netServer) connStateListener(c net.Conn, cs http.ConnState) {
 	tcpconn, ok := c.(*net.TCPConn)
 	cos.Assert(ok)
 	rawconn, _ := tcpconn.SyscallConn()
-	args := cmn.TransportArgs{SndRcvBufSize: server.sndRcvBufSize}
+	args := cmn.TransportArgs{SndRcvBufSize: server.sndRcvBufSize, QoS: server.qos}
 	rawconn.Control(args.ConnControl(rawconn))
 }
 
@@ -636,11 +689,70 @@ func newMuxers() httpMuxers {
 // ServeHTTP dispatches the request to the handler whose
 // pattern most closely matches the request URL.
 func (m httpMuxers) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	if sm, ok := m[r.Method]; ok {
+	sm, ok := m[r.Method]
+	if !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if isIntraReq(r.Header) {
 		sm.ServeHTTP(w, r)
 		return
 	}
-	w.WriteHeader(http.StatusBadRequest)
+	reqID(w, r)
+	if g.xlatAPI && !xlatAPI(w, r) {
+		return
+	}
+
+	// client request: count it or, when draining, reject
+	if !g.drain.enter() {
+		cmn.WriteErr(w, r, errDraining, http.StatusServiceUnavailable)
+		return
+	}
+	sm.ServeHTTP(w, r)
+	g.drain.exit()
+}
+
+// request ID (apc.HdrReqID):
+// - proxy: assign a new one unless provided by the client
+// - target: when redirected, recover it from the query (apc.QparamReqID)
+// - intra-cluster calls on behalf of the request: propagate via callArgs.rid (bcastArgs.rid)
+func reqID(w http.ResponseWriter, r *http.Request) {
+	id := r.Header.Get(apc.HdrReqID)
+	if id == "" {
+		if strings.Contains(r.URL.RawQuery, apc.QparamReqID+"=") {
+			id = r.URL.Query().Get(apc.QparamReqID)
+		} else if g.genReqID {
+			id = cos.GenUUID()
+		}
+		if id == "" {
+			return
+		}
+		r.Header.Set(apc.HdrReqID, id)
+	}
+	w.Header().Set(apc.HdrReqID, id)
+}
+
+// parse optional node-stats filter (see stats.Filter)
+func statsFlt(query url.Values) (*stats.Filter, error) {
+	var (
+		flt   stats.Filter
+		names = query.Get(apc.QparamMetrics)
+		since = query.Get(apc.QparamSince)
+	)
+	if names == "" && since == "" {
+		return nil, nil
+	}
+	if names != "" {
+		flt.Names = strings.Split(names, ",")
+	}
+	if since != "" {
+		v, err := strconv.ParseInt(since, 10, 64)
+		if err != nil || v < 0 {
+			return nil, fmt.Errorf("invalid %s=%q (expecting Unix time in nanoseconds)", apc.QparamSince, since)
+		}
+		flt.Since = v
+	}
+	return &flt, nil
 }
 
 /////////////////
@@ -668,6 +780,9 @@ func (t *target) fillNsti(nsti *cos.NodeStateInfo) {
 	if marked.Restarted {
 		nsti.Flags = nsti.Flags.Set(cos.Restarted)
 	}
+	if marked.DataLoss {
+		nsti.Flags = nsti.Flags.Set(cos.DataLoss)
+	}
 	marked = xreg.GetResilverMarked()
 	if marked.Xact != nil {
 		nsti.Flags = nsti.Flags.Set(cos.Resilvering)
@@ -872,22 +987,53 @@ func newBckFromQuname(query url.Values, required bool) (*meta.Bck, error) {
 	return meta.CloneBck(&bck), nil
 }
 
+// enabling mirroring, changing the number of copies, or disabling mirroring
+// (the latter to remove all extra copies)
 func _reMirror(bprops, nprops *cmn.Bprops) bool {
-	if !bprops.Mirror.Enabled && nprops.Mirror.Enabled {
+	if bprops.Mirror.Enabled != nprops.Mirror.Enabled {
 		return true
 	}
-	if bprops.Mirror.Enabled && nprops.Mirror.Enabled {
+	if bprops.Mirror.Enabled {
 		return bprops.Mirror.Copies != nprops.Mirror.Copies
 	}
 	return false
 }
 
+// the number of copies to re-mirror to (see _reMirror)
+func _mirrorCopies(nprops *cmn.Bprops) int {
+	if !nprops.Mirror.Enabled {
+		return 1
+	}
+	return int(nprops.Mirror.Copies)
+}
+
+// with targets labeled by failure domains (rack, zone, etc.), erasure-coded (or replicated)
+// objects must survive the loss of any single domain (see meta.HrwTargetList)
+func _ecFailDoms(ecconf *cmn.ECConf, smap *smapX) error {
+	if len(smap.FailureDomains()) == 0 {
+		return nil
+	}
+	count, need := ecconf.DataSlices+ecconf.ParitySlices+1, ecconf.DataSlices
+	if ecconf.ObjSizeLimit == cmn.ObjSizeToAlwaysReplicate {
+		count, need = ecconf.ParitySlices+1, 1
+	}
+	maxn, nfds := smap.FailDomSpread(count)
+	if maxn == 0 || count-maxn >= need {
+		return nil // ok (or not enough targets - the case handled by ECConf validation)
+	}
+	err := fmt.Errorf("EC configuration (D = %d, P = %d) cannot survive the loss of a failure domain: "+
+		"up to %d out of %d slices and replicas would reside in the same domain (number of domains: %d)",
+		ecconf.DataSlices, ecconf.ParitySlices, maxn, count, nfds)
+	return cmn.NewErrWarning(err.Error())
+}
+
 func _reEC(bprops, nprops *cmn.Bprops, bck *meta.Bck, smap *smapX) (targetCnt int, yes bool) {
 	if !nprops.EC.Enabled {
 		if bprops.EC.Enabled {
-			// abort running ec-encode xaction, if exists
-			flt := xreg.Flt{Kind: apc.ActECEncode, Bck: bck}
-			xreg.DoAbort(flt, errors.New("ec-disabled"))
+			// abort running ec-encode and ec-reslice xactions, if exist
+			for _, kind := range []string{apc.ActECEncode, apc.ActECReslice} {
+				xreg.DoAbort(xreg.Flt{Kind: kind, Bck: bck}, errors.New("ec-disabled"))
+			}
 		}
 		return
 	}
@@ -900,3 +1046,9 @@ func _reEC(bprops, nprops *cmn.Bprops, bck *meta.Bck, smap *smapX) (targetCnt in
 	}
 	return
 }
+
+// erasure coded bucket with changed (data, parity) - see ec.XactBckReslice
+func _reslice(bprops, nprops *cmn.ECConf) bool {
+	return bprops.Enabled && nprops.Enabled &&
+		(bprops.DataSlices != nprops.DataSlices || bprops.ParitySlices != nprops.ParitySlices)
+}
@@ -0,0 +1,101 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// records apc.HdrReqID of each intra-cluster call, by node ID
+type ridRecorder struct {
+	m  map[string]string
+	mu sync.Mutex
+}
+
+func (rr *ridRecorder) server(sid string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		rr.mu.Lock()
+		rr.m[sid] = r.Header.Get(apc.HdrReqID)
+		rr.mu.Unlock()
+	}))
+}
+
+func (rr *ridRecorder) check(t *testing.T, tag, expect string, sids ...string) {
+	rr.mu.Lock()
+	defer rr.mu.Unlock()
+	for _, sid := range sids {
+		if rid, ok := rr.m[sid]; !ok {
+			t.Errorf("%s: %s not called", tag, sid)
+		} else if rid != expect {
+			t.Errorf("%s: %s: expected request ID %q, got %q", tag, sid, expect, rid)
+		}
+	}
+	clear(rr.m)
+}
+
+func TestReqIDPropagation(t *testing.T) {
+	const rid = "nZ4Ct5Q3h"
+	var (
+		rr   = &ridRecorder{m: make(map[string]string, 2)}
+		p    = newDiscoverServerPrimary()
+		smap = newSmap()
+	)
+	smap.addProxy(p.si)
+	smap.Primary = p.si
+	for _, sid := range []string{"t1", "t2"} {
+		srv := rr.server(sid)
+		defer srv.Close()
+		ni := meta.NetInfo{URL: srv.URL}
+		smap.addTarget(newSnode(sid, apc.Target, ni, ni, ni))
+	}
+	bcast := func(rid string) {
+		args := allocBcArgs()
+		args.req = cmn.HreqArgs{Method: http.MethodGet, Path: apc.URLPathDae.S}
+		args.rid = rid
+		args.smap = smap
+		args.to = core.Targets
+		freeBcastRes(p.bcastGroup(args))
+		freeBcArgs(args)
+	}
+
+	// broadcast
+	bcast(rid)
+	rr.check(t, "bcast", rid, "t1", "t2")
+
+	// (none to propagate)
+	bcast("")
+	rr.check(t, "bcast w/o request ID", "", "t1", "t2")
+
+	// single call
+	cargs := allocCargs()
+	cargs.si = smap.GetTarget("t1")
+	cargs.req = cmn.HreqArgs{Method: http.MethodGet, Path: apc.URLPathDae.S}
+	cargs.rid = rid
+	freeCR(p.call(cargs, smap))
+	freeCargs(cargs)
+	rr.check(t, "call", rid, "t1")
+
+	// on behalf of the client request (compare w/ reqID)
+	r := httptest.NewRequest(http.MethodPut, apc.URLPathClu.S, http.NoBody)
+	r.Header.Set(apc.HdrReqID, rid)
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: apc.URLPathDae.S}
+	args.smap = smap
+	w := httptest.NewRecorder()
+	p.bcastAllNodes(w, r, args)
+	freeBcArgs(args)
+	if w.Code != http.StatusOK {
+		t.Fatalf("bcast all nodes: status %d: %s", w.Code, w.Body.String())
+	}
+	rr.check(t, "bcast all nodes", rid, "t1", "t2")
+}
@@ -0,0 +1,103 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/k8s"
+)
+
+// Dynamic discovery of the proxies to join (see cmn.ProxyConf.Discovery), so that
+// auto-scaled nodes can find the cluster without having (primary) URLs baked into
+// their configuration. Built-in methods:
+// - "dns-srv:<name>"               - DNS SRV records
+// - "k8s:<service>[:<port-name>]"  - Kubernetes endpoints of the (proxy) service
+// Other methods (e.g., cloud instance tags) plug in by adding to the `discoverers` table.
+
+const discoveryTimeout = 10 * time.Second
+
+// returns proxy URLs given discovery spec (the part that follows "<scheme>:")
+type discoverFn func(ctx context.Context, spec string, config *cmn.Config) ([]string, error)
+
+var discoverers = map[string]discoverFn{
+	"dns-srv": discoverSRV,
+	"k8s":     discoverK8s,
+}
+
+func discoverProxies(config *cmn.Config) ([]string, error) {
+	scheme, spec, _ := strings.Cut(config.Proxy.Discovery, ":")
+	fn, ok := discoverers[scheme]
+	if !ok {
+		return nil, fmt.Errorf("unknown proxy discovery method %q (%q)", scheme, config.Proxy.Discovery)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), discoveryTimeout)
+	urls, err := fn(ctx, spec, config)
+	cancel()
+	if err != nil {
+		return nil, fmt.Errorf("proxy discovery %q: %w", config.Proxy.Discovery, err)
+	}
+	return urls, nil
+}
+
+func _discURL(config *cmn.Config, host string, port int) string {
+	scheme := "http"
+	if config.Net.HTTP.UseHTTPS {
+		scheme = "https"
+	}
+	return scheme + "://" + net.JoinHostPort(host, strconv.Itoa(port))
+}
+
+// DNS SRV: "dns-srv:_service._proto.name" (fully qualified)
+func discoverSRV(ctx context.Context, name string, config *cmn.Config) ([]string, error) {
+	_, addrs, err := net.DefaultResolver.LookupSRV(ctx, "", "", name)
+	if err != nil {
+		return nil, err
+	}
+	urls := make([]string, 0, len(addrs))
+	for _, a := range addrs { // (already sorted by priority and randomized by weight)
+		urls = append(urls, _discURL(config, strings.TrimSuffix(a.Target, "."), int(a.Port)))
+	}
+	return urls, nil
+}
+
+// Kubernetes: "k8s:<service>[:<port-name>]" - ready endpoints of the service
+// in the current namespace; absent port name, the first port
+func discoverK8s(_ context.Context, spec string, config *cmn.Config) ([]string, error) {
+	client, err := k8s.GetClient()
+	if err != nil {
+		return nil, errors.Join(k8s.ErrK8sRequired, err)
+	}
+	svc, portName, _ := strings.Cut(spec, ":")
+	eps, err := client.Endpoints(svc)
+	if err != nil {
+		return nil, err
+	}
+	var urls []string
+	for i := range eps.Subsets {
+		sub := &eps.Subsets[i]
+		port := -1
+		for j := range sub.Ports {
+			if portName == "" || sub.Ports[j].Name == portName {
+				port = int(sub.Ports[j].Port)
+				break
+			}
+		}
+		if port < 0 {
+			continue
+		}
+		for j := range sub.Addresses {
+			urls = append(urls, _discURL(config, sub.Addresses[j].IP, port))
+		}
+	}
+	return urls, nil
+}
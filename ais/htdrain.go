@@ -0,0 +1,93 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Graceful shutdown (apc.ActShutdownCluster, apc.ActShutdownNode) - prior to stopping:
+// 1. stop accepting new client requests (intra-cluster requests are still served);
+// 2. wait for in-flight client requests (including PUTs being committed) to complete;
+// 3. wait for running xactions to finish or - in re: on-demand xactions with work queues
+//    (mirroring, EC encoding, etc.) - to become idle;
+// all of the above bounded by the drain timeout (apc.ActValShutdown), after which
+// the node proceeds to abort remaining xactions and shut down (as before).
+
+const drainPoll = 100 * time.Millisecond
+
+var errDraining = errors.New("shutting down")
+
+type drainer struct {
+	inflight atomic.Int64 // client (ie., not intra-cluster) requests
+	draining atomic.Bool
+}
+
+func isIntraReq(hdr http.Header) bool { return hdr.Get(apc.HdrCallerID) != "" || isT2TPut(hdr) }
+
+func (d *drainer) enter() bool {
+	d.inflight.Inc()
+	if d.draining.Load() {
+		d.inflight.Dec()
+		return false
+	}
+	return true
+}
+
+func (d *drainer) exit() { d.inflight.Dec() }
+
+// returns 0 (zero) when draining is not requested
+func drainTimeout(msg *apc.ActMsg) (time.Duration, error) {
+	timeout := cmn.GCO.Get().Timeout.MaxHostBusy.D()
+	if msg.Action != apc.ActShutdownCluster || msg.Value == nil {
+		return timeout, nil
+	}
+	var opts apc.ActValShutdown
+	if err := cos.MorphMarshal(msg.Value, &opts); err != nil {
+		return 0, err
+	}
+	switch {
+	case opts.DrainTimeout < 0:
+		return 0, nil
+	case opts.DrainTimeout > 0:
+		return opts.DrainTimeout.D(), nil
+	}
+	return timeout, nil
+}
+
+func (h *htrun) drain(timeout time.Duration) {
+	if timeout <= 0 {
+		return
+	}
+	started := mono.NanoTime()
+	g.drain.draining.Store(true)
+	nlog.Infoln(h.String(), "draining: not accepting new requests; timeout", timeout)
+
+	for n := g.drain.inflight.Load(); n > 0; n = g.drain.inflight.Load() {
+		if mono.Since(started) > timeout {
+			nlog.Warningln(h.String(), "draining: timed out waiting for", n, "in-flight request(s)")
+			return
+		}
+		time.Sleep(drainPoll)
+	}
+	for xctn := xreg.GetBusy(); xctn != nil; xctn = xreg.GetBusy() {
+		if mono.Since(started) > timeout {
+			nlog.Warningln(h.String(), "draining: timed out waiting for", xctn.Name())
+			return
+		}
+		time.Sleep(drainPoll)
+	}
+	nlog.Infoln(h.String(), "drained in", mono.Since(started))
+}
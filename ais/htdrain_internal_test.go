@@ -0,0 +1,37 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+)
+
+func TestDrainer(t *testing.T) {
+	var d drainer
+	if !d.enter() || !d.enter() {
+		t.Fatal("expecting requests to be admitted")
+	}
+	d.exit()
+	d.draining.Store(true)
+	if d.enter() {
+		t.Fatal("expecting new requests to be rejected when draining")
+	}
+	if n := d.inflight.Load(); n != 1 {
+		t.Fatalf("expecting 1 in-flight request, got %d", n)
+	}
+	d.exit()
+
+	hdr := http.Header{}
+	if isIntraReq(hdr) {
+		t.Fatal("client request misidentified as intra-cluster")
+	}
+	hdr.Set(apc.HdrCallerID, "p[abc]")
+	if !isIntraReq(hdr) {
+		t.Fatal("intra-cluster request misidentified as client")
+	}
+}
@@ -0,0 +1,73 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// PUT payload restrictions (cmn.PayloadConf):
+// - proxy: fail early based on the request's Content-Length and Content-Type
+// - target: same, plus cut off the stream upon exceeding the max size
+//   (Content-Length may be missing, e.g. when chunked, or incorrect)
+// Object naming rules (cmn.NamingConf): proxy only - PUT (including S3 copy and
+// multipart) and rename
+
+type payloadReader struct {
+	r     io.ReadCloser
+	what  string
+	limit int64
+	n     int64
+}
+
+func checkPayload(r *http.Request, bck *meta.Bck, objName string) (int, error) {
+	conf := &bck.Props.Payload
+	if conf.MaxObjSize == 0 && conf.ContentTypes == "" {
+		return 0, nil
+	}
+	what := bck.Cname(objName)
+	if err := conf.CheckContentType(what, r.Header.Get(cos.HdrContentType)); err != nil {
+		return http.StatusUnsupportedMediaType, err
+	}
+	if r.ContentLength > 0 {
+		if err := conf.CheckSize(what, r.ContentLength); err != nil {
+			return http.StatusRequestEntityTooLarge, err
+		}
+	}
+	return 0, nil
+}
+
+// returns non-nil error if the (destination) object name violates the bucket's naming rules
+func checkNaming(bck *meta.Bck, objName string) error {
+	conf := &bck.Props.Naming
+	if conf.MaxLength == 0 && conf.Charset == "" && conf.Prefix == "" {
+		return nil
+	}
+	return conf.Check(bck.Cname(objName), objName)
+}
+
+// wrap request body (see checkPayload)
+func newPayloadReader(r io.ReadCloser, conf *cmn.PayloadConf, what string) io.ReadCloser {
+	if conf.MaxObjSize == 0 {
+		return r
+	}
+	return &payloadReader{r: r, what: what, limit: int64(conf.MaxObjSize)}
+}
+
+func (pr *payloadReader) Read(b []byte) (n int, err error) {
+	n, err = pr.r.Read(b)
+	pr.n += int64(n)
+	if pr.n > pr.limit {
+		return n, cmn.NewErrTooLarge(pr.what, -1, pr.limit)
+	}
+	return n, err
+}
+
+func (pr *payloadReader) Close() error { return pr.r.Close() }
@@ -0,0 +1,210 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"runtime"
+	"runtime/pprof"
+	"strconv"
+	"strings"
+	"sync"
+	ratomic "sync/atomic"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+)
+
+// pprof profiles (apc.WhatProfile), admin access:
+// - GET /v1/daemon?what=profile&type=cpu|heap|mutex&seconds=N - a given node
+//   (targets require intra-cluster caller - i.e., the request must come via proxy: reverse or broadcast)
+// - GET /v1/cluster?what=profile&type=...&seconds=N - all nodes at the same time: the proxy broadcasts
+//   the request while capturing its own profile, and returns TAR bundle of "<node-ID>.<type>.pprof" files
+//   (and "errors.txt" listing nodes that failed, if any)
+// - one cpu (mutex) profile at a time per node (409 otherwise)
+
+const (
+	profMutexFraction = 5 // runtime.SetMutexProfileFraction while profiling
+	profErrsName      = "errors.txt"
+)
+
+var (
+	profCPU, profMutex ratomic.Bool // in progress
+
+	errProfBusy = errors.New("profiling already in progress")
+)
+
+func profArgs(query url.Values) (ty string, d time.Duration, err error) {
+	ty = query.Get(apc.QparamProfType)
+	switch ty {
+	case "":
+		ty = apc.ProfCPU
+	case apc.ProfCPU, apc.ProfHeap, apc.ProfMutex:
+	default:
+		return "", 0, fmt.Errorf("invalid %s=%q (expecting %q, %q, or %q)", apc.QparamProfType, ty,
+			apc.ProfCPU, apc.ProfHeap, apc.ProfMutex)
+	}
+	secs := apc.ProfDfltSeconds
+	if s := query.Get(apc.QparamProfSeconds); s != "" {
+		if secs, err = strconv.Atoi(s); err != nil || secs < 1 || secs > apc.ProfMaxSeconds {
+			return "", 0, fmt.Errorf("invalid %s=%q (expecting range [1, %d])", apc.QparamProfSeconds, s, apc.ProfMaxSeconds)
+		}
+	}
+	return ty, time.Duration(secs) * time.Second, nil
+}
+
+// returns error only when failing to start (nothing written)
+func profCapture(ctx context.Context, w *profWriter, ty string, d time.Duration) error {
+	switch ty {
+	case apc.ProfCPU:
+		if !profCPU.CompareAndSwap(false, true) {
+			return errProfBusy
+		}
+		defer profCPU.Store(false)
+		if err := pprof.StartCPUProfile(w); err != nil { // (e.g., cmd/aisnodeprofile)
+			return err
+		}
+		profSleep(ctx, d)
+		pprof.StopCPUProfile()
+	case apc.ProfMutex:
+		if !profMutex.CompareAndSwap(false, true) {
+			return errProfBusy
+		}
+		defer profMutex.Store(false)
+		prev := runtime.SetMutexProfileFraction(profMutexFraction)
+		profSleep(ctx, d)
+		w.err = pprof.Lookup("mutex").WriteTo(w, 0)
+		runtime.SetMutexProfileFraction(prev)
+	default:
+		runtime.GC() // up-to-date statistics
+		w.err = pprof.Lookup("heap").WriteTo(w, 0)
+	}
+	return nil
+}
+
+func profSleep(ctx context.Context, d time.Duration) {
+	timer := time.NewTimer(d)
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+		timer.Stop()
+	}
+}
+
+// (to tell partially written profile)
+type profWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (pw *profWriter) Write(b []byte) (int, error) {
+	n, err := pw.w.Write(b)
+	pw.n += int64(n)
+	if err != nil && pw.err == nil {
+		pw.err = err
+	}
+	return n, err
+}
+
+// GET /v1/daemon?what=profile
+func (h *htrun) sendProfile(w http.ResponseWriter, r *http.Request, query url.Values) {
+	ty, d, err := profArgs(query)
+	if err != nil {
+		h.writeErr(w, r, err)
+		return
+	}
+	w.Header().Set(cos.HdrContentType, cos.ContentBinary)
+	pw := &profWriter{w: w}
+	if err := profCapture(r.Context(), pw, ty, d); err != nil {
+		ecode := http.StatusInternalServerError
+		if err == errProfBusy {
+			ecode = http.StatusConflict
+		}
+		h.writeErr(w, r, fmt.Errorf("%s: %s profile: %w", h, ty, err), ecode)
+		return
+	}
+	if pw.err != nil {
+		nlog.Errorln(h.String()+": failed to send", ty, "profile:", pw.err, "[ written", pw.n, "]")
+	}
+}
+
+// GET /v1/cluster?what=profile
+func (p *proxy) qcluProfile(w http.ResponseWriter, r *http.Request, query url.Values) {
+	ty, d, err := profArgs(query)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	// self, simultaneously
+	var (
+		local = &profWriter{w: &bytes.Buffer{}}
+		lerr  error
+		wg    sync.WaitGroup
+	)
+	wg.Add(1)
+	go func() {
+		lerr = profCapture(r.Context(), local, ty, d)
+		wg.Done()
+	}()
+
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: apc.URLPathDae.S, Query: query}
+	args.rid = r.Header.Get(apc.HdrReqID)
+	args.to = core.AllNodes
+	args.timeout = d + cmn.Rom.MaxKeepalive()
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	wg.Wait()
+
+	var (
+		errs strings.Builder
+		name = "." + ty + ".pprof"
+	)
+	if lerr == nil {
+		lerr = local.err
+	}
+	w.Header().Set(cos.HdrContentType, cos.ContentTar)
+	aw := archive.NewWriter(archive.ExtTar, w, nil /*checksum*/, nil /*opts*/)
+	if lerr == nil {
+		b := local.w.(*bytes.Buffer)
+		err = aw.Write(p.SID()+name, cos.SimpleOAH{Size: int64(b.Len()), Atime: time.Now().UnixNano()}, b)
+	} else {
+		fmt.Fprintf(&errs, "%s: %v\n", p.si.StringEx(), lerr)
+	}
+	for _, res := range results {
+		if err != nil {
+			break // (failed to write response)
+		}
+		if res.err != nil {
+			fmt.Fprintf(&errs, "%s: %v\n", res.si.StringEx(), res.err)
+			continue
+		}
+		oah := cos.SimpleOAH{Size: int64(len(res.bytes)), Atime: time.Now().UnixNano()}
+		err = aw.Write(res.si.ID()+name, oah, bytes.NewReader(res.bytes))
+	}
+	freeBcastRes(results)
+	if err == nil && errs.Len() > 0 {
+		err = aw.Write(profErrsName, cos.SimpleOAH{Size: int64(errs.Len())}, strings.NewReader(errs.String()))
+	}
+	aw.Fini()
+	if err != nil {
+		nlog.Errorln(p.String()+": failed to send", ty, "profile bundle:", err)
+	}
+	if errs.Len() > 0 {
+		nlog.Warningln(p.String()+":", ty, "profile bundle - failed nodes:\n"+errs.String())
+	}
+}
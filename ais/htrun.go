@@ -17,6 +17,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -29,6 +30,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/feat"
 	"github.com/NVIDIA/aistore/cmn/jsp"
 	"github.com/NVIDIA/aistore/cmn/k8s"
 	"github.com/NVIDIA/aistore/cmn/mono"
@@ -37,6 +39,7 @@ import (
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/xact"
 	"github.com/NVIDIA/aistore/xact/xreg"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -61,6 +64,7 @@ const dfltDetail = "[control-plane]"
 // extra or extended state - currently, target only
 type htext interface {
 	interruptedRestarted() (bool, bool)
+	dataLoss() bool
 }
 
 type htrun struct {
@@ -78,8 +82,10 @@ type htrun struct {
 		cluster atomic.Int64 // mono.NanoTime() since cluster startup, zero prior to that
 		node    atomic.Int64 // ditto - for the node
 	}
-	gmm *memsys.MMSA // system pagesize-based memory manager and slab allocator
-	smm *memsys.MMSA // system MMSA for small-size allocations
+	vote  electionTerm // persistent on proxies
+	hedge hedger       // latencies to hedge idempotent broadcasts (see bcastArgs.hedge)
+	gmm   *memsys.MMSA // system pagesize-based memory manager and slab allocator
+	smm   *memsys.MMSA // system MMSA for small-size allocations
 }
 
 ///////////
@@ -166,6 +172,9 @@ func (h *htrun) cluMeta(opts cmetaFillOpt) (*cluMeta, error) {
 		if restarted {
 			cm.Flags = cm.Flags.Set(cos.Restarted)
 		}
+		if opts.htext.dataLoss() {
+			cm.Flags = cm.Flags.Set(cos.DataLoss)
+		}
 	}
 	if !opts.skipPrimeTime && smap.IsPrimary(h.si) {
 		cm.PrimeTime = time.Now().UnixNano()
@@ -268,17 +277,20 @@ func (h *htrun) init(config *cmn.Config) {
 		tcpbuf = cmn.DefaultSendRecvBufferSize // ditto: targets use AIS default when not configured
 	}
 
-	muxers := newMuxers()
-	g.netServ.pub = &netServer{muxers: muxers, sndRcvBufSize: tcpbuf}
+	var (
+		muxers  = newMuxers()
+		qosData = config.Net.QoS.Class(cmn.QoSData)
+	)
+	g.netServ.pub = &netServer{muxers: muxers, sndRcvBufSize: tcpbuf, qos: qosData}
 	g.netServ.control = g.netServ.pub // if not separately configured, intra-control net is public
 	if config.HostNet.UseIntraControl {
 		muxers = newMuxers()
-		g.netServ.control = &netServer{muxers: muxers, sndRcvBufSize: 0}
+		g.netServ.control = &netServer{muxers: muxers, sndRcvBufSize: 0, qos: config.Net.QoS.Class(cmn.QoSControl)}
 	}
 	g.netServ.data = g.netServ.control // if not configured, intra-data net is intra-control
 	if config.HostNet.UseIntraData {
 		muxers = newMuxers()
-		g.netServ.data = &netServer{muxers: muxers, sndRcvBufSize: tcpbuf}
+		g.netServ.data = &netServer{muxers: muxers, sndRcvBufSize: tcpbuf, qos: qosData}
 	}
 
 	h.owner.smap = newSmapOwner(config)
@@ -398,6 +410,10 @@ func (h *htrun) initSnode(config *cmn.Config) {
 		copy(h.si.PubExtra, pubExtra)
 		nlog.Infof("%s (multihome) access: %v and %v", cmn.NetPublic, pubAddr, h.si.PubExtra)
 	}
+	if fd := os.Getenv(env.AIS.FailureDomain); fd != "" {
+		h.si.FailDom = fd
+		nlog.Infoln("failure domain:", fd)
+	}
 }
 
 func mustDiffer(ip1 meta.NetInfo, port1 int, use1 bool, ip2 meta.NetInfo, port2 int, use2 bool, tag string) {
@@ -487,24 +503,29 @@ func (h *htrun) setDaemonConfigQuery(w http.ResponseWriter, r *http.Request) {
 
 func (h *htrun) run(config *cmn.Config) error {
 	var (
-		tlsConf *tls.Config
-		logger  = log.New(&nlogWriter{}, "net/http err: ", 0) // a wrapper to log http.Server errors
+		tlsConf, intraTLS *tls.Config
+		logger            = log.New(&nlogWriter{}, "net/http err: ", 0) // a wrapper to log http.Server errors
 	)
 	if config.Net.HTTP.UseHTTPS {
 		c, err := newTLS(&config.Net.HTTP)
 		if err != nil {
 			cos.ExitLog(err)
 		}
-		tlsConf = c
+		if err := g.certs.init(&config.Net.HTTP); err != nil {
+			cos.ExitLog(err)
+		}
+		tlsConf, intraTLS = c, c.Clone()
+		tlsConf.GetCertificate = g.certs.getPub
+		intraTLS.GetCertificate = g.certs.getIntra
 	}
 	if config.HostNet.UseIntraControl {
 		go func() {
-			_ = g.netServ.control.listen(h.si.ControlNet.TCPEndpoint(), logger, tlsConf, config)
+			_ = g.netServ.control.listen(h.si.ControlNet.TCPEndpoint(), logger, intraTLS, config)
 		}()
 	}
 	if config.HostNet.UseIntraData {
 		go func() {
-			_ = g.netServ.data.listen(h.si.DataNet.TCPEndpoint(), logger, tlsConf, config)
+			_ = g.netServ.data.listen(h.si.DataNet.TCPEndpoint(), logger, intraTLS, config)
 		}()
 	}
 
@@ -514,7 +535,7 @@ func (h *htrun) run(config *cmn.Config) error {
 	} else if len(h.si.PubExtra) > 0 {
 		for _, pubExtra := range h.si.PubExtra {
 			debug.Assert(pubExtra.Port == h.si.PubNet.Port, "expecting the same TCP port for all multi-home interfaces")
-			server := &netServer{muxers: g.netServ.pub.muxers, sndRcvBufSize: g.netServ.pub.sndRcvBufSize}
+			server := &netServer{muxers: g.netServ.pub.muxers, sndRcvBufSize: g.netServ.pub.sndRcvBufSize, qos: g.netServ.pub.qos}
 			go func() {
 				_ = server.listen(pubExtra.TCPEndpoint(), logger, tlsConf, config)
 			}()
@@ -579,6 +600,7 @@ func (h *htrun) _call(si *meta.Snode, bargs *bcastArgs, results *bcastResults) {
 	{
 		cargs.si = si
 		cargs.req = bargs.req
+		cargs.rid = bargs.rid
 		cargs.timeout = bargs.timeout
 	}
 	cargs.req.Base = si.URL(bargs.network)
@@ -586,10 +608,18 @@ func (h *htrun) _call(si *meta.Snode, bargs *bcastArgs, results *bcastResults) {
 		cargs.req.BodyR, _ = bargs.req.BodyR.(cos.ReadOpenCloser).Open()
 	}
 	cargs.cresv = bargs.cresv
-	res := h.call(cargs, bargs.smap)
-	if bargs.async {
-		freeCR(res) // discard right away
+	var res *callResult
+	if bargs.hedge {
+		res = h.callHedged(cargs, bargs.smap)
 	} else {
+		res = h.call(cargs, bargs.smap)
+	}
+	switch {
+	case bargs.async:
+		freeCR(res) // discard right away
+	case bargs.resCh != nil:
+		bargs.resCh <- res
+	default:
 		results.mu.Lock()
 		results.s = append(results.s, res)
 		results.mu.Unlock()
@@ -661,6 +691,9 @@ func (h *htrun) call(args *callArgs, smap *smapX) (res *callResult) {
 
 	req.Header.Set(apc.HdrCallerID, h.SID())
 	req.Header.Set(apc.HdrCallerName, h.si.Name())
+	if args.rid != "" {
+		req.Header.Set(apc.HdrReqID, args.rid)
+	}
 	if smap.vstr != "" {
 		if smap.IsPrimary(h.si) {
 			req.Header.Set(apc.HdrCallerIsPrimary, "true")
@@ -815,6 +848,21 @@ func (h *htrun) bcastGroup(args *bcastArgs) sliceResults {
 	return h.bcastNodes(args)
 }
 
+// same as bcastGroup except that it does not accumulate results - instead, it calls back
+// with each result as soon as the latter arrives (in no particular order);
+// the callback is executed serially and takes ownership of the result (must `freeCR`)
+func (h *htrun) bcastStream(args *bcastArgs, cb func(*callResult)) {
+	ch := make(chan *callResult, cmn.MaxParallelism())
+	args.resCh = ch
+	go func() {
+		h.bcastGroup(args)
+		close(ch)
+	}()
+	for res := range ch {
+		cb(res)
+	}
+}
+
 // broadcast to the specified destinations (`bargs.nodes`)
 // (if specified, `bargs.req.BodyR` must implement `cos.ReadOpenCloser`)
 func (h *htrun) bcastNodes(bargs *bcastArgs) sliceResults {
@@ -824,7 +872,7 @@ func (h *htrun) bcastNodes(bargs *bcastArgs) sliceResults {
 		f       = func(si *meta.Snode) { h._call(si, bargs, &results); wg.Done() }
 	)
 	debug.Assert(len(bargs.selected) == 0)
-	if !bargs.async {
+	if !bargs.async && bargs.resCh == nil {
 		results.s = allocBcastRes(len(bargs.nodes))
 	}
 	for _, nodeMap := range bargs.nodes {
@@ -858,7 +906,7 @@ func (h *htrun) bcastSelected(bargs *bcastArgs) sliceResults {
 		f       = func(si *meta.Snode) { h._call(si, bargs, &results); wg.Done() }
 	)
 	debug.Assert(len(bargs.selected) > 0)
-	if !bargs.async {
+	if !bargs.async && bargs.resCh == nil {
 		results.s = allocBcastRes(len(bargs.selected))
 	}
 	for _, si := range bargs.selected {
@@ -903,6 +951,7 @@ func (h *htrun) bcastAsyncIC(msg *aisMsg) {
 
 func (h *htrun) bcastAllNodes(w http.ResponseWriter, r *http.Request, args *bcastArgs) {
 	args.to = core.AllNodes
+	args.rid = r.Header.Get(apc.HdrReqID)
 	results := h.bcastGroup(args)
 	for _, res := range results {
 		if res.err != nil {
@@ -992,6 +1041,48 @@ func isBrowser(userAgent string) bool {
 	return strings.HasPrefix(userAgent, "Mozilla/5.0")
 }
 
+// JSON lines (cos.ContentNDJSON) - client's choice via "Accept" header
+func wantsJlines(r *http.Request) bool {
+	return strings.Contains(r.Header.Get(cos.HdrAccept), cos.ContentNDJSON)
+}
+
+// one JSON value per line, each line flushed to the client upon write;
+// the first write commits the response (status 200) - subsequent errors must be reported in-band
+type jlines struct {
+	w   http.ResponseWriter
+	j   *jsoniter.Stream
+	err error
+	cnt int
+}
+
+func newJlines(w http.ResponseWriter) *jlines {
+	return &jlines{w: w, j: cos.JSON.BorrowStream(w)}
+}
+
+func (jl *jlines) write(v any) {
+	if jl.err != nil {
+		return
+	}
+	if jl.cnt == 0 {
+		jl.w.Header().Set(cos.HdrContentType, cos.ContentNDJSON)
+	}
+	jl.cnt++
+	jl.j.WriteVal(v)
+	jl.j.WriteRaw("\n")
+	if jl.err = jl.j.Flush(); jl.err == nil {
+		if err := http.NewResponseController(jl.w).Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			jl.err = err
+		}
+	}
+}
+
+func (jl *jlines) fini(h *htrun, tag string) {
+	if jl.err != nil {
+		h.logerr(tag, nil, jl.err)
+	}
+	cos.JSON.ReturnStream(jl.j)
+}
+
 func (h *htrun) logerr(tag string, v any, err error) {
 	const maxl = 48
 	var efmt, msg string
@@ -1027,16 +1118,23 @@ func (h *htrun) logerr(tag string, v any, err error) {
 	h.statsT.IncErr(stats.ErrHTTPWriteCount)
 }
 
-func _parseNCopies(value any) (copies int64, err error) {
+// make-n-copies: apc.MNCMsg or (legacy) number of copies
+func _parseMNC(value any) (mnc *apc.MNCMsg, err error) {
+	mnc = &apc.MNCMsg{}
 	switch v := value.(type) {
 	case string:
-		copies, err = strconv.ParseInt(v, 10, 16)
+		mnc.Copies, err = strconv.ParseInt(v, 10, 16)
 	case float64:
-		copies = int64(v)
+		mnc.Copies = int64(v)
+	case map[string]any:
+		err = cos.MorphMarshal(v, mnc)
 	default:
 		err = fmt.Errorf("failed to parse 'copies' (%v, %T) - unexpected type", value, value)
 	}
-	return
+	if err == nil {
+		err = mnc.Validate()
+	}
+	return mnc, err
 }
 
 func _checkAction(msg *apc.ActMsg, expectedActions ...string) (err error) {
@@ -1074,13 +1172,19 @@ func (h *htrun) httpdaeget(w http.ResponseWriter, r *http.Request, query url.Val
 	case apc.WhatBMD:
 		body = h.owner.bmd.get()
 	case apc.WhatSmapVote:
-		var err error
-		body, err = h.cluMeta(cmetaFillOpt{htext: htext, skipPrimeTime: true})
+		cm, err := h.cluMeta(cmetaFillOpt{htext: htext, skipPrimeTime: true})
 		if err != nil {
 			nlog.Errorf("failed to fetch cluster config, err: %v", err)
+		} else {
+			cm.Vote = h.vote.get()
 		}
+		body = cm
 	case apc.WhatSnode:
 		body = h.si
+	case apc.WhatCapabilities:
+		body = capabilities(cmn.GCO.Get())
+	case apc.WhatCertInfo:
+		body = g.certs.info()
 	case apc.WhatLog:
 		if cos.IsParseBool(query.Get(apc.QparamAllLogs)) {
 			tempdir := h.sendAllLogs(w, r, query)
@@ -1092,8 +1196,16 @@ func (h *htrun) httpdaeget(w http.ResponseWriter, r *http.Request, query url.Val
 			h.sendOneLog(w, r, query)
 		}
 		return
+	case apc.WhatProfile:
+		h.sendProfile(w, r, query)
+		return
 	case apc.WhatNodeStats:
-		statsNode := h.statsT.GetStats()
+		flt, err := statsFlt(query)
+		if err != nil {
+			h.writeErr(w, r, err)
+			return
+		}
+		statsNode := h.statsT.GetStatsFlt(flt)
 		statsNode.Snode = h.si
 		body = statsNode
 	case apc.WhatNodeStatsV322:
@@ -1114,6 +1226,38 @@ func (h *htrun) httpdaeget(w http.ResponseWriter, r *http.Request, query url.Val
 	h.writeJSON(w, r, body, "httpdaeget-"+what)
 }
 
+func capabilities(config *cmn.Config) *apc.Capabilities {
+	c := &apc.Capabilities{
+		Software:    cmn.VersionAIStore,
+		API:         apc.APIRange{Version: apc.Version, Min: apc.APIRevMin, Max: apc.APIRevMax},
+		Actions:     apc.Actions[:],
+		Xactions:    make([]string, 0, len(xact.Table)),
+		Providers:   []string{apc.AIS, apc.HTTP},
+		ArchFormats: archive.FileExtensions[:],
+		Checksums:   cos.SupportedChecksums(),
+		Features:    config.Features.Names(),
+	}
+	for kind := range xact.Table {
+		c.Xactions = append(c.Xactions, kind)
+	}
+	sort.Strings(c.Xactions)
+	for provider := range config.Backend.Conf {
+		if provider != "" && provider != apc.AIS {
+			c.Providers = append(c.Providers, provider)
+		}
+	}
+	sort.Strings(c.Providers)
+	if config.Auth.Enabled {
+		c.Auth = []string{apc.AuthToken}
+	} else {
+		c.Auth = []string{apc.AuthNone}
+	}
+	if config.Features.IsSet(feat.S3PresignedRequest) {
+		c.Auth = append(c.Auth, apc.AuthS3Presigned)
+	}
+	return c
+}
+
 func (h *htrun) statsAndStatus() (ds *stats.NodeStatus) {
 	smap := h.owner.smap.get()
 	ds = &stats.NodeStatus{
@@ -1784,7 +1928,9 @@ func (h *htrun) extractRevokedTokenList(payload msPayload, caller string) (*toke
 //   - config.Proxy.PrimaryURL   ("primary_url")
 //   - config.Proxy.DiscoveryURL ("discovery_url")
 //   - config.Proxy.OriginalURL  ("original_url")
-//   - if these fails we try the candidates provided by the caller.
+//   - if these fails we try the candidates provided by the caller
+//   - and finally, the proxies found via config.Proxy.Discovery ("discovery"), if configured
+//     (DNS SRV, Kubernetes endpoints, etc. - see htdisc.go), re-discovered upon each retry.
 //
 // ================================== Background =========================================
 func (h *htrun) join(query url.Values, htext htext, contactURLs ...string) (res *callResult, err error) {
@@ -1815,6 +1961,9 @@ func (h *htrun) join(query url.Values, htext htext, contactURLs ...string) (res
 
 	sleep := max(2*time.Second, cmn.Rom.MaxKeepalive())
 	for range 4 { // retry
+		if config.Proxy.Discovery != "" {
+			candidates = h.discoverCans(config, candidates, selfPublicURL.Host, selfIntraURL.Host)
+		}
 		for _, candidateURL := range candidates {
 			if nlog.Stopping() {
 				return res, errors.New(h.String() + " is stopping")
@@ -1862,6 +2011,21 @@ func (h *htrun) join(query url.Values, htext htext, contactURLs ...string) (res
 	return
 }
 
+func (h *htrun) discoverCans(config *cmn.Config, candidates []string, selfPub, selfCtrl string) []string {
+	urls, err := discoverProxies(config)
+	if err != nil {
+		nlog.Warningln(h.String()+":", err)
+		return candidates
+	}
+	if len(urls) > 0 && cmn.Rom.FastV(4, cos.SmoduleAIS) {
+		nlog.Infoln(h.String()+": discovered", urls)
+	}
+	for _, u := range urls {
+		candidates = _addCan(u, selfPub, selfCtrl, candidates)
+	}
+	return candidates
+}
+
 func _addCan(url, selfPub, selfCtrl string, candidates []string) []string {
 	if u, valid := cos.ParseURL(url); !valid || u.Host == selfPub || u.Host == selfCtrl {
 		return candidates
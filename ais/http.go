@@ -18,10 +18,14 @@ type global struct {
 		control  *netServer
 		data     *netServer
 	}
+	drain  drainer    // graceful shutdown
+	certs  certKeeper // HTTPS: server certificates
 	client struct {
 		control *http.Client // http client for intra-cluster comm
 		data    *http.Client // http client to execute target <=> target GET & PUT (object)
 	}
+	genReqID bool // proxy: assign apc.HdrReqID to client requests
+	xlatAPI  bool // proxy: negotiate apc.HdrAPIRev and translate older requests
 }
 
 var g global
@@ -62,6 +66,7 @@ func initCtrlClient(config *cmn.Config) {
 		Timeout:         config.Client.Timeout.D(),
 		WriteBufferSize: defaultControlWriteBufferSize,
 		ReadBufferSize:  defaultControlReadBufferSize,
+		QoS:             config.Net.QoS.Class(cmn.QoSControl),
 	}
 	if config.Net.HTTP.UseHTTPS {
 		g.client.control = cmn.NewIntraClientTLS(cargs, config)
@@ -83,6 +88,7 @@ func initDataClient(config *cmn.Config) {
 		Timeout:         config.Client.TimeoutLong.D(),
 		WriteBufferSize: wbuf,
 		ReadBufferSize:  rbuf,
+		QoS:             config.Net.QoS.Class(cmn.QoSData),
 	}
 	if config.Net.HTTP.UseHTTPS {
 		g.client.data = cmn.NewIntraClientTLS(cargs, config)
@@ -335,6 +335,13 @@ func (ic *ic) handlePost(w http.ResponseWriter, r *http.Request) {
 			ic.p.writeErr(w, r, err)
 			return
 		}
+	case apc.ActInvalListCache:
+		bck := &cmn.Bck{}
+		if err := cos.MorphMarshal(msg.Value, bck); err != nil {
+			ic.p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, ic.p.si, msg.Action, msg.Value, err)
+			return
+		}
+		ic.p.qm.c.invalidate(bck)
 	case apc.ActRegGlobalXaction:
 		var (
 			regMsg     = &xactRegMsg{}
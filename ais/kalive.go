@@ -6,6 +6,7 @@ package ais
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 	ratomic "sync/atomic"
 	"time"
@@ -30,6 +31,13 @@ const (
 	kaNumRetries = 3
 )
 
+// adaptive keepalive: per-node probing interval varies within [interval/2, interval*2]
+// depending on the node's recent history
+const (
+	kaFlapWindow  = 8  // number of intervals since the last flap (failure, then recovery) for a node to be considered flapping
+	kaStableAfter = 16 // number of failure-free intervals for a node to be considered stable
+)
+
 const (
 	waitSelfJoin = 300 * time.Millisecond
 	waitStandby  = 5 * time.Second
@@ -45,6 +53,7 @@ type (
 		paused() bool
 		cfg(config *cmn.Config) *cmn.KeepaliveTrackerConf
 		cluUptime(int64) time.Duration
+		gsp() *gossip
 	}
 	talive struct {
 		t *target
@@ -59,6 +68,7 @@ type (
 	keepalive struct {
 		k            keepaliver
 		hb           hbTracker
+		gossip       *gossip // optional (see config.Keepalive.Gossip)
 		statsT       stats.Tracker
 		controlCh    chan controlSignal
 		startedUp    *atomic.Bool
@@ -76,12 +86,24 @@ type (
 		HeardFrom(id string, now int64) // callback for 'id' to respond
 		TimedOut(id string) bool        // true if 'id` didn't keepalive or called (via "heard") within the interval (above)
 
+		flapped(id string, now int64) // 'id' failed to respond (keepalive or health) and then recovered
+		flapping(now int64) bool      // true if any node has recently flapped
 		reg(id string)
 		set(interval time.Duration) bool
+
+		// gossip
+		heardAbout(id string, at int64) // indirectly, via peer's digest (see gossip.merge)
+		lastHeard(id string) int64      // mono-time, or zero if never (or expired)
+		expire(id string)               // suspected: make 'id' time out right away
 	}
 	heartBeat struct {
-		last     sync.Map
-		interval time.Duration // timeout
+		last     sync.Map      // id => *hbEntry
+		interval time.Duration // timeout (configured)
+	}
+	hbEntry struct {
+		last  int64 // last heard from (mono-time)
+		since int64 // first seen (ditto)
+		flap  int64 // last failure, or zero if never failed (ditto)
 	}
 )
 
@@ -111,6 +133,7 @@ func newTalive(t *target, statsT stats.Tracker, startedUp *atomic.Bool) *talive
 	tkr.hb = newHB(config.Keepalive.Target.Interval.D())
 	tkr.controlCh = make(chan controlSignal) // unbuffered on purpose
 	tkr.interval = config.Keepalive.Target.Interval.D()
+	tkr.gossip = newGossip(&t.htrun, &tkr.keepalive)
 	return tkr
 }
 
@@ -174,6 +197,7 @@ func newPalive(p *proxy, statsT stats.Tracker, startedUp *atomic.Bool) *palive {
 	pkr.hb = newHB(config.Keepalive.Proxy.Interval.D())
 	pkr.controlCh = make(chan controlSignal) // unbuffered on purpose
 	pkr.interval = config.Keepalive.Proxy.Interval.D()
+	pkr.gossip = newGossip(&p.htrun, &pkr.keepalive)
 	return pkr
 }
 
@@ -211,6 +235,9 @@ func (pkr *palive) do(config *cmn.Config) (stopped bool) {
 		return
 	}
 	if smap.isPrimary(pkr.p.si) {
+		if config.Keepalive.Overload.Enabled {
+			pkr.p.sampleLoad(config)
+		}
 		if !pkr.inProgress.CAS(false, true) {
 			nlog.Infoln(pkr.p.String() + ": primary keepalive in progress")
 			return
@@ -219,6 +246,9 @@ func (pkr *palive) do(config *cmn.Config) (stopped bool) {
 		pkr.inProgress.Store(false)
 		return
 	}
+	if config.Keepalive.Overload.Enabled {
+		pkr.p.checkPrimaryLoad(smap, config)
+	}
 	if !pkr.timeToPing(smap.Primary.ID()) { // skip sending keepalive
 		return
 	}
@@ -298,6 +328,8 @@ func (pkr *palive) ping(si *meta.Snode, wg cos.WG, smap *smapX, config *cmn.Conf
 	}
 	if !ok {
 		pkr.toRemoveCh <- si.ID()
+	} else if !stopped {
+		pkr.hb.flapped(si.ID(), 0 /*now*/) // failed and then recovered
 	}
 	wg.Done()
 }
@@ -376,10 +408,36 @@ loop:
 	if cnt == 0 {
 		return fmt.Errorf("%s: nothing to do [%s, %s]", pkr.p.si, ctx.smap.StringEx(), metaction)
 	}
+	if fds := pkr.downDomains(ctx.smap, clone); len(fds) > 0 {
+		nlog.Errorln(pkr.p.String()+":", "failure domain(s) down:", fds)
+		metaction += " failure-domain(s) down: " + strings.Join(fds, ", ")
+	}
 	ctx.msg = &apc.ActMsg{Value: metaction}
 	return nil
 }
 
+// failure domains (with at least 2 nodes) that were fully present in the `prev` Smap
+// and are now completely gone - to be reported as a single correlated outage
+// rather than a series of unrelated node failures
+func (*palive) downDomains(prev, clone *smapX) (fds []string) {
+	for fd, nodes := range prev.FailureDomains() {
+		var alive, total int
+		for _, si := range nodes {
+			if si.InMaintOrDecomm() {
+				continue
+			}
+			total++
+			if clone.GetNode(si.ID()) != nil {
+				alive++
+			}
+		}
+		if total > 1 && alive == 0 {
+			fds = append(fds, fd)
+		}
+	}
+	return fds
+}
+
 func (pkr *palive) _final(ctx *smapModifier, clone *smapX) {
 	msg := pkr.p.newAmsg(ctx.msg, nil)
 	debug.Assert(clone._sgl != nil)
@@ -432,6 +490,8 @@ func (pkr *palive) retry(si *meta.Snode, ticker *time.Ticker, timeout time.Durat
 
 func (k *keepalive) Name() string { return k.name }
 
+func (k *keepalive) gsp() *gossip { return k.gossip }
+
 func (k *keepalive) heardFrom(sid string) {
 	k.hb.HeardFrom(sid, 0 /*now*/)
 }
@@ -480,7 +540,8 @@ func (k *keepalive) init(smap *smapX, self string) {
 
 func (k *keepalive) _run() {
 	var (
-		ticker    = time.NewTicker(k.interval)
+		interval  = k.tick()
+		ticker    = time.NewTicker(interval)
 		lastCheck int64
 	)
 	k.tickerPaused.Store(false)
@@ -489,13 +550,21 @@ func (k *keepalive) _run() {
 		case <-ticker.C:
 			lastCheck = mono.NanoTime()
 			config := cmn.GCO.Get()
+			if config.Keepalive.Gossip.Enabled {
+				go k.gossip.round(config)
+			}
 			k.k.do(config)
 			k.configUpdate(k.k.cfg(config))
+			if d := k.tick(); d != interval && !k.paused() {
+				interval = d
+				ticker.Reset(interval)
+			}
 		case sig := <-k.controlCh:
 			switch sig.msg {
 			case kaResumeMsg:
 				if k.tickerPaused.CAS(true, false) {
-					ticker.Reset(k.interval)
+					interval = k.tick()
+					ticker.Reset(interval)
 				}
 			case kaSuspendMsg:
 				if k.tickerPaused.CAS(false, true) {
@@ -519,6 +588,15 @@ func (k *keepalive) _run() {
 	}
 }
 
+// tick at the configured interval, and twice as often only while there are flapping nodes
+// (see heartBeat.adaptive); either way, skip nodes that are not due (see timeToPing)
+func (k *keepalive) tick() time.Duration {
+	if k.hb.flapping(mono.NanoTime()) {
+		return k.interval >> 1
+	}
+	return k.interval
+}
+
 func (k *keepalive) configUpdate(cfg *cmn.KeepaliveTrackerConf) {
 	if k.hb.set(cfg.Interval.D()) {
 		k.interval = cfg.Interval.D()
@@ -572,6 +650,7 @@ func (k *keepalive) do(smap *smapX, si *meta.Snode, config *cmn.Config) (stopped
 				now := mono.NanoTime()
 				k.statsT.Add(stats.KeepAliveLatency, now-started)
 				k.hb.HeardFrom(pid, now) // effectively, yes
+				k.hb.flapped(pid, now)   // failed and then recovered
 				nlog.Infof("%s: OK after %d attempt%s", si, i, cos.Plural(i))
 				return
 			}
@@ -622,21 +701,20 @@ func (k *keepalive) paused() bool { return k.tickerPaused.Load() }
 
 func newHB(interval time.Duration) *heartBeat { return &heartBeat{interval: interval} }
 
+func (hb *heartBeat) entry(id string, now int64) *hbEntry {
+	if v, ok := hb.last.Load(id); ok {
+		return v.(*hbEntry) // almost always
+	}
+	v, _ := hb.last.LoadOrStore(id, &hbEntry{since: now})
+	return v.(*hbEntry)
+}
+
 func (hb *heartBeat) HeardFrom(id string, now int64) {
-	var (
-		val   *int64
-		v, ok = hb.last.Load(id)
-	)
 	if now == 0 {
 		now = mono.NanoTime()
 	}
-	if ok {
-		val = v.(*int64) // almost always
-	} else {
-		val = new(int64)
-		hb.last.Store(id, val)
-	}
-	ratomic.StoreInt64(val, now)
+	e := hb.entry(id, now)
+	ratomic.StoreInt64(&e.last, now)
 }
 
 func (hb *heartBeat) TimedOut(id string) bool {
@@ -644,13 +722,78 @@ func (hb *heartBeat) TimedOut(id string) bool {
 	if !ok {
 		return true
 	}
-	val := v.(*int64)
-	tim := ratomic.LoadInt64(val)
+	var (
+		e    = v.(*hbEntry)
+		now  = mono.NanoTime()
+		last = ratomic.LoadInt64(&e.last)
+	)
+	return time.Duration(now-last) > hb.adaptive(e, now)
+}
+
+// adaptive interval:
+// - probe recently flapping nodes twice as often
+// - and stable ones - twice as rarely
+func (hb *heartBeat) adaptive(e *hbEntry, now int64) time.Duration {
+	var (
+		interval = hb.interval
+		flap     = ratomic.LoadInt64(&e.flap)
+	)
+	if isFlapping(flap, now, interval) {
+		return interval >> 1
+	}
+	if from := max(flap, ratomic.LoadInt64(&e.since)); time.Duration(now-from) > kaStableAfter*interval {
+		return interval << 1
+	}
+	return interval
+}
+
+func isFlapping(flap, now int64, interval time.Duration) bool {
+	return flap != 0 && time.Duration(now-flap) < kaFlapWindow*interval
+}
+
+// to be called upon recovery - the outcome of the retries (not the failure that triggered them)
+func (hb *heartBeat) flapped(id string, now int64) {
+	if now == 0 {
+		now = mono.NanoTime()
+	}
+	e := hb.entry(id, now)
+	ratomic.StoreInt64(&e.flap, now)
+}
+
+func (hb *heartBeat) flapping(now int64) (yes bool) {
+	hb.last.Range(func(_, v any) bool {
+		yes = isFlapping(ratomic.LoadInt64(&v.(*hbEntry).flap), now, hb.interval)
+		return !yes
+	})
+	return yes
+}
+
+func (hb *heartBeat) reg(id string) { hb.last.Store(id, &hbEntry{since: mono.NanoTime()}) }
 
-	return mono.Since(tim) > hb.interval
+// never goes back in time
+func (hb *heartBeat) heardAbout(id string, at int64) {
+	e := hb.entry(id, at)
+	for {
+		last := ratomic.LoadInt64(&e.last)
+		if at <= last || ratomic.CompareAndSwapInt64(&e.last, last, at) {
+			return
+		}
+	}
 }
 
-func (hb *heartBeat) reg(id string) { hb.last.Store(id, new(int64)) }
+func (hb *heartBeat) lastHeard(id string) int64 {
+	v, ok := hb.last.Load(id)
+	if !ok {
+		return 0
+	}
+	return ratomic.LoadInt64(&v.(*hbEntry).last)
+}
+
+func (hb *heartBeat) expire(id string) {
+	if v, ok := hb.last.Load(id); ok {
+		ratomic.StoreInt64(&v.(*hbEntry).last, 0)
+	}
+}
 
 func (hb *heartBeat) set(interval time.Duration) (changed bool) {
 	changed = hb.interval != interval
@@ -7,6 +7,8 @@ package ais
 import (
 	"testing"
 	"time"
+
+	"github.com/NVIDIA/aistore/cmn/mono"
 )
 
 func TestHB(t *testing.T) {
@@ -49,3 +51,82 @@ func TestHB(t *testing.T) {
 		t.Fatal("Expecting timeout")
 	}
 }
+
+func TestHBGossip(t *testing.T) {
+	var (
+		hb  = newHB(time.Millisecond * 10)
+		id  = "1"
+		now = mono.NanoTime()
+	)
+	hb.heardAbout(id, now)
+	hb.heardAbout(id, now-int64(time.Second)) // older news
+	if hb.lastHeard(id) != now {
+		t.Fatal("gossiped liveness must not go back in time")
+	}
+	if hb.TimedOut(id) {
+		t.Fatal("Expecting no timeout")
+	}
+	hb.expire(id)
+	if !hb.TimedOut(id) {
+		t.Fatal("Expecting timeout upon expiration (suspected)")
+	}
+
+	s := &gsuspect{reporters: map[string]int64{"a": now, "b": now - int64(time.Minute)}}
+	if level := s.level(now, time.Second); level != 1 || len(s.reporters) != 1 {
+		t.Fatalf("expecting suspicion level 1, got %d (%v)", level, s.reporters)
+	}
+}
+
+func TestHBAdaptive(t *testing.T) {
+	var (
+		interval = time.Second
+		hb       = newHB(interval)
+		k        = &keepalive{hb: hb, interval: interval}
+		now      = mono.NanoTime()
+		id       = "1"
+	)
+	hb.HeardFrom(id, now)
+	e := hb.entry(id, now)
+
+	// stable or not, no flapping nodes - no faster ticking
+	if d := hb.adaptive(e, now); d != interval {
+		t.Fatalf("expecting %v, got %v", interval, d)
+	}
+	if d := hb.adaptive(e, now+int64(kaStableAfter*interval)+1); d != interval<<1 {
+		t.Fatalf("expecting stable node to be probed every %v, got %v", interval<<1, d)
+	}
+	if hb.flapping(now) {
+		t.Fatal("not expecting flapping nodes")
+	}
+	if d := k.tick(); d != interval {
+		t.Fatalf("expecting ticker interval %v, got %v", interval, d)
+	}
+
+	// failed, then recovered
+	hb.flapped(id, now)
+	if d := hb.adaptive(e, now); d != interval>>1 {
+		t.Fatalf("expecting flapping node to be probed every %v, got %v", interval>>1, d)
+	}
+	if !hb.flapping(now) {
+		t.Fatal("expecting flapping node")
+	}
+	if d := k.tick(); d != interval>>1 {
+		t.Fatalf("expecting ticker interval %v, got %v", interval>>1, d)
+	}
+
+	// another (stable) node is not affected
+	id2 := "2"
+	hb.HeardFrom(id2, now)
+	if d := hb.adaptive(hb.entry(id2, now), now); d != interval {
+		t.Fatalf("expecting %v, got %v", interval, d)
+	}
+
+	// flap window passed
+	later := now + int64(kaFlapWindow*interval)
+	if hb.flapping(later) {
+		t.Fatal("not expecting flapping nodes past the window")
+	}
+	if d := hb.adaptive(e, later); d != interval {
+		t.Fatalf("expecting %v, got %v", interval, d)
+	}
+}
@@ -263,6 +263,7 @@ func (y *metasyncer) sync(pairs ...revsPair) *sync.WaitGroup {
 		nlog.Errorln(err)
 		return req.wg
 	}
+	y.standby(pairs) // (synchronously)
 	req.wg.Add(1)
 	req.ty = reqSync
 	y.workCh <- req
@@ -0,0 +1,73 @@
+// Package nfs provides embedded NFSv3 gateway (RFC 1813) that exposes ais:// buckets as exports
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package nfs
+
+// MOUNT v3 (RFC 1813, Appendix I)
+
+const (
+	mountNull    = 0
+	mountMnt     = 1
+	mountDump    = 2
+	mountUmnt    = 3
+	mountUmntAll = 4
+	mountExport  = 5
+)
+
+const (
+	mnt3OK        = 0
+	mnt3ErrNoEnt  = 2
+	mnt3ErrAccess = 13
+	mnt3ErrNotDir = 20
+)
+
+func (s *Server) mount(cl *call, w *xdrw) bool {
+	switch cl.proc {
+	case mountNull, mountUmntAll:
+	case mountMnt:
+		s.mnt(cl.args, w)
+	case mountDump:
+		w.bool(false) // (not tracking mounts)
+	case mountUmnt:
+		cl.args.str(maxPath)
+	case mountExport:
+		for _, bck := range s.fs.Exports() {
+			w.bool(true)
+			w.str("/" + bck)
+			w.bool(false) // (no groups)
+		}
+		w.bool(false)
+	default:
+		return false
+	}
+	return true
+}
+
+func (s *Server) mnt(r *xdrr, w *xdrw) {
+	dirpath := r.str(maxPath)
+	if r.err != nil {
+		return
+	}
+	hp, ok := s.export(dirpath)
+	if !ok {
+		w.u32(mnt3ErrNoEnt)
+		return
+	}
+	a, err := s.stat(hp)
+	switch {
+	case err == ErrAccess:
+		w.u32(mnt3ErrAccess)
+		return
+	case err != nil:
+		w.u32(mnt3ErrNoEnt)
+		return
+	case !a.Dir:
+		w.u32(mnt3ErrNotDir)
+		return
+	}
+	w.u32(mnt3OK)
+	w.opaque(s.fh(hp))
+	w.u32(1) // auth flavors
+	w.u32(authSys)
+}
@@ -0,0 +1,882 @@
+// Package nfs provides embedded NFSv3 gateway (RFC 1813) that exposes ais:// buckets as exports
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package nfs
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/mono"
+)
+
+// NFS v3 procedures (RFC 1813, section 3)
+
+const (
+	procNull        = 0
+	procGetattr     = 1
+	procSetattr     = 2
+	procLookup      = 3
+	procAccess      = 4
+	procReadlink    = 5
+	procRead        = 6
+	procWrite       = 7
+	procCreate      = 8
+	procMkdir       = 9
+	procSymlink     = 10
+	procMknod       = 11
+	procRemove      = 12
+	procRmdir       = 13
+	procRename      = 14
+	procLink        = 15
+	procReaddir     = 16
+	procReaddirplus = 17
+	procFsstat      = 18
+	procFsinfo      = 19
+	procPathconf    = 20
+	procCommit      = 21
+)
+
+const (
+	nfs3OK             = 0
+	nfs3ErrNoEnt       = 2
+	nfs3ErrIO          = 5
+	nfs3ErrAccess      = 13
+	nfs3ErrExist       = 17
+	nfs3ErrNotDir      = 20
+	nfs3ErrIsDir       = 21
+	nfs3ErrInval       = 22
+	nfs3ErrRofs        = 30
+	nfs3ErrNameTooLong = 63
+	nfs3ErrNotEmpty    = 66
+	nfs3ErrStale       = 70
+	nfs3ErrBadHandle   = 10001
+	nfs3ErrBadCookie   = 10003
+	nfs3ErrNotSupp     = 10004
+	nfs3ErrTooSmall    = 10005
+)
+
+const (
+	nf3Reg = 1
+	nf3Dir = 2
+
+	// ACCESS
+	accessRead    = 0x01
+	accessLookup  = 0x02
+	accessModify  = 0x04
+	accessExtend  = 0x08
+	accessDelete  = 0x10
+	accessExecute = 0x20
+
+	// WRITE
+	writeUnstable = 0
+	writeFileSync = 2
+
+	// CREATE (UNCHECKED = 0)
+	createGuarded   = 1
+	createExclusive = 2
+
+	// sattr3 time
+	timeClient = 2
+
+	// FSINFO
+	fsfHomogeneous = 0x08
+
+	fattrSize    = 84
+	maxDirlEntry = 4 + 8 + 4 + maxName + 3 + 8 // (READDIR entry, upper bound)
+	maxPlusEntry = maxDirlEntry + 4 + fattrSize + 4 + 4 + 8
+)
+
+func (s *Server) nfs3(cl *call, w *xdrw) bool {
+	r := cl.args
+	switch cl.proc {
+	case procNull:
+	case procGetattr:
+		s.getattr(r, w)
+	case procSetattr:
+		s.setattr(r, w)
+	case procLookup:
+		s.lookup(r, w)
+	case procAccess:
+		s.access(r, w)
+	case procRead:
+		s.read(r, w)
+	case procWrite:
+		s.write(r, w)
+	case procCreate:
+		s.create(r, w)
+	case procMkdir:
+		s.mkdir(r, w)
+	case procRemove:
+		s.remove(r, w)
+	case procRmdir:
+		s.rmdir(r, w)
+	case procReaddir:
+		s.readdir(r, w, false)
+	case procReaddirplus:
+		s.readdir(r, w, true)
+	case procFsstat, procFsinfo, procPathconf:
+		s.fsinfo(cl.proc, r, w)
+	case procCommit:
+		s.commit(r, w)
+	case procReadlink:
+		w.u32(nfs3ErrNotSupp)
+		w.bool(false)
+	case procSymlink, procMknod:
+		w.u32(nfs3ErrNotSupp)
+		noWcc(w)
+	case procRename:
+		w.u32(nfs3ErrNotSupp)
+		noWcc(w)
+		noWcc(w)
+	case procLink:
+		w.u32(nfs3ErrNotSupp)
+		w.bool(false)
+		noWcc(w)
+	default:
+		return false
+	}
+	return true
+}
+
+/////////////
+// helpers //
+/////////////
+
+var errNoWbuf = errors.New("no pending write")
+
+func errStatus(err error) uint32 {
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return nfs3ErrNoEnt
+	case errors.Is(err, ErrAccess):
+		return nfs3ErrAccess
+	default:
+		return nfs3ErrIO
+	}
+}
+
+func checkName(name string) uint32 {
+	switch {
+	case len(name) > maxName:
+		return nfs3ErrNameTooLong
+	case name == "" || name == "." || name == ".." || strings.IndexByte(name, '/') >= 0:
+		return nfs3ErrInval
+	default:
+		return nfs3OK
+	}
+}
+
+// (permissions are not enforced - see docs)
+func (s *Server) fattr(w *xdrw, hp hpath, a *Attr) {
+	var ftype, mode, nlink uint32 = nf3Reg, 0o444, 1
+	if a.Dir {
+		ftype, mode, nlink = nf3Dir, 0o555, 2
+	}
+	if s.fs.Writable() {
+		mode |= 0o222
+	}
+	w.u32(ftype)
+	w.u32(mode)
+	w.u32(nlink)
+	w.u32(0) // uid
+	w.u32(0) // gid
+	w.u64(uint64(a.Size))
+	w.u64(uint64(a.Size)) // used
+	w.u32(0)              // rdev
+	w.u32(0)
+	w.u64(fileid(hpath{bck: hp.bck})) // fsid
+	w.u64(fileid(hp))
+	mtime := a.Mtime
+	if mtime == 0 {
+		mtime = s.born
+	}
+	for range 3 { // atime, mtime, ctime
+		w.u32(uint32(mtime / int64(time.Second)))
+		w.u32(uint32(mtime % int64(time.Second)))
+	}
+}
+
+func (s *Server) postAttr(w *xdrw, hp hpath, a *Attr) {
+	w.bool(true)
+	s.fattr(w, hp, a)
+}
+
+func (s *Server) postFh(w *xdrw, hp hpath) {
+	w.bool(true)
+	w.opaque(s.fh(hp))
+}
+
+// pre_op_attr (none) and post_op_attr
+func (s *Server) wcc(w *xdrw, hp hpath, a *Attr) {
+	w.bool(false)
+	s.postAttr(w, hp, a)
+}
+
+func noWcc(w *xdrw) {
+	w.bool(false)
+	w.bool(false)
+}
+
+func readSattr(r *xdrr) (size int64, setSize bool) {
+	for range 3 { // mode, uid, gid
+		if r.bool() {
+			r.u32()
+		}
+	}
+	if setSize = r.bool(); setSize {
+		size = int64(r.u64())
+	}
+	for range 2 { // atime, mtime
+		if r.u32() == timeClient {
+			r.u64()
+		}
+	}
+	return size, setSize
+}
+
+func (s *Server) dirop(r *xdrr) (dir hpath, name string, status uint32) {
+	fh := r.opaque(64)
+	name = r.str(maxPath)
+	if r.err != nil {
+		return dir, name, nfs3ErrInval
+	}
+	dir, status = s.resolve(fh)
+	return dir, name, status
+}
+
+func (s *Server) getWbuf(hp hpath) *wbuf {
+	s.mu.RLock()
+	wb := s.wbufs[fileid(hp)]
+	s.mu.RUnlock()
+	return wb
+}
+
+////////////////
+// procedures //
+////////////////
+
+func (s *Server) getattr(r *xdrr, w *xdrw) {
+	hp, status := s.resolve(r.opaque(64))
+	if r.err != nil {
+		return
+	}
+	if status != nfs3OK {
+		w.u32(status)
+		return
+	}
+	a, err := s.stat(hp)
+	if err != nil {
+		w.u32(errStatus(err))
+		return
+	}
+	w.u32(nfs3OK)
+	s.fattr(w, hp, a)
+}
+
+// only size is supported (truncate); other attributes are ignored
+func (s *Server) setattr(r *xdrr, w *xdrw) {
+	hp, status := s.resolve(r.opaque(64))
+	size, setSize := readSattr(r)
+	if r.bool() { // guard
+		r.u64()
+	}
+	if r.err != nil {
+		return
+	}
+	if status == nfs3OK && setSize && !s.fs.Writable() {
+		status = nfs3ErrRofs
+	}
+	if status != nfs3OK {
+		w.u32(status)
+		noWcc(w)
+		return
+	}
+	a, err := s.stat(hp)
+	if err != nil && !(setSize && errors.Is(err, ErrNotFound)) {
+		w.u32(errStatus(err))
+		noWcc(w)
+		return
+	}
+	if setSize {
+		if a != nil && a.Dir {
+			w.u32(nfs3ErrIsDir)
+			noWcc(w)
+			return
+		}
+		wb, err := s.lockWbuf(hp, size == 0)
+		if err == nil && size > 0 {
+			if err = wb.fh.Truncate(size); err == nil {
+				wb.size, wb.dirty, wb.mtime = size, true, time.Now().UnixNano()
+			}
+		}
+		if wb != nil {
+			a = &Attr{Size: wb.size, Mtime: wb.mtime}
+			wb.mu.Unlock()
+		}
+		if err != nil {
+			w.u32(errStatus(err))
+			noWcc(w)
+			return
+		}
+	}
+	w.u32(nfs3OK)
+	s.wcc(w, hp, a)
+}
+
+func (s *Server) lookup(r *xdrr, w *xdrw) {
+	dir, name, status := s.dirop(r)
+	if r.err != nil {
+		return
+	}
+	if status == nfs3OK && len(name) > maxName {
+		status = nfs3ErrNameTooLong
+	}
+	if status != nfs3OK {
+		w.u32(status)
+		w.bool(false)
+		return
+	}
+	var hp hpath
+	switch name {
+	case ".":
+		hp = dir
+	case "..":
+		hp = dir.parent()
+	default:
+		hp = dir.child(name)
+	}
+	a, err := s.stat(hp)
+	if err != nil {
+		w.u32(errStatus(err))
+		w.bool(false)
+		return
+	}
+	w.u32(nfs3OK)
+	w.opaque(s.fh(hp))
+	s.postAttr(w, hp, a)
+	w.bool(false) // (dir)
+}
+
+func (s *Server) access(r *xdrr, w *xdrw) {
+	hp, status := s.resolve(r.opaque(64))
+	want := r.u32()
+	if r.err != nil {
+		return
+	}
+	if status != nfs3OK {
+		w.u32(status)
+		w.bool(false)
+		return
+	}
+	a, err := s.stat(hp)
+	if err != nil {
+		w.u32(errStatus(err))
+		w.bool(false)
+		return
+	}
+	granted := uint32(accessRead)
+	if a.Dir {
+		granted |= accessLookup | accessExecute
+	}
+	if s.fs.Writable() {
+		granted |= accessModify | accessExtend | accessDelete
+	}
+	w.u32(nfs3OK)
+	s.postAttr(w, hp, a)
+	w.u32(want & granted)
+}
+
+func (s *Server) read(r *xdrr, w *xdrw) {
+	hp, status := s.resolve(r.opaque(64))
+	off, count := int64(r.u64()), int(r.u32())
+	if r.err != nil {
+		return
+	}
+	if status == nfs3OK && hp.name == "" {
+		status = nfs3ErrIsDir
+	}
+	if status != nfs3OK {
+		w.u32(status)
+		w.bool(false)
+		return
+	}
+	buf := make([]byte, min(count, maxIOSize))
+	n, eof, err := s.readWbuf(hp, off, buf)
+	if err == errNoWbuf {
+		n, err = s.fs.Read(hp.bck, hp.name, off, buf)
+		eof = n < len(buf) || err == io.EOF
+	}
+	if err != nil && err != io.EOF {
+		w.u32(errStatus(err))
+		w.bool(false)
+		return
+	}
+	w.u32(nfs3OK)
+	w.bool(false) // (file attributes)
+	w.u32(uint32(n))
+	w.bool(eof)
+	w.opaque(buf[:n])
+}
+
+// read pending (buffered) write
+func (s *Server) readWbuf(hp hpath, off int64, buf []byte) (n int, eof bool, err error) {
+	wb := s.getWbuf(hp)
+	if wb == nil {
+		return 0, false, errNoWbuf
+	}
+	wb.mu.Lock()
+	defer wb.mu.Unlock()
+	if wb.fh == nil {
+		return 0, false, errNoWbuf
+	}
+	if off < wb.size {
+		n, err = wb.fh.ReadAt(buf[:min(int64(len(buf)), wb.size-off)], off)
+	}
+	return n, off+int64(n) >= wb.size, err
+}
+
+func (s *Server) write(r *xdrr, w *xdrw) {
+	hp, status := s.resolve(r.opaque(64))
+	off := int64(r.u64())
+	r.u32() // count
+	stable := r.u32()
+	data := r.opaque(maxIOSize)
+	if r.err != nil {
+		return
+	}
+	switch {
+	case status != nfs3OK:
+	case !s.fs.Writable():
+		status = nfs3ErrRofs
+	case hp.name == "":
+		status = nfs3ErrIsDir
+	case s.getWbuf(hp) == nil:
+		if a, err := s.stat(hp); err == nil && a.Dir {
+			status = nfs3ErrIsDir
+		}
+	}
+	if status != nfs3OK {
+		w.u32(status)
+		noWcc(w)
+		return
+	}
+	wb, err := s.lockWbuf(hp, false)
+	if err != nil {
+		w.u32(errStatus(err))
+		noWcc(w)
+		return
+	}
+	committed := uint32(writeUnstable)
+	_, err = wb.fh.WriteAt(data, off)
+	if err == nil {
+		wb.size = max(wb.size, off+int64(len(data)))
+		wb.dirty, wb.mtime, wb.last = true, time.Now().UnixNano(), mono.NanoTime()
+		if stable != writeUnstable {
+			err = s.flush(wb)
+			committed = writeFileSync
+		}
+	}
+	a := &Attr{Size: wb.size, Mtime: wb.mtime}
+	wb.mu.Unlock()
+	if err != nil {
+		w.u32(errStatus(err))
+		noWcc(w)
+		return
+	}
+	w.u32(nfs3OK)
+	s.wcc(w, hp, a)
+	w.u32(uint32(len(data)))
+	w.u32(committed)
+	w.fixed(s.verf[:])
+}
+
+func (s *Server) commit(r *xdrr, w *xdrw) {
+	hp, status := s.resolve(r.opaque(64))
+	r.u64() // offset
+	r.u32() // count
+	if r.err != nil {
+		return
+	}
+	if status != nfs3OK {
+		w.u32(status)
+		noWcc(w)
+		return
+	}
+	if wb := s.getWbuf(hp); wb != nil {
+		var err error
+		wb.mu.Lock()
+		if wb.fh != nil && wb.dirty {
+			err = s.flush(wb)
+		}
+		wb.last = mono.NanoTime()
+		wb.mu.Unlock()
+		if err != nil {
+			w.u32(errStatus(err))
+			noWcc(w)
+			return
+		}
+	}
+	w.u32(nfs3OK)
+	noWcc(w)
+	w.fixed(s.verf[:])
+}
+
+func (s *Server) create(r *xdrr, w *xdrw) {
+	dir, name, status := s.dirop(r)
+	how := r.u32()
+	var (
+		size    int64
+		setSize bool
+	)
+	if how == createExclusive {
+		r.fixed(8) // (verifier)
+	} else {
+		size, setSize = readSattr(r)
+	}
+	if r.err != nil {
+		return
+	}
+	if status == nfs3OK {
+		status = s.checkMod(name)
+	}
+	if status != nfs3OK {
+		w.u32(status)
+		noWcc(w)
+		return
+	}
+	hp := dir.child(name)
+	a, err := s.stat(hp)
+	switch {
+	case err == nil && (a.Dir || how == createGuarded):
+		status = nfs3ErrExist
+	case err == nil && how == createExclusive && s.getWbuf(hp) == nil:
+		status = nfs3ErrExist // (not a retransmission)
+	case err != nil && !errors.Is(err, ErrNotFound):
+		status = errStatus(err)
+	}
+	if status != nfs3OK {
+		w.u32(status)
+		noWcc(w)
+		return
+	}
+	if err != nil || (setSize && size == 0) {
+		wb, err := s.lockWbuf(hp, true)
+		if err != nil {
+			w.u32(errStatus(err))
+			noWcc(w)
+			return
+		}
+		a = &Attr{Size: wb.size, Mtime: wb.mtime}
+		wb.mu.Unlock()
+	}
+	w.u32(nfs3OK)
+	s.postFh(w, hp)
+	s.postAttr(w, hp, a)
+	noWcc(w)
+}
+
+func (s *Server) mkdir(r *xdrr, w *xdrw) {
+	dir, name, status := s.dirop(r)
+	readSattr(r)
+	if r.err != nil {
+		return
+	}
+	if status == nfs3OK {
+		status = s.checkMod(name)
+	}
+	if status != nfs3OK {
+		w.u32(status)
+		noWcc(w)
+		return
+	}
+	hp := dir.child(name)
+	if _, err := s.stat(hp); err == nil || !errors.Is(err, ErrNotFound) {
+		if err == nil {
+			w.u32(nfs3ErrExist)
+		} else {
+			w.u32(errStatus(err))
+		}
+		noWcc(w)
+		return
+	}
+	a := &Attr{Dir: true, Mtime: time.Now().UnixNano()}
+	s.mu.Lock()
+	s.mkdirs[hp] = a.Mtime
+	s.mu.Unlock()
+	w.u32(nfs3OK)
+	s.postFh(w, hp)
+	s.postAttr(w, hp, a)
+	noWcc(w)
+}
+
+func (s *Server) remove(r *xdrr, w *xdrw) {
+	dir, name, status := s.dirop(r)
+	if r.err != nil {
+		return
+	}
+	if status == nfs3OK {
+		status = s.checkMod(name)
+	}
+	if status != nfs3OK {
+		w.u32(status)
+		noWcc(w)
+		return
+	}
+	hp := dir.child(name)
+	a, err := s.stat(hp)
+	switch {
+	case err != nil:
+		status = errStatus(err)
+	case a.Dir:
+		status = nfs3ErrIsDir
+	default:
+		wb := s.getWbuf(hp)
+		if wb != nil {
+			s.dropWbuf(wb, true)
+		}
+		if erm := s.fs.Remove(hp.bck, hp.name); erm != nil && !(wb != nil && errors.Is(erm, ErrNotFound)) {
+			status = errStatus(erm)
+		}
+	}
+	w.u32(status)
+	noWcc(w)
+}
+
+func (s *Server) rmdir(r *xdrr, w *xdrw) {
+	dir, name, status := s.dirop(r)
+	if r.err != nil {
+		return
+	}
+	if status == nfs3OK {
+		status = s.checkMod(name)
+	}
+	if status != nfs3OK {
+		w.u32(status)
+		noWcc(w)
+		return
+	}
+	hp := dir.child(name)
+	a, err := s.stat(hp)
+	switch {
+	case err != nil:
+		status = errStatus(err)
+	case !a.Dir:
+		status = nfs3ErrNotDir
+	default:
+		ents, erl := s.list(hp)
+		switch {
+		case erl != nil:
+			status = errStatus(erl)
+		case len(ents) > 0:
+			status = nfs3ErrNotEmpty
+		default:
+			s.mu.Lock()
+			delete(s.mkdirs, hp)
+			s.mu.Unlock()
+		}
+	}
+	w.u32(status)
+	noWcc(w)
+}
+
+func (s *Server) checkMod(name string) uint32 {
+	if !s.fs.Writable() {
+		return nfs3ErrRofs
+	}
+	return checkName(name)
+}
+
+/////////////
+// readdir //
+/////////////
+
+// stored (remote) entries plus pending (local) ones: buffered writes and MKDIR-ed directories
+func (s *Server) list(dir hpath) ([]DirEntry, error) {
+	ents, err := s.fs.List(dir.bck, dir.name)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+	var (
+		wbs   []*wbuf
+		names = make(map[string]struct{}, len(ents))
+	)
+	for i := range ents {
+		names[ents[i].Name] = struct{}{}
+	}
+	s.mu.RLock()
+	for _, wb := range s.wbufs {
+		if wb.hp.parent() == dir && wb.hp.name != "" {
+			wbs = append(wbs, wb)
+		}
+	}
+	for hp, mtime := range s.mkdirs {
+		if hp.parent() != dir {
+			continue
+		}
+		name := hp.name[strings.LastIndexByte(hp.name, '/')+1:]
+		if _, ok := names[name]; !ok {
+			names[name] = struct{}{}
+			ents = append(ents, DirEntry{Name: name, Attr: Attr{Dir: true, Mtime: mtime}})
+		}
+	}
+	s.mu.RUnlock()
+	for _, wb := range wbs {
+		name := wb.hp.name[strings.LastIndexByte(wb.hp.name, '/')+1:]
+		wb.mu.Lock()
+		a, valid := Attr{Size: wb.size, Mtime: wb.mtime}, wb.fh != nil
+		wb.mu.Unlock()
+		if _, ok := names[name]; !ok && valid {
+			ents = append(ents, DirEntry{Name: name, Attr: a})
+		}
+	}
+	return ents, nil
+}
+
+func (s *Server) readdir(r *xdrr, w *xdrw, plus bool) {
+	hp, status := s.resolve(r.opaque(64))
+	cookie := r.u64()
+	cverf := r.u64()
+	maxcount := int(r.u32())
+	if plus {
+		maxcount = int(r.u32()) // (dircount is advisory)
+	}
+	if r.err != nil {
+		return
+	}
+	if status != nfs3OK {
+		w.u32(status)
+		w.bool(false)
+		return
+	}
+	var (
+		dl  *dirl
+		id  = fileid(hp)
+		now = mono.NanoTime()
+	)
+	if cookie == 0 {
+		a, err := s.stat(hp)
+		switch {
+		case err != nil:
+			status = errStatus(err)
+		case !a.Dir:
+			status = nfs3ErrNotDir
+		}
+		var ents []DirEntry
+		if status == nfs3OK {
+			if ents, err = s.list(hp); err != nil {
+				status = errStatus(err)
+			}
+		}
+		if status != nfs3OK {
+			w.u32(status)
+			w.bool(false)
+			return
+		}
+		dl = &dirl{ents: ents, id: id, last: now}
+		cverf = s.dverf.Add(1)
+		s.mu.Lock()
+		if len(s.dirls) >= maxDirls {
+			clear(s.dirls)
+		}
+		s.dirls[cverf] = dl
+		s.mu.Unlock()
+	} else {
+		s.mu.Lock()
+		dl = s.dirls[cverf]
+		if dl != nil {
+			dl.last = now
+		}
+		s.mu.Unlock()
+		if dl == nil || dl.id != id || cookie > uint64(len(dl.ents)) {
+			w.u32(nfs3ErrBadCookie)
+			w.bool(false)
+			return
+		}
+	}
+
+	var (
+		size  = 4 + 4 + 8 + 4 + 4 // status, post_op_attr, cookieverf, end-of-list, eof
+		esize = maxDirlEntry
+		i     = int(cookie)
+	)
+	if plus {
+		esize = maxPlusEntry
+	}
+	if size+esize > maxcount && i < len(dl.ents) {
+		w.u32(nfs3ErrTooSmall)
+		w.bool(false)
+		return
+	}
+	w.u32(nfs3OK)
+	w.bool(false) // (dir attributes)
+	w.u64(cverf)
+	for ; i < len(dl.ents) && size+esize <= maxcount; i++ {
+		var (
+			en    = &dl.ents[i]
+			child = hp.child(en.Name)
+		)
+		w.bool(true)
+		w.u64(fileid(child))
+		w.str(en.Name)
+		w.u64(uint64(i + 1))
+		if plus {
+			s.postAttr(w, child, &en.Attr)
+			s.postFh(w, child)
+		}
+		size += esize
+	}
+	w.bool(false)
+	w.bool(i >= len(dl.ents))
+}
+
+////////////
+// fsinfo //
+////////////
+
+func (s *Server) fsinfo(proc uint32, r *xdrr, w *xdrw) {
+	hp, status := s.resolve(r.opaque(64))
+	if r.err != nil {
+		return
+	}
+	if status != nfs3OK {
+		w.u32(status)
+		w.bool(false)
+		return
+	}
+	w.u32(nfs3OK)
+	if hp.name == "" {
+		s.postAttr(w, hp, &Attr{Dir: true, Mtime: s.born})
+	} else {
+		w.bool(false)
+	}
+	switch proc {
+	case procFsstat:
+		const unlimited = 1 << 50
+		for range 6 { // tbytes, fbytes, abytes, tfiles, ffiles, afiles
+			w.u64(unlimited)
+		}
+		w.u32(0) // invarsec
+	case procFsinfo:
+		for range 2 { // read, write
+			w.u32(maxIOSize) // max
+			w.u32(maxIOSize) // preferred
+			w.u32(4096)      // multiple
+		}
+		w.u32(64 * 1024)      // dtpref
+		w.u64(1<<63 - 1)      // maxfilesize
+		w.u32(0)              // time_delta
+		w.u32(1)              // (1ns)
+		w.u32(fsfHomogeneous) // properties
+	case procPathconf:
+		w.u32(1)       // linkmax
+		w.u32(maxName) // name_max
+		w.bool(true)   // no_trunc
+		w.bool(true)   // chown_restricted
+		w.bool(false)  // case_insensitive
+		w.bool(true)   // case_preserving
+	}
+}
@@ -0,0 +1,203 @@
+// Package nfs provides embedded NFSv3 gateway (RFC 1813) that exposes ais:// buckets as exports
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package nfs
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// ONC RPC v2 (RFC 5531) over TCP, with record marking; NFS and MOUNT programs are served
+// on the same port (no portmapper - clients specify both ports, see docs)
+
+const (
+	progNFS   = 100003
+	progMount = 100005
+
+	versNFS   = 3
+	versMount = 3
+)
+
+const (
+	msgCall  = 0
+	msgReply = 1
+
+	rpcVers = 2
+
+	replyAccepted = 0
+	replyDenied   = 1
+
+	acceptSuccess     = 0
+	acceptProgUnavail = 1
+	acceptProgMismtch = 2
+	acceptProcUnavail = 3
+	acceptGarbageArgs = 4
+
+	rejectRPCMismatch = 0
+
+	authNone = 0
+	authSys  = 1
+
+	maxAuth       = 400
+	maxRecord     = maxIOSize + 64*1024
+	lastFragment  = 1 << 31
+	connInflight  = 16 // max concurrent calls per connection
+	callerBufSize = 64 * 1024
+)
+
+type (
+	call struct {
+		args *xdrr
+		xid  uint32
+		prog uint32
+		vers uint32
+		proc uint32
+	}
+	conn struct {
+		s    *Server
+		c    net.Conn
+		sema chan struct{}
+		wmu  sync.Mutex
+		wg   sync.WaitGroup
+	}
+)
+
+func (s *Server) serveConn(nc net.Conn) {
+	c := &conn{s: s, c: nc, sema: make(chan struct{}, connInflight)}
+	defer func() {
+		c.wg.Wait()
+		nc.Close()
+	}()
+	br := bufio.NewReaderSize(nc, callerBufSize)
+	for {
+		rec, err := readRecord(br)
+		if err != nil {
+			if !errors.Is(err, io.EOF) && !s.closed.Load() {
+				nlog.Warningln("nfs:", nc.RemoteAddr(), err)
+			}
+			return
+		}
+		c.sema <- struct{}{}
+		c.wg.Add(1)
+		go c.handle(rec)
+	}
+}
+
+func readRecord(br *bufio.Reader) ([]byte, error) {
+	var (
+		rec []byte
+		hdr [4]byte
+	)
+	for {
+		if _, err := io.ReadFull(br, hdr[:]); err != nil {
+			return nil, err
+		}
+		v := binary.BigEndian.Uint32(hdr[:])
+		n := int(v &^ lastFragment)
+		if len(rec)+n > maxRecord {
+			return nil, fmt.Errorf("record too large (%d)", len(rec)+n)
+		}
+		off := len(rec)
+		rec = append(rec, make([]byte, n)...)
+		if _, err := io.ReadFull(br, rec[off:]); err != nil {
+			return nil, err
+		}
+		if v&lastFragment != 0 {
+			return rec, nil
+		}
+	}
+}
+
+func (c *conn) handle(rec []byte) {
+	defer func() {
+		<-c.sema
+		c.wg.Done()
+	}()
+	r := &xdrr{b: rec}
+	cl := &call{xid: r.u32()}
+	if r.u32() != msgCall || r.err != nil {
+		return // (not a call - drop)
+	}
+	w := &xdrw{b: make([]byte, 4, 256)} // (reserve record mark)
+	w.u32(cl.xid)
+	w.u32(msgReply)
+	if vers := r.u32(); vers != rpcVers {
+		w.u32(replyDenied)
+		w.u32(rejectRPCMismatch)
+		w.u32(rpcVers)
+		w.u32(rpcVers)
+		c.reply(w)
+		return
+	}
+	cl.prog, cl.vers, cl.proc = r.u32(), r.u32(), r.u32()
+	r.u32() // cred flavor (AUTH_SYS uid/gid are not enforced - see docs)
+	r.opaque(maxAuth)
+	r.u32() // verf
+	r.opaque(maxAuth)
+	cl.args = r
+
+	w.u32(replyAccepted)
+	w.u32(authNone)
+	w.u32(0)
+	if r.err != nil {
+		w.u32(acceptGarbageArgs)
+		c.reply(w)
+		return
+	}
+	var (
+		lo, hi uint32
+		ok     bool
+	)
+	switch cl.prog {
+	case progNFS:
+		lo, hi = versNFS, versNFS
+	case progMount:
+		lo, hi = versMount, versMount
+	default:
+		w.u32(acceptProgUnavail)
+		c.reply(w)
+		return
+	}
+	if cl.vers < lo || cl.vers > hi {
+		w.u32(acceptProgMismtch)
+		w.u32(lo)
+		w.u32(hi)
+		c.reply(w)
+		return
+	}
+	mark := len(w.b)
+	w.u32(acceptSuccess)
+	if cl.prog == progNFS {
+		ok = c.s.nfs3(cl, w)
+	} else {
+		ok = c.s.mount(cl, w)
+	}
+	switch {
+	case !ok:
+		w.b = w.b[:mark]
+		w.u32(acceptProcUnavail)
+	case cl.args.err != nil:
+		w.b = w.b[:mark]
+		w.u32(acceptGarbageArgs)
+	}
+	c.reply(w)
+}
+
+func (c *conn) reply(w *xdrw) {
+	binary.BigEndian.PutUint32(w.b[:4], uint32(len(w.b)-4)|lastFragment)
+	c.wmu.Lock()
+	_, err := c.c.Write(w.b)
+	c.wmu.Unlock()
+	if err != nil && !c.s.closed.Load() {
+		nlog.Warningln("nfs:", c.c.RemoteAddr(), err)
+	}
+}
@@ -0,0 +1,396 @@
+// Package nfs provides embedded NFSv3 gateway (RFC 1813) that exposes ais:// buckets as exports
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package nfs
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	ratomic "sync/atomic"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/OneOfOne/xxhash"
+)
+
+// NFSv3 gateway:
+// - each export is a bucket ("/<bucket>"); virtual directories are object name prefixes ('/'-separated)
+// - file handles are (64-bit) hashes of (bucket, object name), resolved via in-memory table; handles
+//   that are unknown to a given gateway (e.g., after restart) are stale - except export roots
+// - read-mostly: with write-through enabled, writes are buffered in a local temp file and written
+//   (PUT) in their entirety upon COMMIT or stable (FILE_SYNC) WRITE - or else, when idle (wbufIdle);
+//   until then, the gateway serves reads and attributes of the file from the buffer
+// - directories (MKDIR) exist in memory until the first file gets written there
+// - not supported: RENAME, LINK, SYMLINK, MKNOD; NLM (locking) - mount with `nolock`
+
+// backend
+type (
+	Attr struct {
+		Size  int64
+		Mtime int64 // unix nanoseconds
+		Dir   bool
+	}
+	DirEntry struct {
+		Name string
+		Attr
+	}
+	FS interface {
+		// bucket names
+		Exports() []string
+		// objName is a (virtual) directory when there's at least one object with the `objName/` prefix
+		Stat(bck, objName string) (*Attr, error)
+		// immediate children of a given (virtual) directory; dir == "" is the bucket itself
+		List(bck, dir string) ([]DirEntry, error)
+		Read(bck, objName string, off int64, b []byte) (int, error)
+		Write(bck, objName string, r io.Reader, size int64) error
+		Remove(bck, objName string) error
+		// write-through enabled
+		Writable() bool
+	}
+)
+
+var (
+	ErrNotFound = errors.New("not found")
+	ErrAccess   = errors.New("access denied")
+)
+
+const (
+	maxIOSize   = 1024 * 1024
+	maxHandles  = 1024 * 1024
+	maxDirls    = 1024
+	maxName     = 255
+	maxPath     = 1024
+	wbufIdle    = 30 * time.Second // flush when not written (or committed) for a while
+	wbufDrop    = 2 * time.Minute  // ... and drop when clean
+	dirlTimeout = time.Minute      // READDIR continuation
+)
+
+type (
+	hpath struct {
+		bck  string
+		name string // "" => export root
+	}
+	wbuf struct {
+		fh    *os.File
+		hp    hpath
+		size  int64
+		mtime int64
+		last  int64 // mono
+		dirty bool
+		mu    sync.Mutex
+	}
+	dirl struct {
+		ents []DirEntry
+		id   uint64 // directory
+		last int64  // mono
+	}
+	Server struct {
+		fs      FS
+		ln      net.Listener
+		handles map[uint64]hpath
+		wbufs   map[uint64]*wbuf
+		dirls   map[uint64]*dirl
+		mkdirs  map[hpath]int64 // (MKDIR) => unix nanoseconds
+		verf    [8]byte         // write verifier (changes upon restart)
+		born    int64
+		dverf   ratomic.Uint64 // READDIR cookie verifier
+		mu      sync.RWMutex
+		closed  ratomic.Bool
+	}
+)
+
+func NewServer(fs FS) *Server {
+	s := &Server{
+		fs:      fs,
+		handles: make(map[uint64]hpath, 1024),
+		wbufs:   make(map[uint64]*wbuf, 16),
+		dirls:   make(map[uint64]*dirl, 16),
+		mkdirs:  make(map[hpath]int64, 4),
+		born:    time.Now().UnixNano(),
+	}
+	binary.BigEndian.PutUint64(s.verf[:], uint64(s.born))
+	s.dverf.Store(uint64(s.born))
+	return s
+}
+
+// blocking
+func (s *Server) Serve(ln net.Listener) error {
+	s.ln = ln
+	for {
+		nc, err := ln.Accept()
+		if err != nil {
+			if s.closed.Load() {
+				return nil
+			}
+			var ne net.Error
+			if errors.As(err, &ne) && ne.Timeout() {
+				time.Sleep(100 * time.Millisecond)
+				continue
+			}
+			return err
+		}
+		go s.serveConn(nc)
+	}
+}
+
+// stop accepting and flush all pending writes
+func (s *Server) Close() error {
+	s.closed.Store(true)
+	var err error
+	if s.ln != nil {
+		err = s.ln.Close()
+	}
+	s.flushAll(0)
+	return err
+}
+
+// flush idle writes, drop idle buffers and listings; returns the number of pending (dirty) writes
+func (s *Server) Housekeep() int {
+	s.mu.Lock()
+	now := mono.NanoTime()
+	for id, dl := range s.dirls {
+		if time.Duration(now-dl.last) > dirlTimeout {
+			delete(s.dirls, id)
+		}
+	}
+	s.mu.Unlock()
+	return s.flushAll(wbufIdle)
+}
+
+func (s *Server) flushAll(idle time.Duration) (dirty int) {
+	now := mono.NanoTime()
+	s.mu.RLock()
+	wbs := make([]*wbuf, 0, len(s.wbufs))
+	for _, wb := range s.wbufs {
+		wbs = append(wbs, wb)
+	}
+	s.mu.RUnlock()
+	for _, wb := range wbs {
+		wb.mu.Lock()
+		if wb.fh == nil {
+			wb.mu.Unlock()
+			continue
+		}
+		elapsed := time.Duration(now - wb.last)
+		if wb.dirty && elapsed >= idle {
+			if err := s.flush(wb); err != nil {
+				nlog.Errorln("nfs: failed to write", wb.hp.bck+"/"+wb.hp.name+":", err)
+			}
+		}
+		drop := !wb.dirty && (elapsed >= max(idle, wbufDrop) || idle == 0)
+		if wb.dirty {
+			dirty++
+		}
+		wb.mu.Unlock()
+		if drop {
+			s.dropWbuf(wb, false)
+		}
+	}
+	return dirty
+}
+
+/////////////
+// handles //
+/////////////
+
+func fileid(hp hpath) uint64 {
+	return xxhash.Checksum64S(cos.UnsafeB(hp.bck+"\x00"+hp.name), cos.MLCG32)
+}
+
+func (s *Server) fh(hp hpath) []byte {
+	id := fileid(hp)
+	s.mu.Lock()
+	if _, ok := s.handles[id]; !ok {
+		if len(s.handles) >= maxHandles {
+			clear(s.handles) // (clients will re-lookup upon NFS3ERR_STALE)
+		}
+		s.handles[id] = hp
+	}
+	s.mu.Unlock()
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], id)
+	return b[:]
+}
+
+func (s *Server) resolve(fh []byte) (hp hpath, status uint32) {
+	if len(fh) != 8 {
+		return hp, nfs3ErrBadHandle
+	}
+	id := binary.BigEndian.Uint64(fh)
+	s.mu.RLock()
+	hp, ok := s.handles[id]
+	s.mu.RUnlock()
+	if ok {
+		return hp, nfs3OK
+	}
+	// export root (always valid)
+	for _, bck := range s.fs.Exports() {
+		if root := (hpath{bck: bck}); fileid(root) == id {
+			s.fh(root)
+			return root, nfs3OK
+		}
+	}
+	return hp, nfs3ErrStale
+}
+
+func (hp hpath) child(name string) hpath {
+	if hp.name == "" {
+		return hpath{bck: hp.bck, name: name}
+	}
+	return hpath{bck: hp.bck, name: hp.name + "/" + name}
+}
+
+func (hp hpath) parent() hpath {
+	if i := strings.LastIndexByte(hp.name, '/'); i >= 0 {
+		return hpath{bck: hp.bck, name: hp.name[:i]}
+	}
+	return hpath{bck: hp.bck}
+}
+
+// export path: "/<bucket>[/<virtual directory>]"
+func (s *Server) export(dirpath string) (hp hpath, ok bool) {
+	dirpath = strings.Trim(path.Clean("/"+dirpath), "/")
+	bck, name, _ := strings.Cut(dirpath, "/")
+	for _, b := range s.fs.Exports() {
+		if b == bck {
+			return hpath{bck: bck, name: name}, true
+		}
+	}
+	return hp, false
+}
+
+///////////
+// attrs //
+///////////
+
+func (s *Server) stat(hp hpath) (*Attr, error) {
+	if hp.name == "" {
+		return &Attr{Dir: true, Mtime: s.born}, nil
+	}
+	s.mu.RLock()
+	wb := s.wbufs[fileid(hp)]
+	mtime, isdir := s.mkdirs[hp]
+	s.mu.RUnlock()
+	if wb != nil {
+		wb.mu.Lock()
+		a := &Attr{Size: wb.size, Mtime: wb.mtime}
+		valid := wb.fh != nil
+		wb.mu.Unlock()
+		if valid {
+			return a, nil
+		}
+	}
+	a, err := s.fs.Stat(hp.bck, hp.name)
+	if err == ErrNotFound && isdir {
+		return &Attr{Dir: true, Mtime: mtime}, nil
+	}
+	return a, err
+}
+
+//////////
+// wbuf //
+//////////
+
+// get or create write buffer and return it locked;
+// `trunc` to (re)create empty file (CREATE, SETATTR size=0)
+func (s *Server) lockWbuf(hp hpath, trunc bool) (*wbuf, error) {
+	id := fileid(hp)
+	s.mu.Lock()
+	wb, ok := s.wbufs[id]
+	if !ok {
+		fh, err := os.CreateTemp("", "ais-nfs-")
+		if err != nil {
+			s.mu.Unlock()
+			return nil, err
+		}
+		wb = &wbuf{fh: fh, hp: hp, mtime: time.Now().UnixNano()}
+		s.wbufs[id] = wb
+		wb.mu.Lock() // (before anyone else)
+	}
+	s.mu.Unlock()
+
+	if ok {
+		wb.mu.Lock()
+		if wb.fh == nil { // (dropped in the meantime)
+			wb.mu.Unlock()
+			return s.lockWbuf(hp, trunc)
+		}
+	}
+	wb.last = mono.NanoTime()
+	switch {
+	case trunc:
+		if err := wb.fh.Truncate(0); err != nil {
+			wb.mu.Unlock()
+			return nil, err
+		}
+		wb.size, wb.dirty, wb.mtime = 0, true, time.Now().UnixNano()
+	case !ok:
+		// modifying existing object: load its content first
+		if err := s.load(wb); err != nil && err != ErrNotFound {
+			wb.mu.Unlock()
+			s.dropWbuf(wb, true)
+			return nil, err
+		}
+	}
+	return wb, nil
+}
+
+func (s *Server) load(wb *wbuf) error {
+	a, err := s.fs.Stat(wb.hp.bck, wb.hp.name)
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, min(a.Size, maxIOSize))
+	for off := int64(0); off < a.Size; {
+		n, err := s.fs.Read(wb.hp.bck, wb.hp.name, off, buf[:min(int64(len(buf)), a.Size-off)])
+		if n > 0 {
+			if _, erw := wb.fh.WriteAt(buf[:n], off); erw != nil {
+				return erw
+			}
+			off += int64(n)
+		}
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if n == 0 {
+			break
+		}
+	}
+	wb.size, wb.mtime = a.Size, a.Mtime
+	return nil
+}
+
+// under wb lock
+func (s *Server) flush(wb *wbuf) error {
+	if err := s.fs.Write(wb.hp.bck, wb.hp.name, io.NewSectionReader(wb.fh, 0, wb.size), wb.size); err != nil {
+		return err
+	}
+	wb.dirty = false
+	return nil
+}
+
+// lock order: s.mu, wb.mu
+func (s *Server) dropWbuf(wb *wbuf, force bool) {
+	s.mu.Lock()
+	wb.mu.Lock()
+	if !wb.dirty || force {
+		if s.wbufs[fileid(wb.hp)] == wb {
+			delete(s.wbufs, fileid(wb.hp))
+		}
+		name := wb.fh.Name()
+		wb.fh.Close()
+		os.Remove(name)
+		wb.fh = nil
+	}
+	wb.mu.Unlock()
+	s.mu.Unlock()
+}
@@ -0,0 +1,251 @@
+// Package nfs provides embedded NFSv3 gateway (RFC 1813) that exposes ais:// buckets as exports
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package nfs
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// in-memory backend
+type memFS struct {
+	objs map[string][]byte // bck/objName => content
+	mu   sync.Mutex
+}
+
+func (*memFS) Exports() []string { return []string{"bck"} }
+func (*memFS) Writable() bool    { return true }
+
+func (m *memFS) Stat(bck, objName string) (*Attr, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if b, ok := m.objs[bck+"/"+objName]; ok {
+		return &Attr{Size: int64(len(b))}, nil
+	}
+	for name := range m.objs {
+		if strings.HasPrefix(name, bck+"/"+objName+"/") {
+			return &Attr{Dir: true}, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (m *memFS) List(bck, dir string) (ents []DirEntry, _ error) {
+	prefix := bck + "/"
+	if dir != "" {
+		prefix += dir + "/"
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	seen := map[string]bool{}
+	for name, b := range m.objs {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		name = strings.TrimPrefix(name, prefix)
+		child, _, isdir := strings.Cut(name, "/")
+		if !seen[child] {
+			seen[child] = true
+			ents = append(ents, DirEntry{Name: child, Attr: Attr{Size: int64(len(b)), Dir: isdir}})
+		}
+	}
+	return ents, nil
+}
+
+func (m *memFS) Read(bck, objName string, off int64, b []byte) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	data, ok := m.objs[bck+"/"+objName]
+	if !ok {
+		return 0, ErrNotFound
+	}
+	if off >= int64(len(data)) {
+		return 0, io.EOF
+	}
+	return copy(b, data[off:]), nil
+}
+
+func (m *memFS) Write(bck, objName string, r io.Reader, _ int64) error {
+	b, err := io.ReadAll(r)
+	if err == nil {
+		m.mu.Lock()
+		m.objs[bck+"/"+objName] = b
+		m.mu.Unlock()
+	}
+	return err
+}
+
+func (m *memFS) Remove(bck, objName string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.objs[bck+"/"+objName]; !ok {
+		return ErrNotFound
+	}
+	delete(m.objs, bck+"/"+objName)
+	return nil
+}
+
+// RPC client
+type tclient struct {
+	t   *testing.T
+	c   net.Conn
+	br  *bufio.Reader
+	xid uint32
+}
+
+func (tc *tclient) call(prog, proc uint32, args func(w *xdrw)) *xdrr {
+	tc.xid++
+	w := &xdrw{b: make([]byte, 4)}
+	w.u32(tc.xid)
+	w.u32(msgCall)
+	w.u32(rpcVers)
+	w.u32(prog)
+	w.u32(3)
+	w.u32(proc)
+	w.u32(authNone) // cred
+	w.opaque(nil)
+	w.u32(authNone) // verf
+	w.opaque(nil)
+	if args != nil {
+		args(w)
+	}
+	binary.BigEndian.PutUint32(w.b, uint32(len(w.b)-4)|lastFragment)
+	if _, err := tc.c.Write(w.b); err != nil {
+		tc.t.Fatal(err)
+	}
+	rec, err := readRecord(tc.br)
+	if err != nil {
+		tc.t.Fatal(err)
+	}
+	r := &xdrr{b: rec}
+	if xid := r.u32(); xid != tc.xid {
+		tc.t.Fatalf("xid: expected %d, got %d", tc.xid, xid)
+	}
+	if r.u32() != msgReply || r.u32() != replyAccepted {
+		tc.t.Fatal("expected accepted reply")
+	}
+	r.u32() // verf
+	r.opaque(maxAuth)
+	if stat := r.u32(); stat != acceptSuccess {
+		tc.t.Fatalf("expected success, got accept_stat %d", stat)
+	}
+	return r
+}
+
+func TestNFS(t *testing.T) {
+	var (
+		fs = &memFS{objs: map[string][]byte{"bck/a.txt": []byte("hello"), "bck/dir/b.txt": []byte("world")}}
+		s  = NewServer(fs)
+	)
+	c1, c2 := net.Pipe()
+	go s.serveConn(c2)
+	defer func() {
+		c1.Close()
+		s.Close()
+	}()
+	tc := &tclient{t: t, c: c1, br: bufio.NewReader(c1)}
+
+	// MNT
+	r := tc.call(progMount, mountMnt, func(w *xdrw) { w.str("/bck") })
+	if status := r.u32(); status != mnt3OK {
+		t.Fatalf("MNT: status %d", status)
+	}
+	root := r.opaque(64)
+
+	// LOOKUP + READ
+	lookup := func(dir []byte, name string) (uint32, []byte) {
+		r := tc.call(progNFS, procLookup, func(w *xdrw) { w.opaque(dir); w.str(name) })
+		status := r.u32()
+		if status != nfs3OK {
+			return status, nil
+		}
+		return status, r.opaque(64)
+	}
+	if status, _ := lookup(root, "none"); status != nfs3ErrNoEnt {
+		t.Fatalf("LOOKUP: expected NOENT, got %d", status)
+	}
+	_, dir := lookup(root, "dir")
+	status, fh := lookup(dir, "b.txt")
+	if status != nfs3OK {
+		t.Fatalf("LOOKUP: status %d", status)
+	}
+	read := func(fh []byte) string {
+		r := tc.call(progNFS, procRead, func(w *xdrw) { w.opaque(fh); w.u64(0); w.u32(1024) })
+		if status := r.u32(); status != nfs3OK {
+			t.Fatalf("READ: status %d", status)
+		}
+		if r.bool() { // (attrs)
+			r.fixed(fattrSize)
+		}
+		r.u32()
+		if !r.bool() {
+			t.Fatal("READ: expected eof")
+		}
+		return string(r.opaque(maxIOSize))
+	}
+	if got := read(fh); got != "world" {
+		t.Fatalf("READ: expected %q, got %q", "world", got)
+	}
+
+	// CREATE + WRITE (unstable) + COMMIT
+	r = tc.call(progNFS, procCreate, func(w *xdrw) {
+		w.opaque(dir)
+		w.str("c.txt")
+		w.u32(createGuarded)
+		for range 6 {
+			w.u32(0) // (empty sattr3)
+		}
+	})
+	if status := r.u32(); status != nfs3OK {
+		t.Fatalf("CREATE: status %d", status)
+	}
+	r.bool()
+	fh = r.opaque(64)
+	r = tc.call(progNFS, procWrite, func(w *xdrw) { w.opaque(fh); w.u64(0); w.u32(3); w.u32(writeUnstable); w.opaque([]byte("abc")) })
+	if status := r.u32(); status != nfs3OK {
+		t.Fatalf("WRITE: status %d", status)
+	}
+	if got := read(fh); got != "abc" {
+		t.Fatalf("READ (buffered): expected %q, got %q", "abc", got)
+	}
+	if _, err := fs.Stat("bck", "dir/c.txt"); err != ErrNotFound {
+		t.Fatal("expected no object prior to COMMIT")
+	}
+	r = tc.call(progNFS, procCommit, func(w *xdrw) { w.opaque(fh); w.u64(0); w.u32(0) })
+	if status := r.u32(); status != nfs3OK {
+		t.Fatalf("COMMIT: status %d", status)
+	}
+	if a, err := fs.Stat("bck", "dir/c.txt"); err != nil || a.Size != 3 {
+		t.Fatalf("expected 3-byte object upon COMMIT, got %+v, %v", a, err)
+	}
+
+	// READDIR
+	r = tc.call(progNFS, procReaddir, func(w *xdrw) { w.opaque(dir); w.u64(0); w.u64(0); w.u32(4096) })
+	if status := r.u32(); status != nfs3OK {
+		t.Fatalf("READDIR: status %d", status)
+	}
+	r.bool()
+	r.u64()
+	names := map[string]bool{}
+	for r.bool() {
+		r.u64()
+		names[r.str(maxName)] = true
+		r.u64()
+	}
+	if !r.bool() || len(names) != 2 || !names["b.txt"] || !names["c.txt"] {
+		t.Fatalf("READDIR: unexpected %v", names)
+	}
+
+	// unknown handle
+	r = tc.call(progNFS, procGetattr, func(w *xdrw) { w.opaque([]byte{1, 2, 3, 4, 5, 6, 7, 8}) })
+	if status := r.u32(); status != nfs3ErrStale {
+		t.Fatalf("GETATTR: expected STALE, got %d", status)
+	}
+}
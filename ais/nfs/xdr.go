@@ -0,0 +1,109 @@
+// Package nfs provides embedded NFSv3 gateway (RFC 1813) that exposes ais:// buckets as exports
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package nfs
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// XDR (RFC 4506): big-endian, 4-byte aligned
+
+var errXDR = errors.New("xdr: short or malformed message")
+
+type (
+	xdrr struct {
+		b   []byte
+		off int
+		err error
+	}
+	xdrw struct {
+		b []byte
+	}
+)
+
+func pad4(n int) int { return (4 - n&3) & 3 }
+
+//////////
+// xdrr //
+//////////
+
+func (r *xdrr) next(n int) []byte {
+	if r.err != nil || n < 0 || r.off+n > len(r.b) {
+		r.err = errXDR
+		return nil
+	}
+	b := r.b[r.off : r.off+n]
+	r.off += n
+	return b
+}
+
+func (r *xdrr) u32() uint32 {
+	if b := r.next(4); b != nil {
+		return binary.BigEndian.Uint32(b)
+	}
+	return 0
+}
+
+func (r *xdrr) u64() uint64 {
+	if b := r.next(8); b != nil {
+		return binary.BigEndian.Uint64(b)
+	}
+	return 0
+}
+
+func (r *xdrr) bool() bool { return r.u32() != 0 }
+
+func (r *xdrr) fixed(n int) []byte {
+	b := r.next(n)
+	r.next(pad4(n))
+	return b
+}
+
+func (r *xdrr) opaque(maxLen int) []byte {
+	n := int(r.u32())
+	if n > maxLen {
+		r.err = errXDR
+		return nil
+	}
+	return r.fixed(n)
+}
+
+func (r *xdrr) str(maxLen int) string { return string(r.opaque(maxLen)) }
+
+//////////
+// xdrw //
+//////////
+
+func (w *xdrw) u32(v uint32) { w.b = binary.BigEndian.AppendUint32(w.b, v) }
+func (w *xdrw) u64(v uint64) { w.b = binary.BigEndian.AppendUint64(w.b, v) }
+
+func (w *xdrw) bool(v bool) {
+	if v {
+		w.u32(1)
+	} else {
+		w.u32(0)
+	}
+}
+
+func (w *xdrw) fixed(b []byte) {
+	w.b = append(w.b, b...)
+	for range pad4(len(b)) {
+		w.b = append(w.b, 0)
+	}
+}
+
+func (w *xdrw) opaque(b []byte) {
+	w.u32(uint32(len(b)))
+	w.fixed(b)
+}
+
+func (w *xdrw) str(s string) {
+	w.u32(uint32(len(s)))
+	w.b = append(w.b, s...)
+	for range pad4(len(s)) {
+		w.b = append(w.b, 0)
+	}
+}
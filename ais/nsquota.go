@@ -0,0 +1,200 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	ratomic "sync/atomic"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
+)
+
+// Namespace quotas (cmn.NsQuotaConf) - limits on ais:// buckets in any given namespace:
+// - number of buckets: enforced by the primary when adding buckets to BMD
+//   (create, clone, rename, and copy/transform to a new destination bucket)
+// - total size: every ns_quota.interval each proxy collects targets' sizes on disk
+//   (what=ns_usage) of the namespaces that have their size limited; the proxy then
+//   rejects PUTs and APPENDs into namespaces that are (or would become) over quota
+// - the size is, therefore, approximate: in-flight and not yet accounted for writes may
+//   exceed the limit by up to (interval x write throughput)
+// - targets cache computed sizes for half the interval (proxies refresh independently)
+// - see also GET /v1/cluster?what=ns_usage
+
+const nsquotaIdleIval = time.Minute // (disabled or no size limits)
+
+type (
+	// proxy
+	nsquota struct {
+		p     *proxy
+		sizes ratomic.Pointer[cmn.NsSizes] // cluster-wide; nil when not refreshed
+	}
+	// target
+	tnsquota struct {
+		sizes cmn.NsSizes
+		ts    int64 // mono time of the last computation
+		mu    sync.Mutex
+	}
+)
+
+func (nq *nsquota) init(p *proxy) {
+	nq.p = p
+	hk.Reg("ns-quota"+hk.NameSuffix, nq.housekeep, nsquotaIdleIval)
+}
+
+func (nq *nsquota) housekeep() time.Duration {
+	config := cmn.GCO.Get()
+	if !config.NsQuota.Enabled || !config.NsQuota.HasMaxSize() || !nq.p.ClusterStarted() {
+		nq.sizes.Store(nil)
+		return nsquotaIdleIval
+	}
+	nq.refresh(config)
+	return config.NsQuota.Interval.D()
+}
+
+func (nq *nsquota) refresh(config *cmn.Config) {
+	var (
+		p     = nq.p
+		sizes = make(cmn.NsSizes, 4)
+	)
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: apc.URLPathDae.S, Query: url.Values{apc.QparamWhat: []string{apc.WhatNsUsage}}}
+	args.to = core.Targets
+	args.timeout = config.NsQuota.Interval.D() / 2
+	args.cresv = cresNU{} // -> cmn.NsSizes
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	for _, res := range results {
+		if res.err != nil {
+			nlog.Warningln(p.String()+": failed to get namespace sizes from", res.si.StringEx()+":", res.err)
+			continue
+		}
+		for uname, size := range *res.v.(*cmn.NsSizes) {
+			sizes[uname] += size
+		}
+	}
+	freeBcastRes(results)
+	nq.sizes.Store(&sizes)
+}
+
+// (PUT and APPEND datapath)
+func (nq *nsquota) checkSize(bck *meta.Bck, size int64) error {
+	if !bck.IsAIS() {
+		return nil
+	}
+	config := cmn.GCO.Get()
+	if !config.NsQuota.Enabled {
+		return nil
+	}
+	_, maxSize := config.NsQuota.Limits(&bck.Ns)
+	if maxSize == 0 {
+		return nil
+	}
+	sizes := nq.sizes.Load()
+	if sizes == nil {
+		return nil // (not yet known)
+	}
+	if used := int64((*sizes)[bck.Ns.Uname()]); used+max(size, 0) > maxSize {
+		return cmn.NewErrNsQuotaSize(&bck.Ns, maxSize)
+	}
+	return nil
+}
+
+// (primary) adding new bucket to a given BMD
+func nsquotaBuckets(bmd *bucketMD, bck *meta.Bck) error {
+	if !bck.IsAIS() {
+		return nil
+	}
+	config := cmn.GCO.Get()
+	if !config.NsQuota.Enabled {
+		return nil
+	}
+	maxBuckets, _ := config.NsQuota.Limits(&bck.Ns)
+	if maxBuckets == 0 {
+		return nil
+	}
+	var (
+		provider = apc.AIS
+		n        int
+	)
+	bmd.Range(&provider, &bck.Ns, func(*meta.Bck) bool {
+		n++
+		return false
+	})
+	if n >= maxBuckets {
+		return cmn.NewErrNsQuotaBuckets(&bck.Ns, maxBuckets)
+	}
+	return nil
+}
+
+// GET /v1/cluster?what=ns_usage
+func (p *proxy) qcluNsUsage(w http.ResponseWriter, r *http.Request, what string) {
+	var (
+		config   = cmn.GCO.Get()
+		bmd      = p.owner.bmd.get()
+		sizes    = p.nsq.sizes.Load()
+		provider = apc.AIS
+		m        = make(map[string]*cmn.NsUsage, 4)
+	)
+	bmd.Range(&provider, nil, func(bck *meta.Bck) bool {
+		uname := bck.Ns.Uname()
+		u, ok := m[uname]
+		if !ok {
+			u = &cmn.NsUsage{Ns: uname}
+			if config.NsQuota.Enabled {
+				u.MaxBuckets, u.MaxSize = config.NsQuota.Limits(&bck.Ns)
+			}
+			if sizes != nil {
+				u.Size = (*sizes)[uname]
+			}
+			m[uname] = u
+		}
+		u.Buckets++
+		return false
+	})
+	out := make(cmn.NsUsageList, 0, len(m))
+	for _, u := range m {
+		out = append(out, u)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Ns < out[j].Ns })
+	p.writeJSON(w, r, out, what)
+}
+
+////////////
+// target //
+////////////
+
+// sizes on disk of the namespaces that have their size limited
+func (tq *tnsquota) get(bmd *bucketMD, config *cmn.Config) cmn.NsSizes {
+	tq.mu.Lock()
+	defer tq.mu.Unlock()
+	if tq.sizes != nil && mono.Since(tq.ts) < config.NsQuota.Interval.D()/2 {
+		return tq.sizes
+	}
+	var (
+		provider = apc.AIS
+		sizes    = make(cmn.NsSizes, 4)
+	)
+	if config.NsQuota.Enabled {
+		bmd.Range(&provider, nil, func(bck *meta.Bck) bool {
+			if _, maxSize := config.NsQuota.Limits(&bck.Ns); maxSize > 0 {
+				sizes[bck.Ns.Uname()] += fs.OnDiskSize(bck.Bucket(), "")
+			}
+			return false
+		})
+	}
+	tq.sizes, tq.ts = sizes, mono.NanoTime()
+	return sizes
+}
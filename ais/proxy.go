@@ -7,6 +7,7 @@ package ais
 import (
 	"errors"
 	"fmt"
+	"math/rand/v2"
 	"net"
 	"net/http"
 	"net/url"
@@ -61,6 +62,13 @@ type (
 		rproxy     reverseProxy
 		notifs     notifs
 		lstca      lstca
+		warm       warmRestarts
+		cdrift     cdrift
+		lreqs      lreqs
+		tquar      tquar
+		nfsgw      nfsgw
+		nsq        nsquota
+		ovl        povl
 		reg        struct {
 			pool nodeRegPool
 			mu   sync.RWMutex
@@ -98,10 +106,13 @@ func (p *proxy) init(config *cmn.Config) {
 	// (c) generate a new one (genDaemonID())
 	// - in that sequence
 	p.si.Init(initPID(config), apc.Proxy)
+	p.vote.load(filepath.Join(config.ConfigDir, fname.VoteTerm))
 
 	memsys.Init(p.SID(), p.SID(), config)
 
 	cos.InitShortID(p.si.Digest())
+	g.genReqID = true
+	g.xlatAPI = true
 
 	p.initClusterCIDR()
 	daemon.rg.add(p)
@@ -198,8 +209,15 @@ func (p *proxy) Run() error {
 	p.rproxy.init()
 
 	p.notifs.init(p)
+	p.warm.init(p)
+	p.cdrift.init(p)
+	p.lreqs.init(p)
+	p.tquar.init(p)
+	p.nfsgw.init(p)
+	p.nsq.init(p)
 	p.ic.init(p)
 	p.qm.init()
+	p.trashInit()
 
 	//
 	// REST API: register proxy handlers and start listening
@@ -222,6 +240,7 @@ func (p *proxy) Run() error {
 		{r: apc.Metasync, h: p.metasyncHandler, net: accessNetIntraControl},
 		{r: apc.Health, h: p.healthHandler, net: accessNetPublicControl},
 		{r: apc.Vote, h: p.voteHandler, net: accessNetIntraControl},
+		{r: apc.Gossip, h: p.gossipHandler, net: accessNetIntraControl},
 
 		{r: apc.Notifs, h: p.notifs.handler, net: accessNetIntraControl},
 
@@ -603,6 +622,14 @@ func (p *proxy) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 	if len(apiItems) > 0 {
 		bckName = apiItems[0]
 	}
+	if q := r.URL.Query(); q.Has(apc.QparamAction) {
+		if q.Get(apc.QparamAction) == apc.BckChanges {
+			p.bckChanges(w, r, bckName, q)
+		} else {
+			p.bckExpImp(w, r, bckName, q)
+		}
+		return
+	}
 	ctype := r.Header.Get(cos.HdrContentType)
 	if r.ContentLength == 0 && !strings.HasPrefix(ctype, cos.ContentJSON) {
 		// e.g. "easy URL" request: curl -L -X GET 'http://aistore/ais/abc'
@@ -614,6 +641,18 @@ func (p *proxy) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 		p.writeErr(w, r, err)
 		return
 	}
+	var providers []string
+	if strings.Contains(dpq.bck.provider, apc.ProviderSep) {
+		if providers, err = parseProviders(dpq.bck.provider); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		if msg.Action != apc.ActList || msg.Value != nil || dpq.federated {
+			p.writeErrf(w, r, "multi-provider query %q is supported only by (non-federated) list-buckets", dpq.bck.provider)
+			return
+		}
+		dpq.bck.provider = ""
+	}
 	if qbck, err = newQbckFromQ(bckName, nil, dpq); err != nil {
 		p.writeErr(w, r, err)
 		return
@@ -657,20 +696,25 @@ func (p *proxy) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 		if qbck.IsRemoteAIS() {
 			qbck.Ns.UUID = p.a2u(qbck.Ns.UUID)
 		}
-		if err := p.checkAccess(w, r, nil, apc.AceListBuckets); err == nil {
+		if err := p.checkAccess(w, r, nil, apc.AceListBuckets); err != nil {
+			return
+		}
+		switch {
+		case len(providers) > 0:
+			p.listBucketsMulti(w, r, qbck, providers, dpq)
+		case dpq.federated:
+			p.fedListBuckets(w, r, qbck)
+		default:
 			p.listBuckets(w, r, qbck, msg, dpq)
 		}
 		return
 	}
 
-	// (IV) list objects (NOTE -- TODO: currently, always forwarding)
+	// (IV) list objects
 	if !qbck.IsBucket() {
 		p.writeErrf(w, r, "bad list-objects request: %q is not a bucket (is a bucket query?)", qbck)
 		return
 	}
-	if p.forwardCP(w, r, msg, lsotag+" "+qbck.String()) {
-		return
-	}
 
 	// lsmsg
 	var (
@@ -681,6 +725,20 @@ func (p *proxy) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
 		return
 	}
+	if lsmsg.IsFlagSet(apc.LsFederated) {
+		if err := fedPrep(bck, &lsmsg); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+	}
+	// to the IC member that owns (or will own) this listing's buffers
+	if p.lsoReverse(w, r, msg, &lsmsg) {
+		return
+	}
+	if lsmsg.IsFlagSet(apc.LsFederated) {
+		p.lsoFederated(w, r, bck, msg, &lsmsg, dpq)
+		return
+	}
 	if lsmsg.Prefix != "" && strings.Contains(lsmsg.Prefix, "../") {
 		p.writeErrf(w, r, "bad list-objects request: invalid prefix %q", lsmsg.Prefix)
 		return
@@ -741,16 +799,47 @@ func (p *proxy) httpobjget(w http.ResponseWriter, r *http.Request, origURLBck ..
 		p.writeErr(w, r, err)
 		return
 	}
+	if bck.Props.Pin.Enabled {
+		if psi := pinnedTarget(r, bck, smap); psi != nil {
+			tsi, netPub = psi, cmn.NetPublic
+		}
+	} else if p.tquar.has(tsi.ID()) {
+		if asi := p.tquar.alt(bck, objName, smap); asi != nil {
+			tsi, netPub = asi, cmn.NetPublic
+		}
+	}
 	if cmn.Rom.FastV(5, cos.SmoduleAIS) {
 		nlog.Infoln("GET " + bck.Cname(objName) + " => " + tsi.String())
 	}
 	redirectURL := p.redirectURL(r, tsi, time.Now() /*started*/, cmn.NetIntraData, netPub)
-	http.Redirect(w, r, redirectURL, http.StatusMovedPermanently)
+	p.redirect(w, r, tsi, redirectURL, http.StatusMovedPermanently)
 
 	// 4. stats
 	p.statsT.Inc(stats.GetCount)
 }
 
+// pinned bucket: bypass HRW and read from the pinned target co-located with the client, if any,
+// or else from any pinned target (see cmn.PinConf)
+func pinnedTarget(r *http.Request, bck *meta.Bck, smap *smapX) *meta.Snode {
+	var (
+		tsis       = make([]*meta.Snode, 0, len(smap.Tmap))
+		host, _, _ = net.SplitHostPort(r.RemoteAddr)
+	)
+	for _, tsi := range smap.Tmap {
+		if tsi.InMaintOrDecomm() || !bck.Props.Pin.Has(tsi.ID()) {
+			continue
+		}
+		if host != "" && tsi.PubNet.Hostname == host {
+			return tsi
+		}
+		tsis = append(tsis, tsi)
+	}
+	if len(tsis) == 0 {
+		return nil
+	}
+	return tsis[rand.IntN(len(tsis))]
+}
+
 // PUT /v1/objects/bucket-name/object-name
 func (p *proxy) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiRequest) {
 	var (
@@ -791,6 +880,20 @@ func (p *proxy) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiRe
 	if err != nil {
 		return
 	}
+	if !appendTyProvided && apireq.dpq.arch.path == "" && r.Header.Get(cos.HdrContentRange) == "" {
+		if ecode, err := checkPayload(r, bck, apireq.items[1]); err != nil {
+			p.writeErr(w, r, err, ecode)
+			return
+		}
+	}
+	if err := checkNaming(bck, apireq.items[1]); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if err := p.nsq.checkSize(bck, r.ContentLength); err != nil {
+		p.writeErr(w, r, err, http.StatusInsufficientStorage)
+		return
+	}
 
 	// 3. redirect
 	var (
@@ -827,7 +930,7 @@ func (p *proxy) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiRe
 	}
 
 	redirectURL := p.redirectURL(r, tsi, started, cmn.NetIntraData, netPub)
-	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+	p.redirect(w, r, tsi, redirectURL, http.StatusTemporaryRedirect)
 
 	// 4. stats
 	if !appendTyProvided {
@@ -861,7 +964,7 @@ func (p *proxy) httpobjdelete(w http.ResponseWriter, r *http.Request) {
 		nlog.Infoln("DELETE " + bck.Cname(objName) + " => " + tsi.StringEx())
 	}
 	redirectURL := p.redirectURL(r, tsi, time.Now() /*started*/, cmn.NetIntraControl)
-	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+	p.redirect(w, r, tsi, redirectURL, http.StatusTemporaryRedirect)
 
 	p.statsT.Inc(stats.DeleteCount)
 }
@@ -934,7 +1037,12 @@ func (p *proxy) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *ap
 			p.reverseRemAis(w, r, msg, bck.Bucket(), apireq.query)
 			return
 		}
-		if err := p.destroyBucket(msg, bck); err != nil {
+		if trashable(bck, cmn.GCO.Get()) {
+			err = p.trashBucket(msg, bck)
+		} else {
+			err = p.destroyBucket(msg, bck)
+		}
+		if err != nil {
 			if cmn.IsErrBckNotFound(err) {
 				nlog.Infof("%s: %s already %q-ed, nothing to do", p, bck, msg.Action)
 			} else {
@@ -1059,15 +1167,17 @@ func (p *proxy) healthHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	p.uptime2hdr(w.Header())
+	g.certs.expiry2hdr(w.Header())
 
 	var (
-		prr, getCii, askPrimary bool
+		prr, getCii, askPrimary, askLoad bool
 	)
 	if r.URL.RawQuery != "" {
 		query := r.URL.Query()
 		prr = cos.IsParseBool(query.Get(apc.QparamPrimaryReadyReb))
 		getCii = cos.IsParseBool(query.Get(apc.QparamClusterInfo))
 		askPrimary = cos.IsParseBool(query.Get(apc.QparamAskPrimary))
+		askLoad = cos.IsParseBool(query.Get(apc.QparamPrimaryLoad))
 	}
 
 	if !prr {
@@ -1110,6 +1220,10 @@ func (p *proxy) healthHandler(w http.ResponseWriter, r *http.Request) {
 				return
 			}
 		}
+		if askLoad {
+			p.writeLoad(w, r)
+			return
+		}
 		w.WriteHeader(http.StatusOK)
 		return
 	}
@@ -1119,7 +1233,7 @@ func (p *proxy) healthHandler(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusServiceUnavailable)
 		return
 	}
-	if prr || askPrimary {
+	if prr || askPrimary || askLoad {
 		caller := r.Header.Get(apc.HdrCallerName)
 		p.writeErrf(w, r, "%s (non-primary): misdirected health-of-primary request from %s, %s",
 			p, caller, smap.StringEx())
@@ -1139,6 +1253,10 @@ func (p *proxy) httpbckput(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	bucket := apiItems[0]
+	if query.Has(apc.QparamAction) {
+		p.bckExpImp(w, r, bucket, query)
+		return
+	}
 	bck, err := newBckFromQ(bucket, query, nil)
 	if err != nil {
 		p.writeErr(w, r, err)
@@ -1234,6 +1352,14 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 		p._bcr(w, r, query, msg, bck)
 		return
 	}
+	if msg.Action == apc.ActUndeleteBck {
+		p.undeleteBucket(w, r, msg, bck)
+		return
+	}
+	if msg.Action == apc.ActFreezeBck || msg.Action == apc.ActThawBck {
+		p.freezeBck(w, r, msg, bck, query)
+		return
+	}
 
 	// only the primary can do metasync
 	dtor := xact.Table[msg.Action]
@@ -1254,6 +1380,12 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 	//
 	var xid string
 	switch msg.Action {
+	case apc.ActDiffBck:
+		p.diffBck(w, r, bck, msg, query)
+		return
+	case apc.ActSampleBck:
+		p.sampleBck(w, r, bck, msg)
+		return
 	case apc.ActMoveBck:
 		bckFrom := bck
 		bckTo, err := newBckFromQuname(query, true /*required*/)
@@ -1261,8 +1393,10 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			p.writeErr(w, r, err)
 			return
 		}
-		if !bckFrom.IsAIS() && bckFrom.Backend() == nil {
-			p.writeErrf(w, r, "can only rename AIS ('ais://') bucket (%q is not)", bckFrom)
+		// cloud bucket: rename its in-cluster (cached) content and keep the cloud binding
+		// via ais bucket with the original as its backend (see bmodMv)
+		if !bckFrom.IsAIS() && bckFrom.Backend() == nil && !bckFrom.IsCloud() {
+			p.writeErrf(w, r, "can only rename AIS ('ais://') or cloud bucket (%q is neither)", bckFrom)
 			return
 		}
 		if bckTo.IsRemote() {
@@ -1273,7 +1407,10 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			p.writeErrf(w, r, "cannot rename bucket %q to itself (%q)", bckFrom, bckTo)
 			return
 		}
-		bckFrom.Provider, bckTo.Provider = apc.AIS, apc.AIS
+		if !bckFrom.IsCloud() {
+			bckFrom.Provider = apc.AIS
+		}
+		bckTo.Provider = apc.AIS
 		if _, present := p.owner.bmd.get().Get(bckTo); present {
 			err := cmn.NewErrBckAlreadyExists(bckTo.Bucket())
 			p.writeErr(w, r, err)
@@ -1310,6 +1447,10 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 				p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
 				return
 			}
+			if err := tcbmsg.Validate(false); err != nil {
+				p.writeErr(w, r, err)
+				return
+			}
 		}
 		if tcbmsg.Sync && tcbmsg.Prepend != "" {
 			p.writeErrf(w, r, errPrependSync, tcbmsg.Prepend)
@@ -1411,6 +1552,41 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			p.writeErr(w, r, err)
 			return
 		}
+	case apc.ActCloneBck:
+		bckFrom := bck
+		bckTo, err := newBckFromQuname(query, true /*required*/)
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		if !bckFrom.IsAIS() {
+			p.writeErrf(w, r, "can only clone AIS ('ais://') bucket with no remote backend (%q is not)", bckFrom)
+			return
+		}
+		if bckTo.IsRemote() {
+			p.writeErrf(w, r, "can only clone to AIS ('ais://') bucket (%q is remote)", bckTo)
+			return
+		}
+		bckTo.Provider = apc.AIS
+		if bckFrom.Equal(bckTo, false, false) {
+			p.writeErrf(w, r, "cannot clone bucket %q onto itself", bckFrom)
+			return
+		}
+		if _, present := p.owner.bmd.get().Get(bckTo); present {
+			p.writeErr(w, r, cmn.NewErrBckAlreadyExists(bckTo.Bucket()))
+			return
+		}
+		if p.forwardCP(w, r, msg, bucket) {
+			return
+		}
+		if err := p.checkAccess(w, r, nil, apc.AceCreateBucket); err != nil {
+			return
+		}
+		nlog.Infoln(msg.Action, bckFrom.String(), "=>", bckTo.String())
+		if err := p.cloneBucket(bckFrom, bckTo, msg); err != nil {
+			p.writeErr(w, r, err, crerrStatus(err))
+		}
+		return
 	case apc.ActAddRemoteBck:
 		if err := p.checkAccess(w, r, nil, apc.AceCreateBucket); err != nil {
 			return
@@ -1430,7 +1606,7 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			return
 		}
 	case apc.ActInvalListCache:
-		p.qm.c.invalidate(bck.Bucket())
+		p.invalListCache(bck.Bucket())
 		return
 	case apc.ActMakeNCopies:
 		if xid, err = p.makeNCopies(msg, bck); err != nil {
@@ -1487,6 +1663,10 @@ func (p *proxy) _bcr(w http.ResponseWriter, r *http.Request, query url.Values, m
 		p.writeErr(w, r, err)
 		return
 	}
+	if bck.Ns.IsTrash() {
+		p.writeErrf(w, r, "cannot create %s: namespace %q is reserved", bck, cmn.NsTrash.Name)
+		return
+	}
 	if p.forwardCP(w, r, msg, bucket) {
 		return
 	}
@@ -1577,6 +1757,46 @@ func (p *proxy) _bcr(w http.ResponseWriter, r *http.Request, query url.Values, m
 	}
 }
 
+// GET|PUT /v1/buckets/<bck>?action=(export|import)
+// redirect to the (bucket name) HRW target that will then handle the entire tar stream - see tgtexport.go
+func (p *proxy) bckExpImp(w http.ResponseWriter, r *http.Request, bckName string, query url.Values) {
+	var (
+		perms   apc.AccessAttrs
+		started = time.Now()
+		action  = query.Get(apc.QparamAction)
+	)
+	switch {
+	case action == apc.BckExport && r.Method == http.MethodGet:
+		perms = apc.AceGET | apc.AceObjLIST
+	case action == apc.BckImport && r.Method == http.MethodPut:
+		perms = apc.AcePUT
+	default:
+		p.writeErrf(w, r, "invalid %s %s?%s=%s", r.Method, r.URL.Path, apc.QparamAction, action)
+		return
+	}
+	bck, err := newBckFromQ(bckName, query, nil)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	bckArgs := bctx{p: p, w: w, r: r, bck: bck, msg: &apc.ActMsg{Action: action}, perms: perms, query: query}
+	bckArgs.createAIS = false
+	if bck, err = bckArgs.initAndTry(); err != nil {
+		return
+	}
+	smap := p.owner.smap.get()
+	tsi, err := smap.HrwName2T(bck.MakeUname(""))
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+		nlog.Infoln(action, bck.Cname(""), "=>", tsi.StringEx())
+	}
+	redirectURL := p.redirectURL(r, tsi, started, cmn.NetIntraData)
+	p.redirect(w, r, tsi, redirectURL, http.StatusTemporaryRedirect)
+}
+
 func crerrStatus(err error) (ecode int) {
 	switch err.(type) {
 	case *cmn.ErrBucketAlreadyExists:
@@ -1589,6 +1809,10 @@ func crerrStatus(err error) (ecode int) {
 
 // one page => msgpack rsp
 func (p *proxy) listObjects(w http.ResponseWriter, r *http.Request, bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg) {
+	if lsmsg.IsFlagSet(apc.LsSummary) {
+		p.lsoSumm(w, r, bck, lsmsg)
+		return
+	}
 	// LsVerChanged a.k.a. '--check-versions' limitations
 	if lsmsg.IsFlagSet(apc.LsVerChanged) {
 		const a = "cannot perform remote versions check"
@@ -1606,6 +1830,15 @@ func (p *proxy) listObjects(w http.ResponseWriter, r *http.Request, bck *meta.Bc
 		}
 	}
 
+	// offline backend: in-cluster content only (see feat.BackendOffline)
+	if bck.IsOffline() {
+		if lsmsg.IsFlagSet(apc.LsVerChanged) {
+			p.writeErr(w, r, cmn.NewErrBackendOffline("cannot perform remote versions check", bck.Bucket()))
+			return
+		}
+		lsmsg.SetFlag(apc.LsObjCached)
+	}
+
 	// default props & flags => user-provided message
 	switch {
 	case lsmsg.Props == "":
@@ -1623,10 +1856,46 @@ func (p *proxy) listObjects(w http.ResponseWriter, r *http.Request, bck *meta.Bc
 	if bck.IsHTTP() || lsmsg.IsFlagSet(apc.LsArchDir) {
 		lsmsg.SetFlag(apc.LsObjCached)
 	}
+	if lsmsg.IsFlagSet(apc.LsSnapshot) {
+		if bck.IsRemote() && !lsmsg.IsFlagSet(apc.LsObjCached) {
+			p.writeErrf(w, r, "cannot list remote %s as a point-in-time snapshot (consider listing in-cluster objects only)",
+				bck.Cname(""))
+			return
+		}
+		// (cached pages may predate the snapshot)
+		lsmsg.ClearFlag(apc.UseListObjsCache)
+		if lsmsg.ContinuationToken == "" {
+			// cluster-wide cut: leave enough time for all targets to receive the first page
+			lsmsg.SnapTime = time.Now().Add(cmn.Rom.CplaneOperation()).UnixNano()
+		}
+	}
 
 	// do page
+	if lsmsg.UUID == "" {
+		lsmsg.UUID = cos.GenUUID()
+	}
+	lc, ecode, err := p.lreqs.begin(r, lsmsg.UUID, apc.ActList, bck.Cname(""))
+	if err != nil {
+		p.writeErr(w, r, err, ecode)
+		return
+	}
 	beg := mono.NanoTime()
+	if lsoStreamable(r, bck, lsmsg) {
+		token, ok := p.lsPageStream(w, r, bck, amsg, lsmsg)
+		p.lreqs.end(lc, !ok || token == "")
+		if ok {
+			p.statsT.AddMany(
+				cos.NamedVal64{Name: stats.ListCount, Value: 1},
+				cos.NamedVal64{Name: stats.ListLatency, Value: mono.SinceNano(beg)},
+			)
+		}
+		return
+	}
 	lst, err := p.lsPage(bck, amsg, lsmsg, r.Header, p.owner.smap.get())
+	if err == nil && lsmsg.IsFlagSet(apc.LsFederated) {
+		err = p.fedPage(bck, lsmsg, lst)
+	}
+	p.lreqs.end(lc, err != nil || lst.ContinuationToken == "")
 	if err != nil {
 		p.writeErr(w, r, err)
 		return
@@ -1656,7 +1925,6 @@ func (p *proxy) listObjects(w http.ResponseWriter, r *http.Request, bck *meta.Bc
 // one page; common code (native, s3 api)
 func (p *proxy) lsPage(bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg, hdr http.Header, smap *smapX) (*cmn.LsoRes, error) {
 	var (
-		nl             nl.Listener
 		err            error
 		tsi            *meta.Snode
 		lst            *cmn.LsoRes
@@ -1666,22 +1934,15 @@ func (p *proxy) lsPage(bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg, hdr h
 	)
 	if lsmsg.UUID == "" {
 		lsmsg.UUID = cos.GenUUID()
-		newls = true
 	}
+	// (UUID may have already been assigned by lsoReverse)
+	newls = lsmsg.ContinuationToken == ""
 	tsi, listRemote, wantOnlyRemote, err = p._lsofc(bck, lsmsg, smap)
 	if err != nil {
 		return nil, err
 	}
 	if newls {
-		if wantOnlyRemote {
-			nl = xact.NewXactNL(lsmsg.UUID, apc.ActList, &smap.Smap, meta.NodeMap{tsi.ID(): tsi}, bck.Bucket())
-		} else {
-			// bcast
-			nl = xact.NewXactNL(lsmsg.UUID, apc.ActList, &smap.Smap, nil, bck.Bucket())
-		}
-		// NOTE #2: TODO: currently, always primary - hrw redirect vs scenarios***
-		nl.SetOwner(smap.Primary.ID())
-		p.ic.registerEqual(regIC{nl: nl, smap: smap, msg: amsg})
+		p.lsoRegNL(bck, amsg, lsmsg, smap, tsi, wantOnlyRemote)
 	}
 
 	if listRemote {
@@ -1709,6 +1970,8 @@ func (p *proxy) lsPage(bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg, hdr h
 		// remote bucket exists and is offline. We should somehow try to list
 		// cached objects. This isn't easy as we basically need to start a new
 		// xaction and return a new `UUID`.
+	} else if bck.CloneOf() != nil {
+		lst, err = p.lsClone(bck, lsmsg)
 	} else {
 		lst, err = p.lsObjsA(bck, lsmsg)
 	}
@@ -1716,6 +1979,24 @@ func (p *proxy) lsPage(bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg, hdr h
 	return lst, err
 }
 
+// new listing: register notification listener with IC
+func (p *proxy) lsoRegNL(bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg, smap *smapX, tsi *meta.Snode, wantOnlyRemote bool) {
+	var nl nl.Listener
+	if wantOnlyRemote {
+		nl = xact.NewXactNL(lsmsg.UUID, apc.ActList, &smap.Smap, meta.NodeMap{tsi.ID(): tsi}, bck.Bucket())
+	} else {
+		// bcast
+		nl = xact.NewXactNL(lsmsg.UUID, apc.ActList, &smap.Smap, nil, bck.Bucket())
+	}
+	// owned by the IC member that keeps the buffers (see lsoReverse)
+	owner := p.SID()
+	if !smap.IsIC(p.si) {
+		owner = smap.Primary.ID() // (startup)
+	}
+	nl.SetOwner(owner)
+	p.ic.registerEqual(regIC{nl: nl, smap: smap, msg: amsg})
+}
+
 // list-objects flow control helper
 func (p *proxy) _lsofc(bck *meta.Bck, lsmsg *apc.LsoMsg, smap *smapX) (tsi *meta.Snode, listRemote, wantOnlyRemote bool, err error) {
 	listRemote = bck.IsRemote() && !lsmsg.IsFlagSet(apc.LsObjCached)
@@ -1770,7 +2051,7 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 	if err != nil {
 		return
 	}
-	if msg.Action == apc.ActRenameObject {
+	if msg.Action == apc.ActRenameObject || msg.Action == apc.ActLinkObj {
 		apireq.after = 2
 	}
 	if err := p.parseReq(w, r, apireq); err != nil {
@@ -1806,7 +2087,13 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 		if !p.isValidObjname(w, r, objNameTo) {
 			return
 		}
+		if err := checkNaming(bck, objNameTo); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
 		p.redirectObjAction(w, r, bck, apireq.items[1], msg)
+	case apc.ActLinkObj:
+		p.linkObj(w, r, bck, apireq.items[1], msg, apireq.query)
 	case apc.ActPromote:
 		if err := p.checkAccess(w, r, bck, apc.AcePromote); err != nil {
 			return
@@ -1834,7 +2121,7 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 				return
 			}
 		}
-		xid, err := p.promote(bck, msg, tsi)
+		xid, err := p.promote(r, bck, msg, tsi)
 		if err != nil {
 			p.writeErr(w, r, err)
 			return
@@ -2042,7 +2329,7 @@ func (p *proxy) httpbckpatch(w http.ResponseWriter, r *http.Request, apireq *api
 			return
 		}
 	}
-	if xid, err = p.setBprops(msg, bck, nprops); err != nil {
+	if xid, err = p.setBprops(r, msg, bck, nprops); err != nil {
 		p.writeErr(w, r, err)
 		return
 	}
@@ -2076,7 +2363,7 @@ func (p *proxy) httpobjhead(w http.ResponseWriter, r *http.Request, origURLBck .
 		nlog.Infof("%s %s => %s", r.Method, bck.Cname(objName), si.StringEx())
 	}
 	redirectURL := p.redirectURL(r, si, time.Now() /*started*/, cmn.NetIntraControl)
-	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+	p.redirect(w, r, si, redirectURL, http.StatusTemporaryRedirect)
 }
 
 // PATCH /v1/objects/bucket-name/object-name
@@ -2104,7 +2391,7 @@ func (p *proxy) httpobjpatch(w http.ResponseWriter, r *http.Request) {
 		nlog.Infof("%s %s => %s", r.Method, bck.Cname(objName), si.StringEx())
 	}
 	redirectURL := p.redirectURL(r, si, started, cmn.NetIntraControl)
-	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+	p.redirect(w, r, si, redirectURL, http.StatusTemporaryRedirect)
 }
 
 func (p *proxy) listBuckets(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryBcks, msg *apc.ActMsg, dpq *dpq) {
@@ -2200,6 +2487,14 @@ func (p *proxy) redirectURL(r *http.Request, si *meta.Snode, ts time.Time, netIn
 		apc.QparamProxyID:  []string{p.SID()},
 		apc.QparamUnixTime: []string{cos.UnixNano2S(ts.UnixNano())},
 	}
+	// request priority, to survive the redirect regardless of the client's handling of headers
+	if pri := r.Header.Get(apc.HdrPriority); pri != "" {
+		query.Set(apc.QparamPriority, pri)
+	}
+	// ditto request ID
+	if id := r.Header.Get(apc.HdrReqID); id != "" {
+		query.Set(apc.QparamReqID, id)
+	}
 	redirect += query.Encode()
 	return
 }
@@ -2257,6 +2552,7 @@ func (p *proxy) lsObjsA(bck *meta.Bck, lsmsg *apc.LsoMsg) (allEntries *cmn.LsoRe
 	args.timeout = apc.LongTimeout
 	args.smap = smap
 	args.cresv = cresLso{} // -> cmn.LsoRes
+	args.hedge = true      // same page (continuation token) can be requested again
 
 	// Combine the results.
 	results = p.bcastGroup(args)
@@ -2371,6 +2667,7 @@ func (p *proxy) lsObjsR(bck *meta.Bck, lsmsg *apc.LsoMsg, hdr http.Header, smap
 		args.timeout = timeout
 		args.smap = smap
 		args.cresv = cresLso{} // -> cmn.LsoRes
+		args.hedge = true
 		results = p.bcastGroup(args)
 	}
 
@@ -2394,6 +2691,49 @@ func (p *proxy) lsObjsR(bck *meta.Bck, lsmsg *apc.LsoMsg, hdr http.Header, smap
 	return cmn.MergeLso(resLists, lsmsg, 0), nil
 }
 
+// create link (alias) object that points to another object, possibly in a different bucket
+// (see tgtlink.go)
+func (p *proxy) linkObj(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string, msg *apc.ActMsg, query url.Values) {
+	if bck.IsRemote() {
+		p.writeErrActf(w, r, msg.Action, "not supported for remote buckets (%s)", bck)
+		return
+	}
+	bckTo, err := newBckFromQuname(query, false /*required*/)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if bckTo == nil {
+		bckTo = bck
+	} else {
+		if bckTo.IsRemoteAIS() {
+			bckTo.Ns.UUID = p.a2u(bckTo.Ns.UUID) // resolve alias upfront (compare w/ bctx.init)
+		}
+		// must be able to read what the link points to
+		bargs := bctx{p: p, w: w, r: r, bck: bckTo, perms: apc.AceGET, msg: msg, query: query}
+		if bckTo, err = bargs.initAndTry(); err != nil {
+			return
+		}
+	}
+	objNameTo := msg.Name
+	if objNameTo == "" {
+		objNameTo = objName
+	}
+	if !p.isValidObjname(w, r, objNameTo) {
+		return
+	}
+	if objNameTo == objName && bckTo.Equal(bck, true, true) {
+		p.writeErrMsg(w, r, "cannot link "+bck.Cname(objName)+" to itself")
+		return
+	}
+
+	// the target gets the resolved destination
+	q := r.URL.Query()
+	bckTo.AddUnameToQuery(q, apc.QparamBckTo)
+	r.URL.RawQuery = q.Encode()
+	p.redirectObjAction(w, r, bck, objName, msg)
+}
+
 func (p *proxy) redirectObjAction(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string, msg *apc.ActMsg) {
 	started := time.Now()
 	smap := p.owner.smap.get()
@@ -2408,7 +2748,7 @@ func (p *proxy) redirectObjAction(w http.ResponseWriter, r *http.Request, bck *m
 
 	// NOTE: Code 307 is the only way to http-redirect with the original JSON payload.
 	redirectURL := p.redirectURL(r, si, started, cmn.NetIntraControl)
-	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+	p.redirect(w, r, si, redirectURL, http.StatusTemporaryRedirect)
 
 	p.statsT.Inc(stats.RenameCount)
 }
@@ -2486,7 +2826,16 @@ func (p *proxy) reverseHandler(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
 	case http.MethodGet:
 		// must be consistent with httpdaeget, httpcluget
-		err = p.checkAccess(w, r, nil, apc.AceShowCluster)
+		if r.URL.Query().Get(apc.QparamWhat) == apc.WhatProfile {
+			if err = p.checkAccess(w, r, nil, apc.AceAdmin); err == nil {
+				// targets only serve profiles to intra-cluster callers
+				r.Header.Set(apc.HdrCallerID, p.SID())
+				r.Header.Set(apc.HdrCallerName, p.si.Name())
+				r.Header.Set(apc.HdrCallerSmapVer, smap.vstr)
+			}
+		} else {
+			err = p.checkAccess(w, r, nil, apc.AceShowCluster)
+		}
 	case http.MethodPost:
 		// (ditto) httpdaepost, httpclupost
 		err = p.checkAccess(w, r, nil, apc.AceAdmin)
@@ -2557,12 +2906,15 @@ func (p *proxy) daemonHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func (p *proxy) handlePendingRenamedLB(renamedBucket string) {
+func (p *proxy) handlePendingRenamedLB(renamedBucket, provider string) {
+	if provider == "" {
+		provider = apc.AIS
+	}
 	ctx := &bmdModifier{
 		pre:   p.bmodPostMv,
 		final: p.bmodSync,
 		msg:   &apc.ActMsg{Value: apc.ActMoveBck},
-		bcks:  []*meta.Bck{meta.NewBck(renamedBucket, apc.AIS, cmn.NsGlobal)},
+		bcks:  []*meta.Bck{meta.NewBck(renamedBucket, provider, cmn.NsGlobal)},
 	}
 	_, err := p.owner.bmd.modify(ctx)
 	debug.AssertNoErr(err)
@@ -2583,6 +2935,13 @@ func (p *proxy) bmodPostMv(ctx *bmdModifier, clone *bucketMD) error {
 		ctx.terminate = true
 		return nil
 	}
+	if bck.IsCloud() {
+		// keep the cloud bucket - it is now the backend of the renamed one
+		nprops := props.Clone()
+		nprops.Renamed = ""
+		clone.set(bck, nprops)
+		return nil
+	}
 	clone.del(bck)
 	return nil
 }
@@ -2592,18 +2951,30 @@ func (p *proxy) httpdaeget(w http.ResponseWriter, r *http.Request) {
 		query = r.URL.Query()
 		what  = query.Get(apc.QparamWhat)
 	)
+	// no permissions required (clients may query capabilities prior to anything else)
+	if what == apc.WhatCapabilities {
+		p.htrun.httpdaeget(w, r, query, nil /*htext*/)
+		return
+	}
+	if what == apc.WhatProfile {
+		if err := p.checkAccess(w, r, nil, apc.AceAdmin); err != nil {
+			return
+		}
+		p.htrun.httpdaeget(w, r, query, nil /*htext*/)
+		return
+	}
 	if err := p.checkAccess(w, r, nil, apc.AceShowCluster); err != nil {
 		return
 	}
 	switch what {
 	case apc.WhatBMD:
 		if renamedBucket := query.Get(whatRenamedLB); renamedBucket != "" {
-			p.handlePendingRenamedLB(renamedBucket)
+			p.handlePendingRenamedLB(renamedBucket, query.Get(apc.QparamProvider))
 		}
 		fallthrough // fallthrough
 	case apc.WhatNodeConfig, apc.WhatSmapVote, apc.WhatSnode, apc.WhatLog,
 		apc.WhatNodeStats, apc.WhatNodeStatsV322, apc.WhatMetricNames,
-		apc.WhatNodeStatsAndStatusV322:
+		apc.WhatNodeStatsAndStatusV322, apc.WhatCertInfo:
 		p.htrun.httpdaeget(w, r, query, nil /*htext*/)
 
 	case apc.WhatNodeStatsAndStatus:
@@ -2615,6 +2986,10 @@ func (p *proxy) httpdaeget(w http.ResponseWriter, r *http.Request) {
 
 	case apc.WhatSysInfo:
 		p.writeJSON(w, r, apc.GetMemCPU(), what)
+	case apc.WhatLongReqs:
+		p.writeJSON(w, r, p.lreqs.list(), what)
+	case apc.WhatQuarantine:
+		p.writeJSON(w, r, p.tquar.info(), what)
 	case apc.WhatSmap:
 		const retries = 16
 		var (
@@ -2673,9 +3048,15 @@ func (p *proxy) httpdaeput(w http.ResponseWriter, r *http.Request) {
 		}
 	case apc.ActRotateLogs:
 		nlog.Flush(nlog.ActRotate)
+	case apc.ActRotateCert:
+		if err := p.rotateCert(msg); err != nil {
+			p.writeErr(w, r, err)
+		}
 	case apc.ActResetStats:
 		errorsOnly := msg.Value.(bool)
 		p.statsT.ResetStats(errorsOnly)
+	case apc.ActCancelReq, apc.ActSetReqPrio:
+		p.lreqAct(w, r, msg, false /*all proxies*/)
 
 	case apc.ActStartMaintenance:
 		if !p.ensureIntraControl(w, r, true /* from primary */) {
@@ -2698,7 +3079,7 @@ func (p *proxy) httpdaeput(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 		p.termKalive(msg.Action)
-		p.shutdown(msg.Action)
+		go p.drainShutdown(msg.Action, cmn.GCO.Get().Timeout.MaxHostBusy.D())
 	case apc.ActShutdownCluster:
 		smap := p.owner.smap.get()
 		isPrimary := smap.isPrimary(p.si)
@@ -2706,7 +3087,12 @@ func (p *proxy) httpdaeput(w http.ResponseWriter, r *http.Request) {
 			if !p.ensureIntraControl(w, r, true /* from primary */) {
 				return
 			}
-			p.Stop(&errNoUnregister{msg.Action})
+			timeout, err := drainTimeout(msg)
+			if err != nil {
+				p.writeErr(w, r, err)
+				return
+			}
+			go p.drainShutdown(msg.Action, timeout)
 			return
 		}
 		force := cos.IsParseBool(query.Get(apc.QparamForce))
@@ -3017,6 +3403,10 @@ func (p *proxy) dsortHandler(w http.ResponseWriter, r *http.Request) {
 		p.writeErrURL(w, r)
 		return
 	}
+	if len(apiItems) > 0 && apiItems[0] == apc.Templates {
+		p.dsortTmpls(w, r, apiItems[1:])
+		return
+	}
 
 	switch r.Method {
 	case http.MethodPost:
@@ -3033,6 +3423,10 @@ func (p *proxy) dsortHandler(w http.ResponseWriter, r *http.Request) {
 			p.writeErr(w, r, err)
 			return
 		}
+		if ecode, err := dsortApplyTmpl(rs); err != nil {
+			p.writeErr(w, r, err, ecode)
+			return
+		}
 		parsc, err := rs.ParseCtx()
 		if err != nil {
 			p.writeErr(w, r, err)
@@ -3282,13 +3676,15 @@ func (p *proxy) receiveRMD(newRMD *rebMD, msg *aisMsg, caller string) (err error
 func (p *proxy) smapOnUpdate(newSmap, oldSmap *smapX, nfl, ofl cos.BitFlags) {
 	// When some node was removed from the cluster we need to clean up the
 	// reverse proxy structure.
-	p.rproxy.nodes.Range(func(key, _ any) bool {
-		nodeID := key.(string)
-		if oldSmap.GetNode(nodeID) != nil && newSmap.GetNode(nodeID) == nil {
-			p.rproxy.nodes.Delete(nodeID)
-		}
-		return true
-	})
+	for _, m := range []*sync.Map{&p.rproxy.nodes, &p.rproxy.data} {
+		m.Range(func(key, _ any) bool {
+			nodeID := key.(string)
+			if oldSmap.GetNode(nodeID) != nil && newSmap.GetNode(nodeID) == nil {
+				m.Delete(nodeID)
+			}
+			return true
+		})
+	}
 	p.syncNewICOwners(oldSmap, newSmap)
 
 	p.htrun.smapUpdatedCB(newSmap, oldSmap, nfl, ofl)
@@ -3447,6 +3843,11 @@ func (p *proxy) shutdown(action string) {
 	p.Stop(&errNoUnregister{action})
 }
 
+func (p *proxy) drainShutdown(action string, timeout time.Duration) {
+	p.drain(timeout)
+	p.shutdown(action)
+}
+
 func (p *proxy) decommission(action string, opts *apc.ActValRmNode) {
 	cleanupConfigDir(p.Name(), opts.KeepInitialConfig)
 	if !opts.NoShutdown {
@@ -3465,7 +3866,7 @@ func (p *proxy) Stop(err error) {
 	if isPrimary {
 		s += "(primary)"
 		if !isEnu || e.action != apc.ActShutdownCluster {
-			if npsi, err := smap.HrwProxy(p.SID()); err == nil {
+			if npsi, err := nextPrimary(smap, p.SID()); err == nil {
 				p.notifyCandidate(npsi, smap)
 			}
 		}
@@ -3476,6 +3877,7 @@ func (p *proxy) Stop(err error) {
 		nlog.Warningf("%s: %v", s, err)
 	}
 	xreg.AbortAll(errors.New("p-stop"))
+	p.nfsgw.stop()
 
 	p.htrun.stop(&sync.WaitGroup{}, !isPrimary && smap.isValid() && !isEnu /*rmFromSmap*/)
 }
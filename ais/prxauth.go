@@ -11,6 +11,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/NVIDIA/aistore/ais/s3"
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/api/authn"
 	"github.com/NVIDIA/aistore/api/env"
@@ -18,6 +19,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/feat"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/memsys"
@@ -48,10 +50,14 @@ func newAuthManager(config *cmn.Config) *authManager {
 		tkList:        make(tkList),
 		revokedTokens: make(map[string]bool), // TODO: preallocate
 		version:       1,
-		secret:        cos.Right(config.Auth.Secret, os.Getenv(env.AuthN.SecretKey)), // environment override
+		secret:        authSecret(config),
 	}
 }
 
+func authSecret(config *cmn.Config) string {
+	return cos.Right(config.Auth.Secret, os.Getenv(env.AuthN.SecretKey)) // environment override
+}
+
 // Add tokens to the list of invalid ones and clean up the list from expired tokens.
 func (a *authManager) updateRevokedList(newRevoked *tokenList) (allRevoked *tokenList) {
 	a.Lock()
@@ -226,6 +232,47 @@ func (p *proxy) validateToken(hdr http.Header) (*tok.Token, error) {
 	return tk, nil
 }
 
+// Authenticates S3 API request (AuthN on):
+//   - AWS SigV4 (header or presigned query) with AuthN-issued S3 credentials and
+//     the user's token as the session token (see tok.S3SecretKey), or
+//   - AIS native bearer token
+//
+// Payload gets verified as it is being read - by the target, when redirected (see s3Payload).
+//
+// Returns the authenticated user's token (nil when passing through - see feat.S3PresignedRequest).
+func (p *proxy) s3Auth(r *http.Request) (*tok.Token, error) {
+	if cmn.Rom.Features().IsSet(feat.S3PresignedRequest) {
+		return nil, nil // pass-through for subsequent authentication by S3
+	}
+	sig, err := s3.ParseSigV4(r)
+	if err != nil {
+		return nil, err
+	}
+	if sig == nil {
+		return p.validateToken(r.Header)
+	}
+	if sig.SecurityToken == "" {
+		return nil, tok.ErrNoToken
+	}
+	tk, err := p.authn.validateToken(sig.SecurityToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := sig.Verify(r, tok.S3SecretKey(sig.AccessKeyID, tk.UserID, p.authn.secret)); err != nil {
+		return nil, err
+	}
+	return tk, nil
+}
+
+// same as checkAccess for S3 API requests that have already been authenticated (see s3Auth)
+func (p *proxy) s3Access(w http.ResponseWriter, r *http.Request, tk *tok.Token, bck *meta.Bck, ace apc.AccessAttrs) error {
+	err := p._access(tk, bck, ace)
+	if err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+	}
+	return err
+}
+
 // When AuthN is on, accessing a bucket requires two permissions:
 //   - access to the bucket is granted to a user
 //   - bucket ACL allows the required operation
@@ -255,10 +302,7 @@ func aceErrToCode(err error) (status int) {
 }
 
 func (p *proxy) access(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs) (err error) {
-	var (
-		tk     *tok.Token
-		bucket *cmn.Bck
-	)
+	var tk *tok.Token
 	if p.isIntraCall(hdr, false /*from primary*/) == nil {
 		return nil
 	}
@@ -271,10 +315,24 @@ func (p *proxy) access(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs) (err
 			}
 			return err
 		}
-		uid := p.owner.smap.Get().UUID
-		if bck != nil {
-			bucket = bck.Bucket()
+	}
+	return p._access(tk, bck, ace)
+}
+
+// given validated token (nil when AuthN is off)
+func (p *proxy) _access(tk *tok.Token, bck *meta.Bck, ace apc.AccessAttrs) error {
+	var (
+		bucket *cmn.Bck
+		uid    string
+	)
+	if bck != nil {
+		bucket = bck.Bucket()
+	}
+	if cmn.Rom.AuthEnabled() {
+		if tk == nil {
+			return tok.ErrNoToken
 		}
+		uid = p.owner.smap.Get().UUID
 		if err := tk.CheckPermissions(uid, bucket, ace); err != nil {
 			return err
 		}
@@ -286,10 +344,12 @@ func (p *proxy) access(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs) (err
 
 	// bucket access conventions:
 	// - without AuthN: read-only access, PATCH, and ACL
-	// - with AuthN:    superuser can PATCH and change ACL
+	// - with AuthN:    superuser and bucket admin can PATCH and change ACL
+	// - bucket admin is a user (token) permission that bucket ACL does not restrict
+	ace &^= apc.AceBckAdmin
 	if !cmn.Rom.AuthEnabled() {
 		ace &^= (apc.AcePATCH | apc.AceBckSetACL | apc.AccessRO)
-	} else if tk.IsAdmin {
+	} else if tk.IsBckAdmin(uid, bucket) {
 		ace &^= (apc.AcePATCH | apc.AceBckSetACL)
 	}
 	if ace == 0 {
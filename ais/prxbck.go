@@ -144,7 +144,12 @@ func (bctx *bctx) init() (ecode int, err error) {
 		}
 		bctx.perms = dtor.Access
 	}
-	ecode, err = bctx.accessAllowed(bck)
+	if ecode, err = bctx.accessAllowed(bck); err != nil {
+		return
+	}
+	if bck.Props.Frozen != 0 && bctx.perms&apc.AccessWrite != 0 {
+		ecode, err = http.StatusConflict, cmn.NewErrBckFrozen(bck.Bucket())
+	}
 	return
 }
 
@@ -19,15 +19,31 @@ import (
 
 // in this source:
 // - bsummact  <= api.GetBucketSummary(query-bcks, ActMsg)
+//   (and api.GetBucketSummaryStream - JSON lines, see bsummJlines)
 // - bsummhead <= api.GetBucketInfo(bck, QparamBinfoWithOrWithoutRemote)
 
+// aggregates per-target results (see cmn.AllBsummResults.Aggregate)
+type bsummAcc struct {
+	summaries   cmn.AllBsummResults
+	dsize       map[string]uint64
+	numAccepted int
+	numPartial  int
+}
+
 func (p *proxy) bsummact(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryBcks, msg *apc.BsummCtrlMsg) {
 	news := msg.UUID == ""
 	debug.Assert(msg.UUID == "" || cos.IsValidUUID(msg.UUID), msg.UUID)
 
 	// start new
 	if news {
-		err := p.bsummNew(qbck, msg)
+		msg.UUID = cos.GenUUID()
+		lc, ecode, err := p.lreqs.begin(r, msg.UUID, apc.ActSummaryBck, qbck.String())
+		if err != nil {
+			p.writeErr(w, r, err, ecode)
+			return
+		}
+		err = p.bsummNew(qbck, msg)
+		p.lreqs.end(lc, err != nil)
 		if err != nil {
 			p.writeErr(w, r, err)
 		} else {
@@ -39,7 +55,18 @@ func (p *proxy) bsummact(w http.ResponseWriter, r *http.Request, qbck *cmn.Query
 	}
 
 	// or, query partial or final results
+	lc, ecode, err := p.lreqs.begin(r, msg.UUID, apc.ActSummaryBck, qbck.String())
+	if err != nil {
+		p.writeErr(w, r, err, ecode)
+		return
+	}
+	if wantsJlines(r) {
+		p.bsummJlines(w, r, qbck, msg)
+		p.lreqs.end(lc, true)
+		return
+	}
 	summaries, status, err := p.bsummCollect(qbck, msg)
+	p.lreqs.end(lc, err != nil || status != http.StatusAccepted)
 	if err != nil {
 		p.writeErr(w, r, err)
 		return
@@ -51,7 +78,9 @@ func (p *proxy) bsummact(w http.ResponseWriter, r *http.Request, qbck *cmn.Query
 func (p *proxy) bsummNew(qbck *cmn.QueryBcks, msg *apc.BsummCtrlMsg) (err error) {
 	q := qbck.NewQuery()
 
-	msg.UUID = cos.GenUUID()
+	if msg.UUID == "" {
+		msg.UUID = cos.GenUUID()
+	}
 	aisMsg := p.newAmsgActVal(apc.ActSummaryBck, msg)
 
 	args := allocBcArgs()
@@ -84,71 +113,141 @@ func (p *proxy) bsummNew(qbck *cmn.QueryBcks, msg *apc.BsummCtrlMsg) (err error)
 	return err
 }
 
-func (p *proxy) bsummCollect(qbck *cmn.QueryBcks, msg *apc.BsummCtrlMsg) (_ cmn.AllBsummResults, status int, err error) {
+func (p *proxy) bsummArgs(qbck *cmn.QueryBcks, msg *apc.BsummCtrlMsg) (*bcastArgs, error) {
 	var (
 		q      = make(url.Values, 4)
 		aisMsg = p.newAmsgActVal(apc.ActSummaryBck, msg)
-		args   = allocBcArgs()
+		smap   = p.owner.smap.get()
 	)
+	if cnt := smap.CountActiveTs(); cnt < 1 {
+		return nil, cmn.NewErrNoNodes(apc.Target, smap.CountTargets())
+	}
+	args := allocBcArgs()
 	args.req = cmn.HreqArgs{
 		Method: http.MethodGet,
 		Path:   apc.URLPathBuckets.Join(qbck.Name, apc.ActQuery),
 		Body:   cos.MustMarshal(aisMsg),
 	}
-	args.smap = p.owner.smap.get()
-	if cnt := args.smap.CountActiveTs(); cnt < 1 {
-		return nil, 0, cmn.NewErrNoNodes(apc.Target, args.smap.CountTargets())
-	}
+	args.smap = smap
 	qbck.AddToQuery(q)
 	q.Set(apc.QparamSilent, "true")
 	args.req.Query = q
 	args.cresv = cresBsumm{} // -> cmn.AllBsummResults
+	return args, nil
+}
 
+func (p *proxy) bsummCollect(qbck *cmn.QueryBcks, msg *apc.BsummCtrlMsg) (_ cmn.AllBsummResults, status int, err error) {
+	args, err := p.bsummArgs(qbck, msg)
+	if err != nil {
+		return nil, 0, err
+	}
 	results := p.bcastGroup(args)
 	freeBcArgs(args)
 	for _, res := range results {
 		if res.err != nil {
-			if res.details == "" || res.details == dfltDetail {
-				res.details = xact.Cname(apc.ActSummaryBck, msg.UUID)
-			}
-			err = res.toErr()
+			err = _bsummErr(res, msg)
 			freeBcastRes(results)
 			return nil, 0, err
 		}
 	}
 
+	acc := newBsummAcc(len(results))
+	for _, res := range results {
+		acc.add(res)
+	}
+	freeBcastRes(results)
+	summaries, status := acc.fini()
+	return summaries, status, nil
+}
+
+// stream partial or final results as JSON lines (see cmn.BsummLine):
+// - one line per bucket per target - as soon as the target responds
+// - followed by the cluster-wide (aggregated) lines - the ones with no target ID
+// - the aggregated status (200, 202, or 206) is conveyed in-band - the response itself is always 200
+func (p *proxy) bsummJlines(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryBcks, msg *apc.BsummCtrlMsg) {
+	args, err := p.bsummArgs(qbck, msg)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
 	var (
-		summaries   = make(cmn.AllBsummResults, 0, 8)
-		dsize       = make(map[string]uint64, len(results))
-		numAccepted int
-		numPartial  int
+		jl  = newJlines(w)
+		acc = newBsummAcc(args.smap.CountActiveTs())
 	)
-	for _, res := range results {
-		if res.status == http.StatusAccepted {
-			numAccepted++
-			continue
-		}
-		if res.status == http.StatusPartialContent {
-			numPartial++
-		}
-		tbsumm, tid := res.v.(*cmn.AllBsummResults), res.si.ID()
-		for _, summ := range *tbsumm {
-			dsize[tid] = summ.TotalSize.Disks
-			summaries = summaries.Aggregate(summ)
+	p.bcastStream(args, func(res *callResult) {
+		line := cmn.BsummLine{TID: res.si.ID(), Status: res.status}
+		switch {
+		case res.err != nil:
+			line.Err = _bsummErr(res, msg).Error()
+			jl.write(&line)
+			acc.numPartial++ // (incomplete)
+		case res.status == http.StatusAccepted:
+			jl.write(&line)
+		default:
+			// write first - aggregation may modify (and keep) the target's results
+			for _, summ := range *res.v.(*cmn.AllBsummResults) {
+				line.Summ = summ
+				jl.write(&line)
+			}
+			acc.add(res)
 		}
+		freeCR(res)
+	})
+	freeBcArgs(args)
+
+	summaries, status := acc.fini()
+	if len(summaries) == 0 {
+		jl.write(&cmn.BsummLine{Status: status})
 	}
-	summaries.Finalize(dsize, cmn.Rom.TestingEnv())
-	freeBcastRes(results)
+	for _, summ := range summaries {
+		jl.write(&cmn.BsummLine{Summ: summ, Status: status})
+	}
+	jl.fini(&p.htrun, "bucket-summary")
+}
 
+func _bsummErr(res *callResult, msg *apc.BsummCtrlMsg) error {
+	if res.details == "" || res.details == dfltDetail {
+		res.details = xact.Cname(apc.ActSummaryBck, msg.UUID)
+	}
+	return res.toErr()
+}
+
+//////////////
+// bsummAcc //
+//////////////
+
+func newBsummAcc(numTs int) *bsummAcc {
+	return &bsummAcc{
+		summaries: make(cmn.AllBsummResults, 0, 8),
+		dsize:     make(map[string]uint64, numTs),
+	}
+}
+
+func (acc *bsummAcc) add(res *callResult) {
+	if res.status == http.StatusAccepted {
+		acc.numAccepted++
+		return
+	}
+	if res.status == http.StatusPartialContent {
+		acc.numPartial++
+	}
+	tbsumm, tid := res.v.(*cmn.AllBsummResults), res.si.ID()
+	for _, summ := range *tbsumm {
+		acc.dsize[tid] = summ.TotalSize.Disks
+		acc.summaries = acc.summaries.Aggregate(summ)
+	}
+}
+
+func (acc *bsummAcc) fini() (cmn.AllBsummResults, int) {
+	acc.summaries.Finalize(acc.dsize, cmn.Rom.TestingEnv())
 	switch {
-	case numPartial == 0 && numAccepted == 0:
-		status = http.StatusOK
-	case numPartial == 0:
-		status = http.StatusAccepted
+	case acc.numPartial == 0 && acc.numAccepted == 0:
+		return acc.summaries, http.StatusOK
+	case acc.numPartial == 0:
+		return acc.summaries, http.StatusAccepted
 	default:
-		status = http.StatusPartialContent
+		return acc.summaries, http.StatusPartialContent
 	}
-	return summaries, status, nil
 }
 
 // fully reuse bsummact impl.
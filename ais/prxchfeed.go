@@ -0,0 +1,59 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core"
+)
+
+// GET /v1/buckets/<bck>?action=changes&since=<seq>
+// (bucket change feed - see tgtchfeed.go)
+func (p *proxy) bckChanges(w http.ResponseWriter, r *http.Request, bckName string, query url.Values) {
+	bck, err := newBckFromQ(bckName, query, nil)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	bckArgs := bctx{p: p, w: w, r: r, bck: bck, msg: &apc.ActMsg{Action: apc.BckChanges}, perms: apc.AceObjLIST, query: query}
+	bckArgs.createAIS = false
+	if bck, err = bckArgs.initAndTry(); err != nil {
+		return
+	}
+
+	q := make(url.Values, 3)
+	q.Set(apc.QparamAction, apc.BckChanges)
+	q.Set(apc.QparamSince, query.Get(apc.QparamSince))
+	q = bck.AddToQuery(q)
+
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: apc.URLPathBuckets.Join(bck.Name), Query: q}
+	args.rid = r.Header.Get(apc.HdrReqID)
+	args.to = core.Targets
+	args.cresv = cresCH{} // -> cmn.ChangeFeed
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	feeds := make([]*cmn.ChangeFeed, 0, len(results))
+	for _, res := range results {
+		if res.err != nil {
+			p.writeErr(w, r, res.toErr()) // (including 410 Gone)
+			freeBcastRes(results)
+			return
+		}
+		feeds = append(feeds, res.v.(*cmn.ChangeFeed))
+	}
+	freeBcastRes(results)
+	if len(feeds) == 0 {
+		smap := p.owner.smap.get()
+		p.writeErr(w, r, cmn.NewErrNoNodes(apc.Target, smap.CountTargets()))
+		return
+	}
+	p.writeJSON(w, r, cmn.MergeChanges(feeds), apc.BckChanges)
+}
@@ -0,0 +1,152 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// Copy-on-write clone (apc.ActCloneBck), proxy side:
+// - cloning creates an (initially empty) ais bucket that inherits source props and references
+//   the source via cmn.Bprops.CloneOf; for target side, see tgtclone.go
+// - list-objects merges the clone's own pages with the source's (see lsClone below)
+// - the source cannot be renamed or destroyed while it has clones
+
+// clone bucket
+func (p *proxy) cloneBucket(bckFrom, bckTo *meta.Bck, msg *apc.ActMsg) error {
+	if bckFrom.Props.Renamed != "" {
+		return cmn.NewErrBusy("bucket", bckFrom.Cname(""), "(being renamed)")
+	}
+	nprops := bckFrom.Props.Clone()
+	nprops.CloneOf = cmn.Bck{Name: bckFrom.Name, Provider: apc.AIS, Ns: bckFrom.Ns}
+	if err := nprops.Validate(p.owner.smap.get().CountActiveTs()); err != nil {
+		return err
+	}
+	// targets: regular create-bucket transaction
+	cmsg := &apc.ActMsg{Action: apc.ActCreateBck, Name: msg.Name}
+	return p._createBucketWithProps(cmsg, bckTo, nprops)
+}
+
+// returns (any) clone of the bucket, or nil
+func (m *bucketMD) clonedBy(bck *meta.Bck) (dependent *meta.Bck) {
+	if !bck.IsAIS() {
+		return nil
+	}
+	provider := apc.AIS
+	m.Range(&provider, nil, func(b *meta.Bck) bool {
+		if src := b.CloneOf(); src != nil && src.Equal(bck, false, false) {
+			dependent = b
+			return true
+		}
+		return false
+	})
+	return dependent
+}
+
+func errHasClone(action string, bck, dependent *meta.Bck) error {
+	return fmt.Errorf("cannot %s %s: bucket %s is its copy-on-write clone (destroy the clone first)",
+		action, bck.Cname(""), dependent.Cname(""))
+}
+
+// list clone: merge the clone's own pages with the source's, page by page.
+// Both are listed with the same continuation token (under distinct list-objects UUIDs);
+// the merged part ends where the shorter of the two does (entries past it get re-requested).
+// The source may itself be a clone (recursion).
+func (p *proxy) lsClone(bck *meta.Bck, lsmsg *apc.LsoMsg) (*cmn.LsoRes, error) {
+	src := meta.CloneBck((*cmn.Bck)(bck.CloneOf()))
+	if err := src.Init(p.owner.bmd); err != nil {
+		return nil, err
+	}
+	if lsmsg.PageSize == 0 {
+		lsmsg.PageSize = apc.MaxPageSizeAIS
+	}
+	var (
+		entries cmn.LsoEntries
+		flags   uint32
+		token   = lsmsg.ContinuationToken
+		limit   = int(lsmsg.PageSize)
+		done    bool
+	)
+	for {
+		omsg := *lsmsg
+		omsg.ContinuationToken = token
+		omsg.ClearFlag(apc.UseListObjsCache)
+		own, err := p.lsObjsA(bck, &omsg)
+		if err != nil {
+			return nil, err
+		}
+		smsg := omsg
+		smsg.UUID = lsmsg.UUID + "-src"
+		var srcl *cmn.LsoRes
+		if src.CloneOf() != nil {
+			srcl, err = p.lsClone(src, &smsg)
+		} else {
+			srcl, err = p.lsObjsA(src, &smsg)
+		}
+		if err != nil {
+			return nil, err
+		}
+		flags |= own.Flags | srcl.Flags
+		entries, token, done = mergeClone(entries, own.Entries, srcl.Entries,
+			own.ContinuationToken == "", srcl.ContinuationToken == "", limit)
+		if done || len(entries) >= limit {
+			break
+		}
+	}
+	if done {
+		token = ""
+	}
+	return &cmn.LsoRes{UUID: lsmsg.UUID, Entries: entries, ContinuationToken: token, Flags: flags}, nil
+}
+
+// merge sorted pages of the clone (`own`) and its source (`src`) into `dst` (up to `limit` entries):
+// - same name: the clone's entry wins; the clone's tombstone hides the source's entry
+// - a page that is not the last one (`ownDone`, `srcDone`) bounds the merge by its last name
+// returns the name to continue from and whether both are done
+func mergeClone(dst, own, src cmn.LsoEntries, ownDone, srcDone bool, limit int) (_ cmn.LsoEntries, token string, done bool) {
+	var bound string
+	switch {
+	case ownDone && srcDone:
+	case ownDone:
+		bound = src[len(src)-1].Name
+	case srcDone:
+		bound = own[len(own)-1].Name
+	default:
+		bound = min(own[len(own)-1].Name, src[len(src)-1].Name)
+	}
+	var i, j int
+	for len(dst) < limit {
+		var en *cmn.LsoEnt
+		switch {
+		case i < len(own) && j < len(src) && own[i].Name == src[j].Name:
+			en = own[i]
+			i++
+			j++
+		case i < len(own) && (j == len(src) || own[i].Name < src[j].Name):
+			en = own[i]
+			i++
+		case j < len(src):
+			en = src[j]
+			j++
+		default: // consumed both
+			if bound == "" {
+				return dst, token, true
+			}
+			return dst, bound, false
+		}
+		if bound != "" && en.Name > bound {
+			return dst, bound, false
+		}
+		token = en.Name
+		if !en.IsTombstone() {
+			dst = append(dst, en)
+		}
+	}
+	return dst, token, bound == "" && i == len(own) && j == len(src)
+}
@@ -0,0 +1,131 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// one page of sorted entries past the continuation token (cf. proxy.lsObjsA)
+func clonePage(lst cmn.LsoEntries, token string, pageSize int) (cmn.LsoEntries, bool) {
+	i := sort.Search(len(lst), func(i int) bool { return lst[i].Name > token })
+	if len(lst)-i < pageSize {
+		return lst[i:], true
+	}
+	return lst[i : i+pageSize], false
+}
+
+func TestMergeClone(t *testing.T) {
+	var (
+		own, src cmn.LsoEntries
+		expected = make(map[string]int64, 256)
+	)
+	for i := range 100 {
+		name := fmt.Sprintf("o-%03d", i)
+		src = append(src, &cmn.LsoEnt{Name: name, Size: 1})
+		switch i % 5 {
+		case 0: // overwritten in the clone
+			own = append(own, &cmn.LsoEnt{Name: name, Size: 2})
+			expected[name] = 2
+		case 1: // deleted from the clone
+			own = append(own, &cmn.LsoEnt{Name: name, Flags: apc.EntryTombstone})
+		case 2: // clone only
+			own = append(own, &cmn.LsoEnt{Name: name + "-new", Size: 3})
+			expected[name+"-new"] = 3
+			expected[name] = 1
+		default:
+			expected[name] = 1
+		}
+	}
+	for _, pageSize := range []int{1, 3, 7, 64, 100, 1000} {
+		var (
+			got   = make(map[string]int64, len(expected))
+			token string
+		)
+		for pages := 0; ; pages++ {
+			if pages > 1000 {
+				t.Fatalf("page size %d: not done after %d pages", pageSize, pages)
+			}
+			// one client page (see proxy.lsClone)
+			var (
+				entries cmn.LsoEntries
+				tok     = token
+				done    bool
+			)
+			for {
+				o, oDone := clonePage(own, tok, pageSize)
+				s, sDone := clonePage(src, tok, pageSize)
+				entries, tok, done = mergeClone(entries, o, s, oDone, sDone, pageSize)
+				if done || len(entries) >= pageSize {
+					break
+				}
+			}
+			if len(entries) > pageSize {
+				t.Fatalf("page size %d: got %d entries", pageSize, len(entries))
+			}
+			for _, en := range entries {
+				if _, ok := got[en.Name]; ok {
+					t.Fatalf("page size %d: %q listed more than once", pageSize, en.Name)
+				}
+				if en.IsTombstone() {
+					t.Fatalf("page size %d: tombstone %q listed", pageSize, en.Name)
+				}
+				if en.Name <= token {
+					t.Fatalf("page size %d: %q out of order (token %q)", pageSize, en.Name, token)
+				}
+				got[en.Name] = en.Size
+			}
+			if done {
+				break
+			}
+			token = tok
+		}
+		if len(got) != len(expected) {
+			t.Fatalf("page size %d: expected %d entries, got %d", pageSize, len(expected), len(got))
+		}
+		for name, size := range expected {
+			if got[name] != size {
+				t.Fatalf("page size %d: %q: expected size %d, got %d", pageSize, name, size, got[name])
+			}
+		}
+	}
+}
+
+func TestClonedBy(t *testing.T) {
+	var (
+		bmd   = newBucketMD()
+		src   = meta.NewBck("src", apc.AIS, cmn.NsGlobal)
+		clone = meta.NewBck("clone", apc.AIS, cmn.NsGlobal)
+		other = meta.NewBck("other", apc.AIS, cmn.NsGlobal)
+	)
+	bmd.add(src, &cmn.Bprops{})
+	bmd.add(clone, &cmn.Bprops{CloneOf: cmn.Bck{Name: src.Name, Provider: apc.AIS, Ns: cmn.NsGlobal}})
+	bmd.add(other, &cmn.Bprops{})
+
+	if dependent := bmd.clonedBy(src); dependent == nil || !dependent.Equal(clone, false, false) {
+		t.Fatalf("expected %s to be cloned by %s, got %v", src, clone, dependent)
+	}
+	if dependent := bmd.clonedBy(clone); dependent != nil {
+		t.Fatalf("expected %s to have no clones, got %s", clone, dependent)
+	}
+
+	// source cannot be destroyed or renamed; the clone can
+	if err := bmodRm(&bmdModifier{bcks: []*meta.Bck{src}}, bmd.clone()); err == nil {
+		t.Fatalf("expected destroying %s to fail", src)
+	}
+	dst := meta.NewBck("dst", apc.AIS, cmn.NsGlobal)
+	if err := bmodMv(&bmdModifier{bcks: []*meta.Bck{src, dst}}, bmd.clone()); err == nil {
+		t.Fatalf("expected renaming %s to fail", src)
+	}
+	if err := bmodRm(&bmdModifier{bcks: []*meta.Bck{clone}}, bmd.clone()); err != nil {
+		t.Fatal(err)
+	}
+}
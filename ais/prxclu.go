@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -63,6 +64,14 @@ func (p *proxy) httpcluget(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if what == apc.WhatProfile {
+		if err := p.checkAccess(w, r, nil, apc.AceAdmin); err != nil {
+			return
+		}
+		p.qcluProfile(w, r, query)
+		return
+	}
+
 	if err := p.checkAccess(w, r, nil, apc.AceShowCluster); err != nil {
 		return
 	}
@@ -74,10 +83,20 @@ func (p *proxy) httpcluget(w http.ResponseWriter, r *http.Request) {
 		p.xquery(w, r, what, query)
 	case apc.WhatAllRunningXacts:
 		p.xgetRunning(w, r, what, query)
+	case apc.WhatXactHistory, apc.WhatHeatmap:
+		p.qcluFltBck(w, r, what, query)
+	case apc.WhatBpropsHistory:
+		p.qcluPropsHist(w, r, what, query)
 	case apc.WhatNodeStats, apc.WhatNodeStatsV322:
 		p.qcluStats(w, r, what, query)
 	case apc.WhatSysInfo:
 		p.qcluSysinfo(w, r, what, query)
+	case apc.WhatLongReqs:
+		p.qcluLongReqs(w, r, what)
+	case apc.WhatQuarantine:
+		p.qcluQuarantine(w, r, what)
+	case apc.WhatNsUsage:
+		p.qcluNsUsage(w, r, what)
 	case apc.WhatMountpaths:
 		p.qcluMountpaths(w, r, what, query)
 	case apc.WhatRemoteAIS:
@@ -113,6 +132,16 @@ func (p *proxy) httpcluget(w http.ResponseWriter, r *http.Request) {
 		c := config.ClusterConfig
 		c.Auth.Secret = "**********"
 		p.writeJSON(w, r, &c, what)
+	case apc.WhatConfigDrift:
+		if p.forwardCP(w, r, nil, what) {
+			return
+		}
+		report := p.cdrift.report()
+		if report == nil {
+			p.writeErrStatusf(w, r, http.StatusServiceUnavailable, "%s: failed to check config drift", p)
+			return
+		}
+		p.writeJSON(w, r, report, what)
 	case apc.WhatBMD, apc.WhatSmapVote, apc.WhatSnode, apc.WhatSmap:
 		p.htrun.httpdaeget(w, r, query, nil /*htext*/)
 	default:
@@ -131,6 +160,7 @@ func (p *proxy) xquery(w http.ResponseWriter, r *http.Request, what string, quer
 
 	args := allocBcArgs()
 	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: apc.URLPathXactions.S, Body: body, Query: query}
+	args.rid = r.Header.Get(apc.HdrReqID)
 	args.to = core.Targets
 
 	var (
@@ -141,6 +171,11 @@ func (p *proxy) xquery(w http.ResponseWriter, r *http.Request, what string, quer
 	if !onlyRunning {
 		args.timeout = config.Client.TimeoutLong.D()
 	}
+	if wantsJlines(r) {
+		p.xqueryJlines(w, r, args, &xactMsg)
+		freeBcArgs(args)
+		return
+	}
 
 	results := p.bcastGroup(args)
 	freeBcArgs(args)
@@ -163,6 +198,35 @@ func (p *proxy) xquery(w http.ResponseWriter, r *http.Request, what string, quer
 	p.writeJSON(w, r, resRaw, what)
 }
 
+// same as above with results streamed as JSON lines (one line per target, in order of arrival);
+// a failure of any given target does not fail the request (compare w/ _tresRaw) - it becomes the target's line
+func (p *proxy) xqueryJlines(w http.ResponseWriter, r *http.Request, args *bcastArgs, xactMsg *xact.QueryMsg) {
+	var (
+		jl   = newJlines(w)
+		line struct { // (wire-compatible with xact.TargetSnaps)
+			TID   string              `json:"tid"`
+			Snaps jsoniter.RawMessage `json:"snaps,omitempty"`
+			Err   string              `json:"error,omitempty"`
+		}
+	)
+	p.bcastStream(args, func(res *callResult) {
+		if res.status != http.StatusNotFound {
+			line.TID, line.Snaps, line.Err = res.si.ID(), res.bytes, ""
+			if res.err != nil {
+				line.Snaps, line.Err = nil, res.toErr().Error()
+			}
+			jl.write(&line)
+		}
+		freeCR(res)
+	})
+	if jl.cnt == 0 {
+		if smap := p.owner.smap.get(); smap.CountActiveTs() > 0 {
+			p.writeErrStatusf(w, r, http.StatusNotFound, "%q not found", xactMsg.String())
+		}
+	}
+	jl.fini(&p.htrun, apc.WhatQueryXactStats)
+}
+
 // apc.WhatAllRunningXacts
 func (p *proxy) xgetRunning(w http.ResponseWriter, r *http.Request, what string, query url.Values) {
 	var xactMsg xact.QueryMsg
@@ -174,6 +238,7 @@ func (p *proxy) xgetRunning(w http.ResponseWriter, r *http.Request, what string,
 
 	args := allocBcArgs()
 	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: apc.URLPathXactions.S, Body: body, Query: query}
+	args.rid = r.Header.Get(apc.HdrReqID)
 	args.to = core.Targets
 	results := p.bcastGroup(args)
 	freeBcArgs(args)
@@ -260,8 +325,10 @@ func (p *proxy) getRemAisVec(refresh bool) (*meta.RemAisVec, error) {
 func (p *proxy) _sysinfo(r *http.Request, timeout time.Duration, to int, query url.Values) (cos.JSONRawMsgs, error) {
 	args := allocBcArgs()
 	args.req = cmn.HreqArgs{Method: r.Method, Path: apc.URLPathDae.S, Query: query}
+	args.rid = r.Header.Get(apc.HdrReqID)
 	args.timeout = timeout
 	args.to = to
+	args.hedge = true
 	results := p.bcastGroup(args)
 	freeBcArgs(args)
 	sysInfoMap := make(cos.JSONRawMsgs, len(results))
@@ -278,17 +345,79 @@ func (p *proxy) _sysinfo(r *http.Request, timeout time.Duration, to int, query u
 }
 
 func (p *proxy) qcluStats(w http.ResponseWriter, r *http.Request, what string, query url.Values) {
-	targetStats, erred := p._queryTs(w, r, query)
-	if targetStats == nil || erred {
+	flt, err := statsFlt(query)
+	if err != nil {
+		p.writeErr(w, r, err)
 		return
 	}
-	out := &stats.ClusterRaw{}
-	out.Target = targetStats
-	out.Proxy = p.statsT.GetStats()
-	out.Proxy.Snode = p.si
+	var (
+		out = &stats.ClusterRaw{}
+		// nodes (comma-separated IDs) and/or page
+		nodes    = query.Get(apc.QparamNodes)
+		token    = query.Get(apc.QparamPageToken)
+		pageSize int
+	)
+	if s := query.Get(apc.QparamPageSize); s != "" {
+		if pageSize, err = strconv.Atoi(s); err != nil || pageSize < 0 {
+			p.writeErrf(w, r, "invalid %s=%q", apc.QparamPageSize, s)
+			return
+		}
+	}
+	if nodes == "" && pageSize == 0 && token == "" {
+		targetStats, erred := p._queryTs(w, r, query)
+		if targetStats == nil || erred {
+			return
+		}
+		out.Target = targetStats
+	} else {
+		selected, next := p.statsPage(nodes, token, pageSize)
+		if len(selected) == 0 {
+			out.Target = cos.JSONRawMsgs{}
+		} else {
+			targetStats, erred := p._querySelected(w, r, query, selected)
+			if targetStats == nil || erred {
+				return
+			}
+			out.Target = targetStats
+		}
+		out.Next = next
+	}
+	// (proxy's own stats: first page only)
+	if token == "" && (nodes == "" || cos.StringInSlice(p.SID(), strings.Split(nodes, ","))) {
+		out.Proxy = p.statsT.GetStatsFlt(flt)
+		out.Proxy.Snode = p.si
+	}
 	p.writeJSON(w, r, out, what)
 }
 
+// select targets by ID (when specified), order them by ID, and return the requested page
+// along with the next page's continuation token (empty when done)
+func (p *proxy) statsPage(nodes, token string, pageSize int) (selected meta.Nodes, next string) {
+	smap := p.owner.smap.get()
+	if nodes != "" {
+		for _, tid := range strings.Split(nodes, ",") {
+			if tsi := smap.GetTarget(strings.TrimSpace(tid)); tsi != nil {
+				selected = append(selected, tsi)
+			}
+		}
+	} else {
+		selected = make(meta.Nodes, 0, len(smap.Tmap))
+		for _, tsi := range smap.Tmap {
+			selected = append(selected, tsi)
+		}
+	}
+	sort.Slice(selected, func(i, j int) bool { return selected[i].ID() < selected[j].ID() })
+	if token != "" {
+		i := sort.Search(len(selected), func(i int) bool { return selected[i].ID() > token })
+		selected = selected[i:]
+	}
+	if pageSize > 0 && len(selected) > pageSize {
+		selected = selected[:pageSize]
+		next = selected[pageSize-1].ID()
+	}
+	return selected, next
+}
+
 func (p *proxy) qcluMountpaths(w http.ResponseWriter, r *http.Request, what string, query url.Values) {
 	targetMountpaths, erred := p._queryTs(w, r, query)
 	if targetMountpaths == nil || erred {
@@ -299,6 +428,46 @@ func (p *proxy) qcluMountpaths(w http.ResponseWriter, r *http.Request, what stri
 	p.writeJSON(w, r, out, what)
 }
 
+// apc.WhatXactHistory: finished xactions by target ID (see also: xact.MultiSnap)
+// (per-target results optionally filtered by bucket)
+func (p *proxy) qcluFltBck(w http.ResponseWriter, r *http.Request, what string, query url.Values) {
+	if uri := query.Get(apc.QparamBucket); uri != "" {
+		if _, _, err := cmn.ParseBckObjectURI(uri, cmn.ParseURIOpts{IsQuery: true}); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+	}
+	targetResults, erred := p._queryTs(w, r, query)
+	if targetResults == nil || erred {
+		return
+	}
+	p.writeJSON(w, r, targetResults, what)
+}
+
+// apc.WhatBpropsHistory: bucket props changes, oldest first (see cmn.BpropsChange)
+func (p *proxy) qcluPropsHist(w http.ResponseWriter, r *http.Request, what string, query url.Values) {
+	uri := query.Get(apc.QparamBucket)
+	if uri == "" {
+		p.writeErrf(w, r, "%s: missing %q query parameter", what, apc.QparamBucket)
+		return
+	}
+	b, _, err := cmn.ParseBckObjectURI(uri, cmn.ParseURIOpts{IsQuery: true})
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	bck := meta.CloneBck(&b)
+	if err := bck.Init(p.owner.bmd); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	hist := p.owner.bmd.get().PropsHistory(bck.Props.BID)
+	if hist == nil {
+		hist = []*cmn.BpropsChange{}
+	}
+	p.writeJSON(w, r, hist, what)
+}
+
 // helper methods for querying targets
 
 func (p *proxy) _queryTs(w http.ResponseWriter, r *http.Request, query url.Values) (cos.JSONRawMsgs, bool) {
@@ -315,12 +484,27 @@ func (p *proxy) _queryTs(w http.ResponseWriter, r *http.Request, query url.Value
 	}
 	args := allocBcArgs()
 	args.req = cmn.HreqArgs{Method: r.Method, Path: apc.URLPathDae.S, Query: query, Body: body}
+	args.rid = r.Header.Get(apc.HdrReqID)
 	args.timeout = cmn.Rom.MaxKeepalive()
+	args.hedge = r.Method == http.MethodGet
 	results := p.bcastGroup(args)
 	freeBcArgs(args)
 	return p._tresRaw(w, r, results)
 }
 
+// same as above, selected targets only
+func (p *proxy) _querySelected(w http.ResponseWriter, r *http.Request, query url.Values, selected meta.Nodes) (cos.JSONRawMsgs, bool) {
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: r.Method, Path: apc.URLPathDae.S, Query: query}
+	args.rid = r.Header.Get(apc.HdrReqID)
+	args.timeout = cmn.Rom.MaxKeepalive()
+	args.selected = selected
+	args.nodeCount = len(selected)
+	results := p.bcastSelected(args)
+	freeBcArgs(args)
+	return p._tresRaw(w, r, results)
+}
+
 func (p *proxy) _tresRaw(w http.ResponseWriter, r *http.Request, results sliceResults) (tres cos.JSONRawMsgs, erred bool) {
 	tres = make(cos.JSONRawMsgs, len(results))
 	for _, res := range results {
@@ -499,6 +683,11 @@ func (p *proxy) httpclupost(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// known target (same ID) that has lost its data: same as restarted, to rebalance and restore
+	if dataLossRejoin(smap, nsi, regReq.Flags) {
+		nlog.Warningln(p.String()+":", nsi.StringEx(), "rejoined with empty mountpaths (data loss) - will rebalance")
+		regReq.Flags = regReq.Flags.Set(cos.Restarted)
+	}
 	if !config.Rebalance.Enabled {
 		regReq.Flags = regReq.Flags.Clear(cos.RebalanceInterrupted)
 		regReq.Flags = regReq.Flags.Clear(cos.Restarted)
@@ -531,6 +720,9 @@ func (p *proxy) httpclupost(w http.ResponseWriter, r *http.Request) {
 			// TODO: respond !updated (NOP)
 			p.writeJSON(w, r, apc.JoinNodeResult{DaemonID: nsi.ID()}, "")
 		}
+		if apiOp != apc.Keepalive && nsi.IsTarget() {
+			go p.warm.rejoined(nsi, regReq.Flags)
+		}
 		return
 	}
 
@@ -543,6 +735,9 @@ func (p *proxy) httpclupost(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 		p.writeJSON(w, r, apc.JoinNodeResult{DaemonID: nsi.ID(), RebalanceID: rebID}, "")
+		if nsi.IsTarget() {
+			go p.warm.rejoined(nsi, regReq.Flags)
+		}
 		return
 	}
 
@@ -556,7 +751,15 @@ func (p *proxy) httpclupost(w http.ResponseWriter, r *http.Request) {
 		p.writeJSON(w, r, meta, path.Join(msg.Action, nsi.ID()))
 	}
 
-	go p.mcastJoined(nsi, msg, nsi.Flags, &regReq)
+	if apiOp == apc.Keepalive || !nsi.IsTarget() {
+		go p.mcastJoined(nsi, msg, nsi.Flags, &regReq)
+		return
+	}
+	go func() {
+		if _, err := p.mcastJoined(nsi, msg, nsi.Flags, &regReq); err == nil {
+			p.warm.rejoined(nsi, regReq.Flags) // (after the join gets committed)
+		}
+	}()
 }
 
 func (p *proxy) fastKalive(w http.ResponseWriter, r *http.Request, smap *smapX, config *cmn.Config, sid string) {
@@ -951,9 +1154,11 @@ func (p *proxy) httpcluput(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// m.b. admin
-	if err := p.checkAccess(w, r, nil, apc.AceAdmin); err != nil {
-		return
+	// m.b. admin (except xaction control - see xaccess)
+	if len(apiItems) > 0 {
+		if err := p.checkAccess(w, r, nil, apc.AceAdmin); err != nil {
+			return
+		}
 	}
 
 	if nlog.Stopping() {
@@ -976,6 +1181,16 @@ func (p *proxy) cluputActMsg(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
+	if msg.Action != apc.ActXactStart && msg.Action != apc.ActXactStop {
+		if err := p.checkAccess(w, r, nil, apc.AceAdmin); err != nil {
+			return
+		}
+	}
+	if msg.Action == apc.ActCancelReq || msg.Action == apc.ActSetReqPrio {
+		// any proxy (whichever serves the request)
+		p.lreqAct(w, r, msg, true /*all proxies*/)
+		return
+	}
 	if msg.Action != apc.ActSendOwnershipTbl {
 		// must be primary to execute all the rest actions
 		if p.forwardCP(w, r, msg, "") {
@@ -1008,16 +1223,24 @@ func (p *proxy) cluputActMsg(w http.ResponseWriter, r *http.Request) {
 		p.resetCluCfgPersistent(w, r, msg)
 	case apc.ActRotateLogs:
 		p.rotateLogs(w, r, msg)
+	case apc.ActRotateCert:
+		p.rotateCluCert(w, r, msg)
 
 	case apc.ActShutdownCluster:
+		timeout, err := drainTimeout(msg)
+		if err != nil {
+			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+			return
+		}
 		args := allocBcArgs()
 		args.req = cmn.HreqArgs{Method: http.MethodPut, Path: apc.URLPathDae.S, Body: cos.MustMarshal(msg)}
+		args.rid = r.Header.Get(apc.HdrReqID)
 		args.to = core.AllNodes
 		_ = p.bcastGroup(args)
 		freeBcArgs(args)
 		// self
 		p.termKalive(msg.Action)
-		p.shutdown(msg.Action)
+		go p.drainShutdown(msg.Action, timeout)
 	case apc.ActDecommissionCluster:
 		var (
 			opts apc.ActValRmNode
@@ -1027,7 +1250,13 @@ func (p *proxy) cluputActMsg(w http.ResponseWriter, r *http.Request) {
 			p.writeErr(w, r, err)
 			return
 		}
+		// offload all ais:// data first (and keep reporting progress until verified)
+		if opts.OffloadTo != "" && !p.offloadData(w, r, &opts) {
+			freeBcArgs(args)
+			return
+		}
 		args.req = cmn.HreqArgs{Method: http.MethodPut, Path: apc.URLPathDae.S, Body: cos.MustMarshal(msg)}
+		args.rid = r.Header.Get(apc.HdrReqID)
 		args.to = core.AllNodes
 		_ = p.bcastGroup(args)
 		freeBcArgs(args)
@@ -1143,6 +1372,18 @@ func (p *proxy) rotateLogs(w http.ResponseWriter, r *http.Request, msg *apc.ActM
 	freeBcArgs(args)
 }
 
+// self first, to validate
+func (p *proxy) rotateCluCert(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
+	if err := p.rotateCert(msg); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodPut, Path: apc.URLPathDae.S, Body: cos.MustMarshal(msg)}
+	p.bcastAllNodes(w, r, args)
+	freeBcArgs(args)
+}
+
 func (p *proxy) setCluCfgTransient(w http.ResponseWriter, r *http.Request, toUpdate *cmn.ConfigToSet, msg *apc.ActMsg) {
 	co := p.owner.config
 	co.Lock()
@@ -1188,6 +1429,9 @@ func (p *proxy) xstart(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg)
 		return
 	}
 	xargs.Kind, _ = xact.GetKindName(xargs.Kind) // display name => kind
+	if err := p.xaccess(w, r, msg, &xargs); err != nil {
+		return
+	}
 
 	// rebalance
 	if xargs.Kind == apc.ActRebalance {
@@ -1197,6 +1441,7 @@ func (p *proxy) xstart(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg)
 
 	args := allocBcArgs()
 	args.req = cmn.HreqArgs{Method: http.MethodPut, Path: apc.URLPathXactions.S}
+	args.rid = r.Header.Get(apc.HdrReqID)
 
 	switch {
 	case xargs.Kind == apc.ActBlobDl:
@@ -1284,6 +1529,9 @@ func (p *proxy) xstop(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
 		return
 	}
 	xargs.Kind, _ = xact.GetKindName(xargs.Kind) // display name => kind
+	if err := p.xaccess(w, r, msg, &xargs); err != nil {
+		return
+	}
 
 	// (lso + tco) special
 	p.lstca.abort(&xargs)
@@ -1309,6 +1557,7 @@ func (p *proxy) xstop(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
 	body := cos.MustMarshal(apc.ActMsg{Action: msg.Action, Value: xargs})
 	args := allocBcArgs()
 	args.req = cmn.HreqArgs{Method: http.MethodPut, Path: apc.URLPathXactions.S, Body: body}
+	args.rid = r.Header.Get(apc.HdrReqID)
 	args.to = core.Targets
 	results := p.bcastGroup(args)
 	freeBcArgs(args)
@@ -1322,6 +1571,40 @@ func (p *proxy) xstop(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
 	freeBcastRes(results)
 }
 
+// xaction control requires apc.AceXactStart or apc.AceXactAbort, respectively (or else AceAdmin
+// that implies both); starting a bucket-scoped xaction also requires the kind's own permissions
+// on the bucket - e.g., bucket admin with AceXactStart can run ec-encode on their bucket
+// but not elsewhere
+func (p *proxy) xaccess(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg, xargs *xact.ArgsMsg) error {
+	ace := apc.AceXactStart
+	if msg.Action == apc.ActXactStop {
+		ace = apc.AceXactAbort
+	}
+	if err := p.access(r.Header, nil, ace); err != nil {
+		if p.access(r.Header, nil, apc.AceAdmin) != nil {
+			p.writeErr(w, r, err, aceErrToCode(err))
+			return err
+		}
+	}
+	if msg.Action == apc.ActXactStop || xargs.Bck.IsEmpty() {
+		return nil
+	}
+	_, dtor, err := xact.GetDescriptor(xargs.Kind)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return err
+	}
+	if dtor.Scope != xact.ScopeB || dtor.Access == 0 {
+		return nil
+	}
+	bck := meta.CloneBck(&xargs.Bck)
+	if err := bck.Init(p.owner.bmd); err != nil {
+		p.writeErr(w, r, err)
+		return err
+	}
+	return p.checkAccess(w, r, bck, dtor.Access)
+}
+
 func (p *proxy) rebalanceCluster(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
 	// note operational priority over config-disabled `errRebalanceDisabled`
 	if err := p.canRebalance(); err != nil && err != errRebalanceDisabled {
@@ -1384,6 +1667,7 @@ func (p *proxy) sendOwnTbl(w http.ResponseWriter, r *http.Request, msg *apc.ActM
 	)
 	{
 		cargs.req = cmn.HreqArgs{Method: http.MethodPut, Path: apc.URLPathClu.S, Body: cos.MustMarshal(msg)}
+		cargs.rid = r.Header.Get(apc.HdrReqID)
 		cargs.timeout = apc.DefaultTimeout
 	}
 	for pid, psi := range smap.Pmap {
@@ -1451,6 +1735,11 @@ func (p *proxy) rmNode(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg)
 		p.writeErrf(w, r, "%s is the current primary, cannot perform action %q on itself", p, msg.Action)
 		return
 	}
+	if opts.WarmRestart && (msg.Action != apc.ActShutdownNode || !si.IsTarget() || inMaint) {
+		p.writeErrf(w, r, "warm restart is only supported when shutting down an active target (%s %s)",
+			msg.Action, si.StringEx())
+		return
+	}
 
 	nlog.Infof("%s: %s(%s) opts=%v", p, msg.Action, si.StringEx(), opts)
 
@@ -1475,6 +1764,15 @@ func (p *proxy) rmNode(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg)
 		if err != nil {
 			p.writeErr(w, r, cmn.NewErrFailedTo(p, msg.Action, si, err), ecode)
 		}
+	case opts.WarmRestart: // target (see prxwarm.go)
+		grace := cmn.GCO.Get().Rebalance.Grace()
+		p.warm.add(si, grace)
+		if _, err := p.rmTarget(si, msg, false /*reb*/); err != nil {
+			p.warm.del(si.ID())
+			p.writeErr(w, r, cmn.NewErrFailedTo(p, msg.Action, si, err))
+			return
+		}
+		p.warm.gfn(si, grace)
 	default: // target
 		reb := !opts.SkipRebalance && cmn.GCO.Get().Rebalance.Enabled && !inMaint
 		nlog.Infof("%s: %s reb=%t", p, msg.Action, reb)
@@ -1709,6 +2007,7 @@ func (p *proxy) cluputItems(w http.ResponseWriter, r *http.Request, items []stri
 			}
 			path = cos.JoinWords(path, phase)
 			args.req = cmn.HreqArgs{Method: http.MethodPut, Path: path}
+			args.rid = r.Header.Get(apc.HdrReqID)
 			args.to = core.Targets
 			results := p.bcastGroup(args)
 			freeBcArgs(args)
@@ -1954,6 +2253,7 @@ func (p *proxy) _setPrimary(w http.ResponseWriter, r *http.Request, npsi *meta.S
 	q.Set(apc.QparamPrepare, "true")
 	args := allocBcArgs()
 	args.req = cmn.HreqArgs{Method: http.MethodPut, Path: urlPath, Query: q}
+	args.rid = r.Header.Get(apc.HdrReqID)
 
 	cluMeta, errM := p.cluMeta(cmetaFillOpt{skipSmap: true, skipPrimeTime: true})
 	if errM != nil {
@@ -1992,6 +2292,7 @@ func (p *proxy) _setPrimary(w http.ResponseWriter, r *http.Request, npsi *meta.S
 	q.Set(apc.QparamPrepare, "false")
 	args = allocBcArgs()
 	args.req = cmn.HreqArgs{Method: http.MethodPut, Path: urlPath, Query: q}
+	args.rid = r.Header.Get(apc.HdrReqID)
 	args.to = core.AllNodes
 	results = p.bcastGroup(args)
 	freeBcArgs(args)
@@ -2208,6 +2509,11 @@ func (p *proxy) canRebalance() (err error) {
 
 // rebalance's `must`: compares previous and current (cloned, updated) Smap
 // TODO: bmd.num-buckets == 0 would be an easy one to check
+// (see markDataLoss)
+func dataLossRejoin(smap *smapX, nsi *meta.Snode, flags cos.NodeStateFlags) bool {
+	return nsi.IsTarget() && flags.IsSet(cos.DataLoss) && smap.GetNode(nsi.ID()) != nil
+}
+
 func mustRebalance(ctx *smapModifier, cur *smapX) bool {
 	if !cmn.GCO.Get().Rebalance.Enabled {
 		return false
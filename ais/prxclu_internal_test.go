@@ -0,0 +1,64 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+func TestDataLossRejoin(t *testing.T) {
+	config := cmn.GCO.BeginUpdate()
+	enabled := config.Rebalance.Enabled
+	config.Rebalance.Enabled = true
+	cmn.GCO.CommitUpdate(config)
+	defer func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Rebalance.Enabled = enabled
+		cmn.GCO.CommitUpdate(config)
+	}()
+
+	var (
+		ni   = meta.NetInfo{}
+		smap = newSmap()
+	)
+	smap.Primary = newSnode("p1", apc.Proxy, ni, ni, ni)
+	smap.addProxy(smap.Primary)
+	smap.addTarget(newSnode("t1", apc.Target, ni, ni, ni))
+	smap.addTarget(newSnode("t2", apc.Target, ni, ni, ni))
+
+	tests := []struct {
+		name   string
+		nsi    *meta.Snode
+		flags  cos.NodeStateFlags
+		expect bool
+	}{
+		{"known target w/ data loss", newSnode("t1", apc.Target, ni, ni, ni), cos.DataLoss, true},
+		{"known target", newSnode("t1", apc.Target, ni, ni, ni), 0, false},
+		{"new target w/ data loss", newSnode("t3", apc.Target, ni, ni, ni), cos.DataLoss, false},
+		{"proxy w/ data loss", newSnode("p1", apc.Proxy, ni, ni, ni), cos.DataLoss, false},
+	}
+	for _, test := range tests {
+		flags := test.flags
+		if dataLossRejoin(smap, test.nsi, flags) {
+			flags = flags.Set(cos.Restarted)
+		}
+		if restarted := flags.IsSet(cos.Restarted); restarted != test.expect {
+			t.Fatalf("%s: expected restarted %t, got %t", test.name, test.expect, restarted)
+		}
+
+		// same node rejoining (no Smap changes): global rebalance only when restarted
+		cur := smap.clone()
+		cur.Version++
+		ctx := &smapModifier{smap: smap, restarted: flags.IsSet(cos.Restarted)}
+		if reb := mustRebalance(ctx, cur); reb != test.expect {
+			t.Errorf("%s: expected rebalance %t, got %t", test.name, test.expect, reb)
+		}
+	}
+}
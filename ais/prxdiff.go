@@ -0,0 +1,223 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// Bucket diff (apc.ActDiffBck):
+// - the proxy lists both buckets page by page and merges the two (lexicographically ordered)
+//   listings on the fly, streaming the delta to the client as it goes (see cmn.DiffBckRes)
+// - an object "differs" when its sizes differ or, if comparable, its checksums or versions do;
+//   checksums and versions are comparable only between buckets with the same backend provider
+//   (and, for checksums, the same checksum type)
+// - either bucket can be remote, including remote AIS (i.e., bucket in an attached cluster)
+// - once streaming has started, failure to list the next page is reported via cmn.DiffBckRes.Err
+
+type (
+	bdiffSide struct {
+		p     *proxy
+		bck   *meta.Bck
+		hdr   http.Header
+		smap  *smapX
+		amsg  apc.ActMsg
+		lsmsg apc.LsoMsg
+		page  cmn.LsoEntries
+		idx   int
+		done  bool
+	}
+	bdiff struct {
+		a, b       bdiffSide
+		res        cmn.DiffBckRes // (counts only)
+		cmpCksum   bool
+		cmpVersion bool
+	}
+)
+
+// POST {apc.ActDiffBck} /v1/buckets/<bucket-name>?bck_to=<uname>
+func (p *proxy) diffBck(w http.ResponseWriter, r *http.Request, bckA *meta.Bck, msg *apc.ActMsg, query url.Values) {
+	var dmsg apc.DiffBckMsg
+	if err := cos.MorphMarshal(msg.Value, &dmsg); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	bckB, err := newBckFromQuname(query, true /*required*/)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if bckB.IsRemoteAIS() {
+		bckB.Ns.UUID = p.a2u(bckB.Ns.UUID) // resolve alias upfront (compare w/ bctx.init)
+	}
+	bargs := bctx{p: p, w: w, r: r, bck: bckB, perms: apc.AceObjLIST, msg: msg, query: query}
+	if bckB, err = bargs.initAndTry(); err != nil {
+		return
+	}
+	if bckA.Equal(bckB, true, true) {
+		p.writeErrf(w, r, "cannot %s bucket %q with itself", msg.Action, bckA)
+		return
+	}
+
+	var (
+		d    = &bdiff{}
+		smap = p.owner.smap.get()
+	)
+	d.a.init(p, bckA, &dmsg, r.Header, smap)
+	d.b.init(p, bckB, &dmsg, r.Header, smap)
+	d.cmpVersion = d.a.provider() == d.b.provider()
+	d.cmpCksum = d.cmpVersion && bckA.Props.Cksum.Type == bckB.Props.Cksum.Type
+
+	// first pages: fail the request as usual
+	if _, err := d.a.peek(); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if _, err := d.b.peek(); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+
+	w.Header().Set(cos.HdrContentType, cos.ContentJSONCharsetUTF)
+	j := cos.JSON.BorrowStream(w)
+	defer cos.JSON.ReturnStream(j)
+
+	j.WriteObjectStart()
+	j.WriteObjectField("entries")
+	j.WriteArrayStart()
+	var cnt int
+	err = d.run(func(en *cmn.DiffBckEntry) error {
+		if cnt > 0 {
+			j.WriteMore()
+		}
+		cnt++
+		j.WriteVal(en)
+		if j.Buffered() >= cmn.MsgpLsoBufSize {
+			return j.Flush()
+		}
+		return nil
+	})
+	j.WriteArrayEnd()
+	for _, kv := range []struct {
+		k string
+		v int64
+	}{{"only_a", d.res.OnlyA}, {"only_b", d.res.OnlyB}, {"differ", d.res.Differ}, {"same", d.res.Same}} {
+		j.WriteMore()
+		j.WriteObjectField(kv.k)
+		j.WriteString(strconv.FormatInt(kv.v, 10))
+	}
+	if err != nil {
+		nlog.Warningln(p.String()+":", msg.Action, bckA.Cname(""), "vs", bckB.Cname(""), "interrupted:", err)
+		j.WriteMore()
+		j.WriteObjectField("error")
+		j.WriteString(err.Error())
+	}
+	j.WriteObjectEnd()
+	j.WriteRaw("\n")
+	if err := j.Flush(); err != nil && cmn.Rom.FastV(4, cos.SmoduleAIS) {
+		nlog.Errorln("failed to transmit", msg.Action, "results:", err)
+	}
+}
+
+// merge two ordered listings
+func (d *bdiff) run(emit func(*cmn.DiffBckEntry) error) error {
+	var en cmn.DiffBckEntry
+	for {
+		ea, err := d.a.peek()
+		if err != nil {
+			return err
+		}
+		eb, err := d.b.peek()
+		if err != nil {
+			return err
+		}
+		switch {
+		case ea == nil && eb == nil:
+			return nil
+		case eb == nil || (ea != nil && ea.Name < eb.Name):
+			en = cmn.DiffBckEntry{Name: ea.Name, What: apc.DiffOnlyA, A: ea}
+			d.res.OnlyA++
+			d.a.idx++
+		case ea == nil || eb.Name < ea.Name:
+			en = cmn.DiffBckEntry{Name: eb.Name, What: apc.DiffOnlyB, B: eb}
+			d.res.OnlyB++
+			d.b.idx++
+		default:
+			d.a.idx++
+			d.b.idx++
+			if !d.differ(ea, eb) {
+				d.res.Same++
+				continue
+			}
+			en = cmn.DiffBckEntry{Name: ea.Name, What: apc.DiffDiffer, A: ea, B: eb}
+			d.res.Differ++
+		}
+		if err := emit(&en); err != nil {
+			return err
+		}
+	}
+}
+
+func (d *bdiff) differ(a, b *cmn.LsoEnt) bool {
+	if a.Size != b.Size {
+		return true
+	}
+	if d.cmpCksum && a.Checksum != "" && b.Checksum != "" && a.Checksum != b.Checksum {
+		return true
+	}
+	return d.cmpVersion && a.Version != "" && b.Version != "" && a.Version != b.Version
+}
+
+///////////////
+// bdiffSide //
+///////////////
+
+func (s *bdiffSide) init(p *proxy, bck *meta.Bck, dmsg *apc.DiffBckMsg, hdr http.Header, smap *smapX) {
+	s.p, s.bck, s.hdr, s.smap = p, bck, hdr, smap
+	s.lsmsg = apc.LsoMsg{Prefix: dmsg.Prefix}
+	s.lsmsg.AddProps(apc.GetPropsName, apc.GetPropsSize, apc.GetPropsChecksum, apc.GetPropsVersion)
+	s.lsmsg.SetFlag(apc.LsNoDirs)
+	if dmsg.Cached || bck.IsOffline() {
+		s.lsmsg.SetFlag(apc.LsObjCached)
+	}
+	s.amsg = apc.ActMsg{Action: apc.ActList, Value: &s.lsmsg}
+}
+
+// provider of the listed content
+func (s *bdiffSide) provider() string {
+	if backend := s.bck.Backend(); backend != nil {
+		return backend.Provider
+	}
+	return s.bck.Provider
+}
+
+// current entry or nil when done
+func (s *bdiffSide) peek() (*cmn.LsoEnt, error) {
+	for {
+		for ; s.idx < len(s.page); s.idx++ {
+			if en := s.page[s.idx]; !en.IsDir() {
+				return en, nil
+			}
+		}
+		if s.done {
+			return nil, nil
+		}
+		lst, err := s.p.lsPage(s.bck, &s.amsg, &s.lsmsg, s.hdr, s.smap)
+		if err != nil {
+			return nil, err
+		}
+		s.page, s.idx = lst.Entries, 0
+		s.lsmsg.ContinuationToken = lst.ContinuationToken
+		s.done = lst.ContinuationToken == ""
+	}
+}
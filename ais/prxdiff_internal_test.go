@@ -0,0 +1,52 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+func TestBucketDiffMerge(t *testing.T) {
+	d := &bdiff{cmpCksum: true}
+	d.a.page = cmn.LsoEntries{
+		{Name: "a", Size: 1},
+		{Name: "b", Size: 1, Checksum: "x"},
+		{Name: "d/", Flags: apc.EntryIsDir},
+		{Name: "e", Size: 2},
+		{Name: "f", Size: 3, Version: "1"},
+	}
+	d.b.page = cmn.LsoEntries{
+		{Name: "b", Size: 1, Checksum: "y"},
+		{Name: "c", Size: 1},
+		{Name: "e", Size: 2},
+		{Name: "f", Size: 3, Version: "2"}, // (versions are not compared)
+		{Name: "g", Size: 5},
+	}
+	d.a.done, d.b.done = true, true
+
+	var got []string
+	err := d.run(func(en *cmn.DiffBckEntry) error {
+		got = append(got, en.Name+":"+en.What)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"a:" + apc.DiffOnlyA, "b:" + apc.DiffDiffer, "c:" + apc.DiffOnlyB, "g:" + apc.DiffOnlyB}
+	if len(got) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+	for i := range got {
+		if got[i] != expected[i] {
+			t.Fatalf("expected %v, got %v", expected, got)
+		}
+	}
+	if d.res.OnlyA != 1 || d.res.OnlyB != 2 || d.res.Differ != 1 || d.res.Same != 2 {
+		t.Fatalf("unexpected counts: %+v", d.res)
+	}
+}
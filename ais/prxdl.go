@@ -95,6 +95,12 @@ func (p *proxy) httpdlpost(w http.ResponseWriter, r *http.Request) {
 	if !ok {
 		return
 	}
+	if dlb.Type == dload.TypeDataset {
+		if dlb, body, err = p.dlexpand(r, dlb.RawMessage); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+	}
 
 	var progressInterval = dload.DownloadProgressInterval
 	if dlBase.ProgressInterval != "" {
@@ -270,6 +276,26 @@ func (p *proxy) dlstart(r *http.Request, xid, jobID string, body []byte) (ecode
 	return
 }
 
+// resolve dataset repository into multi-download (see ext/dload/dsource.go)
+func (p *proxy) dlexpand(r *http.Request, raw []byte) (dload.Body, []byte, error) {
+	var (
+		dlb = dload.Body{Type: dload.TypeMulti}
+		dsb = &dload.DatasetBody{}
+	)
+	if err := jsoniter.Unmarshal(raw, dsb); err != nil {
+		return dlb, nil, fmt.Errorf(cmn.FmtErrUnmarshal, p, "dataset download", cos.BHead(raw), err)
+	}
+	if err := dsb.Validate(); err != nil {
+		return dlb, nil, err
+	}
+	mb, err := dsb.Expand(r.Context(), cmn.GCO.Get().Client.TimeoutLong.D())
+	if err != nil {
+		return dlb, nil, err
+	}
+	dlb.RawMessage = cos.MustMarshal(mb)
+	return dlb, cos.MustMarshal(dlb), nil
+}
+
 func (p *proxy) validateDownload(w http.ResponseWriter, r *http.Request, body []byte) (dlb dload.Body, dlBase dload.Base, ok bool) {
 	if err := jsoniter.Unmarshal(body, &dlb); err != nil {
 		err = fmt.Errorf(cmn.FmtErrUnmarshal, p, "download request", cos.BHead(body), err)
@@ -284,8 +310,15 @@ func (p *proxy) validateDownload(w http.ResponseWriter, r *http.Request, body []
 	bck := meta.CloneBck(&dlBase.Bck)
 	args := bctx{p: p, w: w, r: r, reqBody: body, bck: bck, perms: apc.AccessRW}
 	args.createAIS = true
-	if _, err := args.initAndTry(); err == nil {
-		ok = true
+	if _, err := args.initAndTry(); err != nil {
+		return
+	}
+	if dlBase.ETLName != "" {
+		if err := p.checkBckETL(dlBase.ETLName); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
 	}
+	ok = true
 	return
 }
@@ -0,0 +1,174 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/hk"
+)
+
+// Config drift (periodic.config_drift_time):
+// - every so often, the primary queries all nodes for their live configuration (apc.WhatNodeConfig)
+//   and compares its cluster-scope part with the cluster config (ConfigMD), key by key
+// - a node drifts in one of two ways: its config version is behind (e.g., the node missed
+//   a metasync), or the version is current but some of the values differ (e.g., node-level
+//   overrides via api.SetDaemonConfig)
+// - GET /v1/cluster?what=config_drift returns the most recent report (cmn.ConfigDriftReport);
+//   when the periodic check is disabled, the query runs it on demand
+// - with feat.FixConfigDrift, the primary resets (apc.ActResetConfig) the nodes that drift
+//   in the second way; lagging nodes are only reported - the next metasync brings them up to date
+
+const cdriftIdleIval = time.Minute // (disabled or not primary)
+
+type cdrift struct {
+	p       *proxy
+	last    *cmn.ConfigDriftReport
+	mu      sync.Mutex
+	running atomic.Bool
+}
+
+func (cd *cdrift) init(p *proxy) {
+	cd.p = p
+	hk.Reg("config-drift"+hk.NameSuffix, cd.housekeep, cdriftIdleIval)
+}
+
+func (cd *cdrift) housekeep() time.Duration {
+	var (
+		p    = cd.p
+		ival = cmn.GCO.Get().Periodic.ConfigDriftTime.D()
+	)
+	if ival == 0 || !p.ClusterStarted() {
+		return cdriftIdleIval
+	}
+	if smap := p.owner.smap.get(); !smap.isPrimary(p.si) {
+		return cdriftIdleIval
+	}
+	cd.check()
+	return ival
+}
+
+func (cd *cdrift) report() *cmn.ConfigDriftReport {
+	cd.mu.Lock()
+	last := cd.last
+	cd.mu.Unlock()
+	if last == nil || cmn.GCO.Get().Periodic.ConfigDriftTime == 0 {
+		return cd.check()
+	}
+	return last
+}
+
+func (cd *cdrift) check() *cmn.ConfigDriftReport {
+	if !cd.running.CAS(false, true) {
+		cd.mu.Lock()
+		last := cd.last
+		cd.mu.Unlock()
+		return last
+	}
+	defer cd.running.Store(false)
+
+	p := cd.p
+	authConfig, err := p.owner.config.get()
+	if err != nil {
+		nlog.Errorln(p.String()+": config drift:", err)
+		return nil
+	}
+	auth := &authConfig.ClusterConfig
+	report := &cmn.ConfigDriftReport{
+		Nodes:   make(map[string]*cmn.NodeConfigDrift, 4),
+		Time:    time.Now().UnixNano(),
+		Version: auth.Version,
+	}
+
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodGet,
+		Path:   apc.URLPathDae.S,
+		Query:  url.Values{apc.QparamWhat: []string{apc.WhatNodeConfig}},
+	}
+	args.to = core.AllNodes
+	args.cresv = cresCF{} // -> cmn.Config
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	cd.cmp(p.SID(), &cmn.GCO.Get().ClusterConfig, auth, report)
+	for _, res := range results {
+		if res.err != nil {
+			report.Nodes[res.si.ID()] = &cmn.NodeConfigDrift{Err: res.err.Error()}
+			continue
+		}
+		cd.cmp(res.si.ID(), &res.v.(*cmn.Config).ClusterConfig, auth, report)
+	}
+	freeBcastRes(results)
+
+	if len(report.Nodes) > 0 && cmn.Rom.Features().IsSet(feat.FixConfigDrift) {
+		cd.fix(report)
+	}
+	cd.mu.Lock()
+	cd.last = report
+	cd.mu.Unlock()
+	return report
+}
+
+func (cd *cdrift) cmp(sid string, live, auth *cmn.ClusterConfig, report *cmn.ConfigDriftReport) {
+	keys := live.DriftFrom(auth)
+	if len(keys) == 0 {
+		return
+	}
+	report.Nodes[sid] = &cmn.NodeConfigDrift{Keys: keys, Version: live.Version}
+	nlog.Warningln(cd.p.String()+": node", sid, "config drift:", len(keys), "key(s), version", live.Version,
+		"vs cluster", auth.Version)
+}
+
+// reset to cluster config the nodes that have the current version
+func (cd *cdrift) fix(report *cmn.ConfigDriftReport) {
+	var (
+		p    = cd.p
+		smap = p.owner.smap.get()
+		body = cos.MustMarshal(apc.ActMsg{Action: apc.ActResetConfig})
+	)
+	for sid, nd := range report.Nodes {
+		if nd.Err != "" || nd.Version != report.Version {
+			continue
+		}
+		if sid == p.SID() {
+			if err := p.owner.config.resetDaemonConfig(); err != nil {
+				nlog.Errorln(p.String()+": failed to reset config:", err)
+				continue
+			}
+			nd.Fixed = true
+			continue
+		}
+		si := smap.GetNode(sid)
+		if si == nil {
+			continue
+		}
+		cargs := allocCargs()
+		{
+			cargs.si = si
+			cargs.req = cmn.HreqArgs{Method: http.MethodPut, Path: apc.URLPathDae.S, Body: body}
+			cargs.timeout = cmn.Rom.CplaneOperation()
+		}
+		res := p.call(cargs, smap)
+		freeCargs(cargs)
+		if res.err != nil {
+			nlog.Errorln(p.String()+": failed to reset", si.StringEx(), "config:", res.err)
+		} else {
+			nd.Fixed = true
+			nlog.Infoln(p.String()+": reset", si.StringEx(), "config to cluster config v", report.Version)
+		}
+		freeCR(res)
+	}
+}
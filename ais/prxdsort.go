@@ -0,0 +1,140 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/ext/dsort"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// dsort job templates: named (partial) dsort specs stored in the cluster config
+// (see cmn.ClusterConfig.DsortTemplates) and referenced by name at submission time
+
+// [METHOD] /v1/sort/templates[/name]
+func (p *proxy) dsortTmpls(w http.ResponseWriter, r *http.Request, items []string) {
+	var name string
+	if len(items) > 0 {
+		name = items[0]
+	}
+	switch r.Method {
+	case http.MethodGet:
+		tmpls := cmn.GCO.Get().DsortTemplates
+		if name == "" {
+			if tmpls == nil {
+				tmpls = cos.JSONRawMsgs{}
+			}
+			p.writeJSON(w, r, tmpls, "dsort-templates")
+			return
+		}
+		tmpl, ok := tmpls[name]
+		if !ok {
+			p.writeErr(w, r, cos.NewErrNotFound(p, "dsort job template "+name), http.StatusNotFound)
+			return
+		}
+		w.Header().Set(cos.HdrContentType, cos.ContentJSON)
+		w.Write(tmpl)
+	case http.MethodPut:
+		if name == "" {
+			p.writeErrURL(w, r)
+			return
+		}
+		if err := cos.CheckAlphaPlus(name, "dsort job template name"); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		body, err := cos.ReadAllN(r.Body, r.ContentLength)
+		if err != nil {
+			p.writeErrStatusf(w, r, http.StatusInternalServerError, "failed to receive dsort job template: %v", err)
+			return
+		}
+		tmpl := &dsort.RequestSpec{}
+		if err := jsoniter.Unmarshal(body, tmpl); err != nil {
+			err = fmt.Errorf(cmn.FmtErrUnmarshal, p, "dsort job template", cos.BHead(body), err)
+			p.writeErr(w, r, err)
+			return
+		}
+		if tmpl.JobTemplate != "" {
+			p.writeErrf(w, r, "dsort job template %q cannot reference another template (%q)", name, tmpl.JobTemplate)
+			return
+		}
+		msg := &apc.ActMsg{Action: apc.ActSetDsortTmpl, Name: name}
+		if p.forwardCP(w, r, msg, name, body) {
+			return
+		}
+		p._modDsortTmpl(w, r, msg, cos.MustMarshal(tmpl))
+	case http.MethodDelete:
+		if name == "" {
+			p.writeErrURL(w, r)
+			return
+		}
+		msg := &apc.ActMsg{Action: apc.ActDelDsortTmpl, Name: name}
+		if p.forwardCP(w, r, msg, name) {
+			return
+		}
+		p._modDsortTmpl(w, r, msg, nil)
+	default:
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodPut)
+	}
+}
+
+func (p *proxy) _modDsortTmpl(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg, tmpl []byte) {
+	ctx := &configModifier{
+		pre: func(_ *configModifier, clone *globalConfig) (bool, error) {
+			tmpls := make(cos.JSONRawMsgs, len(clone.DsortTemplates)+1)
+			for k, v := range clone.DsortTemplates {
+				tmpls[k] = v
+			}
+			if msg.Action == apc.ActDelDsortTmpl {
+				if _, ok := tmpls[msg.Name]; !ok {
+					return false, cos.NewErrNotFound(p, "dsort job template "+msg.Name)
+				}
+				delete(tmpls, msg.Name)
+				if len(tmpls) == 0 {
+					tmpls = nil
+				}
+			} else {
+				tmpls[msg.Name] = tmpl
+			}
+			clone.DsortTemplates = tmpls
+			return true, nil
+		},
+		final: p._syncConfFinal,
+		msg:   msg,
+		wait:  true,
+	}
+	if _, err := p.owner.config.modify(ctx); err != nil {
+		if cos.IsErrNotFound(err) {
+			p.writeErr(w, r, err, http.StatusNotFound)
+		} else {
+			p.writeErr(w, r, err)
+		}
+		return
+	}
+	nlog.Infoln(p.String()+":", msg.Action, msg.Name)
+}
+
+// start from the named template, if requested
+func dsortApplyTmpl(rs *dsort.RequestSpec) (int, error) {
+	if rs.JobTemplate == "" {
+		return 0, nil
+	}
+	b, ok := cmn.GCO.Get().DsortTemplates[rs.JobTemplate]
+	if !ok {
+		return http.StatusNotFound, cos.NewErrNotFound(nil, "dsort job template "+rs.JobTemplate)
+	}
+	tmpl := &dsort.RequestSpec{}
+	if err := jsoniter.Unmarshal(b, tmpl); err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("dsort job template %q: %v", rs.JobTemplate, err)
+	}
+	rs.ApplyTemplate(tmpl)
+	return 0, nil
+}
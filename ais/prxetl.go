@@ -265,6 +265,17 @@ func (p *proxy) _syncEtlMDFinal(ctx *etlMDModifier, clone *etlMD) {
 }
 
 // GET /v1/etl/<etl-name>
+// bucket's default ETL (cmn.BckETLConf) must exist when being set
+func (p *proxy) checkBckETL(etlName string) error {
+	if err := k8s.ValidateEtlName(etlName); err != nil {
+		return err
+	}
+	if p.owner.etl.get().get(etlName) == nil {
+		return cos.NewErrNotFound(p, "etl job "+etlName)
+	}
+	return nil
+}
+
 func (p *proxy) infoETL(w http.ResponseWriter, r *http.Request, etlName string) {
 	if err := k8s.ValidateEtlName(etlName); err != nil {
 		p.writeErr(w, r, err)
@@ -287,6 +298,7 @@ func (p *proxy) listETL(w http.ResponseWriter, r *http.Request) {
 		etls *etl.InfoList
 	)
 	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: apc.URLPathETL.S}
+	args.rid = r.Header.Get(apc.HdrReqID)
 	args.timeout = apc.DefaultTimeout
 	args.cresv = cresEI{} // -> etl.InfoList
 	results := p.bcastGroup(args)
@@ -305,6 +317,7 @@ func (p *proxy) listETL(w http.ResponseWriter, r *http.Request) {
 		} else {
 			another := res.v.(*etl.InfoList)
 			sort.Sort(another)
+			mergeETLStatus(etls, another)
 			if !reflect.DeepEqual(etls, another) {
 				// TODO: Should we return an error to a user?
 				// Or stop mismatching ETLs and return internal server error?
@@ -319,6 +332,28 @@ func (p *proxy) listETL(w http.ResponseWriter, r *http.Request) {
 	p.writeJSON(w, r, *etls, "list-etl")
 }
 
+// ETL is reported degraded if any target says so; restarts are summed up;
+// average latency is the max across targets
+// (modifies `another` as well, to keep comparing apples to apples)
+func mergeETLStatus(etls, another *etl.InfoList) {
+	for i := range *etls {
+		a := &(*etls)[i]
+		for j := range *another {
+			b := &(*another)[j]
+			if a.Name != b.Name {
+				continue
+			}
+			if b.Status == etl.StatusDegraded {
+				a.Status = etl.StatusDegraded
+			}
+			a.Restarts += b.Restarts
+			a.Latency = max(a.Latency, b.Latency)
+			b.Status, b.Restarts, b.Latency = a.Status, a.Restarts, a.Latency
+			break
+		}
+	}
+}
+
 // GET /v1/etl/<etl-name>/logs[/<target_id>]
 func (p *proxy) logsETL(w http.ResponseWriter, r *http.Request, etlName string, apiItems ...string) {
 	var (
@@ -340,6 +375,7 @@ func (p *proxy) logsETL(w http.ResponseWriter, r *http.Request, etlName string,
 		cargs := allocCargs()
 		{
 			cargs.req = cmn.HreqArgs{Method: http.MethodGet, Path: apc.URLPathETL.Join(etlName, apc.ETLLogs)}
+			cargs.rid = r.Header.Get(apc.HdrReqID)
 			cargs.si = si
 			cargs.timeout = apc.DefaultTimeout
 			cargs.cresv = cresEL{} // -> etl.Logs
@@ -350,6 +386,7 @@ func (p *proxy) logsETL(w http.ResponseWriter, r *http.Request, etlName string,
 		// all targets
 		args = allocBcArgs()
 		args.req = cmn.HreqArgs{Method: http.MethodGet, Path: r.URL.Path}
+		args.rid = r.Header.Get(apc.HdrReqID)
 		args.timeout = apc.DefaultTimeout
 		args.cresv = cresEL{} // -> etl.Logs
 		results = p.bcastGroup(args)
@@ -376,6 +413,7 @@ func (p *proxy) healthETL(w http.ResponseWriter, r *http.Request) {
 	)
 	args = allocBcArgs()
 	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: r.URL.Path}
+	args.rid = r.Header.Get(apc.HdrReqID)
 	results = p.bcastGroup(args)
 	defer freeBcastRes(results)
 	freeBcArgs(args)
@@ -403,6 +441,7 @@ func (p *proxy) metricsETL(w http.ResponseWriter, r *http.Request) {
 	)
 	args = allocBcArgs()
 	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: r.URL.Path}
+	args.rid = r.Header.Get(apc.HdrReqID)
 	args.timeout = apc.DefaultTimeout
 	args.cresv = cresEM{} // -> etl.CPUMemByTarget
 	results = p.bcastGroup(args)
@@ -425,6 +464,7 @@ func (p *proxy) metricsETL(w http.ResponseWriter, r *http.Request) {
 func (p *proxy) stopETL(w http.ResponseWriter, r *http.Request) {
 	args := allocBcArgs()
 	args.req = cmn.HreqArgs{Method: http.MethodPost, Path: r.URL.Path}
+	args.rid = r.Header.Get(apc.HdrReqID)
 	args.timeout = apc.LongTimeout
 	results := p.bcastGroup(args)
 	freeBcArgs(args)
@@ -0,0 +1,163 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// Federated (global namespace) view across attached remote AIS clusters
+// - list-buckets (apc.QparamFederated): this cluster's ais:// buckets followed by all buckets
+//   of all attached remote clusters, the latter identified by their respective namespaces (@uuid)
+// - list-objects (apc.LsFederated): given ais://bucket, list it in this cluster (if present) and
+//   then in each attached remote cluster that has a same-named bucket - one origin at a time
+// - each listed entry is annotated with its origin cluster: `Location` = "@uuid[; location]"
+// - federated continuation token: "<origin namespace>|<origin's listing UUID>|<origin's own token>"
+//   where empty UUID and empty token indicate the (next) origin's first page
+
+const fedTokenSepa = "|"
+
+func fedToken(ns cmn.Ns, uuid, token string) string {
+	return ns.Uname() + fedTokenSepa + uuid + fedTokenSepa + token
+}
+
+func parseFedToken(s string) (ns cmn.Ns, uuid, token string, err error) {
+	parts := strings.SplitN(s, fedTokenSepa, 3)
+	if len(parts) != 3 {
+		return ns, "", "", fmt.Errorf("invalid federated continuation token %q", s)
+	}
+	return cmn.ParseNsUname(parts[0]), parts[1], parts[2], nil
+}
+
+// (prior to lsoReverse) the listing UUID of the current origin determines the owner
+func fedPrep(bck *meta.Bck, lsmsg *apc.LsoMsg) error {
+	if !bck.IsAIS() || !bck.Ns.IsGlobal() {
+		return fmt.Errorf("federated listing requires ais:// bucket in the global namespace (have %s)", bck.Cname(""))
+	}
+	if lsmsg.ContinuationToken == "" {
+		lsmsg.UUID = ""
+		return nil
+	}
+	_, uuid, _, err := parseFedToken(lsmsg.ContinuationToken)
+	lsmsg.UUID = uuid
+	return err
+}
+
+func (p *proxy) lsoFederated(w http.ResponseWriter, r *http.Request, bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg, dpq *dpq) {
+	var ns cmn.Ns
+	if lsmsg.ContinuationToken == "" {
+		origins, err := p.fedOrigins(bck.Name)
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		if len(origins) == 0 {
+			p.writeErr(w, r, cmn.NewErrBckNotFound(bck.Bucket()), http.StatusNotFound)
+			return
+		}
+		ns = origins[0]
+	} else {
+		var err error
+		if ns, _, lsmsg.ContinuationToken, err = parseFedToken(lsmsg.ContinuationToken); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+	}
+	obck := meta.NewBck(bck.Name, apc.AIS, ns)
+	bckArgs := bctx{p: p, w: w, r: r, msg: amsg, perms: apc.AceObjLIST, bck: obck, dpq: dpq}
+	bckArgs.createAIS = false
+	bckArgs.dontAddRemote = true
+	if _, err := bckArgs.initAndTry(); err == nil {
+		p.listObjects(w, r, obck, amsg, lsmsg)
+	}
+}
+
+// annotate and set federated continuation token (see above)
+func (p *proxy) fedPage(bck *meta.Bck, lsmsg *apc.LsoMsg, lst *cmn.LsoRes) error {
+	origin := bck.Ns.Uname()
+	if bck.Ns.IsGlobal() {
+		origin = string(apc.NsUUIDPrefix) + p.owner.smap.get().UUID
+	}
+	for _, en := range lst.Entries {
+		if en.Location == "" {
+			en.Location = origin
+		} else {
+			en.Location = origin + apc.LocationSectSepa + en.Location
+		}
+	}
+	lst.UUID = lsmsg.UUID
+	if lst.ContinuationToken != "" {
+		lst.ContinuationToken = fedToken(bck.Ns, lsmsg.UUID, lst.ContinuationToken)
+		return nil
+	}
+	// done with this origin - next one, if any
+	origins, err := p.fedOrigins(bck.Name)
+	if err != nil {
+		return err
+	}
+	for i, ns := range origins {
+		if ns.Uname() == bck.Ns.Uname() && i < len(origins)-1 {
+			lst.ContinuationToken = fedToken(origins[i+1], "", "")
+			break
+		}
+	}
+	return nil
+}
+
+// this cluster first (if the bucket is present), followed by remote clusters in namespace order
+func (p *proxy) fedOrigins(name string) (origins []cmn.Ns, err error) {
+	bmd := p.owner.bmd.get()
+	if _, present := bmd.Get(meta.NewBck(name, apc.AIS, cmn.NsGlobal)); present {
+		origins = append(origins, cmn.NsGlobal)
+	}
+	bcks, err := p.remaisBuckets()
+	if err != nil {
+		return nil, err
+	}
+	for i := range bcks {
+		if bcks[i].Name == name {
+			origins = append(origins, bcks[i].Ns)
+		}
+	}
+	return origins, nil
+}
+
+func (p *proxy) fedListBuckets(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryBcks) {
+	if (qbck.Provider != "" && qbck.Provider != apc.AIS) || !qbck.Ns.IsGlobal() {
+		p.writeErrf(w, r, "federated list-buckets requires ais:// provider and global namespace (have %s)", qbck)
+		return
+	}
+	var (
+		bmd  = p.owner.bmd.get()
+		bcks = bmd.Select(&cmn.QueryBcks{Provider: apc.AIS, Ns: cmn.NsGlobal, Name: qbck.Name})
+	)
+	rbcks, err := p.remaisBuckets()
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	for i := range rbcks {
+		if qbck.Name == "" || rbcks[i].Name == qbck.Name {
+			bcks = append(bcks, rbcks[i])
+		}
+	}
+	sort.Sort(bcks)
+	p.writeJSON(w, r, bcks, "list-buckets")
+}
+
+// all buckets of all attached remote AIS clusters (via random target)
+func (p *proxy) remaisBuckets() (cmn.Bcks, error) {
+	if cmn.GCO.Get().Backend.Get(apc.AIS) == nil {
+		return nil, nil
+	}
+	return p.lsbTarget(&cmn.QueryBcks{Provider: apc.AIS, Ns: cmn.NsAnyRemote}, "")
+}
@@ -0,0 +1,255 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+// Long-running client requests (apc.WhatLongReqs):
+// - each proxy tracks the list-objects, bucket-summary, sample, and promote requests it serves, by the
+//   corresponding xaction ID; multi-page listings and (polled) summaries remain tracked across
+//   client calls - until the last page (final result) or lreqIdle of inactivity
+// - apc.ActCancelReq aborts the xaction cluster-wide and fails the request: the call in flight
+//   and all subsequent calls with the same ID (410 Gone)
+// - apc.ActSetReqPrio changes the request's priority: each call of a lower-priority request
+//   yields to in-flight calls of higher priority (see prioGate)
+// - /v1/cluster queries (and applies to) all proxies; /v1/daemon - the one that's being called
+
+const (
+	lreqIdle   = 2 * time.Minute
+	lreqHkIval = time.Minute
+)
+
+type (
+	lreq struct {
+		cmn.LongReq
+		last  int64 // mono time of the last call
+		level atomic.Int32
+	}
+	lcall struct {
+		e     *lreq
+		level int // entered (see prioGate)
+	}
+	lreqs struct {
+		p    *proxy
+		m    map[string]*lreq // by ID
+		gate prioGate
+		mu   sync.Mutex
+	}
+)
+
+func (lr *lreqs) init(p *proxy) {
+	lr.p = p
+	lr.m = make(map[string]*lreq, 16)
+	hk.Reg("long-requests"+hk.NameSuffix, lr.housekeep, lreqHkIval)
+}
+
+// start (or continue) tracking a given request; must be followed by `end`
+func (lr *lreqs) begin(r *http.Request, id, action, bname string) (lcall, int, error) {
+	lr.mu.Lock()
+	e, ok := lr.m[id]
+	if !ok {
+		// (at the proxy, clients specify priority via apc.HdrPriority)
+		level, err := prioParse(r.Header.Get(apc.HdrPriority))
+		if err != nil {
+			lr.mu.Unlock()
+			return lcall{}, http.StatusBadRequest, err
+		}
+		e = &lreq{LongReq: cmn.LongReq{ID: id, Action: action, Bck: bname, Client: r.RemoteAddr, Started: time.Now().UnixNano()}}
+		e.level.Store(int32(level))
+		lr.m[id] = e
+	}
+	if e.Canceled {
+		lr.mu.Unlock()
+		return lcall{}, http.StatusGone, cmn.NewErrAborted(xact.Cname(action, id), "canceled", nil)
+	}
+	e.Calls++
+	e.InFlight++
+	e.last = mono.NanoTime()
+	lc := lcall{e: e, level: int(e.level.Load())}
+	lr.mu.Unlock()
+
+	lr.gate.enter(lc.level)
+	return lc, 0, nil
+}
+
+// done: last page, final result, or error
+func (lr *lreqs) end(lc lcall, done bool) {
+	lr.gate.exit(lc.level)
+	lr.mu.Lock()
+	e := lc.e
+	e.InFlight--
+	e.last = mono.NanoTime()
+	if done && !e.Canceled && e.InFlight == 0 {
+		delete(lr.m, e.ID)
+	}
+	lr.mu.Unlock()
+}
+
+func (lr *lreqs) canceled(lc lcall) bool {
+	lr.mu.Lock()
+	canceled := lc.e.Canceled
+	lr.mu.Unlock()
+	return canceled
+}
+
+func (lr *lreqs) list() []*cmn.LongReq {
+	lr.mu.Lock()
+	out := make([]*cmn.LongReq, 0, len(lr.m))
+	for _, e := range lr.m {
+		c := e.LongReq
+		c.Proxy = lr.p.SID()
+		c.Priority = prioName(int(e.level.Load()))
+		out = append(out, &c)
+	}
+	lr.mu.Unlock()
+	return out
+}
+
+// returns false if not found
+func (lr *lreqs) act(msg *apc.ActMsg) (bool, error) {
+	lr.mu.Lock()
+	e, ok := lr.m[msg.Name]
+	if !ok {
+		lr.mu.Unlock()
+		return false, nil
+	}
+	switch msg.Action {
+	case apc.ActCancelReq:
+		if e.Canceled {
+			lr.mu.Unlock()
+			return true, nil
+		}
+		e.Canceled = true
+		lr.mu.Unlock()
+		nlog.Infoln("canceling", xact.Cname(e.Action, e.ID), e.Bck, "from", e.Client)
+		lr.abort(e.ID, e.Action)
+	default:
+		debug.Assert(msg.Action == apc.ActSetReqPrio, msg.Action)
+		pri, _ := msg.Value.(string)
+		lr.mu.Unlock()
+		level, err := prioParse(pri)
+		if err != nil {
+			return true, err
+		}
+		e.level.Store(int32(level))
+		nlog.Infoln(xact.Cname(e.Action, e.ID), "priority =>", pri)
+	}
+	return true, nil
+}
+
+// abort the xaction, if any (e.g., promote may run without one)
+func (lr *lreqs) abort(id, kind string) {
+	msg := apc.ActMsg{Action: apc.ActXactStop, Name: "canceled", Value: xact.ArgsMsg{ID: id, Kind: kind}}
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodPut, Path: apc.URLPathXactions.S, Body: cos.MustMarshal(msg)}
+	args.to = core.Targets
+	args.async = true
+	_ = lr.p.bcastGroup(args)
+	freeBcArgs(args)
+}
+
+func (lr *lreqs) housekeep() time.Duration {
+	now := mono.NanoTime()
+	lr.mu.Lock()
+	for id, e := range lr.m {
+		if e.InFlight == 0 && time.Duration(now-e.last) > lreqIdle {
+			delete(lr.m, id)
+		}
+	}
+	lr.mu.Unlock()
+	return lreqHkIval
+}
+
+func prioName(level int) string {
+	switch level {
+	case prioHigh:
+		return apc.PriorityHigh
+	case prioLow:
+		return apc.PriorityLow
+	default:
+		return apc.PriorityNormal
+	}
+}
+
+//
+// handlers
+//
+
+// GET /v1/cluster?what=long_reqs
+func (p *proxy) qcluLongReqs(w http.ResponseWriter, r *http.Request, what string) {
+	all := p.lreqs.list()
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: apc.URLPathDae.S, Query: url.Values{apc.QparamWhat: []string{what}}}
+	args.rid = r.Header.Get(apc.HdrReqID)
+	args.to = core.Proxies
+	args.cresv = cresLR{} // -> []*cmn.LongReq
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	for _, res := range results {
+		if res.err != nil {
+			nlog.Warningln(p.String()+": failed to get", what, "from", res.si.StringEx()+":", res.err)
+			continue
+		}
+		all = append(all, *res.v.(*[]*cmn.LongReq)...)
+	}
+	freeBcastRes(results)
+	sort.Slice(all, func(i, j int) bool { return all[i].Started < all[j].Started })
+	p.writeJSON(w, r, all, what)
+}
+
+// PUT {apc.ActCancelReq | apc.ActSetReqPrio} /v1/cluster (all proxies) or /v1/daemon (this one)
+func (p *proxy) lreqAct(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg, all bool) {
+	if msg.Name == "" {
+		p.writeErrf(w, r, "%s: missing request ID", msg.Action)
+		return
+	}
+	found, err := p.lreqs.act(msg)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if !found && all {
+		args := allocBcArgs()
+		args.req = cmn.HreqArgs{Method: http.MethodPut, Path: apc.URLPathDae.S, Body: cos.MustMarshal(msg)}
+		args.rid = r.Header.Get(apc.HdrReqID)
+		args.to = core.Proxies
+		results := p.bcastGroup(args)
+		freeBcArgs(args)
+		for _, res := range results {
+			switch {
+			case res.err == nil:
+				found = true
+			case res.status != http.StatusNotFound:
+				err = res.toErr()
+			}
+		}
+		freeBcastRes(results)
+	}
+	switch {
+	case found:
+	case err != nil:
+		p.writeErr(w, r, err)
+	default:
+		p.writeErr(w, r, fmt.Errorf("%s: request %q not found", msg.Action, msg.Name), http.StatusNotFound)
+	}
+}
@@ -0,0 +1,60 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+)
+
+func TestLongReqs(t *testing.T) {
+	var (
+		lr = &lreqs{m: make(map[string]*lreq, 4)}
+		r  = &http.Request{Header: http.Header{}, RemoteAddr: "10.0.0.1:5555"}
+	)
+	// multi-page listing: tracked across pages
+	lc, _, err := lr.begin(r, "ls1", apc.ActList, "ais://abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lc.level != prioNormal {
+		t.Fatalf("expected normal priority, got %d", lc.level)
+	}
+	lr.end(lc, false)
+	if e, ok := lr.m["ls1"]; !ok || e.Calls != 1 || e.InFlight != 0 {
+		t.Fatalf("expected tracked request with 1 call, got %+v", e)
+	}
+
+	// reprioritize
+	if found, err := lr.act(&apc.ActMsg{Action: apc.ActSetReqPrio, Name: "ls1", Value: apc.PriorityLow}); !found || err != nil {
+		t.Fatalf("set-priority: (%t, %v)", found, err)
+	}
+	if _, err := lr.act(&apc.ActMsg{Action: apc.ActSetReqPrio, Name: "ls1", Value: "urgent"}); err == nil {
+		t.Fatal("expected invalid priority error")
+	}
+	if found, _ := lr.act(&apc.ActMsg{Action: apc.ActSetReqPrio, Name: "nonexistent", Value: apc.PriorityLow}); found {
+		t.Fatal("expected not found")
+	}
+	lc, _, err = lr.begin(r, "ls1", apc.ActList, "ais://abc")
+	if err != nil || lc.level != prioLow {
+		t.Fatalf("expected low priority, got (%d, %v)", lc.level, err)
+	}
+
+	// last page
+	lr.end(lc, true)
+	if _, ok := lr.m["ls1"]; ok {
+		t.Fatal("expected request to be removed upon last page")
+	}
+
+	// canceled: all subsequent calls fail
+	lc, _, _ = lr.begin(r, "ls2", apc.ActList, "ais://abc")
+	lc.e.Canceled = true // (compare with lr.act - aborts xaction cluster-wide)
+	lr.end(lc, true)
+	if _, ecode, err := lr.begin(r, "ls2", apc.ActList, "ais://abc"); err == nil || ecode != http.StatusGone {
+		t.Fatalf("expected canceled request to fail with %d, got (%d, %v)", http.StatusGone, ecode, err)
+	}
+}
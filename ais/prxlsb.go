@@ -0,0 +1,129 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Multi-provider list-buckets, e.g. all aws:// and gcp:// buckets (?provider=aws,gcp):
+// - built-in providers (ais://, ht://), and all providers when filtering present buckets only,
+//   are selected from this proxy's BMD
+// - all others are listed in parallel, one (random) target per provider
+// - merged and sorted results; each bucket carries its provider
+// - fails if listing any of the providers fails (e.g., not configured)
+// See also: apc.ProviderSep, api.ListBucketsMulti
+
+type lsbRes struct {
+	err  error
+	bcks cmn.Bcks
+}
+
+// normalized and deduplicated
+func parseProviders(s string) ([]string, error) {
+	providers := make([]string, 0, 4)
+	for _, p := range strings.Split(s, apc.ProviderSep) {
+		np, err := cmn.NormalizeProvider(strings.TrimSpace(p))
+		if err != nil {
+			return nil, err
+		}
+		if !cos.StringInSlice(np, providers) {
+			providers = append(providers, np)
+		}
+	}
+	return providers, nil
+}
+
+func (p *proxy) listBucketsMulti(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryBcks, providers []string, dpq *dpq) {
+	var (
+		bmd     = p.owner.bmd.get()
+		results = make([]lsbRes, len(providers))
+		wg      sync.WaitGroup
+		present bool
+	)
+	if dpq.fltPresence != "" {
+		if v, err := strconv.Atoi(dpq.fltPresence); err == nil {
+			present = apc.IsFltPresent(v)
+		}
+	}
+	for i, provider := range providers {
+		q := *qbck
+		q.Provider = provider
+		if present || q.IsAIS() || q.IsHTTP() {
+			results[i].bcks = bmd.Select(&q)
+			continue
+		}
+		wg.Add(1)
+		go func(res *lsbRes, q *cmn.QueryBcks) {
+			res.bcks, res.err = p.lsbTarget(q, dpq.fltPresence)
+			wg.Done()
+		}(&results[i], &q)
+	}
+	wg.Wait()
+
+	var bcks cmn.Bcks
+	for i := range results {
+		if err := results[i].err; err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		bcks = append(bcks, results[i].bcks...)
+	}
+	sort.Sort(bcks)
+	p.writeJSON(w, r, bcks, "list-buckets")
+}
+
+// list buckets via random target
+func (p *proxy) lsbTarget(qbck *cmn.QueryBcks, fltPresence string) (cmn.Bcks, error) {
+	smap := p.owner.smap.get()
+	si, err := smap.GetRandTarget()
+	if err != nil {
+		return nil, err
+	}
+	query := qbck.NewQuery()
+	if fltPresence != "" {
+		if query == nil {
+			query = make(url.Values, 1)
+		}
+		query.Set(apc.QparamFltPresence, fltPresence)
+	}
+	cargs := allocCargs()
+	{
+		cargs.si = si
+		cargs.req = cmn.HreqArgs{
+			Method: http.MethodGet,
+			Path:   apc.URLPathBuckets.S,
+			Query:  query,
+			Header: http.Header{cos.HdrContentType: []string{cos.ContentJSON}},
+			Body:   cos.MustMarshal(apc.ActMsg{Action: apc.ActList, Name: qbck.Name}),
+		}
+		cargs.timeout = apc.DefaultTimeout
+	}
+	res := p.call(cargs, smap)
+	freeCargs(cargs)
+	if res.err != nil {
+		err = res.toErr()
+		freeCR(res)
+		return nil, err
+	}
+	var bcks cmn.Bcks
+	err = jsoniter.Unmarshal(res.bytes, &bcks)
+	freeCR(res)
+	if err != nil {
+		return nil, errors.New("failed to unmarshal " + qbck.String() + " buckets: " + err.Error())
+	}
+	return bcks, nil
+}
@@ -5,16 +5,23 @@
 package ais
 
 import (
+	"bytes"
+	"io"
+	"net/http"
 	"sort"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/xact"
 )
 
 //  Brief theory of operation ================================================
@@ -43,6 +50,10 @@ import (
 // Cached response (to a request) is valid if and only if the request can be
 // fulfilled by a single cache interval (otherwise, cache cannot be trusted
 // as we don't know how many objects can fit in the requested interval).
+//
+// Buffers (and, therefore, caches) are sharded across IC members by list-objects
+// uuid: a request received by any other proxy gets reverse-proxied to the (HRW)
+// owner - see lsoReverse below. Cache invalidation is IC-wide.
 
 // internal timers (rough estimates)
 const (
@@ -147,6 +158,102 @@ func (qm *lsobjMem) housekeep() time.Duration {
 	return qm.d
 }
 
+// returns true if the request was reversed to (or failed to get reversed to) its owner;
+// new list-objects gets its uuid right here, on whichever proxy receives it
+func (p *proxy) lsoReverse(w http.ResponseWriter, r *http.Request, amsg *apc.ActMsg, lsmsg *apc.LsoMsg) bool {
+	smap := p.owner.smap.get()
+	if !smap.isValid() || smap.ICCount() == 0 {
+		return p.forwardCP(w, r, amsg, lsotag) // (startup)
+	}
+	if lsmsg.UUID == "" {
+		lsmsg.UUID = cos.GenUUID()
+	}
+	psi, err := lsoOwner(smap, p.si, lsmsg.UUID, r.Header.Get(apc.HdrCallerID))
+	if err != nil {
+		p.writeErr(w, r, err, http.StatusInternalServerError)
+		return true
+	}
+	if psi == nil {
+		return false
+	}
+	amsg.Value = lsmsg
+	body := cos.MustMarshal(amsg)
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	r.ContentLength = int64(len(body))
+	r.Header.Set(apc.HdrCallerID, p.SID())
+	p.reverseNodeRequest(w, r, psi)
+	return true
+}
+
+// returns the IC member to handle list-objects page request, or nil to handle it locally
+// (only IC members own listings - see nl.Listener.SetOwner; primary always is one)
+func lsoOwner(smap *smapX, self *meta.Snode, uuid, callerID string) (*meta.Snode, error) {
+	psi, err := smap.HrwIC(uuid)
+	if err != nil {
+		return nil, err
+	}
+	if psi.ID() == self.ID() {
+		return nil, nil
+	}
+	// reversed by another proxy (with a different Smap?) - rather than ping-pong,
+	// handle it locally if IC member, or forward to primary otherwise
+	if callerID != "" && smap.GetProxy(callerID) != nil {
+		if smap.IsIC(self) || smap.isPrimary(self) {
+			return nil, nil
+		}
+		return smap.Primary, nil
+	}
+	return psi, nil
+}
+
+// IC-wide (see also: ActInvalListCache)
+func (p *proxy) invalListCache(bck *cmn.Bck) {
+	p.qm.c.invalidate(bck)
+	if smap := p.owner.smap.get(); smap.IsIC(p.si) && smap.ICCount() > 1 {
+		actMsg := &apc.ActMsg{Action: apc.ActInvalListCache, Value: bck}
+		p.bcastAsyncIC(p.newAmsg(actMsg, nil))
+	}
+}
+
+// list-objects in summary mode (apc.LsSummary): broadcast to all targets and sum up
+// their respective per-prefix counts and sizes (single response, no pagination)
+func (p *proxy) lsoSumm(w http.ResponseWriter, r *http.Request, bck *meta.Bck, lsmsg *apc.LsoMsg) {
+	if lsmsg.Depth < 0 {
+		p.writeErrf(w, r, "bad list-objects (summary) request: invalid depth %d", lsmsg.Depth)
+		return
+	}
+	var (
+		summ   = make(cmn.LsoSummMap, 16)
+		aisMsg = p.newAmsgActVal(apc.ActList, lsmsg)
+		args   = allocBcArgs()
+	)
+	args.req = cmn.HreqArgs{
+		Method: http.MethodGet,
+		Path:   apc.URLPathBuckets.Join(bck.Name),
+		Query:  bck.NewQuery(),
+		Body:   cos.MustMarshal(aisMsg),
+	}
+	args.timeout = apc.LongTimeout
+	args.smap = p.owner.smap.get()
+	args.cresv = cresLsumm{} // -> cmn.LsoSumms
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	for _, res := range results {
+		if res.err != nil {
+			if res.details == "" || res.details == dfltDetail {
+				res.details = xact.Cname(apc.ActList, lsmsg.UUID)
+			}
+			err := res.toErr()
+			freeBcastRes(results)
+			p.writeErr(w, r, err)
+			return
+		}
+		summ.Merge(*res.v.(*cmn.LsoSumms))
+	}
+	freeBcastRes(results)
+	p.writeJSON(w, r, summ.Sorted(), "list_objects_summary")
+}
+
 /////////////////
 // lsobjBuffer //
 /////////////////
@@ -5,9 +5,18 @@
 package ais
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+	jsoniter "github.com/json-iterator/go"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/tinylib/msgp/msgp"
 )
 
 var _ = Describe("ListObjectsCache+ListObjectsBuffer", func() {
@@ -452,4 +461,162 @@ var _ = Describe("ListObjectsCache+ListObjectsBuffer", func() {
 			Expect(hasEnough).To(BeFalse())
 		})
 	})
+
+	Describe("ListObjectsStream", func() {
+		// targets' pages (msgpack) decoded and fed into the stream (cf. proxy.lsoStreamIn)
+		stream := func(pages []cmn.LsoEntries, errs []error, pageSize int) (*cmn.LsoRes, string, error) {
+			ls := newLsoStream(len(pages))
+			defer close(ls.stop)
+			for i, page := range pages {
+				in := ls.add()
+				go func() {
+					var bb bytes.Buffer
+					if errs == nil || errs[i] == nil {
+						mw := msgp.NewWriter(&bb)
+						lst := &cmn.LsoRes{UUID: "uuid", Entries: page, Flags: 1 << i}
+						Expect(lst.EncodeMsg(mw)).NotTo(HaveOccurred())
+						Expect(mw.Flush()).NotTo(HaveOccurred())
+						res := &callResult{}
+						cresLsoStream{in}.read(res, &bb)
+						in.err = res.err
+					} else {
+						in.err = errs[i]
+					}
+					close(in.ch)
+				}()
+			}
+			h, err := ls.heads()
+			if err != nil {
+				return nil, "", err
+			}
+			var (
+				bb  bytes.Buffer
+				out = &cmn.LsoRes{}
+			)
+			token, err := ls.merge(&bb, h, "uuid", pageSize)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(jsoniter.Unmarshal(bb.Bytes(), out)).NotTo(HaveOccurred())
+			return out, token, nil
+		}
+
+		// `num` targets, each listing up to `pageSize` of its (sorted) names
+		targetPages := func(names []string, num, pageSize int) []cmn.LsoEntries {
+			pages := make([]cmn.LsoEntries, num)
+			for i, name := range names {
+				if len(pages[i%num]) < pageSize {
+					pages[i%num] = append(pages[i%num], &cmn.LsoEnt{Name: name, Size: int64(i)})
+				}
+			}
+			return pages
+		}
+
+		names := make([]string, 0, 3*lsoStreamMinEntries)
+		for i := range 3 * lsoStreamMinEntries {
+			names = append(names, fmt.Sprintf("obj-%05d", i))
+		}
+
+		It("should merge targets' entries up to the page size", func() {
+			pageSize := lsoStreamMinEntries
+			out, token, err := stream(targetPages(names, 3, pageSize), nil, pageSize)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.UUID).To(Equal("uuid"))
+			Expect(extractNames(out.Entries)).To(Equal(names[:pageSize]))
+			Expect(out.Entries[7].Size).To(BeEquivalentTo(7))
+			Expect(token).To(Equal(names[pageSize-1]))
+			Expect(out.ContinuationToken).To(Equal(token))
+			Expect(out.Flags).To(BeEquivalentTo(7))
+		})
+
+		It("should be done when all targets are", func() {
+			pageSize := 4 * lsoStreamMinEntries
+			out, token, err := stream(targetPages(names, 5, pageSize), nil, pageSize)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(extractNames(out.Entries)).To(Equal(names))
+			Expect(token).To(BeEmpty())
+			Expect(out.ContinuationToken).To(BeEmpty())
+			Expect(out.Flags).To(BeEquivalentTo(31))
+		})
+
+		It("should fail before writing anything when a target fails", func() {
+			pageSize := lsoStreamMinEntries
+			errs := []error{nil, errors.New("target failure"), nil}
+			_, _, err := stream(targetPages(names, 3, pageSize), errs, pageSize)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("lsoOwner", func() {
+		var (
+			smap   *smapX
+			ic     []*meta.Snode
+			nonIC  *meta.Snode
+			caller = "p-caller"
+		)
+		BeforeEach(func() {
+			smap = newSmap()
+			ic = ic[:0]
+			for i := range 3 {
+				psi := newSnode("p"+strconv.Itoa(i), apc.Proxy, meta.NetInfo{}, meta.NetInfo{}, meta.NetInfo{})
+				psi.Flags = meta.SnodeIC
+				smap.addProxy(psi)
+				ic = append(ic, psi)
+			}
+			nonIC = newSnode("p-non-ic", apc.Proxy, meta.NetInfo{}, meta.NetInfo{}, meta.NetInfo{})
+			smap.addProxy(nonIC)
+			smap.addProxy(newSnode(caller, apc.Proxy, meta.NetInfo{}, meta.NetInfo{}, meta.NetInfo{}))
+			smap.Primary = ic[0]
+		})
+
+		It("should route listings to their HRW owners, each handling its own locally", func() {
+			owners := make(map[string]int, len(ic))
+			for i := range 100 {
+				uuid := "uuid-" + strconv.Itoa(i)
+				owner, err := smap.HrwIC(uuid)
+				Expect(err).NotTo(HaveOccurred())
+				owners[owner.ID()]++
+
+				psi, err := lsoOwner(smap, nonIC, uuid, "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(psi.ID()).To(Equal(owner.ID()))
+				for _, self := range ic {
+					psi, err := lsoOwner(smap, self, uuid, "")
+					Expect(err).NotTo(HaveOccurred())
+					if self.ID() == owner.ID() {
+						Expect(psi).To(BeNil())
+					} else {
+						Expect(psi.ID()).To(Equal(owner.ID()))
+					}
+				}
+			}
+			Expect(owners).To(HaveLen(len(ic)))
+		})
+
+		It("should not handle reversed listing locally unless IC member", func() {
+			for i := range 100 {
+				uuid := "uuid-" + strconv.Itoa(i)
+				owner, _ := smap.HrwIC(uuid)
+
+				// (different Smap at the caller)
+				psi, err := lsoOwner(smap, nonIC, uuid, caller)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(psi.ID()).To(Equal(smap.Primary.ID()))
+				for _, self := range ic {
+					if self.ID() == owner.ID() {
+						continue
+					}
+					psi, err := lsoOwner(smap, self, uuid, caller)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(psi).To(BeNil())
+				}
+			}
+		})
+
+		It("should fail when IC is empty", func() {
+			for _, psi := range ic {
+				psi.Flags = 0
+			}
+			_, err := lsoOwner(smap, nonIC, "uuid", "")
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
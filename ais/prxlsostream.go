@@ -0,0 +1,350 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"container/heap"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/tinylib/msgp/msgp"
+)
+
+// Streaming list-objects (JSON) - large pages of in-cluster objects:
+// - each target's (msgpack-encoded) page is decoded entry by entry, as it is being received
+// - the proxy k-way merges the targets' entries and JSON-encodes the merged ones right away,
+//   flushing the encoder every cmn.MsgpLsoBufSize
+// - neither the targets' pages nor the merged page get materialized in the proxy's memory
+//   (and, therefore, none of it gets buffered or cached - see lsoStreamable)
+// - once the response is committed (status 200), a failure truncates it (and the client fails to decode)
+
+const (
+	lsoStreamMinEntries = 1024 // pages smaller than that are listed (and encoded) the usual way
+	lsoStreamBurst      = 256  // per-target entries in flight
+)
+
+type (
+	lsoStream struct {
+		ins  []*lsoStreamIn
+		stop chan struct{} // terminated early (error)
+		full chan struct{} // page is full - skip the rest of the targets' entries (but not their flags)
+	}
+	lsoStreamIn struct {
+		ls    *lsoStream
+		ch    chan *cmn.LsoEnt
+		err   error
+		flags uint32
+	}
+	cresLsoStream struct{ in *lsoStreamIn } // (cresv)
+
+	lsoHead struct {
+		en *cmn.LsoEnt
+		in *lsoStreamIn
+	}
+	lsoHeap []lsoHead
+)
+
+var errLsoStopped = errors.New("list-objects stream stopped")
+
+// JSON field names (cmn.LsoRes tags)
+var (
+	lsoTagUUID    = lsoTag("UUID")
+	lsoTagToken   = lsoTag("ContinuationToken")
+	lsoTagEntries = lsoTag("Entries")
+	lsoTagFlags   = lsoTag("Flags")
+)
+
+func lsoTag(field string) string {
+	f, ok := reflect.TypeOf(cmn.LsoRes{}).FieldByName(field)
+	debug.Assert(ok, field)
+	tag, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	return tag
+}
+
+// in-cluster listing of a (large) page that's been requested in JSON
+func lsoStreamable(r *http.Request, bck *meta.Bck, lsmsg *apc.LsoMsg) bool {
+	switch {
+	case strings.Contains(r.Header.Get(cos.HdrAccept), cos.ContentMsgPack) || isBrowser(r.Header.Get(cos.HdrUserAgent)):
+		return false
+	case lsmsg.PageSize != 0 && lsmsg.PageSize < lsoStreamMinEntries:
+		return false
+	case lsmsg.IsFlagSet(apc.UseListObjsCache) || lsmsg.IsFlagSet(apc.LsFederated):
+		return false
+	case bck.IsRemote() && !lsmsg.IsFlagSet(apc.LsObjCached): // (see _lsofc)
+		return false
+	}
+	return bck.CloneOf() == nil
+}
+
+// one streamed page; returns continuation token and whether the page was successfully sent
+func (p *proxy) lsPageStream(w http.ResponseWriter, r *http.Request, bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg) (string, bool) {
+	smap := p.owner.smap.get()
+	if lsmsg.UUID == "" {
+		lsmsg.UUID = cos.GenUUID()
+	}
+	if lsmsg.ContinuationToken == "" {
+		p.lsoRegNL(bck, amsg, lsmsg, smap, nil, false)
+	}
+	if lsmsg.PageSize == 0 {
+		lsmsg.PageSize = apc.MaxPageSizeAIS
+	}
+	var (
+		body = cos.MustMarshal(p.newAmsgActVal(apc.ActList, lsmsg))
+		ls   = newLsoStream(len(smap.Tmap))
+	)
+	for _, tsi := range smap.Tmap {
+		if tsi.InMaintOrDecomm() {
+			continue
+		}
+		go p.lsoStreamIn(tsi, bck, lsmsg.UUID, body, ls.add(), smap)
+	}
+	defer close(ls.stop)
+
+	h, err := ls.heads()
+	if err != nil {
+		p.writeErr(w, r, err)
+		return "", false
+	}
+	w.Header().Set(cos.HdrContentType, cos.ContentJSONCharsetUTF)
+	token, err := ls.merge(w, h, lsmsg.UUID, int(lsmsg.PageSize))
+	if err != nil {
+		p.logerr(lsotag, lsmsg, err) // (response committed)
+		return "", false
+	}
+	return token, true
+}
+
+// list target's page and feed its entries into the stream
+func (p *proxy) lsoStreamIn(tsi *meta.Snode, bck *meta.Bck, uuid string, body []byte, in *lsoStreamIn, smap *smapX) {
+	cargs := allocCargs()
+	{
+		cargs.si = tsi
+		cargs.req = cmn.HreqArgs{
+			Method: http.MethodGet,
+			Path:   apc.URLPathBuckets.Join(bck.Name),
+			Query:  bck.NewQuery(),
+			Body:   body,
+		}
+		cargs.timeout = apc.LongTimeout
+		cargs.cresv = cresLsoStream{in}
+	}
+	res := p.call(cargs, smap)
+	if res.err != nil {
+		if res.details == "" || res.details == dfltDetail {
+			res.details = xact.Cname(apc.ActList, uuid)
+		}
+		in.err = res.toErr()
+	}
+	freeCargs(cargs)
+	freeCR(res)
+	close(in.ch)
+}
+
+///////////////
+// lsoStream //
+///////////////
+
+func newLsoStream(num int) *lsoStream {
+	return &lsoStream{
+		ins:  make([]*lsoStreamIn, 0, num),
+		stop: make(chan struct{}),
+		full: make(chan struct{}),
+	}
+}
+
+func (ls *lsoStream) add() *lsoStreamIn {
+	in := &lsoStreamIn{ls: ls, ch: make(chan *cmn.LsoEnt, lsoStreamBurst)}
+	ls.ins = append(ls.ins, in)
+	return in
+}
+
+// wait for the first entry from each target (nothing is written until then)
+func (ls *lsoStream) heads() (*lsoHeap, error) {
+	h := make(lsoHeap, 0, len(ls.ins))
+	for _, in := range ls.ins {
+		en, err := in.next()
+		if err != nil {
+			return nil, err
+		}
+		if en != nil {
+			h = append(h, lsoHead{en: en, in: in})
+		}
+	}
+	heap.Init(&h)
+	return &h, nil
+}
+
+// merge and write; the field order is: uuid, entries, continuation token, and flags
+// (the latter are only known when all targets are done)
+func (ls *lsoStream) merge(w io.Writer, h *lsoHeap, uuid string, pageSize int) (token string, _ error) {
+	j := cos.JSON.BorrowStream(w)
+	defer cos.JSON.ReturnStream(j)
+
+	j.WriteObjectStart()
+	j.WriteObjectField(lsoTagUUID)
+	j.WriteString(uuid)
+	j.WriteMore()
+	j.WriteObjectField(lsoTagEntries)
+	j.WriteArrayStart()
+	var n int
+	for h.Len() > 0 {
+		head := heap.Pop(h).(lsoHead)
+		if n < pageSize {
+			if n > 0 {
+				j.WriteMore()
+			}
+			j.WriteVal(head.en)
+			if n++; n == pageSize {
+				token = head.en.Name
+				close(ls.full)
+			}
+			if j.Buffered() >= cmn.MsgpLsoBufSize {
+				if err := j.Flush(); err != nil {
+					return "", err
+				}
+			}
+		}
+		en, err := head.in.next()
+		if err != nil {
+			return "", err
+		}
+		if en != nil {
+			heap.Push(h, lsoHead{en: en, in: head.in})
+		}
+	}
+	j.WriteArrayEnd()
+
+	var flags uint32
+	for _, in := range ls.ins {
+		flags |= in.flags
+	}
+	j.WriteMore()
+	j.WriteObjectField(lsoTagToken)
+	j.WriteString(token)
+	j.WriteMore()
+	j.WriteObjectField(lsoTagFlags)
+	j.WriteUint32(flags)
+	j.WriteObjectEnd()
+	j.WriteRaw("\n")
+	if j.Error != nil {
+		return "", j.Error
+	}
+	return token, j.Flush()
+}
+
+/////////////////
+// lsoStreamIn //
+/////////////////
+
+// next entry, or nil when done
+func (in *lsoStreamIn) next() (*cmn.LsoEnt, error) {
+	if en, ok := <-in.ch; ok {
+		return en, nil
+	}
+	return nil, in.err
+}
+
+// decode cmn.LsoRes entry by entry (cf. generated cmn.LsoRes.DecodeMsg)
+func (in *lsoStreamIn) decode(dc *msgp.Reader) error {
+	cnt, err := dc.ReadMapHeader()
+	if err != nil {
+		return err
+	}
+	for ; cnt > 0; cnt-- {
+		field, err := dc.ReadMapKeyPtr()
+		if err != nil {
+			return err
+		}
+		switch msgp.UnsafeString(field) {
+		case "Entries":
+			err = in.entries(dc)
+		case "Flags":
+			in.flags, err = dc.ReadUint32()
+		default:
+			err = dc.Skip()
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (in *lsoStreamIn) entries(dc *msgp.Reader) error {
+	if dc.IsNil() {
+		return dc.ReadNil()
+	}
+	cnt, err := dc.ReadArrayHeader()
+	if err != nil {
+		return err
+	}
+	for ; cnt > 0; cnt-- {
+		select {
+		case <-in.ls.full:
+			if err := dc.Skip(); err != nil {
+				return err
+			}
+			continue
+		default:
+		}
+		if dc.IsNil() {
+			if err := dc.ReadNil(); err != nil {
+				return err
+			}
+			continue
+		}
+		en := &cmn.LsoEnt{}
+		if err := en.DecodeMsg(dc); err != nil {
+			return err
+		}
+		select {
+		case in.ch <- en:
+		case <-in.ls.stop:
+			return errLsoStopped
+		}
+	}
+	return nil
+}
+
+///////////////////
+// cresLsoStream //
+///////////////////
+
+func (c cresLsoStream) newV() any { return c.in }
+
+func (c cresLsoStream) read(res *callResult, body io.Reader) {
+	buf, slab := memsys.PageMM().AllocSize(cmn.MsgpLsoBufSize)
+	res.err = c.in.decode(msgp.NewReaderBuf(body, buf))
+	slab.Free(buf)
+}
+
+/////////////
+// lsoHeap //
+/////////////
+
+func (h lsoHeap) Len() int           { return len(h) }
+func (h lsoHeap) Less(i, j int) bool { return h[i].en.Name < h[j].en.Name }
+func (h lsoHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *lsoHeap) Push(x any) { *h = append(*h, x.(lsoHead)) }
+
+func (h *lsoHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	old[n-1] = lsoHead{}
+	*h = old[:n-1]
+	return x
+}
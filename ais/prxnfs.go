@@ -0,0 +1,326 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/ais/nfs"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+)
+
+// NFSv3 gateway (cmn.NFSConf):
+// - each proxy that has it enabled serves ais:// buckets (global namespace) as NFS exports
+// - the gateway is a regular client of the cluster: it HEADs, GETs (range reads), PUTs, and DELETEs
+//   objects directly via their respective HRW targets, and lists virtual directories (LsNoRecursion);
+//   bucket access attributes (ACL) apply
+// - NFS (AUTH_SYS) credentials cannot be mapped to AuthN users - the gateway does not start
+//   (and refuses all operations) when authentication is enabled (auth.enabled)
+// - started and stopped by the housekeeper, as per (dynamically updated) configuration
+// - see ais/nfs for the protocol, and docs/nfs.md for usage and limitations
+
+const (
+	nfsHkIval = 10 * time.Second
+	nfsHkName = "nfs-gateway" + hk.NameSuffix
+)
+
+type nfsgw struct {
+	p      *proxy
+	srv    *nfs.Server
+	port   int
+	mu     sync.Mutex
+	warned bool // (auth enabled)
+}
+
+var errNfsAuth = errors.New("nfs gateway cannot run with authentication enabled (auth.enabled): " +
+	"NFS credentials are not mapped to AuthN users")
+
+// interface guard
+var _ nfs.FS = (*nfsgw)(nil)
+
+func (gw *nfsgw) init(p *proxy) {
+	gw.p = p
+	hk.Reg(nfsHkName, gw.housekeep, nfsHkIval)
+}
+
+func (gw *nfsgw) housekeep() time.Duration {
+	config := cmn.GCO.Get()
+	gw.mu.Lock()
+	defer gw.mu.Unlock()
+	switch {
+	case !config.NFS.Enabled || !gw.p.ClusterStarted():
+		gw._stop()
+		gw.warned = false
+	case config.Auth.Enabled:
+		gw._stop()
+		if !gw.warned {
+			nlog.Errorln(gw.p.String()+":", errNfsAuth)
+			gw.warned = true
+		}
+	case gw.srv != nil && gw.port != config.NFS.Port:
+		gw._stop()
+		gw._start(config)
+	case gw.srv == nil:
+		gw._start(config)
+	default:
+		if n := gw.srv.Housekeep(); n > 0 && cmn.Rom.FastV(4, cos.SmoduleAIS) {
+			nlog.Infoln(gw.p.String()+": nfs pending writes:", n)
+		}
+	}
+	return nfsHkIval
+}
+
+func (gw *nfsgw) _start(config *cmn.Config) {
+	if config.Auth.Enabled {
+		nlog.Errorln(gw.p.String()+": failed to start:", errNfsAuth)
+		return
+	}
+	addr := net.JoinHostPort(config.HostNet.Hostname, strconv.Itoa(config.NFS.Port))
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		nlog.Errorln(gw.p.String()+": failed to start nfs gateway:", err)
+		return
+	}
+	gw.srv, gw.port = nfs.NewServer(gw), config.NFS.Port
+	nlog.Infoln(gw.p.String()+": nfs gateway listening on", addr)
+	go func(srv *nfs.Server) {
+		if err := srv.Serve(ln); err != nil {
+			nlog.Errorln(gw.p.String()+": nfs gateway:", err)
+		}
+	}(gw.srv)
+}
+
+func (gw *nfsgw) _stop() {
+	if gw.srv == nil {
+		return
+	}
+	gw.srv.Close() // (flushes pending writes)
+	gw.srv = nil
+	nlog.Infoln(gw.p.String() + ": nfs gateway stopped")
+}
+
+func (gw *nfsgw) stop() {
+	gw.mu.Lock()
+	gw._stop()
+	gw.mu.Unlock()
+}
+
+////////////
+// nfs.FS //
+////////////
+
+func (*nfsgw) Writable() bool { return cmn.GCO.Get().NFS.WriteThrough }
+
+func (gw *nfsgw) Exports() (out []string) {
+	var (
+		bmd      = gw.p.owner.bmd.get()
+		exports  = cmn.GCO.Get().NFS.ExportList()
+		provider = apc.AIS
+	)
+	if len(exports) == 0 {
+		bmd.Range(&provider, &cmn.NsGlobal, func(bck *meta.Bck) bool {
+			out = append(out, bck.Name)
+			return false
+		})
+		return out
+	}
+	for _, name := range exports {
+		if _, present := bmd.Get(meta.NewBck(name, apc.AIS, cmn.NsGlobal)); present {
+			out = append(out, name)
+		}
+	}
+	return out
+}
+
+func (gw *nfsgw) Stat(bname, objName string) (*nfs.Attr, error) {
+	bck, err := gw.bck(bname, apc.AceObjHEAD)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := gw.do(http.MethodHead, bck, objName, nil, nil, 0)
+	if err == nil {
+		var oa cmn.ObjAttrs
+		oa.FromHeader(resp.Header)
+		return &nfs.Attr{Size: oa.Size, Mtime: oa.Atime}, nil
+	}
+	if err != nfs.ErrNotFound {
+		return nil, err
+	}
+	// virtual directory?
+	lsmsg := &apc.LsoMsg{Prefix: objName + "/", PageSize: 1}
+	lsmsg.SetFlag(apc.LsNameOnly)
+	lst, err := gw.p.lsPage(bck, &apc.ActMsg{Action: apc.ActList, Value: lsmsg}, lsmsg, nil, gw.p.owner.smap.get())
+	if err != nil {
+		return nil, err
+	}
+	if len(lst.Entries) == 0 {
+		return nil, nfs.ErrNotFound
+	}
+	return &nfs.Attr{Dir: true}, nil
+}
+
+func (gw *nfsgw) List(bname, dir string) ([]nfs.DirEntry, error) {
+	bck, err := gw.bck(bname, apc.AceObjLIST)
+	if err != nil {
+		return nil, err
+	}
+	var prefix string
+	if dir != "" {
+		prefix = dir + "/"
+	}
+	lsmsg := &apc.LsoMsg{Prefix: prefix, TimeFormat: time.RFC3339Nano}
+	lsmsg.SetFlag(apc.LsNoRecursion)
+	lsmsg.AddProps(apc.GetPropsSize, apc.GetPropsAtime)
+	lst, err := gw.p.lsAllPagesS3(bck, &apc.ActMsg{Action: apc.ActList, Value: lsmsg}, lsmsg, nil)
+	if err != nil {
+		return nil, err
+	}
+	ents := make([]nfs.DirEntry, 0, len(lst.Entries))
+	for _, en := range lst.Entries {
+		name := strings.TrimSuffix(strings.TrimPrefix(en.Name, prefix), "/")
+		if name == "" || strings.IndexByte(name, '/') >= 0 {
+			continue
+		}
+		de := nfs.DirEntry{Name: name, Attr: nfs.Attr{Size: en.Size, Dir: en.IsDir()}}
+		if t, err := time.Parse(time.RFC3339Nano, en.Atime); err == nil {
+			de.Mtime = t.UnixNano()
+		}
+		ents = append(ents, de)
+	}
+	return ents, nil
+}
+
+func (gw *nfsgw) Read(bname, objName string, off int64, b []byte) (int, error) {
+	bck, err := gw.bck(bname, apc.AceGET)
+	if err != nil {
+		return 0, err
+	}
+	hdr := http.Header{cos.HdrRange: []string{cmn.MakeRangeHdr(off, int64(len(b)))}}
+	resp, err := gw.do(http.MethodGet, bck, objName, hdr, nil, 0)
+	if err != nil {
+		if err == errNfsRange {
+			return 0, io.EOF
+		}
+		return 0, err
+	}
+	n, err := io.ReadFull(resp.Body, b)
+	resp.Body.Close()
+	if err == io.ErrUnexpectedEOF {
+		err = io.EOF
+	}
+	return n, err
+}
+
+func (gw *nfsgw) Write(bname, objName string, r io.Reader, size int64) error {
+	bck, err := gw.bck(bname, apc.AcePUT)
+	if err != nil {
+		return err
+	}
+	_, err = gw.do(http.MethodPut, bck, objName, nil, r, size)
+	return err
+}
+
+func (gw *nfsgw) Remove(bname, objName string) error {
+	bck, err := gw.bck(bname, apc.AceObjDELETE)
+	if err != nil {
+		return err
+	}
+	_, err = gw.do(http.MethodDelete, bck, objName, nil, nil, 0)
+	return err
+}
+
+/////////////
+// helpers //
+/////////////
+
+var errNfsRange = errors.New("range not satisfiable")
+
+func (gw *nfsgw) bck(bname string, ace apc.AccessAttrs) (*meta.Bck, error) {
+	// (auth enabled at runtime - until housekeeping stops the gateway)
+	if cmn.Rom.AuthEnabled() {
+		return nil, nfs.ErrAccess
+	}
+	if exports := cmn.GCO.Get().NFS.ExportList(); len(exports) > 0 && !cos.StringInSlice(bname, exports) {
+		return nil, nfs.ErrNotFound
+	}
+	bck := meta.NewBck(bname, apc.AIS, cmn.NsGlobal)
+	if err := bck.Init(gw.p.owner.bmd); err != nil {
+		if cmn.IsErrBucketNought(err) {
+			return nil, nfs.ErrNotFound
+		}
+		return nil, err
+	}
+	if err := bck.Allow(ace); err != nil {
+		return nil, nfs.ErrAccess
+	}
+	return bck, nil
+}
+
+// request HRW target; GET response body (if any) must be closed by the caller
+func (gw *nfsgw) do(method string, bck *meta.Bck, objName string, hdr http.Header, body io.Reader, size int64) (*http.Response, error) {
+	smap := gw.p.owner.smap.get()
+	tsi, err := smap.HrwName2T(bck.MakeUname(objName))
+	if err != nil {
+		return nil, err
+	}
+	query := bck.NewQuery()
+	query.Set(apc.QparamProxyID, gw.p.SID())
+	query.Set(apc.QparamUnixTime, cos.UnixNano2S(time.Now().UnixNano()))
+	reqArgs := cmn.HreqArgs{
+		Method: method,
+		Base:   tsi.URL(cmn.NetIntraData),
+		Path:   apc.URLPathObjects.Join(bck.Name, objName),
+		Query:  query,
+		Header: hdr,
+		BodyR:  body,
+	}
+	req, err := reqArgs.Req()
+	if err != nil {
+		return nil, err
+	}
+	if method == http.MethodPut {
+		req.ContentLength = size
+	}
+	resp, err := nfsClient(method).Do(req) //nolint:bodyclose // closed below or by the caller
+	if err != nil {
+		return nil, cmn.NewErrFailedTo(gw.p, method+" "+bck.Cname(objName), tsi, err)
+	}
+	if method != http.MethodGet || resp.StatusCode >= http.StatusBadRequest {
+		cos.DrainReader(resp.Body)
+		resp.Body.Close()
+	}
+	switch {
+	case resp.StatusCode < http.StatusBadRequest:
+		return resp, nil
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, nfs.ErrNotFound
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return nil, nfs.ErrAccess
+	case resp.StatusCode == http.StatusRequestedRangeNotSatisfiable:
+		return nil, errNfsRange
+	default:
+		return nil, cmn.NewErrFailedTo(gw.p, method+" "+bck.Cname(objName), tsi, fmt.Errorf("status %d", resp.StatusCode))
+	}
+}
+
+func nfsClient(method string) *http.Client {
+	if method == http.MethodGet || method == http.MethodPut {
+		return g.client.data
+	}
+	return g.client.control
+}
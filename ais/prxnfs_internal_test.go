@@ -0,0 +1,43 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/ais/nfs"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+func TestNFSAuthEnabled(t *testing.T) {
+	var (
+		config = *cmn.GCO.Get() // (shallow copy)
+		gw     = &nfsgw{p: &proxy{}}
+	)
+	gw.p.si = &meta.Snode{DaeID: "p1", DaeType: apc.Proxy}
+	config.NFS.Enabled = true
+	config.NFS.Port = 2049
+	config.Auth.Enabled = true
+	config.Log.Level = "3"
+
+	// refuses to start
+	gw._start(&config)
+	if gw.srv != nil {
+		gw._stop()
+		t.Fatal("expected nfs gateway not to start with authentication enabled")
+	}
+
+	// auth enabled at runtime: all operations are denied
+	cmn.Rom.Set(&config.ClusterConfig)
+	defer cmn.Rom.Set(&cmn.GCO.Get().ClusterConfig)
+	if _, err := gw.bck("abc", apc.AceObjLIST); err != nfs.ErrAccess {
+		t.Fatalf("expected %v, got %v", nfs.ErrAccess, err)
+	}
+	if _, err := gw.Stat("abc", "obj"); err != nfs.ErrAccess {
+		t.Fatalf("expected %v, got %v", nfs.ErrAccess, err)
+	}
+}
@@ -25,10 +25,15 @@ import (
 
 type nopHB struct{}
 
-func (*nopHB) HeardFrom(string, int64) {}
-func (*nopHB) TimedOut(string) bool    { return false }
-func (*nopHB) reg(string)              {}
-func (*nopHB) set(time.Duration) bool  { return false }
+func (*nopHB) HeardFrom(string, int64)  {}
+func (*nopHB) TimedOut(string) bool     { return false }
+func (*nopHB) flapped(string, int64)    {}
+func (*nopHB) flapping(int64) bool      { return false }
+func (*nopHB) reg(string)               {}
+func (*nopHB) set(time.Duration) bool   { return false }
+func (*nopHB) heardAbout(string, int64) {}
+func (*nopHB) lastHeard(string) int64   { return 0 }
+func (*nopHB) expire(string)            {}
 
 var _ hbTracker = (*nopHB)(nil)
 
@@ -0,0 +1,195 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// Decommission-cluster with data offload (apc.ActValRmNode.OffloadTo).
+// The (primary) state machine is driven by the user who keeps repeating the same
+// decommission request until the latter succeeds:
+// 1. first request: copy each ais:// bucket to the remote destination, under
+//    the "<bucket-name>/" prefix (one x-tcb per bucket)
+// 2. subsequent requests while copying: report progress (202, []apc.OffloadStatus)
+// 3. all copies done: run bucket summaries for each (source, destination) pair and
+//    compare the numbers - destination must have at least as many objects and bytes
+// 4. all verified: proceed to decommission
+// Failure of any copy or verification resets the respective bucket (or the entire
+// offload) - in other words, decommissioning never happens with data left behind.
+
+type (
+	offloadJob struct {
+		bck     *meta.Bck
+		xid     string
+		srcSumm string // bucket summary UUIDs (verification stage)
+		dstSumm string
+		stage   string
+	}
+	offloadState struct {
+		dst  *meta.Bck
+		jobs []*offloadJob
+	}
+)
+
+var (
+	offload   *offloadState
+	offloadMu sync.Mutex
+)
+
+// returns true when all data has been offloaded and verified (ready to decommission);
+// otherwise, writes the current progress or error
+func (p *proxy) offloadData(w http.ResponseWriter, r *http.Request, opts *apc.ActValRmNode) bool {
+	dst, _, err := cmn.ParseBckObjectURI(opts.OffloadTo, cmn.ParseURIOpts{})
+	if err != nil {
+		p.writeErr(w, r, err)
+		return false
+	}
+	bckTo := meta.CloneBck(&dst)
+	if !bckTo.IsRemote() {
+		p.writeErrf(w, r, "cannot offload to %s: destination must be a remote (cloud or remote AIS) bucket", bckTo)
+		return false
+	}
+
+	offloadMu.Lock()
+	defer offloadMu.Unlock()
+
+	if offload == nil || !offload.dst.Equal(bckTo, false, false) {
+		if bckTo, _, err = p.initBckTo(w, r, nil, bckTo); err != nil {
+			return false
+		}
+		if err := p.offloadStart(bckTo); err != nil {
+			p.writeErr(w, r, err)
+			return false
+		}
+	}
+
+	done, err := p.offloadCheck()
+	if err != nil {
+		p.writeErr(w, r, err, http.StatusConflict)
+		return false
+	}
+	if done {
+		nlog.Infoln(p.String(), "offload to", offload.dst.String(), "verified:", len(offload.jobs), "bucket(s)")
+		offload = nil
+		return true
+	}
+	status := make([]apc.OffloadStatus, 0, len(offload.jobs))
+	for _, job := range offload.jobs {
+		status = append(status, apc.OffloadStatus{Bck: job.bck.Cname(""), XactID: job.xid, Stage: job.stage})
+	}
+	w.WriteHeader(http.StatusAccepted)
+	p.writeJSON(w, r, status, "offload-status")
+	return false
+}
+
+func (p *proxy) offloadStart(bckTo *meta.Bck) error {
+	var (
+		bmd   = p.owner.bmd.get()
+		state = &offloadState{dst: bckTo}
+		pais  = apc.AIS
+	)
+	bmd.Range(&pais, nil, func(bck *meta.Bck) bool {
+		if bck.IsAIS() {
+			state.jobs = append(state.jobs, &offloadJob{bck: bck})
+		}
+		return false
+	})
+	sort.Slice(state.jobs, func(i, j int) bool { return state.jobs[i].bck.Bucket().Less(state.jobs[j].bck.Bucket()) })
+	for _, job := range state.jobs {
+		if err := p.offloadCopy(job, bckTo); err != nil {
+			return err
+		}
+	}
+	offload = state
+	return nil
+}
+
+func (p *proxy) offloadCopy(job *offloadJob, bckTo *meta.Bck) (err error) {
+	msg := &apc.ActMsg{Action: apc.ActCopyBck, Value: &apc.CopyBckMsg{Prepend: job.bck.Name + "/"}}
+	nlog.Infoln("offload x-tcb:", job.bck.String(), "=>", bckTo.String())
+	if job.xid, err = p.tcb(job.bck, bckTo, msg, false /*dry-run*/); err != nil {
+		return fmt.Errorf("failed to offload %s => %s: %w", job.bck, bckTo, err)
+	}
+	job.srcSumm, job.dstSumm, job.stage = "", "", apc.OffloadCopying
+	return nil
+}
+
+// advance each job to the next stage, if possible
+func (p *proxy) offloadCheck() (done bool, err error) {
+	done = true
+	for _, job := range offload.jobs {
+		switch job.stage {
+		case apc.OffloadCopying:
+			nl := p.notifs.entry(job.xid)
+			if nl != nil && !nl.Finished() {
+				done = false
+				continue
+			}
+			if nl != nil && (nl.Aborted() || nl.Err() != nil) {
+				offload = nil
+				return false, fmt.Errorf("failed to offload %s (x-tcb[%s]): aborted %t, err %v",
+					job.bck, job.xid, nl.Aborted(), nl.Err())
+			}
+			job.stage = apc.OffloadVerifying
+			fallthrough
+		case apc.OffloadVerifying:
+			verified, err := p.offloadVerify(job)
+			if err != nil {
+				return false, err
+			}
+			if !verified {
+				done = false
+				continue
+			}
+			job.stage = apc.OffloadVerified
+		}
+	}
+	return done, nil
+}
+
+func (p *proxy) offloadVerify(job *offloadJob) (bool, error) {
+	var (
+		srcMsg = &apc.BsummCtrlMsg{UUID: job.srcSumm, ObjCached: true, BckPresent: true}
+		dstMsg = &apc.BsummCtrlMsg{UUID: job.dstSumm, Prefix: job.bck.Name + "/", BckPresent: true}
+	)
+	src, _, err := p.bsummhead(job.bck, srcMsg)
+	if err != nil {
+		job.srcSumm, job.dstSumm = "", ""
+		return false, err
+	}
+	dst, _, err := p.bsummhead(offload.dst, dstMsg)
+	if err != nil {
+		job.srcSumm, job.dstSumm = "", ""
+		return false, err
+	}
+	job.srcSumm, job.dstSumm = srcMsg.UUID, dstMsg.UUID
+	if src == nil || dst == nil {
+		return false, nil // still running
+	}
+
+	job.srcSumm, job.dstSumm = "", "" // (next time, if need be, start over)
+	if dst.ObjCount.Remote < src.ObjCount.Present || dst.TotalSize.RemoteObjs < src.TotalSize.PresentObjs {
+		err := fmt.Errorf("offload %s => %s not verified: objects %d vs %d, size %s vs %s", job.bck, offload.dst,
+			src.ObjCount.Present, dst.ObjCount.Remote,
+			cos.ToSizeIEC(int64(src.TotalSize.PresentObjs), 2), cos.ToSizeIEC(int64(dst.TotalSize.RemoteObjs), 2))
+		// copy again
+		if errV := p.offloadCopy(job, offload.dst); errV != nil {
+			offload = nil
+			return false, errV
+		}
+		return false, err
+	}
+	return true, nil
+}
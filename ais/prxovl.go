@@ -0,0 +1,192 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/memsys"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Re-electing overloaded primary (cmn.PrimaryOverloadConf):
+// - every keepalive tick, the primary samples its own load - memory pressure (memsys.PressureHigh
+//   or worse) and metasync backlog (number of queued requests) - and tracks for how long
+//   it's been overloaded, continuously
+// - the load is reported via extended health (apc.QparamPrimaryLoad)
+// - every keepalive interval, non-primary proxies query the primary's load; once overloaded
+//   for at least primary_overload.sustain, they trigger election - same as when the primary
+//   is down (the next in line is the candidate) but with VoteRecord.Overload set
+// - the candidate declines if it is itself under memory pressure; voters (proxies and targets)
+//   vote Yes only after confirming the primary's sustained overload on their own
+// - unlike "primary down", the former primary is not removed from the cluster map - it stays
+//   on as a non-primary proxy
+// - at most once per primary_overload.sustain (per proxy)
+
+type (
+	primaryLoad struct {
+		Pressure     int   `json:"pressure"`          // memory pressure (enum memsys.PressureLow, ...)
+		MsyncBacklog int   `json:"msync_backlog"`     // queued metasync requests
+		Overloaded   int64 `json:"overloaded,string"` // for how long, continuously (ns); zero: not overloaded
+	}
+	povl struct {
+		pressure  atomic.Int32 // primary: last sampled
+		backlog   atomic.Int32 // ditto
+		since     atomic.Int64 // primary: overloaded since (mono-time); zero: not overloaded
+		checked   atomic.Int64 // non-primary: last time queried primary's load
+		triggered atomic.Int64 // non-primary: last time triggered election
+	}
+)
+
+func (ld *primaryLoad) sustained(conf *cmn.PrimaryOverloadConf) bool {
+	return ld.Overloaded > 0 && time.Duration(ld.Overloaded) >= conf.Sustain.D()
+}
+
+func (ld *primaryLoad) String() string {
+	return fmt.Sprintf("load[pressure=%d, msync-backlog=%d, overloaded=%v]", ld.Pressure, ld.MsyncBacklog,
+		time.Duration(ld.Overloaded))
+}
+
+//
+// primary
+//
+
+// (keepalive tick)
+func (p *proxy) sampleLoad(config *cmn.Config) {
+	var (
+		pressure = p.gmm.Pressure()
+		backlog  = len(p.metasyncer.workCh)
+		over     = pressure >= memsys.PressureHigh || backlog >= config.Keepalive.Overload.MsyncBacklog
+	)
+	p.ovl.pressure.Store(int32(pressure))
+	p.ovl.backlog.Store(int32(backlog))
+	if !over {
+		p.ovl.since.Store(0)
+		return
+	}
+	if p.ovl.since.CAS(0, mono.NanoTime()) {
+		nlog.Warningln(p.String(), "primary overloaded: memory pressure", pressure, "metasync backlog", backlog)
+	}
+}
+
+// GET /v1/health?pld=true
+func (p *proxy) writeLoad(w http.ResponseWriter, r *http.Request) {
+	ld := &primaryLoad{Pressure: int(p.ovl.pressure.Load()), MsyncBacklog: int(p.ovl.backlog.Load())}
+	if since := p.ovl.since.Load(); since != 0 {
+		ld.Overloaded = max(mono.Since(since).Nanoseconds(), 1)
+	}
+	p.writeJSON(w, r, ld, "primary-load")
+}
+
+//
+// non-primary proxy
+//
+
+// (keepalive tick)
+func (p *proxy) checkPrimaryLoad(smap *smapX, config *cmn.Config) {
+	var (
+		conf = &config.Keepalive.Overload
+		now  = mono.NanoTime()
+	)
+	if time.Duration(now-p.ovl.checked.Load()) < config.Keepalive.Proxy.Interval.D() {
+		return
+	}
+	p.ovl.checked.Store(now)
+	if time.Duration(now-p.ovl.triggered.Load()) < conf.Sustain.D() {
+		return
+	}
+	ld, err := p.primaryLoad(smap.Primary, smap, config)
+	if err != nil {
+		return // (primary down is keepalive's responsibility)
+	}
+	if !ld.sustained(conf) {
+		return
+	}
+	p.ovl.triggered.Store(now)
+	nlog.Warningln(p.String()+":", "primary", smap.Primary.StringEx(), "reports sustained overload", ld.String(),
+		"- triggering re-election")
+	go p.onPrimaryOverload(smap)
+}
+
+func (p *proxy) onPrimaryOverload(smap *smapX) {
+	clone := smap.clone()
+	next, err := nextPrimary(clone, clone.Primary.ID())
+	if err != nil {
+		nlog.Errorf("%s failed to execute HRW selection: %v", p, err)
+		return
+	}
+	vr := &VoteRecord{
+		Candidate: next.ID(),
+		Primary:   clone.Primary.ID(),
+		StartTime: time.Now(),
+		Initiator: p.SID(),
+		Overload:  true,
+	}
+	vr.Smap = clone
+	if next.ID() == p.SID() {
+		nlog.Infof("%s: starting election (candidate = self, primary overloaded)", p)
+		p.startElection(vr)
+		return
+	}
+	nlog.Infof("%s: asking %s to replace overloaded primary", p, meta.Pname(next.ID()))
+	if err := p.sendElectionRequest((*VoteInitiation)(vr), next); err != nil {
+		nlog.Warningln(p.String()+":", "election request failed:", err)
+	}
+}
+
+// candidate
+func (p *proxy) confirmOverload(psi *meta.Snode, smap *smapX, config *cmn.Config) error {
+	if pressure := p.gmm.Pressure(); pressure >= memsys.PressureHigh {
+		return fmt.Errorf("declining to replace overloaded primary %s: memory pressure (%d) on the candidate's side",
+			psi.StringEx(), pressure)
+	}
+	return p.checkOverload(psi, smap, config)
+}
+
+//
+// all nodes
+//
+
+func (h *htrun) primaryLoad(psi *meta.Snode, smap *smapX, config *cmn.Config) (*primaryLoad, error) {
+	query := url.Values{apc.QparamPrimaryLoad: []string{"true"}}
+	b, _, err := h.reqHealth(psi, config.Timeout.CplaneOperation.D(), query, smap)
+	if err != nil {
+		return nil, err
+	}
+	ld := &primaryLoad{}
+	if err := jsoniter.Unmarshal(b, ld); err != nil {
+		return nil, fmt.Errorf("%s: failed to unmarshal %s load: %v", h, psi, err)
+	}
+	return ld, nil
+}
+
+// voter: see for yourself
+func (h *htrun) checkOverload(psi *meta.Snode, smap *smapX, config *cmn.Config) error {
+	if psi == nil {
+		return errors.New("primary not found")
+	}
+	conf := &config.Keepalive.Overload
+	if !conf.Enabled {
+		return errors.New("re-electing overloaded primary is disabled (see keepalivetracker.primary_overload)")
+	}
+	ld, err := h.primaryLoad(psi, smap, config)
+	if err != nil {
+		return fmt.Errorf("failed to confirm primary %s overload: %v", psi.StringEx(), err)
+	}
+	if !ld.sustained(conf) {
+		return fmt.Errorf("primary %s is not (or not long enough) overloaded: %s", psi.StringEx(), ld.String())
+	}
+	return nil
+}
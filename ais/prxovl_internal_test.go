@@ -0,0 +1,45 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	jsoniter "github.com/json-iterator/go"
+)
+
+func TestPrimaryLoad(t *testing.T) {
+	conf := &cmn.PrimaryOverloadConf{Sustain: cos.Duration(time.Minute), MsyncBacklog: 24, Enabled: true}
+	tests := []struct {
+		overloaded time.Duration
+		sustained  bool
+	}{
+		{0, false},
+		{time.Second, false},
+		{time.Minute, true},
+		{time.Hour, true},
+	}
+	for _, test := range tests {
+		ld := &primaryLoad{Pressure: 2, MsyncBacklog: 30, Overloaded: int64(test.overloaded)}
+		// (as reported via health)
+		b, err := jsoniter.Marshal(ld)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rx := &primaryLoad{}
+		if err := jsoniter.Unmarshal(b, rx); err != nil {
+			t.Fatal(err)
+		}
+		if *rx != *ld {
+			t.Fatalf("expected %s, got %s", ld, rx)
+		}
+		if rx.sustained(conf) != test.sustained {
+			t.Errorf("%s: expected sustained=%t", rx, test.sustained)
+		}
+	}
+}
@@ -25,6 +25,7 @@ type (
 	reverseProxy struct {
 		cloud   *httputil.ReverseProxy // unmodified GET requests => storage.googleapis.com
 		nodes   sync.Map               // map [SID => reverse proxy instance]
+		data    sync.Map               // ditto, via intra-cluster data network (see prxstream.go)
 		removed struct {
 			m  meta.NodeMap // map [SID => self-disabled node]
 			mu sync.Mutex
@@ -228,7 +229,17 @@ func (rp *reverseProxy) init() {
 
 func (rp *reverseProxy) loadOrStore(uuid string, u *url.URL,
 	errHdlr func(w http.ResponseWriter, r *http.Request, err error)) *httputil.ReverseProxy {
-	revProxyIf, exists := rp.nodes.Load(uuid)
+	return _loadOrStore(&rp.nodes, uuid, u, errHdlr)
+}
+
+func (rp *reverseProxy) loadOrStoreData(uuid string, u *url.URL,
+	errHdlr func(w http.ResponseWriter, r *http.Request, err error)) *httputil.ReverseProxy {
+	return _loadOrStore(&rp.data, uuid, u, errHdlr)
+}
+
+func _loadOrStore(m *sync.Map, uuid string, u *url.URL,
+	errHdlr func(w http.ResponseWriter, r *http.Request, err error)) *httputil.ReverseProxy {
+	revProxyIf, exists := m.Load(uuid)
 	if exists {
 		shrp := revProxyIf.(*singleRProxy)
 		if shrp.u.Host == u.Host {
@@ -241,6 +252,6 @@ func (rp *reverseProxy) loadOrStore(uuid string, u *url.URL,
 
 	// NOTE: races are rare probably happen only when storing an entry for the first time or when URL changes.
 	// Also, races don't impact the correctness as we always have latest entry for `uuid`, `URL` pair (see: L3917).
-	rp.nodes.Store(uuid, &singleRProxy{rproxy, u})
+	m.Store(uuid, &singleRProxy{rproxy, u})
 	return rproxy
 }
@@ -8,6 +8,7 @@ import (
 	"encoding/xml"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"strings"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/NVIDIA/aistore/ais/s3"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
@@ -25,7 +27,10 @@ import (
 	jsoniter "github.com/json-iterator/go"
 )
 
-// TODO: `checkAccess` permissions (see ais/proxy.go)
+// Access control: with AuthN, user (token) permissions are checked upfront (see s3Authz);
+// bucket access attributes (including those that bucket policy maps onto - see s3/policy.go)
+// are checked by the respective handlers; bucket policy and CORS configuration require
+// the same permissions as native set-bprops (see s3Access)
 
 var (
 	errS3Req = errors.New("invalid s3 request")
@@ -44,6 +49,27 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
+	origin := len(apiItems) > 0 && r.Header.Get(s3.HdrOrigin) != ""
+	if origin && r.Method == http.MethodOptions {
+		// (browsers never send credentials with preflight requests)
+		p.preflightS3(w, r, apiItems[0])
+		return
+	}
+	var tk *tok.Token
+	if cmn.Rom.AuthEnabled() {
+		if tk, err = p.s3Auth(r); err != nil {
+			s3.WriteErr(w, r, err, aceErrToCode(err))
+			return
+		}
+	}
+	if tk != nil {
+		if err := p.s3Authz(w, r, tk, apiItems); err != nil {
+			return
+		}
+	}
+	if origin {
+		s3CORS(w, r, p.owner.bmd, apiItems[0])
+	}
 
 	switch r.Method {
 	case http.MethodHead:
@@ -70,9 +96,16 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 			_, cors      = q[s3.QparamCORS]
 			_, acl       = q[s3.QparamACL]
 		)
-		if lifecycle || policy || cors || acl {
+		switch {
+		case lifecycle || acl:
 			p.unsupported(w, r, apiItems[0])
 			return
+		case policy:
+			p.getBckPolicyS3(w, r, apiItems[0])
+			return
+		case cors:
+			p.getBckCORSS3(w, r, apiItems[0])
+			return
 		}
 		listMultipart := q.Has(s3.QparamMptUploads)
 		if len(apiItems) == 1 && !listMultipart {
@@ -94,11 +127,16 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 		if len(apiItems) == 1 {
 			q := r.URL.Query()
 			_, versioning := q[s3.QparamVersioning]
-			if versioning {
+			switch {
+			case versioning:
 				p.putBckVersioningS3(w, r, apiItems[0])
-				return
+			case q.Has(s3.QparamPolicy):
+				p.putBckPolicyS3(w, r, tk, apiItems[0])
+			case q.Has(s3.QparamCORS):
+				p.putBckCORSS3(w, r, tk, apiItems[0])
+			default:
+				p.putBckS3(w, r, apiItems[0])
 			}
-			p.putBckS3(w, r, apiItems[0])
 			return
 		}
 		p.putObjS3(w, r, apiItems)
@@ -125,11 +163,16 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 		if len(apiItems) == 1 {
 			q := r.URL.Query()
 			_, multiple := q[s3.QparamMultiDelete]
-			if multiple {
+			switch {
+			case multiple:
 				p.delMultipleObjs(w, r, apiItems[0])
-				return
+			case q.Has(s3.QparamPolicy):
+				p.delBckPolicyS3(w, r, tk, apiItems[0])
+			case q.Has(s3.QparamCORS):
+				p.delBckCORSS3(w, r, tk, apiItems[0])
+			default:
+				p.delBckS3(w, r, apiItems[0])
 			}
-			p.delBckS3(w, r, apiItems[0])
 			return
 		}
 		p.delObjS3(w, r, apiItems)
@@ -139,6 +182,98 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// AuthN on: user (token) permissions for the S3 operation; bucket access attributes
+// are separately checked by the respective handlers
+func (p *proxy) s3Authz(w http.ResponseWriter, r *http.Request, tk *tok.Token, items []string) (err error) {
+	ace := s3ace(r, items)
+	switch {
+	case ace == 0:
+		return nil // (invalid request - see s3Handler)
+	case len(items) == 0:
+		if ace == apc.AceListBuckets {
+			err = p._s3authz(tk, "", ace)
+		}
+	default:
+		err = p._s3authz(tk, items[0], ace)
+		// copy object: also read access to the source
+		if src := r.Header.Get(cos.S3HdrObjSrc); err == nil && src != "" && r.Method == http.MethodPut && len(items) > 1 {
+			bname, _, _ := strings.Cut(strings.Trim(src, "/"), "/")
+			err = p._s3authz(tk, bname, apc.AceGET)
+		}
+	}
+	if err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+	}
+	return err
+}
+
+func (p *proxy) _s3authz(tk *tok.Token, bname string, ace apc.AccessAttrs) error {
+	var bck *cmn.Bck
+	if bname != "" && ace&^apc.AccessCluster != 0 {
+		if b, err, _ := meta.InitByNameOnly(bname, p.owner.bmd); err == nil {
+			bck = b.Bucket()
+		} else {
+			bck = &cmn.Bck{Name: bname, Provider: apc.AIS, Ns: cmn.NsGlobal}
+		}
+	}
+	return tk.CheckPermissions(p.owner.smap.get().UUID, bck, ace)
+}
+
+// S3 operation => required permissions (compare w/ s3Handler switch)
+func s3ace(r *http.Request, items []string) apc.AccessAttrs {
+	var (
+		q   = r.URL.Query()
+		bck = len(items) == 1
+	)
+	switch r.Method {
+	case http.MethodHead:
+		if bck {
+			return apc.AceBckHEAD
+		}
+		return apc.AceObjHEAD
+	case http.MethodGet:
+		switch {
+		case len(items) == 0:
+			return apc.AceListBuckets
+		case q.Has(s3.QparamPolicy) || q.Has(s3.QparamCORS) || q.Has(s3.QparamVersioning):
+			return apc.AceBckHEAD
+		case bck || q.Has(s3.QparamMptUploads):
+			return apc.AceObjLIST
+		}
+		return apc.AceGET
+	case http.MethodPut:
+		switch {
+		case !bck:
+			return apc.AcePUT
+		case q.Has(s3.QparamPolicy):
+			return apc.AcePATCH | apc.AceBckSetACL
+		case q.Has(s3.QparamCORS) || q.Has(s3.QparamVersioning):
+			return apc.AcePATCH
+		}
+		return apc.AceCreateBucket
+	case http.MethodPost:
+		if q.Has(s3.QparamMultiDelete) {
+			return apc.AceObjDELETE
+		}
+		return apc.AcePUT // (multipart upload)
+	case http.MethodDelete:
+		switch {
+		case q.Has(s3.QparamMultiDelete):
+			return apc.AceObjDELETE
+		case bck && q.Has(s3.QparamPolicy):
+			return apc.AcePATCH | apc.AceBckSetACL
+		case bck && q.Has(s3.QparamCORS):
+			return apc.AcePATCH
+		case bck:
+			return apc.AceDestroyBucket
+		case q.Has(s3.QparamMptUploadID):
+			return apc.AcePUT // (abort multipart upload)
+		}
+		return apc.AceObjDELETE
+	}
+	return 0 // (405 - see s3Handler)
+}
+
 // GET /s3
 // NOTE: unlike native API, this one is limited to list only those that are currently present in the BMD.
 func (p *proxy) bckNamesFromBMD(w http.ResponseWriter) {
@@ -215,6 +350,10 @@ func (p *proxy) handleMptUpload(w http.ResponseWriter, r *http.Request, parts []
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+	if err := checkNaming(bck, objName); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
 	si, netPub, err := smap.HrwMultiHome(bck.MakeUname(objName))
 	if err != nil {
 		s3.WriteErr(w, r, err, 0)
@@ -453,6 +592,12 @@ func (p *proxy) copyObjS3(w http.ResponseWriter, r *http.Request, items []string
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
+	if len(items) > 1 {
+		if err := checkNaming(bckDst, s3.ObjName(items)); err != nil {
+			s3.WriteErr(w, r, err, 0)
+			return
+		}
+	}
 	objName := strings.Trim(parts[1], "/")
 	si, err = smap.HrwName2T(bckSrc.MakeUname(objName))
 	if err != nil {
@@ -494,6 +639,10 @@ func (p *proxy) directPutObjS3(w http.ResponseWriter, r *http.Request, items []s
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+	if err := checkNaming(bck, objName); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
 	si, netPub, err = smap.HrwMultiHome(bck.MakeUname(objName))
 	if err != nil {
 		s3.WriteErr(w, r, err, 0)
@@ -543,6 +692,11 @@ func (p *proxy) getObjS3(w http.ResponseWriter, r *http.Request, items []string,
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+	if p.tquar.has(si.ID()) {
+		if asi := p.tquar.alt(bck, objName, smap); asi != nil {
+			si, netPub = asi, cmn.NetPublic
+		}
+	}
 	if cmn.Rom.FastV(5, cos.SmoduleS3) {
 		nlog.Infof("%s %s => %s", r.Method, bck.Cname(objName), si)
 	}
@@ -574,6 +728,7 @@ func (p *proxy) listMultipart(w http.ResponseWriter, r *http.Request, bck *meta.
 		)
 		cargs.si = si
 		cargs.req = cmn.HreqArgs{Method: http.MethodGet, Base: url, Path: r.URL.Path, Query: q}
+		cargs.rid = r.Header.Get(apc.HdrReqID)
 		res := p.call(cargs, smap)
 		b, err := res.bytes, res.err
 		freeCargs(cargs)
@@ -684,7 +839,7 @@ func (p *proxy) getBckVersioningS3(w http.ResponseWriter, r *http.Request, bucke
 	sgl.Free()
 }
 
-// GET /s3/<bucket-name>?lifecycle|cors|policy|acl
+// GET /s3/<bucket-name>?lifecycle|acl
 func (p *proxy) unsupported(w http.ResponseWriter, r *http.Request, bucket string) {
 	if _, err, ecode := meta.InitByNameOnly(bucket, p.owner.bmd); err != nil {
 		s3.WriteErr(w, r, err, ecode)
@@ -714,13 +869,166 @@ func (p *proxy) putBckVersioningS3(w http.ResponseWriter, r *http.Request, bucke
 	propsToUpdate := cmn.BpropsToSet{
 		Versioning: &cmn.VersionConfToSet{Enabled: &enabled},
 	}
+	p.setBpropsS3(w, r, msg, bck, &propsToUpdate, http.StatusOK)
+}
+
+func (p *proxy) setBpropsS3(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg, bck *meta.Bck,
+	propsToUpdate *cmn.BpropsToSet, status int) {
 	// make and validate new props
-	nprops, err := p.makeNewBckProps(bck, &propsToUpdate)
+	nprops, err := p.makeNewBckProps(bck, propsToUpdate)
 	if err != nil {
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
-	if _, err := p.setBprops(msg, bck, nprops); err != nil {
+	if _, err := p.setBprops(r, msg, bck, nprops); err != nil {
 		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	w.WriteHeader(status)
+}
+
+// GET /s3/<bucket-name>?policy
+func (p *proxy) getBckPolicyS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck, err, ecode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+	if s3.NoPolicy(bck.Props.Access) {
+		err := s3.NewErrCoded(s3.ErrCodeNoSuchPolicy, "bucket "+bck.Name+" has no policy")
+		s3.WriteErr(w, r, err, http.StatusNotFound)
+		return
+	}
+	p.writeJSON(w, r, s3.NewBucketPolicy(bck.Name, bck.Props.Access), "get-bucket-policy")
+}
+
+// PUT /s3/<bucket-name>?policy
+func (p *proxy) putBckPolicyS3(w http.ResponseWriter, r *http.Request, tk *tok.Token, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck, err, ecode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+	if err := p.s3Access(w, r, tk, bck, apc.AcePATCH|apc.AceBckSetACL); err != nil {
+		return
+	}
+	policy := &s3.BucketPolicy{}
+	if err := jsoniter.NewDecoder(io.LimitReader(r.Body, s3.MaxPolicySize)).Decode(policy); err != nil {
+		s3.WriteErr(w, r, s3.NewErrCoded(s3.ErrCodeMalformedPolicy, err.Error()), 0)
+		return
+	}
+	access, err := policy.ToAccess(bck.Name, bck.Props.Access)
+	if err != nil {
+		s3.WriteErr(w, r, s3.NewErrCoded(s3.ErrCodeMalformedPolicy, err.Error()), 0)
+		return
+	}
+	p.setBpropsS3(w, r, msg, bck, &cmn.BpropsToSet{Access: &access}, http.StatusNoContent)
+}
+
+// DELETE /s3/<bucket-name>?policy
+func (p *proxy) delBckPolicyS3(w http.ResponseWriter, r *http.Request, tk *tok.Token, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck, err, ecode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+	if err := p.s3Access(w, r, tk, bck, apc.AcePATCH|apc.AceBckSetACL); err != nil {
+		return
+	}
+	access := s3.DelPolicy(bck.Props.Access)
+	p.setBpropsS3(w, r, msg, bck, &cmn.BpropsToSet{Access: &access}, http.StatusNoContent)
+}
+
+// GET /s3/<bucket-name>?cors
+func (p *proxy) getBckCORSS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck, err, ecode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+	if len(bck.Props.CORS) == 0 {
+		err := s3.NewErrCoded(s3.ErrCodeNoSuchCORS, "bucket "+bck.Name+" has no CORS configuration")
+		s3.WriteErr(w, r, err, http.StatusNotFound)
+		return
+	}
+	resp := s3.NewCORSConfiguration(bck.Props.CORS)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>?cors
+func (p *proxy) putBckCORSS3(w http.ResponseWriter, r *http.Request, tk *tok.Token, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck, err, ecode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+	if err := p.s3Access(w, r, tk, bck, apc.AcePATCH); err != nil {
+		return
+	}
+	conf := &s3.CORSConfiguration{}
+	if err := xml.NewDecoder(r.Body).Decode(conf); err != nil {
+		s3.WriteErr(w, r, s3.NewErrCoded(s3.ErrCodeMalformedXML, err.Error()), 0)
+		return
+	}
+	rules := conf.ToRules()
+	p.setBpropsS3(w, r, msg, bck, &cmn.BpropsToSet{CORS: &rules}, http.StatusOK)
+}
+
+// DELETE /s3/<bucket-name>?cors
+func (p *proxy) delBckCORSS3(w http.ResponseWriter, r *http.Request, tk *tok.Token, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck, err, ecode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+	if err := p.s3Access(w, r, tk, bck, apc.AcePATCH); err != nil {
+		return
+	}
+	rules := cmn.CORSRules{}
+	p.setBpropsS3(w, r, msg, bck, &cmn.BpropsToSet{CORS: &rules}, http.StatusNoContent)
+}
+
+// OPTIONS /s3/<bucket-name>[/<object-name>] (CORS preflight)
+func (p *proxy) preflightS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck, err, ecode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+	var (
+		origin = r.Header.Get(s3.HdrOrigin)
+		method = r.Header.Get(s3.HdrACRequestMethod)
+		hdrs   = r.Header.Get(s3.HdrACRequestHeaders)
+	)
+	if method == "" || !s3.PreflightCORS(w.Header(), bck.Props.CORS, origin, method, hdrs) {
+		err := s3.NewErrCoded(s3.ErrCodeAccessForbidden, "CORSResponse: this CORS request is not allowed")
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+	}
+}
+
+// cross-origin request (proxies and targets, both)
+func s3CORS(w http.ResponseWriter, r *http.Request, bowner meta.Bowner, bucket string) {
+	if bck, err, _ := meta.InitByNameOnly(bucket, bowner); err == nil && len(bck.Props.CORS) > 0 {
+		s3.SetCORS(w.Header(), bck.Props.CORS, r.Header.Get(s3.HdrOrigin), r.Method)
 	}
 }
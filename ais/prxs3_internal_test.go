@@ -0,0 +1,53 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+)
+
+func TestS3Ace(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string // bucket[/object][?query]
+		ace    apc.AccessAttrs
+	}{
+		{http.MethodGet, "", apc.AceListBuckets},
+		{http.MethodGet, "bck", apc.AceObjLIST},
+		{http.MethodGet, "bck?uploads", apc.AceObjLIST},
+		{http.MethodGet, "bck?policy", apc.AceBckHEAD},
+		{http.MethodGet, "bck/obj", apc.AceGET},
+		{http.MethodHead, "bck", apc.AceBckHEAD},
+		{http.MethodHead, "bck/obj", apc.AceObjHEAD},
+		{http.MethodPut, "bck", apc.AceCreateBucket},
+		{http.MethodPut, "bck?versioning", apc.AcePATCH},
+		{http.MethodPut, "bck?cors", apc.AcePATCH},
+		{http.MethodPut, "bck?policy", apc.AcePATCH | apc.AceBckSetACL},
+		{http.MethodPut, "bck/obj", apc.AcePUT},
+		{http.MethodPost, "bck/obj?uploads", apc.AcePUT},
+		{http.MethodPost, "bck?delete", apc.AceObjDELETE},
+		{http.MethodDelete, "bck", apc.AceDestroyBucket},
+		{http.MethodDelete, "bck?policy", apc.AcePATCH | apc.AceBckSetACL},
+		{http.MethodDelete, "bck/obj", apc.AceObjDELETE},
+		{http.MethodDelete, "bck/obj?uploadId=1", apc.AcePUT},
+	}
+	for _, test := range tests {
+		r, err := http.NewRequest(test.method, "http://localhost:8080/s3/"+test.path, http.NoBody)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var items []string
+		if path, _, _ := strings.Cut(test.path, "?"); path != "" {
+			items = strings.Split(path, "/")
+		}
+		if ace := s3ace(r, items); ace != test.ace {
+			t.Errorf("%s %s: expected %q, got %q", test.method, test.path, test.ace.Describe(true), ace.Describe(true))
+		}
+	}
+}
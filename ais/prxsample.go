@@ -0,0 +1,151 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// Bucket sampling (apc.ActSampleBck) - xaction "sample" that runs in two phases:
+// - begin: each target draws a uniform random sample (reservoir) of up to the requested size
+//   from its local objects, and reports the number of objects it has (see xs/sample.go)
+// - commit: the proxy splits the requested sample size between targets in proportion to
+//   their object counts (see sampleShares), and aggregates the results; each target
+//   processes its share - with ETL, transforms and parses the sampled objects,
+//   otherwise, only loads their metadata
+// - the resulting sample size is exactly min(requested, number of objects)
+// - long-running request (see prxlreq.go) that can be canceled, thus aborting the xaction
+// - remote buckets: only in-cluster (cached) objects get sampled
+
+// POST {apc.ActSampleBck} /v1/buckets/<bucket-name>
+func (p *proxy) sampleBck(w http.ResponseWriter, r *http.Request, bck *meta.Bck, msg *apc.ActMsg) {
+	var smsg apc.SampleMsg
+	if err := cos.MorphMarshal(msg.Value, &smsg); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	if err := smsg.Validate(); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	smap := p.owner.smap.get()
+	if nt := smap.CountActiveTs(); nt == 0 {
+		p.writeErr(w, r, cmn.NewErrNoNodes(apc.Target, smap.CountTargets()))
+		return
+	}
+
+	uuid := cos.GenUUID()
+	lc, ecode, err := p.lreqs.begin(r, uuid, apc.ActSampleBck, bck.Cname(""))
+	if err != nil {
+		p.writeErr(w, r, err, ecode)
+		return
+	}
+	out, err := p._sample(bck, &smsg, smap, uuid, r.Header.Get(apc.HdrReqID))
+	p.lreqs.end(lc, true)
+	if err != nil {
+		p.lreqs.abort(uuid, apc.ActSampleBck)
+		p.writeErr(w, r, err)
+		return
+	}
+	p.writeJSON(w, r, out, msg.Action)
+}
+
+func (p *proxy) _sample(bck *meta.Bck, smsg *apc.SampleMsg, smap *smapX, uuid, rid string) (*cmn.SampleRes, error) {
+	// begin
+	amsg := &apc.ActMsg{Action: apc.ActSampleBck, Name: apc.ActBegin, Value: smsg}
+	results := p._sampleBcast(bck, amsg, smap, uuid, rid)
+	objs := make(map[string]int64, len(results))
+	for _, res := range results {
+		if res.err != nil {
+			err := res.toErr()
+			freeBcastRes(results)
+			return nil, err
+		}
+		objs[res.si.ID()] = res.v.(*cmn.SampleRes).Objects
+	}
+	freeBcastRes(results)
+
+	// commit
+	amsg = &apc.ActMsg{Action: apc.ActSampleBck, Name: apc.ActCommit, Value: sampleShares(objs, smsg.Count)}
+	results = p._sampleBcast(bck, amsg, smap, uuid, rid)
+	out := &cmn.SampleRes{}
+	for _, res := range results {
+		if res.err != nil {
+			err := res.toErr()
+			freeBcastRes(results)
+			return nil, err
+		}
+		out.Merge(res.v.(*cmn.SampleRes))
+	}
+	freeBcastRes(results)
+	return out, nil
+}
+
+func (p *proxy) _sampleBcast(bck *meta.Bck, amsg *apc.ActMsg, smap *smapX, uuid, rid string) sliceResults {
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodPost,
+		Path:   apc.URLPathBuckets.Join(bck.Name),
+		Query:  bck.NewQuery(),
+		Body:   cos.MustMarshal(p.newAmsg(amsg, nil, uuid)),
+	}
+	args.rid = rid
+	args.smap = smap // (same targets in both phases)
+	args.to = core.Targets
+	args.timeout = apc.LongTimeout
+	args.cresv = cresSample{} // -> cmn.SampleRes
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	return results
+}
+
+// split the sample size between targets in proportion to their object counts
+// (largest remainder method); the shares add up to min(count, total number of objects)
+func sampleShares(objs map[string]int64, count int) map[string]int {
+	var (
+		total  int64
+		shares = make(map[string]int, len(objs))
+	)
+	for _, n := range objs {
+		total += n
+	}
+	if total <= int64(count) {
+		for tid, n := range objs {
+			shares[tid] = int(n)
+		}
+		return shares
+	}
+	type rem struct {
+		tid string
+		r   int64
+	}
+	var (
+		rems = make([]rem, 0, len(objs))
+		left = count
+	)
+	for tid, n := range objs {
+		q := int64(count) * n
+		shares[tid] = int(q / total) // (< n)
+		left -= shares[tid]
+		rems = append(rems, rem{tid, q % total})
+	}
+	sort.Slice(rems, func(i, j int) bool {
+		if rems[i].r != rems[j].r {
+			return rems[i].r > rems[j].r
+		}
+		return rems[i].tid < rems[j].tid
+	})
+	for i := range left {
+		shares[rems[i].tid]++
+	}
+	return shares
+}
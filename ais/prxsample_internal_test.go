@@ -0,0 +1,46 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"testing"
+)
+
+func TestSampleShares(t *testing.T) {
+	tests := []struct {
+		name   string
+		objs   map[string]int64
+		count  int
+		expect map[string]int
+	}{
+		{"even", map[string]int64{"t1": 100, "t2": 100}, 10, map[string]int{"t1": 5, "t2": 5}},
+		{"proportional", map[string]int64{"t1": 900, "t2": 100}, 10, map[string]int{"t1": 9, "t2": 1}},
+		{"empty target", map[string]int64{"t1": 1000, "t2": 0}, 10, map[string]int{"t1": 10, "t2": 0}},
+		{"largest remainder", map[string]int64{"t1": 50, "t2": 30, "t3": 20}, 7, map[string]int{"t1": 4, "t2": 2, "t3": 1}},
+		{"tie", map[string]int64{"t1": 10, "t2": 10, "t3": 10}, 2, map[string]int{"t1": 1, "t2": 1, "t3": 0}},
+		{"fewer objects", map[string]int64{"t1": 3, "t2": 4}, 10, map[string]int{"t1": 3, "t2": 4}},
+		{"none", map[string]int64{"t1": 0, "t2": 0}, 10, map[string]int{"t1": 0, "t2": 0}},
+	}
+	for _, test := range tests {
+		shares := sampleShares(test.objs, test.count)
+		var total int64
+		for tid, n := range test.objs {
+			total += n
+			if shares[tid] != test.expect[tid] {
+				t.Errorf("%s: %s: expected share %d, got %d", test.name, tid, test.expect[tid], shares[tid])
+			}
+			if int64(shares[tid]) > n {
+				t.Errorf("%s: %s: share %d exceeds the number of objects %d", test.name, tid, shares[tid], n)
+			}
+		}
+		var sum int
+		for _, n := range shares {
+			sum += n
+		}
+		if int64(sum) != min(int64(test.count), total) {
+			t.Errorf("%s: expected sample size %d, got %d", test.name, min(int64(test.count), total), sum)
+		}
+	}
+}
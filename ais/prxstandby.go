@@ -0,0 +1,124 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// Hot-standby primary (see cmn.ProxyConf.Standby):
+// - the primary sends every Smap and BMD update to the configured standby proxy synchronously,
+//   from within metasyncer.sync() - that is, before the change gets acknowledged to the caller
+// - the regular (asynchronous) metasync follows; the standby simply skips the same version
+// - upon primary failure the standby is next in line, ahead of the HRW-selected proxy
+// - the primary keeps retrying until the standby acknowledges the update, or else is
+//   no longer the standby (removed from the Smap, in maintenance, non-electable, reconfigured)
+
+var (
+	standbyMu sync.Mutex // serializes standby updates (versions on the wire never go down)
+
+	// (can be overridden in tests)
+	standbySend  = (*metasyncer)._standby
+	standbyRetry = time.Second
+)
+
+// the standby iff configured, not self, and currently electable
+func standbyProxy(smap *smapX, idToSkip string) *meta.Snode {
+	sid := cmn.GCO.Get().Proxy.Standby
+	if sid == "" || sid == idToSkip {
+		return nil
+	}
+	psi := smap.GetProxy(sid)
+	if psi == nil || psi.InMaintOrDecomm() || psi.Flags.IsSet(meta.SnodeNonElectable) {
+		return nil
+	}
+	return psi
+}
+
+// next in line: the standby, if available, otherwise HRW
+func nextPrimary(smap *smapX, idToSkip string) (*meta.Snode, error) {
+	if psi := standbyProxy(smap, idToSkip); psi != nil {
+		return psi, nil
+	}
+	return smap.HrwProxy(idToSkip)
+}
+
+func (y *metasyncer) standby(pairs []revsPair) {
+	if standbyProxy(y.p.owner.smap.get(), y.p.SID()) == nil {
+		return
+	}
+
+	standbyMu.Lock()
+	defer standbyMu.Unlock()
+
+	payload := make(msPayload, 4)
+	for _, pair := range pairs {
+		tag := pair.revs.tag()
+		if tag != revsSmapTag && tag != revsBMDTag {
+			continue
+		}
+		revs := pair.revs
+		if jitRevs := revs.jit(y.p); jitRevs != nil && jitRevs.version() > revs.version() {
+			revs = jitRevs
+		}
+		payload[tag] = revs.marshal()
+		payload[tag+revsActionTag] = cos.MustMarshal(pair.msg)
+	}
+	if len(payload) == 0 {
+		return
+	}
+
+	// retry until acknowledged (re-checking the standby and self as primary each time)
+	for i := 0; ; i++ {
+		smap := y.p.owner.smap.get()
+		if !smap.isPrimary(y.p.si) {
+			return
+		}
+		psi := standbyProxy(smap, y.p.SID())
+		if psi == nil {
+			if i > 0 {
+				nlog.Warningln(y.p.String()+":", "standby is no longer available,", smap.StringEx())
+			}
+			return
+		}
+		err := standbySend(y, psi, smap, payload)
+		if err == nil {
+			if i > 0 {
+				nlog.Infoln(y.p.String()+":", "updated standby", psi.StringEx(), "after", i, "retries")
+			}
+			return
+		}
+		if i == 0 || i%10 == 9 {
+			nlog.Warningln(y.p.String()+":", "failed to update standby", psi.StringEx(), "- retrying:", err)
+		}
+		time.Sleep(standbyRetry)
+	}
+}
+
+func (y *metasyncer) _standby(psi *meta.Snode, smap *smapX, payload msPayload) (err error) {
+	body := payload.marshal(y.p.gmm)
+	cargs := allocCargs()
+	{
+		cargs.si = psi
+		cargs.req = cmn.HreqArgs{Method: http.MethodPut, Path: apc.URLPathMetasync.S, BodyR: body}
+		cargs.timeout = cmn.Rom.MaxKeepalive()
+	}
+	res := y.p.call(cargs, smap)
+	if res.err != nil {
+		err = res.toErr()
+	}
+	freeCargs(cargs)
+	freeCR(res)
+	body.Free()
+	return err
+}
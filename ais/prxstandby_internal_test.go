@@ -0,0 +1,135 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+func setStandby(sid string) {
+	config := cmn.GCO.BeginUpdate()
+	config.Proxy.Standby = sid
+	cmn.GCO.CommitUpdate(config)
+}
+
+// primary "p1" and two more proxies
+func standbySmap() *smapX {
+	smap := newSmap()
+	for _, pid := range []string{"p1", "p2", "p3"} {
+		smap.addProxy(newSnode(pid, apc.Proxy, meta.NetInfo{}, meta.NetInfo{}, meta.NetInfo{}))
+	}
+	smap.Primary = smap.GetProxy("p1")
+	return smap
+}
+
+func TestNextPrimary(t *testing.T) {
+	defer setStandby("")
+	smap := standbySmap()
+	hrw, err := smap.HrwProxy("p1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// (the one that HRW wouldn't select)
+	standby := "p2"
+	if hrw.ID() == standby {
+		standby = "p3"
+	}
+
+	tests := []struct {
+		name    string
+		standby string
+		update  func(*meta.Snode)
+		expect  string
+	}{
+		{"not configured", "", nil, hrw.ID()},
+		{"standby", standby, nil, standby},
+		{"self", "p1", nil, hrw.ID()},
+		{"not a member", "p4", nil, hrw.ID()},
+		{"in maintenance", standby, func(psi *meta.Snode) { psi.Flags = psi.Flags.Set(meta.SnodeMaint) }, hrw.ID()},
+		{"non-electable", standby, func(psi *meta.Snode) { psi.Flags = psi.Flags.Set(meta.SnodeNonElectable) }, hrw.ID()},
+	}
+	for _, test := range tests {
+		smap := standbySmap()
+		setStandby(test.standby)
+		if test.update != nil {
+			test.update(smap.GetProxy(test.standby))
+		}
+		psi, err := nextPrimary(smap, "p1")
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if psi.ID() != test.expect {
+			t.Errorf("%s: expected %s, got %s", test.name, test.expect, psi.ID())
+		}
+	}
+}
+
+func TestStandbyUpdate(t *testing.T) {
+	var (
+		p     = &proxy{}
+		y     = &metasyncer{p: p}
+		smap  = standbySmap()
+		calls int
+	)
+	defer setStandby("")
+	p.si = smap.GetProxy("p1")
+	p.owner.smap = newSmapOwner(cmn.GCO.Get())
+	p.owner.smap.put(smap)
+	pairs := []revsPair{{smap, &aisMsg{}}}
+	setStandby("p2")
+
+	send, ival := standbySend, standbyRetry
+	defer func() { standbySend, standbyRetry = send, ival }()
+	standbyRetry = 0
+
+	// retries until acknowledged
+	standbySend = func(_ *metasyncer, psi *meta.Snode, _ *smapX, payload msPayload) error {
+		calls++
+		if psi.ID() != "p2" || len(payload[revsSmapTag]) == 0 {
+			t.Fatalf("unexpected standby update: %s, %v", psi, payload)
+		}
+		if calls < 3 {
+			return errors.New("standby unreachable")
+		}
+		return nil
+	}
+	y.standby(pairs)
+	if calls != 3 {
+		t.Fatalf("expected 3 calls, got %d", calls)
+	}
+
+	// fails until removed from the cluster map
+	calls = 0
+	standbySend = func(*metasyncer, *meta.Snode, *smapX, msPayload) error {
+		calls++
+		if calls == 2 {
+			clone := p.owner.smap.get().clone()
+			clone.delProxy("p2")
+			clone.Version++
+			p.owner.smap.put(clone)
+		}
+		return errors.New("standby unreachable")
+	}
+	y.standby(pairs)
+	if calls != 2 {
+		t.Fatalf("expected 2 calls, got %d", calls)
+	}
+
+	// not primary anymore
+	calls = 0
+	clone := standbySmap()
+	clone.Primary = clone.GetProxy("p3")
+	clone.Version = p.owner.smap.get().Version + 1
+	p.owner.smap.put(clone)
+	y.standby(pairs)
+	if calls != 0 {
+		t.Fatalf("expected no standby updates from non-primary, got %d", calls)
+	}
+}
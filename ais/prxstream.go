@@ -0,0 +1,118 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Redirect-free data path (cmn.ProxyConf.StreamData) - for clients that can only reach
+// the proxy tier:
+// - instead of redirecting object requests, the proxy forwards them to the designated target
+//   (intra-cluster data network) and streams the target's response back to the client
+// - the request carries the same query (proxy ID, timestamp, etc.) as the redirect would
+// - bytes received from and sent to clients are accounted for (stats.StreamRecvSize, et al.)
+// - trade-off: the proxy now carries the entire data traffic, and its network bandwidth
+//   becomes the cluster's throughput limit
+
+type (
+	// counts bytes sent to the client
+	streamWriter struct {
+		http.ResponseWriter
+		n int64
+	}
+	// counts bytes received from the client (read by the transport's goroutine)
+	streamReader struct {
+		io.ReadCloser
+		n atomic.Int64
+	}
+)
+
+// redirect the client to a given target or, if configured, stream the request through
+func (p *proxy) redirect(w http.ResponseWriter, r *http.Request, si *meta.Snode, redirectURL string, status int) {
+	if cmn.GCO.Get().Proxy.StreamData {
+		p.stream(w, r, si, redirectURL)
+		return
+	}
+	http.Redirect(w, r, redirectURL, status)
+}
+
+func (p *proxy) stream(w http.ResponseWriter, r *http.Request, si *meta.Snode, redirectURL string) {
+	u, err := url.Parse(redirectURL)
+	debug.AssertNoErr(err)
+	r.URL.RawQuery = u.RawQuery
+
+	nodeURL, err := url.Parse(si.URL(cmn.NetIntraData))
+	debug.AssertNoErr(err)
+	rproxy := p.rproxy.loadOrStoreData(si.ID(), nodeURL, p.streamErrHdlr)
+
+	sw := &streamWriter{ResponseWriter: w}
+	var sr *streamReader
+	if r.Body != nil && r.Body != http.NoBody {
+		sr = &streamReader{ReadCloser: r.Body}
+		r.Body = sr
+	}
+	if cmn.Rom.FastV(5, cos.SmoduleAIS) {
+		nlog.Infoln(p.String(), "stream", r.Method, r.URL.Path, "via", si.StringEx())
+	}
+
+	rproxy.ServeHTTP(sw, r)
+
+	nvs := make([]cos.NamedVal64, 1, 5)
+	nvs[0] = cos.NamedVal64{Name: stats.StreamCount, Value: 1}
+	if sr != nil {
+		if n := sr.n.Load(); n > 0 {
+			nvs = append(nvs,
+				cos.NamedVal64{Name: stats.StreamRecvSize, Value: n},
+				cos.NamedVal64{Name: stats.StreamRecvThroughput, Value: n},
+			)
+		}
+	}
+	if sw.n > 0 {
+		nvs = append(nvs,
+			cos.NamedVal64{Name: stats.StreamSentSize, Value: sw.n},
+			cos.NamedVal64{Name: stats.StreamSentThroughput, Value: sw.n},
+		)
+	}
+	p.statsT.AddMany(nvs...)
+}
+
+func (p *proxy) streamErrHdlr(w http.ResponseWriter, r *http.Request, err error) {
+	p.statsT.Inc(stats.ErrStreamCount)
+	p.rpErrHandler(w, r, err)
+}
+
+//////////////////
+// streamWriter //
+//////////////////
+
+func (sw *streamWriter) Write(b []byte) (n int, err error) {
+	n, err = sw.ResponseWriter.Write(b)
+	sw.n += int64(n)
+	return n, err
+}
+
+// (http.ResponseController - to flush)
+func (sw *streamWriter) Unwrap() http.ResponseWriter { return sw.ResponseWriter }
+
+//////////////////
+// streamReader //
+//////////////////
+
+func (sr *streamReader) Read(b []byte) (n int, err error) {
+	n, err = sr.ReadCloser.Read(b)
+	sr.n.Add(int64(n))
+	return n, err
+}
@@ -0,0 +1,303 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	ratomic "sync/atomic"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Target quarantine (cmn.QuarantineConf):
+// - every quarantine.interval, each proxy samples all targets' GET counters (get.n, get.ns.total,
+//   err.io.get.n) to compute, for each target, average GET latency and I/O error rate over the interval
+// - a target that served at least min_reqs GETs and exceeds either threshold gets quarantined: the proxy
+//   stops redirecting new GETs to it, as long as the bucket is erasure coded (the next HRW target
+//   restores the object from EC slices or replicas) or remote (the next HRW target cold-GETs it);
+//   all other requests are redirected as usual
+// - quarantined targets are probed in the background (health check round trip, and the same counters);
+//   tquarRecover consecutive healthy probes restore the target
+// - at most tquarMaxPct of the targets can be quarantined at any given time
+// - events are logged, and the most recent ones are kept - see GET /v1/cluster?what=quarantine
+
+const (
+	tquarIdleIval  = time.Minute // (disabled)
+	tquarRecover   = 3           // consecutive healthy probes
+	tquarMaxPct    = 25
+	tquarMaxEvents = 64
+)
+
+var tquarMetrics = strings.Join([]string{stats.GetCount, stats.GetLatencyTotal, stats.IOErrGetCount}, ",")
+
+type (
+	tqsample struct {
+		n, ns, errs int64
+	}
+	tqentry struct {
+		reason  string
+		prev    tqsample
+		since   int64 // quarantined (unix nanoseconds); zero when not
+		healthy int   // consecutive healthy probes (when quarantined)
+		sampled bool  // prev is valid
+	}
+	tquar struct {
+		p      *proxy
+		m      map[string]*tqentry         // by target ID
+		qset   ratomic.Pointer[cos.StrSet] // quarantined (GET datapath)
+		events []*cmn.QuarantineEvent
+		mu     sync.Mutex
+	}
+)
+
+func (tq *tquar) init(p *proxy) {
+	tq.p = p
+	tq.m = make(map[string]*tqentry, 16)
+	hk.Reg("target-quarantine"+hk.NameSuffix, tq.housekeep, tquarIdleIval)
+}
+
+// (GET datapath)
+func (tq *tquar) has(tid string) bool {
+	qset := tq.qset.Load()
+	return qset != nil && qset.Contains(tid)
+}
+
+// given quarantined HRW target, select the one to GET from instead (nil if none)
+func (tq *tquar) alt(bck *meta.Bck, objName string, smap *smapX) *meta.Snode {
+	if !bck.Props.EC.Enabled && !bck.IsRemote() {
+		return nil
+	}
+	uname := cos.UnsafeS(bck.MakeUname(objName))
+	tsis, err := smap.HrwTargetList(&uname, smap.CountTargets())
+	if err != nil || len(tsis) < 2 {
+		return nil
+	}
+	for _, tsi := range tsis[1:] {
+		if !tq.has(tsi.ID()) {
+			return tsi
+		}
+	}
+	return nil
+}
+
+func (tq *tquar) housekeep() time.Duration {
+	config := cmn.GCO.Get()
+	if !config.Quarantine.Enabled || !tq.p.ClusterStarted() {
+		if tq.qset.Load() != nil {
+			tq.restoreAll("disabled")
+		}
+		return tquarIdleIval
+	}
+	tq.check(&config.Quarantine)
+	return config.Quarantine.Interval.D()
+}
+
+func (tq *tquar) check(qconfig *cmn.QuarantineConf) {
+	var (
+		p       = tq.p
+		smap    = p.owner.smap.get()
+		samples = make(map[string]tqsample, smap.CountTargets())
+	)
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodGet,
+		Path:   apc.URLPathDae.S,
+		Query:  url.Values{apc.QparamWhat: []string{apc.WhatNodeStats}, apc.QparamMetrics: []string{tquarMetrics}},
+	}
+	args.to = core.Targets
+	args.timeout = cmn.Rom.MaxKeepalive()
+	args.cresv = cresNS{} // -> stats.Node
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	for _, res := range results {
+		if res.err != nil {
+			continue // (keepalive's job)
+		}
+		tracker := res.v.(*stats.Node).Tracker
+		samples[res.si.ID()] = tqsample{
+			n:    tracker[stats.GetCount].Value,
+			ns:   tracker[stats.GetLatencyTotal].Value,
+			errs: tracker[stats.IOErrGetCount].Value,
+		}
+	}
+	freeBcastRes(results)
+
+	tq.mu.Lock()
+	var (
+		changed bool
+		nq      int
+		maxq    = max(smap.CountActiveTs()*tquarMaxPct/100, 1)
+	)
+	for tid := range tq.m {
+		if smap.GetTarget(tid) == nil {
+			delete(tq.m, tid)
+			changed = true
+		}
+	}
+	for _, e := range tq.m {
+		if e.since != 0 {
+			nq++
+		}
+	}
+	for tid, curr := range samples {
+		e, ok := tq.m[tid]
+		if !ok {
+			e = &tqentry{}
+			tq.m[tid] = e
+		}
+		reason := e.eval(curr, qconfig)
+		e.prev, e.sampled = curr, true
+		switch {
+		case e.since == 0 && reason != "" && nq < maxq:
+			e.since, e.reason, e.healthy = time.Now().UnixNano(), reason, 0
+			nq++
+			changed = true
+			tq._event(tid, cmn.QuarantineEvQuarantine, reason)
+		case e.since != 0 && reason != "":
+			e.healthy = 0
+		case e.since != 0:
+			if !tq.probe(smap.GetTarget(tid), smap, qconfig) {
+				e.healthy = 0
+				continue
+			}
+			if e.healthy++; e.healthy >= tquarRecover {
+				e.since, e.reason = 0, ""
+				nq--
+				changed = true
+				tq._event(tid, cmn.QuarantineEvRestore, fmt.Sprintf("%d consecutive healthy probes", tquarRecover))
+			}
+		}
+	}
+	if changed {
+		tq._publish()
+	}
+	tq.mu.Unlock()
+}
+
+// returns non-empty reason when over the interval the target exceeds configured thresholds
+func (e *tqentry) eval(curr tqsample, qconfig *cmn.QuarantineConf) string {
+	if !e.sampled || curr.n < e.prev.n || curr.errs < e.prev.errs { // (e.g., target restarted)
+		return ""
+	}
+	var (
+		n    = curr.n - e.prev.n
+		errs = curr.errs - e.prev.errs
+	)
+	if n+errs < qconfig.MinReqs {
+		return ""
+	}
+	if pct := errs * 100 / (n + errs); pct >= int64(qconfig.MaxErrPct) {
+		return fmt.Sprintf("GET I/O errors %d%% (%d out of %d)", pct, errs, n+errs)
+	}
+	if n > 0 {
+		if lat := time.Duration((curr.ns - e.prev.ns) / n); lat > qconfig.MaxLatency.D() {
+			return fmt.Sprintf("GET latency %v (%d GETs)", lat, n)
+		}
+	}
+	return ""
+}
+
+// health check round trip
+func (tq *tquar) probe(tsi *meta.Snode, smap *smapX, qconfig *cmn.QuarantineConf) bool {
+	if tsi == nil {
+		return false
+	}
+	started := mono.NanoTime()
+	_, _, err := tq.p.reqHealth(tsi, cmn.Rom.MaxKeepalive(), nil, smap)
+	return err == nil && mono.Since(started) <= qconfig.MaxLatency.D()
+}
+
+func (tq *tquar) restoreAll(reason string) {
+	tq.mu.Lock()
+	for tid, e := range tq.m {
+		if e.since != 0 {
+			tq._event(tid, cmn.QuarantineEvRestore, reason)
+		}
+	}
+	clear(tq.m)
+	tq._publish()
+	tq.mu.Unlock()
+}
+
+// under lock
+func (tq *tquar) _event(tid, event, reason string) {
+	if event == cmn.QuarantineEvQuarantine {
+		nlog.Warningln(tq.p.String()+": quarantine target", meta.Tname(tid)+":", reason)
+	} else {
+		nlog.Infoln(tq.p.String()+": restore target", meta.Tname(tid)+":", reason)
+	}
+	if len(tq.events) >= tquarMaxEvents {
+		tq.events = tq.events[1:]
+	}
+	tq.events = append(tq.events, &cmn.QuarantineEvent{Target: tid, Event: event, Reason: reason, Time: time.Now().UnixNano()})
+}
+
+// under lock
+func (tq *tquar) _publish() {
+	qset := make(cos.StrSet, 2)
+	for tid, e := range tq.m {
+		if e.since != 0 {
+			qset.Add(tid)
+		}
+	}
+	if len(qset) == 0 {
+		tq.qset.Store(nil)
+	} else {
+		tq.qset.Store(&qset)
+	}
+}
+
+func (tq *tquar) info() *cmn.Quarantine {
+	out := &cmn.Quarantine{Proxy: tq.p.SID(), Targets: []*cmn.QuarantinedTarget{}}
+	tq.mu.Lock()
+	for tid, e := range tq.m {
+		if e.since != 0 {
+			out.Targets = append(out.Targets, &cmn.QuarantinedTarget{ID: tid, Reason: e.reason, Since: e.since})
+		}
+	}
+	out.Events = append([]*cmn.QuarantineEvent{}, tq.events...)
+	tq.mu.Unlock()
+	sort.Slice(out.Targets, func(i, j int) bool { return out.Targets[i].Since < out.Targets[j].Since })
+	return out
+}
+
+//
+// handlers
+//
+
+// GET /v1/cluster?what=quarantine (all proxies)
+func (p *proxy) qcluQuarantine(w http.ResponseWriter, r *http.Request, what string) {
+	all := []*cmn.Quarantine{p.tquar.info()}
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: apc.URLPathDae.S, Query: url.Values{apc.QparamWhat: []string{what}}}
+	args.rid = r.Header.Get(apc.HdrReqID)
+	args.to = core.Proxies
+	args.cresv = cresQT{} // -> cmn.Quarantine
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	for _, res := range results {
+		if res.err != nil {
+			nlog.Warningln(p.String()+": failed to get", what, "from", res.si.StringEx()+":", res.err)
+			continue
+		}
+		all = append(all, res.v.(*cmn.Quarantine))
+	}
+	freeBcastRes(results)
+	p.writeJSON(w, r, all, what)
+}
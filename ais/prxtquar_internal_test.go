@@ -0,0 +1,59 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+func TestQuarantineEval(t *testing.T) {
+	var (
+		qconfig = &cmn.QuarantineConf{
+			MaxLatency: cos.Duration(time.Second),
+			MaxErrPct:  5,
+			MinReqs:    100,
+			Enabled:    true,
+		}
+		e = &tqentry{}
+	)
+	// first sample: nothing to compare with
+	if reason := e.eval(tqsample{n: 1000, ns: int64(time.Hour)}, qconfig); reason != "" {
+		t.Fatalf("expected no reason upon first sample, got %q", reason)
+	}
+	e.prev, e.sampled = tqsample{n: 1000, ns: 1000 * int64(time.Millisecond)}, true
+
+	tests := []struct {
+		curr tqsample
+		slow bool
+	}{
+		{tqsample{n: 1050, ns: e.prev.ns + 50*int64(10*time.Second)}, false},             // too few GETs
+		{tqsample{n: 1200, ns: e.prev.ns + 200*int64(10*time.Millisecond)}, false},       // fast
+		{tqsample{n: 1200, ns: e.prev.ns + 200*int64(2*time.Second)}, true},              // slow
+		{tqsample{n: 1190, ns: e.prev.ns + 190*int64(time.Millisecond), errs: 10}, true}, // 5% errors
+		{tqsample{n: 10, ns: 10}, false},                                                 // restarted
+	}
+	for i, tc := range tests {
+		reason := e.eval(tc.curr, qconfig)
+		if (reason != "") != tc.slow {
+			t.Errorf("%d: expected quarantine %t, got %q", i, tc.slow, reason)
+		}
+	}
+
+	// (GET datapath)
+	tq := &tquar{m: map[string]*tqentry{"t1": {since: 1}, "t2": {}}}
+	tq._publish()
+	if !tq.has("t1") || tq.has("t2") {
+		t.Fatal("expected t1 (only) to be quarantined")
+	}
+	tq.m["t1"].since = 0
+	tq._publish()
+	if tq.has("t1") || tq.qset.Load() != nil {
+		t.Fatal("expected no quarantined targets")
+	}
+}
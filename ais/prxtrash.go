@@ -0,0 +1,170 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+)
+
+// Two-phase destroy (aka trash), primary only:
+// - with config.Space.TrashGrace > 0, destroying an ais:// bucket moves it into the
+//   hidden cmn.NsTrash namespace, under a unique name (see trashName below)
+// - the bucket retains its props (BID included), which is why targets simply rename
+//   its directories upon receiving the new BMD (see t._syncBMD)
+// - apc.ActUndeleteBck reverses the move - the props get restored as well
+// - trashed buckets get permanently destroyed once the grace period expires
+//   (or when explicitly destroyed by the user, e.g. `ais://@#.trash/<name>`)
+
+const trashPurgeIval = 10 * time.Minute
+
+func (p *proxy) trashInit() {
+	hk.Reg("trash"+hk.NameSuffix, p.purgeTrash, trashPurgeIval)
+}
+
+// <original-name>.<BID>, or just the BID when the former is not a valid bucket name (e.g., too long)
+func trashName(bck *meta.Bck, bid uint64) string {
+	sbid := strconv.FormatUint(bid, 36)
+	if name := bck.Name + "." + sbid; cos.CheckAlphaPlus(name, "") == nil {
+		return name
+	}
+	return sbid
+}
+
+func trashable(bck *meta.Bck, config *cmn.Config) bool {
+	return config.Space.TrashGrace > 0 && bck.IsAIS() && !bck.Ns.IsTrash() && bck.Backend() == nil
+}
+
+func (p *proxy) trashBucket(msg *apc.ActMsg, bck *meta.Bck) error {
+	if dependent := p.owner.bmd.get().clonedBy(bck); dependent != nil {
+		return errHasClone("destroy", bck, dependent)
+	}
+	return p._destroyTxn(msg, bck, bmodTrash)
+}
+
+func bmodTrash(ctx *bmdModifier, clone *bucketMD) error {
+	bck := ctx.bcks[0]
+	props, present := clone.Get(bck)
+	if !present {
+		return cmn.NewErrBckNotFound(bck.Bucket())
+	}
+	if err := bmodRm(ctx, clone); err != nil {
+		return err
+	}
+	nprops := props.Clone()
+	nprops.TrashedFrom = cmn.Bck{Name: bck.Name, Provider: apc.AIS, Ns: bck.Ns}
+	nprops.Trashed = time.Now().UnixNano()
+	tbck := meta.NewBck(trashName(bck, props.BID), apc.AIS, cmn.NsTrash, nprops)
+	clone.Add(tbck)
+	return nil
+}
+
+// POST /v1/buckets/<bucket-name> {apc.ActUndeleteBck}
+// where bucket is either the original (destroyed) one - in which case the most recently
+// trashed instance gets restored - or its counterpart in the cmn.NsTrash namespace
+func (p *proxy) undeleteBucket(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg, bck *meta.Bck) {
+	if p.forwardCP(w, r, msg, bck.Name) {
+		return
+	}
+	if err := p.checkAccess(w, r, nil, apc.AceCreateBucket); err != nil {
+		return
+	}
+	if bck.Provider == "" {
+		bck.Provider = apc.AIS
+	}
+	if !bck.IsAIS() {
+		p.writeErrf(w, r, "can only undelete ais:// buckets (%q is not)", bck)
+		return
+	}
+	tbck := findTrashed(p.owner.bmd.get(), bck)
+	if tbck == nil {
+		p.writeErr(w, r, cmn.NewErrBckNotFound(bck.Bucket()), http.StatusNotFound)
+		return
+	}
+	nlog.Infof("%s: %s %s => %s", p, msg.Action, tbck, tbck.Props.TrashedFrom.Cname(""))
+	if err := p._destroyTxn(msg, tbck, bmodUndelete); err != nil {
+		p.writeErr(w, r, err)
+	}
+}
+
+func findTrashed(bmd *bucketMD, bck *meta.Bck) (tbck *meta.Bck) {
+	if bck.Ns.IsTrash() {
+		if props, present := bmd.Get(bck); present {
+			tbck = meta.NewBck(bck.Name, apc.AIS, cmn.NsTrash, props)
+		}
+		return tbck
+	}
+	provider := apc.AIS
+	bmd.Range(&provider, &cmn.NsTrash, func(b *meta.Bck) bool {
+		from := &b.Props.TrashedFrom
+		if from.Name == bck.Name && from.Ns == bck.Ns {
+			if tbck == nil || b.Props.Trashed > tbck.Props.Trashed {
+				tbck = b
+			}
+		}
+		return false
+	})
+	return tbck
+}
+
+func bmodUndelete(ctx *bmdModifier, clone *bucketMD) error {
+	tbck := ctx.bcks[0]
+	props, present := clone.Get(tbck)
+	if !present {
+		return cmn.NewErrBckNotFound(tbck.Bucket())
+	}
+	from := &props.TrashedFrom
+	bck := meta.NewBck(from.Name, apc.AIS, from.Ns)
+	if _, present := clone.Get(bck); present {
+		return cmn.NewErrBckAlreadyExists(bck.Bucket())
+	}
+	nprops := props.Clone()
+	nprops.TrashedFrom = cmn.Bck{}
+	nprops.Trashed = 0
+	clone.del(tbck)
+	bck.Props = nprops
+	clone.Add(bck)
+	return nil
+}
+
+// housekeeping: permanently destroy trashed buckets past their grace period
+func (p *proxy) purgeTrash() time.Duration {
+	if !p.ClusterStarted() {
+		return trashPurgeIval
+	}
+	smap := p.owner.smap.get()
+	if !smap.isPrimary(p.si) {
+		return trashPurgeIval
+	}
+	var (
+		expired  []*meta.Bck
+		provider = apc.AIS
+		grace    = cmn.GCO.Get().Space.TrashGrace.D()
+		now      = time.Now().UnixNano()
+	)
+	p.owner.bmd.get().Range(&provider, &cmn.NsTrash, func(b *meta.Bck) bool {
+		// (with trash disabled, purge right away)
+		if grace == 0 || time.Duration(now-b.Props.Trashed) > grace {
+			expired = append(expired, b)
+		}
+		return false
+	})
+	for _, bck := range expired {
+		if err := p.destroyBucket(&apc.ActMsg{Action: apc.ActDestroyBck}, bck); err != nil && !cmn.IsErrBckNotFound(err) {
+			nlog.Errorln(p.String(), "failed to purge", bck.String()+":", err)
+			continue
+		}
+		nlog.Infoln(p.String(), "purged", bck.String(), "trashed from", bck.Props.TrashedFrom.Cname(""))
+	}
+	return trashPurgeIval
+}
@@ -235,6 +235,9 @@ func (p *proxy) _createBucketWithProps(msg *apc.ActMsg, bck *meta.Bck, bprops *c
 	if _, present := bmd.Get(bck); present {
 		return cmn.NewErrBckAlreadyExists(bck.Bucket())
 	}
+	if err := nsquotaBuckets(bmd, bck); err != nil {
+		return err
+	}
 
 	// 2. begin
 	var (
@@ -270,6 +273,9 @@ func (p *proxy) _createBucketWithProps(msg *apc.ActMsg, bck *meta.Bck, bprops *c
 
 func bmodCreate(ctx *bmdModifier, clone *bucketMD) (err error) {
 	bck := ctx.bcks[0]
+	if err := nsquotaBuckets(clone, bck); err != nil {
+		return err
+	}
 	added := clone.add(bck, ctx.setProps)
 	if !added {
 		err = cmn.NewErrBckAlreadyExists(bck.Bucket())
@@ -282,6 +288,9 @@ func bmodRm(ctx *bmdModifier, clone *bucketMD) error {
 	if _, present := clone.Get(bck); !present {
 		return cmn.NewErrBckNotFound(bck.Bucket())
 	}
+	if dependent := clone.clonedBy(bck); dependent != nil {
+		return errHasClone("destroy", bck, dependent)
+	}
 	deleted := clone.del(bck)
 	cos.Assert(deleted)
 	return nil
@@ -289,10 +298,11 @@ func bmodRm(ctx *bmdModifier, clone *bucketMD) error {
 
 // make-n-copies: { confirm existence -- begin -- update locally -- metasync -- commit }
 func (p *proxy) makeNCopies(msg *apc.ActMsg, bck *meta.Bck) (xid string, err error) {
-	copies, err := _parseNCopies(msg.Value)
+	mnc, err := _parseMNC(msg.Value)
 	if err != nil {
 		return
 	}
+	copies := mnc.Copies
 
 	// 1. confirm existence
 	bmd := p.owner.bmd.get()
@@ -368,7 +378,7 @@ func bmodMirror(ctx *bmdModifier, clone *bucketMD) error {
 }
 
 // set-bucket-props: { confirm existence -- begin -- apply props -- metasync -- commit }
-func (p *proxy) setBprops(msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.Bprops) (string /*xid*/, error) {
+func (p *proxy) setBprops(r *http.Request, msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.Bprops) (string /*xid*/, error) {
 	// 1. confirm existence
 	bprops, present := p.owner.bmd.get().Get(bck)
 	if !present {
@@ -411,13 +421,14 @@ func (p *proxy) setBprops(msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.Bprops) (s
 
 	// 3. update BMD locally & metasync updated BMD
 	ctx := &bmdModifier{
-		pre:      p.bmodSetProps,
-		final:    p.bmodSync,
-		wait:     waitmsync,
-		msg:      msg,
-		txnID:    c.uuid,
-		setProps: nprops,
-		bcks:     []*meta.Bck{bck},
+		pre:         p.bmodSetProps,
+		final:       p.bmodSync,
+		wait:        waitmsync,
+		msg:         msg,
+		txnID:       c.uuid,
+		setProps:    nprops,
+		propsChange: p.propsChange(r, msg.Action),
+		bcks:        []*meta.Bck{bck},
 	}
 	bmd, err := p.owner.bmd.modify(ctx)
 	if err != nil {
@@ -430,7 +441,10 @@ func (p *proxy) setBprops(msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.Bprops) (s
 	// NOTE: setting up IC listening prior to committing (and confirming xid) here and elsewhere
 	if ctx.needReMirror || ctx.needReEC {
 		action := apc.ActMakeNCopies
-		if ctx.needReEC {
+		switch {
+		case ctx.needReslice:
+			action = apc.ActECReslice
+		case ctx.needReEC:
 			action = apc.ActECEncode
 		}
 		nl := xact.NewXactNL(c.uuid, action, &c.smap.Smap, nil, bck.Bucket())
@@ -459,11 +473,39 @@ func (p *proxy) bmodSetProps(ctx *bmdModifier, clone *bucketMD) (err error) {
 	}
 	ctx.needReMirror = _reMirror(bprops, ctx.setProps)
 	targetCnt, ctx.needReEC = _reEC(bprops, ctx.setProps, bck, p.owner.smap.get())
+	ctx.needReslice = ctx.needReEC && _reslice(&bprops.EC, &ctx.setProps.EC)
+	if bprops.Frozen != 0 && (ctx.needReMirror || ctx.needReEC) {
+		return cmn.NewErrBckFrozen(bck.Bucket())
+	}
+	ctx.setProps.Frozen = bprops.Frozen // (freeze and thaw are separate actions)
 	debug.Assert(!ctx.needReEC || ctx.setProps.Validate(targetCnt) == nil)
 	clone.set(bck, ctx.setProps)
+
+	// record the change (if any)
+	if c := ctx.propsChange; c != nil {
+		if c.Diff = ctx.setProps.DiffFrom(bprops); len(c.Diff) > 0 {
+			c.BMDVersion = clone.Version
+			clone.AddPropsChange(bprops.BID, c)
+		}
+	}
 	return nil
 }
 
+// who and when
+func (p *proxy) propsChange(r *http.Request, action string) *cmn.BpropsChange {
+	c := &cmn.BpropsChange{Action: action, Client: r.RemoteAddr, Time: time.Now().UnixNano()}
+	if fwd := r.Header.Get(cos.HdrForwardedFor); fwd != "" {
+		// forwarded to primary: the last address is the one added by the (forwarding) proxy
+		c.Client = strings.TrimSpace(fwd[strings.LastIndexByte(fwd, ',')+1:])
+	}
+	if cmn.Rom.AuthEnabled() {
+		if tk, err := p.validateToken(r.Header); err == nil {
+			c.User = tk.UserID
+		}
+	}
+	return c
+}
+
 // rename-bucket: { confirm existence -- begin -- RebID -- metasync -- commit -- wait for rebalance and unlock }
 func (p *proxy) renameBucket(bckFrom, bckTo *meta.Bck, msg *apc.ActMsg) (xid string, err error) {
 	if err = p.canRebalance(); err != nil {
@@ -480,6 +522,10 @@ func (p *proxy) renameBucket(bckFrom, bckTo *meta.Bck, msg *apc.ActMsg) (xid str
 		err = cmn.NewErrBckAlreadyExists(bckTo.Bucket())
 		return
 	}
+	if dependent := bmd.clonedBy(bckFrom); dependent != nil {
+		err = errHasClone("rename", bckFrom, dependent)
+		return
+	}
 
 	// 2. begin
 	var (
@@ -561,8 +607,25 @@ func bmodMv(ctx *bmdModifier, clone *bucketMD) error {
 		bprops, present = clone.Get(bckFrom)
 	)
 	debug.Assert(present)
+	if bprops.Renamed != "" {
+		return cmn.NewErrBusy("bucket", bckFrom.Cname(""), "(being renamed)")
+	}
+	if dependent := clone.clonedBy(bckFrom); dependent != nil {
+		return errHasClone("rename", bckFrom, dependent)
+	}
 	bckFrom.Props = bprops.Clone()
 	bckTo.Props = bprops.Clone()
+	if bckFrom.IsCloud() {
+		// remap: cached content goes to the new ais bucket that keeps the cloud one as its backend
+		bckTo.Props.Provider = apc.AIS
+		bckTo.Props.BackendBck = *bckFrom.Bucket()
+		bckTo.Props.Extra = cmn.ExtraProps{} // (cloud-specific; stays with the backend)
+	}
+	if !bckFrom.IsAIS() || bckFrom.Ns != bckTo.Ns {
+		if err := nsquotaBuckets(clone, bckTo); err != nil {
+			return err
+		}
+	}
 	added := clone.add(bckTo, bckTo.Props)
 	debug.Assert(added)
 	bckFrom.Props.Renamed = apc.ActMoveBck // NOTE: state until `BMDVersionFixup` by renaming xaction
@@ -779,7 +842,11 @@ func (p *proxy) ecEncode(bck *meta.Bck, msg *apc.ActMsg) (xid string, err error)
 	c.msg.BMDVersion = bmd.version()
 
 	// 5. IC
-	nl := xact.NewXactNL(c.uuid, msg.Action, &c.smap.Smap, nil, bck.Bucket())
+	kind := msg.Action
+	if nprops, ok := bmd.Get(bck); ok && _reslice(&props.EC, &nprops.EC) {
+		kind = apc.ActECReslice
+	}
+	nl := xact.NewXactNL(c.uuid, kind, &c.smap.Smap, nil, bck.Bucket())
 	nl.SetOwner(equalIC)
 	p.ic.registerEqual(regIC{nl: nl, smap: c.smap, query: c.req.Query})
 
@@ -802,12 +869,13 @@ func (p *proxy) validateECConf(bck *meta.Bck, confToSet *cmn.ECConfToSet, currCo
 	}
 
 	if currConf.Enabled {
-		err := fmt.Errorf("%s: EC is already enabled on the bucket %s", p, bck.Cname(""))
 		if newConf.DataSlices != currConf.DataSlices || newConf.ParitySlices != currConf.ParitySlices {
-			// Changing data or parity slice count on the fly is unsupported
-			return err
+			// re-slice: targets run ec-reslice to re-encode all existing objects with the new (data, parity)
+			nlog.Infof("%s: re-slicing %s: (d=%d, p=%d) => (d=%d, p=%d)", p, bck.Cname(""),
+				currConf.DataSlices, currConf.ParitySlices, newConf.DataSlices, newConf.ParitySlices)
+		} else {
+			nlog.Warningf("%s: EC is already enabled on the bucket %s: old %+v, new %+v", p, bck.Cname(""), currConf, newConf)
 		}
-		nlog.Warningf("%v: old %+v, new %+v", err, currConf, newConf)
 	}
 
 	smap := p.owner.smap.get()
@@ -858,6 +926,14 @@ func (p *proxy) beginRmTarget(si *meta.Snode, msg *apc.ActMsg) error {
 
 // destroy bucket: { begin -- commit }
 func (p *proxy) destroyBucket(msg *apc.ActMsg, bck *meta.Bck) error {
+	if dependent := p.owner.bmd.get().clonedBy(bck); dependent != nil {
+		return errHasClone("destroy", bck, dependent)
+	}
+	return p._destroyTxn(msg, bck, bmodRm)
+}
+
+// (destroy | trash | undelete)
+func (p *proxy) _destroyTxn(msg *apc.ActMsg, bck *meta.Bck, pre func(*bmdModifier, *bucketMD) error) error {
 	nlp := newBckNLP(bck)
 	nlp.Lock()
 	defer nlp.Unlock()
@@ -883,7 +959,7 @@ func (p *proxy) destroyBucket(msg *apc.ActMsg, bck *meta.Bck) error {
 
 	// 2. Distribute new BMD
 	ctx := &bmdModifier{
-		pre:   bmodRm,
+		pre:   pre,
 		final: p.bmodSync,
 		msg:   msg,
 		txnID: c.uuid,
@@ -928,7 +1004,7 @@ func (p *proxy) destroyBucketData(msg *apc.ActMsg, bck *meta.Bck) error {
 // promote synchronously if the number of files (to promote) is less or equal
 const promoteNumSync = 16
 
-func (p *proxy) promote(bck *meta.Bck, msg *apc.ActMsg, tsi *meta.Snode) (xid string, err error) {
+func (p *proxy) promote(r *http.Request, bck *meta.Bck, msg *apc.ActMsg, tsi *meta.Snode) (xid string, err error) {
 	var (
 		totalN           int64
 		waitmsync        bool
@@ -936,6 +1012,11 @@ func (p *proxy) promote(bck *meta.Bck, msg *apc.ActMsg, tsi *meta.Snode) (xid st
 		singleT          bool
 	)
 	c := p.prepTxnClient(msg, bck, waitmsync)
+	lc, _, err := p.lreqs.begin(r, c.uuid, msg.Action, bck.Cname(""))
+	if err != nil {
+		return "", err
+	}
+	defer p.lreqs.end(lc, true)
 	if c.smap.CountActiveTs() == 1 {
 		singleT = true
 	} else if tsi != nil {
@@ -959,6 +1040,13 @@ func (p *proxy) promote(bck *meta.Bck, msg *apc.ActMsg, tsi *meta.Snode) (xid st
 		noXact = true
 	}
 
+	// canceled while scanning the source (see lreqs)
+	if p.lreqs.canceled(lc) {
+		err = cmn.NewErrAborted(xact.Cname(msg.Action, c.uuid), "canceled", nil)
+		c.bcastAbort(bck, err)
+		return
+	}
+
 	// IC
 	if !noXact {
 		nl := xact.NewXactNL(c.uuid, msg.Action, &c.smap.Smap, nil, bck.Bucket())
@@ -1039,6 +1127,9 @@ func bmodCpProps(ctx *bmdModifier, clone *bucketMD) error {
 	} else {
 		bckTo.Props = defaultBckProps(bckPropsArgs{bck: bckTo})
 	}
+	if err := nsquotaBuckets(clone, bckTo); err != nil {
+		return err
+	}
 	added := clone.add(bckTo, bckTo.Props)
 	debug.Assert(added)
 	return nil
@@ -1088,6 +1179,12 @@ func (p *proxy) makeNewBckProps(bck *meta.Bck, propsToUpdate *cmn.BpropsToSet, c
 	)
 	nprops = bprops.Clone()
 	nprops.Apply(propsToUpdate)
+	if propsToUpdate.Extra != nil {
+		// per-bucket credentials: never store in plain text
+		if err = nprops.Extra.Seal(cfg.Auth.Secret); err != nil {
+			return
+		}
+	}
 	if bck.IsCloud() {
 		bv, nv := bck.VersionConf().Enabled, nprops.Versioning.Enabled
 		if bv != nv {
@@ -1098,10 +1195,10 @@ func (p *proxy) makeNewBckProps(bck *meta.Bck, propsToUpdate *cmn.BpropsToSet, c
 		}
 	}
 	if bprops.EC.Enabled && nprops.EC.Enabled {
-		sameSlices := bprops.EC.DataSlices == nprops.EC.DataSlices && bprops.EC.ParitySlices == nprops.EC.ParitySlices
-		sameLimit := bprops.EC.ObjSizeLimit == nprops.EC.ObjSizeLimit
-		if !sameSlices || (!sameLimit && !propsToUpdate.Force) {
-			err = fmt.Errorf("%s: once enabled, EC configuration can be only disabled but cannot change", p.si)
+		// NOTE: changing (data, parity) is permitted - targets then run ec-reslice
+		// to re-encode existing objects (see _reslice)
+		if bprops.EC.ObjSizeLimit != nprops.EC.ObjSizeLimit && !propsToUpdate.Force {
+			err = fmt.Errorf("%s: once enabled, EC object size limit cannot change (use force to override)", p.si)
 			return
 		}
 	} else if nprops.EC.Enabled {
@@ -1125,14 +1222,23 @@ func (p *proxy) makeNewBckProps(bck *meta.Bck, propsToUpdate *cmn.BpropsToSet, c
 			return
 		}
 	}
+	if name := nprops.ETL.Name; name != "" && name != bprops.ETL.Name {
+		if err = p.checkBckETL(name); err != nil {
+			return
+		}
+	}
 	// cannot have re-mirroring and erasure coding on the same bucket at the same time
+	// (except removing extra copies when switching from mirroring to EC)
 	remirror := _reMirror(bprops, nprops)
 	targetCnt, reec := _reEC(bprops, nprops, bck, p.owner.smap.get())
-	if len(creating) == 0 && remirror && reec {
+	if len(creating) == 0 && remirror && reec && nprops.Mirror.Enabled {
 		err = cmn.NewErrBusy("bucket", bck.Cname(""))
 		return
 	}
 	err = nprops.Validate(targetCnt)
+	if err == nil && reec {
+		err = _ecFailDoms(&nprops.EC, p.owner.smap.get())
+	}
 	if cmn.IsErrWarning(err) && propsToUpdate.Force {
 		nlog.Warningln("Ignoring soft error:", err)
 		err = nil
@@ -0,0 +1,112 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// cloud bucket with some cached content, and its would-be ais counterpart
+func mvTestBcks() (*bucketMD, *meta.Bck, *meta.Bck) {
+	bmd := newBucketMD()
+	bckFrom := meta.NewBck("cloud-src", apc.AWS, cmn.NsGlobal)
+	bmd.add(bckFrom, &cmn.Bprops{
+		Mirror: cmn.MirrorConf{Copies: 2, Enabled: true},
+		Extra:  cmn.ExtraProps{AWS: cmn.ExtraPropsAWS{CloudRegion: "us-west-2"}},
+	})
+	return bmd, meta.NewBck("cloud-src", apc.AWS, cmn.NsGlobal), meta.NewBck("renamed", apc.AIS, cmn.NsGlobal)
+}
+
+func TestRenameCloudBucket(t *testing.T) {
+	bmd, bckFrom, bckTo := mvTestBcks()
+	clone := bmd.clone()
+	ctx := &bmdModifier{msg: &apc.ActMsg{Action: apc.ActMoveBck}, bcks: []*meta.Bck{bckFrom, bckTo}}
+	if err := bmodMv(ctx, clone); err != nil {
+		t.Fatal(err)
+	}
+
+	// new ais bucket backed by the original, with the original's props (except cloud-specific)
+	props, present := clone.Get(bckTo)
+	if !present {
+		t.Fatalf("expected %s to be added", bckTo)
+	}
+	if props.Provider != apc.AIS {
+		t.Errorf("expected provider %q, got %q", apc.AIS, props.Provider)
+	}
+	if !props.BackendBck.Equal(bckFrom.Bucket()) {
+		t.Errorf("expected backend %s, got %s", bckFrom, props.BackendBck.String())
+	}
+	if props.Mirror.Copies != 2 || !props.Mirror.Enabled {
+		t.Errorf("expected mirroring to carry over, got %+v", props.Mirror)
+	}
+	if props.Extra.AWS.CloudRegion != "" {
+		t.Errorf("expected no cloud-specific props, got %+v", props.Extra)
+	}
+
+	// the original is being renamed and cannot be renamed again
+	props, present = clone.Get(bckFrom)
+	if !present || props.Renamed != apc.ActMoveBck {
+		t.Fatalf("expected %s to be marked as being renamed, got %v", bckFrom, props)
+	}
+	again := &bmdModifier{
+		msg:  &apc.ActMsg{Action: apc.ActMoveBck},
+		bcks: []*meta.Bck{meta.NewBck("cloud-src", apc.AWS, cmn.NsGlobal), meta.NewBck("renamed-2", apc.AIS, cmn.NsGlobal)},
+	}
+	err := bmodMv(again, clone.clone())
+	var errBusy *cmn.ErrBusy
+	if !errors.As(err, &errBusy) {
+		t.Fatalf("expected busy error, got %v", err)
+	}
+
+	// upon completion, the original stays (as the backend) and is no longer busy
+	p := &proxy{}
+	post := &bmdModifier{msg: &apc.ActMsg{Value: apc.ActMoveBck}, bcks: []*meta.Bck{meta.NewBck("cloud-src", apc.AWS, cmn.NsGlobal)}}
+	if err := p.bmodPostMv(post, clone); err != nil {
+		t.Fatal(err)
+	}
+	if post.terminate {
+		t.Fatal("unexpected termination")
+	}
+	props, present = clone.Get(bckFrom)
+	if !present || props.Renamed != "" {
+		t.Fatalf("expected %s to remain and not be busy, got %v", bckFrom, props)
+	}
+	if props.Extra.AWS.CloudRegion != "us-west-2" {
+		t.Errorf("expected cloud-specific props to remain, got %+v", props.Extra)
+	}
+}
+
+func TestRenameAISBucket(t *testing.T) {
+	var (
+		bmd     = newBucketMD()
+		bckFrom = meta.NewBck("ais-src", apc.AIS, cmn.NsGlobal)
+		bckTo   = meta.NewBck("ais-dst", apc.AIS, cmn.NsGlobal)
+	)
+	bmd.add(bckFrom, &cmn.Bprops{})
+	clone := bmd.clone()
+	ctx := &bmdModifier{msg: &apc.ActMsg{Action: apc.ActMoveBck}, bcks: []*meta.Bck{bckFrom, bckTo}}
+	if err := bmodMv(ctx, clone); err != nil {
+		t.Fatal(err)
+	}
+	props, present := clone.Get(bckTo)
+	if !present || !props.BackendBck.IsEmpty() {
+		t.Fatalf("expected %s to be added with no backend, got %v", bckTo, props)
+	}
+
+	// upon completion, the original is gone
+	p := &proxy{}
+	post := &bmdModifier{msg: &apc.ActMsg{Value: apc.ActMoveBck}, bcks: []*meta.Bck{meta.NewBck("ais-src", apc.AIS, cmn.NsGlobal)}}
+	if err := p.bmodPostMv(post, clone); err != nil {
+		t.Fatal(err)
+	}
+	if _, present := clone.Get(bckFrom); present {
+		t.Fatalf("expected %s to be removed", bckFrom)
+	}
+}
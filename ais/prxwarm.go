@@ -0,0 +1,190 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+// Warm restart (apc.ActShutdownNode with apc.ActValRmNode.WarmRestart):
+// - the primary puts the target in maintenance without rebalancing and keeps (timed) GFN
+//   active on the remaining targets for the duration of the grace period (rebalance.restart_grace)
+// - if the target rejoins within the grace period with intact mountpaths (ie., without cos.DataLoss),
+//   the primary takes it out of maintenance - again, without rebalancing
+// - otherwise, upon expiration, the primary starts global rebalance; if the target rejoins
+//   intact while this rebalance is still running, the latter gets aborted
+// - the state is kept in memory by the current primary; upon change of primary, the target
+//   simply stays in maintenance (same as shutdown-node with no rebalance)
+
+const warmHkIval = 5 * time.Second
+
+type (
+	warmEntry struct {
+		deadline int64  // mono-time
+		rebID    string // rebalance started upon expiration
+	}
+	warmRestarts struct {
+		p  *proxy
+		m  map[string]*warmEntry // by target ID
+		mu sync.Mutex
+	}
+)
+
+func (wr *warmRestarts) init(p *proxy) {
+	wr.p = p
+	wr.m = make(map[string]*warmEntry, 2)
+	hk.Reg("warm-restart"+hk.NameSuffix, wr.housekeep, warmHkIval)
+}
+
+func (wr *warmRestarts) add(si *meta.Snode, grace time.Duration) {
+	wr.mu.Lock()
+	wr.m[si.ID()] = &warmEntry{deadline: mono.NanoTime() + grace.Nanoseconds()}
+	wr.mu.Unlock()
+	nlog.Infoln(wr.p.String()+":", si.StringEx(), "warm restart, grace period", grace)
+}
+
+func (wr *warmRestarts) del(sid string) {
+	wr.mu.Lock()
+	delete(wr.m, sid)
+	wr.mu.Unlock()
+}
+
+// keep GFN active for the duration of the grace period
+func (wr *warmRestarts) gfn(si *meta.Snode, grace time.Duration) {
+	smap := wr.p.owner.smap.get()
+	msg := wr.p.newAmsgActVal(apc.ActStartGFN, grace)
+	msg.UUID = si.ID()
+	revs := revsPair{&smapX{Smap: meta.Smap{Version: smap.Version}}, msg}
+	_ = wr.p.metasyncer.notify(false /*wait*/, revs)
+}
+
+func (wr *warmRestarts) housekeep() time.Duration {
+	var (
+		now     = mono.NanoTime()
+		expired []string
+		done    []string
+	)
+	wr.mu.Lock()
+	for sid, e := range wr.m {
+		switch {
+		case e.rebID != "":
+			if nl := wr.p.notifs.entry(e.rebID); nl == nil || nl.Finished() {
+				done = append(done, sid)
+			}
+		case now > e.deadline:
+			expired = append(expired, sid)
+		}
+	}
+	for _, sid := range done {
+		delete(wr.m, sid)
+	}
+	wr.mu.Unlock()
+
+	for _, sid := range expired {
+		wr.expire(sid)
+	}
+	return warmHkIval
+}
+
+// grace period expired: rebalance
+func (wr *warmRestarts) expire(sid string) {
+	var (
+		p    = wr.p
+		smap = p.owner.smap.get()
+		tsi  = smap.GetNode(sid)
+	)
+	if tsi == nil || !smap.InMaint(tsi) || !smap.isPrimary(p.si) {
+		wr.del(sid) // removed, taken out of maintenance, or no longer primary
+		return
+	}
+	nlog.Warningln(p.String()+":", tsi.StringEx(), "did not return within the grace period - rebalancing")
+	if err := p.canRebalance(); err != nil {
+		nlog.Errorln(p.String()+":", "cannot rebalance:", err)
+		wr.del(sid)
+		return
+	}
+	rmdCtx := &rmdModifier{
+		pre:     rmdInc,
+		final:   rmdSync,
+		p:       p,
+		smapCtx: &smapModifier{smap: smap, msg: &apc.ActMsg{Action: apc.ActRebalance}},
+	}
+	if _, err := p.owner.rmd.modify(rmdCtx); err != nil {
+		nlog.Errorln(p.String()+":", "failed to rebalance:", err)
+		wr.del(sid)
+		return
+	}
+	wr.mu.Lock()
+	if e, ok := wr.m[sid]; ok {
+		e.rebID = rmdCtx.rebID
+	}
+	wr.mu.Unlock()
+}
+
+// target (self- or admin-) joined back: take it out of maintenance
+func (wr *warmRestarts) rejoined(nsi *meta.Snode, flags cos.NodeStateFlags) {
+	wr.mu.Lock()
+	e, ok := wr.m[nsi.ID()]
+	if ok {
+		delete(wr.m, nsi.ID())
+	}
+	wr.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	var (
+		p        = wr.p
+		dataLoss = flags.IsSet(cos.DataLoss)
+		msg      = &apc.ActMsg{Action: apc.ActStopMaintenance}
+		opts     = &apc.ActValRmNode{DaemonID: nsi.ID(), SkipRebalance: !dataLoss}
+	)
+	if dataLoss {
+		nlog.Warningln(p.String()+":", nsi.StringEx(), "returned from warm restart with data loss - rebalancing")
+	} else {
+		nlog.Infoln(p.String()+":", nsi.StringEx(), "returned from warm restart")
+		if e.rebID != "" {
+			wr.abortReb(e.rebID)
+		}
+	}
+	if _, err := p.mcastStopMaint(msg, opts); err != nil {
+		nlog.Errorln(p.String()+":", "failed to take", nsi.StringEx(), "out of maintenance:", err)
+	}
+}
+
+// (compare with abortReq)
+func (wr *warmRestarts) abortReb(rebID string) {
+	if nl := wr.p.notifs.entry(rebID); nl == nil || nl.Finished() {
+		return
+	}
+	nlog.Infoln(wr.p.String()+":", "aborting rebalance", rebID)
+	msg := apc.ActMsg{
+		Action: apc.ActXactStop,
+		Value:  xact.ArgsMsg{ID: rebID, Kind: apc.ActRebalance},
+	}
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodPut, Path: apc.URLPathXactions.S, Body: cos.MustMarshal(msg)}
+	args.to = core.Targets
+	results := wr.p.bcastGroup(args)
+	freeBcArgs(args)
+	for _, res := range results {
+		if res.err != nil {
+			nlog.Errorln(wr.p.String()+":", "failed to abort rebalance", rebID, res.toErr())
+		}
+	}
+	freeBcastRes(results)
+}
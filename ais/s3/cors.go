@@ -0,0 +1,164 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// CORS configuration (GET|PUT|DELETE /s3/<bucket-name>?cors) is stored as cmn.Bprops.CORS;
+// both proxies and targets respond to cross-origin /s3 requests (and proxies - to preflight
+// OPTIONS) as per the first matching rule
+
+const (
+	HdrOrigin             = "Origin"
+	HdrACRequestMethod    = "Access-Control-Request-Method"
+	HdrACRequestHeaders   = "Access-Control-Request-Headers"
+	hdrACAllowOrigin      = "Access-Control-Allow-Origin"
+	hdrACAllowMethods     = "Access-Control-Allow-Methods"
+	hdrACAllowHeaders     = "Access-Control-Allow-Headers"
+	hdrACExposeHeaders    = "Access-Control-Expose-Headers"
+	hdrACMaxAge           = "Access-Control-Max-Age"
+	hdrACAllowCredentials = "Access-Control-Allow-Credentials"
+	hdrVary               = "Vary"
+)
+
+// NOTE: do not rename (see types.go)
+type (
+	CORSConfiguration struct {
+		Ns    string     `xml:"xmlns,attr,omitempty"`
+		Rules []CORSRule `xml:"CORSRule"`
+	}
+	CORSRule struct {
+		ID             string   `xml:"ID,omitempty"`
+		AllowedHeaders []string `xml:"AllowedHeader,omitempty"`
+		AllowedMethods []string `xml:"AllowedMethod"`
+		AllowedOrigins []string `xml:"AllowedOrigin"`
+		ExposeHeaders  []string `xml:"ExposeHeader,omitempty"`
+		MaxAgeSeconds  int      `xml:"MaxAgeSeconds,omitempty"`
+	}
+)
+
+func NewCORSConfiguration(rules cmn.CORSRules) *CORSConfiguration {
+	r := &CORSConfiguration{Ns: s3Namespace, Rules: make([]CORSRule, len(rules))}
+	for i := range rules {
+		rule := &rules[i]
+		r.Rules[i] = CORSRule{
+			ID:             rule.ID,
+			AllowedHeaders: rule.AllowedHeaders,
+			AllowedMethods: rule.AllowedMethods,
+			AllowedOrigins: rule.AllowedOrigins,
+			ExposeHeaders:  rule.ExposeHeaders,
+			MaxAgeSeconds:  rule.MaxAgeSeconds,
+		}
+	}
+	return r
+}
+
+func (r *CORSConfiguration) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+func (r *CORSConfiguration) ToRules() cmn.CORSRules {
+	rules := make(cmn.CORSRules, len(r.Rules))
+	for i := range r.Rules {
+		rule := &r.Rules[i]
+		rules[i] = cmn.CORSRule{
+			ID:             rule.ID,
+			AllowedOrigins: rule.AllowedOrigins,
+			AllowedMethods: rule.AllowedMethods,
+			AllowedHeaders: rule.AllowedHeaders,
+			ExposeHeaders:  rule.ExposeHeaders,
+			MaxAgeSeconds:  rule.MaxAgeSeconds,
+		}
+	}
+	return rules
+}
+
+// actual (non-preflight) cross-origin request: set response headers if permitted
+func SetCORS(hdr http.Header, rules cmn.CORSRules, origin, method string) {
+	if rule := matchCORS(rules, origin, method, ""); rule != nil {
+		corsHeaders(hdr, rule, origin)
+	}
+}
+
+// preflight (OPTIONS) request: returns false if not permitted
+func PreflightCORS(hdr http.Header, rules cmn.CORSRules, origin, method, reqHeaders string) bool {
+	rule := matchCORS(rules, origin, method, reqHeaders)
+	if rule == nil {
+		return false
+	}
+	corsHeaders(hdr, rule, origin)
+	hdr.Set(hdrACAllowMethods, strings.Join(rule.AllowedMethods, ", "))
+	if reqHeaders != "" {
+		hdr.Set(hdrACAllowHeaders, reqHeaders)
+	}
+	if rule.MaxAgeSeconds > 0 {
+		hdr.Set(hdrACMaxAge, strconv.Itoa(rule.MaxAgeSeconds))
+	}
+	return true
+}
+
+func corsHeaders(hdr http.Header, rule *cmn.CORSRule, origin string) {
+	if len(rule.AllowedOrigins) == 1 && rule.AllowedOrigins[0] == "*" {
+		hdr.Set(hdrACAllowOrigin, "*")
+	} else {
+		hdr.Set(hdrACAllowOrigin, origin)
+		hdr.Set(hdrACAllowCredentials, "true")
+		hdr.Add(hdrVary, HdrOrigin)
+	}
+	if len(rule.ExposeHeaders) > 0 {
+		hdr.Set(hdrACExposeHeaders, strings.Join(rule.ExposeHeaders, ", "))
+	}
+}
+
+func matchCORS(rules cmn.CORSRules, origin, method, reqHeaders string) *cmn.CORSRule {
+outer:
+	for i := range rules {
+		rule := &rules[i]
+		if !matchAny(rule.AllowedOrigins, origin, false) || !matchAny(rule.AllowedMethods, method, false) {
+			continue
+		}
+		for _, h := range strings.Split(reqHeaders, ",") {
+			if h = strings.TrimSpace(h); h != "" && !matchAny(rule.AllowedHeaders, h, true) {
+				continue outer
+			}
+		}
+		return rule
+	}
+	return nil
+}
+
+// with (at most) one '*' wildcard
+func matchAny(patterns []string, s string, fold bool) bool {
+	if fold {
+		s = strings.ToLower(s)
+	}
+	for _, p := range patterns {
+		if fold {
+			p = strings.ToLower(p)
+		}
+		prefix, suffix, wildcard := strings.Cut(p, "*")
+		if !wildcard {
+			if p == s {
+				return true
+			}
+			continue
+		}
+		if len(s) >= len(prefix)+len(suffix) && strings.HasPrefix(s, prefix) && strings.HasSuffix(s, suffix) {
+			return true
+		}
+	}
+	return false
+}
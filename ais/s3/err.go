@@ -6,6 +6,7 @@ package s3
 
 import (
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -20,12 +21,33 @@ import (
 
 const ErrPrefix = "aws-error"
 
-type Error struct {
-	Code      string
-	Message   string
-	Resource  string
-	RequestID string `xml:"RequestId"`
-}
+// S3 error codes (in addition to those that WriteErr infers)
+const (
+	ErrCodeMalformedPolicy = "MalformedPolicy"
+	ErrCodeNoSuchPolicy    = "NoSuchBucketPolicy"
+	ErrCodeNoSuchCORS      = "NoSuchCORSConfiguration"
+	ErrCodeMalformedXML    = "MalformedXML"
+	ErrCodeAccessForbidden = "AccessForbidden"
+	ErrCodeSHA256Mismatch  = "XAmzContentSHA256Mismatch"
+)
+
+type (
+	Error struct {
+		Code      string
+		Message   string
+		Resource  string
+		RequestID string `xml:"RequestId"`
+	}
+	// error with explicit S3 code
+	ErrCoded struct {
+		Code string
+		Msg  string
+	}
+)
+
+func NewErrCoded(code, msg string) *ErrCoded { return &ErrCoded{Code: code, Msg: msg} }
+
+func (e *ErrCoded) Error() string { return e.Msg }
 
 func (e *Error) mustMarshal(sgl *memsys.SGL) {
 	sgl.Write([]byte(xml.Header))
@@ -60,7 +82,10 @@ func WriteErr(w http.ResponseWriter, r *http.Request, err error, ecode int) {
 		allocated = true
 	}
 	out.Message = in.Message
+	var coded *ErrCoded
 	switch {
+	case errors.As(err, &coded):
+		out.Code = coded.Code
 	case cmn.IsErrBucketAlreadyExists(err):
 		out.Code = "BucketAlreadyExists"
 	case cmn.IsErrBckNotFound(err):
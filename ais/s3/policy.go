@@ -0,0 +1,214 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Bucket policy (GET|PUT|DELETE /s3/<bucket-name>?policy) maps onto the bucket's access
+// attributes (cmn.Bprops.Access), and back:
+// - supported are anonymous ("*") principals, this bucket's resources, and the actions below;
+//   per-user permissions are managed by AuthN (see docs/authn.md)
+// - the policy governs only the access bits that the S3 actions map onto; the rest
+//   (e.g., apc.AcePromote) remain unchanged
+// - Allow statements, if any, grant the listed actions and revoke all other (mapped) ones;
+//   no Allow statements means "allow all"; Deny statements revoke the listed actions
+// - conditions, NotAction, NotPrincipal, and NotResource are not supported
+
+const (
+	MaxPolicySize = 20 * cos.KiB // (same as S3)
+
+	policyVersion = "2012-10-17"
+	policyAllow   = "Allow"
+	policyDeny    = "Deny"
+	arnPrefix     = "arn:aws:s3:::"
+)
+
+type (
+	BucketPolicy struct {
+		Version   string            `json:"Version,omitempty"`
+		ID        string            `json:"Id,omitempty"`
+		Statement []PolicyStatement `json:"Statement"`
+	}
+	PolicyStatement struct {
+		Sid          string     `json:"Sid,omitempty"`
+		Effect       string     `json:"Effect"`
+		Principal    any        `json:"Principal"`
+		Action       policyList `json:"Action"`
+		Resource     policyList `json:"Resource"`
+		Condition    any        `json:"Condition,omitempty"`
+		NotPrincipal any        `json:"NotPrincipal,omitempty"`
+		NotAction    any        `json:"NotAction,omitempty"`
+		NotResource  any        `json:"NotResource,omitempty"`
+	}
+	// string or list of strings
+	policyList []string
+)
+
+// S3 action => AIS access
+var policyActions = [...]struct {
+	action string
+	ace    apc.AccessAttrs
+}{
+	{"s3:GetObject", apc.AceGET | apc.AceObjHEAD},
+	{"s3:PutObject", apc.AcePUT | apc.AceAPPEND},
+	{"s3:DeleteObject", apc.AceObjDELETE},
+	{"s3:ListBucket", apc.AceObjLIST | apc.AceBckHEAD},
+	{"s3:DeleteBucket", apc.AceDestroyBucket},
+	{"s3:PutBucketVersioning", apc.AcePATCH},
+	{"s3:PutBucketCORS", apc.AcePATCH},
+	{"s3:PutBucketPolicy", apc.AceBckSetACL},
+	{"s3:DeleteBucketPolicy", apc.AceBckSetACL},
+}
+
+// all access bits that the policy governs
+var policyMask = func() (mask apc.AccessAttrs) {
+	for _, a := range policyActions {
+		mask |= a.ace
+	}
+	return mask
+}()
+
+func (l *policyList) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := jsoniter.Unmarshal(b, &s); err == nil {
+		*l = policyList{s}
+		return nil
+	}
+	var list []string
+	if err := jsoniter.Unmarshal(b, &list); err != nil {
+		return errors.New("expecting string or list of strings")
+	}
+	*l = list
+	return nil
+}
+
+func NewBucketPolicy(bucket string, access apc.AccessAttrs) *BucketPolicy {
+	stmt := PolicyStatement{
+		Effect:    policyAllow,
+		Principal: "*",
+		Resource:  policyList{arnPrefix + bucket, arnPrefix + bucket + "/*"},
+	}
+	for _, a := range policyActions {
+		if access.Has(a.ace) {
+			stmt.Action = append(stmt.Action, a.action)
+		}
+	}
+	if len(stmt.Action) == 0 {
+		stmt.Effect, stmt.Action = policyDeny, policyList{"s3:*"}
+	}
+	return &BucketPolicy{Version: policyVersion, Statement: []PolicyStatement{stmt}}
+}
+
+// whether a given bucket access has no policy-governed restrictions
+func NoPolicy(access apc.AccessAttrs) bool { return access.Has(policyMask) }
+
+// clear all policy-governed restrictions
+func DelPolicy(access apc.AccessAttrs) apc.AccessAttrs { return access | policyMask }
+
+// apply the policy to the current bucket access
+func (bp *BucketPolicy) ToAccess(bucket string, access apc.AccessAttrs) (apc.AccessAttrs, error) {
+	if len(bp.Statement) == 0 {
+		return 0, errors.New("missing policy statement")
+	}
+	var (
+		allow, deny apc.AccessAttrs
+		hasAllow    bool
+	)
+	for i := range bp.Statement {
+		stmt := &bp.Statement[i]
+		ace, err := stmt.validate(bucket)
+		if err != nil {
+			return 0, fmt.Errorf("statement %q: %v", stmt.name(i), err)
+		}
+		if stmt.Effect == policyAllow {
+			allow |= ace
+			hasAllow = true
+		} else {
+			deny |= ace
+		}
+	}
+	if !hasAllow {
+		allow = policyMask
+	}
+	return access&^policyMask | allow&^deny, nil
+}
+
+func (stmt *PolicyStatement) name(i int) string {
+	if stmt.Sid != "" {
+		return stmt.Sid
+	}
+	return fmt.Sprintf("#%d", i+1)
+}
+
+func (stmt *PolicyStatement) validate(bucket string) (ace apc.AccessAttrs, err error) {
+	if stmt.Effect != policyAllow && stmt.Effect != policyDeny {
+		return 0, fmt.Errorf("invalid effect %q (expecting %q or %q)", stmt.Effect, policyAllow, policyDeny)
+	}
+	if stmt.Condition != nil || stmt.NotPrincipal != nil || stmt.NotAction != nil || stmt.NotResource != nil {
+		return 0, errors.New("conditions, NotPrincipal, NotAction, and NotResource are not supported")
+	}
+	if !anyPrincipal(stmt.Principal) {
+		return 0, fmt.Errorf("unsupported principal %v (expecting \"*\")", stmt.Principal)
+	}
+	if len(stmt.Resource) == 0 {
+		return 0, errors.New("missing resource")
+	}
+	for _, res := range stmt.Resource {
+		if res != "*" && res != arnPrefix+bucket && res != arnPrefix+bucket+"/*" {
+			return 0, fmt.Errorf("unsupported resource %q (expecting %q or %q)", res, arnPrefix+bucket, arnPrefix+bucket+"/*")
+		}
+	}
+	if len(stmt.Action) == 0 {
+		return 0, errors.New("missing action")
+	}
+	for _, action := range stmt.Action {
+		a := actionToAccess(action)
+		if a == 0 {
+			return 0, fmt.Errorf("unsupported action %q", action)
+		}
+		ace |= a
+	}
+	return ace, nil
+}
+
+// "*" or {"AWS": "*"} or {"AWS": ["*"]}
+func anyPrincipal(principal any) bool {
+	switch v := principal.(type) {
+	case string:
+		return v == "*"
+	case map[string]any:
+		if len(v) != 1 {
+			return false
+		}
+		switch aws := v["AWS"].(type) {
+		case string:
+			return aws == "*"
+		case []any:
+			return len(aws) == 1 && aws[0] == "*"
+		}
+	}
+	return false
+}
+
+// case-insensitive, with trailing wildcard (e.g., "s3:Get*"); zero when not supported
+func actionToAccess(action string) (ace apc.AccessAttrs) {
+	action = strings.ToLower(action)
+	prefix, wildcard := strings.CutSuffix(action, "*")
+	for _, a := range policyActions {
+		name := strings.ToLower(a.action)
+		if name == action || (wildcard && strings.HasPrefix(name, prefix)) {
+			ace |= a.ace
+		}
+	}
+	return ace
+}
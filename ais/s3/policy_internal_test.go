@@ -0,0 +1,98 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	jsoniter "github.com/json-iterator/go"
+)
+
+func TestBucketPolicy(t *testing.T) {
+	tests := []struct {
+		policy string
+		access apc.AccessAttrs // expected (from apc.AccessAll)
+		fail   bool
+	}{
+		{
+			policy: `{"Statement": [{"Effect": "Allow", "Principal": "*", "Action": ["s3:GetObject", "s3:ListBucket"],
+				"Resource": ["arn:aws:s3:::abc", "arn:aws:s3:::abc/*"]}]}`,
+			access: apc.AccessAll&^policyMask | apc.AceGET | apc.AceObjHEAD | apc.AceObjLIST | apc.AceBckHEAD,
+		},
+		{
+			policy: `{"Statement": [{"Effect": "Deny", "Principal": {"AWS": "*"}, "Action": "s3:Delete*", "Resource": "*"}]}`,
+			access: apc.AccessAll &^ (apc.AceObjDELETE | apc.AceDestroyBucket | apc.AceBckSetACL), // (incl. s3:DeleteBucketPolicy)
+		},
+		{
+			policy: `{"Statement": [{"Effect": "Allow", "Principal": "*", "Action": "s3:*", "Resource": "arn:aws:s3:::abc/*"},
+				{"Effect": "Deny", "Principal": "*", "Action": "s3:PutObject", "Resource": "arn:aws:s3:::abc/*"}]}`,
+			access: apc.AccessAll &^ (apc.AcePUT | apc.AceAPPEND),
+		},
+		{policy: `{"Statement": [{"Effect": "Allow", "Principal": {"AWS": "arn:aws:iam::1:root"}, "Action": "s3:GetObject", "Resource": "*"}]}`, fail: true},
+		{policy: `{"Statement": [{"Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::xyz/*"}]}`, fail: true},
+		{policy: `{"Statement": [{"Effect": "Allow", "Principal": "*", "Action": "s3:GetObjectTagging", "Resource": "*"}]}`, fail: true},
+		{policy: `{"Statement": [{"Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "*",
+				"Condition": {"IpAddress": {"aws:SourceIp": "10.0.0.0/8"}}}]}`, fail: true},
+		{policy: `{"Statement": []}`, fail: true},
+	}
+	for i, test := range tests {
+		policy := &BucketPolicy{}
+		if err := jsoniter.Unmarshal([]byte(test.policy), policy); err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		access, err := policy.ToAccess("abc", apc.AccessAll)
+		if test.fail {
+			if err == nil {
+				t.Errorf("#%d: expected error", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if access != test.access {
+			t.Errorf("#%d: expected %s, got %s", i, test.access.Describe(true), access.Describe(true))
+		}
+		// and back
+		if NoPolicy(access) {
+			t.Fatalf("#%d: expected policy", i)
+		}
+		again, err := NewBucketPolicy("abc", access).ToAccess("abc", apc.AccessAll)
+		if err != nil || again != access {
+			t.Errorf("#%d: round trip: expected %s, got %s (%v)", i, access.Describe(true), again.Describe(true), err)
+		}
+		if DelPolicy(access) != apc.AccessAll {
+			t.Errorf("#%d: expected no restrictions upon deletion", i)
+		}
+	}
+}
+
+func TestCORS(t *testing.T) {
+	rules := cmn.CORSRules{
+		{AllowedOrigins: []string{"https://*.example.com"}, AllowedMethods: []string{"GET", "PUT"}, AllowedHeaders: []string{"x-amz-*", "Content-Type"}},
+		{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"GET"}, MaxAgeSeconds: 600},
+	}
+	tests := []struct {
+		origin, method, hdrs string
+		allowOrigin          string // empty: not permitted
+	}{
+		{"https://app.example.com", "PUT", "content-type, X-Amz-Date", "https://app.example.com"},
+		{"https://app.example.com", "GET", "", "https://app.example.com"},
+		{"https://app.example.com", "PUT", "authorization", ""},
+		{"https://other.org", "GET", "", "*"},
+		{"https://other.org", "DELETE", "", ""},
+		{"https://other.org", "GET", "x-amz-date", ""},
+	}
+	for _, test := range tests {
+		hdr := http.Header{}
+		ok := PreflightCORS(hdr, rules, test.origin, test.method, test.hdrs)
+		if ok != (test.allowOrigin != "") || hdr.Get(hdrACAllowOrigin) != test.allowOrigin {
+			t.Errorf("%s %s [%s]: expected %q, got %v %q", test.method, test.origin, test.hdrs, test.allowOrigin, ok, hdr.Get(hdrACAllowOrigin))
+		}
+	}
+}
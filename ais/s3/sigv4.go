@@ -0,0 +1,528 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"bufio"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// AWS Signature Version 4 (SigV4) request authentication, including streaming
+// (aws-chunked) uploads where each chunk is signed and chained to the previous one.
+// See:
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-query-string-auth.html
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-streaming.html
+
+const (
+	HeaderContentSHA256  = "X-Amz-Content-Sha256"
+	HeaderSecurityToken  = "X-Amz-Security-Token" //nolint:gosec // header name
+	HeaderDecodedLength  = "X-Amz-Decoded-Content-Length"
+	StreamingPayload     = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
+	UnsignedPayload      = "UNSIGNED-PAYLOAD"
+	contentEncodingChunk = "aws-chunked"
+
+	sigV4DateFormat = "20060102T150405Z"
+	sigV4Terminator = "aws4_request"
+	sigV4ChunkAlgo  = "AWS4-HMAC-SHA256-PAYLOAD"
+	sigV4MaxSkew    = 15 * time.Minute
+
+	// sha256 of an empty string
+	emptySHA256 = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+	maxChunkHdrLen = 4 * cos.KiB
+)
+
+var (
+	ErrSigV4Mismatch  = errors.New("the request signature we calculated does not match the signature you provided")
+	ErrSHA256Mismatch = NewErrCoded(ErrCodeSHA256Mismatch,
+		"the provided 'x-amz-content-sha256' header does not match what was computed")
+	errChunkFormat = errors.New("invalid aws-chunked payload")
+)
+
+// must be signed (in addition to any other headers)
+var (
+	sigV4Required     = []string{"host", "x-amz-content-sha256", "x-amz-date"}
+	sigV4RequiredPres = []string{"host"} // (presigned)
+)
+
+type (
+	// parsed SigV4 credentials and signature (header- or query-based)
+	SigV4 struct {
+		AccessKeyID   string
+		SecurityToken string // AuthN token (see also: cmd/authn/tok.S3SecretKey)
+		Signature     string
+		amzDate       string
+		date          string // yyyymmdd
+		region        string
+		service       string
+		signedHeaders []string
+		expires       time.Duration // presigned only
+		presigned     bool
+	}
+
+	// computes sha256 of the payload and compares it with x-amz-content-sha256
+	payloadReader struct {
+		body     io.ReadCloser
+		h        hash.Hash
+		expected []byte
+		err      error // io.EOF or ErrSHA256Mismatch (sticky)
+		remain   int64 // (when Content-Length is known; otherwise, -1)
+	}
+
+	// decodes (and, given the signing key, verifies) aws-chunked payload
+	chunkedReader struct {
+		body    io.ReadCloser
+		br      *bufio.Reader
+		sig     *SigV4
+		key     []byte
+		prevSig string
+		h       hash.Hash
+		chunk   string // current chunk's signature
+		remain  int64  // bytes remaining in the current chunk
+		done    bool
+	}
+)
+
+// ParseSigV4 returns (nil, nil) when the request is not SigV4 signed.
+func ParseSigV4(r *http.Request) (*SigV4, error) {
+	q := r.URL.Query()
+	if q.Get(HeaderAlgorithm) == signatureV4 {
+		return parseQuerySigV4(q)
+	}
+	auth := r.Header.Get(apc.HdrAuthorization)
+	if !strings.HasPrefix(auth, signatureV4+" ") {
+		return nil, nil
+	}
+	s := &SigV4{SecurityToken: r.Header.Get(HeaderSecurityToken), amzDate: r.Header.Get(HeaderDate)}
+	for _, kv := range strings.Split(strings.TrimPrefix(auth, signatureV4+" "), ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(kv), "=")
+		if !ok {
+			return nil, fmt.Errorf("malformed SigV4 authorization header: %q", kv)
+		}
+		switch k {
+		case "Credential":
+			if err := s.parseCreds(v); err != nil {
+				return nil, err
+			}
+		case "SignedHeaders":
+			s.signedHeaders = strings.Split(v, ";")
+		case "Signature":
+			s.Signature = v
+		}
+	}
+	return s, s.validate()
+}
+
+func parseQuerySigV4(q url.Values) (*SigV4, error) {
+	s := &SigV4{
+		SecurityToken: q.Get(HeaderSecurityToken),
+		Signature:     q.Get(HeaderSignature),
+		amzDate:       q.Get(HeaderDate),
+		signedHeaders: strings.Split(q.Get(HeaderSignedHeaders), ";"),
+		presigned:     true,
+	}
+	if err := s.parseCreds(q.Get(HeaderCredentials)); err != nil {
+		return nil, err
+	}
+	secs, err := strconv.ParseInt(q.Get(HeaderExpires), 10, 64)
+	if err != nil || secs <= 0 {
+		return nil, fmt.Errorf("invalid %s: %q", HeaderExpires, q.Get(HeaderExpires))
+	}
+	s.expires = time.Duration(secs) * time.Second
+	return s, s.validate()
+}
+
+// <access-key-id>/<yyyymmdd>/<region>/<service>/aws4_request
+func (s *SigV4) parseCreds(creds string) error {
+	parts := strings.Split(creds, "/")
+	if len(parts) != 5 || parts[4] != sigV4Terminator {
+		return fmt.Errorf("malformed SigV4 credential %q", creds)
+	}
+	s.AccessKeyID, s.date, s.region, s.service = parts[0], parts[1], parts[2], parts[3]
+	return nil
+}
+
+func (s *SigV4) validate() error {
+	switch {
+	case s.AccessKeyID == "":
+		return errors.New("SigV4: missing access key ID")
+	case s.Signature == "":
+		return errors.New("SigV4: missing signature")
+	case len(s.signedHeaders) == 0 || s.signedHeaders[0] == "":
+		return errors.New("SigV4: missing signed headers")
+	case s.amzDate == "" || !strings.HasPrefix(s.amzDate, s.date):
+		return fmt.Errorf("SigV4: invalid or missing %s (%q, credential date %q)", HeaderDate, s.amzDate, s.date)
+	}
+	required := sigV4Required
+	if s.presigned {
+		required = sigV4RequiredPres
+	}
+	for _, name := range required {
+		if !cos.StringInSlice(name, s.signedHeaders) {
+			return fmt.Errorf("SigV4: %q must be signed (signed headers: %s)", name, strings.Join(s.signedHeaders, ";"))
+		}
+	}
+	return nil
+}
+
+func (s *SigV4) scope() string {
+	return s.date + "/" + s.region + "/" + s.service + "/" + sigV4Terminator
+}
+
+// SigningKey derives SigV4 signing key from the secret access key.
+func (s *SigV4) SigningKey(secretKey string) []byte {
+	k := hmacSHA256([]byte("AWS4"+secretKey), s.date)
+	k = hmacSHA256(k, s.region)
+	k = hmacSHA256(k, s.service)
+	return hmacSHA256(k, sigV4Terminator)
+}
+
+// Verify checks request time and signature given the secret access key;
+// the payload gets verified as it is being read: against the (signed)
+// x-amz-content-sha256 value (see VerifyPayload) or, when streaming,
+// chunk by chunk (see DecodeChunked).
+func (s *SigV4) Verify(r *http.Request, secretKey string) error {
+	tm, err := time.Parse(sigV4DateFormat, s.amzDate)
+	if err != nil {
+		return fmt.Errorf("SigV4: invalid %s %q: %v", HeaderDate, s.amzDate, err)
+	}
+	now := time.Now()
+	if s.presigned {
+		if now.After(tm.Add(s.expires)) {
+			return fmt.Errorf("SigV4: request has expired (signed at %s, expires in %v)", s.amzDate, s.expires)
+		}
+	} else if d := now.Sub(tm); d > sigV4MaxSkew || d < -sigV4MaxSkew {
+		return fmt.Errorf("SigV4: the difference between the request time (%s) and the current time is too large", s.amzDate)
+	}
+
+	sts := s.stringToSign(r)
+	sig := hex.EncodeToString(hmacSHA256(s.SigningKey(secretKey), sts))
+	if !hmac.Equal([]byte(sig), []byte(s.Signature)) {
+		return ErrSigV4Mismatch
+	}
+	return VerifyPayload(r)
+}
+
+// VerifyPayload replaces the request body with a reader that computes its sha256 and fails
+// the (last) read upon mismatch with x-amz-content-sha256; no-op for unsigned and streaming payloads
+func VerifyPayload(r *http.Request) error {
+	v := r.Header.Get(HeaderContentSHA256)
+	switch v {
+	case "", UnsignedPayload, StreamingPayload:
+		return nil
+	}
+	expected, err := hex.DecodeString(v)
+	if err != nil || len(expected) != sha256.Size {
+		return fmt.Errorf("invalid %s value %q", HeaderContentSHA256, v)
+	}
+	if r.Body == nil || r.Body == http.NoBody || r.ContentLength == 0 {
+		if v != emptySHA256 {
+			return ErrSHA256Mismatch
+		}
+		return nil
+	}
+	r.Body = &payloadReader{body: r.Body, h: sha256.New(), expected: expected, remain: r.ContentLength}
+	return nil
+}
+
+func (s *SigV4) stringToSign(r *http.Request) string {
+	creq := s.canonicalRequest(r)
+	sum := sha256.Sum256(cos.UnsafeB(creq))
+	return signatureV4 + "\n" + s.amzDate + "\n" + s.scope() + "\n" + hex.EncodeToString(sum[:])
+}
+
+// using the original (as received) request URI - AIS may rewrite r.URL
+// (see, e.g., feat.S3APIviaRoot and cos.ReparseQuery)
+func (s *SigV4) canonicalRequest(r *http.Request) string {
+	var (
+		sb           strings.Builder
+		rawPath, raw = r.RequestURI, ""
+	)
+	if rawPath == "" {
+		rawPath = r.URL.RequestURI()
+	}
+	if i := strings.IndexByte(rawPath, '?'); i >= 0 {
+		rawPath, raw = rawPath[:i], rawPath[i+1:]
+	}
+	if u, err := url.Parse(rawPath); err == nil && u.IsAbs() {
+		rawPath = u.EscapedPath() // absolute-form request target
+	}
+	path, err := url.PathUnescape(rawPath)
+	if err != nil {
+		path = r.URL.Path
+	}
+	if path == "" {
+		path = "/"
+	}
+
+	sb.WriteString(r.Method)
+	sb.WriteByte('\n')
+	sb.WriteString(uriEncode(path, false /*encode slash*/))
+	sb.WriteByte('\n')
+	sb.WriteString(s.canonicalQuery(raw))
+	sb.WriteByte('\n')
+	for _, name := range s.signedHeaders {
+		sb.WriteString(name)
+		sb.WriteByte(':')
+		sb.WriteString(headerValue(r, name))
+		sb.WriteByte('\n')
+	}
+	sb.WriteByte('\n')
+	sb.WriteString(strings.Join(s.signedHeaders, ";"))
+	sb.WriteByte('\n')
+	sb.WriteString(s.payloadHash(r))
+	return sb.String()
+}
+
+func (s *SigV4) canonicalQuery(raw string) string {
+	q, _ := url.ParseQuery(raw)
+	if s.presigned {
+		q.Del(HeaderSignature)
+	}
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		vals := q[k]
+		sort.Strings(vals)
+		for _, v := range vals {
+			parts = append(parts, uriEncode(k, true)+"="+uriEncode(v, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+func (s *SigV4) payloadHash(r *http.Request) string {
+	if v := r.Header.Get(HeaderContentSHA256); v != "" {
+		return v
+	}
+	if s.presigned {
+		return UnsignedPayload
+	}
+	return emptySHA256
+}
+
+func headerValue(r *http.Request, name string) string {
+	if name == "host" {
+		return r.Host
+	}
+	vals := r.Header.Values(name)
+	if name == "content-length" && len(vals) == 0 && r.ContentLength >= 0 {
+		return strconv.FormatInt(r.ContentLength, 10)
+	}
+	trimmed := make([]string, len(vals))
+	for i, v := range vals {
+		trimmed[i] = strings.Join(strings.Fields(v), " ")
+	}
+	return strings.Join(trimmed, ",")
+}
+
+// AWS URI-encoding: everything except unreserved characters (and, optionally, '/')
+func uriEncode(s string, encodeSlash bool) string {
+	var sb strings.Builder
+	sb.Grow(len(s) + 8)
+	for i := range len(s) {
+		c := s[i]
+		switch {
+		case (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9'),
+			c == '-', c == '_', c == '.', c == '~':
+			sb.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			sb.WriteByte(c)
+		default:
+			fmt.Fprintf(&sb, "%%%02X", c)
+		}
+	}
+	return sb.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(cos.UnsafeB(data))
+	return mac.Sum(nil)
+}
+
+///////////////////
+// payloadReader //
+///////////////////
+
+func (pr *payloadReader) Read(b []byte) (n int, err error) {
+	if pr.err != nil {
+		return 0, pr.err
+	}
+	if pr.remain >= 0 && int64(len(b)) > pr.remain {
+		b = b[:pr.remain]
+	}
+	n, err = pr.body.Read(b)
+	pr.h.Write(b[:n])
+	if pr.remain >= 0 {
+		pr.remain -= int64(n)
+		if pr.remain == 0 {
+			err = io.EOF
+		} else if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+	}
+	if err == io.EOF {
+		if !hmac.Equal(pr.h.Sum(nil), pr.expected) {
+			err = ErrSHA256Mismatch
+		}
+		pr.err = err
+	}
+	return n, err
+}
+
+func (pr *payloadReader) Close() error { return pr.body.Close() }
+
+///////////////////
+// chunkedReader //
+///////////////////
+
+func IsStreamingPayload(hdr http.Header) bool {
+	return hdr.Get(HeaderContentSHA256) == StreamingPayload
+}
+
+// DecodeChunked replaces aws-chunked request body with the decoded payload;
+// given non-nil signing key, the reader also verifies each chunk's signature -
+// and fails the read upon mismatch.
+func DecodeChunked(r *http.Request, sig *SigV4, key []byte) error {
+	size, err := strconv.ParseInt(r.Header.Get(HeaderDecodedLength), 10, 64)
+	if err != nil || size < 0 {
+		return fmt.Errorf("invalid or missing %s header", HeaderDecodedLength)
+	}
+	cr := &chunkedReader{body: r.Body, br: bufio.NewReader(r.Body), sig: sig, key: key, h: sha256.New()}
+	if sig != nil {
+		cr.prevSig = sig.Signature
+	}
+	r.Body = cr
+	r.ContentLength = size
+	r.Header.Set(cos.HdrContentLength, strconv.FormatInt(size, 10))
+	r.Header.Del(HeaderDecodedLength)
+
+	if enc := r.Header.Get(cos.HdrContentEncoding); enc != "" {
+		parts := strings.Split(enc, ",")
+		encs := parts[:0]
+		for _, e := range parts {
+			if e = strings.TrimSpace(e); e != contentEncodingChunk && e != "" {
+				encs = append(encs, e)
+			}
+		}
+		if len(encs) == 0 {
+			r.Header.Del(cos.HdrContentEncoding)
+		} else {
+			r.Header.Set(cos.HdrContentEncoding, strings.Join(encs, ","))
+		}
+	}
+	return nil
+}
+
+func (cr *chunkedReader) Read(b []byte) (n int, err error) {
+	for cr.remain == 0 {
+		if cr.done {
+			return 0, io.EOF
+		}
+		if err = cr.next(); err != nil {
+			return 0, err
+		}
+	}
+	if int64(len(b)) > cr.remain {
+		b = b[:cr.remain]
+	}
+	n, err = cr.br.Read(b)
+	cr.h.Write(b[:n])
+	cr.remain -= int64(n)
+	switch {
+	case err == io.EOF:
+		err = io.ErrUnexpectedEOF
+	case err == nil && cr.remain == 0:
+		// end of chunk: CRLF and signature
+		if err = cr.crlf(); err == nil {
+			err = cr.verify()
+		}
+	}
+	return n, err
+}
+
+func (cr *chunkedReader) Close() error { return cr.body.Close() }
+
+// <hex-size>;chunk-signature=<signature>\r\n
+func (cr *chunkedReader) next() error {
+	line, err := cr.br.ReadSlice('\n')
+	if err != nil {
+		if err == io.EOF || err == bufio.ErrBufferFull {
+			err = errChunkFormat
+		}
+		return err
+	}
+	if len(line) > maxChunkHdrLen {
+		return errChunkFormat
+	}
+	hdr := strings.TrimRight(string(line), "\r\n")
+	ssize, ext, _ := strings.Cut(hdr, ";")
+	size, err := strconv.ParseInt(ssize, 16, 64)
+	if err != nil || size < 0 {
+		return errChunkFormat
+	}
+	if cr.key != nil {
+		k, v, ok := strings.Cut(ext, "=")
+		if !ok || k != "chunk-signature" {
+			return errChunkFormat
+		}
+		cr.chunk = v
+	}
+	cr.h.Reset()
+	cr.remain = size
+	if size > 0 {
+		return nil
+	}
+	// final (zero-size) chunk
+	cr.done = true
+	if err := cr.verify(); err != nil {
+		return err
+	}
+	_ = cr.crlf() // (optional trailing CRLF)
+	return nil
+}
+
+func (cr *chunkedReader) crlf() error {
+	var b [2]byte
+	if _, err := io.ReadFull(cr.br, b[:]); err != nil || b[0] != '\r' || b[1] != '\n' {
+		return errChunkFormat
+	}
+	return nil
+}
+
+func (cr *chunkedReader) verify() error {
+	if cr.key == nil {
+		return nil
+	}
+	sts := sigV4ChunkAlgo + "\n" + cr.sig.amzDate + "\n" + cr.sig.scope() + "\n" + cr.prevSig + "\n" +
+		emptySHA256 + "\n" + hex.EncodeToString(cr.h.Sum(nil))
+	sig := hex.EncodeToString(hmacSHA256(cr.key, sts))
+	if !hmac.Equal([]byte(sig), []byte(cr.chunk)) {
+		return ErrSigV4Mismatch
+	}
+	cr.prevSig = cr.chunk
+	return nil
+}
@@ -18,11 +18,15 @@ import (
 )
 
 // s3Redirect performs reverse proxy call or HTTP-redirects to a designated node
-// in a cluster based on feature flag. See also: docs/s3compat.md
+// in a cluster based on feature flag (or proxy.stream_data). See also: docs/s3compat.md
 func (p *proxy) s3Redirect(w http.ResponseWriter, r *http.Request, si *meta.Snode, redirectURL, bucket string) {
-	if cmn.GCO.Get().Features.IsSet(feat.S3ReverseProxy) {
+	config := cmn.GCO.Get()
+	switch {
+	case config.Proxy.StreamData:
+		p.stream(w, r, si, redirectURL)
+	case config.Features.IsSet(feat.S3ReverseProxy):
 		p.reverseNodeRequest(w, r, si)
-	} else {
+	default:
 		h := w.Header()
 		h.Set(cos.HdrLocation, redirectURL)
 		h.Set(cos.HdrContentType, "text/xml; charset=utf-8")
@@ -54,6 +54,9 @@ const dbName = "ais.db"
 
 const clusterClockDrift = 5 * time.Millisecond // is expected to be bounded by
 
+// max number of concurrent background warm-GET validations (versioning.validate_warm_get_async)
+const maxAsyncWarm = 256
+
 type (
 	regstate struct {
 		mu       sync.Mutex  // serialize metasync Rx, shutdown, transition to standby; enable/disable backend
@@ -71,6 +74,18 @@ type (
 		res          *res.Res
 		transactions transactions
 		regstate     regstate
+		ra           readahead
+		apnds        apndSessions
+		admit        admission
+		mmc          mmcache
+		taix         tarIndexes
+		ttl          ttlExp
+		trig         triggers
+		chfeed       chfeed
+		alog         accessLogs
+		prio         prioGate
+		nsq          tnsquota
+		frz          tfreezer
 	}
 )
 
@@ -81,6 +96,12 @@ type redial struct {
 	inUse     string
 }
 
+// in-progress async warm-GET validations (by uname)
+var (
+	asyncWarm  sync.Map
+	asyncWarmN atomic.Int64
+)
+
 // interface guard
 var (
 	_ cos.Runner  = (*target)(nil)
@@ -98,6 +119,8 @@ func (*target) interruptedRestarted() (interrupted, restarted bool) {
 	return
 }
 
+func (*target) dataLoss() bool { return fs.MarkerExists(fname.DataLossMarker) }
+
 //
 // target
 //
@@ -204,6 +227,9 @@ func (t *target) init(config *cmn.Config) {
 		RandomTID:     generated,
 	}
 	newVol := volume.Init(t, config, vini)
+	if newVol && !generated {
+		markDataLoss(t.si)
+	}
 	fs.ComputeDiskSize()
 
 	t.initHostIP(config)
@@ -231,6 +257,33 @@ func (t *target) init(config *cmn.Config) {
 	fs.Clblk()
 }
 
+// Same (persistent, non-generated) target ID but no VMD on any of the configured
+// mountpaths: most likely, a reimaged node or wiped disks. The marker gets reported
+// upon joining; if the primary confirms that this target is a known member it will
+// treat the join as a restart, and the resulting global rebalance will restore
+// (EC slices, replicas) the content this target used to own.
+// The marker is removed when the rebalance completes (see reb.fini) or, at startup,
+// when there's nothing to restore (see gojoin).
+func markDataLoss(si *meta.Snode) {
+	nlog.Warningln(si.String()+":", "known ID with new (empty) volume - suspecting data loss")
+	fatalErr, writeErr := fs.PersistMarker(fname.DataLossMarker)
+	if fatalErr != nil {
+		nlog.Errorln(si.String()+":", "failed to mark data loss:", fatalErr)
+	} else if writeErr != nil {
+		nlog.Warningln(si.String()+":", writeErr)
+	}
+}
+
+// no buckets - nothing to restore
+func unmarkDataLoss(si *meta.Snode, bmd *bucketMD) {
+	if !bmd.IsEmpty() {
+		return
+	}
+	if err := fs.RemoveMarker(fname.DataLossMarker); err == nil {
+		nlog.Infoln(si.String()+":", "empty", bmd.StringEx(), "- removed data-loss marker")
+	}
+}
+
 func (t *target) initHostIP(config *cmn.Config) {
 	hostIP := os.Getenv("AIS_HOST_IP")
 	if hostIP == "" {
@@ -371,6 +424,16 @@ func (t *target) Run() error {
 	}
 
 	t.transactions.init(t)
+	t.ra.init(t)
+	t.apnds.init(t)
+	t.admit.init()
+	t.mmc.init(t)
+	t.taix.init(t)
+	t.ttl.init(t)
+	t.trig.init(t, config)
+	t.chfeed.init()
+	t.frz.init(t)
+	t.alog.init(t)
 
 	t.reb = reb.New(config)
 	t.res = res.New()
@@ -381,15 +444,22 @@ func (t *target) Run() error {
 	ec.Init()
 	mirror.Init()
 
+	xreg.InitHistory(filepath.Join(config.ConfigDir, fname.XactHistory))
 	xreg.RegWithHK()
 
 	marked := xreg.GetResilverMarked()
-	if marked.Interrupted || daemon.resilver.required {
+	_, restarted := t.interruptedRestarted()
+	switch sv := newStartupVal(config, marked.Interrupted || daemon.resilver.required, restarted); {
+	case sv.lazy:
+		go t.golazy(sv, marked.Interrupted)
+	case sv.resilver:
 		go t.goresilver(marked.Interrupted)
 	}
 
 	dsort.Tinit(t.statsT, db, config)
 	dload.Init(t.statsT, db, &config.Client)
+	dload.SetTransform(dlTransform)
+	etl.Tinit(t.statsT)
 
 	err = t.htrun.run(config)
 
@@ -422,9 +492,47 @@ func (t *target) gojoin(config *cmn.Config) {
 		config := cmn.GCO.BeginUpdate()
 		fspathsSave(config)
 	}
+	if t.dataLoss() {
+		unmarkDataLoss(t.si, t.owner.bmd.get())
+	}
 	nlog.Infoln(t.String(), "is ready")
 }
 
+// startup validation: resilvering (when interrupted or required) and, upon unclean shutdown,
+// validation of objects' metadata (XactLVD); the latter only with feat.LazyStartupValidation,
+// in which case both are deferred until the cluster starts - one after another
+type startupVal struct {
+	resilver bool
+	lvd      bool
+	lazy     bool
+}
+
+func newStartupVal(config *cmn.Config, resilver, restarted bool) (sv startupVal) {
+	sv.resilver = resilver
+	if config.Features.IsSet(feat.LazyStartupValidation) {
+		sv.lvd = restarted
+		sv.lazy = sv.resilver || sv.lvd
+	}
+	return sv
+}
+
+func (t *target) golazy(sv startupVal, interrupted bool) {
+	for !t.ClusterStarted() {
+		if nlog.Stopping() {
+			return
+		}
+		time.Sleep(cmn.Rom.CplaneOperation())
+	}
+	if sv.resilver {
+		t.goresilver(interrupted)
+	}
+	if sv.lvd {
+		if err := t.runValidateLomMD(""); err != nil {
+			nlog.Errorln(t.String(), "failed to start metadata validation:", err)
+		}
+	}
+}
+
 func (t *target) goresilver(interrupted bool) {
 	if interrupted {
 		nlog.Infoln("Resuming resilver...")
@@ -448,6 +556,18 @@ func (t *target) runResilver(args res.Args, wg *sync.WaitGroup) {
 	t.res.RunResilver(args)
 }
 
+// see feat.LazyStartupValidation
+func (*target) runValidateLomMD(id string) error {
+	if id == "" {
+		id = cos.GenUUID()
+	}
+	rns := xreg.RenewValidateLomMD(id)
+	if rns.Err != nil && !cmn.IsErrXactUsePrev(rns.Err) {
+		return rns.Err
+	}
+	return nil
+}
+
 func (t *target) endStartupStandby() (err error) {
 	smap := t.owner.smap.get()
 	if err = smap.validate(); err != nil {
@@ -479,6 +599,7 @@ func (t *target) initRecvHandlers() {
 		{r: apc.Xactions, h: t.xactHandler, net: accessNetIntraControl},
 		{r: apc.EC, h: t.ecHandler, net: accessNetIntraData},
 		{r: apc.Vote, h: t.voteHandler, net: accessNetIntraControl},
+		{r: apc.Gossip, h: t.gossipHandler, net: accessNetIntraControl},
 		{r: apc.Txn, h: t.txnHandler, net: accessNetIntraControl},
 		{r: apc.ObjStream, h: transport.RxAnyStream, net: accessControlData},
 
@@ -589,13 +710,25 @@ func (t *target) bucketHandler(w http.ResponseWriter, r *http.Request) {
 		apireq := apiReqAlloc(1, apc.URLPathBuckets.L, false)
 		t.httpbckhead(w, r, apireq)
 		apiReqFree(apireq)
+	case http.MethodPut:
+		t.httpbckput(w, r)
 	default:
-		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodHead, http.MethodPost)
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut)
 	}
 }
 
 // verb /v1/objects
 func (t *target) objectHandler(w http.ResponseWriter, r *http.Request) {
+	if aw, ok := t.alog.wrap(w, r); ok {
+		started := time.Now()
+		t._objectHandler(aw, r)
+		t.alog.record(aw, r, started)
+		return
+	}
+	t._objectHandler(w, r)
+}
+
+func (t *target) _objectHandler(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
 	case http.MethodGet:
 		apireq := apiReqAlloc(2, apc.URLPathObjects.L, true /*dpq*/)
@@ -672,6 +805,11 @@ func (t *target) httpobjget(w http.ResponseWriter, r *http.Request, apireq *apiR
 			return
 		}
 	}
+	level, ok := t.prioEnter(w, r, apireq.dpq)
+	if !ok {
+		return
+	}
+	defer t.prio.exit(level)
 
 	lom := core.AllocLOM(apireq.items[1])
 	lom, err = t.getObject(w, r, apireq.dpq, apireq.bck, lom)
@@ -681,6 +819,21 @@ func (t *target) httpobjget(w http.ResponseWriter, r *http.Request, apireq *apiR
 	core.FreeLOM(lom)
 }
 
+// bucket's default ETL, if any (cmn.BckETLConf) - applies to user-facing GETs only and never
+// to intra-cluster reads: get-from-neighbor, read-through from clones, and other t2t GETs
+// (that must see the original content)
+func (t *target) dfltETL(hdr http.Header, dpq *dpq, bprops *cmn.Bprops) string {
+	if dpq.etlBypass || dpq.isGFN || hdr.Get(apc.HdrBlobDownload) != "" {
+		return ""
+	}
+	if callerID := hdr.Get(apc.HdrCallerID); callerID != "" {
+		if smap := t.owner.smap.get(); smap != nil && smap.GetTarget(callerID) != nil {
+			return ""
+		}
+	}
+	return bprops.ETL.Name
+}
+
 func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck *meta.Bck, lom *core.LOM) (*core.LOM, error) {
 	if err := lom.InitBck(bck.Bucket()); err != nil {
 		if cmn.IsErrRemoteBckNotFound(err) {
@@ -693,8 +846,11 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 	}
 
 	// two special flows
+	if dpq.etlName == "" {
+		dpq.etlName = t.dfltETL(r.Header, dpq, lom.Bprops())
+	}
 	if dpq.etlName != "" {
-		t.getETL(w, r, dpq.etlName, lom)
+		t.getETL(w, r, dpq, lom)
 		return lom, nil
 	}
 	if cos.IsParseBool(r.Header.Get(apc.HdrBlobDownload)) {
@@ -719,7 +875,10 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 	}
 
 	// GET: regular | archive | range
-	goi := allocGOI()
+	var (
+		goi      = allocGOI()
+		asyncVer bool
+	)
 	{
 		goi.atime = time.Now().UnixNano()
 		goi.ltime = mono.NanoTime()
@@ -735,7 +894,15 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 		goi.w = w
 		goi.ctx = context.Background()
 		goi.ranges = byteRanges{Range: r.Header.Get(cos.HdrRange), Size: 0}
-		goi.latestVer = _validateWarmGet(goi.lom, dpq.latestVer) // apc.QparamLatestVer || versioning.*_warm_get
+		goi.latestVer, asyncVer = _validateWarmGet(goi.lom, dpq.latestVer) // apc.QparamLatestVer || versioning.*_warm_get
+	}
+	if dpq.conv != "" {
+		if goi.ranges.Range != "" {
+			return lom, cmn.NewErrUnsupp("range-read with format conversion", dpq.conv)
+		}
+		if dpq.arch.mmode != "" {
+			return lom, cmn.NewErrUnsupp("convert multiple archived files", dpq._archstr())
+		}
 	}
 	if dpq.isArch() {
 		if goi.ranges.Range != "" {
@@ -758,6 +925,15 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 	}
 
 	// do
+	if dpq.ecRead {
+		// on behalf of the main target (see goi.ecDelegate)
+		if ecode, err := goi.ecReadDelegated(); err != nil && err != errSendingResp {
+			t.writeErr(w, r, err, ecode)
+		}
+		lom = goi.lom
+		freeGOI(goi)
+		return lom, nil
+	}
 	if ecode, err := goi.getObject(); err != nil {
 		t.statsT.IncErr(stats.ErrGetCount)
 		if goi.isIOErr {
@@ -772,20 +948,72 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 				t._erris(w, r, err, ecode, !goi.isIOErr /*silent*/)
 			}
 		}
+	} else if asyncVer && !goi.cold {
+		t.validateWarmAsync(goi.lom)
 	}
 	lom = goi.lom
 	freeGOI(goi)
 	return lom, nil
 }
 
-func _validateWarmGet(lom *core.LOM, latestVer bool /*apc.QparamLatestVer*/) bool {
+// versioning.validate_warm_get_async: check remote metadata in the background
+// and, if changed, cold-GET the latest version (for subsequent reads)
+func (t *target) validateWarmAsync(lom *core.LOM) {
+	uname := lom.Uname()
+	if asyncWarmN.Load() >= maxAsyncWarm {
+		return // skip (this time) rather than pile up
+	}
+	if _, loaded := asyncWarm.LoadOrStore(uname, struct{}{}); loaded {
+		return // already in progress
+	}
+	asyncWarmN.Inc()
+	go t._validateWarm(*lom.Bucket(), lom.ObjName, uname)
+}
+
+func (t *target) _validateWarm(bck cmn.Bck, objName, uname string) {
+	lom := core.AllocLOM(objName)
+	defer func() {
+		core.FreeLOM(lom)
+		asyncWarm.Delete(uname)
+		asyncWarmN.Dec()
+	}()
+	if err := lom.InitBck(&bck); err != nil {
+		return
+	}
+	lom.Lock(false)
+	if err := lom.Load(true /*cache it*/, true /*locked*/); err != nil {
+		lom.Unlock(false)
+		return
+	}
+	res := lom.CheckRemoteMD(true /*rlocked*/, false /*synchronize*/, nil /*origReq*/)
+	lom.Unlock(false)
+	if res.Err != nil {
+		if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+			nlog.Warningln(t.String(), "async warm-GET validation", lom.Cname(), res.Err, res.ErrCode)
+		}
+		return
+	}
+	if res.Eq {
+		return
+	}
+	if _, err := t.GetCold(context.Background(), lom, cmn.OwtGetTryLock); err != nil && err != cmn.ErrSkip {
+		nlog.Warningln(t.String(), "async warm-GET: failed to update", lom.Cname(), err)
+	}
+}
+
+// returns (synchronous, asynchronous) validation - at most one of the two
+func _validateWarmGet(lom *core.LOM, latestVer bool /*apc.QparamLatestVer*/) (bool, bool) {
 	switch {
 	case !lom.Bck().IsCloud() && !lom.Bck().IsRemoteAIS():
-		return false
+		return false, false
 	case !latestVer:
-		return lom.VersionConf().ValidateWarmGet || lom.VersionConf().Sync // bucket prop
+		vconf := lom.VersionConf() // bucket prop
+		if vconf.Sync {
+			return true, false
+		}
+		return vconf.ValidateWarmGet && !vconf.ValidateWarmGetAsync, vconf.ValidateWarmGet && vconf.ValidateWarmGetAsync
 	default:
-		return true
+		return true, false
 	}
 }
 
@@ -821,6 +1049,11 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 			return
 		}
 	}
+	level, ok := t.prioEnter(w, r, apireq.dpq)
+	if !ok {
+		return
+	}
+	defer t.prio.exit(level)
 
 	// init
 	if err := lom.InitBck(apireq.bck.Bucket()); err != nil {
@@ -833,6 +1066,13 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 			return
 		}
 	}
+	if !t2tput {
+		if err := t.frz.enter(lom.Bck()); err != nil {
+			t.writeErr(w, r, err, http.StatusConflict)
+			return
+		}
+		defer t.frz.exit(lom.Bck())
+	}
 
 	// load (maybe)
 	skipVC := lom.IsFeatureSet(feat.SkipVC) || apireq.dpq.skipVC
@@ -856,6 +1096,8 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 		lom.Lock(true)
 		ecode, err = t.putApndArch(r, lom, started, apireq.dpq)
 		lom.Unlock(true)
+	case r.Header.Get(cos.HdrContentRange) != "": // byte-range PUT
+		ecode, err = t.patchObject(w, r, lom, started)
 	case apireq.dpq.apnd.ty != "": // apc.QparamAppendType
 		a := &apndOI{
 			started: started,
@@ -872,13 +1114,25 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 		handle, ecode, err = a.do(r)
 		if err == nil && handle != "" {
 			w.Header().Set(apc.HdrAppendHandle, handle)
+			t.apnds.advertise(w.Header(), &config.Append)
 			return
 		}
 		t.statsT.IncErr(stats.ErrAppendCount)
 	default:
+		var expires int64
+		if expires, ecode, err = t.ttl.parse(lom, r, apireq.dpq); err != nil {
+			break
+		}
+		if !t2tput {
+			if ecode, err = checkPayload(r, lom.Bck(), lom.ObjName); err != nil {
+				break
+			}
+			r.Body = newPayloadReader(r.Body, &lom.Bprops().Payload, lom.Cname())
+		}
 		poi := allocPOI()
 		{
 			poi.atime = started
+			poi.expires = expires
 			if apireq.dpq.ptime != "" {
 				if d := ptLatency(poi.atime, apireq.dpq.ptime, r.Header.Get(apc.HdrCallerIsPrimary)); d > 0 {
 					t.statsT.Add(stats.PutRedirLatency, d)
@@ -893,6 +1147,15 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 		}
 		ecode, err = poi.do(w.Header(), r, apireq.dpq)
 		freePOI(poi)
+		if err == nil {
+			if expires != 0 {
+				t.ttl.add(expires)
+			}
+			if !t2tput {
+				t.trig.fire(lom, apc.TriggerPut)
+				t.chfeed.add(lom, apc.ChangePut, "")
+			}
+		}
 	}
 	if err != nil {
 		t.FSHC(err, lom.Mountpath(), "") // TODO -- FIXME: removed from the place where happened, fqn missing...
@@ -925,11 +1188,21 @@ func (t *target) httpobjdelete(w http.ResponseWriter, r *http.Request, apireq *a
 		core.FreeLOM(lom)
 		return
 	}
+	if err := t.frz.enter(lom.Bck()); err != nil {
+		t.writeErr(w, r, err, http.StatusConflict)
+		core.FreeLOM(lom)
+		return
+	}
+	defer t.frz.exit(lom.Bck())
 
 	ecode, err := t.DeleteObject(lom, evict)
 	if err == nil && ecode == 0 {
 		// EC cleanup if EC is enabled
 		ec.ECM.CleanupObject(lom)
+		if !evict {
+			t.trig.fire(lom, apc.TriggerDelete)
+			t.chfeed.add(lom, apc.ChangeDelete, "")
+		}
 	} else {
 		if ecode == http.StatusNotFound {
 			t.writeErrSilentf(w, r, http.StatusNotFound, "%s doesn't exist", lom.Cname())
@@ -956,6 +1229,11 @@ func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *api
 		t.writeErrf(w, r, "%s: %s-%s(obj) is expected to be redirected", t.si, r.Method, msg.Action)
 		return
 	}
+	if err := t.frz.enter(apireq.bck); err != nil {
+		t.writeErr(w, r, err, http.StatusConflict)
+		return
+	}
+	defer t.frz.exit(apireq.bck)
 	var lom *core.LOM
 	switch msg.Action {
 	case apc.ActRenameObject:
@@ -965,11 +1243,21 @@ func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *api
 		}
 		if err = t.objMv(lom, msg); err == nil {
 			t.statsT.Inc(stats.RenameCount)
+			t.chfeed.add(lom, apc.ChangeRename, msg.Name)
 			core.FreeLOM(lom)
 			lom = nil
 		} else {
 			t.statsT.IncErr(stats.ErrRenameCount)
 		}
+	case apc.ActLinkObj:
+		lom = core.AllocLOM(apireq.items[1])
+		if err = lom.InitBck(apireq.bck.Bucket()); err != nil {
+			break
+		}
+		if err = t.objLink(lom, msg, apireq.query); err == nil {
+			core.FreeLOM(lom)
+			lom = nil
+		}
 	case apc.ActBlobDl:
 		var (
 			xid     string
@@ -1046,6 +1334,9 @@ func (t *target) objHead(r *http.Request, whdr http.Header, query url.Values, bc
 	}
 	err = lom.Load(true /*cache it*/, false /*locked*/)
 	if err == nil {
+		if lom.IsTombstone() {
+			return http.StatusNotFound, cos.NewErrNotFound(t, lom.Cname())
+		}
 		if apc.IsFltNoProps(fltPresence) {
 			return
 		}
@@ -1064,6 +1355,10 @@ func (t *target) objHead(r *http.Request, whdr http.Header, query url.Values, bc
 	}
 
 	if !exists {
+		// copy-on-write clone: exists if the source has it (but is not present)
+		if src := lom.Bck().CloneOf(); src != nil && !apc.IsFltPresent(fltPresence) {
+			return t.headClone(lom, src, query, whdr)
+		}
 		if bck.IsAIS() || apc.IsFltPresent(fltPresence) {
 			err = cos.NewErrNotFound(t, lom.Cname())
 			return http.StatusNotFound, err
@@ -1103,6 +1398,9 @@ func (t *target) objHead(r *http.Request, whdr http.Header, query url.Values, bc
 		}
 	} else {
 		// cold HEAD
+		if lom.Bck().IsOffline() {
+			return http.StatusServiceUnavailable, cmn.NewErrBackendOffline("HEAD "+lom.ObjName, lom.Bucket())
+		}
 		var oa *cmn.ObjAttrs
 		oa, ecode, err = t.Backend(lom.Bck()).HeadObj(context.Background(), lom, r)
 		if err != nil {
@@ -1180,6 +1478,11 @@ func (t *target) httpobjpatch(w http.ResponseWriter, r *http.Request, apireq *ap
 		t.writeErr(w, r, err)
 		return
 	}
+	if err := t.frz.enter(lom.Bck()); err != nil {
+		t.writeErr(w, r, err, http.StatusConflict)
+		return
+	}
+	defer t.frz.exit(lom.Bck())
 	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
 		if cos.IsNotExist(err, 0) {
 			t.writeErr(w, r, err, http.StatusNotFound)
@@ -1331,9 +1634,16 @@ func (t *target) putApndArch(r *http.Request, lom *core.LOM, started int64, dpq
 
 func (t *target) DeleteObject(lom *core.LOM, evict bool) (code int, err error) {
 	var isback bool
-	lom.Lock(true)
-	code, err, isback = t.delobj(lom, evict)
-	lom.Unlock(true)
+	if !evict && lom.Bck().IsOffline() {
+		return http.StatusServiceUnavailable, cmn.NewErrBackendOffline("DELETE "+lom.ObjName, lom.Bucket())
+	}
+	if src := lom.Bck().CloneOf(); src != nil {
+		code, err = t.delClone(lom, src)
+	} else {
+		lom.Lock(true)
+		code, err, isback = t.delobj(lom, evict)
+		lom.Unlock(true)
+	}
 
 	// special corner-case retry (quote):
 	// - googleapi: "Error 503: We encountered an internal error. Please try again."
@@ -1382,6 +1692,9 @@ func (t *target) delobj(lom *core.LOM, evict bool) (int, error, bool) {
 	}
 	if delFromAIS {
 		size := lom.Lsize()
+		t.mmc.evict(lom.FQN)
+		t.taix.evict(lom.FQN)
+		snap := xs.SnapPre(lom, true /*deleting*/)
 		aisErr = lom.RemoveObj()
 		if aisErr != nil {
 			if !os.IsNotExist(aisErr) {
@@ -1393,12 +1706,15 @@ func (t *target) delobj(lom *core.LOM, evict bool) (int, error, bool) {
 				return 0, aisErr, false
 			}
 			debug.Assert(aisErr == nil) // expecting lom.RemoveObj() to return nil when IsNotExist
-		} else if evict {
-			debug.Assert(lom.Bck().IsRemote())
-			t.statsT.AddMany(
-				cos.NamedVal64{Name: stats.LruEvictCount, Value: 1},
-				cos.NamedVal64{Name: stats.LruEvictSize, Value: size},
-			)
+		} else {
+			snap.Commit()
+			if evict {
+				debug.Assert(lom.Bck().IsRemote())
+				t.statsT.AddMany(
+					cos.NamedVal64{Name: stats.LruEvictCount, Value: 1},
+					cos.NamedVal64{Name: stats.LruEvictSize, Value: size},
+				)
+			}
 		}
 	}
 	if backendErr != nil {
@@ -1439,8 +1755,14 @@ func (t *target) objMv(lom *core.LOM, msg *apc.ActMsg) (err error) {
 
 	// TODO: combine copy+delete under a single write lock
 	lom.Lock(true)
+	var snap *xs.SnapRec
+	if lom.Load(false /*cache it*/, true /*locked*/) == nil {
+		snap = xs.SnapPre(lom, true /*deleting*/)
+	}
 	if err := lom.RemoveObj(); err != nil {
 		nlog.Warningf("%s: failed to delete renamed object %s (new name %s): %v", t, lom, msg.Name, err)
+	} else {
+		snap.Commit()
 	}
 	lom.Unlock(true)
 	return nil
@@ -0,0 +1,86 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+func TestStartupVal(t *testing.T) {
+	tests := []struct {
+		name                string
+		lazy                bool
+		resilver, restarted bool
+		expected            startupVal
+	}{
+		{name: "eager/clean", expected: startupVal{}},
+		{name: "eager/restarted", restarted: true, expected: startupVal{}},
+		{name: "eager/resilver", resilver: true, expected: startupVal{resilver: true}},
+		{name: "eager/resilver-restarted", resilver: true, restarted: true, expected: startupVal{resilver: true}},
+
+		{name: "lazy/clean", lazy: true, expected: startupVal{}},
+		{name: "lazy/restarted", lazy: true, restarted: true, expected: startupVal{lvd: true, lazy: true}},
+		{name: "lazy/resilver", lazy: true, resilver: true, expected: startupVal{resilver: true, lazy: true}},
+		{
+			name: "lazy/resilver-restarted", lazy: true, resilver: true, restarted: true,
+			expected: startupVal{resilver: true, lvd: true, lazy: true},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := &cmn.Config{}
+			if test.lazy {
+				config.Features = feat.LazyStartupValidation
+			}
+			if sv := newStartupVal(config, test.resilver, test.restarted); sv != test.expected {
+				t.Fatalf("expected %+v, got %+v", test.expected, sv)
+			}
+		})
+	}
+}
+
+// (as reported upon joining - see cluMeta)
+func dlReported() bool { return t.dataLoss() }
+
+func TestDataLossMarker(t *testing.T) {
+	defer fs.RemoveMarker(fname.DataLossMarker)
+
+	si := newSnode("dl-target", apc.Target, meta.NetInfo{}, meta.NetInfo{}, meta.NetInfo{})
+	markDataLoss(si)
+	if !fs.MarkerExists(fname.DataLossMarker) {
+		t.Fatal("expected data-loss marker")
+	}
+	if !xreg.GetRebMarked().DataLoss {
+		t.Error("expected data loss to be marked")
+	}
+	if !dlReported() {
+		t.Error("expected the target to report data loss")
+	}
+
+	// buckets to restore: the marker stays
+	bmd := newBucketMD()
+	bmd.add(meta.NewBck("dl-bck", apc.AIS, cmn.NsGlobal), &cmn.Bprops{})
+	unmarkDataLoss(si, bmd)
+	if !fs.MarkerExists(fname.DataLossMarker) {
+		t.Fatal("expected data-loss marker to remain")
+	}
+
+	// nothing to restore
+	unmarkDataLoss(si, newBucketMD())
+	if fs.MarkerExists(fname.DataLossMarker) {
+		t.Fatal("expected data-loss marker to be removed")
+	}
+	if xreg.GetRebMarked().DataLoss || dlReported() {
+		t.Error("expected no data loss")
+	}
+}
@@ -2261,6 +2261,73 @@ func TestECBucketEncode(t *testing.T) {
 	//
 }
 
+// Changes (data, parity) of an erasure coded bucket: all existing objects get re-encoded
+func TestECReslice(t *testing.T) {
+	const (
+		dataCnt   = 1
+		parityCnt = 2
+	)
+	var (
+		proxyURL = tools.RandomProxyURL()
+		m        = ioContext{
+			t:        t,
+			num:      100,
+			proxyURL: proxyURL,
+		}
+	)
+
+	m.initAndSaveState(true /*cleanup*/)
+	baseParams := tools.BaseAPIParams(proxyURL)
+
+	if nt := m.smap.CountActiveTs(); nt < parityCnt+dataCnt+1 {
+		t.Skipf("%s: not enough targets (%d): (d=%d, p=%d) requires at least %d",
+			t.Name(), nt, dataCnt, parityCnt, parityCnt+dataCnt+1)
+	}
+
+	initMountpaths(t, proxyURL)
+	tools.CreateBucket(t, proxyURL, m.bck, nil, true /*cleanup*/)
+
+	m.puts()
+
+	setSlices := func(parity int) string {
+		xid, err := api.SetBucketProps(baseParams, m.bck, &cmn.BpropsToSet{
+			EC: &cmn.ECConfToSet{
+				Enabled:      apc.Ptr(true),
+				ObjSizeLimit: apc.Ptr[int64](1),
+				DataSlices:   apc.Ptr(dataCnt),
+				ParitySlices: apc.Ptr(parity),
+			},
+		})
+		tassert.CheckFatal(t, err)
+		return xid
+	}
+
+	tlog.Logf("Enabling EC (d=%d, p=1)\n", dataCnt)
+	xid := setSlices(1)
+	xargs := xact.ArgsMsg{ID: xid, Kind: apc.ActECEncode, Bck: m.bck, Timeout: tools.RebalanceTimeout}
+	_, err := api.WaitForXactionIC(baseParams, &xargs)
+	tassert.CheckFatal(t, err)
+
+	tlog.Logf("Re-slicing (d=%d, p=%d)\n", dataCnt, parityCnt)
+	xid = setSlices(parityCnt)
+	xargs = xact.ArgsMsg{ID: xid, Kind: apc.ActECReslice, Bck: m.bck, Timeout: tools.RebalanceTimeout}
+	_, err = api.WaitForXactionIC(baseParams, &xargs)
+	tassert.CheckFatal(t, err)
+
+	snaps, err := api.QueryXactionSnaps(baseParams, &xact.ArgsMsg{ID: xid})
+	tassert.CheckFatal(t, err)
+	for tid, tsnaps := range snaps {
+		for _, snap := range tsnaps {
+			tassert.Errorf(t, snap.Err == "", "%s: %s failed: %s", tid, snap.ID, snap.Err)
+		}
+	}
+	objs, _, _ := snaps.ObjCounts(xid)
+	tassert.Errorf(t, objs == int64(m.num), "expected %d re-encoded objects, got %d", m.num, objs)
+
+	m.gets(nil, false)
+	m.ensureNoGetErrors()
+}
+
 // Creates two buckets (with EC enabled and disabled), fill them with data,
 // and then runs two parallel rebalances
 func TestECAndRegularRebalance(t *testing.T) {
@@ -36,6 +36,8 @@ type prmTests struct {
 	deleteSrc    bool
 	overwriteDst bool
 	notFshare    bool
+	hardLink     bool
+	verify       bool
 }
 
 // flow: TestPromote (tests) => runProvider x (provider tests) => test.do(bck)
@@ -53,6 +55,8 @@ func TestPromote(t *testing.T) {
 		{num: 10000, singleTarget: false, recurs: true, deleteSrc: true, overwriteDst: false, notFshare: true},
 		{num: 10000, singleTarget: true, recurs: true, deleteSrc: false, overwriteDst: true, notFshare: true},
 		{num: 10, singleTarget: false, recurs: true, deleteSrc: false, overwriteDst: false, notFshare: true},
+		{num: 10000, singleTarget: false, recurs: true, deleteSrc: false, overwriteDst: false, hardLink: true},
+		{num: 10, singleTarget: true, recurs: false, deleteSrc: false, overwriteDst: true, verify: true},
 	}
 	// see also "filtering" below
 	if testing.Short() {
@@ -86,6 +90,12 @@ func TestPromote(t *testing.T) {
 		} else {
 			name += "/collaborate-on-fshare"
 		}
+		if test.hardLink {
+			name += "/hard-link"
+		}
+		if test.verify {
+			name += "/verify"
+		}
 		name = name[1:]
 		t.Run(name, func(t *testing.T) { runProviderTests(t, test.do) })
 	}
@@ -153,6 +163,8 @@ func (test *prmTests) do(t *testing.T, bck *meta.Bck) {
 		OverwriteDst:   test.overwriteDst,
 		DeleteSrc:      test.deleteSrc,
 		SrcIsNotFshare: test.notFshare,
+		HardLink:       test.hardLink,
+		Verify:         test.verify,
 	}
 	var target *meta.Snode
 	if test.singleTarget {
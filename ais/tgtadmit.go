@@ -0,0 +1,192 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/stats"
+
+	"github.com/OneOfOne/xxhash"
+)
+
+// Cold GET admission (cmn.AdmissionConf):
+// - remote objects larger than admission.max_object_size are not stored ("cached") in the cluster
+// - nor are objects accessed (on this target) fewer than admission.min_hits times within
+//   admission.window; access frequency is estimated with a per-bucket count-min sketch
+//   whose counters are halved every window (so that past popularity fades away)
+// - objects that do not pass are streamed from the remote backend directly to the client
+// - applies to plain (whole-object) GETs; range reads, reading archived files, and
+//   (already cached) objects with a changed remote version are always stored
+// - in memory: sketches do not survive restarts
+
+const (
+	admitDepth = 4       // hash functions (rows)
+	admitWidth = 1 << 14 // counters per row
+
+	dfltAdmitWindow = time.Hour
+	admitHkIval     = 10 * time.Minute
+	admitIdle       = 8 // windows: all counters are zero by then
+)
+
+type (
+	admission struct {
+		m  map[uint64]*admitSketch // by bucket ID
+		mu sync.Mutex
+	}
+	admitSketch struct {
+		rows   [admitDepth][admitWidth]uint8
+		aged   int64 // last halved (mono-time)
+		atime  int64 // last accessed (ditto)
+		window time.Duration
+		mu     sync.Mutex
+	}
+)
+
+func (a *admission) init() {
+	a.m = make(map[uint64]*admitSketch, 4)
+	hk.Reg("cold-get-admission"+hk.NameSuffix, a.housekeep, admitHkIval)
+}
+
+// returns true if the object is to be stored in the cluster
+func (a *admission) admit(lom *core.LOM, size int64) bool {
+	conf := &lom.Bprops().Admission
+	if conf.MaxObjSize > 0 && size > int64(conf.MaxObjSize) {
+		return false
+	}
+	if conf.MinHits <= 1 {
+		return true
+	}
+	window := conf.Window.D()
+	if window == 0 {
+		window = dfltAdmitWindow
+	}
+	return a.sketch(lom.Bprops().BID).add(lom.ObjName, window) >= conf.MinHits
+}
+
+func (a *admission) sketch(bid uint64) (sk *admitSketch) {
+	a.mu.Lock()
+	if sk = a.m[bid]; sk == nil {
+		sk = &admitSketch{aged: mono.NanoTime()}
+		a.m[bid] = sk
+	}
+	a.mu.Unlock()
+	return sk
+}
+
+// drop sketches of buckets that are no longer accessed (or no longer exist)
+func (a *admission) housekeep() time.Duration {
+	now := mono.NanoTime()
+	a.mu.Lock()
+	for bid, sk := range a.m {
+		sk.mu.Lock()
+		idle := sk.window > 0 && time.Duration(now-sk.atime) > admitIdle*sk.window
+		sk.mu.Unlock()
+		if idle {
+			delete(a.m, bid)
+		}
+	}
+	a.mu.Unlock()
+	return admitHkIval
+}
+
+/////////////////
+// admitSketch //
+/////////////////
+
+// count this access and return the estimated number of accesses (including this one)
+func (sk *admitSketch) add(objName string, window time.Duration) int {
+	var (
+		h      = xxhash.Checksum64S(cos.UnsafeB(objName), cos.MLCG32)
+		h1, h2 = uint32(h), uint32(h>>32) | 1
+		now    = mono.NanoTime()
+		est    = uint8(255)
+	)
+	sk.mu.Lock()
+	sk.age(now, window)
+	for i := range admitDepth {
+		j := (h1 + uint32(i)*h2) % admitWidth
+		if c := sk.rows[i][j]; c < 255 {
+			sk.rows[i][j] = c + 1
+		}
+		est = min(est, sk.rows[i][j])
+	}
+	sk.atime = now
+	sk.mu.Unlock()
+	return int(est)
+}
+
+// is under lock
+func (sk *admitSketch) age(now int64, window time.Duration) {
+	sk.window = window
+	n := time.Duration(now-sk.aged) / window
+	if n == 0 {
+		return
+	}
+	sk.aged = now
+	if n >= admitIdle {
+		sk.rows = [admitDepth][admitWidth]uint8{}
+		return
+	}
+	for i := range admitDepth {
+		for j := range admitWidth {
+			sk.rows[i][j] >>= uint(n)
+		}
+	}
+}
+
+//
+// cold GET bypass
+//
+
+// whether the (plain, whole-object) cold GET is subject to admission policy
+func (goi *getOI) admissible() bool {
+	if goi.verchanged || goi.ranges.Range != "" || goi.dpq.isArch() || goi.dpq.conv != "" {
+		return false
+	}
+	bck := goi.lom.Bck()
+	return bck.IsRemote() && bck.Props != nil && bck.Props.Admission.Enabled()
+}
+
+// stream remote object to the client without storing it (compare w/ coldStream)
+// is called under wlock (see _coldLock) - releases it upfront
+func (goi *getOI) coldBypass(res *core.GetReaderResult) error {
+	var (
+		t, lom = goi.t, goi.lom
+		whdr   = goi.w.Header()
+	)
+	lom.Unlock(true)
+	goi.unlocked = true
+
+	whdr.Set(cos.HdrContentType, cos.ContentBinary)
+	cmn.ToHeader(lom.ObjAttrs(), whdr, res.Size)
+	if goi.dpq.isS3 {
+		s3.SetEtag(whdr, lom)
+	}
+	buf, slab := t.gmm.AllocSize(min(res.Size, memsys.DefaultBuf2Size))
+	written, err := cos.CopyBuffer(goi.w, res.R, buf)
+	cos.Close(res.R)
+	slab.Free(buf)
+	if err != nil {
+		nlog.Warningln(ftcg+"(bypass)", lom.Cname(), err)
+		return errSendingResp // (header is already on the wire)
+	}
+	goi.rltime = mono.SinceNano(goi.rstarttime)
+	t.statsT.AddMany(
+		cos.NamedVal64{Name: stats.GetBypassCount, Value: 1},
+		cos.NamedVal64{Name: stats.GetBypassSize, Value: written},
+	)
+	goi.stats(written)
+	return nil
+}
@@ -0,0 +1,41 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/mono"
+)
+
+func TestAdmitSketch(t *testing.T) {
+	const window = time.Hour
+	sk := &admitSketch{aged: mono.NanoTime()}
+
+	for i := 1; i <= 5; i++ {
+		if n := sk.add("train/shard-000001.tar", window); n != i {
+			t.Fatalf("access %d: estimated %d", i, n)
+		}
+	}
+	// (count-min: never underestimates; with few keys, unlikely to overestimate)
+	for i := range 100 {
+		if n := sk.add(fmt.Sprintf("scan/obj-%06d", i), window); n != 1 {
+			t.Fatalf("scan/obj-%06d: first access estimated %d", i, n)
+		}
+	}
+
+	// halved once per elapsed window
+	sk.aged -= int64(window)
+	if n := sk.add("train/shard-000001.tar", window); n != 5/2+1 {
+		t.Fatalf("expected %d after aging, got %d", 5/2+1, n)
+	}
+	// reset when idle long enough
+	sk.aged -= int64(admitIdle * window)
+	if n := sk.add("train/shard-000001.tar", window); n != 1 {
+		t.Fatalf("expected 1 after reset, got %d", n)
+	}
+}
@@ -0,0 +1,315 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Bucket access logs (see cmn.AccessLogConf):
+// - for buckets with access logging enabled, the target records each client (ie., not intra-cluster)
+//   object GET, HEAD, PUT, and DELETE as a single JSON line (see alogRec)
+// - records are batched in memory, per source bucket, and get written as a single object
+//   "<prefix><UTC time>-<target ID>.jsonl" into the configured destination bucket
+//   once every (configurable) interval, or when the batch grows beyond alogMaxBatch
+// - failed writes are retried at the next flush; in-memory records are not persisted and
+//   will be lost upon restart - hence, best-effort (and not lossless) delivery
+
+const (
+	alogHkIval    = 10 * time.Second
+	alogMaxBatch  = 4 * cos.MiB
+	alogMaxRetain = 64 * cos.MiB // when the destination is unavailable
+	alogTimeFmt   = "2006-01-02T15-04-05.000"
+	wtagAlog      = "alog" // work file tag
+)
+
+type (
+	alogRec struct {
+		Time    int64  `json:"time,string"` // request start (unix nanoseconds)
+		Method  string `json:"method"`
+		Bck     string `json:"bucket"`
+		ObjName string `json:"obj"`
+		Remote  string `json:"remote"` // client address
+		Size    int64  `json:"size,string"`
+		Latency int64  `json:"latency,string"` // nanoseconds
+		Status  int    `json:"status"`
+	}
+	alogBatch struct {
+		bck     cmn.Bck
+		sb      bytes.Buffer
+		started int64
+	}
+	accessLogs struct {
+		t       *target
+		batches map[string]*alogBatch // by source bucket's uname
+		mu      sync.Mutex
+		bmdVer  atomic.Int64 // BMD version when `on` was last evaluated
+		on      atomic.Bool  // any bucket with access logging enabled
+		busy    atomic.Bool
+	}
+	// response writer that counts bytes and remembers the status
+	alogWriter struct {
+		http.ResponseWriter
+		size   int64
+		status int
+	}
+)
+
+// interface guard
+var _ io.ReaderFrom = (*alogWriter)(nil)
+
+func (al *accessLogs) init(t *target) {
+	al.t = t
+	al.batches = make(map[string]*alogBatch, 4)
+	hk.Reg("access-logs"+hk.NameSuffix, al.housekeep, alogHkIval)
+}
+
+// cheap check whether any (BMD) bucket has access logging enabled
+func (al *accessLogs) enabled() bool {
+	bmd := al.t.owner.bmd.get()
+	if bmd.Version == al.bmdVer.Load() {
+		return al.on.Load()
+	}
+	var on bool
+	bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+		on = bck.Props.AccessLog.Enabled
+		return on
+	})
+	al.on.Store(on)
+	al.bmdVer.Store(bmd.Version)
+	return on
+}
+
+// wrap client's object request
+func (al *accessLogs) wrap(w http.ResponseWriter, r *http.Request) (*alogWriter, bool) {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+	default:
+		return nil, false
+	}
+	if r.Header.Get(apc.HdrCallerID) != "" { // intra-cluster
+		return nil, false
+	}
+	if !al.enabled() {
+		return nil, false
+	}
+	return &alogWriter{ResponseWriter: w}, true
+}
+
+func (al *accessLogs) record(aw *alogWriter, r *http.Request, started time.Time) {
+	items, err := cmn.ParseURL(r.URL.Path, apc.URLPathObjects.L, 2, true)
+	if err != nil {
+		return
+	}
+	bck, err := newBckFromQ(items[0], r.URL.Query(), nil)
+	if err != nil {
+		return
+	}
+	if err := bck.Init(al.t.owner.bmd); err != nil {
+		return
+	}
+	if !bck.Props.AccessLog.Enabled {
+		return
+	}
+	rec := alogRec{
+		Time:    started.UnixNano(),
+		Method:  r.Method,
+		Bck:     bck.Cname(""),
+		ObjName: items[1],
+		Remote:  r.RemoteAddr,
+		Size:    aw.size,
+		Latency: int64(time.Since(started)),
+		Status:  aw.status,
+	}
+	if rec.Status == 0 {
+		rec.Status = http.StatusOK
+	}
+	if r.Method == http.MethodPut {
+		rec.Size = r.ContentLength
+	}
+	b, err := jsoniter.Marshal(&rec)
+	if err != nil {
+		return
+	}
+
+	uname := bck.MakeUname("")
+	al.mu.Lock()
+	batch, ok := al.batches[cos.UnsafeS(uname)]
+	if !ok {
+		batch = &alogBatch{bck: *bck.Bucket(), started: started.UnixNano()}
+		al.batches[string(uname)] = batch
+	}
+	if batch.sb.Len() < alogMaxRetain {
+		batch.sb.Write(b)
+		batch.sb.WriteByte('\n')
+	}
+	al.mu.Unlock()
+}
+
+func (al *accessLogs) housekeep() time.Duration {
+	if al.t.ClusterStarted() && al.busy.CAS(false, true) {
+		go al.flush()
+	}
+	return alogHkIval
+}
+
+func (al *accessLogs) flush() {
+	defer al.busy.Store(false)
+
+	var (
+		now     = time.Now()
+		ready   []*alogBatch
+		smap    = al.t.owner.smap.get()
+		config  = cmn.GCO.Get()
+		nowNano = now.UnixNano()
+	)
+	al.mu.Lock()
+	for uname, batch := range al.batches {
+		bck := meta.CloneBck(&batch.bck)
+		if err := bck.Init(al.t.owner.bmd); err != nil || !bck.Props.AccessLog.Enabled {
+			delete(al.batches, uname) // bucket's gone or access logging disabled
+			continue
+		}
+		if batch.sb.Len() >= alogMaxBatch || time.Duration(nowNano-batch.started) >= bck.Props.AccessLog.Ival() {
+			ready = append(ready, batch)
+			delete(al.batches, uname)
+		}
+	}
+	al.mu.Unlock()
+
+	for _, batch := range ready {
+		err := al.write(batch, now, smap, config)
+		if err == nil {
+			continue
+		}
+		nlog.Errorln(al.t.String(), "failed to write", batch.bck.Cname(""), "access log:", err)
+
+		// retry next time
+		uname := batch.bck.MakeUname("")
+		al.mu.Lock()
+		if curr, ok := al.batches[cos.UnsafeS(uname)]; ok && batch.sb.Len()+curr.sb.Len() < alogMaxRetain {
+			batch.sb.Write(curr.sb.Bytes())
+		}
+		al.batches[string(uname)] = batch
+		al.mu.Unlock()
+	}
+}
+
+func (al *accessLogs) write(batch *alogBatch, now time.Time, smap *smapX, config *cmn.Config) error {
+	bck := meta.CloneBck(&batch.bck)
+	if err := bck.Init(al.t.owner.bmd); err != nil {
+		return err
+	}
+	conf := &bck.Props.AccessLog
+	dst, err := conf.DstBck()
+	if err != nil {
+		return err
+	}
+	to := meta.CloneBck(&dst)
+	if err := to.Init(al.t.owner.bmd); err != nil {
+		return err
+	}
+	prefix := conf.Prefix
+	if prefix == "" {
+		prefix = bck.Name + "/"
+	}
+	objName := prefix + now.UTC().Format(alogTimeFmt) + "-" + al.t.SID() + ".jsonl"
+
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(to.Bucket()); err != nil {
+		return err
+	}
+	tsi, local, err := lom.HrwTarget(&smap.Smap)
+	if err != nil {
+		return err
+	}
+	size := int64(batch.sb.Len())
+	if local {
+		params := &core.PutParams{
+			Reader:  io.NopCloser(bytes.NewReader(batch.sb.Bytes())),
+			Atime:   now,
+			WorkTag: wtagAlog,
+			Size:    size,
+			OWT:     cmn.OwtPut,
+		}
+		return al.t.PutObject(lom, params)
+	}
+
+	// send to the HRW target (compare with t.importObj)
+	rhdr := make(http.Header, 4)
+	rhdr.Set(apc.HdrT2TPutterID, al.t.SID())
+	rhdr.Set(cos.HdrContentLength, strconv.FormatInt(size, 10))
+	reqArgs := cmn.HreqArgs{
+		Method: http.MethodPut,
+		Base:   tsi.URL(cmn.NetIntraData),
+		Path:   apc.URLPathObjects.Join(to.Name, lom.ObjName),
+		Query:  to.NewQuery(),
+		Header: rhdr,
+		BodyR:  bytes.NewReader(batch.sb.Bytes()),
+	}
+	req, _, cancel, err := reqArgs.ReqWithTimeout(config.Timeout.SendFile.D())
+	if err != nil {
+		return err
+	}
+	defer cancel()
+	req.ContentLength = size
+	resp, err := g.client.data.Do(req) //nolint:bodyclose // closed below
+	if err != nil {
+		return cmn.NewErrFailedTo(al.t, "write "+lom.Cname(), tsi, err)
+	}
+	cos.DrainReader(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return cmn.NewErrFailedTo(al.t, "write "+lom.Cname(), tsi, fmt.Errorf("status %d", resp.StatusCode))
+	}
+	return nil
+}
+
+////////////////
+// alogWriter //
+////////////////
+
+func (aw *alogWriter) WriteHeader(status int) {
+	if aw.status == 0 {
+		aw.status = status
+	}
+	aw.ResponseWriter.WriteHeader(status)
+}
+
+func (aw *alogWriter) Write(b []byte) (int, error) {
+	n, err := aw.ResponseWriter.Write(b)
+	aw.size += int64(n)
+	return n, err
+}
+
+// preserve sendfile
+func (aw *alogWriter) ReadFrom(r io.Reader) (n int64, err error) {
+	if rf, ok := aw.ResponseWriter.(io.ReaderFrom); ok {
+		n, err = rf.ReadFrom(r)
+	} else {
+		n, err = io.Copy(aw.ResponseWriter, r)
+	}
+	aw.size += n
+	return n, err
+}
+
+func (aw *alogWriter) Unwrap() http.ResponseWriter { return aw.ResponseWriter }
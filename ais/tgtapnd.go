@@ -0,0 +1,194 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// APPEND flow control (see cmn.AppendConf):
+// - a single APPEND request is limited by append.window: Content-Length, if present,
+//   is checked upfront; otherwise (e.g., chunked), the stream gets cut off (413)
+// - the total size of APPEND requests that are being received concurrently is limited by
+//   append.max_inflight; a request that does not fit fails with 429 (Too Many Requests)
+// - each successful APPEND advertises the current window (apc.HdrAppendWindow) - the lesser
+//   of append.window and the remaining in-flight budget - and the session's deadline
+//   (apc.HdrAppendDeadline)
+// - a session (handle) that is neither appended to nor flushed by its deadline is abandoned:
+//   housekeeping removes its work file, and requests that carry its handle fail with 410 (Gone)
+// - one request at a time per session (409 otherwise)
+
+const apndHkIval = time.Minute
+
+type (
+	apndSessions struct {
+		t        *target
+		m        map[string]*apndSess // by work FQN
+		inflight atomic.Int64         // total size of APPEND requests in progress
+		mu       sync.Mutex
+	}
+	apndSess struct {
+		cname string
+		atime int64 // last request (completed)
+		busy  bool  // request in progress
+	}
+)
+
+func (as *apndSessions) init(t *target) {
+	as.t = t
+	as.m = make(map[string]*apndSess, 8)
+	hk.Reg("append-sessions"+hk.NameSuffix, as.housekeep, apndHkIval)
+}
+
+// returns existing session (nil when new) and the reserved in-flight size
+func (as *apndSessions) begin(a *apndOI) (s *apndSess, reserved int64, ecode int, err error) {
+	var (
+		conf  = &a.config.Append
+		cname = a.lom.Cname()
+	)
+	if a.op == apc.AppendOp {
+		if conf.Window > 0 && a.size > int64(conf.Window) {
+			return nil, 0, http.StatusRequestEntityTooLarge, cmn.NewErrTooLarge(cname, a.size, int64(conf.Window))
+		}
+		reserved = a.size
+		if reserved <= 0 {
+			reserved = int64(conf.Window) // (unknown size, at most the window)
+		}
+		if conf.MaxInflight > 0 {
+			if n := as.inflight.Add(reserved); n > int64(conf.MaxInflight) {
+				as.inflight.Sub(reserved)
+				as.t.statsT.Inc(stats.AppendThrottleCount)
+				return nil, 0, http.StatusTooManyRequests,
+					fmt.Errorf("%s: APPEND (%s) exceeds the remaining in-flight budget (append.max_inflight %s) - back off and retry",
+						cname, cos.ToSizeIEC(reserved, 0), cos.ToSizeIEC(int64(conf.MaxInflight), 0))
+			}
+		} else {
+			as.inflight.Add(reserved)
+		}
+	}
+	workFQN := a.hdl.workFQN
+	if workFQN == "" {
+		return nil, reserved, 0, nil // new session
+	}
+
+	as.mu.Lock()
+	s = as.m[workFQN]
+	switch {
+	case s == nil:
+		ecode, err = http.StatusGone, fmt.Errorf("%s: append handle is unknown or expired", cname)
+	case s.busy:
+		ecode, err = http.StatusConflict, fmt.Errorf("%s: another request to the same append handle is in progress", cname)
+	case as.expired(s, conf, time.Now().UnixNano()):
+		delete(as.m, workFQN)
+		ecode, err = http.StatusGone, fmt.Errorf("%s: append handle expired (append.flush_deadline %s)", cname, conf.FlushDeadline)
+	default:
+		s.busy = true
+	}
+	as.mu.Unlock()
+
+	if err != nil {
+		if ecode == http.StatusGone && s != nil {
+			as.gc(workFQN, s)
+		}
+		as.inflight.Sub(reserved)
+		return nil, 0, ecode, err
+	}
+	return s, reserved, 0, nil
+}
+
+// upon completion of APPEND or flush
+func (as *apndSessions) end(a *apndOI, s *apndSess, reserved int64, err error) {
+	as.inflight.Sub(reserved)
+	workFQN := a.hdl.workFQN
+	if workFQN == "" {
+		return
+	}
+	now := time.Now().UnixNano()
+	as.mu.Lock()
+	switch {
+	case s != nil && a.op == apc.FlushOp && err == nil:
+		delete(as.m, workFQN) // done
+	case s != nil:
+		s.busy = false
+		s.atime = now
+	case err == nil:
+		as.m[workFQN] = &apndSess{cname: a.lom.Cname(), atime: now}
+	}
+	as.mu.Unlock()
+
+	// failed to start a new session: no one has the handle
+	if s == nil && err != nil {
+		if errV := cos.RemoveFile(workFQN); errV != nil {
+			nlog.Errorln(as.t.String(), "failed to remove", workFQN, "[", errV, "]")
+		}
+	}
+}
+
+// advertise window and deadline
+func (as *apndSessions) advertise(hdr http.Header, conf *cmn.AppendConf) {
+	window := int64(conf.Window)
+	if conf.MaxInflight > 0 {
+		avail := max(int64(conf.MaxInflight)-as.inflight.Load(), 0)
+		if window == 0 || avail < window {
+			window = avail
+		}
+	}
+	if window > 0 || conf.MaxInflight > 0 {
+		hdr.Set(apc.HdrAppendWindow, strconv.FormatInt(window, 10))
+	}
+	if conf.FlushDeadline > 0 {
+		hdr.Set(apc.HdrAppendDeadline, cos.UnixNano2S(time.Now().UnixNano()+int64(conf.FlushDeadline)))
+	}
+}
+
+func (*apndSessions) expired(s *apndSess, conf *cmn.AppendConf, now int64) bool {
+	return conf.FlushDeadline > 0 && time.Duration(now-s.atime) > conf.FlushDeadline.D()
+}
+
+func (as *apndSessions) gc(workFQN string, s *apndSess) {
+	if err := cos.RemoveFile(workFQN); err != nil {
+		nlog.Errorln(as.t.String(), "failed to remove abandoned append", s.cname, "work file:", err)
+		return
+	}
+	as.t.statsT.Inc(stats.AppendGCCount)
+	nlog.Infoln(as.t.String(), "removed abandoned append", s.cname, "work file", workFQN)
+}
+
+func (as *apndSessions) housekeep() time.Duration {
+	var (
+		conf    = &cmn.GCO.Get().Append
+		now     = time.Now().UnixNano()
+		expired map[string]*apndSess
+	)
+	as.mu.Lock()
+	for workFQN, s := range as.m {
+		if s.busy || !as.expired(s, conf, now) {
+			continue
+		}
+		if expired == nil {
+			expired = make(map[string]*apndSess, 4)
+		}
+		expired[workFQN] = s
+		delete(as.m, workFQN)
+	}
+	as.mu.Unlock()
+
+	for workFQN, s := range expired {
+		as.gc(workFQN, s)
+	}
+	return apndHkIval
+}
@@ -0,0 +1,215 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Index of TAR shards (see cmn.ArchIndexConf):
+// - upon GET of an archived file (archpath) from (uncompressed) TAR shard that is not indexed yet,
+//   the target scans the shard as usual, and then indexes it in the background:
+//   archived filenames => offsets and sizes of their contents
+// - subsequent GETs of archived files from the same shard read the contents directly at the offset;
+//   files that are not in the index are not there - no scanning either
+// - an index is valid while the shard's size, checksum, version, and mtime remain the same;
+//   PUT and DELETE evict it right away
+// - shards that cannot be indexed (too many files, sparse files) are remembered as such
+// - least recently used indexes get evicted beyond arch_index.max_shards, idle ones - after taixIdle,
+//   and all of them - under high memory pressure
+// - hit vs miss counters: get.archidx.hit.n vs get.archidx.miss.n
+
+const (
+	taixHkIval  = time.Minute
+	taixIdle    = 30 * time.Minute
+	taixMaxBusy = 4 // max shards indexed at the same time
+)
+
+type (
+	tarIndexes struct {
+		t    *target
+		m    map[string]*taixEntry // by FQN
+		busy map[string]struct{}   // being indexed
+		mu   sync.Mutex
+	}
+	taixEntry struct {
+		idx     archive.TarIndex // nil: cannot be indexed
+		cksum   *cos.Cksum
+		version string
+		size    int64
+		mtime   int64
+		last    atomic.Int64 // mono time of the last access
+	}
+	// (index hit)
+	taixReader struct {
+		*io.SectionReader
+	}
+)
+
+func (tx *tarIndexes) init(t *target) {
+	tx.t = t
+	tx.m = make(map[string]*taixEntry, 64)
+	tx.busy = make(map[string]struct{}, taixMaxBusy)
+	hk.Reg("arch-index"+hk.NameSuffix, tx.housekeep, taixHkIval)
+}
+
+// (GET datapath) returns the shard's index or nil
+func (tx *tarIndexes) get(lom *core.LOM, lmfh *os.File, config *cmn.Config) archive.TarIndex {
+	if !config.ArchIndex.Enabled {
+		return nil
+	}
+	tx.mu.Lock()
+	e, ok := tx.m[lom.FQN]
+	tx.mu.Unlock()
+	if !ok || e.idx == nil {
+		return nil
+	}
+	if !e.valid(lom, lmfh) {
+		tx.evict(lom.FQN)
+		return nil
+	}
+	e.last.Store(mono.NanoTime())
+	tx.t.statsT.Inc(stats.ArchIdxHitCount)
+	return e.idx
+}
+
+// (GET datapath) upon scanning uncompressed TAR shard; lom is rlocked
+func (tx *tarIndexes) admit(lom *core.LOM, config *cmn.Config) {
+	if !config.ArchIndex.Enabled {
+		return
+	}
+	tx.t.statsT.Inc(stats.ArchIdxMissCount)
+	tx.mu.Lock()
+	if _, ok := tx.m[lom.FQN]; ok {
+		tx.mu.Unlock()
+		return
+	}
+	if _, ok := tx.busy[lom.FQN]; ok || len(tx.busy) >= taixMaxBusy || tx.t.gmm.Pressure() >= memsys.PressureHigh {
+		tx.mu.Unlock()
+		return
+	}
+	tx.busy[lom.FQN] = struct{}{}
+	tx.mu.Unlock()
+
+	e := &taixEntry{cksum: lom.Checksum().Clone(), version: lom.Version(), size: lom.Lsize()}
+	go tx.index(e, lom.FQN, lom.Cname(), config.ArchIndex.MaxFiles)
+}
+
+func (tx *tarIndexes) index(e *taixEntry, fqn, cname string, maxFiles int) {
+	err := e.build(fqn, maxFiles)
+	e.last.Store(mono.NanoTime())
+
+	config := cmn.GCO.Get()
+	tx.mu.Lock()
+	delete(tx.busy, fqn)
+	switch err {
+	case nil:
+		tx._add(fqn, e, config)
+	case archive.ErrTooManyFiles, archive.ErrSparseFiles:
+		e.idx = nil // (remember as such)
+		tx._add(fqn, e, config)
+	case errTaixChanged:
+		// (will be indexed upon the next GET)
+	default:
+		nlog.Warningln(tx.t.String(), "failed to index", cname+":", err)
+	}
+	tx.mu.Unlock()
+	if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+		nlog.Infoln(tx.t.String(), "index", cname+":", len(e.idx), "archived files", err)
+	}
+}
+
+// under lock
+func (tx *tarIndexes) _add(fqn string, e *taixEntry, config *cmn.Config) {
+	if !config.ArchIndex.Enabled {
+		return
+	}
+	for len(tx.m) >= config.ArchIndex.MaxShards {
+		var (
+			lru    string
+			oldest int64
+		)
+		for k, v := range tx.m {
+			if last := v.last.Load(); lru == "" || last < oldest {
+				lru, oldest = k, last
+			}
+		}
+		delete(tx.m, lru)
+	}
+	tx.m[fqn] = e
+}
+
+// (upon PUT and DELETE)
+func (tx *tarIndexes) evict(fqn string) {
+	tx.mu.Lock()
+	delete(tx.m, fqn)
+	tx.mu.Unlock()
+}
+
+func (tx *tarIndexes) housekeep() time.Duration {
+	var (
+		config = cmn.GCO.Get()
+		now    = mono.NanoTime()
+		all    = !config.ArchIndex.Enabled || tx.t.gmm.Pressure() >= memsys.PressureHigh
+	)
+	tx.mu.Lock()
+	for fqn, e := range tx.m {
+		if all || time.Duration(now-e.last.Load()) > taixIdle {
+			delete(tx.m, fqn)
+		}
+	}
+	tx.mu.Unlock()
+	return taixHkIval
+}
+
+var errTaixChanged = errors.New("changed while indexing")
+
+func (e *taixEntry) build(fqn string, maxFiles int) error {
+	fh, err := os.Open(fqn)
+	if err != nil {
+		return err
+	}
+	defer cos.Close(fh)
+	finfo, err := fh.Stat()
+	if err != nil {
+		return err
+	}
+	if finfo.Size() != e.size {
+		return errTaixChanged
+	}
+	e.mtime = finfo.ModTime().UnixNano()
+	e.idx, err = archive.NewTarIndex(fh, maxFiles)
+	return err
+}
+
+func (e *taixEntry) valid(lom *core.LOM, lmfh *os.File) bool {
+	finfo, err := lmfh.Stat()
+	if err != nil || finfo.Size() != e.size {
+		return false
+	}
+	if lmfh.Name() == lom.FQN && finfo.ModTime().UnixNano() != e.mtime { // (mtime of a copy differs)
+		return false
+	}
+	// (ditto mmentry.valid)
+	cksum := lom.Checksum()
+	return e.size == lom.Lsize() && e.version == lom.Version() && e.cksum.Type() == cksum.Type() && e.cksum.Value() == cksum.Value()
+}
+
+func (taixReader) Close() error { return nil }
@@ -0,0 +1,69 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/archive"
+)
+
+func TestTarIndex(t *testing.T) {
+	var (
+		buf   bytes.Buffer
+		tw    = tar.NewWriter(&buf)
+		files = map[string]string{
+			"a.txt":     "hello",
+			"dir/b.cls": "1",
+			"dir/c.jpg": strings.Repeat("x", 3000),
+			// (PAX header)
+			strings.Repeat("long/", 30) + "d.txt": "world",
+		}
+		order = []string{"a.txt", "dir/b.cls", "dir/c.jpg", strings.Repeat("long/", 30) + "d.txt"}
+	)
+	if err := tw.WriteHeader(&tar.Header{Name: "dir/", Typeflag: tar.TypeDir, Mode: 0o755}); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range order {
+		body := files[name]
+		if err := tw.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0o644, Size: int64(len(body))}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(body)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tw.Close()
+
+	r := bytes.NewReader(buf.Bytes())
+	idx, err := archive.NewTarIndex(r, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, body := range files {
+		e, ok := idx.Get("/" + name)
+		if !ok {
+			t.Fatalf("%q: not indexed", name)
+		}
+		b, err := io.ReadAll(io.NewSectionReader(r, e.Off, e.Size))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != body {
+			t.Errorf("%q: expected %q, got %q", name, body, b)
+		}
+	}
+	if _, ok := idx.Get("none"); ok {
+		t.Error("expected not found")
+	}
+
+	if _, err := archive.NewTarIndex(bytes.NewReader(buf.Bytes()), 2); err != archive.ErrTooManyFiles {
+		t.Fatalf("expected %v, got %v", archive.ErrTooManyFiles, err)
+	}
+}
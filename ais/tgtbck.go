@@ -6,10 +6,12 @@ package ais
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"runtime"
 	"sort"
 	"strconv"
+	"sync"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -20,6 +22,7 @@ import (
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
 	"github.com/NVIDIA/aistore/nl"
 	"github.com/NVIDIA/aistore/reb"
 	"github.com/NVIDIA/aistore/stats"
@@ -38,6 +41,16 @@ func (t *target) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 	if err != nil {
 		return
 	}
+	if q := r.URL.Query(); q.Has(apc.QparamAction) && len(apiItems) > 0 {
+		switch q.Get(apc.QparamAction) {
+		case apc.BckExport:
+			t.bckExport(w, r, apiItems[0], q)
+			return
+		case apc.BckChanges:
+			t.bckChanges(w, r, apiItems[0])
+			return
+		}
+	}
 	if err = t.isIntraCall(r.Header, false); err != nil {
 		t.writeErr(w, r, err)
 		return
@@ -102,7 +115,13 @@ func (t *target) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 			t.writeErrf(w, r, "list-objects: invalid UUID %q", lsmsg.UUID)
 			return
 		}
-		if ok := t.listObjects(w, r, bck, lsmsg); !ok {
+		var ok bool
+		if lsmsg.IsFlagSet(apc.LsSummary) {
+			ok = t.lsoSumm(w, r, bck, lsmsg)
+		} else {
+			ok = t.listObjects(w, r, bck, lsmsg)
+		}
+		if !ok {
 			t.statsT.IncErr(stats.ErrListCount)
 			return
 		}
@@ -269,7 +288,11 @@ func (t *target) listObjects(w http.ResponseWriter, r *http.Request, bck *meta.B
 		rns = xreg.RenewLso(bck, lsmsg.UUID, lsmsg, r.Header)
 	}
 	if rns.Err != nil {
-		t.writeErr(w, r, rns.Err)
+		if errors.Is(rns.Err, xs.ErrSnapshotGone) {
+			t.writeErr(w, r, rns.Err, http.StatusGone)
+		} else {
+			t.writeErr(w, r, rns.Err)
+		}
 		return
 	}
 	// run
@@ -296,6 +319,39 @@ func (t *target) listObjects(w http.ResponseWriter, r *http.Request, bck *meta.B
 	return t.writeMsgPack(w, resp.Lst, "list_objects")
 }
 
+// list-objects in summary mode (apc.LsSummary): walk in-cluster objects that match the prefix
+// and are owned by this target (misplaced objects and copies excepted), and return
+// per-prefix counts and sizes (see cmn.LsoSummPrefix)
+func (t *target) lsoSumm(w http.ResponseWriter, r *http.Request, bck *meta.Bck, lsmsg *apc.LsoMsg) bool {
+	var (
+		mu    sync.Mutex
+		summ  = make(cmn.LsoSummMap, 16)
+		visit = func(lom *core.LOM, _ []byte) error {
+			prefix := cmn.LsoSummPrefix(lom.ObjName, lsmsg.Prefix, lsmsg.Depth)
+			mu.Lock()
+			summ.Add(prefix, 1, lom.Lsize())
+			mu.Unlock()
+			return nil
+		}
+		opts = &mpather.JgroupOpts{
+			CTs:                   []string{fs.ObjectType},
+			VisitObj:              visit,
+			Prefix:                lsmsg.Prefix,
+			DoLoad:                mpather.Load,
+			SkipGloballyMisplaced: true,
+		}
+	)
+	opts.Bck.Copy(bck.Bucket())
+	jg := mpather.NewJoggerGroup(opts, cmn.GCO.Get(), "")
+	jg.Run()
+	<-jg.ListenFinished()
+	if err := jg.Stop(); err != nil {
+		t.writeErr(w, r, err)
+		return false
+	}
+	return t.writeJS(w, r, summ.Sorted(), "list_objects_summary")
+}
+
 func (t *target) bsumm(w http.ResponseWriter, r *http.Request, phase string, bck *meta.Bck, msg *apc.BsummCtrlMsg, dpq *dpq) {
 	if phase == apc.ActBegin {
 		rns := xreg.RenewBckSummary(bck, msg)
@@ -418,7 +474,7 @@ func (t *target) httpbckpost(w http.ResponseWriter, r *http.Request, apireq *api
 	if err != nil {
 		return
 	}
-	if msg.Action != apc.ActPrefetchObjects {
+	if msg.Action != apc.ActPrefetchObjects && msg.Action != apc.ActSampleBck && msg.Action != apc.ActFreezeBck {
 		t.writeErrAct(w, r, msg.Action)
 		return
 	}
@@ -431,6 +487,18 @@ func (t *target) httpbckpost(w http.ResponseWriter, r *http.Request, apireq *api
 		t.writeErr(w, r, err)
 		return
 	}
+	switch msg.Action {
+	case apc.ActSampleBck:
+		t.sampleBck(w, r, apireq.bck, msg)
+		return
+	case apc.ActFreezeBck:
+		t.freezeBck(w, r, apireq.bck, msg)
+		return
+	}
+	if apireq.bck.Props.Frozen != 0 {
+		t.writeErr(w, r, cmn.NewErrBckFrozen(apireq.bck.Bucket()), http.StatusConflict)
+		return
+	}
 
 	prfMsg := &apc.PrefetchMsg{}
 	if err := cos.MorphMarshal(msg.Value, prfMsg); err != nil {
@@ -501,7 +569,11 @@ func (t *target) httpbckhead(w http.ResponseWriter, r *http.Request, apireq *api
 		}
 	}
 	// + cloud
-	bucketProps, code, err = t.Backend(apireq.bck).HeadBucket(ctx, apireq.bck)
+	if inBMD && apireq.bck.IsOffline() {
+		err = cmn.NewErrBackendOffline("HEAD", apireq.bck.Bucket())
+	} else {
+		bucketProps, code, err = t.Backend(apireq.bck).HeadBucket(ctx, apireq.bck)
+	}
 	if err != nil {
 		if !inBMD {
 			if code == http.StatusNotFound {
@@ -513,7 +585,9 @@ func (t *target) httpbckhead(w http.ResponseWriter, r *http.Request, apireq *api
 			}
 			return
 		}
-		nlog.Warningf("%s: bucket %s, err: %v(%d)", t, apireq.bck, err, code)
+		if !cmn.IsErrBackendOffline(err) {
+			nlog.Warningf("%s: bucket %s, err: %v(%d)", t, apireq.bck, err, code)
+		}
 		bucketProps = make(cos.StrKVs)
 		bucketProps[apc.HdrBackendProvider] = apireq.bck.Provider
 		bucketProps[apc.HdrRemoteOffline] = strconv.FormatBool(apireq.bck.IsRemote())
@@ -0,0 +1,157 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// Bucket change feed (GET /v1/buckets/<bck>?action=changes&since=<seq>):
+// - each target records committed client PUT, DELETE, and rename of the objects it stores,
+//   numbering the events with a monotonic sequence - wall clock (unix nanoseconds) that never
+//   goes back and never repeats (see chfeed.tick)
+// - the proxy merges per-target feeds up to the minimum of their `Next` watermarks, so that
+//   no event at or below the returned `Next` can show up later (see cmn.MergeChanges)
+// - the feed is in-memory and bounded (chfeedMaxEvents per bucket): when `since` precedes
+//   the oldest retained event (or the target's restart), the call fails with 410 Gone -
+//   the caller must then resync via a full listing
+// - t2t PUTs (rebalance, copies, etc.) do not count
+
+const (
+	chfeedMaxEvents = 64 * 1024
+	chfeedMaxPage   = 10 * 1024
+)
+
+type (
+	chfeed struct {
+		bcks  sync.Map // bucket cname => *bchanges
+		last  atomic.Int64
+		floor int64 // the first sequence number since (re)start
+	}
+	bchanges struct {
+		events  []*cmn.ChangeEvent
+		dropped int64 // sequence number of the last dropped event (or restart)
+		mu      sync.Mutex
+	}
+)
+
+func (cf *chfeed) init() {
+	cf.floor = cf.tick()
+}
+
+func (cf *chfeed) tick() int64 {
+	for {
+		var (
+			last = cf.last.Load()
+			now  = time.Now().UnixNano()
+		)
+		if now <= last {
+			now = last + 1
+		}
+		if cf.last.CAS(last, now) {
+			return now
+		}
+	}
+}
+
+// post-commit PUT, DELETE, or rename
+func (cf *chfeed) add(lom *core.LOM, op, newName string) {
+	bc := cf.get(lom.Bck())
+	bc.mu.Lock()
+	if len(bc.events) >= chfeedMaxEvents {
+		n := chfeedMaxEvents / 8
+		bc.dropped = bc.events[n-1].Seq
+		bc.events = append(bc.events[:0], bc.events[n:]...)
+	}
+	// (under lock to keep events ordered)
+	bc.events = append(bc.events, &cmn.ChangeEvent{Seq: cf.tick(), Op: op, ObjName: lom.ObjName, NewName: newName})
+	bc.mu.Unlock()
+}
+
+// (a bucket that has no events yet starts at the target's restart)
+func (cf *chfeed) get(bck *meta.Bck) *bchanges {
+	cname := bck.Cname("")
+	if v, ok := cf.bcks.Load(cname); ok {
+		return v.(*bchanges)
+	}
+	v, _ := cf.bcks.LoadOrStore(cname, &bchanges{dropped: cf.floor})
+	return v.(*bchanges)
+}
+
+// (upon bucket removal - see _postBMD)
+func (cf *chfeed) del(bcks ...*meta.Bck) {
+	for _, bck := range bcks {
+		cf.bcks.Delete(bck.Cname(""))
+	}
+}
+
+// events with sequence numbers greater than `since`
+func (cf *chfeed) changes(bck *meta.Bck, since int64) (*cmn.ChangeFeed, error) {
+	feed := &cmn.ChangeFeed{}
+	if since <= 0 {
+		feed.Next = cf.tick() // starting point
+		return feed, nil
+	}
+	bc := cf.get(bck)
+	bc.mu.Lock()
+	if since < bc.dropped {
+		bc.mu.Unlock()
+		return nil, fmt.Errorf("%s change feed: sequence %d is no longer available (oldest %d)", bck, since, bc.dropped)
+	}
+	i, l := 0, len(bc.events)
+	for i < l && bc.events[i].Seq <= since {
+		i++
+	}
+	if l-i > chfeedMaxPage {
+		feed.Events = append(feed.Events, bc.events[i:i+chfeedMaxPage]...)
+		feed.Next = feed.Events[chfeedMaxPage-1].Seq
+	} else {
+		feed.Events = append(feed.Events, bc.events[i:]...)
+		feed.Next = cf.tick()
+	}
+	bc.mu.Unlock()
+	return feed, nil
+}
+
+// GET /v1/buckets/<bck>?action=changes&since=<seq> (intra-cluster)
+func (t *target) bckChanges(w http.ResponseWriter, r *http.Request, bckName string) {
+	if err := t.isIntraCall(r.Header, false); err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	query := r.URL.Query()
+	bck, err := newBckFromQ(bckName, query, nil)
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	if err := bck.Init(t.owner.bmd); err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	var since int64
+	if s := query.Get(apc.QparamSince); s != "" {
+		if since, err = strconv.ParseInt(s, 10, 64); err != nil {
+			t.writeErrf(w, r, "invalid %s=%q: %v", apc.QparamSince, s, err)
+			return
+		}
+	}
+	feed, err := t.chfeed.changes(bck, since)
+	if err != nil {
+		t.writeErr(w, r, err, http.StatusGone)
+		return
+	}
+	t.writeJSON(w, r, feed, apc.BckChanges)
+}
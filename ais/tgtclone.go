@@ -0,0 +1,109 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Copy-on-write clone (apc.ActCloneBck), target side:
+// - objects that have not been written into the clone are read through from the source
+//   bucket (GET) and their properties are taken from the source as well (HEAD) - nothing
+//   gets stored in the clone
+// - deleting an object from the clone leaves a tombstone: zero-size object with
+//   cmn.TombstoneObjMD that hides the source's object, if any; writing the object into
+//   the clone again replaces the tombstone
+// - list-objects: tombstones are listed (with apc.EntryTombstone) for the proxy to merge
+//   the clone's own pages with the source's (see proxy.lsClone)
+// - the source may itself be a clone, in which case the same logic applies recursively
+
+// GET: the object has not been written into the clone (yet)
+func (goi *getOI) getFromClone(src *meta.Bck) (int, error) {
+	return goi.readThrough(src, goi.lom.ObjName, "clone of "+src.Cname(""), nil)
+}
+
+// HEAD: ditto - HEAD the source's object via the target that owns it and relay its properties
+// (whdr == nil: existence only)
+func (t *target) headClone(lom *core.LOM, src *meta.Bck, query url.Values, whdr http.Header) (int, error) {
+	smap := t.owner.smap.get()
+	tsi, err := smap.HrwName2T(src.MakeUname(lom.ObjName))
+	if err != nil {
+		return http.StatusServiceUnavailable, err
+	}
+	q := src.AddToQuery(cmn.DelBckFromQuery(query))
+	q.Set(apc.QparamSilent, "true")
+	cargs := allocCargs()
+	{
+		cargs.si = tsi
+		cargs.req = cmn.HreqArgs{
+			Method: http.MethodHead,
+			Header: http.Header{
+				apc.HdrCallerID:   []string{t.SID()},
+				apc.HdrCallerName: []string{t.callerName()},
+			},
+			Base:  tsi.URL(cmn.NetIntraControl),
+			Path:  apc.URLPathObjects.Join(src.Name, lom.ObjName),
+			Query: q,
+		}
+		cargs.timeout = cmn.Rom.CplaneOperation()
+	}
+	res := t.call(cargs, smap)
+	ecode, err := res.status, res.err
+	if err == nil && whdr != nil {
+		for k, v := range res.header {
+			whdr[k] = v
+		}
+	}
+	freeCargs(cargs)
+	freeCR(res)
+	if err != nil && ecode == http.StatusNotFound {
+		err = cos.NewErrNotFound(t, lom.Cname()+" (clone of "+src.Cname("")+")")
+	}
+	return ecode, err
+}
+
+// DELETE: replace the object with a tombstone or, if the object has not been written into
+// the clone, make sure the source has it and place the tombstone
+func (t *target) delClone(lom *core.LOM, src *meta.Bck) (int, error) {
+	lom.Lock(false)
+	err := lom.Load(false /*cache it*/, true /*locked*/)
+	lom.Unlock(false)
+	switch {
+	case err == nil:
+		if lom.IsTombstone() {
+			return http.StatusNotFound, cos.NewErrNotFound(t, lom.Cname())
+		}
+	case !cos.IsNotExist(err, 0):
+		return 0, err
+	default:
+		query := url.Values{apc.QparamFltPresence: []string{strconv.Itoa(apc.FltExistsNoProps)}}
+		if ecode, err := t.headClone(lom, src, query, nil); err != nil {
+			return ecode, err
+		}
+	}
+
+	lom.SetCustomMD(cos.StrKVs{cmn.TombstoneObjMD: "true"})
+	params := core.AllocPutParams()
+	{
+		params.WorkTag = fs.WorkfilePut
+		params.Reader = http.NoBody
+		params.Atime = time.Now()
+		params.OWT = cmn.OwtPut
+		params.SkipEC = true
+	}
+	err = t.PutObject(lom, params)
+	core.FreePutParams(params)
+	return 0, err
+}
@@ -0,0 +1,115 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+const testClone = "bck-clone"
+
+// add copy-on-write clone of the test bucket
+func cloneTestBck() *meta.Bck {
+	bck := meta.NewBck(testClone, apc.AIS, cmn.NsGlobal)
+	bmd := t.owner.bmd.get().clone()
+	if _, present := bmd.Get(bck); !present {
+		bmd.add(bck, &cmn.Bprops{
+			Cksum:   cmn.CksumConf{Type: cos.ChecksumNone},
+			CloneOf: cmn.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal},
+		})
+		t.owner.bmd.putPersist(bmd, nil)
+		fs.CreateBucket(bck.Bucket(), false /*nilbmd*/)
+	}
+	return bck
+}
+
+func clonePut(lom *core.LOM, content string) (int, error) {
+	poi := &putOI{atime: time.Now().UnixNano(), t: t, lom: lom, config: cmn.GCO.Get()}
+	r := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(content))
+	return poi.do(http.Header{}, r, &dpq{})
+}
+
+func cloneHead(bck *meta.Bck, objName string) (int, error) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	r := httptest.NewRequest(http.MethodHead, "/", http.NoBody)
+	return t.objHead(r, http.Header{}, url.Values{}, bck, lom)
+}
+
+func cloneDel(bck *meta.Bck, objName string) (int, error) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		return 0, err
+	}
+	return t.DeleteObject(lom, false /*evict*/)
+}
+
+func TestCloneTombstone(t *testing.T) {
+	const objName = "clone-obj"
+	bck := cloneTestBck()
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := clonePut(lom, "0123456789"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cloneHead(bck, objName); err != nil {
+		t.Fatal(err)
+	}
+
+	// delete: tombstone in place of the object
+	if _, err := cloneDel(bck, objName); err != nil {
+		t.Fatal(err)
+	}
+	tlom := core.AllocLOM(objName)
+	defer core.FreeLOM(tlom)
+	if err := tlom.InitBck(bck.Bucket()); err != nil {
+		t.Fatal(err)
+	}
+	if err := tlom.Load(false, false); err != nil {
+		t.Fatal(err)
+	}
+	if !tlom.IsTombstone() || tlom.Lsize() != 0 {
+		t.Fatalf("expected zero-size tombstone, got size %d, custom %v", tlom.Lsize(), tlom.GetCustomMD())
+	}
+
+	// deleted object: not found, cannot be deleted again
+	if ecode, err := cloneHead(bck, objName); err == nil || ecode != http.StatusNotFound {
+		t.Fatalf("expected HEAD to fail with %d, got (%d, %v)", http.StatusNotFound, ecode, err)
+	}
+	if ecode, err := cloneDel(bck, objName); err == nil || ecode != http.StatusNotFound {
+		t.Fatalf("expected DELETE to fail with %d, got (%d, %v)", http.StatusNotFound, ecode, err)
+	}
+
+	// write it again: tombstone replaced
+	if _, err := clonePut(lom, "abc"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cloneHead(bck, objName); err != nil {
+		t.Fatal(err)
+	}
+	if err := tlom.Load(false, false); err != nil {
+		t.Fatal(err)
+	}
+	if tlom.IsTombstone() || tlom.Lsize() != 3 {
+		t.Fatalf("expected object (size 3) in place of the tombstone, got size %d, custom %v",
+			tlom.Lsize(), tlom.GetCustomMD())
+	}
+}
@@ -0,0 +1,162 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/memsys"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/tinylib/msgp/msgp"
+)
+
+// GET with on-the-fly format conversion (apc.QparamConvert):
+// - the converted content is streamed (chunked) and, therefore, its size is not known upfront;
+// - neither Content-Length nor object's checksum is included in the response header
+// - conversion errors that occur after the first byte has been sent cannot be reported
+//   (other than by terminating the response)
+
+type convWriter struct {
+	w       io.Writer
+	written int64
+}
+
+var (
+	// preserve (json) numbers, to be further converted as ints or floats
+	jsonNum = jsoniter.Config{UseNumber: true}.Froze()
+	// sorted keys
+	jsonStd = jsoniter.ConfigCompatibleWithStandardLibrary
+)
+
+func (goi *getOI) _txconv(r io.Reader, fqn string, whdr http.Header) (err error) {
+	var (
+		conv = goi.dpq.conv
+		cw   = &convWriter{w: goi.w}
+	)
+	switch conv {
+	case apc.ConvGunzip:
+		var gzr *gzip.Reader
+		if gzr, err = gzip.NewReader(r); err != nil {
+			return cmn.NewErrFailedTo(goi.t, conv, goi.lom.Cname(), err, http.StatusUnprocessableEntity)
+		}
+		whdr.Set(cos.HdrContentType, cos.ContentBinary)
+		buf, slab := goi.t.gmm.AllocSize(memsys.DefaultBuf2Size)
+		_, err = cos.CopyBuffer(cw, gzr, buf)
+		slab.Free(buf)
+		gzr.Close()
+	case apc.ConvJSONL2Msg:
+		whdr.Set(cos.HdrContentType, cos.ContentMsgPack)
+		err = jsonl2msgp(cw, r)
+	case apc.ConvMsg2JSONL:
+		whdr.Set(cos.HdrContentType, cos.ContentJSONL)
+		err = msgp2jsonl(cw, r)
+	default:
+		debug.Assert(false, conv)
+	}
+	if err != nil {
+		if cw.written == 0 {
+			return cmn.NewErrFailedTo(goi.t, conv, goi.lom.Cname(), err, http.StatusUnprocessableEntity)
+		}
+		nlog.Warningln("failed to GET (Tx)", goi.lom.Cname(), "with", conv, "conversion:", err)
+		return errSendingResp
+	}
+	return goi.posttx(cw.written)
+}
+
+// each (non-empty) line => msgpack-encoded value
+func jsonl2msgp(w io.Writer, r io.Reader) error {
+	var (
+		br = bufio.NewReaderSize(r, memsys.DefaultBufSize)
+		mw = msgp.NewWriter(w)
+	)
+	for {
+		line, err := br.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			var v any
+			if errV := jsonNum.Unmarshal(line, &v); errV != nil {
+				return errV
+			}
+			if errV := mw.WriteIntf(_jnum(v)); errV != nil {
+				return errV
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+	}
+	return mw.Flush()
+}
+
+// json.Number => int64 or float64 (msgp would otherwise encode it as a string)
+func _jnum(v any) any {
+	switch x := v.(type) {
+	case json.Number:
+		if i, err := x.Int64(); err == nil {
+			return i
+		}
+		f, _ := x.Float64()
+		return f
+	case map[string]any:
+		for k, vv := range x {
+			x[k] = _jnum(vv)
+		}
+	case []any:
+		for i, vv := range x {
+			x[i] = _jnum(vv)
+		}
+	}
+	return v
+}
+
+// each msgpack-encoded value => JSON line
+func msgp2jsonl(w io.Writer, r io.Reader) error {
+	var (
+		mr = msgp.NewReader(r)
+		bw = bufio.NewWriterSize(w, memsys.DefaultBufSize)
+	)
+	for {
+		v, err := mr.ReadIntf()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+		b, err := jsonStd.Marshal(v)
+		if err != nil {
+			return err
+		}
+		if _, err := bw.Write(b); err != nil {
+			return err
+		}
+		if err := bw.WriteByte('\n'); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+////////////////
+// convWriter //
+////////////////
+
+func (cw *convWriter) Write(b []byte) (int, error) {
+	n, err := cw.w.Write(b)
+	cw.written += int64(n)
+	return n, err
+}
@@ -0,0 +1,49 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestConvJSONLRoundTrip(t *testing.T) {
+	const in = `{"id":1,"name":"a","score":0.5}
+
+{"id":9007199254740993,"tags":["x","y"],"nested":{"ok":true}}
+[1,2,3]
+"str"
+`
+	var msg, out bytes.Buffer
+	if err := jsonl2msgp(&msg, strings.NewReader(in)); err != nil {
+		t.Fatal(err)
+	}
+	if err := msgp2jsonl(&out, &msg); err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		`{"id":1,"name":"a","score":0.5}`,
+		`{"id":9007199254740993,"nested":{"ok":true},"tags":["x","y"]}`,
+		`[1,2,3]`,
+		`"str"`,
+	}
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	if len(lines) != len(expected) {
+		t.Fatalf("expected %d lines, got %d: %q", len(expected), len(lines), out.String())
+	}
+	for i, line := range lines {
+		if line != expected[i] {
+			t.Errorf("line %d: expected %s, got %s", i, expected[i], line)
+		}
+	}
+}
+
+func TestConvJSONLInvalid(t *testing.T) {
+	var msg bytes.Buffer
+	if err := jsonl2msgp(&msg, strings.NewReader("{\"a\":1}\n{not-json}\n")); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -43,6 +43,7 @@ const (
 )
 
 type delb struct {
+	t       *target
 	obck    *meta.Bck
 	present bool
 }
@@ -180,6 +181,10 @@ func (t *target) daeputActMsg(w http.ResponseWriter, r *http.Request) {
 		}
 	case apc.ActRotateLogs:
 		nlog.Flush(nlog.ActRotate)
+	case apc.ActRotateCert:
+		if err := t.rotateCert(msg); err != nil {
+			t.writeErr(w, r, err)
+		}
 	case apc.ActResetStats:
 		errorsOnly := msg.Value.(bool)
 		t.statsT.ResetStats(errorsOnly)
@@ -194,9 +199,17 @@ func (t *target) daeputActMsg(w http.ResponseWriter, r *http.Request) {
 		if !t.ensureIntraControl(w, r, true /* from primary */) {
 			return
 		}
+		timeout, err := drainTimeout(msg)
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
 		t.statsT.SetFlag(stats.NodeStateFlags, cos.MaintenanceMode)
 		t.termKaliveX(msg.Action, false)
-		t.shutdown(msg.Action)
+		go func() {
+			t.drain(timeout)
+			t.shutdown(msg.Action)
+		}()
 	case apc.ActRmNodeUnsafe:
 		if !t.ensureIntraControl(w, r, true /* from primary */) {
 			return
@@ -374,16 +387,29 @@ func (t *target) httpdaeget(w http.ResponseWriter, r *http.Request) {
 	)
 	switch what {
 	case apc.WhatNodeConfig, apc.WhatSmap, apc.WhatBMD, apc.WhatSmapVote,
-		apc.WhatSnode, apc.WhatLog, apc.WhatMetricNames:
+		apc.WhatSnode, apc.WhatLog, apc.WhatMetricNames, apc.WhatCapabilities, apc.WhatCertInfo:
+		t.htrun.httpdaeget(w, r, query, t /*htext*/)
+	case apc.WhatProfile:
+		// admin access: via proxy only
+		if err := t.isIntraCall(r.Header, false /*from primary*/); err != nil {
+			t.writeErr(w, r, err, http.StatusForbidden)
+			return
+		}
 		t.htrun.httpdaeget(w, r, query, t /*htext*/)
 	case apc.WhatSysInfo:
 		tsysinfo := apc.TSysInfo{MemCPUInfo: apc.GetMemCPU(), CapacityInfo: fs.CapStatusGetWhat()}
 		t.writeJSON(w, r, tsysinfo, httpdaeWhat)
 	case apc.WhatNodeStats:
+		flt, err := statsFlt(query)
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
 		ds := t.statsAndStatus()
-		daeStats := t.statsT.GetStats()
+		daeStats := t.statsT.GetStatsFlt(flt)
 		ds.Tracker = daeStats.Tracker
 		ds.Tcdf = daeStats.Tcdf
+		ds.Now = daeStats.Now
 		t.writeJSON(w, r, ds, httpdaeWhat)
 	case apc.WhatNodeStatsV322: // [backward compatibility] v3.22 and prior
 		ds := t.statsAndStatusV322()
@@ -406,6 +432,20 @@ func (t *target) httpdaeget(w http.ResponseWriter, r *http.Request) {
 		ds.Tcdf = daeStats.Tcdf
 		t.writeJSON(w, r, ds, httpdaeWhat)
 
+	case apc.WhatXactHistory, apc.WhatHeatmap:
+		var bck cmn.Bck
+		if uri := query.Get(apc.QparamBucket); uri != "" {
+			var err error
+			if bck, _, err = cmn.ParseBckObjectURI(uri, cmn.ParseURIOpts{IsQuery: true}); err != nil {
+				t.writeErr(w, r, err)
+				return
+			}
+		}
+		if what == apc.WhatHeatmap {
+			t.writeJSON(w, r, stats.GetHeatmap(&bck), httpdaeWhat)
+		} else {
+			t.writeJSON(w, r, xreg.History(&bck), httpdaeWhat)
+		}
 	case apc.WhatMountpaths:
 		var (
 			num    = fs.NumAvail()
@@ -432,6 +472,9 @@ func (t *target) httpdaeget(w http.ResponseWriter, r *http.Request) {
 		fs.DiskStats(tcdfExt.AllDiskStats, &tcdfExt.Tcdf, config, true)
 		t.writeJSON(w, r, tcdfExt, httpdaeWhat)
 
+	case apc.WhatNsUsage:
+		t.writeJSON(w, r, t.nsq.get(t.owner.bmd.get(), cmn.GCO.Get()), httpdaeWhat)
+
 	case apc.WhatRemoteAIS:
 		var (
 			config  = cmn.GCO.Get()
@@ -769,11 +812,18 @@ func (t *target) _syncBMD(newBMD *bucketMD, msg *aisMsg, payload msPayload, psi
 
 	// 3. delete, ignore errors
 	bmd.Range(nil, nil, func(obck *meta.Bck) bool {
-		f := &delb{obck: obck}
+		f := &delb{t: t, obck: obck}
 		newBMD.Range(nil, nil, f.do)
 		if !f.present {
 			rmbcks = append(rmbcks, obck)
-			if errD := fs.DestroyBucket("recv-bmd-"+msg.Action, obck.Bucket(), obck.Props.BID); errD != nil {
+			var errD error
+			if nbck := _trashMoved(newBMD, obck); nbck != nil {
+				// moved to or from trash (see prxtrash.go)
+				errD = fs.RenameBucketDirs(obck.Bucket(), nbck.Bucket())
+			} else {
+				errD = fs.DestroyBucket("recv-bmd-"+msg.Action, obck.Bucket(), obck.Props.BID)
+			}
+			if errD != nil {
 				destroyErrs = append(destroyErrs, errD)
 			}
 		}
@@ -786,6 +836,22 @@ func (t *target) _syncBMD(newBMD *bucketMD, msg *aisMsg, payload msPayload, psi
 	return
 }
 
+// same BID across the trash boundary
+func _trashMoved(newBMD *bucketMD, obck *meta.Bck) (nbck *meta.Bck) {
+	if !obck.IsAIS() {
+		return nil
+	}
+	provider := apc.AIS
+	newBMD.Range(&provider, nil, func(b *meta.Bck) bool {
+		if b.Props.BID == obck.Props.BID && b.Ns.IsTrash() != obck.Ns.IsTrash() {
+			nbck = b
+			return true
+		}
+		return false
+	})
+	return nbck
+}
+
 func (f *delb) do(nbck *meta.Bck) bool {
 	if !f.obck.Equal(nbck, false /*ignore BID*/, false /* ignore backend */) {
 		return false // keep going
@@ -799,8 +865,12 @@ func (f *delb) do(nbck *meta.Bck) bool {
 		// NOTE: apc.ActMakeNCopies takes care of itself
 	}
 	if f.obck.Props.EC.Enabled && !nbck.Props.EC.Enabled {
-		flt := xreg.Flt{Kind: apc.ActECEncode, Bck: nbck}
-		xreg.DoAbort(flt, errors.New("apply-bmd"))
+		for _, kind := range []string{apc.ActECEncode, apc.ActECReslice} {
+			xreg.DoAbort(xreg.Flt{Kind: kind, Bck: nbck}, errors.New("apply-bmd"))
+		}
+	}
+	if f.obck.IsOffline() && !nbck.IsOffline() {
+		go f.t.flushWriteBack(meta.CloneBck(nbck.Bucket())) // back online
 	}
 	return true // break
 }
@@ -810,6 +880,7 @@ func (t *target) _postBMD(newBMD *bucketMD, tag string, rmbcks []*meta.Bck) {
 	if len(rmbcks) > 0 {
 		errV := fmt.Errorf("[post-bmd] %s %s: remove bucket%s", tag, newBMD, cos.Plural(len(rmbcks)))
 		xreg.AbortAllBuckets(errV, rmbcks...)
+		t.chfeed.del(rmbcks...)
 		go func(bcks ...*meta.Bck) {
 			for _, b := range bcks {
 				core.UncacheBck(b)
@@ -909,7 +980,7 @@ func (t *target) ensureLatestBMD(msg *aisMsg, r *http.Request) {
 	}
 }
 
-func (t *target) getPrimaryBMD(renamed string) (bmd *bucketMD, err error) {
+func (t *target) getPrimaryBMD(renamed *cmn.Bck) (bmd *bucketMD, err error) {
 	smap := t.owner.smap.get()
 	if err = smap.validate(); err != nil {
 		return nil, cmn.NewErrFailedTo(t, "get-primary-bmd", smap, err)
@@ -922,8 +993,11 @@ func (t *target) getPrimaryBMD(renamed string) (bmd *bucketMD, err error) {
 		url     = psi.URL(cmn.NetIntraControl)
 		timeout = cmn.Rom.CplaneOperation()
 	)
-	if renamed != "" {
-		q.Set(whatRenamedLB, renamed)
+	if renamed.Name != "" {
+		q.Set(whatRenamedLB, renamed.Name)
+		if renamed.IsCloud() {
+			q.Set(apc.QparamProvider, renamed.Provider)
+		}
 	}
 	cargs := allocCargs()
 	{
@@ -958,7 +1032,7 @@ func (t *target) BMDVersionFixup(r *http.Request, bcks ...cmn.Bck) {
 		bck = bcks[0]
 	}
 	time.Sleep(200 * time.Millisecond)
-	newBucketMD, err := t.getPrimaryBMD(bck.Name)
+	newBucketMD, err := t.getPrimaryBMD(&bck)
 	if err != nil {
 		nlog.Errorln(err)
 		return
@@ -1135,7 +1209,14 @@ func (t *target) metasyncPost(w http.ResponseWriter, r *http.Request) {
 	}
 	switch msg.Action {
 	case apc.ActStartGFN:
-		reb.OnTimedGFN()
+		var d time.Duration
+		if msg.Value != nil {
+			if err := cos.MorphMarshal(msg.Value, &d); err != nil {
+				t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+				return
+			}
+		}
+		reb.OnTimedGFNFor(d)
 	case apc.ActStopGFN:
 		detail := meta.Tname(ntid) + " " + newSmap.String()
 		reb.OffTimedGFN(detail)
@@ -1160,6 +1241,7 @@ func (t *target) healthHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	t.uptime2hdr(w.Header())
+	g.certs.expiry2hdr(w.Header())
 
 	var (
 		getCii, getRebStatus bool
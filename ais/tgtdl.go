@@ -19,12 +19,22 @@ import (
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/ext/dload"
+	"github.com/NVIDIA/aistore/ext/etl"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/nl"
 	"github.com/NVIDIA/aistore/xact/xreg"
 	jsoniter "github.com/json-iterator/go"
 )
 
+// post-download ETL (see dload.Base.ETLName)
+func dlTransform(lom *core.LOM, etlName string, timeout time.Duration) (cos.ReadCloseSizer, error) {
+	comm, err := etl.GetCommunicator(etlName)
+	if err != nil {
+		return nil, err
+	}
+	return comm.OfflineTransform(lom, timeout)
+}
+
 // [METHOD] /v1/download
 func (t *target) downloadHandler(w http.ResponseWriter, r *http.Request) {
 	var (
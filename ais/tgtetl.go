@@ -151,10 +151,11 @@ func (t *target) stopETL(w http.ResponseWriter, r *http.Request, etlName string)
 	}
 }
 
-func (t *target) getETL(w http.ResponseWriter, r *http.Request, etlName string, lom *core.LOM) {
+func (t *target) getETL(w http.ResponseWriter, r *http.Request, dpq *dpq, lom *core.LOM) {
 	var (
-		comm etl.Communicator
-		err  error
+		comm    etl.Communicator
+		err     error
+		etlName = dpq.etlName
 	)
 	comm, err = etl.GetCommunicator(etlName)
 	if err != nil {
@@ -168,7 +169,16 @@ func (t *target) getETL(w http.ResponseWriter, r *http.Request, etlName string,
 		t.writeErr(w, r, err)
 		return
 	}
-	if err := comm.InlineTransform(w, r, lom); err != nil {
+	if err := comm.Degraded(); err != nil {
+		t.writeErr(w, r, err, http.StatusServiceUnavailable)
+		return
+	}
+	sect, ecode, err := t.etlSection(r, dpq, lom)
+	if err != nil {
+		t.writeErr(w, r, err, ecode)
+		return
+	}
+	if err := comm.InlineTransform(w, r, lom, sect); err != nil {
 		errV := cmn.NewErrETL(&cmn.ETLErrCtx{ETLName: etlName, PodName: comm.PodName(), SvcName: comm.SvcName()},
 			err.Error())
 		xetl := comm.Xact()
@@ -177,6 +187,49 @@ func (t *target) getETL(w http.ResponseWriter, r *http.Request, etlName string,
 	}
 }
 
+// GET with Range or archpath: transform only the requested part of the object
+// (single range, single archived file)
+func (t *target) etlSection(r *http.Request, dpq *dpq, lom *core.LOM) (*etl.Section, int, error) {
+	rng := r.Header.Get(cos.HdrRange)
+	if rng == "" && dpq.arch.path == "" {
+		if dpq.arch.regx != "" {
+			return nil, http.StatusBadRequest, cmn.NewErrUnsupp("transform multiple archived files", dpq._archstr())
+		}
+		return nil, 0, nil
+	}
+	if rng != "" && dpq.arch.path != "" {
+		return nil, http.StatusRequestedRangeNotSatisfiable, cmn.NewErrUnsupp("range-read archived file", dpq.arch.path)
+	}
+	if dpq.arch.path != "" {
+		return &etl.Section{Archpath: dpq.arch.path, Archmime: dpq.arch.mime}, 0, nil
+	}
+
+	// byte range: need the size
+	err := lom.Load(true /*cache it*/, false /*locked*/)
+	if cos.IsNotExist(err, 0) && lom.Bucket().IsRemote() {
+		if ecode, err := t.GetCold(r.Context(), lom, cmn.OwtGetLock); err != nil {
+			return nil, ecode, err
+		}
+		err = lom.Load(true, false)
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	size := lom.Lsize()
+	ranges, err := parseMultiRange(rng, size)
+	if err != nil {
+		return nil, http.StatusRequestedRangeNotSatisfiable, err
+	}
+	switch len(ranges) {
+	case 0:
+		return nil, 0, nil
+	case 1:
+		return &etl.Section{Offset: ranges[0].Start, Length: ranges[0].Length}, 0, nil
+	default:
+		return nil, http.StatusRequestedRangeNotSatisfiable, cmn.NewErrUnsupp("transform multi-range read of", lom.Cname())
+	}
+}
+
 func (t *target) logsETL(w http.ResponseWriter, r *http.Request, etlName string) {
 	logs, err := etl.PodLogs(etlName)
 	if err != nil {
@@ -263,6 +316,7 @@ func (t *target) getObjectETL(w http.ResponseWriter, r *http.Request) {
 		t.writeErr(w, r, err)
 		return
 	}
+	dpq.etlBypass = true // the transformer reads the original (see cmn.BckETLConf)
 	lom := core.AllocLOM(objName)
 	lom, err = t.getObject(w, r, dpq, bck, lom)
 	core.FreeLOM(lom)
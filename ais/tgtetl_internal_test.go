@@ -0,0 +1,52 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// (canonical key)
+func hdr(key, value string) http.Header {
+	h := http.Header{}
+	h.Set(key, value)
+	return h
+}
+
+func TestDefaultETL(t *testing.T) {
+	var (
+		tgt    = &target{}
+		bprops = &cmn.Bprops{ETL: cmn.BckETLConf{Name: "md5"}}
+		other  = &meta.Snode{DaeID: "t2", DaeType: apc.Target}
+		smap   = newSmap()
+	)
+	smap.addTarget(other)
+	tgt.owner.smap = newSmapOwner(cmn.GCO.Get())
+	tgt.owner.smap.put(smap)
+
+	tests := []struct {
+		name string
+		hdr  http.Header
+		dpq  *dpq
+		etl  string
+	}{
+		{"user", http.Header{}, &dpq{}, "md5"},
+		{"etl-bypass", http.Header{}, &dpq{etlBypass: true}, ""},
+		{"get-from-neighbor", hdr(apc.HdrCallerID, other.ID()), &dpq{isGFN: true}, ""},
+		{"t2t (read-through from clone)", hdr(apc.HdrCallerID, other.ID()), &dpq{}, ""},
+		{"unknown caller", hdr(apc.HdrCallerID, "t3"), &dpq{}, "md5"},
+		{"blob-download", hdr(apc.HdrBlobDownload, "true"), &dpq{}, ""},
+	}
+	for _, test := range tests {
+		if etl := tgt.dfltETL(test.hdr, test.dpq, bprops); etl != test.etl {
+			t.Errorf("%s: expected default ETL %q, got %q", test.name, test.etl, etl)
+		}
+	}
+}
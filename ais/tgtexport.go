@@ -0,0 +1,370 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"archive/tar"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	jsoniter "github.com/json-iterator/go"
+)
+
+//
+// Bucket export/import as a single (PAX) tar stream - to move buckets between clusters
+// that cannot attach to each other (compare with remote AIS and x-tcb)
+// - GET /v1/buckets/<bck>?action=export: the proxy redirects to the (bucket name) HRW target
+//   which then serializes its own part of the bucket followed by the parts of all the
+//   other targets (one target at a time, via intra-cluster GET w/ QparamExportLocal)
+// - PUT /v1/buckets/<bck>?action=import: same redirect; the receiving target reads the stream
+//   and PUTs each object to its HRW location
+// - object metadata travels as PAX records (below); atime - as tar ModTime
+// - on error in the middle of the export the response gets aborted, so that the client
+//   sees unexpected EOF rather than a seemingly valid (albeit truncated) tar
+//
+
+const (
+	paxVersion    = "AIS.version"
+	paxCksumType  = "AIS.cksum_type"
+	paxCksumValue = "AIS.cksum_value"
+	paxCustomMD   = "AIS.custom_md"
+)
+
+const wtagImport = "import" // work file tag
+
+type bckExport struct {
+	t   *target
+	bck *meta.Bck
+	tw  *tar.Writer
+	buf []byte
+	cnt int64
+}
+
+// (GET /v1/buckets/<bck>?action=export)
+func (t *target) bckExport(w http.ResponseWriter, r *http.Request, bckName string, query url.Values) {
+	local := cos.IsParseBool(query.Get(apc.QparamExportLocal))
+	if local {
+		if err := t.isIntraCall(r.Header, false); err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+	} else if isRedirect(query) == "" {
+		t.writeErrf(w, r, "%s: %s is expected to be redirected by a proxy", t, apc.BckExport)
+		return
+	}
+	bck, err := newBckFromQ(bckName, query, nil)
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	if err := bck.Init(t.owner.bmd); err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+
+	buf, slab := t.gmm.Alloc()
+	e := &bckExport{t: t, bck: bck, tw: tar.NewWriter(w), buf: buf}
+	w.Header().Set(cos.HdrContentType, cos.ContentTar)
+
+	err = e.local()
+	if err == nil && !local {
+		err = e.others()
+	}
+	slab.Free(buf)
+	if err != nil {
+		nlog.Errorln(t.String(), "export", bck.Cname(""), "failed:", err)
+		panic(http.ErrAbortHandler) // (see above)
+	}
+	if err := e.tw.Close(); err != nil {
+		nlog.Errorln(t.String(), "export", bck.Cname(""), "failed to finalize:", err)
+		return
+	}
+	if !local {
+		nlog.Infoln(t.String(), "exported", bck.Cname(""), "objects:", e.cnt)
+	}
+}
+
+func (e *bckExport) local() error {
+	opts := &fs.WalkBckOpts{
+		WalkOpts: fs.WalkOpts{CTs: []string{fs.ObjectType}, Callback: e.cb, Sorted: true},
+	}
+	opts.WalkOpts.Bck.Copy(e.bck.Bucket())
+	return fs.WalkBck(opts)
+}
+
+func (e *bckExport) cb(fqn string, de fs.DirEntry) error {
+	if de.IsDir() {
+		return nil
+	}
+	lom := core.AllocLOM("")
+	err := e.write(lom, fqn)
+	core.FreeLOM(lom)
+	return err
+}
+
+func (e *bckExport) write(lom *core.LOM, fqn string) error {
+	if err := lom.InitFQN(fqn, e.bck.Bucket()); err != nil {
+		return nil // skip
+	}
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if cos.IsNotExist(err, 0) {
+			return nil // deleted in the meantime
+		}
+		return err
+	}
+	if lom.IsCopy() {
+		return nil
+	}
+	fh, err := lom.Open()
+	if err != nil {
+		return err
+	}
+	hdr := &tar.Header{
+		Typeflag:   tar.TypeReg,
+		Name:       lom.ObjName,
+		Size:       lom.Lsize(),
+		Mode:       int64(cos.PermRWRR),
+		ModTime:    lom.Atime(),
+		Format:     tar.FormatPAX,
+		PAXRecords: attrsToPAX(lom),
+	}
+	if err = e.tw.WriteHeader(hdr); err == nil {
+		_, err = io.CopyBuffer(e.tw, fh, e.buf)
+	}
+	cos.Close(fh)
+	if err == nil {
+		e.cnt++
+	}
+	return err
+}
+
+// pull and re-serialize all other targets' parts, one target at a time
+func (e *bckExport) others() error {
+	var (
+		t    = e.t
+		smap = t.owner.smap.get()
+	)
+	for _, tsi := range smap.Tmap {
+		if tsi.ID() == t.SID() || tsi.InMaintOrDecomm() {
+			continue
+		}
+		if err := e.pull(tsi, smap); err != nil {
+			return fmt.Errorf("%s: failed to export from %s: %w", t, tsi, err)
+		}
+	}
+	return nil
+}
+
+func (e *bckExport) pull(tsi *meta.Snode, smap *smapX) error {
+	query := e.bck.NewQuery()
+	query.Set(apc.QparamAction, apc.BckExport)
+	query.Set(apc.QparamExportLocal, "true")
+	reqArgs := cmn.HreqArgs{
+		Method: http.MethodGet,
+		Base:   tsi.URL(cmn.NetIntraData),
+		Path:   apc.URLPathBuckets.Join(e.bck.Name),
+		Query:  query,
+	}
+	req, err := reqArgs.Req()
+	if err != nil {
+		return err
+	}
+	req.Header.Set(apc.HdrCallerID, e.t.SID())
+	req.Header.Set(apc.HdrCallerName, e.t.si.Name())
+	req.Header.Set(apc.HdrCallerSmapVer, smap.vstr)
+
+	resp, err := g.client.data.Do(req) //nolint:bodyclose // closed below
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("status %d", resp.StatusCode)
+	}
+	tr := tar.NewReader(resp.Body)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		hdr.Format = tar.FormatPAX
+		if err := e.tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if _, err := io.CopyBuffer(e.tw, tr, e.buf); err != nil {
+			return err
+		}
+		e.cnt++
+	}
+}
+
+//
+// import
+//
+
+// (PUT /v1/buckets/<bck>?action=import)
+func (t *target) httpbckput(w http.ResponseWriter, r *http.Request) {
+	apiItems, err := t.parseURL(w, r, apc.URLPathBuckets.L, 1, false)
+	if err != nil {
+		return
+	}
+	query := r.URL.Query()
+	if query.Get(apc.QparamAction) != apc.BckImport {
+		t.writeErrf(w, r, "%s: invalid PUT %s", t, r.URL.Path)
+		return
+	}
+	if isRedirect(query) == "" {
+		t.writeErrf(w, r, "%s: %s is expected to be redirected by a proxy", t, apc.BckImport)
+		return
+	}
+	bck, err := newBckFromQ(apiItems[0], query, nil)
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	if err := bck.Init(t.owner.bmd); err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	var (
+		tr     = tar.NewReader(r.Body)
+		smap   = t.owner.smap.get()
+		config = cmn.GCO.Get()
+		cnt    int64
+	)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.writeErr(w, r, fmt.Errorf("%s: failed to import %s (objects so far: %d): %w", t, bck, cnt, err))
+			return
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		if err := t.importObj(bck, hdr, tr, smap, config); err != nil {
+			t.writeErr(w, r, fmt.Errorf("%s: failed to import %s (objects so far: %d): %w", t, bck, cnt, err))
+			return
+		}
+		cnt++
+	}
+	nlog.Infoln(t.String(), "imported", bck.Cname(""), "objects:", cnt)
+}
+
+func (t *target) importObj(bck *meta.Bck, hdr *tar.Header, r io.Reader, smap *smapX, config *cmn.Config) error {
+	lom := core.AllocLOM(hdr.Name)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		return err
+	}
+	oa := paxToAttrs(hdr)
+	tsi, local, err := lom.HrwTarget(&smap.Smap)
+	if err != nil {
+		return err
+	}
+	if local {
+		lom.CopyAttrs(oa, true /*skip cksum*/)
+		params := &core.PutParams{
+			Reader:  io.NopCloser(io.LimitReader(r, hdr.Size)),
+			Cksum:   oa.Cksum,
+			Atime:   time.Unix(0, oa.Atime),
+			WorkTag: wtagImport,
+			Size:    hdr.Size,
+			OWT:     cmn.OwtCopy,
+		}
+		return t.PutObject(lom, params)
+	}
+
+	// send to the HRW target (compare with coi.put)
+	var (
+		rhdr  = make(http.Header, 8)
+		query = bck.NewQuery()
+	)
+	cmn.ToHeader(oa, rhdr, hdr.Size)
+	rhdr.Set(apc.HdrT2TPutterID, t.SID())
+	query.Set(apc.QparamOWT, cmn.OwtCopy.ToS())
+	reqArgs := cmn.HreqArgs{
+		Method: http.MethodPut,
+		Base:   tsi.URL(cmn.NetIntraData),
+		Path:   apc.URLPathObjects.Join(bck.Name, lom.ObjName),
+		Query:  query,
+		Header: rhdr,
+		BodyR:  io.NopCloser(io.LimitReader(r, hdr.Size)),
+	}
+	req, _, cancel, err := reqArgs.ReqWithTimeout(config.Timeout.SendFile.D())
+	if err != nil {
+		return err
+	}
+	defer cancel()
+	resp, err := g.client.data.Do(req) //nolint:bodyclose // closed below
+	if err != nil {
+		return cmn.NewErrFailedTo(t, "import "+lom.Cname(), tsi, err)
+	}
+	cos.DrainReader(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return cmn.NewErrFailedTo(t, "import "+lom.Cname(), tsi, fmt.Errorf("status %d", resp.StatusCode))
+	}
+	return nil
+}
+
+//
+// object metadata <=> PAX records
+//
+
+func attrsToPAX(oah cos.OAH) map[string]string {
+	recs := make(map[string]string, 4)
+	if v := oah.Version(true); v != "" {
+		recs[paxVersion] = v
+	}
+	if cksum := oah.Checksum(); !cksum.IsEmpty() {
+		recs[paxCksumType], recs[paxCksumValue] = cksum.Get()
+	}
+	if md := oah.GetCustomMD(); len(md) > 0 {
+		recs[paxCustomMD] = cos.UnsafeS(cos.MustMarshal(md))
+	}
+	return recs
+}
+
+func paxToAttrs(hdr *tar.Header) *cmn.ObjAttrs {
+	oa := &cmn.ObjAttrs{Size: hdr.Size}
+	if !hdr.ModTime.IsZero() {
+		oa.Atime = hdr.ModTime.UnixNano()
+	} else {
+		oa.Atime = time.Now().UnixNano()
+	}
+	if v, ok := hdr.PAXRecords[paxVersion]; ok {
+		oa.SetVersion(v)
+	}
+	if ty, ok := hdr.PAXRecords[paxCksumType]; ok {
+		oa.SetCksum(ty, hdr.PAXRecords[paxCksumValue])
+	}
+	if s, ok := hdr.PAXRecords[paxCustomMD]; ok {
+		md := make(cos.StrKVs, 4)
+		if err := jsoniter.Unmarshal(cos.UnsafeB(s), &md); err == nil {
+			oa.CustomMD = md
+		} else {
+			nlog.Warningln("import", hdr.Name, "invalid custom metadata:", err)
+		}
+	}
+	return oa
+}
@@ -0,0 +1,77 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+func TestExportPAXRoundtrip(t *testing.T) {
+	var (
+		buf     bytes.Buffer
+		content = []byte("0123456789")
+		atime   = time.Now().Truncate(time.Second)
+		oa      = &cmn.ObjAttrs{Size: int64(len(content))}
+	)
+	oa.SetVersion("3")
+	oa.SetCksum(cos.ChecksumXXHash, "0123abcd")
+	oa.SetCustomMD(cos.StrKVs{"source": "aws", "etag": "\"abc\""})
+
+	tw := tar.NewWriter(&buf)
+	hdr := &tar.Header{
+		Typeflag:   tar.TypeReg,
+		Name:       "a/b/c.bin",
+		Size:       oa.Size,
+		Mode:       int64(cos.PermRWRR),
+		ModTime:    atime,
+		Format:     tar.FormatPAX,
+		PAXRecords: attrsToPAX(oa),
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tw.Write(content); err != nil {
+		t.Fatal(err)
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	tr := tar.NewReader(&buf)
+	rhdr, err := tr.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := io.ReadAll(tr)
+	if err != nil || !bytes.Equal(b, content) {
+		t.Fatalf("content mismatch: %q, %v", b, err)
+	}
+	ra := paxToAttrs(rhdr)
+	if rhdr.Name != hdr.Name || ra.Size != oa.Size {
+		t.Fatalf("name/size mismatch: %q %d vs %q %d", rhdr.Name, ra.Size, hdr.Name, oa.Size)
+	}
+	if ra.Version() != oa.Version() {
+		t.Errorf("version: %q vs %q", ra.Version(), oa.Version())
+	}
+	if !ra.Cksum.Equal(oa.Cksum) {
+		t.Errorf("checksum: %s vs %s", ra.Cksum, oa.Cksum)
+	}
+	if len(ra.CustomMD) != len(oa.CustomMD) || ra.CustomMD["etag"] != oa.CustomMD["etag"] {
+		t.Errorf("custom: %v vs %v", ra.CustomMD, oa.CustomMD)
+	}
+	if ra.Atime != atime.UnixNano() {
+		t.Errorf("atime: %d vs %d", ra.Atime, atime.UnixNano())
+	}
+	if _, err := tr.Next(); err != io.EOF {
+		t.Errorf("expected EOF, got %v", err)
+	}
+}
@@ -141,7 +141,7 @@ func (goi *getOI) _fini(revert string, fullSize, txSize int64) error {
 	goi.rltime = mono.SinceNano(goi.rstarttime)
 	lom := goi.lom
 	if revert != "" {
-		if err := cos.RemoveFile(revert); err != nil {
+		if err := lom.Mountpath().Remove(revert); err != nil {
 			nlog.InfoDepth(1, ftcg+"(rm-revert)", lom, err)
 		}
 	}
@@ -260,14 +260,15 @@ func (t *target) _promLocal(params *core.PromoteParams, lom *core.LOM) (fileSize
 	if err = lom.Load(true /*cache it*/, false /*locked*/); err == nil && !params.OverwriteDst {
 		return
 	}
-	if params.DeleteSrc {
-		// To use `params.SrcFQN` as `workFQN`, make sure both are
+	if params.DeleteSrc || params.HardLink {
+		// To use `params.SrcFQN` as `workFQN` (or to hard-link it), make sure both are
 		// located on the same filesystem. About "filesystem sharing" see also:
 		// * https://github.com/NVIDIA/aistore/blob/main/docs/overview.md#terminology
 		mi, _, err := fs.FQN2Mpath(params.SrcFQN)
 		extraCopy = err != nil || !mi.FS.Equal(lom.Mountpath().FS)
 	}
-	if extraCopy {
+	switch {
+	case extraCopy:
 		workFQN = fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfilePut)
 		buf, slab := t.gmm.Alloc()
 		fileSize, cksum, err = cos.CopyFile(params.SrcFQN, workFQN, buf, lom.CksumType())
@@ -275,8 +276,40 @@ func (t *target) _promLocal(params *core.PromoteParams, lom *core.LOM) (fileSize
 		if err != nil {
 			return
 		}
+		if params.Verify {
+			if err = _prmVerify(lom, workFQN, cksum); err != nil {
+				if nested := cos.RemoveFile(workFQN); nested != nil {
+					nlog.Errorln("nested err:", nested)
+				}
+				return
+			}
+		}
 		lom.SetCksum(cksum.Clone())
-	} else {
+	case params.HardLink && !params.DeleteSrc:
+		// zero-copy: link the source as `workFQN`
+		var fi os.FileInfo
+		if fi, err = os.Stat(params.SrcFQN); err != nil {
+			if os.IsNotExist(err) {
+				err = nil
+			}
+			return
+		}
+		fileSize = fi.Size()
+		workFQN = fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfilePut)
+		if err = os.Link(params.SrcFQN, workFQN); err != nil {
+			return
+		}
+		clone := lom.CloneMD(workFQN)
+		cksum, err = clone.ComputeCksum(lom.CksumType())
+		core.FreeLOM(clone)
+		if err != nil {
+			if nested := cos.RemoveFile(workFQN); nested != nil {
+				nlog.Errorln("nested err:", nested)
+			}
+			return
+		}
+		lom.SetCksum(cksum.Clone())
+	default:
 		// avoid extra copy: use the source as `workFQN`
 		var fi os.FileInfo
 		fi, err = os.Stat(params.SrcFQN)
@@ -326,6 +359,23 @@ func (t *target) _promLocal(params *core.PromoteParams, lom *core.LOM) (fileSize
 	return
 }
 
+// compare the checksum computed while copying with the one computed from the (copied) workfile
+func _prmVerify(lom *core.LOM, workFQN string, cksum *cos.CksumHash) error {
+	if cksum == nil || cksum.Type() == cos.ChecksumNone {
+		return nil
+	}
+	clone := lom.CloneMD(workFQN)
+	computed, err := clone.ComputeCksum(cksum.Type())
+	core.FreeLOM(clone)
+	if err != nil {
+		return err
+	}
+	if !computed.Equal(cksum.Clone()) {
+		return cos.NewErrDataCksum(computed.Clone(), cksum.Clone(), workFQN+" (promote verification)")
+	}
+	return nil
+}
+
 // TODO: use DM streams
 // TODO: Xact.InObjsAdd on the receive side
 func (t *target) _promRemote(params *core.PromoteParams, lom *core.LOM, tsi *meta.Snode, smap *smapX) (int64, error) {
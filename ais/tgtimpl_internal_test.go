@@ -0,0 +1,121 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+const testPromote = "bck-promote"
+
+// (checksummed)
+func prmTestBck() *meta.Bck {
+	bck := meta.NewBck(testPromote, apc.AIS, cmn.NsGlobal)
+	bmd := t.owner.bmd.get().clone()
+	if _, present := bmd.Get(bck); !present {
+		bmd.add(bck, &cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumXXHash}})
+		t.owner.bmd.putPersist(bmd, nil)
+		fs.CreateBucket(bck.Bucket(), false /*nilbmd*/)
+	}
+	return bck
+}
+
+func prmLocal(bck *meta.Bck, lom *core.LOM, args *apc.PromoteArgs) (int64, error) {
+	params := &core.PromoteParams{Bck: bck, Config: cmn.GCO.Get(), PromoteArgs: *args}
+	size, _, err := t._promLocal(params, lom)
+	return size, err
+}
+
+func TestPromoteHardLink(t *testing.T) {
+	const content = "hard-linked content"
+	bck := prmTestBck()
+
+	// source on the same filesystem as the destination mountpath
+	src := filepath.Join(testMountpath, "prm-src")
+	if err := os.WriteFile(src, []byte(content), cos.PermRWR); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(src)
+
+	lom := core.AllocLOM("prm-lnk")
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		t.Fatal(err)
+	}
+	size, err := prmLocal(bck, lom, &apc.PromoteArgs{SrcFQN: src, ObjName: lom.ObjName, HardLink: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size != int64(len(content)) {
+		t.Fatalf("expected size %d, got %d", len(content), size)
+	}
+
+	// zero-copy: the source remains, and shares the content with the object
+	sfi, err := os.Stat(src)
+	if err != nil {
+		t.Fatalf("expected the source to remain: %v", err)
+	}
+	ofi, err := os.Stat(lom.FQN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !os.SameFile(sfi, ofi) {
+		t.Fatalf("expected %s to be hard-linked to %s", lom.FQN, src)
+	}
+	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+		t.Fatal(err)
+	}
+	if ty := lom.Checksum().Type(); ty != cos.ChecksumXXHash {
+		t.Fatalf("expected %s checksum, got %q", cos.ChecksumXXHash, ty)
+	}
+}
+
+func TestPromoteVerify(t *testing.T) {
+	bck := prmTestBck()
+	src := filepath.Join(testMountpath, "prm-src-vfy")
+	if err := os.WriteFile(src, []byte("promoted content"), cos.PermRWR); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(src)
+
+	lom := core.AllocLOM("prm-vfy")
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		t.Fatal(err)
+	}
+	workFQN := fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfilePut)
+	defer os.Remove(workFQN)
+
+	buf := make([]byte, cos.KiB)
+	_, cksum, err := cos.CopyFile(src, workFQN, buf, cos.ChecksumXXHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := _prmVerify(lom, workFQN, cksum); err != nil {
+		t.Fatal(err)
+	}
+
+	// corrupted copy
+	if err := os.WriteFile(workFQN, []byte("corrupted content"), cos.PermRWR); err != nil {
+		t.Fatal(err)
+	}
+	if err := _prmVerify(lom, workFQN, cksum); !cos.IsErrBadCksum(err) {
+		t.Fatalf("expected checksum mismatch, got %v", err)
+	}
+
+	// nothing to verify against
+	if err := _prmVerify(lom, workFQN, nil); err != nil {
+		t.Fatal(err)
+	}
+}
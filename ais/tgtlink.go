@@ -0,0 +1,77 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Link (alias) objects (apc.ActLinkObj):
+// - a link is a zero-size object with cmn.LinkObjMD custom property that names the object it points to -
+//   in the same or a different bucket, including remote buckets and buckets in attached AIS clusters
+// - GET resolves links transparently: the target reads through from the target that owns
+//   the object the link points to (compare w/ getFromClone); links to links are followed
+//   up to maxLinkHops (loop protection)
+// - creating a link requires read access to the bucket it points to (the proxy checks);
+//   subsequent GETs via the link do not re-check it
+// - HEAD, list-objects, and all other operations do not resolve links - they see the link itself
+// - dangling links are allowed (GET fails with 404)
+
+const maxLinkHops = 4
+
+func (t *target) objLink(lom *core.LOM, msg *apc.ActMsg, query url.Values) error {
+	bckTo, err := newBckFromQuname(query, true /*required*/)
+	if err != nil {
+		return err
+	}
+	objNameTo := cos.Left(msg.Name, lom.ObjName)
+	lom.SetCustomKey(cmn.LinkObjMD, bckTo.Cname(objNameTo))
+
+	params := core.AllocPutParams()
+	{
+		params.WorkTag = fs.WorkfilePut
+		params.Reader = http.NoBody
+		params.Atime = time.Now()
+		params.OWT = cmn.OwtPut
+	}
+	err = t.PutObject(lom, params)
+	core.FreePutParams(params)
+	return err
+}
+
+func (goi *getOI) getFromLink(to string) (int, error) {
+	var hops int
+	if s := goi.req.Header.Get(apc.HdrLinkHops); s != "" {
+		hops, _ = strconv.Atoi(s)
+	}
+	if hops >= maxLinkHops {
+		return http.StatusLoopDetected, fmt.Errorf("%s: too many levels of links (%d)", goi.lom.Cname(), hops+1)
+	}
+	bck, objName, err := cmn.ParseBckObjectURI(to, cmn.ParseURIOpts{})
+	if err == nil && objName == "" {
+		err = errors.New("missing object name")
+	}
+	if err != nil {
+		return http.StatusUnprocessableEntity, fmt.Errorf("%s: invalid link %q: %v", goi.lom.Cname(), to, err)
+	}
+	dst := meta.CloneBck(&bck)
+	if err := dst.Init(goi.t.owner.bmd); err != nil {
+		return 0, err // (e.g., dangling link to a since destroyed bucket)
+	}
+	hdr := http.Header{apc.HdrLinkHops: []string{strconv.Itoa(hops + 1)}}
+	return goi.readThrough(dst, objName, "link to "+to, hdr)
+}
@@ -0,0 +1,202 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"os"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Memory-mapped read path (see cmn.MmapConf):
+// - GETs of small objects (up to mmap.max_obj_size) are counted per object; an object that
+//   gets mmap.min_hits GETs within a housekeeping interval is hot and gets memory-mapped,
+//   as long as the total stays within mmap.budget and memory pressure is low
+// - subsequent (whole-object) GETs transmit directly from the mapping - no open/read/close
+// - a cached object is valid while its size, checksum, and version match the LOM; PUT and
+//   DELETE evict it right away
+// - idle (mmIdle) objects are evicted; under high memory pressure - all of them
+// - hit vs miss counters: get.mmap.hit.n vs get.mmap.miss.n
+
+const (
+	mmHkIval      = time.Minute
+	mmIdle        = 10 * time.Minute
+	mmMaxTracked  = 64 * 1024 // max objects counted (per housekeeping interval)
+	mmAdmitting   = -1        // (freq) being mapped
+	mmNoAdmission = memsys.PressureModerate
+)
+
+type (
+	mmcache struct {
+		t    *target
+		m    map[string]*mmentry // by FQN
+		freq map[string]int      // GET counts
+		size int64               // total size of m
+		mu   sync.Mutex
+	}
+	mmentry struct {
+		cksum    *cos.Cksum
+		version  string
+		data     []byte
+		fqn      string
+		last     atomic.Int64 // mono time of the last access
+		refc     atomic.Int32
+		evicted  atomic.Bool
+		unmapped atomic.Bool
+	}
+)
+
+func (mc *mmcache) init(t *target) {
+	mc.t = t
+	mc.m = make(map[string]*mmentry, 64)
+	mc.freq = make(map[string]int, 64)
+	hk.Reg("mmap"+hk.NameSuffix, mc.housekeep, mmHkIval)
+}
+
+// (GET datapath) returns referenced mapping or nil; must be followed by `put`
+func (mc *mmcache) get(lom *core.LOM, config *cmn.Config) *mmentry {
+	size := lom.Lsize()
+	if !config.Mmap.Enabled || size == 0 || size > int64(config.Mmap.MaxObjSize) || lom.IsChunked() {
+		return nil
+	}
+	mc.mu.Lock()
+	if e, ok := mc.m[lom.FQN]; ok {
+		if e.valid(lom) {
+			e.refc.Inc()
+			e.last.Store(mono.NanoTime())
+			mc.mu.Unlock()
+			mc.t.statsT.Inc(stats.MmapHitCount)
+			return e
+		}
+		mc._evict(e)
+	}
+	mc.t.statsT.Inc(stats.MmapMissCount)
+	n, ok := mc.freq[lom.FQN]
+	switch {
+	case n == mmAdmitting:
+		mc.mu.Unlock()
+		return nil
+	case !ok && len(mc.freq) >= mmMaxTracked:
+		mc.mu.Unlock()
+		return nil
+	case n+1 < config.Mmap.MinHits || mc.size+size > int64(config.Mmap.Budget):
+		mc.freq[lom.FQN] = n + 1
+		mc.mu.Unlock()
+		return nil
+	}
+	if mc.t.gmm.Pressure() >= mmNoAdmission {
+		mc.mu.Unlock()
+		return nil
+	}
+	mc.freq[lom.FQN] = mmAdmitting
+	mc.mu.Unlock()
+
+	// admit (outside the lock; lom is rlocked)
+	e, err := mmap(lom)
+	mc.mu.Lock()
+	delete(mc.freq, lom.FQN)
+	if err != nil {
+		mc.mu.Unlock()
+		nlog.Warningln(mc.t.String(), "failed to mmap", lom.Cname()+":", err)
+		return nil
+	}
+	if _, ok := mc.m[lom.FQN]; ok || mc.size+size > int64(config.Mmap.Budget) {
+		mc.mu.Unlock()
+		e.unmap()
+		return nil
+	}
+	mc.m[lom.FQN] = e
+	mc.size += size
+	mc.mu.Unlock()
+	return nil // (this GET proceeds via regular read)
+}
+
+func (*mmcache) put(e *mmentry) {
+	if e.refc.Dec() == 0 && e.evicted.Load() {
+		e.unmap()
+	}
+}
+
+// (upon PUT and DELETE)
+func (mc *mmcache) evict(fqn string) {
+	mc.mu.Lock()
+	if e, ok := mc.m[fqn]; ok {
+		mc._evict(e)
+	}
+	mc.mu.Unlock()
+}
+
+// under lock
+func (mc *mmcache) _evict(e *mmentry) {
+	delete(mc.m, e.fqn)
+	mc.size -= int64(len(e.data))
+	e.evicted.Store(true)
+	if e.refc.Load() == 0 {
+		e.unmap()
+	}
+}
+
+func (mc *mmcache) housekeep() time.Duration {
+	var (
+		config = cmn.GCO.Get()
+		now    = mono.NanoTime()
+		all    = !config.Mmap.Enabled || mc.t.gmm.Pressure() >= memsys.PressureHigh
+	)
+	mc.mu.Lock()
+	clear(mc.freq)
+	for _, e := range mc.m {
+		if all || time.Duration(now-e.last.Load()) > mmIdle || mc.size > int64(config.Mmap.Budget) {
+			mc._evict(e)
+		}
+	}
+	mc.mu.Unlock()
+	return mmHkIval
+}
+
+/////////////
+// mmentry //
+/////////////
+
+func mmap(lom *core.LOM) (*mmentry, error) {
+	fh, err := os.Open(lom.FQN)
+	if err != nil {
+		return nil, err
+	}
+	data, err := syscall.Mmap(int(fh.Fd()), 0, int(lom.Lsize()), syscall.PROT_READ, syscall.MAP_SHARED)
+	cos.Close(fh) // (the mapping remains valid)
+	if err != nil {
+		return nil, err
+	}
+	e := &mmentry{fqn: lom.FQN, data: data, cksum: lom.Checksum().Clone(), version: lom.Version()}
+	e.last.Store(mono.NanoTime())
+	return e, nil
+}
+
+func (e *mmentry) valid(lom *core.LOM) bool {
+	// (not using cos.Cksum.Equal that fails when there's no checksum)
+	cksum := lom.Checksum()
+	return int64(len(e.data)) == lom.Lsize() && e.version == lom.Version() &&
+		e.cksum.Type() == cksum.Type() && e.cksum.Value() == cksum.Value()
+}
+
+func (e *mmentry) unmap() {
+	if !e.unmapped.CAS(false, true) {
+		return
+	}
+	if err := syscall.Munmap(e.data); err != nil {
+		nlog.Errorln("failed to munmap", e.fqn+":", err)
+	}
+}
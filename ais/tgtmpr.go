@@ -0,0 +1,201 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Cold GET via parallel range reads (see cmn.ColdGetConf):
+// - the first part is read from the (already open) cold-GET reader
+// - the remaining parts are range-read concurrently, each into its own SGL
+// - parts are consumed strictly in order; each consumed part gets freed, making room
+//   for the next one - at most `num_workers` parts are in flight (or buffered) at any time
+// - the resulting reader replaces the original one (`res.R`) - all cold-GET flows remain unchanged
+
+const mprRetries = 1 // per part
+
+type (
+	mprPart struct {
+		sgl  *memsys.SGL
+		err  error
+		done chan struct{}
+	}
+	mpReader struct {
+		ctx      context.Context
+		cancel   context.CancelFunc
+		backend  core.Backend
+		lom      *core.LOM
+		first    io.ReadCloser // part #0
+		mm       *memsys.MMSA
+		queue    chan *mprPart // scheduled parts, in order
+		part     *mprPart      // current
+		rd       io.Reader     // current part's reader
+		wg       sync.WaitGroup
+		size     int64
+		partSize int64
+		roff     int64 // total bytes read
+		pend     int64 // current part's end offset
+		closed   bool
+	}
+)
+
+// interface guard
+var _ io.ReadCloser = (*mpReader)(nil)
+
+// wrap cold-GET reader when enabled and the object is large enough
+func (goi *getOI) mpr(res *core.GetReaderResult, backend core.Backend) {
+	c := &cmn.GCO.Get().ColdGet
+	if !c.Enabled || res.Size < int64(c.Threshold) {
+		return
+	}
+	r := &mpReader{
+		backend:  backend,
+		lom:      goi.lom,
+		first:    res.R,
+		mm:       goi.t.gmm,
+		queue:    make(chan *mprPart, c.NumWorkers-1),
+		size:     res.Size,
+		partSize: int64(c.PartSize),
+	}
+	r.ctx, r.cancel = context.WithCancel(goi.ctx)
+	r.rd = io.LimitReader(r.first, r.partSize)
+	r.pend = r.partSize
+
+	r.wg.Add(1)
+	go r.schedule()
+
+	res.R = r
+	if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+		nlog.Infoln("cold-GET", goi.lom.Cname(), "size", res.Size, "via parallel range reads")
+	}
+}
+
+func (r *mpReader) schedule() {
+	defer func() {
+		close(r.queue)
+		r.wg.Done()
+	}()
+	for off := r.partSize; off < r.size; off += r.partSize {
+		part := &mprPart{done: make(chan struct{})}
+		select {
+		case r.queue <- part:
+		case <-r.ctx.Done():
+			return
+		}
+		r.wg.Add(1)
+		go r.fetch(part, off, min(r.partSize, r.size-off))
+	}
+}
+
+func (r *mpReader) fetch(part *mprPart, off, length int64) {
+	defer func() {
+		close(part.done)
+		r.wg.Done()
+	}()
+	for i := 0; ; i++ {
+		err := r._fetch(part, off, length)
+		if err == nil {
+			return
+		}
+		if i >= mprRetries || r.ctx.Err() != nil {
+			part.err = err
+			return
+		}
+	}
+}
+
+func (r *mpReader) _fetch(part *mprPart, off, length int64) error {
+	res := r.backend.GetObjReader(r.ctx, r.lom, off, length)
+	if res.Err != nil {
+		return res.Err
+	}
+	sgl := r.mm.NewSGL(length)
+	n, err := sgl.ReadFrom(res.R)
+	cos.Close(res.R)
+	if err == nil && n != length {
+		err = fmt.Errorf("%s: range [%d, %d) - short read (%d)", r.lom.Cname(), off, off+length, n)
+	}
+	if err != nil {
+		sgl.Free()
+		return err
+	}
+	part.sgl = sgl
+	return nil
+}
+
+func (r *mpReader) Read(b []byte) (n int, err error) {
+	for {
+		n, err = r.rd.Read(b)
+		r.roff += int64(n)
+		if err != io.EOF {
+			return n, err
+		}
+		if err = r.next(); err != nil {
+			return n, err
+		}
+		if n > 0 {
+			return n, nil
+		}
+	}
+}
+
+// advance to the next part (in order)
+func (r *mpReader) next() error {
+	if r.roff != min(r.pend, r.size) {
+		return fmt.Errorf("%s: premature eof at offset %d (expected %d)", r.lom.Cname(), r.roff, min(r.pend, r.size))
+	}
+	if r.part == nil {
+		cos.Close(r.first)
+		r.first = nil
+	} else {
+		r.part.sgl.Free()
+		r.part = nil
+	}
+	if r.roff >= r.size {
+		return io.EOF
+	}
+	part, ok := <-r.queue
+	if !ok {
+		return fmt.Errorf("%s: cold-GET aborted at offset %d", r.lom.Cname(), r.roff)
+	}
+	<-part.done
+	if part.err != nil {
+		return part.err
+	}
+	r.part, r.rd = part, part.sgl
+	r.pend += r.partSize
+	return nil
+}
+
+func (r *mpReader) Close() error {
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+	r.cancel()
+	if r.first != nil {
+		cos.Close(r.first)
+	}
+	r.wg.Wait()
+	if r.part != nil {
+		r.part.sgl.Free()
+	}
+	for part := range r.queue {
+		if part.sgl != nil {
+			part.sgl.Free()
+		}
+	}
+	return nil
+}
@@ -13,7 +13,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
@@ -39,12 +41,15 @@ import (
 	"github.com/NVIDIA/aistore/transport"
 	"github.com/NVIDIA/aistore/transport/bundle"
 	"github.com/NVIDIA/aistore/xact/xreg"
+	"github.com/NVIDIA/aistore/xact/xs"
 )
 
 //
 // PUT, GET, APPEND (to file | to archive), and COPY object
 //
 
+const ecDelegateTries = 2 // max number of alternate targets to read EC-sliced object (see ecDelegate)
+
 type (
 	putOI struct {
 		oreq       *http.Request
@@ -60,6 +65,7 @@ type (
 		ltime      int64         // mono.NanoTime, to measure latency
 		rltime     int64         // mono.NanoTime, to measure remote bucket latency
 		size       int64         // aka Content-Length
+		expires    int64         // expiration time (unix nanoseconds) if PUT with TTL
 		owt        cmn.OWT       // object write transaction enum { OwtPut, ..., OwtGet* }
 		restful    bool          // being invoked via RESTful API
 		t2t        bool          // by another target
@@ -67,6 +73,8 @@ type (
 		skipVC     bool          // skip loading existing Version and skip comparing Checksums (skip VC)
 		coldGET    bool          // (one implication: proceed to write)
 		remoteErr  bool          // to exclude `putRemote` errors when counting soft IO errors
+		trailer    bool          // checksum value arrives in the HTTP trailer (chunked PUT of unknown length)
+		createOnly bool          // put-if-absent ("If-None-Match: *"): fail with 412 if exists
 	}
 
 	getOI struct {
@@ -88,6 +96,7 @@ type (
 		cold       bool       // true if executed backend.Get
 		latestVer  bool       // QparamLatestVer || 'versioning.*_warm_get'
 		isIOErr    bool       // to count GET error as a "IO error"; see `Trunner._softErrs()`
+		ecFailed   bool       // failed to EC-restore (see ecDelegate)
 	}
 
 	// textbook append: (packed) handle and control structure (see also `putA2I` arch below)
@@ -147,9 +156,25 @@ func (poi *putOI) do(resphdr http.Header, r *http.Request, dpq *dpq) (int, error
 		poi.cksumToUse = poi.lom.ObjAttrs().FromHeader(r.Header)
 		poi.owt = cmn.OwtPut // default
 	}
+	if poi.expires != 0 {
+		poi.lom.SetCustomKey(cmn.ExpiresObjMD, cos.UnixNano2S(poi.expires))
+	} else {
+		poi.lom.ObjAttrs().DelCustomKeys(cmn.ExpiresObjMD) // (overwriting object with TTL)
+	}
+	poi.lom.ObjAttrs().DelCustomKeys(cmn.TombstoneObjMD) // (writing into clone what was deleted from it)
+	if _, ok := r.Trailer[http.CanonicalHeaderKey(apc.HdrObjCksumVal)]; ok {
+		if err := poi.initTrailer(); err != nil {
+			return http.StatusBadRequest, err
+		}
+	}
 	if dpq.owt != "" {
 		poi.owt.FromS(dpq.owt)
 	}
+	if inm := r.Header.Get(cos.HdrIfNoneMatch); inm != "" && !poi.t2t {
+		if ecode, err := poi.ifAbsent(inm); err != nil {
+			return ecode, err
+		}
+	}
 	if dpq.uuid != "" {
 		// resolve cluster-wide xact "behind" this PUT (promote via a single target won't show up)
 		xctn, err := xreg.GetXact(dpq.uuid)
@@ -169,10 +194,28 @@ func (poi *putOI) do(resphdr http.Header, r *http.Request, dpq *dpq) (int, error
 	return poi.putObject()
 }
 
+// put-if-absent: the (cheap) check here fails most of the losers early;
+// the atomic one is done at commit time under write lock (see LOM.CreateFinalize)
+func (poi *putOI) ifAbsent(inm string) (int, error) {
+	lom := poi.lom
+	if inm != "*" {
+		return http.StatusNotImplemented, cmn.NewErrUnsupp("PUT with", cos.HdrIfNoneMatch+": "+inm)
+	}
+	if !lom.Bck().IsAIS() {
+		return http.StatusNotImplemented, cmn.NewErrUnsupp("put-if-absent", lom.Bck().String()+" (remote bucket)")
+	}
+	if err := cos.Stat(lom.FQN); err == nil {
+		cos.DrainReader(poi.r)
+		return http.StatusPreconditionFailed, cmn.NewErrObjExists(lom.Cname())
+	}
+	poi.createOnly = true
+	return 0, nil
+}
+
 func (poi *putOI) putObject() (ecode int, err error) {
 	poi.ltime = mono.NanoTime()
 	// PUT is a no-op if the checksums do match
-	if !poi.skipVC && !poi.coldGET && !poi.cksumToUse.IsEmpty() {
+	if !poi.skipVC && !poi.coldGET && !poi.trailer && !poi.createOnly && !poi.cksumToUse.IsEmpty() {
 		if poi.lom.EqCksum(poi.cksumToUse) {
 			if cmn.Rom.FastV(4, cos.SmoduleAIS) {
 				nlog.Infof("destination %s has identical %s: PUT is a no-op", poi.lom, poi.cksumToUse)
@@ -186,6 +229,9 @@ func (poi *putOI) putObject() (ecode int, err error) {
 	poi._cleanup(buf, slab, lmfh, erw)
 	if erw != nil {
 		err, ecode = erw, http.StatusInternalServerError
+		if cmn.IsErrTooLarge(erw) {
+			ecode = http.StatusRequestEntityTooLarge // (see payloadReader)
+		}
 		goto rerr
 	}
 
@@ -217,7 +263,8 @@ func (poi *putOI) putObject() (ecode int, err error) {
 rerr:
 	if poi.owt == cmn.OwtPut && poi.restful && !poi.t2t {
 		poi.t.statsT.IncErr(stats.ErrPutCount)
-		if err != cmn.ErrSkip && !poi.remoteErr && err != io.ErrUnexpectedEOF && !cos.IsRetriableConnErr(err) {
+		if err != cmn.ErrSkip && !poi.remoteErr && err != io.ErrUnexpectedEOF && !cos.IsRetriableConnErr(err) &&
+			!cmn.IsErrTooLarge(err) && !cmn.IsErrObjExists(err) {
 			poi.t.statsT.IncErr(stats.IOErrPutCount)
 		}
 	}
@@ -268,6 +315,9 @@ func (poi *putOI) loghdr() string {
 	if poi.t2t {
 		sb.WriteString(", t2t")
 	}
+	if poi.createOnly {
+		sb.WriteString(", create-only")
+	}
 	return sb.String()
 }
 
@@ -304,11 +354,24 @@ func (poi *putOI) finalize() (ecode int, err error) {
 // poi.workFQN => LOM
 func (poi *putOI) fini() (ecode int, err error) {
 	var (
-		lom = poi.lom
-		bck = lom.Bck()
+		lom  = poi.lom
+		bck  = lom.Bck()
+		ackn = poi.ackCopies()
 	)
+	// write ack: fail early, with nothing committed
+	if ackn > 1 {
+		if avail := fs.NumAvail(); avail < ackn {
+			poi.t.statsT.IncErr(stats.ErrPutMirrorCount)
+			return http.StatusServiceUnavailable, fmt.Errorf(fmtErrInsuffMpaths2, poi.t, avail, lom.Cname(), ackn)
+		}
+	}
 	// put remote
-	if bck.IsRemote() && poi.owt < cmn.OwtRebalance {
+	switch {
+	case bck.IsRemote() && poi.owt < cmn.OwtRebalance && bck.IsOffline():
+		if ecode, err = poi.writeBack(); err != nil {
+			return ecode, err
+		}
+	case bck.IsRemote() && poi.owt < cmn.OwtRebalance:
 		ecode, err = poi.putRemote()
 		if err != nil {
 			loghdr := poi.loghdr()
@@ -362,9 +425,20 @@ func (poi *putOI) fini() (ecode int, err error) {
 	}
 
 	// done
-	if err = lom.RenameFinalize(poi.workFQN); err != nil {
+	snap := xs.SnapPre(lom, false /*deleting*/)
+	if poi.createOnly {
+		if err = lom.CreateFinalize(poi.workFQN); err != nil {
+			if cmn.IsErrObjExists(err) {
+				ecode = http.StatusPreconditionFailed
+			}
+			return ecode, err
+		}
+	} else if err = lom.RenameFinalize(poi.workFQN); err != nil {
 		return 0, err
 	}
+	snap.Commit()
+	poi.t.mmc.evict(lom.FQN)
+	poi.t.taix.evict(lom.FQN)
 	if lom.HasCopies() {
 		if errdc := lom.DelAllCopies(); errdc != nil {
 			nlog.Errorf("PUT (%s): failed to delete old copies [%v], proceeding anyway...", poi.loghdr(), errdc)
@@ -373,7 +447,75 @@ func (poi *putOI) fini() (ecode int, err error) {
 	if lom.AtimeUnix() == 0 { // (is set when migrating within cluster; prefetch special case)
 		lom.SetAtimeUnix(poi.atime)
 	}
-	return 0, lom.PersistMain()
+	if err = lom.PersistMain(); err != nil {
+		return 0, err
+	}
+	if !lom.Bprops().WritePolicy.Fsync.IsNone() {
+		if err = poi.fsync(); err != nil {
+			return 0, err
+		}
+	}
+	if ackn > 1 {
+		return poi.writeAck(ackn)
+	}
+	return 0, nil
+}
+
+// number of copies to write before acknowledging user PUT (see `mirror.write_ack`)
+func (poi *putOI) ackCopies() int {
+	mconfig := poi.lom.MirrorConf()
+	if !mconfig.Enabled || poi.owt != cmn.OwtPut || poi.t2t || !poi.lom.Bck().IsAIS() {
+		return 1
+	}
+	return mconfig.AckCopies()
+}
+
+// synchronous n-way mirroring (same target, different mountpaths): the object is (still) wlocked;
+// failing to write n copies fails the PUT - the object itself stays committed, with fewer copies
+// (to be retried by the client); otherwise, the remaining copies are added asynchronously (see putMirror)
+func (poi *putOI) writeAck(n int) (int, error) {
+	lom := poi.lom
+	buf, slab := poi.t.gmm.AllocSize(lom.Lsize())
+	defer slab.Free(buf)
+	for lom.NumCopies() < n {
+		var (
+			err error
+			mi  = lom.LeastUtilNoCopy()
+		)
+		if mi == nil {
+			err = errors.New("no destination mountpath")
+		} else {
+			err = lom.Copy(mi, buf)
+		}
+		if err != nil {
+			poi.t.statsT.IncErr(stats.ErrPutMirrorCount)
+			what := fmt.Sprintf("write %d (%s) copies of", n, lom.MirrorConf().WriteAck)
+			return 0, cmn.NewErrFailedTo(poi.t, what, lom.Cname(),
+				fmt.Errorf("wrote %d: %w", lom.NumCopies(), err))
+		}
+	}
+	return 0, nil
+}
+
+// durability, as per bucket's write_policy.fsync:
+// - md:   the object (its inode and xattr - see PersistMain above), and then the parent directory (its name)
+// - full: same as md, with the content synced prior to commit (see write)
+func (poi *putOI) fsync() error {
+	var (
+		lom     = poi.lom
+		started = mono.NanoTime()
+	)
+	if err := cos.Fsync(lom.FQN); err != nil {
+		return cmn.NewErrFailedTo(poi.t, "fsync", lom.Cname(), err)
+	}
+	if err := cos.Fsync(filepath.Dir(lom.FQN)); err != nil {
+		return cmn.NewErrFailedTo(poi.t, "fsync directory of", lom.Cname(), err)
+	}
+	poi.t.statsT.AddMany(
+		cos.NamedVal64{Name: stats.PutFsyncCount, Value: 1},
+		cos.NamedVal64{Name: stats.PutFsyncLatency, Value: mono.SinceNano(started)},
+	)
+	return nil
 }
 
 // via backend.PutObj()
@@ -419,6 +561,11 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 		}{}
 		ckconf = poi.lom.CksumConf()
 	)
+	if poi.owt == cmn.OwtPut && !poi.t2t { // user PUT (compare w/ poi.stats)
+		if mi := poi.lom.Mountpath(); mi.BeginIO(fs.IOWrite) {
+			defer mi.EndIO(fs.IOWrite)
+		}
+	}
 	if lmfh, err = poi.lom.CreateWork(poi.workFQN); err != nil {
 		return
 	}
@@ -429,12 +576,12 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 	}
 
 	switch {
-	case ckconf.Type == cos.ChecksumNone:
+	case ckconf.Type == cos.ChecksumNone && !poi.trailer:
 		poi.lom.SetCksum(cos.NoneCksum)
 		// not using `ReadFrom` of the `*os.File` -
 		// ultimately, https://github.com/golang/go/blob/master/src/internal/poll/copy_file_range_linux.go#L100
 		written, err = cos.CopyBuffer(lmfh, poi.r, buf)
-	case !poi.cksumToUse.IsEmpty() && !poi.validateCksum(ckconf):
+	case !poi.cksumToUse.IsEmpty() && !poi.trailer && !poi.validateCksum(ckconf):
 		// if the corresponding validation is not configured/enabled we just go ahead
 		// and use the checksum that has arrived with the object
 		poi.lom.SetCksum(poi.cksumToUse)
@@ -442,9 +589,16 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 		written, err = cos.CopyBuffer(lmfh, poi.r, buf)
 	default:
 		writers := make([]io.Writer, 0, 3)
-		cksums.store = cos.NewCksumHash(ckconf.Type) // always according to the bucket
+		ckty := ckconf.Type // always according to the bucket
+		if poi.owt == cmn.OwtRebalance && !poi.cksumToUse.IsEmpty() {
+			// except rebalance that must keep the checksum type of the migrating object,
+			// to stay consistent with its other copies (and EC slices) on other targets
+			ckty = poi.cksumToUse.Type()
+		}
+		cksums.store = cos.NewCksumHash(ckty)
 		writers = append(writers, cksums.store.H)
-		if !poi.skipVC && !poi.cksumToUse.IsEmpty() && poi.validateCksum(ckconf) {
+		// NOTE: trailer checksum is always validated (it's the only protection a streamed PUT has)
+		if poi.trailer || (!poi.skipVC && !poi.cksumToUse.IsEmpty() && poi.validateCksum(ckconf)) {
 			cksums.expct = poi.cksumToUse
 			if poi.cksumToUse.Type() == cksums.store.Type() {
 				cksums.compt = cksums.store
@@ -462,6 +616,12 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 	}
 
 	// validate
+	if poi.trailer {
+		// (trailer is only available once the body has been fully read)
+		if cksums.expct, err = poi.trailerCksum(); err != nil {
+			return
+		}
+	}
 	if cksums.compt != nil {
 		cksums.finalized = cksums.compt == cksums.store
 		cksums.compt.Finalize()
@@ -476,9 +636,10 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 	}
 
 	// ok
-	if poi.lom.IsFeatureSet(feat.FsyncPUT) {
-		err = lmfh.Sync() // compare w/ cos.FlushClose
-		debug.AssertNoErr(err)
+	if poi.lom.IsFeatureSet(feat.FsyncPUT) || poi.lom.Bprops().WritePolicy.Fsync == apc.FsyncFull {
+		if err = lmfh.Sync(); err != nil { // compare w/ cos.FlushClose
+			return
+		}
 	}
 
 	cos.Close(lmfh)
@@ -514,6 +675,41 @@ func (poi *putOI) _cleanup(buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 	}
 }
 
+// chunked PUT with the checksum value (and, optionally, type) sent in the HTTP trailer;
+// the type must be known upfront - via apc.HdrObjCksumType header or else the bucket's configured type
+func (poi *putOI) initTrailer() error {
+	poi.trailer = true
+	if !poi.cksumToUse.IsEmpty() {
+		if poi.cksumToUse.Value() != "" {
+			return fmt.Errorf("%s: checksum value must be sent either in the header or in the trailer (not both)",
+				poi.lom.Cname())
+		}
+		return nil
+	}
+	ty := poi.lom.CksumConf().Type
+	if ty == cos.ChecksumNone {
+		return fmt.Errorf("%s: missing %q header (required when sending checksum in the trailer)",
+			poi.lom.Cname(), apc.HdrObjCksumType)
+	}
+	poi.cksumToUse = cos.NewCksum(ty, "")
+	return nil
+}
+
+func (poi *putOI) trailerCksum() (*cos.Cksum, error) {
+	var (
+		ty  = poi.oreq.Trailer.Get(apc.HdrObjCksumType)
+		val = poi.oreq.Trailer.Get(apc.HdrObjCksumVal)
+	)
+	if val == "" {
+		return nil, fmt.Errorf("%s: missing %q in the trailer", poi.lom.Cname(), apc.HdrObjCksumVal)
+	}
+	if ty != "" && ty != poi.cksumToUse.Type() {
+		return nil, fmt.Errorf("%s: trailer checksum type %q differs from the declared %q",
+			poi.lom.Cname(), ty, poi.cksumToUse.Type())
+	}
+	return cos.NewCksum(poi.cksumToUse.Type(), val), nil
+}
+
 func (poi *putOI) validateCksum(c *cmn.CksumConf) (v bool) {
 	switch poi.owt {
 	case cmn.OwtRebalance, cmn.OwtCopy:
@@ -562,6 +758,9 @@ do:
 		if goi.lom.IsFeatureSet(feat.DisableColdGET) && goi.lom.Bck().IsRemote() {
 			return http.StatusNotFound, fmt.Errorf("%w (cold GET disabled)", err)
 		}
+		if goi.lom.Bck().IsOffline() {
+			return http.StatusServiceUnavailable, cmn.NewErrBackendOffline("cold GET "+goi.lom.ObjName, goi.lom.Bucket())
+		}
 		cs = fs.Cap()
 		if cs.IsOOS() {
 			return http.StatusInsufficientStorage, cs.Err()
@@ -569,12 +768,24 @@ do:
 		if errN := cmn.ValidateObjName(goi.lom.ObjName); errN != nil {
 			return 0, errN
 		}
+	} else if goi.lom.IsTombstone() {
+		return http.StatusNotFound, cos.NewErrNotFound(goi.t, goi.lom.Cname())
+	} else if to, ok := goi.lom.GetCustomKey(cmn.LinkObjMD); ok {
+		goi.lom.Unlock(false)
+		goi.unlocked = true
+		return goi.getFromLink(to)
 	}
 
 	switch {
 	case cold && goi.lom.Bck().IsAIS():
 		// ais bucket with no backend - try recover
 		goi.lom.Unlock(false)
+		if goi.ranges.Range != "" && goi.lom.ECEnabled() {
+			if done, ecode, err := goi.ecRange(); done {
+				goi.unlocked = true
+				return ecode, err
+			}
+		}
 		doubleCheck, ecode, err = goi.restoreFromAny(false /*skipLomRestore*/)
 		if doubleCheck && err != nil {
 			lom2 := core.AllocLOM(goi.lom.ObjName)
@@ -593,6 +804,14 @@ do:
 		}
 		if err != nil {
 			goi.unlocked = true
+			if src := goi.lom.Bck().CloneOf(); src != nil && cos.IsNotExist(err, ecode) {
+				return goi.getFromClone(src)
+			}
+			if goi.ecFailed {
+				if done, ecode2, err2 := goi.ecDelegate(err); done {
+					return ecode2, err2
+				}
+			}
 			return ecode, err
 		}
 		goi.lom.Lock(false)
@@ -603,8 +822,9 @@ do:
 		goto fin // ok, done
 	case cold:
 		// have remote backend - use it
-	case goi.latestVer:
+	case goi.latestVer && !goi.lom.Bck().IsOffline() && !goi.lom.IsPendingPut():
 		// apc.QparamLatestVer or 'versioning.validate_warm_get'
+		// (not when offline, and never overwrite not-yet-uploaded content)
 		res := goi.lom.CheckRemoteMD(true /* rlocked */, false /*synchronize*/, goi.req)
 		if res.Err != nil {
 			return res.ErrCode, res.Err
@@ -665,6 +885,13 @@ do:
 			return res.ErrCode, res.Err
 		}
 		goi.cold = true
+		goi.mpr(&res, backend) // (when configured)
+
+		// not to be stored as per bucket's admission policy
+		if goi.admissible() && !goi.t.admit.admit(goi.lom, res.Size) {
+			err = goi.coldBypass(&res)
+			return 0, err
+		}
 
 		// 3 alternative ways to perform cold GET
 		if goi.dpq.arch.path == "" && goi.dpq.arch.regx == "" &&
@@ -792,7 +1019,7 @@ validate:
 	if _, ok := err.(*cos.ErrBadCksum); !ok {
 		return
 	}
-	if !lom.Bck().IsAIS() && !goi.lom.IsFeatureSet(feat.DisableColdGET) {
+	if !lom.Bck().IsAIS() && !goi.lom.IsFeatureSet(feat.DisableColdGET) && !lom.Bck().IsOffline() && !lom.IsPendingPut() {
 		coldGet = true
 		return
 	}
@@ -880,6 +1107,13 @@ func (goi *getOI) restoreFromAny(skipLomRestore bool) (doubleCheck bool, ecode i
 		}
 	}
 
+	// pinned bucket: replicate on demand (see also xs.XactPin)
+	if tsi.ID() != goi.t.SID() && goi.lom.Bprops().Pin.Has(goi.t.SID()) {
+		if goi.getFromNeighbor(goi.lom, tsi) {
+			return
+		}
+	}
+
 	// when rebalancing: cluster-wide lookup (aka "get from neighbor" or GFN)
 	var (
 		gfnNode   *meta.Snode
@@ -901,7 +1135,7 @@ func (goi *getOI) restoreFromAny(skipLomRestore bool) (doubleCheck bool, ecode i
 		}
 	}
 	if running || !enoughECRestoreTargets ||
-		((marked.Interrupted || marked.Restarted || gfnActive) && !ecEnabled) {
+		((marked.Interrupted || marked.Restarted || marked.DataLoss || gfnActive) && !ecEnabled) {
 		gfnNode = goi.t.headObjBcast(goi.lom, smap)
 	}
 gfn:
@@ -926,6 +1160,7 @@ gfn:
 		if cmn.IsErrCapExceeded(ecErr) {
 			ecode = http.StatusInsufficientStorage
 		}
+		goi.ecFailed = ecErr != ec.ErrorNoMetafile && ecErr != ec.ErrorNotFound
 		return
 	}
 
@@ -938,6 +1173,125 @@ gfn:
 	return
 }
 
+// range-read EC-sliced object directly from its slices, without restoring it first
+// (see ec.Manager.ReadRange); not done - fall back to restoring
+func (goi *getOI) ecRange() (done bool, ecode int, err error) {
+	if goi.dpq.isArch() || goi.dpq.isGFN || goi.dpq.conv != "" {
+		return false, 0, nil
+	}
+	// when rebalancing or resilvering, the object may as well be found elsewhere
+	if xreg.GetRebMarked().Xact != nil || xreg.GetResilverMarked().Xact != nil || reb.IsGFN() {
+		return false, 0, nil
+	}
+	var written int64
+	written, ecode, err = goi.ecRead(false /*whole*/)
+	switch {
+	case err == nil:
+		goi.stats(written)
+		return true, 0, nil
+	case written > 0:
+		nlog.Warningln("failed to GET (EC range)", goi.lom.Cname(), err)
+		return true, 0, errSendingResp
+	case ecode != 0:
+		return true, ecode, err // e.g., range not satisfiable
+	case err == ec.ErrorECReplicated || err == ec.ErrorECDisabled:
+		return false, 0, nil
+	default:
+		nlog.Warningln("EC range-read", goi.lom.Cname(), err, "- proceeding to restore")
+		return false, 0, nil
+	}
+}
+
+// read the requested range (or, if requested, the whole object) directly from EC slices
+// and write it into the response
+func (goi *getOI) ecRead(whole bool) (written int64, ecode int, err error) {
+	whdr := goi.w.Header()
+	args := &ec.RangeArgs{W: goi.w}
+	args.Open = func(md *ec.Metadata) (int64, int64, error) {
+		hrng, ecode2, err := goi.rngToHeader(whdr, md.Size)
+		if err != nil {
+			ecode = ecode2
+			return 0, 0, err
+		}
+		off, length := int64(0), md.Size
+		switch {
+		case hrng != nil:
+			off, length = hrng.Start, hrng.Length
+		case !whole:
+			return 0, 0, nil
+		}
+		goi.lom.SetSize(md.Size)
+		goi.lom.SetVersion(md.ObjVersion)
+		whdr.Set(cos.HdrContentType, cos.ContentBinary)
+		cmn.ToHeader(goi.lom.ObjAttrs(), whdr, length, nil)
+		return off, length, nil
+	}
+	err = ec.ECM.ReadRange(goi.lom, args)
+	return args.Written, ecode, err
+}
+
+// Delegated EC restore: this (main) target has failed to restore the object - e.g., because it
+// is out of space or its mountpath is failing - while the object's slices may well be intact.
+// Ask other targets, one at a time and in HRW order, to read the object from its slices and
+// relay the result. Only sliced objects; see also ecReadDelegated.
+func (goi *getOI) ecDelegate(errRestore error) (bool, int, error) {
+	if goi.dpq.isArch() || goi.dpq.isGFN || goi.dpq.conv != "" || goi.dpq.ecRead {
+		return false, 0, nil
+	}
+	var (
+		smap  = goi.t.owner.smap.get()
+		uname = goi.lom.Uname()
+		bck   = goi.lom.Bck()
+		tries int
+	)
+	tsis, err := smap.HrwTargetList(&uname, smap.CountTargets())
+	if err != nil {
+		return false, 0, nil
+	}
+	query := bck.AddToQuery(nil)
+	query.Set(apc.QparamECRead, "true")
+	query.Set(apc.QparamETLBypass, "true")
+	for _, tsi := range tsis {
+		if tsi.ID() == goi.t.SID() {
+			continue
+		}
+		ecode, err := goi.relay(tsi, bck, goi.lom.ObjName, "EC-delegated", query, nil)
+		if err == nil || err == errSendingResp {
+			nlog.Infoln(goi.t.String(), "EC-delegated", goi.lom.Cname(), "to", tsi.StringEx(), "[", errRestore, "]")
+			return true, ecode, err
+		}
+		nlog.Warningln(goi.t.String(), "failed to EC-delegate", goi.lom.Cname(), "to", tsi.StringEx()+":", err)
+		if ecode >= http.StatusBadRequest && ecode < http.StatusInternalServerError {
+			break // e.g., not sliced or not enough slices - same thing elsewhere
+		}
+		if tries++; tries >= ecDelegateTries {
+			break
+		}
+	}
+	return false, 0, nil
+}
+
+// the other side of ecDelegate: read the whole object (or its range) from EC slices without
+// restoring it (and without storing anything locally)
+func (goi *getOI) ecReadDelegated() (int, error) {
+	if err := goi.t.isIntraCall(goi.req.Header, false /*from primary*/); err != nil {
+		return http.StatusForbidden, err
+	}
+	written, ecode, err := goi.ecRead(true /*whole*/)
+	switch {
+	case err == nil:
+		return 0, nil
+	case written > 0:
+		nlog.Warningln("failed to EC-read", goi.lom.Cname(), "on behalf of the main target:", err)
+		return 0, errSendingResp
+	case err == ec.ErrorNoMetafile:
+		return http.StatusNotFound, cos.NewErrNotFound(goi.t, goi.lom.Cname())
+	case ecode == 0:
+		ecode = http.StatusUnprocessableEntity // (not sliced, not enough slices, etc.)
+	}
+	return ecode, err
+}
+
 func (goi *getOI) getFromNeighbor(lom *core.LOM, tsi *meta.Snode) bool {
 	query := lom.Bck().NewQuery()
 	query.Set(apc.QparamIsGFNRequest, "true")
@@ -949,6 +1303,9 @@ func (goi *getOI) getFromNeighbor(lom *core.LOM, tsi *meta.Snode) bool {
 			apc.HdrCallerID:   []string{goi.t.SID()},
 			apc.HdrCallerName: []string{goi.t.callerName()},
 		}
+		if id := goi.req.Header.Get(apc.HdrReqID); id != "" {
+			reqArgs.Header.Set(apc.HdrReqID, id)
+		}
 		reqArgs.Path = apc.URLPathObjects.Join(lom.Bck().Name, lom.ObjName)
 		reqArgs.Query = query
 	}
@@ -992,6 +1349,79 @@ func (goi *getOI) getFromNeighbor(lom *core.LOM, tsi *meta.Snode) bool {
 	return false
 }
 
+// read-through: GET `objName` from `src` via the target that owns it and relay the response
+// (`what` explains the relationship, e.g. "clone of")
+func (goi *getOI) readThrough(src *meta.Bck, objName, what string, hdr http.Header) (int, error) {
+	smap := goi.t.owner.smap.get()
+	tsi, err := smap.HrwName2T(src.MakeUname(objName))
+	if err != nil {
+		return http.StatusServiceUnavailable, err
+	}
+	query := src.AddToQuery(cmn.DelBckFromQuery(goi.req.URL.Query())) // preserving archpath, et al.
+	query.Del(apc.QparamUnixTime)
+	return goi.relay(tsi, src, objName, what, query, hdr)
+}
+
+// GET `objName` from a given target and write the response into this one
+func (goi *getOI) relay(tsi *meta.Snode, src *meta.Bck, objName, what string, query url.Values, hdr http.Header) (int, error) {
+	reqArgs := cmn.AllocHra()
+	{
+		reqArgs.Method = http.MethodGet
+		reqArgs.Base = tsi.URL(cmn.NetIntraData)
+		reqArgs.Header = http.Header{
+			apc.HdrCallerID:   []string{goi.t.SID()},
+			apc.HdrCallerName: []string{goi.t.callerName()},
+		}
+		if rng := goi.req.Header.Get(cos.HdrRange); rng != "" {
+			reqArgs.Header.Set(cos.HdrRange, rng)
+		}
+		if id := goi.req.Header.Get(apc.HdrReqID); id != "" {
+			reqArgs.Header.Set(apc.HdrReqID, id)
+		}
+		for k, v := range hdr {
+			reqArgs.Header[k] = v
+		}
+		reqArgs.Path = apc.URLPathObjects.Join(src.Name, objName)
+		reqArgs.Query = query
+	}
+	req, _, cancel, err := reqArgs.ReqWithTimeout(cmn.GCO.Get().Timeout.SendFile.D())
+	cmn.FreeHra(reqArgs)
+	if err != nil {
+		return 0, err
+	}
+	defer cancel()
+
+	resp, err := g.client.data.Do(req)
+	if err != nil {
+		return http.StatusBadGateway, cmn.NewErrFailedTo(goi.t, "read-through", src.Cname(objName), err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		if resp.StatusCode == http.StatusNotFound {
+			return resp.StatusCode, cos.NewErrNotFound(goi.t, goi.lom.Cname()+" ("+what+")")
+		}
+		err = fmt.Errorf("status %d from %s", resp.StatusCode, tsi)
+		return resp.StatusCode, cmn.NewErrFailedTo(goi.t, "read-through", src.Cname(objName), err)
+	}
+
+	whdr := goi.w.Header()
+	for k, v := range resp.Header {
+		whdr[k] = v
+	}
+	if resp.StatusCode != http.StatusOK {
+		goi.w.WriteHeader(resp.StatusCode) // e.g. 206
+	}
+	buf, slab := goi.t.gmm.Alloc()
+	written, err := cos.CopyBuffer(goi.w, resp.Body, buf)
+	slab.Free(buf)
+	if err != nil {
+		nlog.Warningln("failed to read-through", goi.lom.Cname(), "from", src.Cname(""), err)
+		return 0, errSendingResp
+	}
+	goi.stats(written)
+	return 0, nil
+}
+
 func (goi *getOI) txfini() (ecode int, err error) {
 	var (
 		lmfh *os.File
@@ -1000,8 +1430,24 @@ func (goi *getOI) txfini() (ecode int, err error) {
 		dpq  = goi.dpq
 	)
 	if !goi.cold && !dpq.isGFN && !goi.lom.IsChunked() {
+		if goi.ranges.Range == "" && !dpq.isArch() && dpq.conv == "" {
+			if e := goi.t.mmc.get(goi.lom, cmn.GCO.Get()); e != nil {
+				err = goi._txmmap(e)
+				goi.t.mmc.put(e)
+				return 0, err
+			}
+		}
 		fqn = goi.lom.LBGet() // best-effort GET load balancing (see also mirror.findLeastUtilized())
 	}
+	mi := goi.lom.Mountpath()
+	if fqn != goi.lom.FQN {
+		if cmi, _, err := fs.FQN2Mpath(fqn); err == nil {
+			mi = cmi // (copy)
+		}
+	}
+	if mi.BeginIO(fs.IORead) {
+		defer mi.EndIO(fs.IORead)
+	}
 	// open
 	// TODO -- FIXME: use lom.Open() instead of os.Open(); TestECChecksum
 	lmfh, err = os.Open(fqn)
@@ -1034,6 +1480,8 @@ func (goi *getOI) txfini() (ecode int, err error) {
 		err = goi._txrng(fqn, lmfh, whdr, hrng)
 	case dpq.isArch():
 		err = goi._txarch(fqn, lmfh, whdr)
+	case dpq.conv != "":
+		err = goi._txconv(lmfh, fqn, whdr)
 	default:
 		err = goi._txreg(fqn, lmfh, whdr)
 	}
@@ -1103,13 +1551,44 @@ func (goi *getOI) _txreg(fqn string, lmfh *os.File, whdr http.Header) (err error
 	return err
 }
 
+// hot small object: transmit from memory (see tgtmmap.go)
+func (goi *getOI) _txmmap(e *mmentry) error {
+	lom := goi.lom
+	whdr := goi.w.Header()
+	whdr.Set(cos.HdrContentType, cos.ContentBinary)
+	cmn.ToHeader(lom.ObjAttrs(), whdr, lom.Lsize(), lom.Checksum())
+	if goi.dpq.isS3 {
+		s3.SetEtag(whdr, lom)
+	}
+	written, err := goi.w.Write(e.data)
+	if err != nil {
+		if !cos.IsRetriableConnErr(err) || cmn.Rom.FastV(5, cos.SmoduleAIS) {
+			nlog.Warningln("failed to GET (Tx)", lom.Cname(), err)
+		}
+		return errSendingResp
+	}
+	return goi.posttx(int64(written))
+}
+
 // TODO: checksum
 func (goi *getOI) _txarch(fqn string, lmfh *os.File, whdr http.Header) error {
 	var (
-		ar  archive.Reader
-		dpq = goi.dpq
-		lom = goi.lom
+		ar     archive.Reader
+		dpq    = goi.dpq
+		lom    = goi.lom
+		config = cmn.GCO.Get()
 	)
+	// single, indexed TAR (see tgtarch.go)
+	if dpq.arch.path != "" {
+		if idx := goi.t.taix.get(lom, lmfh, config); idx != nil {
+			e, ok := idx.Get(dpq.arch.path)
+			if !ok {
+				return cos.NewErrNotFound(goi.t, dpq._archstr()+" in "+lom.Cname())
+			}
+			return goi._txone(taixReader{io.NewSectionReader(lmfh, e.Off, e.Size)}, fqn, whdr)
+		}
+	}
+
 	mime, err := archive.MimeFile(lmfh, goi.t.smm, dpq.arch.mime, lom.ObjName)
 	if err != nil {
 		return err
@@ -1124,6 +1603,9 @@ func (goi *getOI) _txarch(fqn string, lmfh *os.File, whdr http.Header) error {
 		debug.Assert(dpq.arch.mmode == "", dpq.arch.mmode)
 		var csl cos.ReadCloseSizer
 		csl, err = ar.ReadOne(dpq.arch.path)
+		if mime == archive.ExtTar {
+			goi.t.taix.admit(lom, config)
+		}
 		if err != nil {
 			goi.isIOErr = true
 			return cmn.NewErrFailedTo(goi.t, "extract "+dpq._archstr()+" from", lom.Cname(), err)
@@ -1131,13 +1613,7 @@ func (goi *getOI) _txarch(fqn string, lmfh *os.File, whdr http.Header) error {
 		if csl == nil {
 			return cos.NewErrNotFound(goi.t, dpq._archstr()+" in "+lom.Cname())
 		}
-		// found
-		whdr.Set(cos.HdrContentType, cos.ContentBinary)
-		buf, slab := goi.t.gmm.AllocSize(min(csl.Size(), memsys.DefaultBuf2Size))
-		err = goi.transmit(csl, buf, fqn)
-		slab.Free(buf)
-		csl.Close()
-		return err
+		return goi._txone(csl, fqn, whdr)
 	}
 
 	// multi match; writing & streaming tar =>(directly)=> response writer
@@ -1157,6 +1633,21 @@ func (goi *getOI) _txarch(fqn string, lmfh *os.File, whdr http.Header) error {
 	return err
 }
 
+// transmit (and close) a single archived file
+func (goi *getOI) _txone(csl cos.ReadCloseSizer, fqn string, whdr http.Header) (err error) {
+	if goi.dpq.conv != "" {
+		err = goi._txconv(csl, fqn, whdr)
+		csl.Close()
+		return err
+	}
+	whdr.Set(cos.HdrContentType, cos.ContentBinary)
+	buf, slab := goi.t.gmm.AllocSize(min(csl.Size(), memsys.DefaultBuf2Size))
+	err = goi.transmit(csl, buf, fqn)
+	slab.Free(buf)
+	csl.Close()
+	return err
+}
+
 func (goi *getOI) transmit(r io.Reader, buf []byte, fqn string) error {
 	written, err := cos.CopyBuffer(goi.w, r, buf)
 	if err != nil {
@@ -1169,6 +1660,10 @@ func (goi *getOI) transmit(r io.Reader, buf []byte, fqn string) error {
 		// return special code to indicate just that
 		return errSendingResp
 	}
+	return goi.posttx(written)
+}
+
+func (goi *getOI) posttx(written int64) error {
 	// Update objects sent during GFN. Thanks to this we will not
 	// have to resend them in rebalance. In case of a race between rebalance
 	// and GFN the former wins, resulting in duplicated transmission.
@@ -1206,6 +1701,12 @@ func (goi *getOI) stats(written int64) {
 			cos.NamedVal64{Name: stats.VerChangeSize, Value: goi.lom.Lsize()},
 		)
 	}
+	if bck := goi.lom.Bck(); bck.Props != nil {
+		stats.HeatAdd(bck.Props.BID, bck.Bucket(), goi.lom.ObjName, goi.atime)
+	}
+	if bck := goi.lom.Bck(); bck.IsRemote() {
+		goi.t.ra.onGet(goi.lom)
+	}
 
 	if goi.rltime > 0 {
 		bck := goi.lom.Bck()
@@ -1279,18 +1780,32 @@ func (a *apndOI) do(r *http.Request) (packedHdl string, ecode int, err error) {
 	if cksumValue != "" {
 		a.cksum = cos.NewCksum(cksumType, cksumValue)
 	}
+	if a.op != apc.AppendOp && a.op != apc.FlushOp {
+		err = fmt.Errorf("invalid operation %q (expecting either %q or %q) - check %q query",
+			a.op, apc.AppendOp, apc.FlushOp, apc.QparamAppendType)
+		return "", 0, err
+	}
 
+	// flow control (see tgtapnd.go)
+	s, reserved, ecode, err := a.t.apnds.begin(a)
+	if err != nil {
+		return "", ecode, err
+	}
 	switch a.op {
 	case apc.AppendOp:
+		if window := int64(a.config.Append.Window); window > 0 {
+			a.r = &payloadReader{r: a.r, what: a.lom.Cname(), limit: window}
+		}
 		buf, slab := a.t.gmm.Alloc()
 		packedHdl, ecode, err = a.apnd(buf)
 		slab.Free(buf)
+		if err != nil && cmn.IsErrTooLarge(err) {
+			ecode = http.StatusRequestEntityTooLarge
+		}
 	case apc.FlushOp:
 		ecode, err = a.flush()
-	default:
-		err = fmt.Errorf("invalid operation %q (expecting either %q or %q) - check %q query",
-			a.op, apc.AppendOp, apc.FlushOp, apc.QparamAppendType)
 	}
+	a.t.apnds.end(a, s, reserved, err)
 
 	return packedHdl, ecode, err
 }
@@ -1302,6 +1817,7 @@ func (a *apndOI) apnd(buf []byte) (packedHdl string, ecode int, err error) {
 	)
 	if workFQN == "" {
 		workFQN = fs.CSM.Gen(a.lom, fs.WorkfileType, fs.WorkfileAppend)
+		a.hdl.workFQN = workFQN
 		a.lom.Lock(false)
 		if a.lom.Load(false /*cache it*/, false /*locked*/) == nil {
 			_, a.hdl.partialCksum, err = cos.CopyFile(a.lom.FQN, workFQN, buf, a.lom.CksumType())
@@ -1859,6 +2375,9 @@ func (t *target) putMirror(lom *core.LOM) {
 	if !mconfig.Enabled {
 		return
 	}
+	if lom.NumCopies() >= int(mconfig.Copies) {
+		return // e.g., written synchronously (see writeAck)
+	}
 	if mpathCnt := fs.NumAvail(); mpathCnt < int(mconfig.Copies) {
 		t.statsT.IncErr(stats.ErrPutMirrorCount)
 		nanotim := mono.NanoTime()
@@ -6,10 +6,14 @@ package ais
 
 import (
 	"flag"
+	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"path"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -265,3 +269,242 @@ func BenchmarkObjGetDiscard(b *testing.B) {
 		})
 	}
 }
+
+//
+// mirror.write_ack
+//
+
+const testMountpath2 = "/tmp/ais-test-mpath2"
+
+// write and finalize (no asynchronous mirroring - see putObject)
+func wackPut(lom *core.LOM, content string) (int, error) {
+	poi := &putOI{
+		atime:   time.Now().UnixNano(),
+		t:       t,
+		lom:     lom,
+		r:       readers.NewBytes([]byte(content)),
+		workFQN: fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfilePut),
+		config:  cmn.GCO.Get(),
+		owt:     cmn.OwtPut,
+	}
+	buf, slab, lmfh, err := poi.write()
+	poi._cleanup(buf, slab, lmfh, err)
+	if err != nil {
+		return 0, err
+	}
+	return poi.finalize()
+}
+
+// add or update bucket with given mirror props
+func wackBck(name string, mirror cmn.MirrorConf) *meta.Bck {
+	bck := meta.NewBck(name, apc.AIS, cmn.NsGlobal)
+	props := &cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumNone}, Mirror: mirror}
+	bmd := t.owner.bmd.get().clone()
+	if _, present := bmd.Get(bck); present {
+		bmd.set(bck, props)
+	} else {
+		bmd.add(bck, props)
+	}
+	t.owner.bmd.putPersist(bmd, nil)
+	fs.CreateBucket(bck.Bucket(), false /*nilbmd*/)
+	return bck
+}
+
+func wackAddMpath() error {
+	if err := cos.CreateDir(testMountpath2); err != nil {
+		return err
+	}
+	_, err := fs.Add(testMountpath2, t.SID())
+	return err
+}
+
+func wackLoad(bck *meta.Bck, objName string) (*core.LOM, error) {
+	lom := core.AllocLOM(objName)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		return lom, err
+	}
+	return lom, lom.Load(false /*cache it*/, false /*locked*/)
+}
+
+func TestPutWriteAck(t *testing.T) {
+	const name = "bck-wack"
+	mirror := cmn.MirrorConf{Enabled: true, Copies: 2, WriteAck: apc.WriteAckAll}
+
+	// existing object, no write ack
+	bck := wackBck(name, cmn.MirrorConf{})
+	lom, _ := wackLoad(bck, "wack-obj-1")
+	if _, err := wackPut(lom, "v1"); err != nil {
+		t.Fatal(err)
+	}
+	core.FreeLOM(lom)
+
+	// single mountpath: fails upfront, with the existing object unchanged
+	bck = wackBck(name, mirror)
+	lom, _ = wackLoad(bck, "wack-obj-1")
+	if ecode, err := wackPut(lom, "version-2"); err == nil || ecode != http.StatusServiceUnavailable {
+		t.Fatalf("expected PUT to fail with %d, got (%d, %v)", http.StatusServiceUnavailable, ecode, err)
+	}
+	core.FreeLOM(lom)
+	lom, err := wackLoad(bck, "wack-obj-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lom.Lsize() != 2 {
+		t.Fatalf("expected the existing object (size 2) to remain unchanged, got size %d", lom.Lsize())
+	}
+	core.FreeLOM(lom)
+
+	// two mountpaths (and disk utilization watermarks to select the copy's destination)
+	config := cmn.GCO.BeginUpdate()
+	config.Disk.DiskUtilLowWM = 70
+	config.Disk.DiskUtilHighWM = 80
+	config.Disk.DiskUtilMaxWM = 95
+	cmn.GCO.CommitUpdate(config)
+	if err := wackAddMpath(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		fs.Remove(testMountpath2)
+		os.RemoveAll(testMountpath2)
+	}()
+	fs.CreateBucket(bck.Bucket(), false /*nilbmd*/)
+
+	// acknowledged upon writing both copies
+	lom, _ = wackLoad(bck, "wack-obj-2")
+	if _, err := wackPut(lom, "version-2"); err != nil {
+		t.Fatal(err)
+	}
+	core.FreeLOM(lom)
+	lom, err = wackLoad(bck, "wack-obj-2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lom.NumCopies() != 2 || lom.Lsize() != 9 {
+		t.Fatalf("expected 2 copies of size 9, got %d copies of size %d", lom.NumCopies(), lom.Lsize())
+	}
+	core.FreeLOM(lom)
+
+	// failing to write the copy: fails the PUT (with the object itself committed)
+	lom, _ = wackLoad(bck, "wack-obj-3")
+	for _, mi := range fs.GetAvail() {
+		if mi.Path != lom.Mountpath().Path {
+			// (occupy the copy's destination)
+			copyFQN := mi.MakePathFQN(bck.Bucket(), fs.ObjectType, lom.ObjName)
+			if err := cos.CreateDir(path.Join(copyFQN, "dir")); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if _, err := wackPut(lom, "version-3"); err == nil {
+		t.Fatal("expected PUT to fail upon failing to write the required number of copies")
+	}
+	core.FreeLOM(lom)
+	lom, err = wackLoad(bck, "wack-obj-3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lom.NumCopies() != 1 || lom.Lsize() != 9 {
+		t.Fatalf("expected committed object of size 9 with no copies, got %d copies of size %d", lom.NumCopies(), lom.Lsize())
+	}
+	core.FreeLOM(lom)
+}
+
+//
+// put-if-absent
+//
+
+func ifAbsentPut(objName, content string) (int, error) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(&cmn.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}); err != nil {
+		return 0, err
+	}
+	poi := &putOI{atime: time.Now().UnixNano(), t: t, lom: lom, config: cmn.GCO.Get()}
+	r := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(content))
+	r.Header.Set(cos.HdrIfNoneMatch, "*")
+	return poi.do(http.Header{}, r, &dpq{})
+}
+
+// a loser that got past the early check (see putOI.ifAbsent) and raced on EEXIST at commit time
+func ifAbsentCommit(lom *core.LOM, content string) (int, error) {
+	poi := &putOI{
+		atime:      time.Now().UnixNano(),
+		t:          t,
+		lom:        lom,
+		r:          readers.NewBytes([]byte(content)),
+		workFQN:    fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfilePut),
+		config:     cmn.GCO.Get(),
+		owt:        cmn.OwtPut,
+		createOnly: true,
+	}
+	ecode, err := poi.putObject()
+	if erw := cos.Stat(poi.workFQN); erw == nil {
+		return ecode, fmt.Errorf("work file %q not removed (%v)", poi.workFQN, err)
+	}
+	return ecode, err
+}
+
+func TestPutIfAbsentRace(t *testing.T) {
+	const (
+		objName = "if-absent-obj"
+		num     = 8
+	)
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		winners []int
+		ecodes  = make([]int, num)
+		errs    = make([]error, num)
+	)
+	for i := range num {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ecodes[i], errs[i] = ifAbsentPut(objName, fmt.Sprintf("content-%d", i))
+			if errs[i] == nil {
+				mu.Lock()
+				winners = append(winners, i)
+				mu.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	// exactly one wins, all the rest fail with 412
+	if len(winners) != 1 {
+		t.Fatalf("expected exactly one successful put-if-absent, got %d (%v)", len(winners), errs)
+	}
+	for i := range num {
+		if i == winners[0] {
+			continue
+		}
+		if ecodes[i] != http.StatusPreconditionFailed || !cmn.IsErrObjExists(errs[i]) {
+			t.Fatalf("expected %d (object exists), got (%d, %v)", http.StatusPreconditionFailed, ecodes[i], errs[i])
+		}
+	}
+	expected := fmt.Sprintf("content-%d", winners[0])
+
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(&cmn.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}); err != nil {
+		t.Fatal(err)
+	}
+	if b, err := os.ReadFile(lom.FQN); err != nil || string(b) != expected {
+		t.Fatalf("expected %q, got (%q, %v)", expected, b, err)
+	}
+
+	// EEXIST at commit time (see LOM.CreateFinalize): 412, with the original intact
+	ecode, err := ifAbsentCommit(lom, "overwritten")
+	if ecode != http.StatusPreconditionFailed || !cmn.IsErrObjExists(err) {
+		t.Fatalf("expected %d (object exists), got (%d, %v)", http.StatusPreconditionFailed, ecode, err)
+	}
+	if b, err := os.ReadFile(lom.FQN); err != nil || string(b) != expected {
+		t.Fatalf("expected the original %q to remain intact, got (%q, %v)", expected, b, err)
+	}
+	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+		t.Fatal(err)
+	}
+	if lom.Lsize() != int64(len(expected)) {
+		t.Fatalf("expected size %d, got %d", len(expected), lom.Lsize())
+	}
+}
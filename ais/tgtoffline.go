@@ -0,0 +1,116 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+)
+
+// Offline remote backend (feat.BackendOffline):
+// - GET and HEAD: in-cluster objects only, with no remote version checks; cold GET and cold HEAD
+//   fail with 503 (cmn.ErrBackendOffline)
+// - list-objects: in-cluster content, each entry marked apc.EntryOffline
+// - PUT: iff write_policy.data is "delayed" (write-back), the object gets stored in-cluster and
+//   marked pending (cmn.PendingPutObjMD); otherwise, 503
+// - DELETE: 503 (evict is fine)
+// - once the feature is cleared (via BMD), each target walks the bucket and uploads
+//   all pending objects (see flushWriteBack)
+
+// buckets with write-back flush in progress (by uname)
+var writeBackBusy sync.Map
+
+// (under poi.fini)
+func (poi *putOI) writeBack() (int, error) {
+	lom := poi.lom
+	if lom.Bprops().WritePolicy.Data != apc.WriteDelayed {
+		return http.StatusServiceUnavailable, cmn.NewErrBackendOffline("PUT "+lom.ObjName, lom.Bucket())
+	}
+	// (compare w/ putRemote)
+	lom.ObjAttrs().DelCustomKeys(cmn.SourceObjMD, cmn.CRC32CObjMD, cmn.ETag, cmn.MD5ObjMD, cmn.VersionObjMD)
+	lom.SetCustomKey(cmn.PendingPutObjMD, "true")
+	return 0, nil
+}
+
+// upload objects that were written while the backend was offline
+func (t *target) flushWriteBack(bck *meta.Bck) {
+	uname := string(bck.MakeUname(""))
+	if _, busy := writeBackBusy.LoadOrStore(uname, true); busy {
+		return
+	}
+	defer writeBackBusy.Delete(uname)
+
+	if err := bck.Init(t.owner.bmd); err != nil {
+		nlog.Warningln(t.String()+":", "write-back", bck.Cname(""), err)
+		return
+	}
+	var (
+		cnt, errCnt atomic.Int64
+		opts        = &mpather.JgroupOpts{
+			CTs:                   []string{fs.ObjectType},
+			VisitObj:              func(lom *core.LOM, _ []byte) error { t._writeBack(lom, &cnt, &errCnt); return nil },
+			DoLoad:                mpather.Load,
+			SkipGloballyMisplaced: true,
+		}
+	)
+	opts.Bck.Copy(bck.Bucket())
+	jg := mpather.NewJoggerGroup(opts, cmn.GCO.Get(), "")
+	jg.Run()
+	<-jg.ListenFinished()
+	if err := jg.Stop(); err != nil {
+		nlog.Errorln(t.String()+":", "write-back", bck.Cname(""), err)
+	}
+	if n := cnt.Load(); n > 0 || errCnt.Load() > 0 {
+		nlog.Infoln(t.String()+":", "write-back", bck.Cname(""), "uploaded", n, "failed", errCnt.Load())
+	}
+}
+
+func (t *target) _writeBack(lom *core.LOM, cnt, errCnt *atomic.Int64) {
+	if !lom.IsPendingPut() {
+		return
+	}
+	bck := lom.Bck()
+	if bck.IsOffline() {
+		return // offline again
+	}
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil || !lom.IsPendingPut() {
+		return // removed or overwritten in the meantime
+	}
+	lmfh, err := lom.Open()
+	if err != nil {
+		errCnt.Inc()
+		nlog.Errorln(t.String()+":", "write-back", lom.Cname(), err)
+		return
+	}
+	backend := t.Backend(bck)
+	if ecode, err := backend.PutObj(lmfh, lom, nil); err != nil {
+		errCnt.Inc()
+		nlog.Errorln(t.String()+":", "write-back", lom.Cname(), err, ecode)
+		return
+	}
+	lom.ObjAttrs().DelCustomKeys(cmn.PendingPutObjMD)
+	if !bck.IsRemoteAIS() {
+		lom.SetCustomKey(cmn.SourceObjMD, backend.Provider())
+	}
+	if err := lom.PersistMain(); err != nil {
+		nlog.Errorln(t.String()+":", "write-back", lom.Cname(), err)
+	}
+	cnt.Inc()
+	if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+		nlog.Infoln(t.String()+":", "write-back", lom.Cname())
+	}
+}
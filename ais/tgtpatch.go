@@ -0,0 +1,262 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/ec"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+//
+// PATCH: PUT with Content-Range - overwrite (or extend) a byte range of an existing object
+// - the range must start within (or right at the end of) the object - no holes
+// - copy-on-write, under write lock: the original content with the new range spliced in gets
+//   written into a work file, checksummed on the fly (single pass), and then renamed over the
+//   (main) replica; the version is incremented
+// - upon any failure, the object (content and metadata) remains unchanged
+// - copies (if any) and EC slices (if configured) get regenerated
+// - not supported for remote buckets (that would require re-uploading the entire object)
+//
+
+type patchOI struct {
+	r       io.ReadCloser // content reader
+	t       *target       // this
+	lom     *core.LOM     // object to patch
+	started int64         // start time (nanoseconds)
+	off     int64         // range start
+	size    int64         // range size (Content-Length)
+}
+
+var errPatchRange = errors.New("invalid Content-Range")
+
+// (can be overridden in tests)
+var patchPersist = (*core.LOM).Persist
+
+// parse "bytes <start>-<end>/<total>" where total may be "*" (returns -1)
+// NOTE: the range must be fully specified (compare w/ GET's cos.ParseMultiRange)
+func parsePatchRange(s string, size int64) (off, total int64, err error) {
+	var end int64
+	if !strings.HasPrefix(s, cos.HdrContentRangeValPrefix) {
+		return 0, 0, fmt.Errorf("%w %q: expecting %q prefix", errPatchRange, s, cos.HdrContentRangeValPrefix)
+	}
+	rng, stotal, ok := strings.Cut(s[len(cos.HdrContentRangeValPrefix):], "/")
+	if !ok {
+		return 0, 0, fmt.Errorf("%w %q: missing total", errPatchRange, s)
+	}
+	sstart, send, ok := strings.Cut(rng, "-")
+	if !ok {
+		return 0, 0, fmt.Errorf("%w %q", errPatchRange, s)
+	}
+	if off, err = strconv.ParseInt(sstart, 10, 64); err != nil || off < 0 {
+		return 0, 0, fmt.Errorf("%w %q: bad start", errPatchRange, s)
+	}
+	if end, err = strconv.ParseInt(send, 10, 64); err != nil || end < off {
+		return 0, 0, fmt.Errorf("%w %q: bad end", errPatchRange, s)
+	}
+	total = -1
+	if stotal != "*" {
+		if total, err = strconv.ParseInt(stotal, 10, 64); err != nil || total <= end {
+			return 0, 0, fmt.Errorf("%w %q: bad total", errPatchRange, s)
+		}
+	}
+	if size >= 0 && end-off+1 != size {
+		return 0, 0, fmt.Errorf("%w %q: range size %d vs Content-Length %d", errPatchRange, s, end-off+1, size)
+	}
+	return off, total, nil
+}
+
+func (t *target) patchObject(w http.ResponseWriter, r *http.Request, lom *core.LOM, started int64) (int, error) {
+	if lom.Bck().IsRemote() {
+		return http.StatusNotImplemented, fmt.Errorf("%s: byte-range PUT is not supported for remote buckets", lom)
+	}
+	if r.ContentLength < 0 {
+		return http.StatusLengthRequired, fmt.Errorf("%s: byte-range PUT requires Content-Length", lom)
+	}
+	off, total, err := parsePatchRange(r.Header.Get(cos.HdrContentRange), r.ContentLength)
+	if err != nil {
+		return http.StatusRequestedRangeNotSatisfiable, err
+	}
+	p := &patchOI{r: r.Body, t: t, lom: lom, started: started, off: off, size: r.ContentLength}
+
+	lom.Lock(true)
+	ecode, err := p.do(total)
+	lom.Unlock(true)
+	if err != nil {
+		return ecode, err
+	}
+
+	if ecode, err := p.fini(); err != nil {
+		return ecode, err
+	}
+	w.Header().Set(apc.HdrObjVersion, lom.Version())
+	if cksum := lom.Checksum(); !cksum.IsEmpty() {
+		ty, val := cksum.Get()
+		w.Header().Set(apc.HdrObjCksumType, ty)
+		w.Header().Set(apc.HdrObjCksumVal, val)
+	}
+	lat := time.Now().UnixNano() - started
+	t.statsT.AddMany(
+		cos.NamedVal64{Name: stats.PutCount, Value: 1},
+		cos.NamedVal64{Name: stats.PutSize, Value: p.size},
+		cos.NamedVal64{Name: stats.PutLatency, Value: lat},
+	)
+	if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+		nlog.Infof("PATCH %s [%d, %d): %s", lom, off, off+p.size, lat)
+	}
+	return 0, nil
+}
+
+// (under wlock)
+func (p *patchOI) do(total int64) (int, error) {
+	lom := p.lom
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if cos.IsNotExist(err, 0) {
+			return http.StatusNotFound, err
+		}
+		return http.StatusInternalServerError, err
+	}
+	var (
+		size    = lom.Lsize()
+		newSize = max(size, p.off+p.size)
+	)
+	if p.off > size {
+		return http.StatusRequestedRangeNotSatisfiable,
+			fmt.Errorf("%s: range start %d is beyond the object size %d", lom, p.off, size)
+	}
+	if total >= 0 && total != newSize {
+		return http.StatusRequestedRangeNotSatisfiable,
+			fmt.Errorf("%s: resulting size %d vs Content-Range total %d", lom, newSize, total)
+	}
+
+	// keep the original (hard link: same inode, same xattr-stored metadata) to restore it
+	// if committing the new metadata fails
+	var (
+		workFQN = fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfilePatch)
+		origFQN = fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfilePatch)
+	)
+	cksum, err := p.write(workFQN, size)
+	if err == nil {
+		err = os.Link(lom.FQN, origFQN)
+	}
+	if err == nil {
+		if err = lom.RenameFinalize(workFQN); err != nil {
+			p.rmWork(origFQN)
+		}
+	}
+	if err != nil {
+		p.rmWork(workFQN)
+		return http.StatusInternalServerError, err
+	}
+
+	// update metadata
+	lom.SetSize(newSize)
+	lom.SetCksum(cksum)
+	lom.SetAtimeUnix(p.started)
+	if lom.VersionConf().Enabled {
+		if errV := lom.IncVersion(); errV != nil {
+			nlog.Errorln(errV) // (unlikely)
+		}
+	}
+	if err := patchPersist(lom); err != nil {
+		// restore the original content (and metadata)
+		lom.Uncache()
+		if errV := cos.Rename(origFQN, lom.FQN); errV != nil {
+			nlog.Errorln("PATCH", lom.String(), "failed to restore the original [", errV, "]")
+			p.rmWork(origFQN)
+		} else if errV := lom.Load(false /*cache it*/, true /*locked*/); errV != nil {
+			nlog.Errorln("PATCH", lom.String(), "failed to reload the original [", errV, "]")
+		}
+		return http.StatusInternalServerError, err
+	}
+	p.rmWork(origFQN)
+
+	if lom.HasCopies() {
+		if errV := lom.DelAllCopies(); errV != nil {
+			nlog.Errorln("PATCH", lom.String(), "failed to delete old copies:", errV)
+		}
+	}
+	return 0, nil
+}
+
+func (p *patchOI) rmWork(fqn string) {
+	if err := cos.RemoveFile(fqn); err != nil && !os.IsNotExist(err) {
+		nlog.Errorln("PATCH", p.lom.String(), "failed to remove", fqn, "[", err, "]")
+	}
+}
+
+// write [0, off) of the original, the new range, and the remaining original tail (if any)
+// into the work file - computing the resulting checksum along the way
+func (p *patchOI) write(workFQN string, size int64) (_ *cos.Cksum, err error) {
+	lom := p.lom
+	lmfh, err := lom.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer cos.Close(lmfh)
+	wfh, err := lom.CreateWork(workFQN)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		ckh       = cos.NewCksumHash(lom.CksumType())
+		buf, slab = p.t.gmm.AllocSize(max(size, p.off+p.size))
+	)
+	err = p._write(io.MultiWriter(wfh, ckh.H), lmfh, size, buf)
+	slab.Free(buf)
+	if err == nil {
+		err = wfh.Sync()
+	}
+	if errC := wfh.Close(); err == nil {
+		err = errC
+	}
+	if err != nil || ckh.Type() == cos.ChecksumNone {
+		return nil, err
+	}
+	ckh.Finalize()
+	return ckh.Clone(), nil
+}
+
+func (p *patchOI) _write(w io.Writer, lmfh io.ReaderAt, size int64, buf []byte) error {
+	tail := p.off + p.size
+	if _, err := io.CopyBuffer(w, io.NewSectionReader(lmfh, 0, p.off), buf); err != nil {
+		return err
+	}
+	n, err := io.CopyBuffer(w, io.LimitReader(p.r, p.size), buf)
+	if err != nil {
+		return err
+	}
+	if n != p.size {
+		return fmt.Errorf("%s: short read (%d vs Content-Length %d)", p.lom, n, p.size)
+	}
+	if tail < size {
+		_, err = io.CopyBuffer(w, io.NewSectionReader(lmfh, tail, size-tail), buf)
+	}
+	return err
+}
+
+func (p *patchOI) fini() (int, error) {
+	if p.lom.ECEnabled() {
+		if err := ec.ECM.EncodeObject(p.lom, nil); err != nil && err != ec.ErrorECDisabled {
+			return http.StatusInternalServerError, err
+		}
+	}
+	p.t.putMirror(p.lom)
+	return 0, nil
+}
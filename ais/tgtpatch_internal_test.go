@@ -0,0 +1,169 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/readers"
+)
+
+func TestParsePatchRange(t *testing.T) {
+	tests := []struct {
+		hdr   string
+		size  int64
+		off   int64
+		total int64
+		err   bool
+	}{
+		{hdr: "bytes 0-9/*", size: 10, off: 0, total: -1},
+		{hdr: "bytes 100-199/1000", size: 100, off: 100, total: 1000},
+		{hdr: "bytes 5-5/6", size: 1, off: 5, total: 6},
+
+		{hdr: "bytes 0-9/*", size: 11, err: true},     // Content-Length mismatch
+		{hdr: "bytes 9-0/*", size: 10, err: true},     // end < start
+		{hdr: "bytes 0-9/9", size: 10, err: true},     // total <= end
+		{hdr: "bytes 0-9", size: 10, err: true},       // missing total
+		{hdr: "bytes -9/*", size: 10, err: true},      // suffix range
+		{hdr: "bytes 0-/*", size: 10, err: true},      // open range
+		{hdr: "items 0-9/*", size: 10, err: true},     // unit
+		{hdr: "bytes abc-9/*", size: 10, err: true},   // not a number
+		{hdr: "bytes 0-9/total", size: 10, err: true}, // ditto
+	}
+	for _, test := range tests {
+		off, total, err := parsePatchRange(test.hdr, test.size)
+		if test.err {
+			if err == nil {
+				t.Errorf("%q: expected error", test.hdr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", test.hdr, err)
+			continue
+		}
+		if off != test.off || total != test.total {
+			t.Errorf("%q: expected (%d, %d), got (%d, %d)", test.hdr, test.off, test.total, off, total)
+		}
+	}
+}
+
+func patchPutObj(lom *core.LOM, content string) error {
+	poi := &putOI{
+		atime:   time.Now().UnixNano(),
+		t:       t,
+		lom:     lom,
+		r:       readers.NewBytes([]byte(content)),
+		workFQN: fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfilePut),
+		config:  cmn.GCO.Get(),
+	}
+	_, err := poi.putObject()
+	return err
+}
+
+func patchDo(lom *core.LOM, r io.Reader, off, size int64) (int, error) {
+	p := &patchOI{r: io.NopCloser(r), t: t, lom: lom, started: time.Now().UnixNano(), off: off, size: size}
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	return p.do(-1)
+}
+
+func TestPatchObject(t *testing.T) {
+	const orig = "0123456789"
+	lom := core.AllocLOM("patch-obj")
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(&cmn.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}); err != nil {
+		t.Fatal(err)
+	}
+	if err := patchPutObj(lom, orig); err != nil {
+		t.Fatal(err)
+	}
+	defer lom.RemoveMain()
+
+	check := func(tag, expected string) {
+		t.Helper()
+		b, err := os.ReadFile(lom.FQN)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != expected {
+			t.Fatalf("%s: expected %q, got %q", tag, expected, b)
+		}
+		loaded := core.AllocLOM(lom.ObjName)
+		defer core.FreeLOM(loaded)
+		if err := loaded.InitBck(lom.Bucket()); err != nil {
+			t.Fatal(err)
+		}
+		loaded.Uncache()
+		if err := loaded.Load(false, false); err != nil {
+			t.Fatal(err)
+		}
+		if loaded.Lsize() != int64(len(expected)) {
+			t.Fatalf("%s: expected size %d, got %d", tag, len(expected), loaded.Lsize())
+		}
+		// no work files left behind
+		err = filepath.WalkDir(testMountpath, func(path string, _ os.DirEntry, _ error) error {
+			if strings.HasPrefix(filepath.Base(path), fs.WorkfilePatch+".") {
+				t.Fatalf("%s: work file %q left behind", tag, path)
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// failed writes: the object remains intact
+	r := io.MultiReader(strings.NewReader("ab"), iotest.ErrReader(errors.New("connection reset")))
+	if _, err := patchDo(lom, r, 2, 5); err == nil {
+		t.Fatal("expected read error")
+	}
+	check("read error", orig)
+
+	if _, err := patchDo(lom, strings.NewReader("ab"), 2, 5); err == nil {
+		t.Fatal("expected short read error")
+	}
+	check("short read", orig)
+
+	if ecode, err := patchDo(lom, strings.NewReader("ab"), 11, 2); err == nil || ecode != http.StatusRequestedRangeNotSatisfiable {
+		t.Fatalf("expected %d, got %d(%v)", http.StatusRequestedRangeNotSatisfiable, ecode, err)
+	}
+	check("hole", orig)
+
+	// overwrite and extend
+	if _, err := patchDo(lom, strings.NewReader("abc"), 2, 3); err != nil {
+		t.Fatal(err)
+	}
+	check("overwrite", "01abc56789")
+	if _, err := patchDo(lom, strings.NewReader("XYZ"), 8, 3); err != nil {
+		t.Fatal(err)
+	}
+	check("extend", "01abc567XYZ")
+
+	// failing to commit metadata: the original content and metadata get restored
+	saved := patchPersist
+	patchPersist = func(*core.LOM) error { return errors.New("failed to persist") }
+	_, err := patchDo(lom, strings.NewReader("---"), 0, 3)
+	patchPersist = saved
+	if err == nil {
+		t.Fatal("expected persist error")
+	}
+	check("persist error", "01abc567XYZ")
+	if lom.Lsize() != 11 {
+		t.Fatalf("persist error: expected in-memory size 11, got %d", lom.Lsize())
+	}
+}
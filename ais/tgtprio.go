@@ -0,0 +1,95 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+)
+
+// Request priority (apc.HdrPriority or, when redirected, apc.QparamPriority):
+// - high:   admitted immediately
+// - normal: (default) yields to in-flight high-priority requests, for at most prioNormalWait
+// - low:    yields to in-flight high- and normal-priority requests, for at most prioLowWait
+// The (bounded) waits keep bulk, low-priority reads and writes from competing for
+// disks and network with latency-critical ones, without starving them.
+
+const (
+	prioLow = iota
+	prioNormal
+	prioHigh
+)
+
+const (
+	prioPoll       = 5 * time.Millisecond
+	prioNormalWait = 100 * time.Millisecond
+	prioLowWait    = time.Second
+)
+
+type prioGate struct {
+	high   atomic.Int32 // in-flight high-priority requests
+	normal atomic.Int32 // ditto, normal
+}
+
+func (t *target) prioEnter(w http.ResponseWriter, r *http.Request, dpq *dpq) (int, bool) {
+	level, err := prioLevel(r, dpq)
+	if err != nil {
+		t.writeErr(w, r, err)
+		return 0, false
+	}
+	t.prio.enter(level)
+	return level, true
+}
+
+func prioLevel(r *http.Request, dpq *dpq) (int, error) {
+	pri := r.Header.Get(apc.HdrPriority)
+	if pri == "" {
+		pri = dpq.priority
+	}
+	return prioParse(pri)
+}
+
+func prioParse(pri string) (int, error) {
+	switch pri {
+	case "", apc.PriorityNormal:
+		return prioNormal, nil
+	case apc.PriorityHigh:
+		return prioHigh, nil
+	case apc.PriorityLow:
+		return prioLow, nil
+	default:
+		return 0, fmt.Errorf("invalid request priority %q (expecting one of: %q, %q, %q)",
+			pri, apc.PriorityHigh, apc.PriorityNormal, apc.PriorityLow)
+	}
+}
+
+func (g *prioGate) enter(level int) {
+	switch level {
+	case prioHigh:
+		g.high.Inc()
+	case prioNormal:
+		for waited := time.Duration(0); waited < prioNormalWait && g.high.Load() > 0; waited += prioPoll {
+			time.Sleep(prioPoll)
+		}
+		g.normal.Inc()
+	default:
+		for waited := time.Duration(0); waited < prioLowWait && g.high.Load()+g.normal.Load() > 0; waited += prioPoll {
+			time.Sleep(prioPoll)
+		}
+	}
+}
+
+func (g *prioGate) exit(level int) {
+	switch level {
+	case prioHigh:
+		g.high.Dec()
+	case prioNormal:
+		g.normal.Dec()
+	}
+}
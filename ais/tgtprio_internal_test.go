@@ -0,0 +1,66 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+)
+
+func TestPrioLevel(t *testing.T) {
+	tests := []struct {
+		hdr, qparam string
+		level       int
+		fail        bool
+	}{
+		{"", "", prioNormal, false},
+		{apc.PriorityHigh, "", prioHigh, false},
+		{"", apc.PriorityLow, prioLow, false},
+		{apc.PriorityLow, apc.PriorityHigh, prioLow, false}, // header takes precedence
+		{"urgent", "", 0, true},
+	}
+	for _, test := range tests {
+		r := &http.Request{Header: http.Header{}}
+		if test.hdr != "" {
+			r.Header.Set(apc.HdrPriority, test.hdr)
+		}
+		level, err := prioLevel(r, &dpq{priority: test.qparam})
+		if test.fail {
+			if err == nil {
+				t.Fatalf("%+v: expected error", test)
+			}
+			continue
+		}
+		if err != nil || level != test.level {
+			t.Fatalf("%+v: got (%d, %v)", test, level, err)
+		}
+	}
+}
+
+func TestPrioGate(t *testing.T) {
+	var g prioGate
+	g.enter(prioHigh)
+
+	started := time.Now()
+	g.enter(prioNormal)
+	if d := time.Since(started); d < prioNormalWait {
+		t.Fatalf("normal priority expected to yield to high for %v, got %v", prioNormalWait, d)
+	}
+	g.exit(prioNormal)
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		g.exit(prioHigh)
+	}()
+	started = time.Now()
+	g.enter(prioLow)
+	if d := time.Since(started); d >= prioLowWait {
+		t.Fatalf("low priority expected to proceed once high is done, waited %v", d)
+	}
+	g.exit(prioLow)
+}
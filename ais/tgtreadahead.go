@@ -0,0 +1,235 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Readahead (remote buckets only; see cmn.ReadaheadConf):
+// - object names are split as (prefix, index, suffix) by the last run of decimal digits,
+//   e.g. "train/shard-000123.tar" => ("train/shard-", 123, ".tar")
+// - (bucket, prefix, suffix) identifies a stream; GETs with strictly increasing indices
+//   (and gaps bounded by the number of targets - each target only sees its own share)
+//   make the stream sequential
+// - for a sequential stream, the target prefetches the next readahead.depth objects
+//   that it (HRW) owns, unless the total size of the prefetched-but-not-yet-read
+//   objects exceeds readahead.max_inflight
+// - a prefetched object read within readahead.max_age is a hit; otherwise, a miss
+//   (compare hits vs misses to decide whether readahead helps)
+
+const (
+	raMinSeq     = 3               // consecutive increasing GETs to consider the stream sequential
+	raMaxWorkers = 4               // max concurrent prefetches (per target)
+	raMaxStreams = 1024            // max tracked streams (per target)
+	raStreamIdle = 5 * time.Minute // forget idle streams
+	raHkIval     = 30 * time.Second
+)
+
+type (
+	readahead struct {
+		t        *target
+		streams  map[string]*raStream // by bucket + prefix + suffix
+		pending  map[string]*raEntry  // prefetched and not yet read, by uname
+		inflight atomic.Int64         // total size of pending
+		workers  chan struct{}
+		mu       sync.Mutex
+	}
+	raStream struct {
+		last  int64 // most recent GET index
+		ahead int64 // highest index considered for prefetching so far
+		atime int64 // last access
+		seq   int   // consecutive increasing GETs
+	}
+	raEntry struct {
+		size    int64
+		started int64
+	}
+)
+
+func (ra *readahead) init(t *target) {
+	ra.t = t
+	ra.streams = make(map[string]*raStream, 16)
+	ra.pending = make(map[string]*raEntry, 16)
+	ra.workers = make(chan struct{}, raMaxWorkers)
+	hk.Reg("readahead"+hk.NameSuffix, ra.housekeep, raHkIval)
+}
+
+// split object name by the last run of decimal digits
+func raSplit(objName string) (prefix, suffix string, idx int64, width int, ok bool) {
+	end := len(objName)
+	for end > 0 && !_isdigit(objName[end-1]) {
+		end--
+	}
+	if end == 0 {
+		return
+	}
+	start := end - 1
+	for start > 0 && _isdigit(objName[start-1]) {
+		start--
+	}
+	if end-start > 18 { // (int64)
+		return
+	}
+	idx, err := strconv.ParseInt(objName[start:end], 10, 64)
+	if err != nil {
+		return
+	}
+	return objName[:start], objName[end:], idx, end - start, true
+}
+
+func _isdigit(c byte) bool { return c >= '0' && c <= '9' }
+
+func raName(prefix, suffix string, idx int64, width int) string {
+	s := strconv.FormatInt(idx, 10)
+	for len(s) < width {
+		s = "0" + s
+	}
+	return prefix + s + suffix
+}
+
+// GET datapath (remote buckets)
+func (ra *readahead) onGet(lom *core.LOM) {
+	config := cmn.GCO.Get()
+	if !config.Readahead.Enabled {
+		return
+	}
+	now := time.Now().UnixNano()
+	ra.mu.Lock()
+	if e, ok := ra.pending[lom.Uname()]; ok {
+		delete(ra.pending, lom.Uname())
+		ra.inflight.Sub(e.size)
+		ra.t.statsT.Inc(stats.ReadaheadHitCount)
+	}
+	prefix, suffix, idx, width, ok := raSplit(lom.ObjName)
+	if !ok {
+		ra.mu.Unlock()
+		return
+	}
+	var (
+		smap   = ra.t.owner.smap.get()
+		maxGap = int64(max(smap.CountActiveTs(), 1)) << 1
+		key    = string(lom.Bck().MakeUname(prefix + "\x00" + suffix))
+		s, ok2 = ra.streams[key]
+	)
+	if !ok2 {
+		if len(ra.streams) >= raMaxStreams {
+			ra.mu.Unlock()
+			return
+		}
+		s = &raStream{last: idx, ahead: idx, atime: now, seq: 1}
+		ra.streams[key] = s
+		ra.mu.Unlock()
+		return
+	}
+	if idx > s.last && idx-s.last <= maxGap {
+		s.seq++
+	} else {
+		s.seq = 1
+		s.ahead = idx
+	}
+	s.last, s.atime = idx, now
+	if s.seq < raMinSeq {
+		ra.mu.Unlock()
+		return
+	}
+	from, to := max(idx, s.ahead)+1, idx+int64(config.Readahead.Depth)
+	if from > to {
+		ra.mu.Unlock()
+		return
+	}
+	s.ahead = to
+	ra.mu.Unlock()
+
+	bck := lom.Bucket()
+	for i := from; i <= to; i++ {
+		if ra.inflight.Load() >= int64(config.Readahead.MaxInflight) {
+			return
+		}
+		name := raName(prefix, suffix, i, width)
+		tsi, err := smap.HrwName2T(bck.MakeUname(name))
+		if err != nil || tsi.ID() != ra.t.SID() {
+			continue
+		}
+		select {
+		case ra.workers <- struct{}{}:
+			go ra.prefetch(bck, name)
+		default:
+			return // busy
+		}
+	}
+}
+
+func (ra *readahead) prefetch(bck *cmn.Bck, objName string) {
+	defer func() { <-ra.workers }()
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck); err != nil {
+		return
+	}
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err == nil {
+		return // present
+	}
+	// not setting atime - see xs/prefetch
+	lom.SetAtimeUnix(-time.Now().UnixNano())
+	if _, err := ra.t.GetCold(context.Background(), lom, cmn.OwtGetPrefetchLock); err != nil {
+		if !cos.IsNotExist(err, 0) && err != cmn.ErrSkip && cmn.Rom.FastV(4, cos.SmoduleAIS) {
+			nlog.Warningln(ra.t.String(), "readahead", lom.Cname(), "failed:", err)
+		}
+		return
+	}
+	size := lom.Lsize()
+	ra.mu.Lock()
+	if _, ok := ra.pending[lom.Uname()]; !ok {
+		ra.pending[lom.Uname()] = &raEntry{size: size, started: time.Now().UnixNano()}
+		ra.inflight.Add(size)
+	}
+	ra.mu.Unlock()
+	ra.t.statsT.AddMany(
+		cos.NamedVal64{Name: stats.ReadaheadCount, Value: 1},
+		cos.NamedVal64{Name: stats.ReadaheadSize, Value: size},
+	)
+}
+
+func (ra *readahead) housekeep() time.Duration {
+	var (
+		config = cmn.GCO.Get()
+		maxAge = config.Readahead.MaxAge.D()
+		now    = time.Now().UnixNano()
+		misses int64
+	)
+	ra.mu.Lock()
+	for uname, e := range ra.pending {
+		switch {
+		case time.Duration(now-e.started) > maxAge:
+			misses++
+		case config.Readahead.Enabled:
+			continue
+		}
+		delete(ra.pending, uname)
+		ra.inflight.Sub(e.size)
+	}
+	for key, s := range ra.streams {
+		if !config.Readahead.Enabled || time.Duration(now-s.atime) > raStreamIdle {
+			delete(ra.streams, key)
+		}
+	}
+	ra.mu.Unlock()
+	if misses > 0 {
+		ra.t.statsT.Add(stats.ReadaheadMissCount, misses)
+	}
+	return raHkIval
+}
@@ -0,0 +1,42 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import "testing"
+
+func TestReadaheadSplit(t *testing.T) {
+	tests := []struct {
+		name           string
+		prefix, suffix string
+		idx            int64
+		width          int
+		ok             bool
+	}{
+		{"train/shard-000123.tar", "train/shard-", ".tar", 123, 6, true},
+		{"v2/img-0009.jpg", "v2/img-", ".jpg", 9, 4, true},
+		{"x7", "x", "", 7, 1, true},
+		{"a/b/c", "", "", 0, 0, false},
+		{"n-1234567890123456789.bin", "", "", 0, 0, false}, // too many digits
+	}
+	for _, tc := range tests {
+		prefix, suffix, idx, width, ok := raSplit(tc.name)
+		if ok != tc.ok {
+			t.Fatalf("%q: expected ok=%t, got %t", tc.name, tc.ok, ok)
+		}
+		if !ok {
+			continue
+		}
+		if prefix != tc.prefix || suffix != tc.suffix || idx != tc.idx || width != tc.width {
+			t.Fatalf("%q: got (%q, %q, %d, %d)", tc.name, prefix, suffix, idx, width)
+		}
+		if s := raName(prefix, suffix, idx, width); s != tc.name {
+			t.Fatalf("%q: roundtrip => %q", tc.name, s)
+		}
+	}
+	// index outgrowing zero-padded width
+	if s := raName("s-", ".tar", 1000, 3); s != "s-1000.tar" {
+		t.Fatalf("expected %q, got %q", "s-1000.tar", s)
+	}
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/NVIDIA/aistore/ais/s3"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/feat"
@@ -41,6 +42,9 @@ func (t *target) s3Handler(w http.ResponseWriter, r *http.Request) {
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+	if r.Header.Get(s3.HdrOrigin) != "" {
+		s3CORS(w, r, t.owner.bmd, apiItems[0])
+	}
 
 	switch r.Method {
 	case http.MethodHead:
@@ -49,6 +53,10 @@ func (t *target) s3Handler(w http.ResponseWriter, r *http.Request) {
 		t.getObjS3(w, r, apiItems)
 	case http.MethodPut:
 		config := cmn.GCO.Get()
+		if err := s3Payload(r, config); err != nil {
+			s3.WriteErr(w, r, err, http.StatusForbidden)
+			return
+		}
 		t.putCopyMpt(w, r, config, apiItems)
 	case http.MethodDelete:
 		q := r.URL.Query()
@@ -58,6 +66,10 @@ func (t *target) s3Handler(w http.ResponseWriter, r *http.Request) {
 			t.delObjS3(w, r, apiItems)
 		}
 	case http.MethodPost:
+		if err := s3Payload(r, cmn.GCO.Get()); err != nil {
+			s3.WriteErr(w, r, err, http.StatusForbidden)
+			return
+		}
 		t.postObjS3(w, r, apiItems)
 	default:
 		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodHead, http.MethodPut, http.MethodPost)
@@ -191,6 +203,12 @@ func (t *target) putObjS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck,
 			return
 		}
 	}
+	if ecode, err := checkPayload(r, lom.Bck(), lom.ObjName); err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+	r.Body = newPayloadReader(r.Body, &lom.Bprops().Payload, lom.Cname())
+
 	started := time.Now()
 	lom.SetAtimeUnix(started.UnixNano())
 
@@ -222,6 +240,44 @@ func (t *target) putObjS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck,
 	dpqFree(dpq)
 }
 
+// request payload:
+//   - decode aws-chunked (streaming SigV4) payload
+//   - with AuthN enabled, verify it as well: chunk signatures or, otherwise, sha256 of the
+//     entire payload vs x-amz-content-sha256 (the request itself has already been
+//     authenticated by the proxy)
+func s3Payload(r *http.Request, config *cmn.Config) error {
+	authn := config.Auth.Enabled && !config.Features.IsSet(feat.S3PresignedRequest)
+	switch {
+	case s3.IsStreamingPayload(r.Header):
+		return s3DecodeChunked(r, config, authn)
+	case authn:
+		return s3.VerifyPayload(r)
+	default:
+		return nil
+	}
+}
+
+func s3DecodeChunked(r *http.Request, config *cmn.Config, authn bool) error {
+	sig, err := s3.ParseSigV4(r)
+	if err != nil {
+		return err
+	}
+	if !authn {
+		return s3.DecodeChunked(r, sig, nil)
+	}
+	// with AuthN, streaming payload must be signed (compare w/ p.s3Auth)
+	if sig == nil || sig.SecurityToken == "" {
+		return tok.ErrNoToken
+	}
+	secret := authSecret(config)
+	tk, err := tok.DecryptToken(sig.SecurityToken, secret)
+	if err != nil {
+		return err
+	}
+	key := sig.SigningKey(tok.S3SecretKey(sig.AccessKeyID, tk.UserID, secret))
+	return s3.DecodeChunked(r, sig, key)
+}
+
 // GET s3/<bucket-name[/<object-name>]
 func (t *target) getObjS3(w http.ResponseWriter, r *http.Request, items []string) {
 	bucket := items[0]
@@ -0,0 +1,112 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/ext/etl"
+	"github.com/NVIDIA/aistore/xact/xreg"
+	"github.com/NVIDIA/aistore/xact/xs"
+)
+
+// target side of the bucket sampling (see prxsample.go and xs/sample.go)
+
+const sampleDfltTimeout = 30 * time.Second // ETL: per-object transform
+
+// POST {apc.ActSampleBck} /v1/buckets/<bucket-name> (via proxy)
+// (the phase - apc.ActBegin or apc.ActCommit - in the message's name)
+func (t *target) sampleBck(w http.ResponseWriter, r *http.Request, bck *meta.Bck, msg *aisMsg) {
+	switch msg.Name {
+	case apc.ActBegin:
+		t.sampleBegin(w, r, bck, msg)
+	case apc.ActCommit:
+		t.sampleCommit(w, r, bck, msg)
+	default:
+		t.writeErrf(w, r, "%s: invalid phase %q", msg.Action, msg.Name)
+	}
+}
+
+// start sampling; respond with the number of local objects the sample is drawn from
+func (t *target) sampleBegin(w http.ResponseWriter, r *http.Request, bck *meta.Bck, msg *aisMsg) {
+	smsg := &apc.SampleMsg{}
+	if err := cos.MorphMarshal(msg.Value, smsg); err != nil {
+		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+		return
+	}
+	if err := smsg.Validate(); err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	args := &xreg.SampleArgs{Msg: smsg}
+	if smsg.ETLName != "" {
+		comm, err := etl.GetCommunicator(smsg.ETLName)
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		if err := comm.Degraded(); err != nil {
+			t.writeErr(w, r, err, http.StatusServiceUnavailable)
+			return
+		}
+		timeout := smsg.Timeout
+		if timeout == 0 {
+			timeout = cos.Duration(sampleDfltTimeout)
+		}
+		tcbmsg := &apc.TCBMsg{Transform: apc.Transform{Name: smsg.ETLName, Timeout: timeout}}
+		dp, err := etl.NewOfflineDP(tcbmsg, cmn.GCO.Get())
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		args.DP = dp
+	}
+	rns := xreg.RenewSample(msg.UUID, bck, args)
+	if rns.Err != nil {
+		t.writeErr(w, r, rns.Err)
+		return
+	}
+	xsample := rns.Entry.Get().(*xs.XactSample)
+	n, err := xsample.Walked()
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	t.writeJSON(w, r, &cmn.SampleRes{Objects: n}, msg.Action)
+}
+
+// process this target's share (by target ID) of the total sample size; respond with the results
+func (t *target) sampleCommit(w http.ResponseWriter, r *http.Request, bck *meta.Bck, msg *aisMsg) {
+	shares := make(map[string]int)
+	if err := cos.MorphMarshal(msg.Value, &shares); err != nil {
+		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+		return
+	}
+	xctn, err := xreg.GetXact(msg.UUID)
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	if xctn == nil {
+		err := cos.NewErrNotFound(t, apc.ActSampleBck+" job "+msg.UUID)
+		t.writeErr(w, r, err, http.StatusNotFound)
+		return
+	}
+	res, err := xctn.(*xs.XactSample).Commit(shares[t.SID()])
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+		nlog.Infoln(t.String(), xctn.Name(), bck.Cname(""), "sampled", res.Sampled, "out of", res.Objects)
+	}
+	t.writeJSON(w, r, res, msg.Action)
+}
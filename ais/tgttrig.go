@@ -0,0 +1,244 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/ext/dsort"
+	"github.com/NVIDIA/aistore/hk"
+)
+
+// Bucket event hooks (see cmn.TriggerConf):
+// - upon committed client PUT or DELETE of a matching object, the target that stores the object
+//   queues the corresponding event (t2t PUTs - rebalance, copies, ETL output, etc. - do not fire)
+// - queued events get delivered via the primary proxy as:
+//   * apc.ActDsort:         dsort job from the named template, with the object as its (only) input shard
+//   * apc.ActETLObjects:    the object transformed by the named ETL into the destination bucket
+//   * apc.ActDeleteObjects: same-named object deleted from the destination bucket
+// - failed deliveries are retried with exponential backoff, up to trigMaxAttempts
+// - pending (and in-flight) events are periodically persisted (fname.Triggers) and reloaded
+//   upon restart - hence, at-least-once (and not exactly-once) delivery
+
+const (
+	trigHkIval      = time.Second
+	trigBackoffMin  = time.Second
+	trigBackoffMax  = 5 * time.Minute
+	trigMaxAttempts = 16
+	trigMaxPending  = 64 * 1024
+)
+
+type (
+	trigEvent struct {
+		Bck     cmn.Bck `json:"bck"`
+		ObjName string  `json:"obj"`
+		Name    string  `json:"name"`           // trigger name (cmn.TriggerConf.Name)
+		Next    int64   `json:"next,omitempty"` // not before (unix nanoseconds)
+		Attempt int     `json:"attempt,omitempty"`
+	}
+	triggers struct {
+		t        *target
+		fpath    string
+		pending  []*trigEvent
+		inflight []*trigEvent
+		mu       sync.Mutex
+		busy     atomic.Bool
+		dirty    bool
+	}
+)
+
+func (tr *triggers) init(t *target, config *cmn.Config) {
+	tr.t = t
+	tr.fpath = filepath.Join(config.ConfigDir, fname.Triggers)
+	if _, err := jsp.Load(tr.fpath, &tr.pending, jsp.Options{Checksum: true}); err != nil && !os.IsNotExist(err) {
+		nlog.Warningln(t.String(), "failed to load pending trigger events:", err)
+		tr.pending = nil
+	}
+	if l := len(tr.pending); l > 0 {
+		nlog.Infoln(t.String(), "pending trigger events:", l)
+	}
+	hk.Reg("triggers"+hk.NameSuffix, tr.housekeep, trigHkIval)
+}
+
+// post-commit PUT or DELETE
+func (tr *triggers) fire(lom *core.LOM, event string) {
+	trigs := lom.Bprops().Triggers
+	for i := range trigs {
+		tc := &trigs[i]
+		if !tc.Match(event, lom.ObjName) {
+			continue
+		}
+		ev := &trigEvent{Bck: *lom.Bucket(), ObjName: lom.ObjName, Name: tc.Name}
+		tr.mu.Lock()
+		if len(tr.pending) >= trigMaxPending {
+			tr.mu.Unlock()
+			nlog.Errorln(tr.t.String(), "too many pending trigger events - dropping", tc.Name, "upon", event, lom.Cname())
+			return
+		}
+		tr.pending = append(tr.pending, ev)
+		tr.dirty = true
+		tr.mu.Unlock()
+	}
+}
+
+func (tr *triggers) housekeep() time.Duration {
+	tr.persist()
+	if tr.t.ClusterStarted() && tr.busy.CAS(false, true) {
+		go tr.deliver()
+	}
+	return trigHkIval
+}
+
+func (tr *triggers) persist() {
+	tr.mu.Lock()
+	if !tr.dirty {
+		tr.mu.Unlock()
+		return
+	}
+	events := make([]*trigEvent, 0, len(tr.pending)+len(tr.inflight))
+	events = append(events, tr.inflight...)
+	events = append(events, tr.pending...)
+	tr.dirty = false
+	tr.mu.Unlock()
+
+	if len(events) == 0 {
+		if err := cos.RemoveFile(tr.fpath); err != nil {
+			nlog.Errorln(tr.t.String(), "failed to remove", tr.fpath, err)
+		}
+		return
+	}
+	if err := jsp.Save(tr.fpath, events, jsp.Options{Checksum: true}, nil); err != nil {
+		nlog.Errorln(tr.t.String(), "failed to persist pending trigger events:", err)
+	}
+}
+
+func (tr *triggers) deliver() {
+	defer tr.busy.Store(false)
+
+	// move due events to in-flight
+	now := time.Now().UnixNano()
+	tr.mu.Lock()
+	if len(tr.pending) == 0 {
+		tr.mu.Unlock()
+		return
+	}
+	pending := make([]*trigEvent, 0, len(tr.pending))
+	for _, ev := range tr.pending {
+		if ev.Next <= now {
+			tr.inflight = append(tr.inflight, ev)
+		} else {
+			pending = append(pending, ev)
+		}
+	}
+	tr.pending = pending
+	inflight := tr.inflight
+	tr.mu.Unlock()
+
+	var retry []*trigEvent
+	for _, ev := range inflight {
+		status, err := tr.do(ev)
+		if err == nil {
+			continue
+		}
+		ev.Attempt++
+		switch {
+		case status == http.StatusBadRequest || status == http.StatusUnauthorized || status == http.StatusForbidden:
+			nlog.Errorln(tr.t.String(), "trigger", ev.Name, "upon", ev.Bck.Cname(ev.ObjName), "failed (not retrying):", err)
+		case ev.Attempt >= trigMaxAttempts:
+			nlog.Errorln(tr.t.String(), "trigger", ev.Name, "upon", ev.Bck.Cname(ev.ObjName), "failed after",
+				ev.Attempt, "attempts (giving up):", err)
+		default:
+			backoff := min(trigBackoffMin<<(ev.Attempt-1), trigBackoffMax)
+			ev.Next = time.Now().Add(backoff).UnixNano()
+			retry = append(retry, ev)
+			if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+				nlog.Infoln(tr.t.String(), "trigger", ev.Name, "upon", ev.Bck.Cname(ev.ObjName), "retrying in", backoff, err)
+			}
+		}
+	}
+
+	tr.mu.Lock()
+	tr.inflight = nil
+	tr.pending = append(tr.pending, retry...)
+	tr.dirty = true
+	tr.mu.Unlock()
+}
+
+// run the action via primary (note: intra-cluster call bypasses access control)
+func (tr *triggers) do(ev *trigEvent) (int, error) {
+	bck := meta.CloneBck(&ev.Bck)
+	if err := bck.Init(tr.t.owner.bmd); err != nil {
+		if cmn.IsErrBucketNought(err) {
+			return 0, nil // bucket's gone - nothing to do
+		}
+		return 0, err
+	}
+	tc := bck.Props.Triggers.Get(ev.Name)
+	if tc == nil {
+		return 0, nil // removed in the meantime
+	}
+
+	var req cmn.HreqArgs
+	switch tc.Action {
+	case apc.ActDsort:
+		rs := &dsort.RequestSpec{
+			JobTemplate: tc.Arg,
+			InputBck:    ev.Bck,
+			InputFormat: apc.ListRange{ObjNames: []string{ev.ObjName}},
+		}
+		req = cmn.HreqArgs{Method: http.MethodPost, Path: apc.URLPathdSort.S, Body: cos.MustMarshal(rs)}
+	case apc.ActETLObjects:
+		msg := &cmn.TCObjsMsg{ToBck: tc.ToBck}
+		msg.ObjNames = []string{ev.ObjName}
+		msg.Transform.Name = tc.Arg
+		req = cmn.HreqArgs{
+			Method: http.MethodPost,
+			Path:   apc.URLPathBuckets.Join(ev.Bck.Name),
+			Query:  ev.Bck.NewQuery(),
+			Body:   cos.MustMarshal(apc.ActMsg{Action: apc.ActETLObjects, Value: msg}),
+		}
+	case apc.ActDeleteObjects:
+		msg := &apc.ListRange{ObjNames: []string{ev.ObjName}}
+		req = cmn.HreqArgs{
+			Method: http.MethodDelete,
+			Path:   apc.URLPathBuckets.Join(tc.ToBck.Name),
+			Query:  tc.ToBck.NewQuery(),
+			Body:   cos.MustMarshal(apc.ActMsg{Action: apc.ActDeleteObjects, Value: msg}),
+		}
+	default:
+		return http.StatusBadRequest, cmn.NewErrUnsupp("trigger", tc.Action)
+	}
+
+	smap := tr.t.owner.smap.get()
+	if err := smap.validate(); err != nil {
+		return 0, err
+	}
+	req.Base = smap.Primary.URL(cmn.NetPublic)
+	req.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	cargs := allocCargs()
+	{
+		cargs.si = smap.Primary
+		cargs.req = req
+		cargs.timeout = apc.DefaultTimeout
+	}
+	res := tr.t.call(cargs, smap)
+	freeCargs(cargs)
+	status, err := res.status, res.err
+	freeCR(res)
+	return status, err
+}
@@ -0,0 +1,168 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Per-object TTL:
+// - PUT with apc.HdrObjTTL header (or apc.QparamTTL query) records expiration time
+//   in the object's custom metadata (cmn.ExpiresObjMD)
+// - the target keeps track of the earliest known expiration and, once reached,
+//   runs apc.ActExpireObjs xaction that deletes all expired objects
+// - fname.TTLMarker indicates that objects with expiration may exist (survives restarts)
+// - expired but not yet deleted objects are not listed (see xs.walkInfo)
+
+const ttlHkIval = time.Minute
+
+type ttlExp struct {
+	t      *target
+	next   atomic.Int64 // earliest known expiration (unix nanoseconds); zero: none
+	marked atomic.Bool  // fname.TTLMarker persisted
+}
+
+func (te *ttlExp) init(t *target) {
+	te.t = t
+	if fs.MarkerExists(fname.TTLMarker) {
+		te.marked.Store(true)
+		te.next.Store(1) // unknown - run asap
+	}
+	hk.Reg("ttl"+hk.NameSuffix, te.housekeep, ttlHkIval)
+}
+
+// PUT with TTL
+func (te *ttlExp) add(expires int64) {
+	if !te.marked.Load() && te.marked.CAS(false, true) {
+		if fatalErr, writeErr := fs.PersistMarker(fname.TTLMarker); fatalErr != nil || writeErr != nil {
+			nlog.Errorln(te.t.String(), "failed to persist", fname.TTLMarker, "marker:", fatalErr, writeErr)
+		}
+	}
+	te.setEarliest(expires)
+}
+
+func (te *ttlExp) setEarliest(expires int64) {
+	for {
+		next := te.next.Load()
+		if next != 0 && next <= expires {
+			return
+		}
+		if te.next.CAS(next, expires) {
+			return
+		}
+	}
+}
+
+func (te *ttlExp) housekeep() time.Duration {
+	next := te.next.Load()
+	if next == 0 || next > time.Now().UnixNano() || !te.t.ClusterStarted() {
+		return ttlHkIval
+	}
+	te.next.Store(0) // (PUTs in the meantime will update it)
+	rns := xreg.RenewExpireObjs(cos.GenUUID(), te.done)
+	if rns.Err != nil {
+		if !cmn.IsErrXactUsePrev(rns.Err) {
+			nlog.Errorln(te.t.String(), "failed to start", apc.ActExpireObjs, rns.Err)
+		}
+		te.setEarliest(next) // retry next time
+	}
+	return ttlHkIval
+}
+
+// xaction done: earliest expiration of the remaining objects, if any
+func (te *ttlExp) done(earliest int64) {
+	if earliest != 0 {
+		te.setEarliest(earliest)
+		return
+	}
+	if te.next.Load() == 0 && te.marked.CAS(true, false) {
+		if err := fs.RemoveMarker(fname.TTLMarker); err != nil {
+			nlog.Errorln(te.t.String(), "failed to remove", fname.TTLMarker, "marker:", err)
+		}
+	}
+}
+
+// PUT: parse and validate apc.HdrObjTTL (or apc.QparamTTL) - duration or seconds
+func (*ttlExp) parse(lom *core.LOM, r *http.Request, dpq *dpq) (expires int64, ecode int, err error) {
+	s := r.Header.Get(apc.HdrObjTTL)
+	if s == "" {
+		s = dpq.ttl
+	}
+	if s == "" {
+		return 0, 0, nil
+	}
+	if !lom.Bck().IsAIS() {
+		return 0, http.StatusNotImplemented,
+			fmt.Errorf("%s: TTL is supported only for ais:// buckets with no remote backend", lom.Cname())
+	}
+	ttl, err := time.ParseDuration(s)
+	if err != nil {
+		secs, errN := strconv.ParseInt(s, 10, 64)
+		if errN != nil {
+			return 0, http.StatusBadRequest, fmt.Errorf("%s: invalid TTL %q (expecting duration, e.g. \"12h\", or seconds)",
+				lom.Cname(), s)
+		}
+		ttl = time.Duration(secs) * time.Second
+	}
+	if ttl <= 0 {
+		return 0, http.StatusBadRequest, errors.New(lom.Cname() + ": TTL must be positive, got " + s)
+	}
+	return time.Now().Add(ttl).UnixNano(), 0, nil
+}
+
+// delete expired object (see xs.XactTTL): reload and re-check expiration
+// under the same write lock, to exclude racing with a concurrent overwrite
+// that may have removed (or extended) the object's TTL
+func (t *target) DeleteExpired(lom *core.LOM, now int64) (bool, error) {
+	lom.Lock(true)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		lom.Unlock(true)
+		if cos.IsNotExist(err, 0) {
+			return false, nil
+		}
+		return false, err
+	}
+	if expires := lom.Expires(); expires == 0 || expires > now {
+		lom.Unlock(true)
+		return false, nil
+	}
+	if lom.Bck().CloneOf() != nil {
+		// (writing tombstone takes the lock)
+		lom.Unlock(true)
+		ecode, err := t.DeleteObject(lom, false /*evict*/)
+		if err != nil && cos.IsNotExist(err, ecode) {
+			err = nil
+		}
+		return err == nil, err
+	}
+	ecode, err, _ := t.delobj(lom, false /*evict*/)
+	lom.Unlock(true)
+	if err != nil {
+		if cos.IsNotExist(err, ecode) {
+			return false, nil
+		}
+		t.statsT.IncErr(stats.ErrDeleteCount)
+		t.statsT.IncErr(stats.IOErrDeleteCount)
+		return false, err
+	}
+	t.statsT.Inc(stats.DeleteCount)
+	return true, nil
+}
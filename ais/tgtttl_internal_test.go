@@ -0,0 +1,83 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+)
+
+// zero expires: no TTL
+func ttlPutObj(objName, content string, expires int64) (*core.LOM, error) {
+	lom := core.AllocLOM(objName)
+	if err := lom.InitBck(&cmn.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}); err != nil {
+		return lom, err
+	}
+	if expires != 0 {
+		lom.SetCustomKey(cmn.ExpiresObjMD, cos.UnixNano2S(expires))
+	}
+	return lom, patchPutObj(lom, content)
+}
+
+func ttlDelete(lom *core.LOM, now int64) (bool, error) { return t.DeleteExpired(lom, now) }
+
+func TestDeleteExpired(t *testing.T) {
+	var (
+		now  = time.Now().UnixNano()
+		past = now - int64(time.Minute)
+	)
+
+	// expired
+	lom, err := ttlPutObj("ttl-expired", "expired", past)
+	defer core.FreeLOM(lom)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deleted, err := ttlDelete(lom, now)
+	if err != nil || !deleted {
+		t.Fatalf("expected expired object to be deleted, got (%t, %v)", deleted, err)
+	}
+	if _, err := os.Stat(lom.FQN); !os.IsNotExist(err) {
+		t.Fatalf("expected %s to be removed, got %v", lom.FQN, err)
+	}
+
+	// overwrite race: the jogger loads expired object that is then overwritten with no TTL
+	stale, err := ttlPutObj("ttl-overwritten", "expired", past)
+	defer core.FreeLOM(stale)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := stale.Load(false /*cache it*/, false /*locked*/); err != nil {
+		t.Fatal(err)
+	}
+	if stale.Expires() != past {
+		t.Fatalf("expected expiration %d, got %d", past, stale.Expires())
+	}
+	lom2, err := ttlPutObj("ttl-overwritten", "overwritten", 0)
+	defer core.FreeLOM(lom2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deleted, err = ttlDelete(stale, now)
+	if err != nil || deleted {
+		t.Fatalf("expected overwritten object to remain, got (%t, %v)", deleted, err)
+	}
+	b, err := os.ReadFile(lom2.FQN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "overwritten" {
+		t.Fatalf("expected %q, got %q", "overwritten", string(b))
+	}
+	if stale.Expires() != 0 {
+		t.Fatalf("expected no expiration upon reload, got %d", stale.Expires())
+	}
+}
@@ -93,6 +93,15 @@ func (t *target) txnHandler(w http.ResponseWriter, r *http.Request) {
 		t.writeErr(w, r, err)
 		return
 	}
+	if c.phase == apc.ActBegin || c.phase == apc.ActCommit {
+		if bck := t.frz.txnBck(c); bck != nil {
+			if err := t.frz.enter(bck); err != nil {
+				t.writeErr(w, r, err, http.StatusConflict)
+				return
+			}
+			defer t.frz.exit(bck)
+		}
+	}
 
 	switch msg.Action {
 	case apc.ActCreateBck, apc.ActAddRemoteBck:
@@ -148,7 +157,7 @@ func (t *target) txnHandler(w http.ResponseWriter, r *http.Request) {
 		xid, err = t.createArchMultiObj(c)
 	case apc.ActStartMaintenance, apc.ActDecommissionNode, apc.ActShutdownNode:
 		err = t.beginRm(c)
-	case apc.ActDestroyBck, apc.ActEvictRemoteBck:
+	case apc.ActDestroyBck, apc.ActEvictRemoteBck, apc.ActUndeleteBck:
 		err = t.destroyBucket(c)
 	case apc.ActPromote:
 		hdr := w.Header()
@@ -249,14 +258,14 @@ func (t *target) makeNCopies(c *txnSrv) (string, error) {
 		if err := c.bck.Init(t.owner.bmd); err != nil {
 			return "", err
 		}
-		copies, err := _parseNCopies(c.msg.Value)
+		mnc, err := _parseMNC(c.msg.Value)
 		debug.AssertNoErr(err)
 		txn, err := t.transactions.find(c.uuid, "")
 		if err != nil {
 			return "", err
 		}
 		txnMnc := txn.(*txnMakeNCopies)
-		debug.Assert(txnMnc.newCopies == copies)
+		debug.Assert(txnMnc.newCopies == mnc.Copies)
 
 		// wait for newBMD w/timeout
 		if err = t.transactions.wait(txn, c.timeout.netw, c.timeout.host); err != nil {
@@ -264,7 +273,8 @@ func (t *target) makeNCopies(c *txnSrv) (string, error) {
 		}
 
 		// do the work in xaction
-		rns := xreg.RenewBckMakeNCopies(c.bck, c.uuid, "mnc-actmnc", int(copies))
+		args := &xreg.MNCArgs{Tag: "mnc-actmnc", Prefix: mnc.Prefix, Copies: int(mnc.Copies), MaxThroughput: int64(mnc.MaxThroughput)}
+		rns := xreg.RenewBckMakeNCopies(c.bck, c.uuid, args)
 		if rns.Err != nil {
 			return "", fmt.Errorf("%s %s: %v", t, txn, rns.Err)
 		}
@@ -283,8 +293,9 @@ func (t *target) makeNCopies(c *txnSrv) (string, error) {
 
 func (t *target) validateMakeNCopies(bck *meta.Bck, msg *aisMsg) (curCopies, newCopies int64, err error) {
 	curCopies = bck.Props.Mirror.Copies
-	newCopies, err = _parseNCopies(msg.Value)
+	mnc, err := _parseMNC(msg.Value)
 	if err == nil {
+		newCopies = mnc.Copies
 		err = fs.ValidateNCopies(t.si.Name(), int(newCopies))
 	}
 	// (consider adding "force" option similar to CopyBckMsg.Force)
@@ -345,8 +356,8 @@ func (t *target) setBprops(c *txnSrv) (string, error) {
 			return "", cmn.NewErrFailedTo(t, "commit", txn, err)
 		}
 		if _reMirror(bprops, nprops) {
-			n := int(nprops.Mirror.Copies)
-			rns := xreg.RenewBckMakeNCopies(c.bck, c.uuid, "mnc-setprops", n)
+			args := &xreg.MNCArgs{Tag: "mnc-setprops", Copies: _mirrorCopies(nprops), Reconfig: true}
+			rns := xreg.RenewBckMakeNCopies(c.bck, c.uuid, args)
 			if rns.Err != nil {
 				return "", fmt.Errorf("%s %s: %v", t, txn, rns.Err)
 			}
@@ -358,22 +369,27 @@ func (t *target) setBprops(c *txnSrv) (string, error) {
 			xid = xctn.ID()
 		}
 		if _, reec := _reEC(bprops, nprops, c.bck, nil /*smap*/); reec {
-			flt := xreg.Flt{Kind: apc.ActECEncode, Bck: c.bck}
-			xreg.DoAbort(flt, errors.New("re-ec"))
-			rns := xreg.RenewECEncode(c.bck, c.uuid, apc.ActCommit)
-			if rns.Err != nil {
-				return "", rns.Err
+			reslice := _reslice(&bprops.EC, &nprops.EC)
+			if !reslice {
+				flt := xreg.Flt{Kind: apc.ActECEncode, Bck: c.bck}
+				xreg.DoAbort(flt, errors.New("re-ec"))
+			}
+			xctn, err := t._startEC(c, reslice)
+			if err != nil {
+				return "", err
 			}
-			xctn := rns.Entry.Get()
-			c.addNotif(xctn) // ditto
-			xact.GoRunW(xctn)
-
 			if xid == "" {
 				xid = xctn.ID()
 			} else {
 				xid = "" // not supporting multiple..
 			}
 		}
+		if nprops.Pin.Enabled && (!bprops.Pin.Enabled || bprops.Pin.Targets != nprops.Pin.Targets) {
+			// replicate (in the background, not waiting)
+			if rns := xreg.RenewBckPin(c.uuid, c.bck); rns.Err != nil {
+				nlog.Errorln(t.String(), txn.String()+":", rns.Err)
+			}
+		}
 		return xid, nil
 	default:
 		debug.Assert(false)
@@ -481,9 +497,13 @@ func (t *target) validateBckRenTxn(bckFrom, bckTo *meta.Bck, msg *aisMsg) error
 		return err
 	}
 	bmd := t.owner.bmd.get()
-	if _, present := bmd.Get(bckFrom); !present {
+	bprops, present := bmd.Get(bckFrom)
+	if !present {
 		return cmn.NewErrBckNotFound(bckFrom.Bucket())
 	}
+	if bprops.Renamed != "" {
+		return cmn.NewErrBusy("bucket", bckFrom.Cname(""), "(being renamed)")
+	}
 	if _, present := bmd.Get(bckTo); present {
 		return cmn.NewErrBckAlreadyExists(bckTo.Bucket())
 	}
@@ -758,22 +778,39 @@ func (t *target) ecEncode(c *txnSrv) (string, error) {
 		if err = t.transactions.wait(txn, c.timeout.netw, c.timeout.host); err != nil {
 			return "", cmn.NewErrFailedTo(t, "commit", txn, err)
 		}
-		rns := xreg.RenewECEncode(c.bck, c.uuid, apc.ActCommit)
-		if rns.Err != nil {
-			nlog.Errorf("%s: %s %v", t, txn, rns.Err)
-			return "", rns.Err
+		txnEnc := txn.(*txnECEncode)
+		xctn, err := t._startEC(c, _reslice(&txnEnc.ecConf, &c.bck.Props.EC))
+		if err != nil {
+			nlog.Errorf("%s: %s %v", t, txn, err)
+			return "", err
 		}
-		xctn := rns.Entry.Get()
-		c.addNotif(xctn) // notify upon completion
-		xact.GoRunW(xctn)
-
-		return xctn.ID(), rns.Err
+		return xctn.ID(), nil
 	default:
 		debug.Assert(false)
 	}
 	return "", nil
 }
 
+// ec-encode or, upon changing (data, parity) of an erasure coded bucket, ec-reslice
+func (*target) _startEC(c *txnSrv, reslice bool) (core.Xact, error) {
+	var rns xreg.RenewRes
+	if reslice {
+		for _, kind := range []string{apc.ActECEncode, apc.ActECReslice} {
+			xreg.DoAbort(xreg.Flt{Kind: kind, Bck: c.bck}, errors.New("re-slice"))
+		}
+		rns = xreg.RenewECReslice(c.bck, c.uuid)
+	} else {
+		rns = xreg.RenewECEncode(c.bck, c.uuid, apc.ActCommit)
+	}
+	if rns.Err != nil {
+		return nil, rns.Err
+	}
+	xctn := rns.Entry.Get()
+	c.addNotif(xctn) // notify upon completion
+	xact.GoRunW(xctn)
+	return xctn, nil
+}
+
 func (t *target) validateECEncode(bck *meta.Bck, msg *aisMsg) error {
 	cs := fs.Cap()
 	if err := cs.Err(); err != nil {
@@ -1043,8 +1080,11 @@ func prmScan(dirFQN string, prmMsg *apc.PromoteArgs) (fqns []string, totalN int,
 
 // synchronously wo/ xaction
 func (t *target) prmNumFiles(c *txnSrv, txnPrm *txnPromote, confirmedFshare bool) error {
-	smap := t.owner.smap.Get()
-	config := cmn.GCO.Get()
+	var (
+		failed []string
+		smap   = t.owner.smap.Get()
+		config = cmn.GCO.Get()
+	)
 	for _, fqn := range txnPrm.fqns {
 		objName, err := xs.PrmObjName(fqn, txnPrm.dirFQN, txnPrm.msg.ObjName)
 		if err != nil {
@@ -1068,12 +1108,21 @@ func (t *target) prmNumFiles(c *txnSrv, txnPrm *txnPromote, confirmedFshare bool
 				ObjName:      objName,
 				OverwriteDst: txnPrm.msg.OverwriteDst,
 				DeleteSrc:    txnPrm.msg.DeleteSrc,
+				HardLink:     txnPrm.msg.HardLink,
+				Verify:       txnPrm.msg.Verify,
 			},
 		}
 		if _, err := t.Promote(&params); err != nil {
-			return err
+			if !txnPrm.msg.ContinueOnError {
+				return err
+			}
+			failed = append(failed, fqn+": "+err.Error())
 		}
 	}
+	if len(failed) > 0 {
+		return fmt.Errorf("%s: failed to promote %d file%s (out of %d):\n%s", t, len(failed), cos.Plural(len(failed)),
+			len(txnPrm.fqns), strings.Join(failed, "\n"))
+	}
 	return nil
 }
 
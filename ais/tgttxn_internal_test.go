@@ -0,0 +1,50 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+func renValidate(bckFrom, bckTo *meta.Bck) error {
+	return t.validateBckRenTxn(bckFrom, bckTo, &aisMsg{ActMsg: apc.ActMsg{Action: apc.ActMoveBck}})
+}
+
+func renModBMD(mod func(*bucketMD)) {
+	bmd := t.owner.bmd.get().clone()
+	mod(bmd)
+	t.owner.bmd.putPersist(bmd, nil)
+}
+
+func TestValidateRenameBusy(t *testing.T) {
+	var (
+		bckFrom = meta.NewBck("cloud-ren", apc.AWS, cmn.NsGlobal)
+		bckTo   = meta.NewBck("cloud-ren-dst", apc.AIS, cmn.NsGlobal)
+	)
+	renModBMD(func(bmd *bucketMD) { bmd.add(bckFrom, &cmn.Bprops{}) })
+	defer renModBMD(func(bmd *bucketMD) { bmd.del(bckFrom) })
+
+	if err := renValidate(bckFrom, bckTo); err != nil {
+		t.Fatalf("expected %s to be renamable, got %v", bckFrom, err)
+	}
+
+	// being renamed
+	renModBMD(func(bmd *bucketMD) {
+		props, _ := bmd.Get(bckFrom)
+		props = props.Clone()
+		props.Renamed = apc.ActMoveBck
+		bmd.set(bckFrom, props)
+	})
+	err := renValidate(bckFrom, bckTo)
+	var errBusy *cmn.ErrBusy
+	if !errors.As(err, &errBusy) {
+		t.Fatalf("expected busy error, got %v", err)
+	}
+}
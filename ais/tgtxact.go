@@ -228,9 +228,27 @@ func (t *target) xstart(args *xact.ArgsMsg, bck *meta.Bck, msg *apc.ActMsg) (xid
 		}
 		go t.runResilver(res.Args{UUID: args.ID, Notif: notif}, wg)
 		wg.Wait()
+	case apc.ActValidateLomMD:
+		if bck != nil {
+			nlog.Errorf(erfmb, args.Kind, bck)
+		}
+		if args.ID == "" {
+			args.ID = cos.GenUUID()
+			xid = args.ID
+		}
+		return xid, t.runValidateLomMD(args.ID)
 	case apc.ActLoadLomCache:
 		rns := xreg.RenewBckLoadLomCache(args.ID, bck)
 		return xid, rns.Err
+	case apc.ActPinBck:
+		if !bck.Props.Pin.Enabled {
+			return xid, fmt.Errorf("%s is not pinned", bck)
+		}
+		rns := xreg.RenewBckPin(args.ID, bck)
+		return xid, rns.Err
+	case apc.ActCompactBck:
+		rns := xreg.RenewBckCompact(args.ID, bck, args.Force)
+		return xid, rns.Err
 	case apc.ActBlobDl:
 		debug.Assert(msg.Name != "")
 		lom := core.AllocLOM(msg.Name)
@@ -249,7 +267,7 @@ func (t *target) xstart(args *xact.ArgsMsg, bck *meta.Bck, msg *apc.ActMsg) (xid
 	// 3. cannot start
 	case apc.ActPutCopies:
 		return xid, fmt.Errorf("cannot start %q (is driven by PUTs into a mirrored bucket)", args)
-	case apc.ActDownload, apc.ActEvictObjects, apc.ActDeleteObjects, apc.ActMakeNCopies, apc.ActECEncode:
+	case apc.ActDownload, apc.ActEvictObjects, apc.ActDeleteObjects, apc.ActMakeNCopies, apc.ActECEncode, apc.ActECReslice:
 		return xid, fmt.Errorf("initiating %q must be done via a separate documented API", args)
 	// 4. unknown
 	case "":
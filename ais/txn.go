@@ -118,6 +118,7 @@ type (
 		txnBckBase
 	}
 	txnECEncode struct {
+		ecConf cmn.ECConf // prior to this transaction (see _reslice)
 		txnBckBase
 	}
 	txnArchMultiObj struct {
@@ -604,7 +605,7 @@ func (txn *txnTCObjs) String() string {
 /////////////////
 
 func newTxnECEncode(c *txnSrv, bck *meta.Bck) (txn *txnECEncode) {
-	txn = &txnECEncode{}
+	txn = &txnECEncode{ecConf: bck.Props.EC}
 	txn.init(bck)
 	txn.fillFromCtx(c)
 	return
@@ -39,6 +39,9 @@ type (
 		Smap      *smapX    `json:"smap"`
 		StartTime time.Time `json:"start_time"`
 		Initiator string    `json:"initiator"`
+		Term      int64     `json:"term,string,omitempty"` // election term (see voteterm)
+		PreVote   bool      `json:"pre_vote,omitempty"`    // pre-vote: no side effects on the voter's side
+		Overload  bool      `json:"overload,omitempty"`    // primary is alive but overloaded (see prxovl.go)
 	}
 
 	VoteInitiation VoteRecord
@@ -151,7 +154,7 @@ func (p *proxy) httpelect(w http.ResponseWriter, r *http.Request) {
 	}
 
 	smap = p.owner.smap.get()
-	psi, err := smap.HrwProxy(smap.Primary.ID())
+	psi, err := nextPrimary(smap, smap.Primary.ID())
 	if err != nil {
 		p.writeErr(w, r, err)
 		return
@@ -173,6 +176,7 @@ func (p *proxy) httpelect(w http.ResponseWriter, r *http.Request) {
 		Primary:   msg.Request.Primary,
 		StartTime: time.Now(),
 		Initiator: p.SID(),
+		Overload:  msg.Request.Overload,
 	}
 	// include resulting Smap in the response
 	vr.Smap = p.owner.smap.get()
@@ -208,48 +212,42 @@ func (p *proxy) startElection(vr *VoteRecord) {
 func (p *proxy) elect(vr *VoteRecord, xele *xs.Election) {
 	var (
 		smap       *smapX
-		err        error
 		curPrimary = vr.Smap.Primary
 		config     = cmn.GCO.Get()
-		timeout    = config.Timeout.CplaneOperation.D() / 2
 	)
-	// 1. ping the current primary (not using apc.QparamAskPrimary as it might be transitioning)
-	for i := range 2 {
-		if i > 0 {
-			runtime.Gosched()
-		}
-		smap = p.owner.smap.get()
-		if smap.version() > vr.Smap.version() {
-			nlog.Warningf("%s: %s updated from %s, moving back to idle", p, smap, vr.Smap)
+	// 1. make sure the current primary is down or, otherwise, remains overloaded
+	if vr.Overload {
+		if err := p.confirmOverload(curPrimary, vr.Smap, config); err != nil {
+			nlog.Infof("%s: %v - moving back to idle", p, err)
 			return
 		}
-		_, _, err = p.reqHealth(curPrimary, timeout, nil /*ask primary*/, smap)
-		if err == nil {
-			break
-		}
-		timeout = config.Timeout.CplaneOperation.D()
+		nlog.Infof("%s: primary %s is confirmed overloaded - moving to pre-vote", p, curPrimary.StringEx())
+	} else if !p.confirmDown(vr, xele, config) {
+		return
 	}
-	if err == nil {
-		// move back to idle
-		query := url.Values{apc.QparamAskPrimary: []string{"true"}}
-		_, _, err = p.reqHealth(curPrimary, timeout, query /*ask primary*/, smap)
-		if err == nil {
-			nlog.Infof("%s: current primary %s is up, moving back to idle", p, curPrimary)
-		} else {
-			errV := fmt.Errorf("%s: current primary(?) %s responds but does not consider itself primary",
-				p, curPrimary.StringEx())
-			xele.AddErr(errV, 0)
-		}
+
+	// 2. pre-vote: make sure the majority is willing to vote for us _prior_ to incrementing
+	// the term - a partitioned (or flapping) candidate must not disrupt the rest of the cluster
+	vr.PreVote, vr.Term = true, p.vote.term()+1
+	elected, _ := p.electPhase1(vr)
+	p.vote.add(&meta.VoteEvent{Phase: meta.VotePhasePre, Candidate: p.SID(), Primary: vr.Primary,
+		Initiator: vr.Initiator, Term: vr.Term, Yes: elected, Overload: vr.Overload})
+	if !elected {
+		errV := fmt.Errorf("%s: pre-vote failed: primary %s w/ status unknown, term %d",
+			p, curPrimary.StringEx(), vr.Term)
+		xele.AddErr(errV, 0)
 		return
 	}
-	nlog.Infof("%s: primary %s is confirmed down: [%v] - moving to election state phase 1 (prepare)",
-		p, curPrimary.StringEx(), err)
 
-	// 2. election phase 1
+	// 3. election phase 1
+	vr.PreVote, vr.Term = false, p.vote.next(p.SID())
+	nlog.Infof("%s: moving to election state phase 1 (prepare), term %d", p, vr.Term)
 	elected, votingErrors := p.electPhase1(vr)
+	p.vote.add(&meta.VoteEvent{Phase: meta.VotePhaseVote, Candidate: p.SID(), Primary: vr.Primary,
+		Initiator: vr.Initiator, Term: vr.Term, Yes: elected, Overload: vr.Overload})
 	if !elected {
-		errV := fmt.Errorf("%s: election phase 1 (prepare) failed: primary still %s w/ status unknown",
-			p, curPrimary.StringEx())
+		errV := fmt.Errorf("%s: election phase 1 (prepare) failed: primary still %s w/ status unknown, term %d",
+			p, curPrimary.StringEx(), vr.Term)
 		xele.AddErr(errV, 0)
 
 		smap = p.owner.smap.get()
@@ -278,7 +276,7 @@ func (p *proxy) elect(vr *VoteRecord, xele *xs.Election) {
 		return
 	}
 
-	// 3. election phase 2
+	// 4. election phase 2
 	nlog.Infoln(p.String()+":", "moving to election state phase 2 (commit)")
 	confirmationErrors := p.electPhase2(vr)
 	for sid := range confirmationErrors {
@@ -288,9 +286,53 @@ func (p *proxy) elect(vr *VoteRecord, xele *xs.Election) {
 		}
 	}
 
-	// 4. become!
-	nlog.Infof("%s: becoming primary", p)
-	p.becomeNewPrimary(vr.Primary /*proxyIDToRemove*/)
+	// 5. become!
+	nlog.Infof("%s: becoming primary (term %d)", p, vr.Term)
+	proxyIDToRemove := vr.Primary
+	if vr.Overload {
+		proxyIDToRemove = "" // stays on as non-primary
+	}
+	p.becomeNewPrimary(proxyIDToRemove)
+}
+
+// ping the current primary (not using apc.QparamAskPrimary as it might be transitioning)
+func (p *proxy) confirmDown(vr *VoteRecord, xele *xs.Election, config *cmn.Config) bool {
+	var (
+		smap       *smapX
+		err        error
+		curPrimary = vr.Smap.Primary
+		timeout    = config.Timeout.CplaneOperation.D() / 2
+	)
+	for i := range 2 {
+		if i > 0 {
+			runtime.Gosched()
+		}
+		smap = p.owner.smap.get()
+		if smap.version() > vr.Smap.version() {
+			nlog.Warningf("%s: %s updated from %s, moving back to idle", p, smap, vr.Smap)
+			return false
+		}
+		_, _, err = p.reqHealth(curPrimary, timeout, nil /*ask primary*/, smap)
+		if err == nil {
+			break
+		}
+		timeout = config.Timeout.CplaneOperation.D()
+	}
+	if err == nil {
+		// move back to idle
+		query := url.Values{apc.QparamAskPrimary: []string{"true"}}
+		_, _, err = p.reqHealth(curPrimary, timeout, query /*ask primary*/, smap)
+		if err == nil {
+			nlog.Infof("%s: current primary %s is up, moving back to idle", p, curPrimary)
+		} else {
+			errV := fmt.Errorf("%s: current primary(?) %s responds but does not consider itself primary",
+				p, curPrimary.StringEx())
+			xele.AddErr(errV, 0)
+		}
+		return false
+	}
+	nlog.Infof("%s: primary %s is confirmed down: [%v] - moving to pre-vote", p, curPrimary.StringEx(), err)
+	return true
 }
 
 // phase 1: prepare (via simple majority voting)
@@ -320,7 +362,11 @@ func (p *proxy) electPhase1(vr *VoteRecord) (winner bool, errors cos.StrSet) {
 	}
 
 	winner = y > n || (y+n == 0) // No Votes: Default Winner
-	nlog.Infof("Vote Results:\n Y: %d, N: %d\n Victory: %t\n", y, n, winner)
+	phase := meta.VotePhaseVote
+	if vr.PreVote {
+		phase = meta.VotePhasePre
+	}
+	nlog.Infof("Vote Results (%s, term %d):\n Y: %d, N: %d\n Victory: %t\n", phase, vr.Term, y, n, winner)
 	return
 }
 
@@ -372,6 +418,8 @@ func (p *proxy) electPhase2(vr *VoteRecord) cos.StrSet {
 				Smap:      vr.Smap,
 				StartTime: time.Now(),
 				Initiator: p.SID(),
+				Term:      vr.Term,
+				Overload:  vr.Overload,
 			},
 		}
 	)
@@ -440,8 +488,8 @@ func (h *htrun) onPrimaryDown(self *proxy, callerID string) {
 		if nlog.Stopping() {
 			return
 		}
-		// use HRW ordering
-		nextPrimaryProxy, err := clone.HrwProxy(clone.Primary.ID())
+		// the standby (if configured), otherwise HRW ordering
+		nextPrimaryProxy, err := nextPrimary(clone, clone.Primary.ID())
 		if err != nil {
 			if !nlog.Stopping() {
 				nlog.Errorf("%s failed to execute HRW selection: %v", h, err)
@@ -524,6 +572,18 @@ func (h *htrun) httpgetvote(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	rec := &msg.Record
+	if rec.PreVote {
+		h.preVote(w, r, rec, currPrimaryID)
+		return
+	}
+	if !h.vote.wouldVote(rec.Term, candidate) {
+		nlog.Warningf("%s: stale term %d or already voted in this term (%+v) - voting No for %s",
+			h, rec.Term, h.vote.get().VotedFor, candidate)
+		h.castVote(w, rec, false, nil)
+		return
+	}
+
 	if err := h.owner.smap.synchronize(h.si, newSmap, nil /*ms payload*/, h.smapUpdatedCB); err != nil {
 		// double-checking errDowngrade
 		if isErrDowngrade(err) {
@@ -535,25 +595,57 @@ func (h *htrun) httpgetvote(w http.ResponseWriter, r *http.Request) {
 		}
 		if err != nil {
 			nlog.Errorf("%s: failed to synch %s, err %v - voting No", h, newSmap, err)
-			w.Header().Set(cos.HdrContentLength, strconv.Itoa(len(VoteNo)))
-			_, err := w.Write([]byte(VoteNo))
-			debug.AssertNoErr(err)
+			h.castVote(w, rec, false, err)
 			return
 		}
 	}
 
-	vote, err := h.voteOnProxy(psi.ID(), currPrimaryID)
+	vote, err := h.voteOnProxy(psi.ID(), currPrimaryID, h.owner.smap.get(), rec.Overload)
 	if err != nil {
 		h.writeErr(w, r, err)
 		return
 	}
 	if vote {
-		w.Header().Set(cos.HdrContentLength, strconv.Itoa(len(VoteYes)))
-		_, err = w.Write([]byte(VoteYes))
+		// (race vs another candidate in the same term)
+		vote = h.vote.vote(rec.Term, candidate)
 	} else {
-		w.Header().Set(cos.HdrContentLength, strconv.Itoa(len(VoteNo)))
-		_, err = w.Write([]byte(VoteNo))
+		h.vote.observe(rec.Term)
+	}
+	h.castVote(w, rec, vote, nil)
+}
+
+// pre-vote: would we vote Yes? (not synchronizing Smap, not changing the term)
+func (h *htrun) preVote(w http.ResponseWriter, r *http.Request, rec *VoteRecord, currPrimaryID string) {
+	smap := h.owner.smap.get()
+	if rec.Smap.version() < smap.version() || !h.vote.wouldVote(rec.Term, rec.Candidate) {
+		h.writeVote(w, false)
+		return
+	}
+	vote, err := h.voteOnProxy(rec.Candidate, currPrimaryID, rec.Smap, rec.Overload)
+	if err != nil {
+		h.writeErr(w, r, err)
+		return
 	}
+	h.writeVote(w, vote)
+}
+
+func (h *htrun) castVote(w http.ResponseWriter, rec *VoteRecord, vote bool, err error) {
+	ev := &meta.VoteEvent{Phase: meta.VotePhaseCast, Candidate: rec.Candidate, Primary: rec.Primary,
+		Initiator: rec.Initiator, Term: rec.Term, Yes: vote, Overload: rec.Overload}
+	if err != nil {
+		ev.Err = err.Error()
+	}
+	h.vote.add(ev)
+	h.writeVote(w, vote)
+}
+
+func (*htrun) writeVote(w http.ResponseWriter, vote bool) {
+	v := VoteNo
+	if vote {
+		v = VoteYes
+	}
+	w.Header().Set(cos.HdrContentLength, strconv.Itoa(len(v)))
+	_, err := w.Write([]byte(v))
 	debug.AssertNoErr(err)
 }
 
@@ -567,13 +659,19 @@ func (h *htrun) httpsetprimary(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	vr := msg.Result
-	nlog.Infof("%s: received vote result: new primary %s (old %s)", h.si, vr.Candidate, vr.Primary)
+	nlog.Infof("%s: received vote result: new primary %s (old %s), term %d", h.si, vr.Candidate, vr.Primary, vr.Term)
+	h.vote.observe(vr.Term)
+	h.vote.add(&meta.VoteEvent{Phase: meta.VotePhaseResult, Candidate: vr.Candidate, Primary: vr.Primary,
+		Initiator: vr.Initiator, Term: vr.Term, Yes: true, Overload: vr.Overload})
 
 	ctx := &smapModifier{
 		pre: h._votedPrimary,
 		nid: vr.Candidate,
 		sid: vr.Primary,
 	}
+	if vr.Overload {
+		ctx.sid = "" // overloaded (former) primary stays in the cluster map
+	}
 	err := h.owner.smap.modify(ctx)
 	if err != nil {
 		h.writeErr(w, r, err)
@@ -639,20 +737,25 @@ func (h *htrun) sendElectionRequest(vr *VoteInitiation, nextPrimaryProxy *meta.S
 	return
 }
 
-func (h *htrun) voteOnProxy(daemonID, currPrimaryID string) (bool, error) {
+func (h *htrun) voteOnProxy(daemonID, currPrimaryID string, smap *smapX, overload bool) (bool, error) {
 	// First: Check last keepalive timestamp. If the proxy was recently successfully reached,
 	// this will always vote no, as we believe the original proxy is still alive.
-	if !h.keepalive.timeToPing(currPrimaryID) {
+	// Unless, that is, the primary is alive but overloaded - which we confirm on our own.
+	if overload {
+		if err := h.checkOverload(smap.GetProxy(currPrimaryID), smap, cmn.GCO.Get()); err != nil {
+			nlog.Warningf("%s: voting No for %s: %v", h, daemonID, err)
+			return false, nil
+		}
+	} else if !h.keepalive.timeToPing(currPrimaryID) {
 		if cmn.Rom.FastV(4, cos.SmoduleAIS) {
 			nlog.Warningf("Primary %s is still alive", currPrimaryID)
 		}
 		return false, nil
 	}
 
-	// Second: Vote according to whether or not the candidate is the Highest Random Weight remaining
-	// in the Smap
-	smap := h.owner.smap.get()
-	nextPrimaryProxy, err := smap.HrwProxy(currPrimaryID)
+	// Second: Vote according to whether or not the candidate is the standby or, otherwise,
+	// the Highest Random Weight remaining in the Smap
+	nextPrimaryProxy, err := nextPrimary(smap, currPrimaryID)
 	if err != nil {
 		return false, fmt.Errorf("error executing HRW: %v", err)
 	}
@@ -0,0 +1,127 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"os"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// Election term (Raft-style):
+// - monotonic; incremented by the candidate upon passing the pre-vote (and prior to phase 1)
+// - voters reject stale terms and vote at most once per term - first come, first served
+// - proxies persist the term (along with the most recently voted-for candidate), so that
+//   a restarted (or flapping) node won't vote twice in the same election
+// - term zero is legacy (pre-term) and is always accepted
+
+const maxVoteEvents = 32
+
+type electionTerm struct {
+	fpath string // empty for targets (in-memory only)
+	hist  meta.ElectionHistory
+	mu    sync.Mutex
+}
+
+func (et *electionTerm) load(fpath string) {
+	et.fpath = fpath
+	if _, err := jsp.Load(fpath, &et.hist, jsp.Options{Checksum: true}); err != nil && !os.IsNotExist(err) {
+		nlog.Errorln("failed to load election term:", err)
+	}
+}
+
+func (et *electionTerm) term() (term int64) {
+	et.mu.Lock()
+	term = et.hist.Term
+	et.mu.Unlock()
+	return
+}
+
+// candidate: start a new term, vote for self
+func (et *electionTerm) next(self string) (term int64) {
+	et.mu.Lock()
+	et.hist.Term++
+	et.hist.VotedFor = self
+	term = et.hist.Term
+	et.persist()
+	et.mu.Unlock()
+	return
+}
+
+// voter: returns false if the term is stale or if we've already voted for another candidate
+// in this term; otherwise, moves to the (new) term and remembers the candidate
+func (et *electionTerm) vote(term int64, candidate string) bool {
+	if term == 0 {
+		return true
+	}
+	et.mu.Lock()
+	defer et.mu.Unlock()
+	switch {
+	case term < et.hist.Term:
+		return false
+	case term == et.hist.Term && et.hist.VotedFor != "" && et.hist.VotedFor != candidate:
+		return false
+	}
+	et.hist.Term, et.hist.VotedFor = term, candidate
+	et.persist()
+	return true
+}
+
+// pre-vote: same checks as above minus side effects
+func (et *electionTerm) wouldVote(term int64, candidate string) bool {
+	if term == 0 {
+		return true
+	}
+	et.mu.Lock()
+	defer et.mu.Unlock()
+	if term < et.hist.Term {
+		return false
+	}
+	return term > et.hist.Term || et.hist.VotedFor == "" || et.hist.VotedFor == candidate
+}
+
+// any node: catch up with the (newer) term of the election result
+func (et *electionTerm) observe(term int64) {
+	et.mu.Lock()
+	if term > et.hist.Term {
+		et.hist.Term, et.hist.VotedFor = term, ""
+		et.persist()
+	}
+	et.mu.Unlock()
+}
+
+func (et *electionTerm) add(ev *meta.VoteEvent) {
+	ev.Time = time.Now().UnixNano()
+	et.mu.Lock()
+	if len(et.hist.Events) >= maxVoteEvents {
+		copy(et.hist.Events, et.hist.Events[1:])
+		et.hist.Events = et.hist.Events[:maxVoteEvents-1]
+	}
+	et.hist.Events = append(et.hist.Events, *ev)
+	et.persist()
+	et.mu.Unlock()
+}
+
+func (et *electionTerm) get() *meta.ElectionHistory {
+	et.mu.Lock()
+	hist := et.hist
+	hist.Events = append([]meta.VoteEvent(nil), et.hist.Events...)
+	et.mu.Unlock()
+	return &hist
+}
+
+// under lock
+func (et *electionTerm) persist() {
+	if et.fpath == "" {
+		return
+	}
+	if err := jsp.Save(et.fpath, &et.hist, jsp.Options{Checksum: true}, nil); err != nil {
+		nlog.Errorln("failed to persist election term:", err)
+	}
+}
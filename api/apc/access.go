@@ -36,6 +36,13 @@ const (
 	AceDestroyBucket
 	AceMoveBucket
 	AceAdmin
+	// bucket-scoped admin: full control over a given bucket, including its props,
+	// n-way mirroring, and erasure coding (appended to keep existing values intact)
+	AceBckAdmin
+	// xaction control (start and abort, respectively) without full AceAdmin;
+	// starting bucket-scoped xactions also requires the kind's own permissions (see xact.Table)
+	AceXactStart
+	AceXactAbort
 	// note: must be the last one
 	AceMax
 )
@@ -63,6 +70,9 @@ var accessOp = map[AccessAttrs]string{
 	AceDestroyBucket: "DESTROY-BUCKET",
 	AceMoveBucket:    "MOVE-BUCKET",
 	AceAdmin:         "ADMIN",
+	AceBckAdmin:      "BUCKET-ADMIN",
+	AceXactStart:     "START-XACTION",
+	AceXactAbort:     "ABORT-XACTION",
 
 	// NOTE: update Describe() when adding/deleting
 }
@@ -79,15 +89,28 @@ const (
 	AccessRW             = AccessRO | AcePUT | AceAPPEND | AceObjDELETE | AceObjMOVE
 	AllowReadWriteAccess = "rw"
 
+	// full control over a given bucket (without cluster-wide AceAdmin)
+	AccessBckAdmin      = AccessRW | AcePromote | AceObjUpdate | AcePATCH | AceBckSetACL | AceBckAdmin
+	AllowBckAdminAccess = "admin-bck"
+
 	AccessNone = AccessAttrs(0)
 
+	// all operations that modify bucket's content (see ActFreezeBck)
+	AccessWrite = AcePUT | AceAPPEND | AceObjDELETE | AceObjMOVE | AcePromote | AceObjUpdate |
+		AceMoveBucket | AceDestroyBucket
+
 	// permission to perform cluster-level ops
-	AccessCluster = AceListBuckets | AceCreateBucket | AceDestroyBucket | AceMoveBucket | AceAdmin
+	AccessCluster = AceListBuckets | AceCreateBucket | AceDestroyBucket | AceMoveBucket | AceAdmin |
+		AceXactStart | AceXactAbort
+
+	// delegated xaction control
+	AccessXactControl      = AceXactStart | AceXactAbort
+	AllowXactControlAccess = "xact"
 )
 
 // verbs
 func SupportedPermissions() []string {
-	accList := []string{"ro", "rw", "su"}
+	accList := []string{"ro", "rw", "admin-bck", "xact", "su"}
 	for _, v := range accessOp {
 		accList = append(accList, v)
 	}
@@ -158,6 +181,15 @@ func (a AccessAttrs) Describe(all bool) string {
 	if a.Has(AceAdmin) {
 		accList = append(accList, accessOp[AceAdmin])
 	}
+	if a.Has(AceBckAdmin) {
+		accList = append(accList, accessOp[AceBckAdmin])
+	}
+	if a.Has(AceXactStart) {
+		accList = append(accList, accessOp[AceXactStart])
+	}
+	if a.Has(AceXactAbort) {
+		accList = append(accList, accessOp[AceXactAbort])
+	}
 
 	// return
 	if all || len(accList) <= 4 {
@@ -180,6 +212,10 @@ func StrToAccess(accessStr string) (access AccessAttrs, err error) {
 		access |= AccessRO
 	case AllowReadWriteAccess:
 		access |= AccessRW
+	case AllowBckAdminAccess:
+		access |= AccessBckAdmin
+	case AllowXactControlAccess:
+		access |= AccessXactControl
 	case AllowAllAccess:
 		access = AccessAll
 	case "":
@@ -14,26 +14,37 @@ import (
 // ActMsg.Action
 // includes Xaction.Kind == ActMsg.Action (when the action is asynchronous)
 const (
-	ActCreateBck   = "create-bck"  // NOTE: compare w/ ActAddRemoteBck below
-	ActDestroyBck  = "destroy-bck" // destroy bucket data and metadata
+	ActCreateBck   = "create-bck"   // NOTE: compare w/ ActAddRemoteBck below
+	ActDestroyBck  = "destroy-bck"  // destroy bucket data and metadata
+	ActUndeleteBck = "undelete-bck" // restore destroyed (trashed) bucket - see cmn.NsTrash
+	ActFreezeBck   = "freeze-bck"   // block writes and wait for the bucket to quiesce (e.g., external snapshot)
+	ActThawBck     = "thaw-bck"     // resume writes (ditto)
 	ActSetBprops   = "set-bprops"
 	ActResetBprops = "reset-bprops"
 
 	ActSummaryBck = "summary-bck"
 
-	ActECEncode  = "ec-encode" // erasure code a bucket
-	ActECGet     = "ec-get"    // read erasure coded objects
-	ActECPut     = "ec-put"    // erasure code objects
-	ActECRespond = "ec-resp"   // respond to other targets' EC requests
+	ActECEncode  = "ec-encode"  // erasure code a bucket
+	ActECReslice = "ec-reslice" // re-encode erasure coded bucket upon changing its (data, parity)
+	ActECGet     = "ec-get"     // read erasure coded objects
+	ActECPut     = "ec-put"     // erasure code objects
+	ActECRespond = "ec-resp"    // respond to other targets' EC requests
 
-	ActCopyBck = "copy-bck"
-	ActETLBck  = "etl-bck"
+	ActCopyBck   = "copy-bck"
+	ActETLBck    = "etl-bck"
+	ActCloneBck  = "clone-bck"  // zero-copy (copy-on-write) view of an existing ais bucket
+	ActDiffBck   = "diff-bck"   // compare two buckets (see DiffBckMsg)
+	ActSampleBck = "sample-bck" // random sample of bucket's objects => aggregate statistics (see SampleMsg)
 
 	ActETLInline = "etl-inline"
 
 	ActDsort    = "dsort"
 	ActDownload = "download"
 
+	// dsort job templates (stored in cluster config)
+	ActSetDsortTmpl = "set-dsort-template"
+	ActDelDsortTmpl = "delete-dsort-template"
+
 	ActBlobDl = "blob-download"
 
 	ActMakeNCopies = "make-n-copies"
@@ -53,9 +64,14 @@ const (
 	ActInvalListCache = "inval-listobj-cache"
 	ActList           = "list"
 	ActLoadLomCache   = "load-lom-cache"
+	ActValidateLomMD  = "validate-lom-md"
+	ActExpireObjs     = "expire-objects"
+	ActCompactBck     = "compact-bck"
+	ActPinBck         = "pin-bck"
 	ActNewPrimary     = "new-primary"
 	ActPromote        = "promote"
 	ActRenameObject   = "rename-obj"
+	ActLinkObj        = "link-obj"
 
 	// cp (reverse)
 	ActResetStats  = "reset-stats"
@@ -63,6 +79,11 @@ const (
 	ActSetConfig   = "set-config"
 
 	ActRotateLogs = "rotate-logs"
+	ActRotateCert = "rotate-cert" // HTTPS: upload new server certificate or reload renewed one (see ActValCert)
+
+	// long-running client requests: listings, summaries, promotes (see cmn.LongReq)
+	ActCancelReq  = "cancel-req"       // ActMsg.Name: request ID
+	ActSetReqPrio = "set-req-priority" // ActMsg.Name: request ID; ActMsg.Value: one of PriorityHigh, ...
 
 	ActShutdownCluster = "shutdown" // see also: ActShutdownNode
 
@@ -153,7 +174,34 @@ type (
 		RmUserData        bool   `json:"rm_user_data"`        // decommission-only
 		KeepInitialConfig bool   `json:"keep_initial_config"` // ditto (to be able to restart a node from scratch)
 		NoShutdown        bool   `json:"no_shutdown"`
+		// shutdown-node only: the target is expected to restart shortly - keep it in maintenance
+		// and delay rebalancing for up to (configurable) rebalance.restart_grace
+		WarmRestart bool `json:"warm_restart,omitempty"`
+		// decommission-cluster only: prior to decommissioning, copy all ais:// buckets
+		// to this remote (cloud or remote AIS) bucket, one virtual directory per bucket
+		// (e.g. "s3://archive" or "ais://@remais/archive"); see also OffloadStatus
+		OffloadTo string `json:"offload_to,omitempty"`
 	}
+
+	// shutdown-cluster: drain (and then shut down) each node in the cluster, whereby
+	// draining is bounded by DrainTimeout: zero - use `timeout.max_host_busy`, negative - don't drain
+	ActValShutdown struct {
+		DrainTimeout cos.Duration `json:"drain_timeout,omitempty"`
+	}
+
+	// decommission-cluster offload progress, one entry per ais:// bucket
+	OffloadStatus struct {
+		Bck    string `json:"bck"` // source bucket (cname)
+		XactID string `json:"xid"` // copy-bucket xaction
+		Stage  string `json:"stage"`
+	}
+)
+
+// OffloadStatus stages
+const (
+	OffloadCopying   = "copying"
+	OffloadVerifying = "verifying"
+	OffloadVerified  = "verified"
 )
 
 type (
@@ -0,0 +1,18 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// compare two buckets (ActDiffBck), possibly across providers and (attached) clusters
+type DiffBckMsg struct {
+	Prefix string `json:"prefix,omitempty"` // compare only the objects with names that start with the prefix
+	Cached bool   `json:"cached,omitempty"` // remote buckets: compare only in-cluster (cached) objects
+}
+
+// enum: cmn.DiffBckEntry.What
+const (
+	DiffOnlyA  = "only-a" // present only in the first (source) bucket
+	DiffOnlyB  = "only-b" // present only in the second (bck_to) bucket
+	DiffDiffer = "differ" // present in both buckets but differ in size, checksum, or version
+)
@@ -0,0 +1,72 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import "github.com/NVIDIA/aistore/cmn/cos"
+
+// REST API revisions
+//   - the URL path remains versioned as a whole (see `Version` = "v1")
+//   - the revision gets incremented upon (backward-compatible) additions to the API;
+//     clients are expected to check the supported range - see `Capabilities.API` -
+//     and gracefully degrade when talking to an older cluster
+//   - the revision also gets incremented when the shape of a control message changes;
+//     clients specify the one they speak via `HdrAPIRev` (no header - revision 1),
+//     and proxies translate older requests (see ais/htapirev.go)
+//
+// revisions:
+//   - 1: initial
+//   - 2: ActMakeNCopies value is MNCMsg (rev 1: number of copies)
+const (
+	APIRevMin = 1
+	APIRevMax = 2
+)
+
+// authentication modes
+const (
+	AuthNone        = "none"         // no authentication (default)
+	AuthToken       = "token"        // AuthN-issued JWT tokens (see `config.auth`)
+	AuthS3Presigned = "s3-presigned" // S3 presigned requests (feature flag)
+)
+
+type (
+	APIRange struct {
+		Version string `json:"version"` // URL path version (e.g., "v1")
+		Min     int    `json:"min"`     // APIRevMin
+		Max     int    `json:"max"`     // APIRevMax
+	}
+	// GET /v1/daemon?what=capabilities (see WhatCapabilities)
+	Capabilities struct {
+		Software    string   `json:"software"`     // aistore version (cmn.VersionAIStore)
+		API         APIRange `json:"api"`          // supported API revisions
+		Actions     []string `json:"actions"`      // ActMsg.Action values
+		Xactions    []string `json:"xactions"`     // xaction kinds (see xact.Table)
+		Providers   []string `json:"providers"`    // enabled backend providers, ais included
+		ArchFormats []string `json:"arch_formats"` // supported archive (shard) formats
+		Checksums   []string `json:"checksums"`    // supported checksum types (see cos.SupportedChecksums)
+		Auth        []string `json:"auth"`         // enabled authentication modes (see Auth* above)
+		Features    []string `json:"features"`     // enabled cluster-wide feature flags (see cmn/feat)
+	}
+)
+
+// user-facing control actions (compare with xaction kinds)
+var Actions = [...]string{
+	ActCreateBck, ActDestroyBck, ActUndeleteBck, ActSetBprops, ActResetBprops, ActSummaryBck,
+	ActCopyBck, ActETLBck, ActCloneBck, ActMoveBck, ActMakeNCopies, ActECEncode,
+	ActEvictRemoteBck, ActInvalListCache, ActList, ActPromote, ActRenameObject, ActBlobDl,
+	ActCopyObjects, ActDeleteObjects, ActETLObjects, ActEvictObjects, ActPrefetchObjects, ActArchive,
+	ActResetStats, ActResetConfig, ActSetConfig, ActRotateLogs, ActRotateCert,
+	ActAttachRemAis, ActDetachRemAis, ActEnableBackend, ActDisableBackend,
+	ActStartMaintenance, ActStopMaintenance, ActShutdownNode, ActDecommissionNode,
+	ActShutdownCluster, ActDecommissionCluster,
+	ActMountpathAttach, ActMountpathEnable, ActMountpathDetach, ActMountpathDisable,
+	ActXactStart, ActXactStop,
+}
+
+func (c *Capabilities) HasAction(action string) bool { return cos.StringInSlice(action, c.Actions) }
+func (c *Capabilities) HasXaction(kind string) bool  { return cos.StringInSlice(kind, c.Xactions) }
+func (c *Capabilities) HasProvider(p string) bool    { return cos.StringInSlice(p, c.Providers) }
+
+// whether the cluster supports a given API revision
+func (c *Capabilities) SupportsRev(rev int) bool { return rev >= c.API.Min && rev <= c.API.Max }
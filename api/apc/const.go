@@ -32,3 +32,16 @@ const (
 	DefaultTimeout = time.Duration(-1)
 	LongTimeout    = time.Duration(-2)
 )
+
+// bucket event hooks (cmn.TriggerConf.Event)
+const (
+	TriggerPut    = "put"
+	TriggerDelete = "delete"
+)
+
+// bucket change feed (cmn.ChangeEvent.Op)
+const (
+	ChangePut    = "put"
+	ChangeDelete = "delete"
+	ChangeRename = "rename"
+)
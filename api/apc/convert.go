@@ -0,0 +1,26 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import "fmt"
+
+// GET with on-the-fly format conversion (see QparamConvert);
+// applies to the entire object or, when combined with QparamArchpath, to the archived file
+const (
+	ConvGunzip    = "gunzip"     // decompress gzip-compressed (.gz) content
+	ConvJSONL2Msg = "jsonl2msgp" // line-delimited JSON => stream of msgpack-encoded values
+	ConvMsg2JSONL = "msgp2jsonl" // stream of msgpack-encoded values => line-delimited JSON
+)
+
+var SupportedConversions = [...]string{ConvGunzip, ConvJSONL2Msg, ConvMsg2JSONL}
+
+func ValidateConvert(conv string) error {
+	for _, c := range SupportedConversions {
+		if c == conv {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid format conversion %q (expecting one of: %v)", conv, SupportedConversions)
+}
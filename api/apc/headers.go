@@ -14,6 +14,13 @@ import "strings"
 
 const HdrError = "Hdr-Error"
 
+// HdrPriority values
+const (
+	PriorityHigh   = "high"
+	PriorityNormal = "normal"
+	PriorityLow    = "low"
+)
+
 const (
 	HeaderPrefix = "ais-"
 
@@ -38,6 +45,11 @@ const (
 	HdrS3Endpoint = HeaderPrefix + "endpoint"
 	HdrS3Profile  = HeaderPrefix + "profile"
 
+	// including BucketProps.Extra.GCP
+	HdrGCPDefaultKMSKey = HeaderPrefix + "default-kms-key"
+	HdrGCPRequesterPays = HeaderPrefix + "requester-pays"
+	HdrGCPUniformAccess = HeaderPrefix + "uniform-access"
+
 	// including BucketProps.Extra.HTTP
 	HdrOrigURLBck = HeaderPrefix + "original-url"
 
@@ -48,15 +60,31 @@ const (
 
 	HdrRemoteOffline = HeaderPrefix + "remote-offline" // When accessing cached remote bucket with no backend connectivity.
 
+	// request priority: one of PriorityHigh, PriorityNormal (default), PriorityLow
+	// (propagated by proxies when redirecting - see QparamPriority)
+	HdrPriority = HeaderPrefix + "priority"
+
+	// unique request ID: assigned by the (first) proxy unless provided by the client,
+	// propagated via redirects (see QparamReqID) and intra-cluster calls, and returned
+	// with every error (see cmn.ErrHTTP)
+	HdrReqID = HeaderPrefix + "request-id"
+
+	// REST API revision the client speaks (APIRevMin..APIRevMax); none - revision 1;
+	// proxies upgrade older requests to the current revision and return the negotiated one
+	HdrAPIRev = HeaderPrefix + "api-rev"
+
 	// Object props headers
 	HdrObjCksumType = HeaderPrefix + "checksum-type"  // Checksum type, one of SupportedChecksums().
 	HdrObjCksumVal  = HeaderPrefix + "checksum-value" // Checksum value.
 	HdrObjAtime     = HeaderPrefix + "atime"          // Object access time.
 	HdrObjCustomMD  = HeaderPrefix + "custom-md"      // Object custom metadata.
 	HdrObjVersion   = HeaderPrefix + "version"        // Object version/generation - ais or cloud.
+	HdrObjTTL       = HeaderPrefix + "ttl"            // PUT: object's time-to-live (duration, e.g. "30m", or seconds).
 
-	// Append object header.
-	HdrAppendHandle = HeaderPrefix + "append-handle"
+	// Append object headers.
+	HdrAppendHandle   = HeaderPrefix + "append-handle"
+	HdrAppendWindow   = HeaderPrefix + "append-window"   // max size of the next APPEND (bytes; zero: back off and retry)
+	HdrAppendDeadline = HeaderPrefix + "append-deadline" // continue (APPEND) or flush the session by (Unix time, nanoseconds)
 
 	// api.PutApndArchArgs message flags
 	HdrPutApndArchFlags = HeaderPrefix + "pine"
@@ -70,6 +98,7 @@ const (
 	// uptimes, respectively
 	HdrNodeUptime    = HeaderPrefix + "node-uptime"
 	HdrClusterUptime = HeaderPrefix + "cluster-uptime"
+	HdrCertExpiry    = HeaderPrefix + "cert-expiry" // HTTPS: earliest server certificate expiration (Unix time, nanoseconds)
 )
 
 // AuthN consts
@@ -88,6 +117,9 @@ const (
 
 	HdrXactionID = HeaderPrefix + "xaction-id"
 
+	// GET via link (alias) object: number of links followed so far
+	HdrLinkHops = HeaderPrefix + "link-hops"
+
 	// Stream related headers.
 	HdrSessID   = HeaderPrefix + "session-id"
 	HdrCompress = HeaderPrefix + "compress" // LZ4Compression, etc.
@@ -13,6 +13,7 @@ import (
 
 const (
 	LocationPropSepa = ":"
+	LocationSectSepa = "; " // location sections: primary; mirror copies; EC slices (see GetPropsLocation)
 	LsPropsSepa      = ","
 )
 
@@ -82,6 +83,26 @@ const (
 	// for instance, `list-objects(aws://BUCKET)` MAY return the latter.
 	// To prevent this from happening, specify LsNoDirs flag.
 	LsNoDirs
+
+	// Point-in-time (consistent snapshot) listing of an ais:// bucket (or in-cluster objects
+	// of a remote one, with LsObjCached). The proxy stamps the first page with a cluster-wide
+	// cut (SnapTime); each target walks its part of the bucket to completion, journals PUTs
+	// and DELETEs that commit after the cut, and then serves all subsequent pages
+	// from the resulting (in-memory) snapshot as of the cut - on all targets.
+	// The price: memory proportional to the number of listed objects (capped), and
+	// 410 (Gone) whenever the snapshot is lost mid-listing (e.g., idle timeout, target
+	// joining or restarting) - in which case the listing must be restarted from scratch.
+	LsSnapshot
+
+	// Federated listing of a given ais:// bucket: this cluster first, followed by each attached
+	// remote AIS cluster that has a bucket with the same name (one cluster at a time).
+	// Each entry's `Location` is prefixed with its origin cluster's namespace ("@uuid").
+	LsFederated
+
+	// Summary ("du -s") mode: instead of entries, each target returns the number of objects
+	// and their total size per (virtual) subdirectory, at a given `LsoMsg.Depth` below the prefix.
+	// Only in-cluster objects are counted; the result is a single (non-paginated) cmn.LsoSumms.
+	LsSummary
 )
 
 // max page sizes
@@ -108,6 +129,8 @@ const (
 	EntryIsArchive  = 1 << (EntryStatusBits + 4)
 	EntryVerChanged = 1 << (EntryStatusBits + 5) // see also: QparamLatestVer, et al.
 	EntryVerRemoved = 1 << (EntryStatusBits + 6) // ditto
+	EntryOffline    = 1 << (EntryStatusBits + 7) // listed in-cluster while the remote backend is offline (feat.BackendOffline)
+	EntryTombstone  = 1 << (EntryStatusBits + 8) // (internal) deleted from copy-on-write clone - hides the source's object
 )
 
 // ObjEntry.Flags field
@@ -154,7 +177,9 @@ type LsoMsg struct {
 	SID               string      `json:"target"`                // selected target to solely execute backend.list-objects
 	Flags             uint64      `json:"flags,string"`          // enum {LsObjCached, ...} - "LsoMsg flags" above
 	PageSize          int64       `json:"pagesize"`              // max entries returned by list objects call
+	Depth             int         `json:"depth,omitempty"`       // number of '/'-delimited levels below prefix to summarize (LsSummary only)
 	Header            http.Header `json:"hdr,omitempty"`         // (for pointers, see `ListArgs` in api/ls.go)
+	SnapTime          int64       `json:"snap_time,omitempty"`   // LsSnapshot: cluster-wide cut (Unix nanoseconds) - set by proxy
 }
 
 ////////////
@@ -0,0 +1,35 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// make-n-copies (ActMakeNCopies): bring a bucket (or its part) to the N-way redundancy;
+// for backward compatibility, the action also accepts (plain) number of copies
+type MNCMsg struct {
+	// process only the objects with names that start with the prefix;
+	// note that bucket's mirroring (mirror.copies) is always updated bucket-wide
+	Prefix string `json:"prefix,omitempty"`
+
+	// per-target bandwidth cap, bytes per second (0 - unlimited), e.g. "100MiB";
+	// (counts the bytes of the copies that get added)
+	MaxThroughput cos.SizeIEC `json:"max_throughput,omitempty"`
+
+	Copies int64 `json:"copies"`
+}
+
+func (msg *MNCMsg) Validate() error {
+	if msg.Copies < 1 {
+		return fmt.Errorf("invalid number of copies %d (expecting positive integer)", msg.Copies)
+	}
+	if msg.MaxThroughput < 0 {
+		return fmt.Errorf("invalid max throughput %d (expecting non-negative number of bytes per second)", msg.MaxThroughput)
+	}
+	return nil
+}
@@ -17,4 +17,12 @@ type PromoteArgs struct {
 	// and _not_ to try to auto-detect if it is;
 	// (auto-detection takes time, etc.)
 	SrcIsNotFshare bool `json:"notshr,omitempty"` // the source is not a file share equally accessible by all targets
+	// zero-copy: hard-link source files that reside on the same filesystem as the destination mountpath
+	// (otherwise, copy); note that the source and the promoted object then share the same content
+	HardLink bool `json:"lnk,omitempty"`
+	// (copying only) re-read promoted content and compare its checksum with the one computed from the source
+	Verify bool `json:"vfy,omitempty"`
+	// do not fail the entire (multi-file) promotion upon the first failed file;
+	// failed files are individually reported, successfully promoted ones remain
+	ContinueOnError bool `json:"coer,omitempty"`
 }
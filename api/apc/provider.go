@@ -24,6 +24,9 @@ const (
 	// consistent with rfc2396.txt "Uniform Resource Identifiers (URI): Generic Syntax"
 	BckProviderSeparator = "://"
 
+	// list-buckets only: multiple providers in one query (QparamProvider), e.g. "aws,gcp"
+	ProviderSep = ","
+
 	// scheme://
 	DefaultScheme = "https"
 	GSScheme      = "gs"
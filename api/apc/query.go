@@ -15,6 +15,9 @@ const (
 	QparamJobID   = "jobid"    // job
 	QparamETLName = "etl_name" // etl
 
+	// GET the original content of an object in a bucket with default ETL (see cmn.BckETLConf)
+	QparamETLBypass = "etl_bypass"
+
 	QparamRegex      = "regex"       // dsort: list regex
 	QparamOnlyActive = "only_active" // dsort: list only active
 
@@ -29,6 +32,9 @@ const (
 	// e.g., usage: copy bucket
 	QparamBckTo = "bck_to"
 
+	// bucket (URI, e.g. "ais://abc") to filter xaction history - see WhatXactHistory
+	QparamBucket = "bucket"
+
 	// Do not add remote bucket to cluster's BMD e.g. when checking existence
 	// via api.HeadBucket
 	// By default, when existence of a remote buckets is confirmed the bucket's
@@ -59,6 +65,10 @@ const (
 	// - ListObjsMsg flags, docs/providers.md (for terminology)
 	QparamFltPresence = "presence"
 
+	// list-buckets: this cluster's ais:// buckets along with all buckets of all attached
+	// remote AIS clusters (see also: LsFederated)
+	QparamFederated = "fed"
+
 	// APPEND(object) operation - QparamAppendType enum below
 	QparamAppendType   = "append_type"
 	QparamAppendHandle = "append_handle"
@@ -71,6 +81,10 @@ const (
 	QparamLogOff  = "offset"
 	QparamAllLogs = "all"
 
+	// Get (pprof) profile - QparamProfType enum below
+	QparamProfType    = "type"
+	QparamProfSeconds = "seconds"
+
 	// The following 4 (four) QparamArch* parameters are all intended for usage with sharded datasets,
 	// whereby the shards are (.tar, .tgz (or .tar.gz), .zip, and/or .tar.lz4) formatted objects.
 	//
@@ -105,6 +119,10 @@ const (
 	// - docs/cli/archive.md#get-archived-content-multiple-selection  - multi-selection usage and examples
 	// - cmn/archive                                                  - the most recently updated "archmode" enumeration
 
+	// GET: convert object's content (or archived file - see QparamArchpath) on the fly,
+	// e.g. "?convert=gunzip"; see ConvGunzip et al. enum in apc/convert
+	QparamConvert = "convert"
+
 	// Skip loading existing object's metadata, in part to
 	// compare its Checksum and update its existing Version (if exists).
 	// Can be used to reduce PUT latency when:
@@ -112,6 +130,15 @@ const (
 	// - we simply don't care.
 	QparamSkipVC = "skip_vc"
 
+	// PUT: object's time-to-live, e.g. "24h" (same as HdrObjTTL; see also cmn.ExpiresObjMD)
+	QparamTTL = "ttl"
+
+	// request priority (same as HdrPriority)
+	QparamPriority = "pri"
+
+	// request ID (same as HdrReqID)
+	QparamReqID = "req_id"
+
 	// force operation
 	// used to overcome certain restrictions, e.g.:
 	// - shutdown the primary and the entire cluster
@@ -133,6 +160,30 @@ const (
 
 	// (see api.AttachMountpath vs. LocalConfig.FSP)
 	QparamMpathLabel = "mountpath_label"
+
+	// bucket export/import as a single tar stream: GET|PUT /v1/buckets/<bck>?action=(export|import);
+	// bucket change feed: GET /v1/buckets/<bck>?action=changes&since=<seq>
+	QparamAction = "action"
+
+	// cluster stats (apc.WhatNodeStats): filter by node IDs and metric names (comma-separated;
+	// "name*" denotes name prefix), and/or report only metrics that changed since a given time
+	// (Unix nanoseconds as returned by the previous call - see stats.Node.Now);
+	// bucket change feed: sequence number as returned by the previous call (see cmn.ChangeFeed.Next)
+	QparamNodes   = "nodes"
+	QparamMetrics = "metrics"
+	QparamSince   = "since"
+
+	// cluster stats: paginate targets (ordered by ID) - max page size and the continuation
+	// token returned by the previous call (see stats.Cluster.Next)
+	QparamPageSize  = "page_size"
+	QparamPageToken = "page_token"
+)
+
+// QparamAction values
+const (
+	BckExport  = "export"
+	BckImport  = "import"
+	BckChanges = "changes"
 )
 
 // QparamFltPresence enum.
@@ -172,6 +223,7 @@ const (
 	QparamHealthReadiness = "readiness" // to be used by external watchdogs (e.g. K8s)
 	QparamAskPrimary      = "apr"       // true: the caller is directing health request to primary
 	QparamPrimaryReadyReb = "prr"       // true: check whether primary is ready to start rebalancing cluster
+	QparamPrimaryLoad     = "pld"       // true: primary to report its load (overload, if any) - see keepalivetracker.primary_overload
 )
 
 // Internal query params.
@@ -182,6 +234,7 @@ const (
 	QparamNonElectable     = "nel" // true: proxy is non-electable for the primary role
 	QparamUnixTime         = "utm" // Unix time since 01/01/70 UTC (nanoseconds)
 	QparamIsGFNRequest     = "gfn" // true if the request is a Get-From-Neighbor
+	QparamECRead           = "ecr" // true: read EC-sliced object from its slices on behalf of the main target
 	QparamRebStatus        = "rbs" // true: get detailed rebalancing status
 	QparamRebData          = "rbd" // true: get EC rebalance data (pulling data if push way fails)
 	QparamClusterInfo      = "cii" // true: /Health to return `cos.NodeStateInfo` including cluster metadata versions and state flags
@@ -189,6 +242,8 @@ const (
 
 	QparamDontResilver = "dntres" // true: do not resilver data off of mountpaths that are being disabled/detached
 
+	QparamExportLocal = "exlocal" // true: export only this target's part of the bucket (see QparamAction)
+
 	// dsort
 	QparamTotalCompressedSize       = "tcs"
 	QparamTotalInputShardsExtracted = "tise"
@@ -215,6 +270,7 @@ const (
 	// config
 	WhatNodeConfig    = "config" // query specific node for (cluster config + overrides, local config)
 	WhatClusterConfig = "cluster_config"
+	WhatConfigDrift   = "config_drift" // (primary) nodes' live config vs cluster config - see cmn.ConfigDriftReport
 
 	// stats and status
 	WhatNodeStatsV322          = "stats"  // [ backward compatibility ]
@@ -230,15 +286,30 @@ const (
 	WhatRemoteAIS  = "remote"
 	WhatSmapVote   = "smapvote"
 	WhatSysInfo    = "sysinfo"
+	WhatCertInfo   = "cert"       // HTTPS: loaded server certificates - see apc.CertInfo
 	WhatTargetIPs  = "target_ips" // comma-separated list of all target IPs (compare w/ GetWhatSnode)
+	WhatLongReqs   = "long_reqs"  // long-running client requests (listings, summaries, promotes) - see cmn.LongReq
+	WhatQuarantine = "quarantine" // targets that proxies (temporarily) do not redirect GETs to - see cmn.Quarantine
+	WhatNsUsage    = "ns_usage"   // ais:// buckets per namespace vs configured limits - see cmn.NsUsage
+
+	WhatCapabilities = "capabilities" // feature matrix for clients to negotiate with (see apc.Capabilities)
 	// log
 	WhatLog = "log"
+	// pprof profile (admin): given node (/v1/daemon) or all nodes, captured simultaneously (/v1/cluster)
+	WhatProfile = "profile"
 	// xactions
 	WhatOneXactStatus   = "status"      // IC status by uuid (returns a single matching xaction or none)
 	WhatAllXactStatus   = "status_all"  // ditto - all matching xactions
 	WhatXactStats       = "getxstats"   // stats: xaction by uuid
 	WhatQueryXactStats  = "qryxstats"   // stats: all matching xactions
 	WhatAllRunningXacts = "running_all" // e.g. e.g.: put-copies[D-ViE6HEL_j] list[H96Y7bhR2s] ...
+
+	WhatXactHistory = "xact-history" // finished xactions (bounded, persistent); optionally, filtered by QparamBucket
+
+	WhatHeatmap = "heatmap" // per-bucket, per-prefix GET access counters; optionally, filtered by QparamBucket
+
+	WhatBpropsHistory = "props-history" // bucket props changes (who, when, and what) - requires QparamBucket
+
 	// internal
 	WhatSnode    = "snode"
 	WhatICBundle = "ic_bundle"
@@ -250,3 +321,13 @@ const (
 	LogWarn = "warning"
 	LogErr  = "error"
 )
+
+// QparamProfType enum.
+const (
+	ProfCPU   = "cpu"   // sampled over QparamProfSeconds
+	ProfHeap  = "heap"  // immediate snapshot (QparamProfSeconds ignored)
+	ProfMutex = "mutex" // contention sampled over QparamProfSeconds
+
+	ProfDfltSeconds = 30
+	ProfMaxSeconds  = 300
+)
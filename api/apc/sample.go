@@ -0,0 +1,40 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// server-side random sample of a bucket's objects (ActSampleBck) => cmn.SampleRes
+//   - with ETLName: each sampled object gets transformed (offline), and the transformer is expected
+//     to respond with a JSON object - e.g. `{"label": "cat"}`; the response that parses counts
+//     as success, and its (optional) "label" - toward the label distribution
+//   - without ETL: the sampled objects are not read - only their metadata (size) gets loaded
+type SampleMsg struct {
+	Prefix  string       `json:"prefix,omitempty"`   // sample only the objects with names that start with the prefix
+	ETLName string       `json:"etl_name,omitempty"` // name of an existing ETL (optional)
+	Timeout cos.Duration `json:"timeout,omitempty"`  // ETL: per-object transform timeout (default: 30s)
+	Count   int          `json:"count"`              // sample size
+}
+
+const (
+	SampleMaxCount  = 100_000
+	SampleMaxLabels = 1000 // max distinct labels (the rest is counted under SampleLabelOther)
+
+	SampleLabelOther = "(other)"
+)
+
+func (msg *SampleMsg) Validate() error {
+	if msg.Count < 1 || msg.Count > SampleMaxCount {
+		return fmt.Errorf("invalid sample size %d (expecting 1 through %d)", msg.Count, SampleMaxCount)
+	}
+	if msg.Timeout < 0 {
+		return fmt.Errorf("invalid ETL timeout %v", msg.Timeout)
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ package apc
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -20,6 +21,10 @@ type (
 		Force     bool   `json:"force"`       // force running in presence of "limited coexistence" type conflicts
 		LatestVer bool   `json:"latest-ver"`  // see also: QparamLatestVer, 'versioning.validate_warm_get', PrefetchMsg
 		Sync      bool   `json:"synchronize"` // see also: 'versioning.synchronize'
+
+		// per-target bandwidth cap, bytes per second (0 - unlimited), e.g. "100MiB";
+		// (copying in the cluster with N targets can therefore run at up to N * MaxThroughput)
+		MaxThroughput cos.SizeIEC `json:"max_throughput,omitempty"`
 	}
 	Transform struct {
 		Name    string       `json:"id,omitempty"`
@@ -43,8 +48,11 @@ type (
 ////////////
 
 func (msg *TCBMsg) Validate(isEtl bool) (err error) {
-	if isEtl && msg.Transform.Name == "" {
+	switch {
+	case isEtl && msg.Transform.Name == "":
 		err = errors.New("ETL name can't be empty")
+	case msg.MaxThroughput < 0:
+		err = fmt.Errorf("invalid max throughput %d (expecting non-negative number of bytes per second)", msg.MaxThroughput)
 	}
 	return
 }
@@ -0,0 +1,36 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import "time"
+
+// HTTPS server certificates: which listeners (see ActRotateCert)
+const (
+	CertNetPub   = "pub"   // public listener(s)
+	CertNetIntra = "intra" // intra-cluster control and data listeners
+)
+
+type (
+	// ActRotateCert: PEM-encoded certificate (optionally, with intermediates) and private key;
+	// empty - reload the (renewed) certificates from the configured files
+	ActValCert struct {
+		Net  string `json:"net,omitempty"` // CertNetPub, CertNetIntra, or empty (all listeners)
+		Cert string `json:"cert,omitempty"`
+		Key  string `json:"key,omitempty"`
+	}
+
+	// loaded server certificate (see WhatCertInfo)
+	CertInfo struct {
+		NotBefore time.Time `json:"not_before"`
+		NotAfter  time.Time `json:"not_after"`
+		Loaded    time.Time `json:"loaded"`
+		Net       string    `json:"net"` // CertNetPub or CertNetIntra
+		Subject   string    `json:"subject"`
+		Issuer    string    `json:"issuer"`
+		Serial    string    `json:"serial"`
+		Source    string    `json:"source"` // certificate file
+		DNSNames  []string  `json:"dns_names,omitempty"`
+	}
+)
@@ -33,6 +33,7 @@ const (
 	Clusters  = "clusters" // AuthN
 	Roles     = "roles"    // AuthN
 	IC        = "ic"       // information center
+	Gossip    = "gossip"   // (optional) gossip-based failure detection
 
 	// l3 ---
 
@@ -64,6 +65,7 @@ const (
 	FinishedAck = "finished_ack"
 	UList       = "list"
 	Remove      = "remove"
+	Templates   = "templates"
 	Next        = "next"
 	Peek        = "peek"
 	Discard     = "discard"
@@ -106,6 +108,7 @@ var (
 	URLPathXactions  = urlpath(Version, Xactions)
 	URLPathIC        = urlpath(Version, IC)
 	URLPathHealth    = urlpath(Version, Health)
+	URLPathGossip    = urlpath(Version, Gossip)
 	URLPathMetasync  = urlpath(Version, Metasync)
 	URLPathRebalance = urlpath(Version, Rebalance)
 
@@ -149,6 +152,7 @@ var (
 	URLPathdSortMetrics = urlpath(Version, Sort, Metrics)
 	URLPathdSortAck     = urlpath(Version, Sort, FinishedAck)
 	URLPathdSortRemove  = urlpath(Version, Sort, Remove)
+	URLPathdSortTmpls   = urlpath(Version, Sort, Templates)
 
 	URLPathDownload       = urlpath(Version, Download)
 	URLPathDownloadAbort  = urlpath(Version, Download, Abort)
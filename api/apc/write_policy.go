@@ -28,3 +28,48 @@ func (wp WritePolicy) Validate() (err error) {
 	}
 	return fmt.Errorf("invalid write policy %q (expecting one of %v)", wp, SupportedWritePolicy)
 }
+
+// durability of PUT (and all other writes that finalize objects), bucket-configurable
+// with global default via cluster config (`write_policy.fsync`)
+type FsyncPolicy string
+
+const (
+	FsyncNone = FsyncPolicy("none") // rely on the OS to eventually flush (default)
+	FsyncMD   = FsyncPolicy("md")   // upon commit: fsync the object (inode and xattr) and its parent directory (name)
+	FsyncFull = FsyncPolicy("full") // same as FsyncMD, plus: fsync the content prior to commit
+
+	FsyncDefault = FsyncPolicy("") // same as `FsyncNone`
+)
+
+var SupportedFsyncPolicy = [...]string{string(FsyncNone), string(FsyncMD), string(FsyncFull)}
+
+func (fp FsyncPolicy) IsNone() bool { return fp == FsyncDefault || fp == FsyncNone }
+
+func (fp FsyncPolicy) Validate() error {
+	if fp.IsNone() || fp == FsyncMD || fp == FsyncFull {
+		return nil
+	}
+	return fmt.Errorf("invalid fsync policy %q (expecting one of %v)", fp, SupportedFsyncPolicy)
+}
+
+// when to acknowledge PUT into an n-way mirrored ais:// bucket (`mirror.write_ack`)
+type WriteAck string
+
+const (
+	WriteAckOne    = WriteAck("one")    // upon writing the object; mirror copies are added asynchronously (default)
+	WriteAckQuorum = WriteAck("quorum") // upon writing the majority of (configured) `mirror.copies`
+	WriteAckAll    = WriteAck("all")    // upon writing all `mirror.copies`
+
+	WriteAckDefault = WriteAck("") // same as `WriteAckOne`
+)
+
+var SupportedWriteAck = [...]string{string(WriteAckOne), string(WriteAckQuorum), string(WriteAckAll)}
+
+func (wa WriteAck) IsOne() bool { return wa == WriteAckDefault || wa == WriteAckOne }
+
+func (wa WriteAck) Validate() error {
+	if wa.IsOne() || wa == WriteAckQuorum || wa == WriteAckAll {
+		return nil
+	}
+	return fmt.Errorf("invalid write ack %q (expecting one of %v)", wa, SupportedWriteAck)
+}
@@ -62,8 +62,22 @@ func DeleteUser(bp api.BaseParams, userID string) error {
 // The token expires in `expire` time. If `expire` is `nil` the expiration
 // time is set by AuthN (default AuthN expiration time is 24 hours)
 func LoginUser(bp api.BaseParams, userID, pass string, expire *time.Duration) (token *TokenMsg, err error) {
+	return login(bp, userID, &LoginMsg{Password: pass, ExpiresIn: expire})
+}
+
+// Same as LoginUser but additionally returns a newly generated pair of S3 credentials
+// (access key ID and secret access key) to be used with the returned token as the S3
+// session token (`X-Amz-Security-Token`). Each call generates a new pair (key rotation).
+func LoginUserS3(bp api.BaseParams, userID, pass string, expire *time.Duration) (token *TokenMsg, err error) {
+	token, err = login(bp, userID, &LoginMsg{Password: pass, ExpiresIn: expire, S3: true})
+	if err == nil && token.S3AccessKeyID == "" {
+		err = errors.New("login failed: AuthN server did not return S3 credentials")
+	}
+	return token, err
+}
+
+func login(bp api.BaseParams, userID string, rec *LoginMsg) (token *TokenMsg, err error) {
 	bp.Method = http.MethodPost
-	rec := LoginMsg{Password: pass, ExpiresIn: expire}
 	reqParams := api.AllocRp()
 	defer api.FreeRp(reqParams)
 	{
@@ -272,6 +286,51 @@ func RevokeToken(bp api.BaseParams, token string) error {
 	return reqParams.DoRequest()
 }
 
+// CreateAPIKey issues a new API key for the (authenticated) caller; the returned
+// APIKey.Token is the bearer token to use - it cannot be retrieved later
+func CreateAPIKey(bp api.BaseParams, msg *APIKeyMsg) (*APIKey, error) {
+	bp.Method = http.MethodPost
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathTokens.S
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	key := &APIKey{}
+	_, err := reqParams.DoReqAny(key)
+	return key, err
+}
+
+// ListAPIKeys returns the caller's API keys (all API keys, if the caller is admin)
+func ListAPIKeys(bp api.BaseParams) ([]*APIKey, error) {
+	bp.Method = http.MethodGet
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathTokens.S
+	}
+	keys := make([]*APIKey, 0)
+	_, err := reqParams.DoReqAny(&keys)
+
+	less := func(i, j int) bool { return keys[i].Created.Before(keys[j].Created) }
+	sort.Slice(keys, less)
+	return keys, err
+}
+
+func RevokeAPIKey(bp api.BaseParams, keyID string) error {
+	bp.Method = http.MethodDelete
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathTokens.Join(keyID)
+	}
+	return reqParams.DoRequest()
+}
+
 func GetConfig(bp api.BaseParams) (*Config, error) {
 	bp.Method = http.MethodGet
 	reqParams := api.AllocRp()
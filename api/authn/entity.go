@@ -37,11 +37,34 @@ type (
 
 	TokenMsg struct {
 		Token string `json:"token"`
+		// S3 credentials (when requested via LoginMsg.S3); use Token as the session token
+		S3AccessKeyID string `json:"s3_access_key_id,omitempty"`
+		S3SecretKey   string `json:"s3_secret_access_key,omitempty"`
 	}
 
 	LoginMsg struct {
 		Password  string         `json:"password"`
 		ExpiresIn *time.Duration `json:"expires_in"`
+		S3        bool           `json:"s3,omitempty"` // also issue S3 (SigV4) access key ID and secret
+	}
+
+	// API key: long-lived token scoped to (a subset of) the owner's permissions;
+	// unlike login sessions, API keys are listed and revoked individually, by ID
+	APIKeyMsg struct {
+		Name        string         `json:"name"`
+		ClusterACLs []*CluACL      `json:"clusters,omitempty"`
+		BucketACLs  []*BckACL      `json:"buckets,omitempty"`
+		ExpiresIn   *time.Duration `json:"expires_in,omitempty"`
+	}
+	APIKey struct {
+		Created     time.Time `json:"created"`
+		Expires     time.Time `json:"expires"`
+		ID          string    `json:"id"`
+		Name        string    `json:"name"`
+		UserID      string    `json:"user_id"`
+		Token       string    `json:"token,omitempty"` // returned only once - upon creation
+		ClusterACLs []*CluACL `json:"clusters,omitempty"`
+		BucketACLs  []*BckACL `json:"buckets,omitempty"`
 	}
 
 	RegisteredClusters struct {
@@ -5,6 +5,7 @@
 package api
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -203,6 +204,27 @@ func GetBucketSummary(bp BaseParams, qbck cmn.QueryBcks, msg *apc.BsummCtrlMsg,
 	return
 }
 
+// GetBucketSummaryStream queries bucket summary job (that must be already running - see BsummArgs.DontWait)
+// and calls back with each target's results as soon as the latter arrive, followed by the cluster-wide
+// (aggregated) results - see cmn.BsummLine
+func GetBucketSummaryStream(bp BaseParams, qbck cmn.QueryBcks, msg *apc.BsummCtrlMsg, cb func(*cmn.BsummLine) error) error {
+	if msg.UUID == "" {
+		return errors.New("bucket summary stream: missing job ID")
+	}
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(qbck.Name)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = qbck.NewQuery()
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActSummaryBck, Value: msg})
+	}
+	err := doJlines(reqParams, cb)
+	FreeRp(reqParams)
+	return err
+}
+
 // Wait/poll bucket-summary:
 // - initiate `apc.ActSummaryBck` (msg.UUID == "").
 // - poll for status != ok
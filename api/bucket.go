@@ -5,6 +5,8 @@
 package api
 
 import (
+	"errors"
+	"io"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -158,6 +160,51 @@ func DestroyBucket(bp BaseParams, bck cmn.Bck) error {
 	return err
 }
 
+// UndeleteBucket restores a destroyed ais:// bucket from the trash - see `space.trash_grace` config.
+// The bucket can be specified either by its original name (in which case the most recently
+// destroyed instance gets restored) or by its name in the (hidden) `cmn.NsTrash` namespace.
+func UndeleteBucket(bp BaseParams, bck cmn.Bck) error {
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActUndeleteBck})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.NewQuery()
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
+// FreezeBucket blocks all writes to the bucket and returns when all targets are quiescent,
+// with the bucket's metadata flushed - e.g., prior to taking external (filesystem) snapshot.
+// Use ThawBucket to resume writes.
+func FreezeBucket(bp BaseParams, bck cmn.Bck) error {
+	return _freeze(bp, bck, apc.ActFreezeBck)
+}
+
+// ThawBucket resumes writes to the previously frozen bucket.
+func ThawBucket(bp BaseParams, bck cmn.Bck) error {
+	return _freeze(bp, bck, apc.ActThawBck)
+}
+
+func _freeze(bp BaseParams, bck cmn.Bck, action string) error {
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: action})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.NewQuery()
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
 // CopyBucket copies existing `bckFrom` bucket to the destination `bckTo` thus,
 // effectively, creating a copy of the `bckFrom`.
 //   - AIS will create `bckTo` on the fly but only if the destination bucket does not
@@ -223,6 +270,160 @@ func RenameBucket(bp BaseParams, bckFrom, bckTo cmn.Bck) (xid string, err error)
 	return
 }
 
+// CloneBucket creates bckTo as a zero-copy (copy-on-write) clone of the existing ais bucket bckFrom:
+// GET requests fall through to bckFrom until the corresponding object gets written into bckTo.
+func CloneBucket(bp BaseParams, bckFrom, bckTo cmn.Bck) error {
+	if err := bckTo.Validate(); err != nil {
+		return err
+	}
+	bp.Method = http.MethodPost
+	q := bckFrom.NewQuery()
+	_ = bckTo.AddUnameToQuery(q, apc.QparamBckTo)
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bckFrom.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActCloneBck})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = q
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
+// DiffBucket compares bckA with bckB - by name, size, and (when comparable) checksum and version -
+// and returns the delta: objects only in bckA, only in bckB, or present in both but differing.
+// Either bucket can be remote, including remote AIS (i.e., bucket in an attached cluster).
+// Returns an error if the diff was interrupted; the partial result is returned as well.
+func DiffBucket(bp BaseParams, bckA, bckB cmn.Bck, msg *apc.DiffBckMsg) (*cmn.DiffBckRes, error) {
+	if err := bckB.Validate(); err != nil {
+		return nil, err
+	}
+	q := bckA.NewQuery()
+	_ = bckB.AddUnameToQuery(q, apc.QparamBckTo)
+	bp.Method = http.MethodPost
+	res := &cmn.DiffBckRes{}
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bckA.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActDiffBck, Value: msg})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = q
+	}
+	_, err := reqParams.DoReqAny(res)
+	FreeRp(reqParams)
+	if err == nil && res.Err != "" {
+		err = errors.New(res.Err)
+	}
+	return res, err
+}
+
+// SampleBucket reads a random sample of the bucket's objects on the server side (optionally,
+// via ETL) and returns aggregate statistics - see apc.SampleMsg.
+func SampleBucket(bp BaseParams, bck cmn.Bck, msg *apc.SampleMsg) (*cmn.SampleRes, error) {
+	bp.Method = http.MethodPost
+	res := &cmn.SampleRes{}
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActSampleBck, Value: msg})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.NewQuery()
+	}
+	_, err := reqParams.DoReqAny(res)
+	FreeRp(reqParams)
+	return res, err
+}
+
+// ExportBucket streams the entire bucket - objects along with their metadata - as a single tar
+// and writes the latter into `w`. Returns the number of bytes written.
+// See also: ImportBucket
+func ExportBucket(bp BaseParams, bck cmn.Bck, w io.Writer) (int64, error) {
+	q := bck.NewQuery()
+	q.Set(apc.QparamAction, apc.BckExport)
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Query = q
+	}
+	wresp, err := reqParams.doWriter(w)
+	FreeRp(reqParams)
+	if err != nil {
+		return 0, err
+	}
+	return wresp.n, nil
+}
+
+// GetBucketChanges returns the bucket's object changes (PUT, DELETE, rename) with sequence
+// numbers greater than `since`. Zero `since` returns no events - only the current sequence
+// number to start from. To continue, pass the returned `Next` as `since` in the next call.
+// Fails with 410 Gone when the requested changes are no longer available (in which case
+// the caller must resync via a full listing).
+func GetBucketChanges(bp BaseParams, bck cmn.Bck, since int64) (feed *cmn.ChangeFeed, err error) {
+	q := bck.NewQuery()
+	q.Set(apc.QparamAction, apc.BckChanges)
+	q.Set(apc.QparamSince, strconv.FormatInt(since, 10))
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Query = q
+	}
+	feed = &cmn.ChangeFeed{}
+	_, err = reqParams.DoReqAny(feed)
+	FreeRp(reqParams)
+	return feed, err
+}
+
+// GetBpropsHistory returns the bucket's props changes - who, when, and what - oldest first
+// (bounded; see cmn.BpropsChange)
+func GetBpropsHistory(bp BaseParams, bck cmn.Bck) (hist []*cmn.BpropsChange, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatBpropsHistory}, apc.QparamBucket: []string{bck.Cname("")}}
+	}
+	_, err = reqParams.DoReqAny(&hist)
+	FreeRp(reqParams)
+	return hist, err
+}
+
+// ImportBucket PUTs the content of a (previously exported) tar into an existing bucket.
+// The reader must be reopenable (e.g., an open file) to follow the proxy's redirect.
+func ImportBucket(bp BaseParams, bck cmn.Bck, reader cos.ReadOpenCloser) error {
+	q := bck.NewQuery()
+	q.Set(apc.QparamAction, apc.BckImport)
+	reqArgs := cmn.AllocHra()
+	{
+		reqArgs.Method = http.MethodPut
+		reqArgs.Base = bp.URL
+		reqArgs.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqArgs.Query = q
+		reqArgs.BodyR = reader
+	}
+	newRequest := func(reqArgs *cmn.HreqArgs) (*http.Request, error) {
+		req, err := reqArgs.Req()
+		if err != nil {
+			return nil, newErrCreateHTTPRequest(err)
+		}
+		req.GetBody = func() (io.ReadCloser, error) { return reader.Open() }
+		req.Header.Set(cos.HdrContentType, cos.ContentTar)
+		SetAuxHeaders(req, &bp)
+		return req, nil
+	}
+	_, err := DoWithRetry(bp.Client, newRequest, reqArgs) //nolint:bodyclose // is closed inside
+	cmn.FreeHra(reqArgs)
+	return err
+}
+
 // EvictRemoteBucket sends request to evict an entire remote bucket from the AIStore
 // - keepMD: evict objects but keep bucket metadata
 func EvictRemoteBucket(bp BaseParams, bck cmn.Bck, keepMD bool) error {
@@ -254,7 +455,25 @@ func MakeNCopies(bp BaseParams, bck cmn.Bck, copies int) (xid string, err error)
 	{
 		reqParams.BaseParams = bp
 		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
-		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActMakeNCopies, Value: copies})
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActMakeNCopies, Value: &apc.MNCMsg{Copies: int64(copies)}})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.NewQuery()
+	}
+	_, err = reqParams.doReqStr(&xid)
+	FreeRp(reqParams)
+	return
+}
+
+// MakeNCopiesMsg is MakeNCopies with options: prefix (to restrict the operation to
+// a subset of bucket's objects) and per-target bandwidth limit (see apc.MNCMsg).
+// Returns xaction ID if successful, an error otherwise.
+func MakeNCopiesMsg(bp BaseParams, bck cmn.Bck, msg *apc.MNCMsg) (xid string, err error) {
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActMakeNCopies, Value: msg})
 		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
 		reqParams.Query = bck.NewQuery()
 	}
@@ -11,6 +11,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"sync"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -21,6 +22,9 @@ import (
 	"github.com/tinylib/msgp/msgp"
 )
 
+// REST API revision this client speaks (see apc.HdrAPIRev)
+var apiRev = strconv.Itoa(apc.APIRevMax)
+
 const (
 	errNilCksum     = "nil checksum"
 	errNilCksumType = "checksum is empty (checksum type %q) - cannot validate"
@@ -91,6 +95,7 @@ func SetAuxHeaders(r *http.Request, bp *BaseParams) {
 	if bp.UA != "" {
 		r.Header.Set(cos.HdrUserAgent, bp.UA)
 	}
+	r.Header.Set(apc.HdrAPIRev, apiRev)
 }
 
 func GetWhatRawQuery(getWhat, getProps string) string {
@@ -185,6 +190,31 @@ func (reqParams *ReqParams) doReader() (io.ReadCloser, int64, error) {
 	return resp.Body, resp.ContentLength, nil
 }
 
+// requests JSON lines (cos.ContentNDJSON) and calls back with each decoded line as soon as it arrives;
+// non-nil error returned by the callback stops reading
+func doJlines[T any](reqParams *ReqParams, cb func(*T) error) error {
+	if reqParams.Header == nil {
+		reqParams.Header = make(http.Header, 2)
+	}
+	reqParams.Header.Set(cos.HdrAccept, cos.ContentNDJSON)
+	body, _, err := reqParams.doReader()
+	if err != nil {
+		return err
+	}
+	dec := jsoniter.NewDecoder(body)
+	for dec.More() {
+		var line T
+		if err = dec.Decode(&line); err != nil {
+			break
+		}
+		if err = cb(&line); err != nil {
+			break
+		}
+	}
+	body.Close()
+	return err
+}
+
 // makes HTTP request, retries on connection-refused and reset errors, and returns the response
 func (reqParams *ReqParams) do() (resp *http.Response, err error) {
 	var reqBody io.Reader
@@ -347,6 +377,7 @@ func (reqParams *ReqParams) checkResp(resp *http.Response) error {
 				Status:   resp.StatusCode,
 				Method:   reqParams.BaseParams.Method,
 				URLPath:  reqParams.Path,
+				ReqID:    resp.Header.Get(apc.HdrReqID),
 			}
 		}
 	}
@@ -362,11 +393,15 @@ func (reqParams *ReqParams) checkResp(resp *http.Response) error {
 			Status:  resp.StatusCode,
 			Method:  reqParams.BaseParams.Method,
 			URLPath: reqParams.Path,
+			ReqID:   resp.Header.Get(apc.HdrReqID),
 		}
 	}
 
 	herr := &cmn.ErrHTTP{}
 	if err := jsoniter.Unmarshal(b, herr); err == nil {
+		if herr.ReqID == "" {
+			herr.ReqID = resp.Header.Get(apc.HdrReqID)
+		}
 		return herr
 	}
 	// otherwise, recreate
@@ -377,6 +412,7 @@ func (reqParams *ReqParams) checkResp(resp *http.Response) error {
 		Status:   resp.StatusCode,
 		Method:   reqParams.BaseParams.Method,
 		URLPath:  reqParams.Path,
+		ReqID:    resp.Header.Get(apc.HdrReqID),
 	}
 }
 
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -49,6 +51,25 @@ func HealthUptime(bp BaseParams, readyToRebalance ...bool) (string, string, erro
 	return clutime, nutime, err
 }
 
+// HTTPS: the earliest expiration of the node's server certificates
+// (zero time if the node does not use HTTPS)
+func HealthCertExpiry(bp BaseParams) (expiry time.Time, err error) {
+	reqParams := mkhealth(bp)
+	hdr, _, err := reqParams.doReqHdr()
+	FreeRp(reqParams)
+	if err != nil {
+		return expiry, err
+	}
+	if s := hdr.Get(apc.HdrCertExpiry); s != "" {
+		ns, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return expiry, err
+		}
+		expiry = time.Unix(0, ns)
+	}
+	return expiry, nil
+}
+
 func mkhealth(bp BaseParams, readyToRebalance ...bool) (reqParams *ReqParams) {
 	var q url.Values
 	bp.Method = http.MethodGet
@@ -257,6 +278,13 @@ func RotateClusterLogs(bp BaseParams) error {
 	return _putCluster(bp, apc.ActMsg{Action: apc.ActRotateLogs})
 }
 
+// HTTPS: upload a new server certificate to all nodes or, if `args` is nil (or empty),
+// have all nodes reload their (renewed) certificate files; zero downtime - applies to
+// subsequent TLS handshakes
+func RotateClusterCert(bp BaseParams, args *apc.ActValCert) error {
+	return _putCluster(bp, apc.ActMsg{Action: apc.ActRotateCert, Value: args})
+}
+
 func _putCluster(bp BaseParams, msg apc.ActMsg) error {
 	bp.Method = http.MethodPut
 	reqParams := AllocRp()
@@ -291,6 +319,103 @@ func GetClusterConfig(bp BaseParams) (*cmn.ClusterConfig, error) {
 	return cluConfig, nil
 }
 
+// GetConfigDrift returns the nodes whose live configuration differs from the cluster config
+// (see also: periodic.config_drift_time)
+func GetConfigDrift(bp BaseParams) (*cmn.ConfigDriftReport, error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatConfigDrift}}
+	}
+	report := &cmn.ConfigDriftReport{}
+	_, err := reqParams.DoReqAny(report)
+	FreeRp(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// GetLongRequests returns long-running client requests (listings, bucket summaries, promotes)
+// currently served by all proxies in the cluster
+func GetLongRequests(bp BaseParams) ([]*cmn.LongReq, error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatLongReqs}}
+	}
+	var lreqs []*cmn.LongReq
+	_, err := reqParams.DoReqAny(&lreqs)
+	FreeRp(reqParams)
+	return lreqs, err
+}
+
+// GetQuarantine returns, for each proxy in the cluster, the targets that the proxy currently
+// does not redirect GETs to, and the most recent quarantine events (see cmn.QuarantineConf)
+func GetQuarantine(bp BaseParams) ([]*cmn.Quarantine, error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatQuarantine}}
+	}
+	var out []*cmn.Quarantine
+	_, err := reqParams.DoReqAny(&out)
+	FreeRp(reqParams)
+	return out, err
+}
+
+// GetNsUsage returns, for each namespace, the number and total size of its ais:// buckets
+// vs configured limits (see cmn.NsQuotaConf); the size is as of the most recent refresh
+func GetNsUsage(bp BaseParams) (cmn.NsUsageList, error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatNsUsage}}
+	}
+	var out cmn.NsUsageList
+	_, err := reqParams.DoReqAny(&out)
+	FreeRp(reqParams)
+	return out, err
+}
+
+// GetClusterProfile captures pprof profiles of all nodes at the same time, and writes them
+// as a single TAR: "<node-ID>.<type>.pprof" files and "errors.txt" (nodes that failed, if any).
+// NOTE: ditto GetProfile (bp.Client timeout)
+func GetClusterProfile(bp BaseParams, args GetProfileInput) (int64, error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = args.query()
+	}
+	wrap, err := reqParams.doWriter(args.Writer)
+	FreeRp(reqParams)
+	if err == nil {
+		return wrap.n, nil
+	}
+	return 0, err
+}
+
+// CancelRequest aborts a long-running request by its ID (cmn.LongReq.ID) - the call in flight
+// (if any) and all subsequent calls with the same ID (e.g., the remaining list-objects pages)
+func CancelRequest(bp BaseParams, id string) error {
+	return _putCluster(bp, apc.ActMsg{Action: apc.ActCancelReq, Name: id})
+}
+
+// SetRequestPriority changes the priority of a long-running request, e.g. apc.PriorityLow
+func SetRequestPriority(bp BaseParams, id, priority string) error {
+	return _putCluster(bp, apc.ActMsg{Action: apc.ActSetReqPrio, Name: id, Value: priority})
+}
+
 func AttachRemoteAIS(bp BaseParams, alias, u string) error {
 	bp.Method = http.MethodPut
 	reqParams := AllocRp()
@@ -413,8 +538,14 @@ func StopMaintenance(bp BaseParams, actValue *apc.ActValRmNode) (xid string, err
 }
 
 // ShutdownCluster shuts down the whole cluster
-func ShutdownCluster(bp BaseParams) error {
+// ShutdownCluster gracefully shuts down the cluster: each node stops accepting new requests
+// and drains in-flight ones (bounded by the drain timeout) prior to shutting down;
+// actValue is optional (nil: default drain timeout, see apc.ActValShutdown)
+func ShutdownCluster(bp BaseParams, actValue *apc.ActValShutdown) error {
 	msg := apc.ActMsg{Action: apc.ActShutdownCluster}
+	if actValue != nil {
+		msg.Value = actValue
+	}
 	bp.Method = http.MethodPut
 	reqParams := AllocRp()
 	{
@@ -450,6 +581,35 @@ func DecommissionCluster(bp BaseParams, rmUserData bool) error {
 	return err
 }
 
+// DecommissionClusterOffload copies all ais:// buckets to the specified remote bucket
+// (e.g. "s3://archive") and, once the copies are verified, permanently decommissions
+// entire cluster. Until then, the call returns offload progress (and does nothing else) -
+// the caller is expected to keep calling it until the returned status is empty.
+func DecommissionClusterOffload(bp BaseParams, offloadTo string, rmUserData bool) (status []apc.OffloadStatus, err error) {
+	msg := apc.ActMsg{
+		Action: apc.ActDecommissionCluster,
+		Value:  &apc.ActValRmNode{RmUserData: rmUserData, OffloadTo: offloadTo},
+	}
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	var code int
+	code, err = reqParams.DoReqAny(&status)
+	FreeRp(reqParams)
+	if code != http.StatusAccepted {
+		status = nil // decommissioning
+		if cos.IsEOF(err) {
+			err = nil
+		}
+	}
+	return status, err
+}
+
 // ShutdownNode shuts down a specific node
 func ShutdownNode(bp BaseParams, actValue *apc.ActValRmNode) (id string, err error) {
 	msg := apc.ActMsg{
@@ -24,6 +24,12 @@ type GetLogInput struct {
 	All      bool
 }
 
+type GetProfileInput struct {
+	Writer  io.Writer
+	Type    string // one of: {apc.ProfCPU, ...}; empty - cpu
+	Seconds int    // zero - apc.ProfDfltSeconds
+}
+
 // GetMountpaths given the direct public URL of the target, returns the target's mountpaths or error.
 func GetMountpaths(bp BaseParams, node *meta.Snode) (mpl *apc.MountpathList, err error) {
 	bp.Method = http.MethodGet
@@ -146,6 +152,42 @@ func GetDaemonConfig(bp BaseParams, node *meta.Snode) (config *cmn.Config, err e
 	return config, nil
 }
 
+// GetCapabilities returns the feature matrix (supported actions, xactions, providers,
+// archive formats, auth modes, and API revision range) of the cluster that `bp.URL` points to.
+// Intended for clients to negotiate features and gracefully degrade vs older clusters.
+func GetCapabilities(bp BaseParams) (c *apc.Capabilities, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathDae.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatCapabilities}}
+	}
+	_, err = reqParams.DoReqAny(&c)
+	FreeRp(reqParams)
+	return c, err
+}
+
+// GetElectionHistory returns the node's current election term, the candidate it voted for
+// in this term (if any), and a bounded history of recent elections (pre-votes included).
+// Useful to debug dueling-primary scenarios (the data comes from GET what=smapvote).
+func GetElectionHistory(bp BaseParams, node *meta.Snode) (*meta.ElectionHistory, error) {
+	var out struct {
+		Vote *meta.ElectionHistory `json:"vote"`
+	}
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathReverseDae.S // NOTE: reverse, via p.reverseHandler
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatSmapVote}}
+		reqParams.Header = http.Header{apc.HdrNodeID: []string{node.ID()}}
+	}
+	_, err := reqParams.DoReqAny(&out)
+	FreeRp(reqParams)
+	return out.Vote, err
+}
+
 // names _and_ kinds, i.e. (name, kind) pairs
 func GetMetricNames(bp BaseParams, node *meta.Snode) (kvs cos.StrKVs, err error) {
 	bp.Method = http.MethodGet
@@ -191,6 +233,37 @@ func GetDaemonLog(bp BaseParams, node *meta.Snode, args GetLogInput) (int64, err
 	return 0, err
 }
 
+// GetProfile writes pprof profile of a given node (admin access).
+// NOTE: the call takes (args.Seconds) time - bp.Client timeout must be greater than that.
+func GetProfile(bp BaseParams, node *meta.Snode, args GetProfileInput) (int64, error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathReverseDae.S // NOTE: reverse, via p.reverseHandler
+		reqParams.Query = args.query()
+		reqParams.Header = http.Header{apc.HdrNodeID: []string{node.ID()}}
+	}
+	wrap, err := reqParams.doWriter(args.Writer)
+	FreeRp(reqParams)
+	if err == nil {
+		return wrap.n, nil
+	}
+	return 0, err
+}
+
+func (args *GetProfileInput) query() url.Values {
+	q := make(url.Values, 3)
+	q.Set(apc.QparamWhat, apc.WhatProfile)
+	if args.Type != "" {
+		q.Set(apc.QparamProfType, args.Type)
+	}
+	if args.Seconds != 0 {
+		q.Set(apc.QparamProfSeconds, strconv.Itoa(args.Seconds))
+	}
+	return q
+}
+
 // SetDaemonConfig, given key value pairs, sets the configuration accordingly for a specific node.
 func SetDaemonConfig(bp BaseParams, nodeID string, nvs cos.StrKVs, transient ...bool) error {
 	bp.Method = http.MethodPut
@@ -222,6 +295,27 @@ func RotateLogs(bp BaseParams, nodeID string) error {
 	return _putDaemon(bp, nodeID, apc.ActMsg{Action: apc.ActRotateLogs})
 }
 
+// HTTPS: upload a new server certificate to a given node or, if `args` is nil (or empty),
+// have the node reload its (renewed) certificate files; see also RotateClusterCert
+func RotateNodeCert(bp BaseParams, nodeID string, args *apc.ActValCert) error {
+	return _putDaemon(bp, nodeID, apc.ActMsg{Action: apc.ActRotateCert, Value: args})
+}
+
+// HTTPS: server certificates currently loaded by a given node
+func GetCertInfo(bp BaseParams, node *meta.Snode) (out []*apc.CertInfo, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathReverseDae.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatCertInfo}}
+		reqParams.Header = http.Header{apc.HdrNodeID: []string{node.ID()}}
+	}
+	_, err = reqParams.DoReqAny(&out)
+	FreeRp(reqParams)
+	return out, err
+}
+
 func _putDaemon(bp BaseParams, nodeID string, msg apc.ActMsg) error {
 	bp.Method = http.MethodPut
 	reqParams := AllocRp()
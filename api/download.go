@@ -77,6 +77,12 @@ func DownloadBackend(bp BaseParams, descr string, bck cmn.Bck, prefix, suffix st
 	return DownloadWithParam(bp, dload.TypeBackend, dlBody)
 }
 
+// DownloadDataset downloads a dataset repository, e.g. `dload.DatasetBody{HF: "org/dataset"}`
+// (the files to download are resolved by the cluster - see dload.DatasetBody)
+func DownloadDataset(bp BaseParams, body *dload.DatasetBody) (string, error) {
+	return DownloadWithParam(bp, dload.TypeDataset, body)
+}
+
 func DownloadStatus(bp BaseParams, id string, onlyActive bool) (dlStatus *dload.StatusResp, err error) {
 	dlBody := dload.AdminBody{ID: id, OnlyActive: onlyActive}
 	bp.Method = http.MethodGet
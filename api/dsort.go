@@ -83,3 +83,47 @@ func MetricsDsort(bp BaseParams, managerUUID string) (metrics map[string]*dsort.
 	FreeRp(reqParams)
 	return metrics, err
 }
+
+//
+// dsort job templates: named specs stored cluster-wide and referenced via `RequestSpec.JobTemplate`
+//
+
+// SetDsortTemplate creates or updates the named job template
+func SetDsortTemplate(bp BaseParams, name string, tmpl *dsort.RequestSpec) error {
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathdSortTmpls.Join(name)
+		reqParams.Body = cos.MustMarshal(tmpl)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
+func RemoveDsortTemplate(bp BaseParams, name string) error {
+	bp.Method = http.MethodDelete
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathdSortTmpls.Join(name)
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
+// returns all stored templates by name
+func GetDsortTemplates(bp BaseParams) (tmpls map[string]*dsort.RequestSpec, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathdSortTmpls.S
+	}
+	_, err = reqParams.DoReqAny(&tmpls)
+	FreeRp(reqParams)
+	return tmpls, err
+}
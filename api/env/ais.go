@@ -30,6 +30,8 @@ var (
 		K8sPod       string
 		K8sNode      string
 		K8sNamespace string
+		// rack, zone, or any other failure domain
+		FailureDomain string
 	}{
 		// the way to designate primary when cluster's starting up
 		Endpoint:  "AIS_ENDPOINT",
@@ -57,5 +59,9 @@ var (
 		K8sPod:       "MY_POD",
 		K8sNode:      "MY_NODE",
 		K8sNamespace: "K8S_NS",
+
+		// node label: all nodes that share a given failure domain (e.g., rack or zone)
+		// are likely to fail together
+		FailureDomain: "AIS_FAILURE_DOMAIN",
 	}
 )
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -53,6 +54,33 @@ func ListBuckets(bp BaseParams, qbck cmn.QueryBcks, fltPresence int) (cmn.Bcks,
 	q := make(url.Values, 4)
 	q.Set(apc.QparamFltPresence, strconv.Itoa(fltPresence))
 	qbck.AddToQuery(q)
+	return lsb(bp, qbck, q)
+}
+
+// ListBucketsMulti returns buckets of multiple providers in one call, e.g. all aws:// and gcp://
+// buckets; other than provider, the selection is as per `qbck` (namespace and, optionally, name).
+// See also: apc.ProviderSep
+func ListBucketsMulti(bp BaseParams, providers []string, qbck cmn.QueryBcks, fltPresence int) (cmn.Bcks, error) {
+	q := make(url.Values, 4)
+	q.Set(apc.QparamFltPresence, strconv.Itoa(fltPresence))
+	qbck.Provider = ""
+	qbck.AddToQuery(q)
+	q.Set(apc.QparamProvider, strings.Join(providers, apc.ProviderSep))
+	return lsb(bp, qbck, q)
+}
+
+// ListBucketsFederated returns this cluster's ais:// buckets along with all buckets
+// of all attached remote AIS clusters (the latter - in their respective "@uuid" namespaces).
+// Optionally, `qbck.Name` selects same-named buckets across the federation.
+// See also: apc.LsFederated
+func ListBucketsFederated(bp BaseParams, qbck cmn.QueryBcks) (cmn.Bcks, error) {
+	q := make(url.Values, 4)
+	q.Set(apc.QparamFederated, "true")
+	qbck.AddToQuery(q)
+	return lsb(bp, qbck, q)
+}
+
+func lsb(bp BaseParams, qbck cmn.QueryBcks, q url.Values) (cmn.Bcks, error) {
 
 	bp.Method = http.MethodGet
 	reqParams := AllocRp()
@@ -179,7 +207,8 @@ func lso(reqParams *ReqParams, lsmsg *apc.LsoMsg, args ListArgs) (lst *cmn.LsoRe
 			lst.Entries = append(lst.Entries, page.Entries...)
 			lst.ContinuationToken = page.ContinuationToken
 			lst.Flags |= page.Flags
-			debug.Assert(lst.UUID == page.UUID, lst.UUID, page.UUID)
+			// (federated listing switches UUIDs when switching between origin clusters)
+			debug.Assert(lst.UUID == page.UUID || lsmsg.IsFlagSet(apc.LsFederated), lst.UUID, page.UUID)
 		}
 		if ctx != nil && ctx.mustCall() {
 			ctx.count = len(lst.Entries)
@@ -242,6 +271,31 @@ func ListObjectsPage(bp BaseParams, bck cmn.Bck, lsmsg *apc.LsoMsg, args ListArg
 	return page, nil
 }
 
+// ListObjectsSummary returns the number of (in-cluster) objects and their total size
+// per (virtual) subdirectory, `depth` levels below the prefix (zero depth: a single total).
+// Unlike ListObjects, the result is computed by the targets in a single pass - no entries
+// get transferred - and is returned all at once, sorted by prefix.
+// See also: apc.LsSummary
+func ListObjectsSummary(bp BaseParams, bck cmn.Bck, prefix string, depth int) (cmn.LsoSumms, error) {
+	lsmsg := &apc.LsoMsg{Prefix: prefix, Depth: depth, Flags: apc.LsSummary | apc.LsObjCached}
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActList, Value: lsmsg})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.NewQuery()
+	}
+	summs := cmn.LsoSumms{}
+	_, err := reqParams.DoReqAny(&summs)
+	FreeRp(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	return summs, nil
+}
+
 // TODO: obsolete this function after introducing mechanism to detect remote bucket changes.
 func ListObjectsInvalidateCache(bp BaseParams, bck cmn.Bck) error {
 	var (
@@ -87,12 +87,31 @@ type (
 
 		Size uint64 // optional
 
+		// optional; object's time-to-live (ais:// buckets only) - upon expiration
+		// the object gets deleted (see also apc.HdrObjTTL)
+		TTL time.Duration
+
 		// Skip loading existing object's metadata in order to
 		// compare its Checksum and update its existing Version (if exists);
 		// can be used to reduce PUT latency when:
 		// - we massively write a new content into a bucket, and/or
 		// - we simply don't care.
 		SkipVC bool
+
+		// Put-if-absent: fail with 412 (Precondition Failed) if the object already exists
+		// (ais:// buckets only; see also cos.HdrIfNoneMatch)
+		IfAbsent bool
+	}
+
+	// PUT of unknown length (e.g., program output piped directly into AIS);
+	// the checksum is computed en route and sent in the HTTP trailer
+	// for the target to validate upon receiving the last byte
+	PutStreamArgs struct {
+		Reader     io.Reader
+		BaseParams BaseParams
+		Bck        cmn.Bck
+		ObjName    string
+		CksumType  string // optional; default: cos.ChecksumXXHash
 	}
 
 	// (see also: api.PutApndArchArgs)
@@ -104,6 +123,21 @@ type (
 		Handle     string
 		Size       int64
 	}
+	// APPEND flow control, as advertised by the target (see api.AppendObjectWindow)
+	AppendWindow struct {
+		Deadline time.Time // continue (APPEND) or flush by; zero: no deadline
+		Handle   string
+		Window   int64 // max size of the next APPEND; zero: back off and retry; -1: unlimited
+	}
+	// overwrite (or extend) a byte range of an existing object, in place (see api.PatchObject)
+	PatchArgs struct {
+		Reader     cos.ReadOpenCloser
+		BaseParams BaseParams
+		Bck        cmn.Bck
+		ObjName    string
+		Offset     int64 // must be within (or right at the end of) the existing object
+		Size       int64 // required
+	}
 	FlushArgs struct {
 		Cksum      *cos.Cksum
 		BaseParams BaseParams
@@ -276,6 +310,12 @@ func (args *PutArgs) put(reqArgs *cmn.HreqArgs) (*http.Request, error) {
 	if args.Size != 0 {
 		req.ContentLength = int64(args.Size) // as per https://tools.ietf.org/html/rfc7230#section-3.3.2
 	}
+	if args.TTL > 0 {
+		req.Header.Set(apc.HdrObjTTL, args.TTL.String())
+	}
+	if args.IfAbsent {
+		req.Header.Set(cos.HdrIfNoneMatch, "*")
+	}
 	SetAuxHeaders(req, &args.BaseParams)
 	return req, nil
 }
@@ -301,6 +341,25 @@ func (args *AppendArgs) _append(reqArgs *cmn.HreqArgs) (*http.Request, error) {
 	return req, nil
 }
 
+///////////////
+// PatchArgs //
+///////////////
+
+func (args *PatchArgs) getBody() (io.ReadCloser, error) { return args.Reader.Open() }
+
+func (args *PatchArgs) patch(reqArgs *cmn.HreqArgs) (*http.Request, error) {
+	req, err := reqArgs.Req()
+	if err != nil {
+		return nil, newErrCreateHTTPRequest(err)
+	}
+	req.GetBody = args.getBody
+	req.ContentLength = args.Size
+	req.Header.Set(cos.HdrContentRange,
+		fmt.Sprintf("%s%d-%d/*", cos.HdrContentRangeValPrefix, args.Offset, args.Offset+args.Size-1))
+	SetAuxHeaders(req, &args.BaseParams)
+	return req, nil
+}
+
 // HeadObject returns object properties; can be conventionally used to establish in-cluster presence.
 // - fltPresence:  as per QparamFltPresence enum (for values and comments, see api/apc/query.go)
 // - silent==true: not to log (not-found) error
@@ -444,6 +503,116 @@ func PutObject(args *PutArgs) (oah ObjAttrs, err error) {
 	return
 }
 
+// PutObjectStream PUTs a stream of unknown length using chunked transfer encoding,
+// with checksum sent in the HTTP trailer. On checksum mismatch the target fails the request
+// and discards all received data.
+// Unlike PutObject, there's no retrying: a stream cannot be replayed.
+func PutObjectStream(args *PutStreamArgs) (oah ObjAttrs, err error) {
+	ckty := args.CksumType
+	if ckty == "" {
+		ckty = cos.ChecksumXXHash
+	}
+	if err = cos.ValidateCksumType(ckty); err != nil {
+		return oah, err
+	}
+	if ckty == cos.ChecksumNone {
+		return oah, fmt.Errorf("invalid checksum type %q (streaming PUT requires checksum)", ckty)
+	}
+
+	// 1. resolve the target (ie., get redirected) without sending any payload
+	location, err := args.location()
+	if err != nil {
+		return oah, err
+	}
+
+	// 2. stream
+	req, err := http.NewRequest(http.MethodPut, location, nil)
+	if err != nil {
+		return oah, newErrCreateHTTPRequest(err)
+	}
+	tr := &trailerReader{r: args.Reader, cksum: cos.NewCksumHash(ckty), req: req}
+	req.Body = io.NopCloser(tr)
+	req.ContentLength = -1 // unknown (chunked)
+	req.Header.Set(apc.HdrObjCksumType, ckty)
+	req.Trailer = http.Header{http.CanonicalHeaderKey(apc.HdrObjCksumVal): nil}
+	SetAuxHeaders(req, &args.BaseParams)
+
+	resp, err := args.BaseParams.Client.Do(req) //nolint:bodyclose // closed below
+	if err != nil {
+		return oah, err
+	}
+	reqParams := AllocRp()
+	reqParams.BaseParams = args.BaseParams
+	reqParams.BaseParams.Method = http.MethodPut
+	err = reqParams.checkResp(resp)
+	FreeRp(reqParams)
+	cos.DrainReader(resp.Body)
+	cos.Close(resp.Body)
+	if err == nil {
+		oah.wrespHeader = resp.Header
+	}
+	return oah, err
+}
+
+func (args *PutStreamArgs) location() (string, error) {
+	reqArgs := cmn.AllocHra()
+	{
+		reqArgs.Method = http.MethodPut
+		reqArgs.Base = args.BaseParams.URL
+		reqArgs.Path = apc.URLPathObjects.Join(args.Bck.Name, args.ObjName)
+		reqArgs.Query = args.Bck.NewQuery()
+	}
+	req, err := reqArgs.Req()
+	cmn.FreeHra(reqArgs)
+	if err != nil {
+		return "", newErrCreateHTTPRequest(err)
+	}
+	SetAuxHeaders(req, &args.BaseParams)
+
+	client := *args.BaseParams.Client
+	client.CheckRedirect = func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }
+	resp, err := client.Do(req) //nolint:bodyclose // closed below
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		cos.DrainReader(resp.Body)
+		cos.Close(resp.Body)
+	}()
+	if loc := resp.Header.Get(cos.HdrLocation); loc != "" && resp.StatusCode < http.StatusBadRequest {
+		return loc, nil
+	}
+	reqParams := AllocRp()
+	reqParams.BaseParams = args.BaseParams
+	reqParams.BaseParams.Method = http.MethodPut
+	err = reqParams.checkResp(resp)
+	FreeRp(reqParams)
+	if err == nil {
+		err = fmt.Errorf("PUT %s: expected redirect, got %s", args.Bck.Cname(args.ObjName), resp.Status)
+	}
+	return "", err
+}
+
+// computes checksum en route and, upon EOF, fills-in the request trailer
+// (which net/http sends only after the body has been fully read)
+type trailerReader struct {
+	r     io.Reader
+	cksum *cos.CksumHash
+	req   *http.Request
+}
+
+func (tr *trailerReader) Read(b []byte) (n int, err error) {
+	n, err = tr.r.Read(b)
+	if n > 0 {
+		tr.cksum.H.Write(b[:n])
+	}
+	if err == io.EOF {
+		tr.cksum.Finalize()
+		tr.req.Trailer.Set(apc.HdrObjCksumVal, tr.cksum.Value())
+	}
+	return n, err
+}
+
 // Archive the content of a reader (`args.Reader` - e.g., an open file).
 // Destination, depending on the options, can be an existing (.tar, .tgz or .tar.gz, .zip, .tar.lz4)
 // formatted object (aka "shard") or a new one (or, a new version).
@@ -484,6 +653,19 @@ func PutApndArch(args *PutApndArchArgs) (err error) {
 // to finalize the object.
 // NOTE: object becomes visible and accessible only _after_ the call to `api.FlushObject`.
 func AppendObject(args *AppendArgs) (string /*handle*/, error) {
+	aw, err := AppendObjectWindow(args)
+	if err != nil {
+		return "", err
+	}
+	return aw.Handle, nil
+}
+
+// AppendObjectWindow is AppendObject that also returns the target's flow control:
+// the max size of the next APPEND and the deadline to either continue or flush.
+// Exceeding the window fails with 413 (Request Entity Too Large), exceeding the target's
+// in-flight budget - with 429 (Too Many Requests), and using expired handle - with 410 (Gone).
+// See also: cmn.AppendConf
+func AppendObjectWindow(args *AppendArgs) (*AppendWindow, error) {
 	q := make(url.Values, 4)
 	q.Set(apc.QparamAppendType, apc.AppendOp)
 	q.Set(apc.QparamAppendHandle, args.Handle)
@@ -500,9 +682,46 @@ func AppendObject(args *AppendArgs) (string /*handle*/, error) {
 	wresp, err := DoWithRetry(args.BaseParams.Client, args._append, reqArgs) //nolint:bodyclose // it's closed inside
 	cmn.FreeHra(reqArgs)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	aw := &AppendWindow{Handle: wresp.Header.Get(apc.HdrAppendHandle), Window: -1}
+	if s := wresp.Header.Get(apc.HdrAppendWindow); s != "" {
+		if aw.Window, err = strconv.ParseInt(s, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid %s header %q: %v", apc.HdrAppendWindow, s, err)
+		}
 	}
-	return wresp.Header.Get(apc.HdrAppendHandle), err
+	if s := wresp.Header.Get(apc.HdrAppendDeadline); s != "" {
+		ns, err := cos.S2UnixNano(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s header %q: %v", apc.HdrAppendDeadline, s, err)
+		}
+		aw.Deadline = time.Unix(0, ns)
+	}
+	return aw, nil
+}
+
+// PatchObject overwrites `args.Size` bytes of an existing object starting at `args.Offset`
+// (with the object growing if the range extends beyond its current size).
+// Returns the resulting object's version and checksum.
+// Supported for ais:// buckets only.
+func PatchObject(args *PatchArgs) (oah ObjAttrs, err error) {
+	if args.Size <= 0 {
+		return oah, fmt.Errorf("invalid patch size %d", args.Size)
+	}
+	reqArgs := cmn.AllocHra()
+	{
+		reqArgs.Method = http.MethodPut
+		reqArgs.Base = args.BaseParams.URL
+		reqArgs.Path = apc.URLPathObjects.Join(args.Bck.Name, args.ObjName)
+		reqArgs.Query = args.Bck.NewQuery()
+		reqArgs.BodyR = args.Reader
+	}
+	resp, err := DoWithRetry(args.BaseParams.Client, args.patch, reqArgs) //nolint:bodyclose // is closed inside
+	cmn.FreeHra(reqArgs)
+	if err == nil {
+		oah.wrespHeader = resp.Header
+	}
+	return oah, err
 }
 
 // FlushObject must be called after all the appends (via `api.AppendObject`).
@@ -554,6 +773,28 @@ func RenameObject(bp BaseParams, bck cmn.Bck, oldName, newName string) error {
 	return err
 }
 
+// LinkObject creates link (alias) object `objName` that points to object `toName` in bucket `toBck`
+// (empty `toBck` - same bucket; empty `toName` - same name); GET via the link returns the object it points to
+// (see also cmn.LinkObjMD)
+func LinkObject(bp BaseParams, bck cmn.Bck, objName string, toBck cmn.Bck, toName string) error {
+	q := bck.NewQuery()
+	if !toBck.IsEmpty() {
+		q = toBck.AddUnameToQuery(q, apc.QparamBckTo)
+	}
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActLinkObj, Name: toName})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = q
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
 // promote files and directories to ais objects
 func Promote(bp BaseParams, bck cmn.Bck, args *apc.PromoteArgs) (xid string, err error) {
 	actMsg := apc.ActMsg{Action: apc.ActPromote, Name: args.SrcFQN, Value: args}
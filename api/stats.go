@@ -7,8 +7,11 @@ package api
 import (
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/stats"
@@ -33,13 +36,47 @@ import (
 // cluster ----------------------
 //
 
+type (
+	// filtered and/or paginated cluster stats, to reduce the amount of data per scrape
+	// (see apc.QparamNodes and subsequent query parameters)
+	ClusterStatsArgs struct {
+		Nodes     []string // node IDs (default: all nodes)
+		Metrics   []string // metric names; "name*" selects all metrics that have the given prefix
+		Since     int64    // only metrics that changed since (Unix nanoseconds, e.g. stats.Node.Now from the previous call)
+		PageSize  int      // max number of targets per call (0: all)
+		PageToken string   // stats.Cluster.Next returned by the previous call
+	}
+)
+
 func GetClusterStats(bp BaseParams) (res stats.Cluster, err error) {
+	return GetClusterStatsFlt(bp, nil)
+}
+
+func GetClusterStatsFlt(bp BaseParams, args *ClusterStatsArgs) (res stats.Cluster, err error) {
+	q := url.Values{apc.QparamWhat: []string{apc.WhatNodeStats}}
+	if args != nil {
+		if len(args.Nodes) > 0 {
+			q.Set(apc.QparamNodes, strings.Join(args.Nodes, ","))
+		}
+		if len(args.Metrics) > 0 {
+			q.Set(apc.QparamMetrics, strings.Join(args.Metrics, ","))
+		}
+		if args.Since > 0 {
+			q.Set(apc.QparamSince, strconv.FormatInt(args.Since, 10))
+		}
+		if args.PageSize > 0 {
+			q.Set(apc.QparamPageSize, strconv.Itoa(args.PageSize))
+		}
+		if args.PageToken != "" {
+			q.Set(apc.QparamPageToken, args.PageToken)
+		}
+	}
 	bp.Method = http.MethodGet
 	reqParams := AllocRp()
 	{
 		reqParams.BaseParams = bp
 		reqParams.Path = apc.URLPathClu.S
-		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatNodeStats}}
+		reqParams.Query = q
 	}
 
 	var rawStats stats.ClusterRaw
@@ -50,6 +87,7 @@ func GetClusterStats(bp BaseParams) (res stats.Cluster, err error) {
 	}
 
 	res.Proxy = rawStats.Proxy
+	res.Next = rawStats.Next
 	res.Target = make(map[string]*stats.Node, len(rawStats.Target))
 	for tid := range rawStats.Target {
 		var ts stats.Node
@@ -60,6 +98,43 @@ func GetClusterStats(bp BaseParams) (res stats.Cluster, err error) {
 	return
 }
 
+// GetHeatmap returns cluster-wide (merged across all targets) per-prefix GET access
+// counters - coldest first; optionally, filtered by bucket
+// See also: stats/heatmap.go
+func GetHeatmap(bp BaseParams, bck *cmn.Bck) (stats.Heatmap, error) {
+	q := url.Values{apc.QparamWhat: []string{apc.WhatHeatmap}}
+	switch {
+	case bck == nil || bck.IsEmpty():
+	case bck.Provider == "":
+		q.Set(apc.QparamBucket, bck.Name) // any provider
+	default:
+		q.Set(apc.QparamBucket, bck.Cname(""))
+	}
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = q
+	}
+	var raw cos.JSONRawMsgs
+	_, err := reqParams.DoReqAny(&raw)
+	FreeRp(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	var out stats.Heatmap
+	for _, msg := range raw {
+		var hm stats.Heatmap
+		if err := jsoniter.Unmarshal(msg, &hm); err != nil {
+			return nil, err
+		}
+		out = out.Merge(hm)
+	}
+	out.Sort()
+	return out, nil
+}
+
 //
 // node ----------------------
 //
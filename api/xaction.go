@@ -104,6 +104,51 @@ func QueryXactionSnaps(bp BaseParams, args *xact.ArgsMsg) (xs xact.MultiSnap, er
 	return
 }
 
+// QueryXactionSnapsStream is the same as QueryXactionSnaps except that it calls back with
+// each target's xaction snapshots as soon as the latter arrive (see xact.TargetSnaps);
+// target that fails to respond is reported via xact.TargetSnaps.Err (and does not fail the call)
+func QueryXactionSnapsStream(bp BaseParams, args *xact.ArgsMsg, cb func(*xact.TargetSnaps) error) error {
+	msg := xact.QueryMsg{ID: args.ID, Kind: args.Kind, Bck: args.Bck}
+	if args.OnlyRunning {
+		msg.OnlyRunning = apc.Ptr(true)
+	}
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatQueryXactStats}}
+	}
+	err := doJlines(reqParams, cb)
+	FreeRp(reqParams)
+	return err
+}
+
+// GetXactionHistory returns finished xactions - from each target's bounded and persistent
+// history - optionally, filtered by bucket (source, destination, or the bucket itself)
+func GetXactionHistory(bp BaseParams, bck *cmn.Bck) (xs xact.MultiSnap, err error) {
+	q := url.Values{apc.QparamWhat: []string{apc.WhatXactHistory}}
+	switch {
+	case bck == nil || bck.IsEmpty():
+	case bck.Provider == "":
+		q.Set(apc.QparamBucket, bck.Name) // any provider
+	default:
+		q.Set(apc.QparamBucket, bck.Cname(""))
+	}
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = q
+	}
+	_, err = reqParams.DoReqAny(&xs)
+	FreeRp(reqParams)
+	return
+}
+
 // GetOneXactionStatus queries one of the IC (proxy) members for status
 // of the `args`-identified xaction.
 // NOTE:
@@ -11,6 +11,7 @@ import (
 	"testing"
 	"unsafe"
 
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/OneOfOne/xxhash"
 	"github.com/minio/highwayhash"
 )
@@ -22,6 +23,7 @@ import (
 // go test -bench=Through -benchtime=30s -benchmem
 //
 // go test -v -tags=debug -bench=ID -benchtime=10s
+// go test -bench=Checksums -benchtime=10s
 
 const (
 	numThr = 64 * 1024 * 1024
@@ -137,6 +139,24 @@ func BenchmarkThroughput(b *testing.B) {
 	}
 }
 
+// all supported (object) checksums, as per cos.CksumHash
+func BenchmarkChecksums(b *testing.B) {
+	for i := range vec {
+		vec[i] = make([]byte, numThr)
+		cryptorand.Read(vec[i])
+	}
+	for _, ty := range cos.SupportedChecksums() {
+		if ty == cos.ChecksumNone {
+			continue
+		}
+		for _, size := range []int64{cos.MiB, 8 * cos.MiB} {
+			b.Run(ty+"-"+cos.ToSizeIEC(size, 0), func(b *testing.B) {
+				throughput(b, size, func() (hash.Hash, error) { return cos.NewCksumHash(ty).H, nil })
+			})
+		}
+	}
+}
+
 func throughput(b *testing.B, size int64, newHash func() (hash.Hash, error)) {
 	b.SetBytes(size)
 	b.ResetTimer()
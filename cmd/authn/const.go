@@ -17,9 +17,11 @@ const (
 	rolesCollection    = "role"
 	revokedCollection  = "revoked"
 	clustersCollection = "cluster"
+	apiKeysCollection  = "apikey"
 
 	adminUserID   = "admin"
 	adminUserPass = "admin"
 
 	foreverTokenTime = 24 * 365 * 20 * time.Hour // kind of never-expired token
+	apiKeyExpireTime = 90 * 24 * time.Hour       // API key default expiration
 )
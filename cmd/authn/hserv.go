@@ -5,6 +5,7 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
@@ -132,9 +133,13 @@ func (h *hserv) userHandler(w http.ResponseWriter, r *http.Request) {
 func (h *hserv) tokenHandler(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
 	case http.MethodDelete:
-		h.httpRevokeToken(w, r)
+		h.httpTokenDel(w, r)
+	case http.MethodPost:
+		h.httpAPIKeyPost(w, r)
+	case http.MethodGet:
+		h.httpAPIKeyGet(w, r)
 	default:
-		cmn.WriteErr405(w, r, http.MethodDelete)
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodPost)
 	}
 }
 
@@ -153,11 +158,32 @@ func (h *hserv) clusterHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// Deletes existing token, h.k.h log out
-func (h *hserv) httpRevokeToken(w http.ResponseWriter, r *http.Request) {
-	if _, err := parseURL(w, r, 0, apc.URLPathTokens.L); err != nil {
+// DELETE /v1/tokens/<api-key-ID> - revoke API key;
+// DELETE /v1/tokens (with authn.TokenMsg) - revoke token
+func (h *hserv) httpTokenDel(w http.ResponseWriter, r *http.Request) {
+	apiItems, err := parseURL(w, r, 0, apc.URLPathTokens.L)
+	if err != nil {
+		return
+	}
+	if len(apiItems) == 0 {
+		h.httpRevokeToken(w, r)
 		return
 	}
+	caller, err := h.validateToken(w, r)
+	if err != nil {
+		return
+	}
+	if err := h.mgr.revokeAPIKey(caller, apiItems[0]); err != nil {
+		cmn.WriteErr(w, r, err)
+		return
+	}
+	if Conf.Verbose() {
+		nlog.Infof("Revoked API key %q (%s)", apiItems[0], caller)
+	}
+}
+
+// Deletes existing token, h.k.h log out
+func (h *hserv) httpRevokeToken(w http.ResponseWriter, r *http.Request) {
 	msg := &authn.TokenMsg{}
 	if err := cmn.ReadJSON(w, r, msg); err != nil {
 		return
@@ -174,6 +200,49 @@ func (h *hserv) httpRevokeToken(w http.ResponseWriter, r *http.Request) {
 	h.mgr.revokeToken(msg.Token)
 }
 
+func (h *hserv) httpAPIKeyPost(w http.ResponseWriter, r *http.Request) {
+	if _, err := parseURL(w, r, 0, apc.URLPathTokens.L); err != nil {
+		return
+	}
+	caller, err := h.validateToken(w, r)
+	if err != nil {
+		return
+	}
+	msg := &authn.APIKeyMsg{}
+	if err := cmn.ReadJSON(w, r, msg); err != nil {
+		return
+	}
+	key, err := h.mgr.addAPIKey(caller, msg)
+	if err != nil {
+		status := http.StatusForbidden
+		if errors.Is(err, errAPIKeyExpiration) {
+			status = http.StatusBadRequest
+		}
+		cmn.WriteErr(w, r, err, status)
+		return
+	}
+	if Conf.Verbose() {
+		nlog.Infof("Issued API key %q[%s] (%s)", key.Name, key.ID, caller)
+	}
+	writeJSON(w, key, "issue API key")
+}
+
+func (h *hserv) httpAPIKeyGet(w http.ResponseWriter, r *http.Request) {
+	if _, err := parseURL(w, r, 0, apc.URLPathTokens.L); err != nil {
+		return
+	}
+	caller, err := h.validateToken(w, r)
+	if err != nil {
+		return
+	}
+	keys, err := h.mgr.apiKeyList(caller)
+	if err != nil {
+		cmn.WriteErr(w, r, err)
+		return
+	}
+	writeJSON(w, keys, "list API keys")
+}
+
 func (h *hserv) httpUserDel(w http.ResponseWriter, r *http.Request) {
 	apiItems, err := parseURL(w, r, 1, apc.URLPathUsers.L)
 	if err != nil {
@@ -281,28 +350,50 @@ func (h *hserv) httpUserGet(w http.ResponseWriter, r *http.Request) {
 // Checks if the request header contains valid admin credentials.
 // (admin is created at deployment time and cannot be modified via API)
 func validateAdminPerms(w http.ResponseWriter, r *http.Request) error {
-	token, err := tok.ExtractToken(r.Header)
+	tk, _, err := decryptToken(w, r)
 	if err != nil {
+		return err
+	}
+	if !tk.IsAdmin {
+		err := fmt.Errorf("not authorized: requires admin (%s)", tk)
 		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
 		return err
 	}
+	return nil
+}
+
+// Checks if the request header contains valid (and not revoked) credentials - any user
+func (h *hserv) validateToken(w http.ResponseWriter, r *http.Request) (*tok.Token, error) {
+	tk, token, err := decryptToken(w, r)
+	if err != nil {
+		return nil, err
+	}
+	if h.mgr.isRevoked(token) {
+		err := fmt.Errorf("%w: %s", tok.ErrTokenRevoked, tk)
+		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
+		return nil, err
+	}
+	return tk, nil
+}
+
+func decryptToken(w http.ResponseWriter, r *http.Request) (*tok.Token, string, error) {
+	token, err := tok.ExtractToken(r.Header)
+	if err != nil {
+		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
+		return nil, "", err
+	}
 	secret := Conf.Secret()
 	tk, err := tok.DecryptToken(token, secret)
 	if err != nil {
 		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
-		return err
+		return nil, "", err
 	}
 	if tk.Expires.Before(time.Now()) {
 		err := fmt.Errorf("not authorized: %s", tk)
 		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
-		return err
-	}
-	if !tk.IsAdmin {
-		err := fmt.Errorf("not authorized: requires admin (%s)", tk)
-		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
-		return err
+		return nil, "", err
 	}
-	return nil
+	return tk, token, nil
 }
 
 // Generate h token for h user if provided credentials are valid.
@@ -332,8 +423,18 @@ func (h *hserv) userLogin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	repl := fmt.Sprintf(`{"token": %q}`, token)
-	writeBytes(w, cos.UnsafeB(repl), "login")
+	if !msg.S3 {
+		repl := fmt.Sprintf(`{"token": %q}`, token)
+		writeBytes(w, cos.UnsafeB(repl), "login")
+		return
+	}
+	// new S3 key pair upon each login (key rotation)
+	akid := tok.NewS3AccessKeyID()
+	writeJSON(w, &authn.TokenMsg{
+		Token:         token,
+		S3AccessKeyID: akid,
+		S3SecretKey:   tok.S3SecretKey(akid, userID, Conf.Secret()),
+	}, "login")
 }
 
 func writeJSON(w http.ResponseWriter, val any, tag string) {
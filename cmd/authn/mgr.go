@@ -5,6 +5,7 @@
 package main
 
 import (
+	"crypto/sha256"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -25,14 +26,22 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
-type mgr struct {
-	clientH   *http.Client
-	clientTLS *http.Client
-	db        kvdb.Driver
-}
+type (
+	mgr struct {
+		clientH   *http.Client
+		clientTLS *http.Client
+		db        kvdb.Driver
+	}
+	// API key as stored in the DB: the token itself is never stored - only its hash
+	apiKeyRec struct {
+		authn.APIKey
+		TokenHash string `json:"token_hash"`
+	}
+)
 
 var (
 	errInvalidCredentials = errors.New("invalid credentials")
+	errAPIKeyExpiration   = errors.New("API key expiration must be positive")
 
 	predefinedRoles = []struct {
 		prefix string
@@ -463,6 +472,139 @@ func (m *mgr) generateRevokedTokenList() ([]string, error) {
 	return revokeList, nil
 }
 
+func (m *mgr) isRevoked(token string) bool {
+	_, err := m.db.GetString(revokedCollection, token)
+	return err == nil
+}
+
+//
+// API keys ============================================================
+//
+
+// Issues a new API key on behalf of the caller (session token). The key's scope
+// must be a subset of the caller's own permissions; API keys cannot issue API keys.
+func (m *mgr) addAPIKey(caller *tok.Token, msg *authn.APIKeyMsg) (*authn.APIKey, error) {
+	if caller.APIKeyID != "" {
+		return nil, fmt.Errorf("%s: API keys cannot be used to issue API keys", caller)
+	}
+	if msg.Name == "" {
+		return nil, errors.New("API key name is undefined")
+	}
+	if len(msg.ClusterACLs) == 0 && len(msg.BucketACLs) == 0 {
+		return nil, fmt.Errorf("API key %q: empty scope (expecting cluster and/or bucket permissions)", msg.Name)
+	}
+	m.fixClusterIDs(msg.ClusterACLs)
+	if err := checkScope(caller, msg); err != nil {
+		return nil, err
+	}
+
+	expDelta := apiKeyExpireTime
+	if msg.ExpiresIn != nil {
+		expDelta = *msg.ExpiresIn
+	}
+	if expDelta <= 0 {
+		return nil, fmt.Errorf("%w: API key %q, expires in %v", errAPIKeyExpiration, msg.Name, expDelta)
+	}
+	now := time.Now()
+	rec := &apiKeyRec{
+		APIKey: authn.APIKey{
+			ID:      cos.GenUUID(),
+			Name:    msg.Name,
+			UserID:  caller.UserID,
+			Created: now,
+			// (second precision, UTC - the token must be reproducible from the stored record)
+			Expires: now.Add(expDelta).UTC().Truncate(time.Second),
+		},
+	}
+	if len(msg.ClusterACLs) > 0 {
+		rec.ClusterACLs = msg.ClusterACLs
+	}
+	if len(msg.BucketACLs) > 0 {
+		rec.BucketACLs = msg.BucketACLs
+	}
+	token, err := apiKeyToken(&rec.APIKey)
+	if err != nil {
+		return nil, err
+	}
+	rec.TokenHash = hashToken(token)
+	if err := m.db.Set(apiKeysCollection, rec.ID, rec); err != nil {
+		return nil, err
+	}
+	key := rec.APIKey
+	key.Token = token
+	return &key, nil
+}
+
+func apiKeyToken(key *authn.APIKey) (string, error) {
+	return tok.APIKeyJWT(key.Expires, key.UserID, key.ID, key.BucketACLs, key.ClusterACLs, Conf.Secret())
+}
+
+func hashToken(token string) string {
+	b := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(b[:])
+}
+
+func checkScope(caller *tok.Token, msg *authn.APIKeyMsg) error {
+	for _, clu := range msg.ClusterACLs {
+		// (empty bucket: cluster-wide permissions to access all buckets)
+		if err := caller.CheckPermissions(clu.ID, &cmn.Bck{}, clu.Access); err != nil {
+			return fmt.Errorf("API key %q: cluster %s: %w", msg.Name, clu, err)
+		}
+	}
+	for _, b := range msg.BucketACLs {
+		bck := cmn.Bck{Name: b.Bck.Name, Provider: b.Bck.Provider}
+		if err := caller.CheckPermissions(b.Bck.Ns.UUID, &bck, b.Access); err != nil {
+			return fmt.Errorf("API key %q: bucket %s: %w", msg.Name, b.Bck.String(), err)
+		}
+	}
+	return nil
+}
+
+// Returns the caller's API keys or, for admin, all API keys (without tokens)
+func (m *mgr) apiKeyList(caller *tok.Token) ([]*authn.APIKey, error) {
+	recs, err := m.db.GetAll(apiKeysCollection, "")
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]*authn.APIKey, 0, len(recs))
+	for _, s := range recs {
+		key := &authn.APIKey{}
+		if err := jsoniter.Unmarshal([]byte(s), key); err != nil {
+			return nil, err
+		}
+		if !caller.IsAdmin && key.UserID != caller.UserID {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// Revokes API key (the caller must be the key's owner or admin)
+func (m *mgr) revokeAPIKey(caller *tok.Token, keyID string) error {
+	rec := &apiKeyRec{}
+	if err := m.db.Get(apiKeysCollection, keyID, rec); err != nil {
+		return cos.NewErrNotFound(m, "API key "+keyID)
+	}
+	if !caller.IsAdmin && rec.UserID != caller.UserID {
+		return cos.NewErrNotFound(m, "API key "+keyID) // (not revealing other users' keys)
+	}
+	// reproduce the token to broadcast its revocation
+	token, err := apiKeyToken(&rec.APIKey)
+	if err != nil {
+		return err
+	}
+	if hashToken(token) == rec.TokenHash {
+		if err := m.revokeToken(token); err != nil {
+			return err
+		}
+	} else {
+		// e.g., the secret has changed - the key's token is no longer valid anyway
+		nlog.Warningf("API key %s[%s]: token mismatch, removing the key without broadcasting", rec.Name, keyID)
+	}
+	return m.db.Delete(apiKeysCollection, keyID)
+}
+
 //
 // private helpers ============================================================
 //
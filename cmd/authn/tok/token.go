@@ -6,6 +6,9 @@
 package tok
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"net/http"
@@ -25,9 +28,16 @@ type Token struct {
 	Token       string          `json:"token"`
 	ClusterACLs []*authn.CluACL `json:"clusters"`
 	BucketACLs  []*authn.BckACL `json:"buckets,omitempty"`
+	APIKeyID    string          `json:"apikey,omitempty"` // (API keys only)
 	IsAdmin     bool            `json:"admin"`
 }
 
+const (
+	s3AccessKeyPrefix = "AIS"
+	s3AccessKeyLen    = 20
+	s3SecretKeyLen    = 40
+)
+
 var (
 	ErrNoPermissions = errors.New("insufficient permissions")
 	ErrInvalidToken  = errors.New("invalid token")
@@ -59,6 +69,19 @@ func JWT(expires time.Time, userID string, bucketACLs []*authn.BckACL, clusterAC
 	return t.SignedString([]byte(secret))
 }
 
+// same as JWT, with the API key's ID (see authn.APIKey)
+func APIKeyJWT(expires time.Time, userID, keyID string, bucketACLs []*authn.BckACL, clusterACLs []*authn.CluACL,
+	secret string) (string, error) {
+	t := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"expires":  expires,
+		"username": userID,
+		"buckets":  bucketACLs,
+		"clusters": clusterACLs,
+		"apikey":   keyID,
+	})
+	return t.SignedString([]byte(secret))
+}
+
 // Header format: 'Authorization: Bearer <token>'
 func ExtractToken(hdr http.Header) (string, error) {
 	s := hdr.Get(apc.HdrAuthorization)
@@ -98,6 +121,9 @@ func DecryptToken(tokenStr, secret string) (*Token, error) {
 ///////////
 
 func (tk *Token) String() string {
+	if tk.APIKeyID != "" {
+		return fmt.Sprintf("user %s, API key %s, %s", tk.UserID, tk.APIKeyID, expiresIn(tk.Expires))
+	}
 	return fmt.Sprintf("user %s, %s", tk.UserID, expiresIn(tk.Expires))
 }
 
@@ -111,9 +137,10 @@ func (tk *Token) String() string {
 //
 // ACL rules are checked in the following order (from highest to the lowest priority):
 //  1. A user's role is an admin.
-//  2. User's permissions for the given bucket
-//  3. User's permissions for the given cluster
-//  4. User's default cluster permissions (ACL for a cluster with empty clusterID)
+//  2. User is a bucket admin (apc.AceBckAdmin) for the given bucket - all bucket-scope permissions
+//  3. User's permissions for the given bucket
+//  4. User's permissions for the given cluster
+//  5. User's default cluster permissions (ACL for a cluster with empty clusterID)
 //
 // If there are no defined ACL found at any step, any access is denied.
 func (tk *Token) CheckPermissions(clusterID string, bck *cmn.Bck, perms apc.AccessAttrs) error {
@@ -149,7 +176,7 @@ func (tk *Token) CheckPermissions(clusterID string, bck *cmn.Bck, perms apc.Acce
 	}
 	bckACL, bckOk := tk.aclForBucket(clusterID, bck)
 	if bckOk {
-		if bckACL.Has(objPerms) {
+		if bckACL.Has(apc.AceBckAdmin) || bckACL.Has(objPerms) {
 			return nil
 		}
 		return fmt.Errorf("%v: [%s, bucket %s, granted(%s)]",
@@ -161,6 +188,23 @@ func (tk *Token) CheckPermissions(clusterID string, bck *cmn.Bck, perms apc.Acce
 	return nil
 }
 
+// IsBckAdmin returns true if the token grants bucket-scoped admin
+// (apc.AceBckAdmin) for the given bucket - either explicitly via the
+// bucket's ACL or, otherwise, via the cluster-wide one.
+func (tk *Token) IsBckAdmin(clusterID string, bck *cmn.Bck) bool {
+	if tk.IsAdmin {
+		return true
+	}
+	if bck == nil {
+		return false
+	}
+	if bckACL, ok := tk.aclForBucket(clusterID, bck); ok {
+		return bckACL.Has(apc.AceBckAdmin)
+	}
+	cluACL, ok := tk.aclForCluster(clusterID)
+	return ok && cluACL.Has(apc.AceBckAdmin)
+}
+
 //
 // private
 //
@@ -207,3 +251,23 @@ func (tk *Token) aclForBucket(clusterID string, bck *cmn.Bck) (perms apc.AccessA
 	}
 	return 0, false
 }
+
+//
+// S3 credentials
+//
+
+// NewS3AccessKeyID returns a new (random, AWS-like) access key ID.
+func NewS3AccessKeyID() string {
+	return s3AccessKeyPrefix + strings.ToUpper(cos.CryptoRandS(s3AccessKeyLen-len(s3AccessKeyPrefix)))
+}
+
+// S3SecretKey derives the secret access key that goes with a given access key ID and user.
+// The derivation is keyed by the AuthN secret, which is how AIS gateways (and targets)
+// validate S3 SigV4 signatures without storing the keys. The user's token travels
+// with each request as the S3 session token (`X-Amz-Security-Token`), so that
+// the credentials are effectively rotated (and revoked) along with the token.
+func S3SecretKey(accessKeyID, userID, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("s3:" + userID + ":" + accessKeyID))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))[:s3SecretKeyLen]
+}
@@ -9,6 +9,7 @@ package main
 // NOTE go:build debug (above) =====================================
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/core/mock"
 	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact"
 )
 
 var (
@@ -637,3 +639,154 @@ func TestMergeBckACLS(t *testing.T) {
 		}
 	}
 }
+
+func TestBckAdminPermissions(t *testing.T) {
+	const cluID = "1234"
+	var (
+		bck   = newBck("bck", "ais", cluID)
+		other = newBck("other", "ais", cluID)
+		tk    = &tok.Token{
+			UserID:      "bck-admin",
+			ClusterACLs: []*authn.CluACL{{ID: cluID, Access: apc.AccessRO}},
+			BucketACLs:  []*authn.BckACL{{Bck: bck, Access: apc.AccessBckAdmin}},
+		}
+		lbck   = cmn.Bck{Name: bck.Name, Provider: bck.Provider}
+		lother = cmn.Bck{Name: other.Name, Provider: other.Provider}
+	)
+	// full control over its own bucket
+	for _, perms := range []apc.AccessAttrs{apc.AcePATCH, apc.AceBckSetACL, apc.AceBckAdmin, apc.AccessRW} {
+		tassert.CheckError(t, tk.CheckPermissions(cluID, &lbck, perms))
+	}
+	tassert.Fatalf(t, tk.IsBckAdmin(cluID, &lbck), "expecting bucket admin for %s", lbck.String())
+
+	// but not over the other buckets and not cluster-wide
+	tassert.Fatalf(t, tk.CheckPermissions(cluID, &lother, apc.AcePATCH) != nil, "expecting no PATCH for %s", lother.String())
+	tassert.Fatalf(t, tk.CheckPermissions(cluID, &lother, apc.AceBckAdmin) != nil, "expecting no admin for %s", lother.String())
+	tassert.Fatalf(t, !tk.IsBckAdmin(cluID, &lother), "unexpected bucket admin for %s", lother.String())
+	tassert.Fatalf(t, tk.CheckPermissions(cluID, nil, apc.AceAdmin) != nil, "unexpected cluster admin")
+}
+
+// bucket admin is an additive grant: regular read-write users keep running bucket-scope jobs
+func TestBckAdminAdditive(t *testing.T) {
+	const cluID = "1234"
+	var (
+		bck  = newBck("bck", "ais", cluID)
+		lbck = cmn.Bck{Name: bck.Name, Provider: bck.Provider}
+		rw   = []*tok.Token{
+			{UserID: "rw-cluster", ClusterACLs: []*authn.CluACL{{ID: cluID, Access: apc.AccessRW}}},
+			{
+				UserID:      "rw-bucket",
+				ClusterACLs: []*authn.CluACL{{ID: cluID, Access: apc.AccessRO}},
+				BucketACLs:  []*authn.BckACL{{Bck: bck, Access: apc.AccessRW}},
+			},
+		}
+		admin = &tok.Token{
+			UserID:      "bck-admin",
+			ClusterACLs: []*authn.CluACL{{ID: cluID, Access: apc.AccessRO}},
+			BucketACLs:  []*authn.BckACL{{Bck: bck, Access: apc.AccessBckAdmin}},
+		}
+	)
+	for _, action := range []string{apc.ActMakeNCopies, apc.ActECEncode} {
+		perms := xact.Table[action].Access
+		for _, tk := range rw {
+			tassert.CheckError(t, tk.CheckPermissions(cluID, &lbck, perms))
+			tassert.Fatalf(t, !tk.IsBckAdmin(cluID, &lbck), "unexpected bucket admin %s", tk.UserID)
+		}
+		tassert.CheckError(t, admin.CheckPermissions(cluID, &lbck, perms))
+	}
+}
+
+func TestXactControlPermissions(t *testing.T) {
+	const cluID = "1234"
+	var (
+		bck = newBck("bck", "ais", cluID)
+		tk  = &tok.Token{
+			UserID:      "operator",
+			ClusterACLs: []*authn.CluACL{{ID: cluID, Access: apc.AccessRO | apc.AccessXactControl}},
+			BucketACLs:  []*authn.BckACL{{Bck: bck, Access: apc.AccessBckAdmin}},
+		}
+		lbck = cmn.Bck{Name: bck.Name, Provider: bck.Provider}
+	)
+	access, err := apc.StrToAccess(apc.AllowXactControlAccess)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, access == apc.AccessXactControl, "expecting %s, got %s", apc.AccessXactControl.Describe(true), access.Describe(true))
+
+	// cluster-level
+	tassert.CheckError(t, tk.CheckPermissions(cluID, nil, apc.AceXactStart))
+	tassert.CheckError(t, tk.CheckPermissions(cluID, nil, apc.AceXactAbort))
+	tassert.Fatalf(t, tk.CheckPermissions(cluID, nil, apc.AceAdmin) != nil, "unexpected cluster admin")
+
+	// plus the xaction kind's own permissions on the bucket (e.g., ec-encode)
+	tassert.CheckError(t, tk.CheckPermissions(cluID, &lbck, apc.AccessRW|apc.AceBckAdmin))
+
+	// no xaction control without the respective permission
+	tk.ClusterACLs[0].Access = apc.AccessRO | apc.AceXactAbort
+	tassert.Fatalf(t, tk.CheckPermissions(cluID, nil, apc.AceXactStart) != nil, "unexpected %s", apc.AccessOp(apc.AceXactStart))
+}
+
+func TestAPIKey(t *testing.T) {
+	const cluID = "1234"
+	var (
+		bck    = newBck("bck", "ais", cluID)
+		other  = newBck("other", "ais", cluID)
+		caller = &tok.Token{
+			UserID:      "ci-owner",
+			Expires:     time.Now().Add(time.Hour),
+			ClusterACLs: []*authn.CluACL{{ID: cluID, Access: apc.AccessRO}},
+			BucketACLs:  []*authn.BckACL{{Bck: bck, Access: apc.AccessRW}},
+		}
+	)
+	driver := mock.NewDBDriver()
+	mgr, err := newMgr(driver)
+	tassert.CheckFatal(t, err)
+
+	// within the caller's scope
+	msg := &authn.APIKeyMsg{Name: "ci", BucketACLs: []*authn.BckACL{{Bck: bck, Access: apc.AccessRW}}}
+	key, err := mgr.addAPIKey(caller, msg)
+	tassert.CheckFatal(t, err)
+	tk, err := tok.DecryptToken(key.Token, Conf.Secret())
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, tk.APIKeyID == key.ID && tk.UserID == caller.UserID, "unexpected API key token %s", tk)
+	lbck := cmn.Bck{Name: bck.Name, Provider: bck.Provider}
+	tassert.CheckError(t, tk.CheckPermissions(cluID, &lbck, apc.AcePUT))
+
+	// exceeding the caller's scope
+	msg = &authn.APIKeyMsg{Name: "ci-other", BucketACLs: []*authn.BckACL{{Bck: other, Access: apc.AccessRW}}}
+	_, err = mgr.addAPIKey(caller, msg)
+	tassert.Fatalf(t, err != nil, "expecting error: API key scope exceeds caller's permissions")
+
+	// API keys cannot issue API keys
+	msg = &authn.APIKeyMsg{Name: "nested", BucketACLs: []*authn.BckACL{{Bck: bck, Access: apc.AccessRO}}}
+	_, err = mgr.addAPIKey(tk, msg)
+	tassert.Fatalf(t, err != nil, "expecting error: API key issued by API key")
+
+	// expiration must be positive
+	for _, d := range []time.Duration{0, -time.Hour} {
+		msg = &authn.APIKeyMsg{Name: "forever", BucketACLs: []*authn.BckACL{{Bck: bck, Access: apc.AccessRO}}, ExpiresIn: &d}
+		_, err = mgr.addAPIKey(caller, msg)
+		tassert.Fatalf(t, errors.Is(err, errAPIKeyExpiration), "expecting %v, got %v", errAPIKeyExpiration, err)
+	}
+
+	// the token is not stored - only its hash
+	rec := &apiKeyRec{}
+	tassert.CheckFatal(t, driver.Get(apiKeysCollection, key.ID, rec))
+	tassert.Fatalf(t, rec.Token == "", "API key token stored in plaintext")
+	tassert.Fatalf(t, rec.TokenHash == hashToken(key.Token), "unexpected API key token hash %q", rec.TokenHash)
+	token, err := apiKeyToken(&rec.APIKey)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, token == key.Token, "API key token must be reproducible from the stored record")
+
+	keys, err := mgr.apiKeyList(caller)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(keys) == 1 && keys[0].ID == key.ID && keys[0].Token == "", "unexpected API keys %+v", keys)
+	keys, err = mgr.apiKeyList(&tok.Token{UserID: "someone-else"})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(keys) == 0, "unexpected API keys %+v", keys)
+
+	// revoke
+	tassert.CheckFatal(t, mgr.revokeAPIKey(caller, key.ID))
+	tassert.Fatalf(t, mgr.isRevoked(key.Token), "expecting API key %s to be revoked", key.ID)
+	keys, err = mgr.apiKeyList(caller)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(keys) == 0, "unexpected API keys %+v", keys)
+}
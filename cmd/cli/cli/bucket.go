@@ -168,7 +168,15 @@ func _evictBck(c *cli.Context, bck cmn.Bck) (err error) {
 }
 
 func listOrSummBuckets(c *cli.Context, qbck cmn.QueryBcks, lsb lsbCtx) error {
-	bcks, err := api.ListBuckets(apiBP, qbck, lsb.fltPresence)
+	var (
+		bcks cmn.Bcks
+		err  error
+	)
+	if lsb.federated {
+		bcks, err = api.ListBucketsFederated(apiBP, qbck)
+	} else {
+		bcks, err = api.ListBuckets(apiBP, qbck, lsb.fltPresence)
+	}
 	if err != nil {
 		return V(err)
 	}
@@ -87,6 +87,7 @@ var (
 			continueOnErrorFlag,
 			forceFlag,
 			copyDryRunFlag,
+			copyMaxThroughputFlag,
 			copyPrependFlag,
 			progressFlag,
 			refreshFlag,
@@ -137,6 +138,8 @@ var (
 			noDirsFlag,
 			dontHeadRemoteFlag,
 			dontAddRemoteFlag,
+			federatedFlag,
+			lsDuFlag,
 			listArchFlag,
 			unitsFlag,
 			silentFlag,
@@ -585,6 +588,7 @@ type lsbCtx struct {
 	fltPresence     int
 	countRemoteObjs bool
 	all             bool
+	federated       bool
 }
 
 func listAnyHandler(c *cli.Context) error {
@@ -628,6 +632,7 @@ func listAnyHandler(c *cli.Context) error {
 			lsb.regex = regex
 		}
 		lsb.all = flagIsSet(c, allObjsOrBcksFlag)
+		lsb.federated = flagIsSet(c, federatedFlag)
 		lsb.fltPresence = apc.FltPresent
 		if lsb.all {
 			lsb.fltPresence = apc.FltExists
@@ -658,6 +663,9 @@ func listAnyHandler(c *cli.Context) error {
 		return listOrSummBuckets(c, cmn.QueryBcks(bck), lsb)
 	default: // list objects
 		prefix := parseStrFlag(c, listObjPrefixFlag)
+		if flagIsSet(c, lsDuFlag) {
+			return listObjectsSummary(c, bck, prefix)
+		}
 		listArch := flagIsSet(c, listArchFlag) // include archived content, if requested
 		return listObjects(c, bck, prefix, listArch)
 	}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/urfave/cli"
@@ -32,6 +33,7 @@ var (
 			transientFlag,
 		},
 		cmdShutdown: {
+			drainTimeoutFlag,
 			yesFlag,
 		},
 		cmdPrimary: {},
@@ -48,6 +50,7 @@ var (
 		},
 		cmdShutdown + ".node": {
 			noRebalanceFlag,
+			warmRestartFlag,
 			rmUserDataFlag,
 			yesFlag,
 		},
@@ -256,7 +259,11 @@ func clusterShutdownHandler(c *cli.Context) (err error) {
 			return nil
 		}
 	}
-	if err := api.ShutdownCluster(apiBP); err != nil {
+	var actValue *apc.ActValShutdown
+	if flagIsSet(c, drainTimeoutFlag) {
+		actValue = &apc.ActValShutdown{DrainTimeout: cos.Duration(parseDurationFlag(c, drainTimeoutFlag))}
+	}
+	if err := api.ShutdownCluster(apiBP, actValue); err != nil {
 		return V(err)
 	}
 	actionDone(c, "Cluster successfully shut down")
@@ -368,13 +375,18 @@ func nodeMaintShutDecommHandler(c *cli.Context) error {
 		noShutdown        = flagIsSet(c, noShutdownFlag)
 		rmUserData        = flagIsSet(c, rmUserDataFlag)
 		keepInitialConfig = flagIsSet(c, keepInitialConfigFlag)
+		warmRestart       = flagIsSet(c, warmRestartFlag)
 		actValue          = &apc.ActValRmNode{
 			DaemonID:      node.ID(),
 			SkipRebalance: skipRebalance,
 			NoShutdown:    noShutdown,
+			WarmRestart:   warmRestart,
 		}
 	)
-	if skipRebalance && node.IsTarget() {
+	if warmRestart && (action != cmdShutdown || !node.IsTarget()) {
+		return fmt.Errorf("option %s is valid only when shutting down a target", qflprn(warmRestartFlag))
+	}
+	if skipRebalance && node.IsTarget() && !warmRestart {
 		warn := fmt.Sprintf("executing %q _and_ not running global rebalance may lead to a loss of data!", action)
 		actionWarn(c, warn)
 		fmt.Fprintln(c.App.Writer,
@@ -514,6 +514,10 @@ func bpropsFilterExtra(c *cli.Context, tag string) bool {
 	switch c.Args().Get(0) {
 	case apc.S3Scheme, apc.AWS:
 		return strings.HasPrefix(tag, "extra.aws")
+	case apc.GSScheme, apc.GCP:
+		return strings.HasPrefix(tag, "extra.gcp")
+	case apc.AZScheme, apc.Azure:
+		return strings.HasPrefix(tag, "extra.azure")
 	case apc.HTTP:
 		return strings.HasPrefix(tag, "extra.http")
 	}
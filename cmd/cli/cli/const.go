@@ -611,6 +611,19 @@ var (
 		Value: dload.DownloadProgressInterval.String(),
 	}
 
+	dloadHeadersFlag = cli.StringFlag{
+		Name: "headers",
+		Usage: "JSON-formatted HTTP headers to add to each source request, e.g.:\n" +
+			indent4 + "\t'--headers {\"Authorization\": \"Bearer <token>\", \"Cookie\": \"session=abc\"}'",
+	}
+	dloadClientCertFlag = cli.StringFlag{
+		Name:  "client-cert",
+		Usage: "PEM-encoded client certificate file, to download from endpoints that require mutual TLS (see also: '--client-key')",
+	}
+	dloadClientKeyFlag = cli.StringFlag{
+		Name:  "client-key",
+		Usage: "PEM-encoded private key file that goes with '--client-cert'",
+	}
 	limitConnectionsFlag = cli.IntFlag{
 		Name:  "max-conns",
 		Usage: "max number of connections each target can make concurrently (up to num mountpaths)",
@@ -744,6 +757,20 @@ var (
 		Usage: "check whether a given named object is present in cluster\n" +
 			indent1 + "\t(applies only to buckets with remote backend)",
 	}
+	federatedFlag = cli.BoolFlag{
+		Name: "federated",
+		Usage: "global namespace view across all attached remote ais clusters:\n" +
+			indent1 + "\t- list buckets: this cluster's ais:// buckets and all buckets of all attached remote clusters;\n" +
+			indent1 + "\t- list objects: same-named ais:// bucket in this and all attached remote clusters (one cluster at a time),\n" +
+			indent1 + "\t  with each object's location prefixed by its origin cluster (\"@uuid\")",
+	}
+	lsDuFlag = cli.IntFlag{
+		Name: "du",
+		Usage: "instead of listing, show the number of in-cluster objects and their total size\n" +
+			indent1 + "\tper (virtual) subdirectory - the specified number of levels below the prefix, e.g.:\n" +
+			indent1 + "\t- 'ais ls ais://abc --du 0'\t- total count and size (akin to 'du -s');\n" +
+			indent1 + "\t- 'ais ls ais://abc --prefix a/b/ --du 1'\t- per subdirectory of 'a/b/'",
+	}
 	listObjCachedFlag = cli.BoolFlag{
 		Name:  "cached",
 		Usage: "list only in-cluster objects - only those objects from a remote bucket that are present (\"cached\")",
@@ -811,6 +838,19 @@ var (
 		Usage: "chunk size in IEC or SI units, or \"raw\" bytes (e.g.: 4mb, 1MiB, 1048576, 128k; see '--units')",
 	}
 
+	putStreamFlag = cli.BoolFlag{
+		Name: "stream",
+		Usage: "PUT standard input as a single stream of unknown length (chunked transfer encoding);\n" +
+			indent1 + "\tthe checksum is computed en route and sent in the HTTP trailer for the target to validate\n" +
+			indent1 + "\t(default: upload in chunks of '--chunk-size' and append them to the destination object)",
+	}
+
+	putTTLFlag = DurationFlag{
+		Name: "ttl",
+		Usage: "object's time-to-live (ais:// buckets only): upon expiration the object gets deleted, e.g. '--ttl 24h';\n" +
+			indent1 + "\tvalid time units: " + timeUnits,
+	}
+
 	blobThresholdFlag = cli.StringFlag{
 		Name: "blob-threshold",
 		Usage: "utilize built-in blob-downloader for remote objects greater than the specified (threshold) size\n" +
@@ -893,6 +933,16 @@ var (
 			indent4 + "\t\tand wdskey=subdir/aaa, aistore will match and return (subdir/aaa.jpg, subdir/aaa.json)",
 	}
 
+	// GET with server-side format conversion (apc.QparamConvert)
+	convertFlag = cli.StringFlag{
+		Name: "convert",
+		Usage: "convert object's content (or archived file - see '--archpath') on the fly, one of:\n" +
+			indent4 + "\t  * gunzip - decompress gzip-compressed (.gz) content;\n" +
+			indent4 + "\t  * jsonl2msgp - line-delimited JSON => msgpack;\n" +
+			indent4 + "\t  * msgp2jsonl - msgpack => line-delimited JSON\n" +
+			indent4 + "\texample: 'ais get ais://nnn/logs.json.gz /tmp/logs.json --convert gunzip'",
+	}
+
 	// client side
 	extractFlag = cli.BoolFlag{
 		Name:  "extract,x",
@@ -940,6 +990,12 @@ var (
 		Name:  "dry-run",
 		Usage: "show total size of new objects without really creating them",
 	}
+	copyMaxThroughputFlag = cli.StringFlag{
+		Name: "max-throughput",
+		Usage: "per-target bandwidth cap, in bytes per second, e.g.:\n" +
+			indent4 + "\t--max-throughput=100MiB\t- at most 100MiB/s per target (total cluster-wide throughput scales with the number of targets);\n" +
+			indent4 + "\tin combination with '--dry-run', estimate the time to copy",
+	}
 	copyPrependFlag = cli.StringFlag{
 		Name: "prepend",
 		Usage: "prefix to prepend to every copied object name, e.g.:\n" +
@@ -1007,6 +1063,11 @@ var (
 		Name:  "no-rebalance",
 		Usage: "do _not_ run global rebalance after putting node in maintenance (caution: advanced usage only!)",
 	}
+	warmRestartFlag = cli.BoolFlag{
+		Name: "warm-restart",
+		Usage: "the target is expected to restart shortly: delay global rebalance for up to\n" +
+			indent4 + "\tconfigured 'rebalance.restart_grace' and skip it altogether if the target returns intact",
+	}
 	mountpathLabelFlag = cli.StringFlag{
 		Name: "label",
 		Usage: "an optional _mountpath label_ to facilitate extended functionality and context, including:\n" +
@@ -1026,6 +1087,12 @@ var (
 		Name:  "no-shutdown",
 		Usage: "do not shutdown node upon decommissioning it from the cluster",
 	}
+	drainTimeoutFlag = DurationFlag{
+		Name: "drain-timeout",
+		Usage: "maximum time for each node to drain (ie., complete in-flight requests and jobs) prior to shutting down;\n" +
+			indent4 + "\tif omitted: use configured 'timeout.max_host_busy'; negative value: shut down without draining;\n" +
+			indent4 + "\tvalid time units: " + timeUnits,
+	}
 	rmUserDataFlag = cli.BoolFlag{
 		Name:  "rm-user-data",
 		Usage: "remove all user data when decommissioning node from the cluster",
@@ -52,6 +52,9 @@ func catHandler(c *cli.Context) error {
 		return err
 	}
 	a := qparamArch{archpath: parseStrFlag(c, archpathGetFlag)}
+	if err := validateConvert(c, &a, false); err != nil {
+		return err
+	}
 	return getObject(c, bck, objName, fileStdIO, a, true /*quiet*/, false /*extract*/)
 }
 
@@ -153,6 +156,9 @@ func getHandler(c *cli.Context) error {
 	if err := a.validate(c); err != nil {
 		return err
 	}
+	if err := validateConvert(c, &a, extract); err != nil {
+		return err
+	}
 
 	// GET multiple -- currently, only prefix (TODO: list/range)
 	if flagIsSet(c, getObjPrefixFlag) {
@@ -660,9 +666,34 @@ func (a *qparamArch) getQuery(c *cli.Context, bck *cmn.Bck) (q url.Values) {
 		f()
 		q.Set(apc.QparamLatestVer, "true")
 	}
+	if flagIsSet(c, convertFlag) {
+		f()
+		q.Set(apc.QparamConvert, parseStrFlag(c, convertFlag))
+	}
 	return q
 }
 
+// server-side format conversion (apc.QparamConvert)
+func validateConvert(c *cli.Context, a *qparamArch, extract bool) error {
+	if !flagIsSet(c, convertFlag) {
+		return nil
+	}
+	if err := apc.ValidateConvert(parseStrFlag(c, convertFlag)); err != nil {
+		return err
+	}
+	switch {
+	case flagIsSet(c, lengthFlag):
+		return fmt.Errorf(errFmtExclusive, qflprn(lengthFlag), qflprn(convertFlag))
+	case a.archregx != "" || a.archmode != "":
+		return fmt.Errorf(errFmtExclusive, qflprn(archregxFlag), qflprn(convertFlag))
+	case extract:
+		return fmt.Errorf(errFmtExclusive, extractVia, qflprn(convertFlag))
+	case flagIsSet(c, blobDownloadFlag):
+		return fmt.Errorf(errFmtExclusive, qflprn(blobDownloadFlag), qflprn(convertFlag))
+	}
+	return nil
+}
+
 //
 // post-GET local extraction
 //
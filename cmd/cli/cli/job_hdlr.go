@@ -73,6 +73,9 @@ var (
 			limitBytesPerHourFlag,
 			syncFlag,
 			unitsFlag,
+			dloadHeadersFlag,
+			dloadClientCertFlag,
+			dloadClientKeyFlag,
 		},
 		cmdDsort: {
 			dsortSpecFlag,
@@ -101,6 +104,10 @@ var (
 			forceFlag,
 			nonverboseFlag,
 		},
+		apc.ActCompactBck: append(
+			startCommonFlags,
+			forceFlag, // rewrite all objects
+		),
 	}
 
 	jobStartResilver = cli.Command{
@@ -288,6 +295,11 @@ outer:
 			Flags:  startCommonFlags,
 			Action: startXactionHandler,
 		}
+		if kind, _ := xact.GetKindName(xname); kind == apc.ActCompactBck {
+			cmd.Usage = "compact bucket: rewrite objects that occupy excessive disk space (all objects, with '--force'),\n" +
+				indent1 + "\tremove empty directories, and report reclaimed space per mountpath"
+			cmd.Flags = startSpecialFlags[kind]
+		}
 		if xact.IsSameScope(xname, xact.ScopeB) { // with a single arg: bucket
 			cmd.ArgsUsage = bucketArgument
 			cmd.BashComplete = bucketCompletions(bcmplop{})
@@ -339,7 +351,7 @@ func startXactionKind(c *cli.Context, xname string) (err error) {
 			return err
 		}
 	}
-	xargs := xact.ArgsMsg{Kind: xname, Bck: bck}
+	xargs := xact.ArgsMsg{Kind: xname, Bck: bck, Force: flagIsSet(c, forceFlag)}
 	return startXaction(c, &xargs, extra)
 }
 
@@ -444,6 +456,23 @@ func startDownloadHandler(c *cli.Context) error {
 			BytesPerHour: int(limitBPH),
 		},
 	}
+	if flagIsSet(c, dloadHeadersFlag) {
+		hdrs := parseStrFlag(c, dloadHeadersFlag)
+		if err := jsoniter.Unmarshal([]byte(hdrs), &basePayload.Headers); err != nil {
+			return fmt.Errorf("invalid %s %q (expecting JSON-formatted name-value pairs): %v", qflprn(dloadHeadersFlag), hdrs, err)
+		}
+	}
+	if flagIsSet(c, dloadClientCertFlag) || flagIsSet(c, dloadClientKeyFlag) {
+		cert, err := os.ReadFile(parseStrFlag(c, dloadClientCertFlag))
+		if err != nil {
+			return err
+		}
+		key, err := os.ReadFile(parseStrFlag(c, dloadClientKeyFlag))
+		if err != nil {
+			return err
+		}
+		basePayload.ClientCert, basePayload.ClientKey = string(cert), string(key)
+	}
 
 	if basePayload.Bck.Props, err = api.HeadBucket(apiBP, basePayload.Bck, true /* don't add */); err != nil {
 		if !cmn.IsStatusNotFound(err) {
@@ -10,6 +10,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
@@ -245,6 +246,44 @@ func listBckTableWithSummary(c *cli.Context, qbck cmn.QueryBcks, bcks cmn.Bcks,
 	return footer.nb
 }
 
+// (implemented over Go text/tabwriter directly w/ no templates)
+func listObjectsSummary(c *cli.Context, bck cmn.Bck, prefix string) error {
+	depth := parseIntFlag(c, lsDuFlag)
+	if depth < 0 {
+		return fmt.Errorf("invalid %s=%d (expecting non-negative integer)", qflprn(lsDuFlag), depth)
+	}
+	units, err := parseUnitsFlag(c, unitsFlag)
+	if err != nil {
+		return err
+	}
+	summs, err := api.ListObjectsSummary(apiBP, bck, prefix, depth)
+	if err != nil {
+		return V(err)
+	}
+	var (
+		cnt, size int64
+		tw        = &tabwriter.Writer{}
+	)
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "PREFIX\tOBJECTS\tSIZE")
+	}
+	for _, e := range summs {
+		p := e.Prefix
+		if p == "" {
+			p = bck.Cname("")
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%s\n", p, e.Count, teb.FmtSize(e.Size, units, 2))
+		cnt += e.Count
+		size += e.Size
+	}
+	tw.Flush()
+	if len(summs) > 1 && !flagIsSet(c, noFooterFlag) {
+		fmt.Fprintln(c.App.Writer, fcyan(fmt.Sprintf("Total: [objects %d, size %s] ========", cnt, teb.FmtSize(size, units, 2))))
+	}
+	return nil
+}
+
 func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch bool) error {
 	// prefix and filter
 	lstFilter, prefixFromTemplate, err := newLstFilter(c)
@@ -363,6 +402,11 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch bool) erro
 		// (due to mirroring, EC). The status helps to tell an object from its replica(s).
 		msg.AddProps(apc.GetPropsStatus)
 	}
+	if flagIsSet(c, federatedFlag) {
+		// origin cluster (see apc.LsFederated)
+		msg.SetFlag(apc.LsFederated)
+		msg.AddProps(apc.GetPropsLocation)
+	}
 	propsStr = msg.Props // show these and _only_ these props
 	// finally:
 	if flagIsSet(c, verChangedFlag) {
@@ -50,6 +50,7 @@ var (
 			archmimeFlag,
 			archregxFlag,
 			archmodeFlag,
+			convertFlag,
 			// archive, client side
 			extractFlag,
 			// bucket inventory
@@ -70,6 +71,8 @@ var (
 		commandPut: append(
 			listRangeProgressWaitFlags,
 			chunkSizeFlag,
+			putStreamFlag,
+			putTTLFlag,
 			concurrencyFlag,
 			dryRunFlag,
 			recursFlag,
@@ -103,6 +106,7 @@ var (
 			offsetFlag,
 			lengthFlag,
 			archpathGetFlag,
+			convertFlag,
 			cksumFlag,
 			forceFlag,
 		},
@@ -389,6 +393,9 @@ func warnMultiSrcDstPrefix(c *cli.Context, a *putargs, from string) bool {
 }
 
 func putStdin(c *cli.Context, a *putargs) error {
+	if flagIsSet(c, putStreamFlag) {
+		return putStdinStream(c, a)
+	}
 	chunkSize, err := parseSizeFlag(c, chunkSizeFlag)
 	if err != nil {
 		return err
@@ -414,6 +421,36 @@ func putStdin(c *cli.Context, a *putargs) error {
 	return nil
 }
 
+// single chunked PUT with checksum in the HTTP trailer (see api.PutObjectStream)
+func putStdinStream(c *cli.Context, a *putargs) error {
+	if flagIsSet(c, chunkSizeFlag) {
+		return incorrectUsageMsg(c, "%s and %s are mutually exclusive", qflprn(putStreamFlag), qflprn(chunkSizeFlag))
+	}
+	cksum, err := cksumToCompute(c, a.dst.bck)
+	if err != nil {
+		return err
+	}
+	var ckty string // (api default)
+	if cksum != nil && cksum.Type() != cos.ChecksumNone {
+		ckty = cksum.Type()
+	}
+	if flagIsSet(c, verboseFlag) {
+		actionWarn(c, "To terminate input, press Ctrl-D two or more times")
+	}
+	args := api.PutStreamArgs{
+		Reader:     os.Stdin,
+		BaseParams: apiBP,
+		Bck:        a.dst.bck,
+		ObjName:    a.dst.oname,
+		CksumType:  ckty,
+	}
+	if _, err := api.PutObjectStream(&args); err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("PUT (standard input) => %s\n", a.dst.bck.Cname(a.dst.oname)))
+	return nil
+}
+
 func concatHandler(c *cli.Context) (err error) {
 	var (
 		bck     cmn.Bck
@@ -158,6 +158,13 @@ func _iniCopyBckMsg(c *cli.Context, msg *apc.CopyBckMsg) (err error) {
 		msg.LatestVer = flagIsSet(c, latestVerFlag)
 		msg.Sync = flagIsSet(c, syncFlag)
 	}
+	if flagIsSet(c, copyMaxThroughputFlag) {
+		bps, err := parseSizeFlag(c, copyMaxThroughputFlag)
+		if err != nil {
+			return err
+		}
+		msg.MaxThroughput = cos.SizeIEC(bps)
+	}
 	if msg.Sync && msg.Prepend != "" {
 		err = fmt.Errorf("prepend option (%q) is incompatible with %s (the latter requires identical source/destination naming)",
 			msg.Prepend, qflprn(progressFlag))
@@ -213,6 +220,10 @@ func copyBucket(c *cli.Context, bckFrom, bckTo cmn.Bck) error {
 		}
 	}
 
+	if msg.DryRun {
+		return dryRunCopy(c, xid, kind, &msg)
+	}
+
 	if !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
 		/// TODO: unify vs e2e: ("%s[%s] %s => %s", kind, xid, from, to)
 		if flagIsSet(c, nonverboseFlag) {
@@ -238,6 +249,38 @@ func copyBucket(c *cli.Context, bckFrom, bckTo cmn.Bck) error {
 	return nil
 }
 
+// [DRY-RUN] wait for the (no-op) job to visit all source objects and show the totals
+// and, given max-throughput, the estimated (lower-bound) time to copy
+func dryRunCopy(c *cli.Context, xid, kind string, msg *apc.CopyBckMsg) error {
+	xargs := xact.ArgsMsg{ID: xid, Kind: kind}
+	if err := waitXact(&xargs); err != nil {
+		return err
+	}
+	snaps, err := api.QueryXactionSnaps(apiBP, &xargs)
+	if err != nil {
+		return V(err)
+	}
+	locObjs, outObjs, _ := snaps.ObjCounts(xid)
+	locBytes, outBytes, _ := snaps.ByteCounts(xid)
+	fmt.Fprintf(c.App.Writer, "Objects to copy:\t %d (%s)\n", locObjs+outObjs,
+		cos.ToSizeIEC(locBytes+outBytes, 2))
+	if msg.MaxThroughput <= 0 {
+		return nil
+	}
+	// the slowest (ie., the one with the most bytes to copy) target defines the total
+	var maxBytes int64
+	for _, tsnaps := range snaps {
+		for _, xsnap := range tsnaps {
+			if xsnap.ID == xid {
+				maxBytes = max(maxBytes, xsnap.Stats.Bytes+xsnap.Stats.OutBytes)
+			}
+		}
+	}
+	est := time.Duration(float64(maxBytes) / float64(msg.MaxThroughput) * float64(time.Second))
+	fmt.Fprintf(c.App.Writer, "Estimated time:\t %v (at %s/s per target)\n", est.Round(time.Second), msg.MaxThroughput)
+	return nil
+}
+
 func tcbtcoCptn(action string, bckFrom, bckTo cmn.Bck) string {
 	from, to := bckFrom.Cname(""), bckTo.Cname("")
 	if bckFrom.Equal(&bckTo) {
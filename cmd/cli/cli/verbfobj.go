@@ -38,6 +38,7 @@ type (
 		cksum     *cos.Cksum
 		cptn      string
 		totalSize int64
+		ttl       time.Duration
 		dryRun    bool
 	}
 	uctx struct {
@@ -111,6 +112,7 @@ func verbFobjs(c *cli.Context, wop wop, fobjs []fobj, bck cmn.Bck, ndir int, rec
 		cksum:     cksum,
 		cptn:      cptn,
 		totalSize: totalSize,
+		ttl:       parseDurationFlag(c, putTTLFlag),
 		dryRun:    flagIsSet(c, dryRunFlag),
 	}
 	return uparams.do(c)
@@ -200,6 +202,7 @@ func (p *uparams) _putOne(c *cli.Context, fobj fobj, reader cos.ReadOpenCloser,
 		Reader:     reader,
 		Cksum:      p.cksum,
 		Size:       uint64(fobj.size),
+		TTL:        p.ttl,
 		SkipVC:     skipVC,
 	}
 	_, err = api.PutObject(&putArgs)
@@ -389,6 +392,7 @@ func putRegular(c *cli.Context, bck cmn.Bck, objName, path string, finfo os.File
 		ObjName:    objName,
 		Reader:     reader,
 		Cksum:      cksum,
+		TTL:        parseDurationFlag(c, putTTLFlag),
 		SkipVC:     flagIsSet(c, skipVerCksumFlag),
 	}
 	_, err = api.PutObject(&putArgs)
@@ -87,6 +87,8 @@ func fmtLsObjStatus(e *cmn.LsoEnt) string {
 			return UnknownStatusVal
 		}
 		switch {
+		case e.IsOffline():
+			return fcyan("offline")
 		case e.IsVerChanged():
 			return fcyan("version-changed")
 		case e.IsVerRemoved():
@@ -8,15 +8,21 @@ package cmn
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"path"
 	"reflect"
+	"regexp"
 	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/feat"
 	"github.com/NVIDIA/aistore/cmn/nlog"
+	jsoniter "github.com/json-iterator/go"
 )
 
 // Bprops - manageable, user-configurable, and inheritable (from cluster config).
@@ -42,31 +48,47 @@ const (
 
 type (
 	Bprops struct {
-		BackendBck  Bck             `json:"backend_bck,omitempty"` // makes remote bucket out of a given ais bucket
+		BackendBck  Bck             `json:"backend_bck,omitempty"`               // makes remote bucket out of a given ais bucket
+		CloneOf     Bck             `json:"clone_of,omitempty" list:"omitempty"` // source of the copy-on-write clone (see apc.ActCloneBck)
 		Extra       ExtraProps      `json:"extra,omitempty" list:"omitempty"`
 		WritePolicy WritePolicyConf `json:"write_policy"`
-		Provider    string          `json:"provider" list:"readonly"`       // backend provider
-		Renamed     string          `list:"omit"`                           // non-empty if the bucket has been renamed
-		Cksum       CksumConf       `json:"checksum"`                       // the bucket's checksum
-		EC          ECConf          `json:"ec"`                             // erasure coding
-		LRU         LRUConf         `json:"lru"`                            // LRU (watermarks and enabled/disabled)
-		Mirror      MirrorConf      `json:"mirror"`                         // mirroring
-		Access      apc.AccessAttrs `json:"access,string"`                  // access permissions
-		Features    feat.Flags      `json:"features,string"`                // assorted features from feat.Bucket
-		BID         uint64          `json:"bid,string" list:"omit"`         // unique ID
-		Created     int64           `json:"created,string" list:"readonly"` // creation timestamp
-		Versioning  VersionConf     `json:"versioning"`                     // versioning (see "inherit")
+		Provider    string          `json:"provider" list:"readonly"`                // backend provider
+		Renamed     string          `list:"omit"`                                    // non-empty if the bucket has been renamed
+		TrashedFrom Bck             `json:"trashed_from,omitempty" list:"omit"`      // original bucket (see cmn.NsTrash)
+		Trashed     int64           `json:"trashed,string,omitempty" list:"omit"`    // when destroyed (ditto)
+		Frozen      int64           `json:"frozen,string,omitempty" list:"readonly"` // when frozen (see apc.ActFreezeBck)
+		Cksum       CksumConf       `json:"checksum"`                                // the bucket's checksum
+		EC          ECConf          `json:"ec"`                                      // erasure coding
+		LRU         LRUConf         `json:"lru"`                                     // LRU (watermarks and enabled/disabled)
+		Mirror      MirrorConf      `json:"mirror"`                                  // mirroring
+		Pin         PinConf         `json:"pin"`                                     // read-only replicas on (selected) targets
+		Triggers    Triggers        `json:"triggers,omitempty" list:"omitempty"`     // event hooks (PUT, DELETE)
+		CORS        CORSRules       `json:"cors,omitempty" list:"omitempty"`         // cross-origin access via /s3
+		AccessLog   AccessLogConf   `json:"access_log"`                              // server access logs
+		Payload     PayloadConf     `json:"payload"`                                 // PUT: max object size, allowed content types
+		Naming      NamingConf      `json:"naming"`                                  // PUT and rename: object naming rules
+		Admission   AdmissionConf   `json:"admission"`                               // cold GET: whether to store (cache) remote objects
+		ETL         BckETLConf      `json:"etl"`                                     // GET: default (transform-on-read) ETL
+		Access      apc.AccessAttrs `json:"access,string"`                           // access permissions
+		Features    feat.Flags      `json:"features,string"`                         // assorted features from feat.Bucket
+		BID         uint64          `json:"bid,string" list:"omit"`                  // unique ID
+		Created     int64           `json:"created,string" list:"readonly"`          // creation timestamp
+		Versioning  VersionConf     `json:"versioning"`                              // versioning (see "inherit")
 	}
 
 	ExtraProps struct {
-		AWS  ExtraPropsAWS  `json:"aws,omitempty" list:"omitempty"`
-		HTTP ExtraPropsHTTP `json:"http,omitempty" list:"omitempty"`
-		HDFS ExtraPropsHDFS `json:"hdfs,omitempty" list:"omitempty"` // NOTE: obsolete; rm with meta-version
+		AWS   ExtraPropsAWS   `json:"aws,omitempty" list:"omitempty"`
+		GCP   ExtraPropsGCP   `json:"gcp,omitempty" list:"omitempty"`
+		Azure ExtraPropsAzure `json:"azure,omitempty" list:"omitempty"`
+		HTTP  ExtraPropsHTTP  `json:"http,omitempty" list:"omitempty"`
+		HDFS  ExtraPropsHDFS  `json:"hdfs,omitempty" list:"omitempty"` // NOTE: obsolete; rm with meta-version
 	}
 	ExtraToSet struct { // ref. bpropsFilterExtra
-		AWS  *ExtraPropsAWSToSet  `json:"aws"`
-		HTTP *ExtraPropsHTTPToSet `json:"http"`
-		HDFS *ExtraPropsHDFSToSet `json:"hdfs"` // ditto
+		AWS   *ExtraPropsAWSToSet   `json:"aws"`
+		GCP   *ExtraPropsGCPToSet   `json:"gcp"`
+		Azure *ExtraPropsAzureToSet `json:"azure"`
+		HTTP  *ExtraPropsHTTPToSet  `json:"http"`
+		HDFS  *ExtraPropsHDFSToSet  `json:"hdfs"` // ditto
 	}
 
 	ExtraPropsAWS struct {
@@ -88,12 +110,52 @@ type (
 		// vs OpenStack Swift: 10,000
 		// - https://docs.openstack.org/swift/latest/api/pagination.html
 		MaxPageSize int64 `json:"max_pagesize,omitempty"`
+
+		// per-bucket static credentials that take precedence over the profile and the environment
+		// (the secret is stored sealed - see SealSecret)
+		AccessKeyID     string `json:"access_key_id,omitempty"`
+		SecretAccessKey string `json:"secret_access_key,omitempty"`
 	}
 	ExtraPropsAWSToSet struct {
-		CloudRegion *string `json:"cloud_region"`
+		CloudRegion     *string `json:"cloud_region"`
+		Endpoint        *string `json:"endpoint"`
+		Profile         *string `json:"profile"`
+		MaxPageSize     *int64  `json:"max_pagesize"`
+		AccessKeyID     *string `json:"access_key_id"`
+		SecretAccessKey *string `json:"secret_access_key"`
+	}
+
+	// per-bucket GCP project and service account credentials (JSON; stored sealed), and:
+	// - user (billing) project to access requester-pays buckets;
+	// - customer-managed (Cloud KMS) encryption key to write objects with, overriding the bucket's default
+	//   (format: "projects/P/locations/L/keyRings/R/cryptoKeys/K");
+	// - the rest is read-only and gets discovered upon (remote) bucket lookup
+	ExtraPropsGCP struct {
+		ProjectID     string `json:"project_id,omitempty"`
+		Credentials   string `json:"credentials,omitempty"`
+		UserProject   string `json:"user_project,omitempty"`
+		KMSKeyName    string `json:"kms_key_name,omitempty"`
+		DefaultKMSKey string `json:"default_kms_key,omitempty" list:"readonly"`
+		RequesterPays bool   `json:"requester_pays,omitempty" list:"readonly"`
+		UniformAccess bool   `json:"uniform_access,omitempty" list:"readonly"`
+	}
+	ExtraPropsGCPToSet struct {
+		ProjectID   *string `json:"project_id"`
+		Credentials *string `json:"credentials"`
+		UserProject *string `json:"user_project"`
+		KMSKeyName  *string `json:"kms_key_name"`
+	}
+
+	// per-bucket Azure storage account (the key is stored sealed) and, optionally, endpoint
+	ExtraPropsAzure struct {
+		AccountName string `json:"account_name,omitempty"`
+		AccountKey  string `json:"account_key,omitempty"`
+		Endpoint    string `json:"endpoint,omitempty"`
+	}
+	ExtraPropsAzureToSet struct {
+		AccountName *string `json:"account_name"`
+		AccountKey  *string `json:"account_key"`
 		Endpoint    *string `json:"endpoint"`
-		Profile     *string `json:"profile"`
-		MaxPageSize *int64  `json:"max_pagesize"`
 	}
 
 	ExtraPropsHTTP struct {
@@ -125,6 +187,14 @@ type (
 		Access      *apc.AccessAttrs      `json:"access,string,omitempty"`
 		Features    *feat.Flags           `json:"features,string,omitempty"`
 		WritePolicy *WritePolicyConfToSet `json:"write_policy,omitempty"`
+		Pin         *PinConfToSet         `json:"pin,omitempty"`
+		Triggers    *Triggers             `json:"triggers,omitempty"`
+		CORS        *CORSRules            `json:"cors,omitempty"`
+		AccessLog   *AccessLogConfToSet   `json:"access_log,omitempty"`
+		Payload     *PayloadConfToSet     `json:"payload,omitempty"`
+		Naming      *NamingConfToSet      `json:"naming,omitempty"`
+		Admission   *AdmissionConfToSet   `json:"admission,omitempty"`
+		ETL         *BckETLConfToSet      `json:"etl,omitempty"`
 		Extra       *ExtraToSet           `json:"extra,omitempty"`
 		Force       bool                  `json:"force,omitempty" copy:"skip" list:"omit"`
 	}
@@ -133,6 +203,104 @@ type (
 		Name     *string `json:"name"`
 		Provider *string `json:"provider"`
 	}
+
+	// pinned bucket: fully replicated on every target (or a named subset), and read-only;
+	// reads are served by any of the pinned targets locally, bypassing HRW
+	PinConf struct {
+		Targets string `json:"targets,omitempty"` // comma-separated target IDs; empty: all targets
+		Enabled bool   `json:"enabled"`
+	}
+	PinConfToSet struct {
+		Targets *string `json:"targets,omitempty"`
+		Enabled *bool   `json:"enabled,omitempty"`
+	}
+
+	// bucket event hook: upon (committed) PUT or DELETE of a matching object, the target
+	// that stores the object runs the specified action - at least once (see ais/tgttrig.go)
+	TriggerConf struct {
+		Name    string `json:"name"`              // unique (within a given bucket)
+		Event   string `json:"event"`             // apc.TriggerPut | apc.TriggerDelete
+		Pattern string `json:"pattern,omitempty"` // shell pattern (e.g., "*.tar") to match the object's basename or, if contains '/', full name
+		Action  string `json:"action"`            // apc.ActDsort | apc.ActETLObjects | apc.ActDeleteObjects (see below)
+		Arg     string `json:"arg,omitempty"`     // dsort: job template name; ETL: ETL name
+		ToBck   Bck    `json:"to_bck,omitempty"`  // ETL: destination bucket; delete: bucket to delete the same-named object from
+	}
+	Triggers []TriggerConf
+
+	// CORS rule (compare w/ S3 CORS configuration): a cross-origin request to the bucket via /s3
+	// is permitted by the first rule that matches its origin, method, and (preflight) headers
+	// (see ais/s3/cors.go)
+	CORSRule struct {
+		ID             string   `json:"id,omitempty"`
+		AllowedOrigins []string `json:"allowed_origins"`           // e.g. "https://app.example.com", "*"; at most one '*' wildcard each
+		AllowedMethods []string `json:"allowed_methods"`           // GET | PUT | POST | DELETE | HEAD
+		AllowedHeaders []string `json:"allowed_headers,omitempty"` // preflight: permitted request headers (ditto wildcard)
+		ExposeHeaders  []string `json:"expose_headers,omitempty"`  // response headers that browsers expose to the app
+		MaxAgeSeconds  int      `json:"max_age_seconds,omitempty"` // preflight: how long to cache the response
+	}
+	CORSRules []CORSRule
+
+	// server access logs (compare w/ S3 server access logging): targets batch per-request
+	// records and periodically write them as objects into the destination bucket (see ais/tgtalog.go)
+	AccessLogConf struct {
+		ToBck    string       `json:"to_bck,omitempty"`   // destination bucket, e.g. "ais://logs"
+		Prefix   string       `json:"prefix,omitempty"`   // destination object name prefix (default: "<bucket-name>/")
+		Interval cos.Duration `json:"interval,omitempty"` // flush interval (default: 5m)
+		Enabled  bool         `json:"enabled"`
+	}
+	AccessLogConfToSet struct {
+		ToBck    *string       `json:"to_bck,omitempty"`
+		Prefix   *string       `json:"prefix,omitempty"`
+		Interval *cos.Duration `json:"interval,omitempty"`
+		Enabled  *bool         `json:"enabled,omitempty"`
+	}
+
+	// client PUT restrictions: enforced by the proxy (based on Content-Length and Content-Type)
+	// and by the target (when receiving the object's content)
+	PayloadConf struct {
+		ContentTypes string      `json:"content_types,omitempty"`   // comma-separated allowlist, e.g. "image/*, application/x-tar"
+		MaxObjSize   cos.SizeIEC `json:"max_object_size,omitempty"` // zero: unlimited
+	}
+	PayloadConfToSet struct {
+		ContentTypes *string      `json:"content_types,omitempty"`
+		MaxObjSize   *cos.SizeIEC `json:"max_object_size,omitempty"`
+	}
+
+	// object naming rules - to keep (e.g.) ingestion jobs from writing names that break downstream tools;
+	// enforced by the proxy on PUT (native and S3 API, including APPEND and multipart upload) and rename
+	NamingConf struct {
+		Charset   string `json:"charset,omitempty"`    // allowed characters (regex character class), e.g. "a-zA-Z0-9._/-"; empty: any
+		Prefix    string `json:"prefix,omitempty"`     // required prefix (regex anchored at the start), e.g. "(train|val)/[0-9]{4}/"
+		MaxLength int    `json:"max_length,omitempty"` // in bytes; zero: unlimited
+	}
+	NamingConfToSet struct {
+		Charset   *string `json:"charset,omitempty"`
+		Prefix    *string `json:"prefix,omitempty"`
+		MaxLength *int    `json:"max_length,omitempty"`
+	}
+
+	// cold GET admission: remote objects that do not pass are served (streamed) to the client
+	// without being stored in the cluster - e.g., to keep a one-off scan of a large remote bucket
+	// from evicting the working set
+	AdmissionConf struct {
+		MaxObjSize cos.SizeIEC  `json:"max_object_size,omitempty"` // larger objects are not stored; zero: unlimited
+		MinHits    int          `json:"min_hits,omitempty"`        // store upon (estimated) N-th access within the window; zero or one: first
+		Window     cos.Duration `json:"window,omitempty"`          // access frequency window; zero: 1h
+	}
+	AdmissionConfToSet struct {
+		MaxObjSize *cos.SizeIEC  `json:"max_object_size,omitempty"`
+		MinHits    *int          `json:"min_hits,omitempty"`
+		Window     *cos.Duration `json:"window,omitempty"`
+	}
+
+	// transform-on-read: GET requests are transparently transformed by the named (and running) ETL,
+	// unless the request specifies its own (apc.QparamETLName) or opts out (apc.QparamETLBypass)
+	BckETLConf struct {
+		Name string `json:"name,omitempty"` // empty: none
+	}
+	BckETLConfToSet struct {
+		Name *string `json:"name,omitempty"`
+	}
 )
 
 /////////////////
@@ -216,6 +384,15 @@ func (bp *Bprops) Validate(targetCnt int) error {
 		}
 	}
 
+	if !bp.CloneOf.IsEmpty() {
+		if bp.Provider != apc.AIS || !bp.CloneOf.IsAIS() {
+			return fmt.Errorf("invalid clone %q: both source and destination must be AIS buckets", bp.CloneOf)
+		}
+		if !bp.BackendBck.IsEmpty() {
+			return fmt.Errorf("clone of %q cannot have remote backend (%q)", bp.CloneOf, bp.BackendBck)
+		}
+	}
+
 	// run assorted props validators
 	var softErr error
 	for _, pv := range []PropsValidator{&bp.Cksum, &bp.Mirror, &bp.EC, &bp.Extra, &bp.WritePolicy} {
@@ -234,9 +411,34 @@ func (bp *Bprops) Validate(targetCnt int) error {
 			softErr = err
 		}
 	}
+	if bp.Mirror.Enabled && !bp.Mirror.WriteAck.IsOne() && bp.Provider != apc.AIS {
+		return fmt.Errorf("invalid mirror.write_ack %q: only ais:// buckets can acknowledge PUT upon writing mirror copies (%s bucket)",
+			bp.Mirror.WriteAck, bp.Provider)
+	}
 	if bp.Mirror.Enabled && bp.EC.Enabled {
 		nlog.Warningln("n-way mirroring and EC are both enabled at the same time on the same bucket")
 	}
+	if bp.Pin.Enabled && bp.EC.Enabled {
+		return errors.New("pinned bucket cannot be erasure coded (hint: disable EC or pinning)")
+	}
+	if err := bp.Triggers.validate(); err != nil {
+		return err
+	}
+	if err := bp.CORS.validate(); err != nil {
+		return err
+	}
+	if err := bp.AccessLog.validate(); err != nil {
+		return err
+	}
+	if err := bp.Payload.validate(); err != nil {
+		return err
+	}
+	if err := bp.Naming.validate(); err != nil {
+		return err
+	}
+	if err := bp.Admission.validate(); err != nil {
+		return err
+	}
 
 	// not inheriting cluster-scope features
 	names := bp.Features.Names()
@@ -248,6 +450,337 @@ func (bp *Bprops) Validate(targetCnt int) error {
 	return softErr
 }
 
+/////////////
+// PinConf //
+/////////////
+
+// whether a given target is expected to keep a replica of (each and every object in) the bucket
+func (c *PinConf) Has(tid string) bool {
+	if !c.Enabled {
+		return false
+	}
+	if c.Targets == "" {
+		return true
+	}
+	for _, id := range strings.Split(c.Targets, ",") {
+		if strings.TrimSpace(id) == tid {
+			return true
+		}
+	}
+	return false
+}
+
+///////////////////
+// AccessLogConf //
+///////////////////
+
+const (
+	DefaultAccessLogIval = 5 * time.Minute
+	MinAccessLogIval     = 10 * time.Second
+)
+
+func (c *AccessLogConf) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	bck, err := c.DstBck()
+	if err != nil {
+		return err
+	}
+	if bck.IsHTTP() {
+		return fmt.Errorf("access log: invalid destination bucket %s (HTTP buckets are read-only)", bck)
+	}
+	if c.Interval != 0 && c.Interval.D() < MinAccessLogIval {
+		return fmt.Errorf("access log: flush interval %v is too short (minimum %v)", c.Interval, MinAccessLogIval)
+	}
+	return nil
+}
+
+func (c *AccessLogConf) DstBck() (bck Bck, err error) {
+	if c.ToBck == "" {
+		return bck, errors.New("access log: missing destination bucket")
+	}
+	var objName string
+	bck, objName, err = ParseBckObjectURI(c.ToBck, ParseURIOpts{DefaultProvider: apc.AIS})
+	if err != nil {
+		return bck, fmt.Errorf("access log: invalid destination bucket %q: %v", c.ToBck, err)
+	}
+	if objName != "" {
+		return bck, fmt.Errorf("access log: invalid destination bucket %q (hint: use \"prefix\" to specify object name prefix)", c.ToBck)
+	}
+	return bck, nil
+}
+
+func (c *AccessLogConf) Ival() time.Duration {
+	if c.Interval == 0 {
+		return DefaultAccessLogIval
+	}
+	return c.Interval.D()
+}
+
+/////////////////
+// PayloadConf //
+/////////////////
+
+func (c *PayloadConf) validate() error {
+	if c.MaxObjSize < 0 {
+		return fmt.Errorf("payload: invalid max object size %d", c.MaxObjSize)
+	}
+	for _, ct := range c.types() {
+		typ, sub, ok := strings.Cut(ct, "/")
+		if !ok || typ == "" || sub == "" || typ == "*" {
+			return fmt.Errorf("payload: invalid content type %q (expecting \"type/subtype\" or \"type/*\")", ct)
+		}
+	}
+	return nil
+}
+
+func (c *PayloadConf) types() (out []string) {
+	if c.ContentTypes == "" {
+		return nil
+	}
+	for _, ct := range strings.Split(c.ContentTypes, ",") {
+		if ct = strings.TrimSpace(ct); ct != "" {
+			out = append(out, strings.ToLower(ct))
+		}
+	}
+	return out
+}
+
+// returns ErrTooLarge if the size exceeds the configured maximum
+func (c *PayloadConf) CheckSize(what string, size int64) error {
+	if c.MaxObjSize > 0 && size > int64(c.MaxObjSize) {
+		return NewErrTooLarge(what, size, int64(c.MaxObjSize))
+	}
+	return nil
+}
+
+// (Content-Type parameters, e.g. "; charset=utf-8", are ignored)
+func (c *PayloadConf) CheckContentType(what, ctype string) error {
+	types := c.types()
+	if len(types) == 0 {
+		return nil
+	}
+	mtype, _, _ := strings.Cut(ctype, ";")
+	mtype = strings.ToLower(strings.TrimSpace(mtype))
+	if mtype != "" {
+		for _, ct := range types {
+			if ct == mtype {
+				return nil
+			}
+			if prefix, ok := strings.CutSuffix(ct, "/*"); ok && strings.HasPrefix(mtype, prefix+"/") {
+				return nil
+			}
+		}
+	}
+	if mtype == "" {
+		mtype = "<none>"
+	}
+	return fmt.Errorf("%s: content type %s is not allowed (expecting one of: %s)", what, mtype, c.ContentTypes)
+}
+
+////////////////
+// NamingConf //
+////////////////
+
+// compiled naming rules (by regex)
+var namingRegex sync.Map
+
+func namingRegexp(expr string) (*regexp.Regexp, error) {
+	if v, ok := namingRegex.Load(expr); ok {
+		return v.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	namingRegex.Store(expr, re)
+	return re, nil
+}
+
+func (c *NamingConf) charsetRe() (*regexp.Regexp, error) {
+	return namingRegexp("^[" + c.Charset + "]*$")
+}
+
+func (c *NamingConf) prefixRe() (*regexp.Regexp, error) {
+	return namingRegexp("^(?:" + c.Prefix + ")")
+}
+
+func (c *NamingConf) validate() error {
+	if c.MaxLength < 0 {
+		return fmt.Errorf("naming: invalid max length %d", c.MaxLength)
+	}
+	if c.Charset != "" {
+		if _, err := c.charsetRe(); err != nil {
+			return fmt.Errorf("naming: invalid charset %q (expecting regex character class, e.g. \"a-z0-9._/-\"): %v", c.Charset, err)
+		}
+	}
+	if c.Prefix != "" {
+		if _, err := c.prefixRe(); err != nil {
+			return fmt.Errorf("naming: invalid prefix pattern %q: %v", c.Prefix, err)
+		}
+	}
+	return nil
+}
+
+// returns non-nil error if the object name violates the rules
+func (c *NamingConf) Check(what, objName string) error {
+	if c.MaxLength > 0 && len(objName) > c.MaxLength {
+		return fmt.Errorf("%s: object name length %d exceeds the bucket's maximum %d", what, len(objName), c.MaxLength)
+	}
+	if c.Charset != "" {
+		if re, err := c.charsetRe(); err == nil && !re.MatchString(objName) {
+			return fmt.Errorf("%s: object name contains characters outside the bucket's charset [%s]", what, c.Charset)
+		}
+	}
+	if c.Prefix != "" {
+		if re, err := c.prefixRe(); err == nil && !re.MatchString(objName) {
+			return fmt.Errorf("%s: object name does not start with the bucket's required prefix %q", what, c.Prefix)
+		}
+	}
+	return nil
+}
+
+///////////////////
+// AdmissionConf //
+///////////////////
+
+// (access counts are estimated with 8-bit saturating counters)
+const MaxAdmissionHits = 255
+
+func (c *AdmissionConf) validate() error {
+	if c.MaxObjSize < 0 {
+		return fmt.Errorf("admission: invalid max object size %d", c.MaxObjSize)
+	}
+	if c.MinHits < 0 || c.MinHits > MaxAdmissionHits {
+		return fmt.Errorf("admission: invalid min hits %d (expecting [0, %d] range)", c.MinHits, MaxAdmissionHits)
+	}
+	if c.Window < 0 {
+		return fmt.Errorf("admission: invalid window %v", c.Window)
+	}
+	return nil
+}
+
+func (c *AdmissionConf) Enabled() bool { return c.MaxObjSize > 0 || c.MinHits > 1 }
+
+//////////////
+// Triggers //
+//////////////
+
+func (trigs Triggers) validate() error {
+	names := make(cos.StrSet, len(trigs))
+	for i := range trigs {
+		tc := &trigs[i]
+		if tc.Name == "" {
+			return fmt.Errorf("trigger #%d: missing name", i+1)
+		}
+		if names.Contains(tc.Name) {
+			return fmt.Errorf("duplicate trigger name %q", tc.Name)
+		}
+		names.Add(tc.Name)
+		if _, err := path.Match(tc.Pattern, ""); err != nil {
+			return fmt.Errorf("trigger %q: invalid pattern %q: %v", tc.Name, tc.Pattern, err)
+		}
+		switch tc.Event {
+		case apc.TriggerPut:
+			switch tc.Action {
+			case apc.ActDsort:
+				if tc.Arg == "" {
+					return fmt.Errorf("trigger %q: dsort job template is required", tc.Name)
+				}
+			case apc.ActETLObjects:
+				if tc.Arg == "" || tc.ToBck.IsEmpty() {
+					return fmt.Errorf("trigger %q: both ETL name and destination bucket are required", tc.Name)
+				}
+			default:
+				return fmt.Errorf("trigger %q: invalid action %q upon %q (expecting %q or %q)",
+					tc.Name, tc.Action, tc.Event, apc.ActDsort, apc.ActETLObjects)
+			}
+		case apc.TriggerDelete:
+			if tc.Action != apc.ActDeleteObjects {
+				return fmt.Errorf("trigger %q: invalid action %q upon %q (expecting %q)",
+					tc.Name, tc.Action, tc.Event, apc.ActDeleteObjects)
+			}
+			if tc.ToBck.IsEmpty() {
+				return fmt.Errorf("trigger %q: destination bucket is required", tc.Name)
+			}
+		default:
+			return fmt.Errorf("trigger %q: invalid event %q (expecting %q or %q)",
+				tc.Name, tc.Event, apc.TriggerPut, apc.TriggerDelete)
+		}
+		if !tc.ToBck.IsEmpty() {
+			if err := tc.ToBck.Validate(); err != nil {
+				return fmt.Errorf("trigger %q: %v", tc.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (trigs Triggers) Get(name string) *TriggerConf {
+	for i := range trigs {
+		if trigs[i].Name == name {
+			return &trigs[i]
+		}
+	}
+	return nil
+}
+
+// whether a given trigger fires upon a given event on a given object
+func (tc *TriggerConf) Match(event, objName string) bool {
+	if tc.Event != event {
+		return false
+	}
+	if tc.Pattern == "" {
+		return true
+	}
+	name := objName
+	if !strings.Contains(tc.Pattern, "/") {
+		name = path.Base(objName)
+	}
+	ok, _ := path.Match(tc.Pattern, name)
+	return ok
+}
+
+///////////////
+// CORSRules //
+///////////////
+
+const maxCORSRules = 100 // (same as S3)
+
+var corsMethods = []string{http.MethodGet, http.MethodPut, http.MethodPost, http.MethodDelete, http.MethodHead}
+
+func (rules CORSRules) validate() error {
+	if len(rules) > maxCORSRules {
+		return fmt.Errorf("too many CORS rules: %d (max %d)", len(rules), maxCORSRules)
+	}
+	for i := range rules {
+		rule := &rules[i]
+		if len(rule.AllowedOrigins) == 0 || len(rule.AllowedMethods) == 0 {
+			return fmt.Errorf("CORS rule #%d: allowed origins and methods are required", i+1)
+		}
+		for _, o := range rule.AllowedOrigins {
+			if strings.Count(o, "*") > 1 {
+				return fmt.Errorf("CORS rule #%d: invalid origin %q (at most one '*' wildcard)", i+1, o)
+			}
+		}
+		for _, h := range rule.AllowedHeaders {
+			if strings.Count(h, "*") > 1 {
+				return fmt.Errorf("CORS rule #%d: invalid header %q (at most one '*' wildcard)", i+1, h)
+			}
+		}
+		for _, m := range rule.AllowedMethods {
+			if !cos.StringInSlice(m, corsMethods) {
+				return fmt.Errorf("CORS rule #%d: invalid method %q (expecting one of: %v)", i+1, m, corsMethods)
+			}
+		}
+		if rule.MaxAgeSeconds < 0 {
+			return fmt.Errorf("CORS rule #%d: invalid max age %d", i+1, rule.MaxAgeSeconds)
+		}
+	}
+	return nil
+}
+
 func (bp *Bprops) Apply(propsToSet *BpropsToSet) {
 	err := copyProps(propsToSet, bp, apc.Daemon)
 	debug.AssertNoErr(err)
@@ -262,6 +795,23 @@ func NewBpropsToSet(nvs cos.StrKVs) (props *BpropsToSet, err error) {
 	for key, val := range nvs {
 		name, value := strings.ToLower(key), val
 
+		// (JSON-formatted lists - the only non-scalar bucket properties)
+		if name == "triggers" {
+			trigs := Triggers{}
+			if err := jsoniter.Unmarshal([]byte(value), &trigs); err != nil {
+				return props, fmt.Errorf("invalid triggers %q (expecting JSON-formatted list): %v", value, err)
+			}
+			props.Triggers = &trigs
+			continue
+		}
+		if name == "cors" {
+			rules := CORSRules{}
+			if err := jsoniter.Unmarshal([]byte(value), &rules); err != nil {
+				return props, fmt.Errorf("invalid CORS rules %q (expecting JSON-formatted list): %v", value, err)
+			}
+			props.CORS = &rules
+			continue
+		}
 		// HACK: Some of the fields are present in `Bprops` and not in `BpropsToSet`.
 		// Thus, if user wants to change such field, `unknown field` will be returned.
 		// To make UX more friendly we attempt to set the value in an empty `Bprops` first.
@@ -282,6 +832,19 @@ func (c *ExtraProps) ValidateAsProps(arg ...any) error {
 	if provider == apc.HTTP && c.HTTP.OrigURLBck == "" {
 		return errors.New("original bucket URL must be set for a bucket with HTTP provider")
 	}
+	if (c.AWS.AccessKeyID == "") != (c.AWS.SecretAccessKey == "") {
+		return errors.New("invalid AWS bucket credentials: both access key ID and secret access key must be set (or none)")
+	}
+	if (c.Azure.AccountName == "") != (c.Azure.AccountKey == "") {
+		return errors.New("invalid Azure bucket credentials: both account name and account key must be set (or none)")
+	}
+	if c.GCP.KMSKeyName != "" && !strings.HasPrefix(c.GCP.KMSKeyName, "projects/") {
+		return fmt.Errorf("invalid GCP encryption key name %q (expecting \"projects/P/locations/L/keyRings/R/cryptoKeys/K\")",
+			c.GCP.KMSKeyName)
+	}
+	if c.HasCreds() && provider == apc.AIS {
+		return errors.New("cloud credentials cannot be specified for ais:// buckets")
+	}
 	return nil
 }
 
@@ -295,6 +858,15 @@ type (
 		apc.BsummResult
 	}
 	AllBsummResults []*BsummResult
+
+	// `api.GetBucketSummaryStream` (JSON lines): per-target results in order of arrival
+	// followed by cluster-wide (aggregated) results - the lines with no target ID
+	BsummLine struct {
+		Summ   *BsummResult `json:"summ,omitempty"`
+		TID    string       `json:"tid,omitempty"`
+		Err    string       `json:"error,omitempty"`
+		Status int          `json:"status"` // http.StatusOK, StatusAccepted (not ready yet), or StatusPartialContent
+	}
 )
 
 // interface guard
@@ -0,0 +1,103 @@
+// Package archive: write, read, copy, append, list primitives
+// across all supported formats
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package archive
+
+import (
+	"archive/tar"
+	"errors"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// TAR index: archived file => offset and size of its contents within (uncompressed) TAR;
+// use `NewTarIndex` to build, `Get` to look up
+type (
+	TarIndex      map[string]TarIndexEntry
+	TarIndexEntry struct {
+		Off  int64
+		Size int64
+	}
+	posReader struct {
+		r   io.ReadSeeker
+		pos int64
+	}
+)
+
+var (
+	ErrTooManyFiles = errors.New("too many archived files")
+	ErrSparseFiles  = errors.New("cannot index sparse archived files")
+)
+
+// NOTE: uncompressed TAR only (is expected to be positioned at the beginning)
+func NewTarIndex(r io.ReadSeeker, maxFiles int) (TarIndex, error) {
+	var (
+		pr  = &posReader{r: r}
+		tr  = tar.NewReader(pr)
+		idx = make(TarIndex, 64)
+	)
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			if err == io.EOF {
+				return idx, nil
+			}
+			return nil, err
+		}
+		if _sparse(hdr) {
+			return nil, ErrSparseFiles // (contents are not contiguous)
+		}
+		if len(idx) >= maxFiles {
+			return nil, ErrTooManyFiles
+		}
+		// tar.Reader has consumed the header(s) and is now positioned at the contents;
+		// duplicate names: the first one wins (same as ReadOne)
+		name := _idxname(hdr.Name)
+		if _, ok := idx[name]; !ok {
+			idx[name] = TarIndexEntry{Off: pr.pos, Size: hdr.Size}
+		}
+	}
+}
+
+// (compare w/ namesEq)
+func (idx TarIndex) Get(filename string) (TarIndexEntry, bool) {
+	e, ok := idx[_idxname(filename)]
+	return e, ok
+}
+
+func _sparse(hdr *tar.Header) bool {
+	if hdr.Typeflag == tar.TypeGNUSparse {
+		return true
+	}
+	for k := range hdr.PAXRecords {
+		if strings.HasPrefix(k, "GNU.sparse.") {
+			return true
+		}
+	}
+	return false
+}
+
+func _idxname(name string) string {
+	if name != "" && name[0] == filepath.Separator {
+		return name[1:]
+	}
+	return name
+}
+
+// io.ReadSeeker that keeps track of its position (tar.Reader seeks to skip contents)
+func (pr *posReader) Read(b []byte) (n int, err error) {
+	n, err = pr.r.Read(b)
+	pr.pos += int64(n)
+	return n, err
+}
+
+func (pr *posReader) Seek(offset int64, whence int) (n int64, err error) {
+	n, err = pr.r.Seek(offset, whence)
+	if err == nil {
+		pr.pos = n
+	}
+	return n, err
+}
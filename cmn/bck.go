@@ -16,6 +16,7 @@ import (
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/feat"
 )
 
 type (
@@ -65,6 +66,9 @@ var (
 	// NsAnyRemote represents any remote cluster. As such, NsGlobalRemote applies
 	// exclusively to AIS (provider) given that other Backend providers are remote by definition.
 	NsAnyRemote = Ns{UUID: string(apc.NsUUIDPrefix)}
+	// NsTrash is a reserved (hidden) namespace of destroyed ais:// buckets
+	// awaiting permanent removal - see SpaceConf.TrashGrace
+	NsTrash = Ns{Name: ".trash"}
 )
 
 // A note on validation logic: cmn.Bck vs cmn.QueryBcks - same structures,
@@ -345,6 +349,7 @@ func (b *Bck) ubuf(buf []byte, nsUname, objName string) []byte {
 func (n Ns) IsGlobal() bool    { return n == NsGlobal }
 func (n Ns) IsAnyRemote() bool { return n == NsAnyRemote }
 func (n Ns) IsRemote() bool    { return n.UUID != "" }
+func (n Ns) IsTrash() bool     { return n == NsTrash }
 
 func (b *Bck) Backend() *Bck {
 	bprops := b.Props
@@ -357,6 +362,15 @@ func (b *Bck) Backend() *Bck {
 	return &bprops.BackendBck
 }
 
+// source bucket of a copy-on-write clone, or nil
+func (b *Bck) CloneOf() *Bck {
+	bprops := b.Props
+	if bprops == nil || bprops.CloneOf.Name == "" {
+		return nil
+	}
+	return &bprops.CloneOf
+}
+
 func (b *Bck) RemoteBck() *Bck {
 	if bck := b.Backend(); bck != nil {
 		return bck
@@ -398,6 +412,11 @@ func (b *Bck) IsCloud() bool {
 // * see related: `ObjAttrs.Equal`
 func (b *Bck) HasVersioningMD() bool { return b.IsCloud() || b.IsRemoteAIS() }
 
+// remote bucket with its backend marked offline - in-cluster content only
+func (b *Bck) IsOffline() bool {
+	return b.IsRemote() && b.Props != nil && b.Props.Features.IsSet(feat.BackendOffline)
+}
+
 func (b *Bck) HasProvider() bool { return b.Provider != "" }
 
 //
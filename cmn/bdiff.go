@@ -0,0 +1,25 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+// `api.DiffBucket` (apc.ActDiffBck) results: delta entries in (lexicographical) order
+// followed by the counts; non-empty `Err` indicates that the diff was interrupted
+type (
+	DiffBckEntry struct {
+		A    *LsoEnt `json:"a,omitempty"` // nil when apc.DiffOnlyB
+		B    *LsoEnt `json:"b,omitempty"` // nil when apc.DiffOnlyA
+		Name string  `json:"name"`
+		What string  `json:"what"` // enum { apc.DiffOnlyA, ... }
+	}
+	DiffBckRes struct {
+		Err     string          `json:"error,omitempty"`
+		Entries []*DiffBckEntry `json:"entries"`
+		OnlyA   int64           `json:"only_a,string"`
+		OnlyB   int64           `json:"only_b,string"`
+		Differ  int64           `json:"differ,string"`
+		Same    int64           `json:"same,string"`
+	}
+)
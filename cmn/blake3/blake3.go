@@ -0,0 +1,305 @@
+// Package blake3 implements BLAKE3 cryptographic hash (default, unkeyed mode, 256-bit output)
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package blake3
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash"
+	"math/bits"
+)
+
+// Translated from the reference implementation:
+//   https://github.com/BLAKE3-team/BLAKE3/blob/master/reference_impl/reference_impl.rs
+//
+// Unlike other (SIMD-optimized) Go implementations, this one implements encoding.BinaryMarshaler
+// and encoding.BinaryUnmarshaler - a requirement for all aistore checksums (see cos.CksumHash
+// and, in particular, appending to existing objects).
+
+const (
+	Size      = 32
+	BlockSize = 64
+
+	chunkLen = 1024
+	maxDepth = 54 // 2^54 * chunkLen = 2^64
+
+	chunkStart = 1 << 0
+	chunkEnd   = 1 << 1
+	parent     = 1 << 2
+	root       = 1 << 3
+)
+
+var iv = [8]uint32{0x6A09E667, 0xBB67AE85, 0x3C6EF372, 0xA54FF53A, 0x510E527F, 0x9B05688C, 0x1F83D9AB, 0x5BE0CD19}
+
+// message word schedule: 7 rounds, each subsequent round permuting the previous one
+// with (2, 6, 3, 10, 7, 0, 4, 13, 1, 11, 12, 5, 9, 14, 15, 8)
+var schedule = [7][16]uint8{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+	{2, 6, 3, 10, 7, 0, 4, 13, 1, 11, 12, 5, 9, 14, 15, 8},
+	{3, 4, 10, 12, 13, 2, 7, 14, 6, 5, 9, 0, 11, 15, 8, 1},
+	{10, 7, 12, 9, 14, 3, 13, 15, 4, 0, 11, 2, 5, 8, 1, 6},
+	{12, 13, 9, 11, 15, 10, 14, 8, 7, 2, 5, 3, 0, 1, 6, 4},
+	{9, 14, 11, 5, 8, 12, 15, 1, 13, 3, 0, 10, 2, 6, 4, 7},
+	{11, 15, 5, 0, 1, 9, 8, 6, 14, 10, 2, 12, 3, 4, 7, 13},
+}
+
+type (
+	chunkState struct {
+		cv               [8]uint32
+		block            [BlockSize]byte
+		chunkCounter     uint64
+		blockLen         int
+		blocksCompressed int
+	}
+	output struct {
+		cv       [8]uint32
+		block    [16]uint32
+		counter  uint64
+		blockLen uint32
+		flags    uint32
+	}
+	digest struct {
+		cs      chunkState
+		cvStack [maxDepth][8]uint32
+		cvLen   int
+	}
+)
+
+// interface guard
+var _ hash.Hash = (*digest)(nil)
+
+func New() hash.Hash {
+	d := &digest{}
+	d.Reset()
+	return d
+}
+
+// convenience
+func Sum256(data []byte) (sum [Size]byte) {
+	d := &digest{}
+	d.Reset()
+	_, _ = d.Write(data)
+	d.Sum(sum[:0])
+	return
+}
+
+func (*digest) Size() int      { return Size }
+func (*digest) BlockSize() int { return BlockSize }
+
+func (d *digest) Reset() {
+	d.cs = chunkState{cv: iv}
+	d.cvLen = 0
+}
+
+func (d *digest) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		if d.cs.len() == chunkLen {
+			out := d.cs.output()
+			cv := out.chainingValue()
+			total := d.cs.chunkCounter + 1
+			d.addChunkCV(cv, total)
+			d.cs = chunkState{cv: iv, chunkCounter: total}
+		}
+		k := min(chunkLen-d.cs.len(), len(p))
+		d.cs.update(p[:k])
+		p = p[k:]
+	}
+	return n, nil
+}
+
+// does not change the underlying state
+func (d *digest) Sum(b []byte) []byte {
+	out := d.cs.output()
+	for i := d.cvLen - 1; i >= 0; i-- {
+		out = parentOutput(&d.cvStack[i], out.chainingValue())
+	}
+	var sum [Size]byte
+	out.rootBytes(&sum)
+	return append(b, sum[:]...)
+}
+
+// merge completed subtrees (as many as there are trailing zero bits in the total number of chunks)
+func (d *digest) addChunkCV(cv [8]uint32, total uint64) {
+	for total&1 == 0 {
+		d.cvLen--
+		pout := parentOutput(&d.cvStack[d.cvLen], cv)
+		cv = pout.chainingValue()
+		total >>= 1
+	}
+	d.cvStack[d.cvLen] = cv
+	d.cvLen++
+}
+
+//
+// binary (un)marshaling
+//
+
+const (
+	magic         = "b3\x01"
+	marshaledSize = len(magic) + 8*4 + BlockSize + 8 + 1 + 1 + 1
+)
+
+var errInvalidState = errors.New("blake3: invalid hash state")
+
+func (d *digest) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 0, marshaledSize+d.cvLen*32)
+	b = append(b, magic...)
+	b = appendCV(b, &d.cs.cv)
+	b = append(b, d.cs.block[:]...)
+	b = binary.BigEndian.AppendUint64(b, d.cs.chunkCounter)
+	b = append(b, byte(d.cs.blockLen), byte(d.cs.blocksCompressed), byte(d.cvLen))
+	for i := range d.cvLen {
+		b = appendCV(b, &d.cvStack[i])
+	}
+	return b, nil
+}
+
+func (d *digest) UnmarshalBinary(b []byte) error {
+	if len(b) < marshaledSize || string(b[:len(magic)]) != magic {
+		return errInvalidState
+	}
+	b = b[len(magic):]
+	b = consumeCV(&d.cs.cv, b)
+	b = b[copy(d.cs.block[:], b):]
+	d.cs.chunkCounter = binary.BigEndian.Uint64(b)
+	d.cs.blockLen, d.cs.blocksCompressed, d.cvLen = int(b[8]), int(b[9]), int(b[10])
+	b = b[11:]
+	if d.cs.blockLen > BlockSize || d.cs.blocksCompressed >= chunkLen/BlockSize || d.cvLen > maxDepth ||
+		len(b) != d.cvLen*32 {
+		return errInvalidState
+	}
+	for i := range d.cvLen {
+		b = consumeCV(&d.cvStack[i], b)
+	}
+	return nil
+}
+
+func appendCV(b []byte, cv *[8]uint32) []byte {
+	for _, w := range cv {
+		b = binary.BigEndian.AppendUint32(b, w)
+	}
+	return b
+}
+
+func consumeCV(cv *[8]uint32, b []byte) []byte {
+	for i := range cv {
+		cv[i] = binary.BigEndian.Uint32(b[i*4:])
+	}
+	return b[32:]
+}
+
+////////////////
+// chunkState //
+////////////////
+
+func (cs *chunkState) len() int { return BlockSize*cs.blocksCompressed + cs.blockLen }
+
+func (cs *chunkState) startFlag() uint32 {
+	if cs.blocksCompressed == 0 {
+		return chunkStart
+	}
+	return 0
+}
+
+func (cs *chunkState) update(p []byte) {
+	for len(p) > 0 {
+		if cs.blockLen == BlockSize {
+			var words [16]uint32
+			wordsLE(&words, &cs.block)
+			out := compress(&cs.cv, &words, cs.chunkCounter, BlockSize, cs.startFlag())
+			copy(cs.cv[:], out[:8])
+			cs.blocksCompressed++
+			cs.block = [BlockSize]byte{}
+			cs.blockLen = 0
+		}
+		k := copy(cs.block[cs.blockLen:], p)
+		cs.blockLen += k
+		p = p[k:]
+	}
+}
+
+func (cs *chunkState) output() (out output) {
+	out.cv = cs.cv
+	wordsLE(&out.block, &cs.block)
+	out.counter = cs.chunkCounter
+	out.blockLen = uint32(cs.blockLen)
+	out.flags = cs.startFlag() | chunkEnd
+	return
+}
+
+////////////
+// output //
+////////////
+
+func parentOutput(left *[8]uint32, right [8]uint32) (out output) {
+	out.cv = iv
+	copy(out.block[:8], left[:])
+	copy(out.block[8:], right[:])
+	out.blockLen = BlockSize
+	out.flags = parent
+	return
+}
+
+func (out *output) chainingValue() (cv [8]uint32) {
+	w := compress(&out.cv, &out.block, out.counter, out.blockLen, out.flags)
+	copy(cv[:], w[:8])
+	return
+}
+
+// (256-bit output requires a single root compression)
+func (out *output) rootBytes(sum *[Size]byte) {
+	w := compress(&out.cv, &out.block, 0, out.blockLen, out.flags|root)
+	for i := range 8 {
+		binary.LittleEndian.PutUint32(sum[i*4:], w[i])
+	}
+}
+
+//
+// compression
+//
+
+func wordsLE(words *[16]uint32, block *[BlockSize]byte) {
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint32(block[i*4:])
+	}
+}
+
+func g(s *[16]uint32, a, b, c, d int, mx, my uint32) {
+	s[a] += s[b] + mx
+	s[d] = bits.RotateLeft32(s[d]^s[a], -16)
+	s[c] += s[d]
+	s[b] = bits.RotateLeft32(s[b]^s[c], -12)
+	s[a] += s[b] + my
+	s[d] = bits.RotateLeft32(s[d]^s[a], -8)
+	s[c] += s[d]
+	s[b] = bits.RotateLeft32(s[b]^s[c], -7)
+}
+
+func compress(cv *[8]uint32, m *[16]uint32, counter uint64, blockLen, flags uint32) (s [16]uint32) {
+	copy(s[:8], cv[:])
+	copy(s[8:12], iv[:4])
+	s[12], s[13] = uint32(counter), uint32(counter>>32)
+	s[14], s[15] = blockLen, flags
+
+	for r := range schedule {
+		x := &schedule[r]
+		// columns
+		g(&s, 0, 4, 8, 12, m[x[0]], m[x[1]])
+		g(&s, 1, 5, 9, 13, m[x[2]], m[x[3]])
+		g(&s, 2, 6, 10, 14, m[x[4]], m[x[5]])
+		g(&s, 3, 7, 11, 15, m[x[6]], m[x[7]])
+		// diagonals
+		g(&s, 0, 5, 10, 15, m[x[8]], m[x[9]])
+		g(&s, 1, 6, 11, 12, m[x[10]], m[x[11]])
+		g(&s, 2, 7, 8, 13, m[x[12]], m[x[13]])
+		g(&s, 3, 4, 9, 14, m[x[14]], m[x[15]])
+	}
+	for i := range 8 {
+		s[i] ^= s[i+8]
+		s[i+8] ^= cv[i]
+	}
+	return
+}
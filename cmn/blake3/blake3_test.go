@@ -0,0 +1,82 @@
+// Package blake3 implements BLAKE3 cryptographic hash (default, unkeyed mode, 256-bit output)
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package blake3_test
+
+import (
+	"encoding"
+	"encoding/hex"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/blake3"
+)
+
+// official test vectors (https://github.com/BLAKE3-team/BLAKE3/blob/master/test_vectors/test_vectors.json):
+// input of a given length filled with the repeating sequence 0, 1, ..., 250
+var vectors = []struct {
+	hash string
+	size int
+}{
+	{"af1349b9f5f9a1a6a0404dea36dcc9499bcb25c9adc112b7cc9a93cae41f3262", 0},
+	{"2d3adedff11b61f14c886e35afa036736dcd87a74d27b5c1510225d0f592e213", 1},
+	{"10108970eeda3eb932baac1428c7a2163b0e924c9a9e25b35bba72b28f70bd11", 1023},
+	{"42214739f095a406f3fc83deb889744ac00df831c10daa55189b5d121c855af7", 1024},
+	{"d00278ae47eb27b34faecf67b4fe263f82d5412916c1ffd97c8cb7fb814b8444", 1025},
+	{"e776b6028c7cd22a4d0ba182a8bf62205d2ef576467e838ed6f2529b85fba24a", 2048},
+}
+
+func input(size int) []byte {
+	b := make([]byte, size)
+	for i := range b {
+		b[i] = byte(i % 251)
+	}
+	return b
+}
+
+func TestVectors(t *testing.T) {
+	for _, v := range vectors {
+		sum := blake3.Sum256(input(v.size))
+		if s := hex.EncodeToString(sum[:]); s != v.hash {
+			t.Errorf("size %d: expected %s, got %s", v.size, v.hash, s)
+		}
+		// byte by byte
+		h := blake3.New()
+		for _, c := range input(v.size) {
+			h.Write([]byte{c})
+		}
+		if s := hex.EncodeToString(h.Sum(nil)); s != v.hash {
+			t.Errorf("size %d (streaming): expected %s, got %s", v.size, v.hash, s)
+		}
+	}
+}
+
+func TestMarshalBinary(t *testing.T) {
+	data := input(100*1024 + 17)
+	expected := blake3.Sum256(data)
+	for _, split := range []int{0, 1, 64, 1024, 1025, 4096, 50*1024 + 3, len(data)} {
+		h := blake3.New()
+		h.Write(data[:split])
+		state, err := h.(encoding.BinaryMarshaler).MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		h2 := blake3.New()
+		if err := h2.(encoding.BinaryUnmarshaler).UnmarshalBinary(state); err != nil {
+			t.Fatal(err)
+		}
+		h2.Write(data[split:])
+		if sum := h2.Sum(nil); string(sum) != string(expected[:]) {
+			t.Errorf("split %d: expected %x, got %x", split, expected, sum)
+		}
+	}
+}
+
+func BenchmarkBlake3(b *testing.B) {
+	data := input(1024 * 1024)
+	b.SetBytes(int64(len(data)))
+	b.ResetTimer()
+	for range b.N {
+		blake3.Sum256(data)
+	}
+}
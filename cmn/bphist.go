@@ -0,0 +1,48 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import "fmt"
+
+// `what=props-history` (apc.WhatBpropsHistory): bucket props changes - who, when, and
+// what (changed props only) - stored in the BMD (bounded, per bucket)
+type (
+	BpropsDiff struct {
+		Old string `json:"old"`
+		New string `json:"new"`
+	}
+	BpropsChange struct {
+		Diff       map[string]BpropsDiff `json:"diff"`             // by prop name, e.g. "checksum.type"
+		Action     string                `json:"action"`           // apc.ActSetBprops or apc.ActResetBprops
+		User       string                `json:"user,omitempty"`   // user ID (AuthN enabled)
+		Client     string                `json:"client,omitempty"` // client address
+		Time       int64                 `json:"time,string"`      // unix nanoseconds
+		BMDVersion int64                 `json:"bmd_version,string"`
+	}
+)
+
+// DiffFrom compares bucket props with the previous ones - prop by prop
+func (bp *Bprops) DiffFrom(prev *Bprops) (diff map[string]BpropsDiff) {
+	vals := make(map[string]string, 64)
+	IterFields(prev, func(tag string, field IterField) (error, bool) {
+		vals[tag] = fmt.Sprint(field.Value())
+		return nil, false
+	})
+	diff = make(map[string]BpropsDiff, 4)
+	IterFields(bp, func(tag string, field IterField) (error, bool) {
+		pv, ok := vals[tag]
+		delete(vals, tag)
+		if v := fmt.Sprint(field.Value()); !ok || v != pv {
+			diff[tag] = BpropsDiff{Old: pv, New: v}
+		}
+		return nil, false
+	})
+	// (omitted when empty)
+	for tag, pv := range vals {
+		diff[tag] = BpropsDiff{Old: pv}
+	}
+	return diff
+}
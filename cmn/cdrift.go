@@ -0,0 +1,69 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import "fmt"
+
+// `what=config_drift` (apc.WhatConfigDrift): nodes whose live (cluster-scope) configuration
+// differs from the cluster config (ConfigMD), by node ID
+type (
+	ConfigDriftKey struct {
+		Node    string `json:"node"`    // node's live value
+		Cluster string `json:"cluster"` // authoritative value
+	}
+	NodeConfigDrift struct {
+		Keys    map[string]ConfigDriftKey `json:"keys,omitempty"`  // by config name, e.g. "timeout.max_keepalive"
+		Err     string                    `json:"error,omitempty"` // failed to check
+		Version int64                     `json:"config_version,string"`
+		Fixed   bool                      `json:"fixed,omitempty"` // reset to cluster config (feat.FixConfigDrift)
+	}
+	ConfigDriftReport struct {
+		Nodes   map[string]*NodeConfigDrift `json:"nodes"`
+		Time    int64                       `json:"time,string"` // when checked (unix nanoseconds)
+		Version int64                       `json:"config_version,string"`
+	}
+)
+
+// not compared: read-only or hidden (see apc.WhatNodeConfig)
+var noDrift = map[string]struct{}{
+	"lastupdate_time": {},
+	"auth.secret":     {},
+}
+
+// DriftFrom compares (node's) live config with the authoritative one - key by key
+func (c *ClusterConfig) DriftFrom(auth *ClusterConfig) (keys map[string]ConfigDriftKey) {
+	vals := make(map[string]string, 256)
+	IterFields(auth, func(tag string, field IterField) (error, bool) {
+		vals[tag] = fmt.Sprint(field.Value()) // (not field.String() - see NOTE therein)
+		return nil, false
+	})
+	IterFields(c, func(tag string, field IterField) (error, bool) {
+		if _, ok := noDrift[tag]; ok {
+			return nil, false
+		}
+		cv, ok := vals[tag]
+		delete(vals, tag)
+		if v := fmt.Sprint(field.Value()); !ok || v != cv {
+			keys = _drift(keys, tag, v, cv)
+		}
+		return nil, false
+	})
+	// (omitted when empty)
+	for tag, cv := range vals {
+		if _, ok := noDrift[tag]; !ok {
+			keys = _drift(keys, tag, "", cv)
+		}
+	}
+	return keys
+}
+
+func _drift(keys map[string]ConfigDriftKey, tag, v, cv string) map[string]ConfigDriftKey {
+	if keys == nil {
+		keys = make(map[string]ConfigDriftKey, 4)
+	}
+	keys[tag] = ConfigDriftKey{Node: v, Cluster: cv}
+	return keys
+}
@@ -0,0 +1,49 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import "sort"
+
+// bucket change feed: GET /v1/buckets/<bck>?action=changes&since=<seq> (see apc.BckChanges)
+type (
+	ChangeEvent struct {
+		ObjName string `json:"name"`
+		NewName string `json:"new_name,omitempty"` // apc.ChangeRename only
+		Op      string `json:"op"`                 // enum { apc.ChangePut, apc.ChangeDelete, apc.ChangeRename }
+		Seq     int64  `json:"seq,string"`
+	}
+	ChangeFeed struct {
+		Events []*ChangeEvent `json:"events"`      // in order of (increasing) sequence numbers
+		Next   int64          `json:"next,string"` // to pass as `since` in the next call
+	}
+)
+
+// MergeChanges combines the (sorted) per-target feeds up to the watermark - the minimum
+// of their `Next` values: since each target's sequence is monotonic, no event at or below
+// the watermark can show up later
+func MergeChanges(feeds []*ChangeFeed) *ChangeFeed {
+	var (
+		out = &ChangeFeed{}
+		n   int
+	)
+	for i, f := range feeds {
+		if i == 0 || f.Next < out.Next {
+			out.Next = f.Next
+		}
+		n += len(f.Events)
+	}
+	out.Events = make([]*ChangeEvent, 0, n)
+	for _, f := range feeds {
+		for _, ev := range f.Events {
+			if ev.Seq > out.Next {
+				break
+			}
+			out.Events = append(out.Events, ev)
+		}
+	}
+	sort.Slice(out.Events, func(i, j int) bool { return out.Events[i].Seq < out.Events[j].Seq })
+	return out
+}
@@ -29,6 +29,7 @@ type (
 		SndRcvBufSize    int
 		WriteBufferSize  int
 		ReadBufferSize   int
+		QoS              *QoSClass // DSCP marking and socket priority (nil: system defaults)
 		UseHTTPProxyEnv  bool
 	}
 	TLSArgs struct {
@@ -54,7 +55,7 @@ func NewTransport(cargs TransportArgs) *http.Transport {
 		KeepAlive: 30 * time.Second,
 	}
 	// setsockopt when non-zero, otherwise use TCP defaults
-	if cargs.SndRcvBufSize > 0 {
+	if cargs.SndRcvBufSize > 0 || cargs.QoS != nil {
 		dialer.Control = cargs.setSockOpt
 	}
 	transport := &http.Transport{
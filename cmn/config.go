@@ -57,6 +57,8 @@ type (
 		HostNet   LocalNetConfig `json:"host_net"`
 		FSP       FSPConf        `json:"fspaths"`
 		TestFSP   TestFSPConf    `json:"test_fspaths"`
+		// mountpath => object metadata store (LmetaStoreXattr (default) | LmetaStoreKVDB); see fs/lmstore.go
+		LmetaStores cos.StrKVs `json:"lmeta_stores,omitempty"`
 	}
 
 	// ais node: (local) network config
@@ -119,13 +121,47 @@ type (
 		// Transform (offline) or Copy src Bucket => dst bucket
 		TCB TCBConf `json:"tcb"`
 
+		// (remote buckets) prefetch objects ahead of sequential GETs
+		Readahead ReadaheadConf `json:"readahead"`
+
+		// (remote buckets) cold GET large objects via parallel range reads
+		ColdGet ColdGetConf `json:"cold_get"`
+
+		// GET hot small objects from memory-mapped cache
+		Mmap MmapConf `json:"mmap"`
+
+		// GET archived files from TAR shards via in-memory index of their offsets
+		ArchIndex ArchIndexConf `json:"arch_index"`
+
+		// targets: per-mountpath IO scheduling - user reads vs user writes vs background
+		IOSched IOSchedConf `json:"io_sched"`
+
+		// proxies: stop redirecting GETs to slow (or failing) targets, probe, and restore
+		Quarantine QuarantineConf `json:"quarantine"`
+
+		// proxies: embedded NFSv3 gateway exposing ais:// buckets
+		NFS NFSConf `json:"nfs"`
+
+		// per-namespace limits on ais:// buckets (number of buckets, total size)
+		NsQuota NsQuotaConf `json:"ns_quota"`
+
 		// metadata write policy: (immediate | delayed | never)
 		WritePolicy WritePolicyConf `json:"write_policy"`
 
+		// push node metrics to remote collectors (in addition to, or instead of, Prometheus scraping)
+		StatsPush StatsPushConf `json:"stats_push"`
+
+		// targets: APPEND flow control (max request size, max in-flight total, flush deadline)
+		Append AppendConf `json:"append"`
+
 		// standalone enumerated features that can be configured
 		// to flip assorted global defaults (see cmn/feat/feat.go)
 		Features feat.Flags `json:"features,string" allow:"cluster"`
 
+		// named dsort job templates (template name => dsort.RequestSpec)
+		// managed via /v1/sort/templates (not via 'set config')
+		DsortTemplates cos.JSONRawMsgs `json:"dsort_templates,omitempty" list:"omit"`
+
 		// read-only
 		LastUpdated string `json:"lastupdate_time"`       // timestamp
 		UUID        string `json:"uuid"`                  // UUID
@@ -156,7 +192,17 @@ type (
 		Transport   *TransportConfToSet   `json:"transport,omitempty"`
 		Memsys      *MemsysConfToSet      `json:"memsys,omitempty"`
 		TCB         *TCBConfToSet         `json:"tcb,omitempty"`
+		Readahead   *ReadaheadConfToSet   `json:"readahead,omitempty"`
+		ColdGet     *ColdGetConfToSet     `json:"cold_get,omitempty"`
+		Mmap        *MmapConfToSet        `json:"mmap,omitempty"`
+		ArchIndex   *ArchIndexConfToSet   `json:"arch_index,omitempty"`
+		IOSched     *IOSchedConfToSet     `json:"io_sched,omitempty"`
+		Quarantine  *QuarantineConfToSet  `json:"quarantine,omitempty"`
+		NFS         *NFSConfToSet         `json:"nfs,omitempty"`
+		NsQuota     *NsQuotaConfToSet     `json:"ns_quota,omitempty"`
 		WritePolicy *WritePolicyConfToSet `json:"write_policy,omitempty"`
+		StatsPush   *StatsPushConfToSet   `json:"stats_push,omitempty"`
+		Append      *AppendConfToSet      `json:"append,omitempty"`
 		Proxy       *ProxyConfToSet       `json:"proxy,omitempty"`
 		Features    *feat.Flags           `json:"features,string,omitempty"`
 
@@ -174,14 +220,16 @@ type (
 	BackendConfAIS map[string][]string // cluster alias -> [urls...]
 
 	MirrorConf struct {
-		Copies  int64 `json:"copies"`       // num copies
-		Burst   int   `json:"burst_buffer"` // xaction channel (buffer) size
-		Enabled bool  `json:"enabled"`      // enabled (to generate copies)
+		WriteAck apc.WriteAck `json:"write_ack,omitempty"` // PUT: when to acknowledge (ais:// buckets only)
+		Copies   int64        `json:"copies"`              // num copies
+		Burst    int          `json:"burst_buffer"`        // xaction channel (buffer) size
+		Enabled  bool         `json:"enabled"`             // enabled (to generate copies)
 	}
 	MirrorConfToSet struct {
-		Copies  *int64 `json:"copies,omitempty"`
-		Burst   *int   `json:"burst_buffer,omitempty"`
-		Enabled *bool  `json:"enabled,omitempty"`
+		WriteAck *apc.WriteAck `json:"write_ack,omitempty"`
+		Copies   *int64        `json:"copies,omitempty"`
+		Burst    *int          `json:"burst_buffer,omitempty"`
+		Enabled  *bool         `json:"enabled,omitempty"`
 	}
 
 	ECConf struct {
@@ -249,11 +297,15 @@ type (
 		StatsTime     cos.Duration `json:"stats_time"`      // collect and publish stats; other house-keeping
 		RetrySyncTime cos.Duration `json:"retry_sync_time"` // metasync retry
 		NotifTime     cos.Duration `json:"notif_time"`      // (IC notifications)
+		// primary: compare all nodes' live config with the cluster ConfigMD (0 - disabled)
+		// see also: feat.FixConfigDrift
+		ConfigDriftTime cos.Duration `json:"config_drift_time"`
 	}
 	PeriodConfToSet struct {
-		StatsTime     *cos.Duration `json:"stats_time,omitempty"`
-		RetrySyncTime *cos.Duration `json:"retry_sync_time,omitempty"`
-		NotifTime     *cos.Duration `json:"notif_time,omitempty"`
+		StatsTime       *cos.Duration `json:"stats_time,omitempty"`
+		RetrySyncTime   *cos.Duration `json:"retry_sync_time,omitempty"`
+		NotifTime       *cos.Duration `json:"notif_time,omitempty"`
+		ConfigDriftTime *cos.Duration `json:"config_drift_time,omitempty"`
 	}
 
 	// maximum intra-cluster latencies (in the increasing order)
@@ -289,12 +341,27 @@ type (
 		PrimaryURL   string `json:"primary_url"`
 		OriginalURL  string `json:"original_url"`
 		DiscoveryURL string `json:"discovery_url"`
-		NonElectable bool   `json:"non_electable"`
+		// dynamic discovery of the proxies to join, in addition to (and after) the URLs above:
+		// - "dns-srv:<name>", e.g. "dns-srv:_ais-proxy._tcp.ais.svc.cluster.local"
+		// - "k8s:<service>[:<port-name>]" (Kubernetes endpoints in the current namespace)
+		// - "<scheme>:<spec>" for any other (registered) discovery method
+		Discovery string `json:"discovery,omitempty"`
+		// hot-standby proxy (ID): receives every Smap and BMD update synchronously,
+		// before the primary acknowledges the change; takes over upon primary failure
+		Standby string `json:"standby,omitempty"`
+		// stream object data through this proxy (reverse-proxying the target's response)
+		// instead of redirecting clients to targets - for clients that can only reach
+		// the proxy tier (e.g., strict firewalls); see also feat.S3ReverseProxy
+		StreamData   bool `json:"stream_data,omitempty"`
+		NonElectable bool `json:"non_electable"`
 	}
 	ProxyConfToSet struct {
 		PrimaryURL   *string `json:"primary_url,omitempty"`
 		OriginalURL  *string `json:"original_url,omitempty"`
 		DiscoveryURL *string `json:"discovery_url,omitempty"`
+		Discovery    *string `json:"discovery,omitempty"`
+		Standby      *string `json:"standby,omitempty"`
+		StreamData   *bool   `json:"stream_data,omitempty"`
 		NonElectable *bool   `json:"non_electable,omitempty"`
 	}
 
@@ -315,12 +382,18 @@ type (
 		// Out-of-Space: if exceeded, the target starts failing new PUTs and keeps
 		// failing them until its local used-cap gets back below HighWM (see above)
 		OOS int64 `json:"out_of_space"`
+
+		// TrashGrace: when non-zero, destroying an ais:// bucket moves it into the
+		// hidden cmn.NsTrash namespace, to be permanently removed only after the
+		// specified grace period (and be restored via apc.ActUndeleteBck in the meantime)
+		TrashGrace cos.Duration `json:"trash_grace,omitempty"`
 	}
 	SpaceConfToSet struct {
-		CleanupWM *int64 `json:"cleanupwm,omitempty"`
-		LowWM     *int64 `json:"lowwm,omitempty"`
-		HighWM    *int64 `json:"highwm,omitempty"`
-		OOS       *int64 `json:"out_of_space,omitempty"`
+		CleanupWM  *int64        `json:"cleanupwm,omitempty"`
+		LowWM      *int64        `json:"lowwm,omitempty"`
+		HighWM     *int64        `json:"highwm,omitempty"`
+		OOS        *int64        `json:"out_of_space,omitempty"`
+		TrashGrace *cos.Duration `json:"trash_grace,omitempty"`
 	}
 
 	LRUConf struct {
@@ -359,10 +432,13 @@ type (
 		Compression   string       `json:"compression"`       // enum { CompressAlways, ... } in api/apc/compression.go
 		DestRetryTime cos.Duration `json:"dest_retry_time"`   // max wait for ACKs & neighbors to complete
 		SbundleMult   int          `json:"bundle_multiplier"` // stream-bundle multiplier: num streams to destination
-		Enabled       bool         `json:"enabled"`           // true=auto-rebalance | manual rebalancing
+		// warm restart: time to wait for a target to come back before rebalancing (default: 5m)
+		RestartGrace cos.Duration `json:"restart_grace,omitempty"`
+		Enabled      bool         `json:"enabled"` // true=auto-rebalance | manual rebalancing
 	}
 	RebalanceConfToSet struct {
 		DestRetryTime *cos.Duration `json:"dest_retry_time,omitempty"`
+		RestartGrace  *cos.Duration `json:"restart_grace,omitempty"`
 		Compression   *string       `json:"compression,omitempty"`
 		SbundleMult   *int          `json:"bundle_multiplier"`
 		Enabled       *bool         `json:"enabled,omitempty"`
@@ -424,23 +500,32 @@ type (
 		// - apc.QparamLatestVer, apc.PrefetchMsg, apc.CopyBckMsg
 		ValidateWarmGet bool `json:"validate_warm_get"`
 
+		// When ValidateWarmGet is enabled: do not wait for the remote version check
+		// (and, possibly, re-fetch) but rather return the in-cluster copy right away
+		// while checking (and updating it) in the background.
+		// Trade-off: lower GET latency vs. one (or more) stale reads upon remote change.
+		ValidateWarmGetAsync bool `json:"validate_warm_get_async,omitempty" list:"omitempty"`
+
 		// A stronger variant of the above that in addition entails:
 		// - deleting in-cluster object if its remote ("cached") counterpart does not exist
 		// See also: apc.QparamSync, apc.CopyBckMsg
 		Sync bool `json:"synchronize"`
 	}
 	VersionConfToSet struct {
-		Enabled         *bool `json:"enabled,omitempty"`
-		ValidateWarmGet *bool `json:"validate_warm_get,omitempty"`
-		Sync            *bool `json:"synchronize,omitempty"`
+		Enabled              *bool `json:"enabled,omitempty"`
+		ValidateWarmGet      *bool `json:"validate_warm_get,omitempty"`
+		ValidateWarmGetAsync *bool `json:"validate_warm_get_async,omitempty" list:"omitempty"`
+		Sync                 *bool `json:"synchronize,omitempty"`
 	}
 
 	NetConf struct {
 		L4   L4Conf   `json:"l4"`
 		HTTP HTTPConf `json:"http"`
+		QoS  QoSConf  `json:"qos" list:"omitempty"`
 	}
 	NetConfToSet struct {
 		HTTP *HTTPConfToSet `json:"http,omitempty"`
+		QoS  *QoSConfToSet  `json:"qos,omitempty" list:"omitempty"`
 	}
 
 	L4Conf struct {
@@ -448,10 +533,39 @@ type (
 		SndRcvBufSize int    `json:"sndrcv_buf_size"` // SO_RCVBUF and SO_SNDBUF
 	}
 
+	// Network QoS: intra-cluster traffic classes, each with its own DSCP marking and
+	// (Linux) socket priority - so that control plane never gets starved by bulk
+	// data movement (e.g., rebalance) on shared NICs. Applies to newly established
+	// connections (and therefore requires restart).
+	QoSConf struct {
+		Control   QoSClass `json:"control"`   // keepalive, metasync, IC, and all other control-plane requests
+		Data      QoSClass `json:"data"`      // user data: GET/PUT (incl. redirects), copy/transform, dsort
+		EC        QoSClass `json:"ec"`        // erasure coding: slices and replicas
+		Rebalance QoSClass `json:"rebalance"` // global rebalance
+		Enabled   bool     `json:"enabled"`
+	}
+	QoSConfToSet struct {
+		Control   *QoSClassToSet `json:"control,omitempty"`
+		Data      *QoSClassToSet `json:"data,omitempty"`
+		EC        *QoSClassToSet `json:"ec,omitempty"`
+		Rebalance *QoSClassToSet `json:"rebalance,omitempty"`
+		Enabled   *bool          `json:"enabled,omitempty"`
+	}
+	QoSClass struct {
+		DSCP     int `json:"dscp"`     // differentiated services code point [0, 63] (IP_TOS and IPV6_TCLASS)
+		Priority int `json:"priority"` // SO_PRIORITY [0, 6] (Linux egress queuing; zero - system default)
+	}
+	QoSClassToSet struct {
+		DSCP     *int `json:"dscp,omitempty"`
+		Priority *int `json:"priority,omitempty"`
+	}
+
 	HTTPConf struct {
 		Proto           string `json:"-"`                 // http or https (set depending on `UseHTTPS`)
 		Certificate     string `json:"server_crt"`        // HTTPS: X509 certificate
 		CertKey         string `json:"server_key"`        // HTTPS: X509 key
+		IntraCert       string `json:"intra_server_crt"`  // HTTPS: intra-cluster listeners' certificate; empty: same as server_crt
+		IntraCertKey    string `json:"intra_server_key"`  // ditto, key
 		ServerNameTLS   string `json:"domain_tls"`        // #6410
 		ClientCA        string `json:"client_ca_tls"`     // #6410
 		ClientAuthTLS   int    `json:"client_auth_tls"`   // #6410 tls.ClientAuthType enum
@@ -464,6 +578,8 @@ type (
 	HTTPConfToSet struct {
 		Certificate     *string `json:"server_crt,omitempty"`
 		CertKey         *string `json:"server_key,omitempty"`
+		IntraCert       *string `json:"intra_server_crt,omitempty"`
+		IntraCertKey    *string `json:"intra_server_key,omitempty"`
 		ServerNameTLS   *string `json:"domain_tls,omitempty"`
 		ClientCA        *string `json:"client_ca_tls,omitempty"`
 		WriteBufferSize *int    `json:"write_buffer_size,omitempty" list:"readonly"`
@@ -520,14 +636,49 @@ type (
 	KeepaliveConf struct {
 		Proxy       KeepaliveTrackerConf `json:"proxy"`  // how proxy tracks target keepalives
 		Target      KeepaliveTrackerConf `json:"target"` // how target tracks primary proxies keepalives
+		Gossip      GossipConf           `json:"gossip"`
+		Overload    PrimaryOverloadConf  `json:"primary_overload"`
 		RetryFactor uint8                `json:"retry_factor"`
 	}
 	KeepaliveConfToSet struct {
 		Proxy       *KeepaliveTrackerConfToSet `json:"proxy,omitempty"`
 		Target      *KeepaliveTrackerConfToSet `json:"target,omitempty"`
+		Gossip      *GossipConfToSet           `json:"gossip,omitempty"`
+		Overload    *PrimaryOverloadConfToSet  `json:"primary_overload,omitempty"`
 		RetryFactor *uint8                     `json:"retry_factor,omitempty"`
 	}
 
+	// (optional) SWIM-style gossip among all nodes: random peers probe each other and
+	// exchange liveness, failed probes are retried indirectly (via other peers), and
+	// suspected nodes get reported to the primary - for large clusters
+	GossipConf struct {
+		// number of random peers probed by each node every keepalive tick;
+		// also, max number of peers asked to probe indirectly
+		Fanout uint8 `json:"fanout"`
+		// enabled/disabled
+		Enabled bool `json:"enabled"`
+	}
+	GossipConfToSet struct {
+		Fanout  *uint8 `json:"fanout,omitempty"`
+		Enabled *bool  `json:"enabled,omitempty"`
+	}
+
+	// (optional) re-elect the primary that is alive but reports sustained overload
+	// (memory pressure, metasync backlog) - see ais/prxovl.go
+	PrimaryOverloadConf struct {
+		// for how long the primary must remain overloaded to get replaced
+		Sustain cos.Duration `json:"sustain"`
+		// number of queued metasync requests that counts as overload
+		MsyncBacklog int `json:"msync_backlog"`
+		// enabled/disabled
+		Enabled bool `json:"enabled"`
+	}
+	PrimaryOverloadConfToSet struct {
+		Sustain      *cos.Duration `json:"sustain,omitempty"`
+		MsyncBacklog *int          `json:"msync_backlog,omitempty"`
+		Enabled      *bool         `json:"enabled,omitempty"`
+	}
+
 	DownloaderConf struct {
 		Timeout cos.Duration `json:"timeout"`
 	}
@@ -607,13 +758,217 @@ type (
 		SbundleMult *int    `json:"bundle_multiplier,omitempty"`
 	}
 
+	// Readahead: when GETs from a remote bucket are detected to be sequential by the
+	// numeric index in the object names (e.g., "shard-000123.tar", "shard-000124.tar", ...),
+	// targets prefetch the next objects in the sequence - within byte and time budgets
+	ReadaheadConf struct {
+		// max total size of prefetched objects that haven't been accessed yet
+		MaxInflight cos.SizeIEC `json:"max_inflight"`
+		// prefetched object that isn't accessed within this time counts as a miss
+		MaxAge cos.Duration `json:"max_age"`
+		// number of objects to look ahead (cluster-wide); each target prefetches its own share
+		Depth int `json:"depth"`
+		// enabled/disabled
+		Enabled bool `json:"enabled"`
+	}
+	ReadaheadConfToSet struct {
+		MaxInflight *cos.SizeIEC  `json:"max_inflight,omitempty"`
+		MaxAge      *cos.Duration `json:"max_age,omitempty"`
+		Depth       *int          `json:"depth,omitempty"`
+		Enabled     *bool         `json:"enabled,omitempty"`
+	}
+
+	// Cold GET: objects of (remote) size >= threshold are read from the backend
+	// in parts (ranges) - concurrently, and reassembled in memory, in order
+	ColdGetConf struct {
+		// min object size to read in parts
+		Threshold cos.SizeIEC `json:"threshold"`
+		// part (range) size
+		PartSize cos.SizeIEC `json:"part_size"`
+		// max number of parts read (and buffered) at any given time
+		NumWorkers int `json:"num_workers"`
+		// enabled/disabled
+		Enabled bool `json:"enabled"`
+	}
+	ColdGetConfToSet struct {
+		Threshold  *cos.SizeIEC `json:"threshold,omitempty"`
+		PartSize   *cos.SizeIEC `json:"part_size,omitempty"`
+		NumWorkers *int         `json:"num_workers,omitempty"`
+		Enabled    *bool        `json:"enabled,omitempty"`
+	}
+
+	// Memory-mapped read path: targets mmap and cache hot small objects
+	// (by access frequency), to serve GETs without per-GET open/read/close
+	MmapConf struct {
+		// max total size of memory-mapped objects (further bounded by memory pressure)
+		Budget cos.SizeIEC `json:"budget"`
+		// max size of an object to cache
+		MaxObjSize cos.SizeIEC `json:"max_obj_size"`
+		// number of GETs (within a minute) that make an object hot
+		MinHits int `json:"min_hits"`
+		// enabled/disabled
+		Enabled bool `json:"enabled"`
+	}
+	MmapConfToSet struct {
+		Budget     *cos.SizeIEC `json:"budget,omitempty"`
+		MaxObjSize *cos.SizeIEC `json:"max_obj_size,omitempty"`
+		MinHits    *int         `json:"min_hits,omitempty"`
+		Enabled    *bool        `json:"enabled,omitempty"`
+	}
+
+	// TAR shards: upon the first GET of an archived file (archpath), index (in the background)
+	// all archived files - names, offsets, and sizes - so that subsequent reads of the same shard
+	// skip scanning (see ais/tgtarch.go)
+	ArchIndexConf struct {
+		// max number of indexed shards per target (least recently used get evicted)
+		MaxShards int `json:"max_shards"`
+		// max number of archived files in a shard (larger shards are not indexed)
+		MaxFiles int `json:"max_files"`
+		// enabled/disabled
+		Enabled bool `json:"enabled"`
+	}
+	ArchIndexConfToSet struct {
+		MaxShards *int  `json:"max_shards,omitempty"`
+		MaxFiles  *int  `json:"max_files,omitempty"`
+		Enabled   *bool `json:"enabled,omitempty"`
+	}
+
+	// Per-mountpath IO scheduler: at most MaxInflight concurrent IO operations per mountpath;
+	// when contended, user reads, user writes, and background (xaction) IO get the slots
+	// in proportion to their respective shares (see fs/iosched.go)
+	IOSchedConf struct {
+		// max concurrent IO operations (of all classes) per mountpath
+		MaxInflight int `json:"max_inflight"`
+		// relative weights: user GETs, user PUTs, and background IO (rebalance, resilver,
+		// mirroring, EC encoding, bucket copy/transform, and other jobs), respectively
+		ReadShare  int `json:"read_share"`
+		WriteShare int `json:"write_share"`
+		BgShare    int `json:"bg_share"`
+		// enabled/disabled
+		Enabled bool `json:"enabled"`
+	}
+	IOSchedConfToSet struct {
+		MaxInflight *int  `json:"max_inflight,omitempty"`
+		ReadShare   *int  `json:"read_share,omitempty"`
+		WriteShare  *int  `json:"write_share,omitempty"`
+		BgShare     *int  `json:"bg_share,omitempty"`
+		Enabled     *bool `json:"enabled,omitempty"`
+	}
+
+	// Latency-based target quarantine: each proxy samples targets' GET latency and I/O error rate
+	// and temporarily stops redirecting GETs to the outliers (see ais/prxtquar.go)
+	QuarantineConf struct {
+		// how often to sample (and probe quarantined targets)
+		Interval cos.Duration `json:"interval"`
+		// average GET latency (over the interval) that quarantines a target
+		MaxLatency cos.Duration `json:"max_latency"`
+		// GET I/O errors (percentage of GETs) that quarantine a target
+		MaxErrPct int `json:"max_err_pct"`
+		// min number of GETs (over the interval) to evaluate latency and error rate
+		MinReqs int64 `json:"min_reqs"`
+		// enabled/disabled
+		Enabled bool `json:"enabled"`
+	}
+	QuarantineConfToSet struct {
+		Interval   *cos.Duration `json:"interval,omitempty"`
+		MaxLatency *cos.Duration `json:"max_latency,omitempty"`
+		MaxErrPct  *int          `json:"max_err_pct,omitempty"`
+		MinReqs    *int64        `json:"min_reqs,omitempty"`
+		Enabled    *bool         `json:"enabled,omitempty"`
+	}
+
+	// NFSv3 gateway: each proxy serves ais:// buckets as NFS exports (see ais/prxnfs.go)
+	NFSConf struct {
+		// TCP port (NFS and MOUNT programs alike)
+		Port int `json:"port"`
+		// comma-separated bucket names; empty - all ais:// buckets
+		Exports string `json:"exports"`
+		// allow writes (PUT whole object upon NFS COMMIT); otherwise, read-only
+		WriteThrough bool `json:"write_through"`
+		// enabled/disabled
+		Enabled bool `json:"enabled"`
+	}
+	NFSConfToSet struct {
+		Port         *int    `json:"port,omitempty"`
+		Exports      *string `json:"exports,omitempty"`
+		WriteThrough *bool   `json:"write_through,omitempty"`
+		Enabled      *bool   `json:"enabled,omitempty"`
+	}
+
+	// Per-namespace limits on ais:// buckets: enforced by the primary when creating buckets,
+	// and by proxies when redirecting PUTs (see ais/nsquota.go)
+	NsQuotaConf struct {
+		// max number of ais:// buckets in any given namespace (zero - unlimited)
+		MaxBuckets int `json:"max_buckets"`
+		// max total size of ais:// buckets in any given namespace (zero - unlimited)
+		MaxSize cos.SizeIEC `json:"max_size"`
+		// per-namespace limits that take precedence: "<ns>=<max-buckets>:<max-size>[,...]",
+		// e.g. "#tenant-a=100:10TiB,@uuid#ci=1000:" (empty value - max_buckets or max_size, respectively)
+		Overrides string `json:"overrides"`
+		// how often to recompute namespace sizes (applies when max size is configured)
+		Interval cos.Duration `json:"interval"`
+		// enabled/disabled
+		Enabled bool `json:"enabled"`
+	}
+	NsQuotaConfToSet struct {
+		MaxBuckets *int          `json:"max_buckets,omitempty"`
+		MaxSize    *cos.SizeIEC  `json:"max_size,omitempty"`
+		Overrides  *string       `json:"overrides,omitempty"`
+		Interval   *cos.Duration `json:"interval,omitempty"`
+		Enabled    *bool         `json:"enabled,omitempty"`
+	}
+
 	WritePolicyConf struct {
-		Data apc.WritePolicy `json:"data"`
-		MD   apc.WritePolicy `json:"md"`
+		Data  apc.WritePolicy `json:"data"`
+		MD    apc.WritePolicy `json:"md"`
+		Fsync apc.FsyncPolicy `json:"fsync,omitempty"` // durability upon finalizing new object (see apc.FsyncPolicy)
 	}
 	WritePolicyConfToSet struct {
-		Data *apc.WritePolicy `json:"data,omitempty" list:"readonly"` // NOTE: NIY
-		MD   *apc.WritePolicy `json:"md,omitempty"`
+		Data  *apc.WritePolicy `json:"data,omitempty"` // NOTE: only "immediate" and "delayed" (write-back)
+		MD    *apc.WritePolicy `json:"md,omitempty"`
+		Fsync *apc.FsyncPolicy `json:"fsync,omitempty"`
+	}
+
+	// Push-based metric streaming: every Interval, each node takes a snapshot of its metrics
+	// and POSTs buffered snapshots (JSON) to each of the configured collectors (see stats/push.go)
+	StatsPushConf struct {
+		// comma-separated collector URLs, e.g. "http://collector-1:8080/ais,https://collector-2/ais"
+		Collectors string `json:"collectors"`
+		// how often to take (and push) a snapshot
+		Interval cos.Duration `json:"interval"`
+		// per-request timeout
+		Timeout cos.Duration `json:"timeout"`
+		// max number of snapshots in a single request
+		BatchSize int `json:"batch_size"`
+		// max number of snapshots to keep (per collector) while the latter is unreachable;
+		// when exceeded, the oldest get dropped
+		BufferSize int `json:"buffer_size"`
+		// enabled/disabled
+		Enabled bool `json:"enabled"`
+	}
+	StatsPushConfToSet struct {
+		Collectors *string       `json:"collectors,omitempty"`
+		Interval   *cos.Duration `json:"interval,omitempty"`
+		Timeout    *cos.Duration `json:"timeout,omitempty"`
+		BatchSize  *int          `json:"batch_size,omitempty"`
+		BufferSize *int          `json:"buffer_size,omitempty"`
+		Enabled    *bool         `json:"enabled,omitempty"`
+	}
+
+	// APPEND sessions (api.AppendObject ... api.FlushObject); zero values mean "no limit"
+	AppendConf struct {
+		// max size of a single APPEND request (advertised to clients as the window)
+		Window cos.SizeIEC `json:"window"`
+		// max total size of APPEND requests being received concurrently (per target)
+		MaxInflight cos.SizeIEC `json:"max_inflight"`
+		// session that is neither appended to nor flushed within this time is abandoned
+		// and gets garbage-collected (along with its work file)
+		FlushDeadline cos.Duration `json:"flush_deadline"`
+	}
+	AppendConfToSet struct {
+		Window        *cos.SizeIEC  `json:"window,omitempty"`
+		MaxInflight   *cos.SizeIEC  `json:"max_inflight,omitempty"`
+		FlushDeadline *cos.Duration `json:"flush_deadline,omitempty"`
 	}
 )
 
@@ -629,6 +984,12 @@ const (
 
 var SupportedReactions = []string{IgnoreReaction, WarnReaction, AbortReaction}
 
+// object metadata stores (local config: lmeta_stores)
+const (
+	LmetaStoreXattr = "xattr"
+	LmetaStoreKVDB  = "kvdb"
+)
+
 //
 // config meta-versioning & serialization
 //
@@ -663,6 +1024,7 @@ var (
 	_ Validator = (*PeriodConf)(nil)
 	_ Validator = (*TimeoutConf)(nil)
 	_ Validator = (*ClientConf)(nil)
+	_ Validator = (*ProxyConf)(nil)
 	_ Validator = (*RebalanceConf)(nil)
 	_ Validator = (*ResilverConf)(nil)
 	_ Validator = (*NetConf)(nil)
@@ -673,7 +1035,17 @@ var (
 	_ Validator = (*TransportConf)(nil)
 	_ Validator = (*MemsysConf)(nil)
 	_ Validator = (*TCBConf)(nil)
+	_ Validator = (*ReadaheadConf)(nil)
+	_ Validator = (*ColdGetConf)(nil)
+	_ Validator = (*MmapConf)(nil)
+	_ Validator = (*ArchIndexConf)(nil)
+	_ Validator = (*IOSchedConf)(nil)
+	_ Validator = (*QuarantineConf)(nil)
+	_ Validator = (*NFSConf)(nil)
+	_ Validator = (*NsQuotaConf)(nil)
 	_ Validator = (*WritePolicyConf)(nil)
+	_ Validator = (*StatsPushConf)(nil)
+	_ Validator = (*AppendConf)(nil)
 
 	_ PropsValidator = (*CksumConf)(nil)
 	_ PropsValidator = (*SpaceConf)(nil)
@@ -711,6 +1083,9 @@ func (c *Config) Validate() error {
 	if err := c.LocalConfig.TestFSP.Validate(c); err != nil {
 		return err
 	}
+	if err := c.LocalConfig.validateLmetaStores(); err != nil {
+		return err
+	}
 
 	opts := IterOpts{VisitAll: true}
 	return IterFields(c, vdate, opts)
@@ -769,6 +1144,25 @@ func (c *LocalConfig) TestingEnv() bool {
 	return c.TestFSP.Count > 0
 }
 
+func (c *LocalConfig) validateLmetaStores() error {
+	if len(c.LmetaStores) == 0 {
+		return nil
+	}
+	clean := cos.NewStrKVs(len(c.LmetaStores))
+	for fspath, kind := range c.LmetaStores {
+		mpath, err := ValidateMpath(fspath)
+		if err != nil {
+			return err
+		}
+		if kind != LmetaStoreXattr && kind != LmetaStoreKVDB {
+			return fmt.Errorf("invalid lmeta_stores[%q] = %q (expecting %q or %q)", fspath, kind, LmetaStoreXattr, LmetaStoreKVDB)
+		}
+		clean[mpath] = kind
+	}
+	c.LmetaStores = clean
+	return nil
+}
+
 func (c *LocalConfig) AddPath(mpath string) {
 	debug.Assert(!c.TestingEnv())
 	c.FSP.Paths[mpath] = ""
@@ -796,6 +1190,10 @@ func (c *PeriodConf) Validate() error {
 		return fmt.Errorf("invalid periodic.notif_time=%s (expected range [1s, 1m])",
 			c.StatsTime)
 	}
+	if c.ConfigDriftTime != 0 && (c.ConfigDriftTime.D() < 10*time.Second || c.ConfigDriftTime.D() > 24*time.Hour) {
+		return fmt.Errorf("invalid periodic.config_drift_time=%s (expected 0 (disabled) or range [10s, 24h])",
+			c.ConfigDriftTime)
+	}
 	return nil
 }
 
@@ -842,6 +1240,21 @@ func (c *ClientConf) Validate() error {
 	return nil
 }
 
+///////////////
+// ProxyConf //
+///////////////
+
+func (c *ProxyConf) Validate() error {
+	if c.Discovery == "" {
+		return nil
+	}
+	if scheme, spec, ok := strings.Cut(c.Discovery, ":"); !ok || scheme == "" || spec == "" {
+		return fmt.Errorf("invalid proxy.discovery %q (expecting \"<scheme>:<spec>\", e.g. \"dns-srv:_ais._tcp.example.com\")",
+			c.Discovery)
+	}
+	return nil
+}
+
 /////////////////
 // BackendConf //
 /////////////////
@@ -990,14 +1403,274 @@ func (c *SpaceConf) Validate() (err error) {
 	if c.CleanupWM <= 0 || c.LowWM < c.CleanupWM || c.HighWM < c.LowWM || c.OOS < c.HighWM || c.OOS > 100 {
 		err = fmt.Errorf("invalid %s (expecting: 0 < cleanup < low < high < OOS < 100)", c)
 	}
+	if c.TrashGrace < 0 {
+		err = fmt.Errorf("invalid %s (expecting: trash_grace >= 0)", c)
+	}
 	return
 }
 
 func (c *SpaceConf) ValidateAsProps(...any) error { return c.Validate() }
 
 func (c *SpaceConf) String() string {
-	return fmt.Sprintf("space config: cleanup=%d%%, low=%d%%, high=%d%%, OOS=%d%%",
-		c.CleanupWM, c.LowWM, c.HighWM, c.OOS)
+	return fmt.Sprintf("space config: cleanup=%d%%, low=%d%%, high=%d%%, OOS=%d%%, trash_grace=%v",
+		c.CleanupWM, c.LowWM, c.HighWM, c.OOS, c.TrashGrace)
+}
+
+///////////////////
+// ReadaheadConf //
+///////////////////
+
+const maxReadaheadDepth = 256
+
+func (c *ReadaheadConf) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Depth <= 0 || c.Depth > maxReadaheadDepth {
+		return fmt.Errorf("invalid readahead.depth %d (expecting range [1, %d])", c.Depth, maxReadaheadDepth)
+	}
+	if c.MaxInflight <= 0 {
+		return fmt.Errorf("invalid readahead.max_inflight %d (expecting positive size)", c.MaxInflight)
+	}
+	if c.MaxAge.D() < time.Second {
+		return fmt.Errorf("invalid readahead.max_age %v (expecting >= 1s)", c.MaxAge)
+	}
+	return nil
+}
+
+/////////////////
+// ColdGetConf //
+/////////////////
+
+const (
+	minColdGetPartSize   = cos.MiB
+	maxColdGetPartSize   = cos.GiB
+	maxColdGetNumWorkers = 64
+)
+
+func (c *ColdGetConf) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.PartSize < minColdGetPartSize || c.PartSize > maxColdGetPartSize {
+		return fmt.Errorf("invalid cold_get.part_size %s (expecting range [%s, %s])",
+			c.PartSize, cos.ToSizeIEC(minColdGetPartSize, 0), cos.ToSizeIEC(maxColdGetPartSize, 0))
+	}
+	if c.NumWorkers < 2 || c.NumWorkers > maxColdGetNumWorkers {
+		return fmt.Errorf("invalid cold_get.num_workers %d (expecting range [2, %d])", c.NumWorkers, maxColdGetNumWorkers)
+	}
+	if c.Threshold < 2*c.PartSize {
+		return fmt.Errorf("invalid cold_get.threshold %s (expecting at least 2 x part_size)", c.Threshold)
+	}
+	return nil
+}
+
+//////////////
+// MmapConf //
+//////////////
+
+const maxMmapObjSize = 64 * cos.MiB
+
+func (c *MmapConf) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.MaxObjSize <= 0 || c.MaxObjSize > maxMmapObjSize {
+		return fmt.Errorf("invalid mmap.max_obj_size %s (expecting range (0, %s])", c.MaxObjSize, cos.ToSizeIEC(maxMmapObjSize, 0))
+	}
+	if c.Budget < c.MaxObjSize {
+		return fmt.Errorf("invalid mmap.budget %s (expecting at least max_obj_size %s)", c.Budget, c.MaxObjSize)
+	}
+	if c.MinHits < 1 {
+		return fmt.Errorf("invalid mmap.min_hits %d (expecting positive)", c.MinHits)
+	}
+	return nil
+}
+
+///////////////////
+// ArchIndexConf //
+///////////////////
+
+func (c *ArchIndexConf) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.MaxShards < 1 {
+		return fmt.Errorf("invalid arch_index.max_shards %d (expecting positive)", c.MaxShards)
+	}
+	if c.MaxFiles < 1 {
+		return fmt.Errorf("invalid arch_index.max_files %d (expecting positive)", c.MaxFiles)
+	}
+	return nil
+}
+
+/////////////////
+// IOSchedConf //
+/////////////////
+
+const ioschedMaxInflight = 4096
+
+func (c *IOSchedConf) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.MaxInflight < 2 || c.MaxInflight > ioschedMaxInflight {
+		return fmt.Errorf("invalid io_sched.max_inflight %d (expecting 2 through %d)", c.MaxInflight, ioschedMaxInflight)
+	}
+	for _, v := range []struct {
+		name  string
+		share int
+	}{{"read_share", c.ReadShare}, {"write_share", c.WriteShare}, {"bg_share", c.BgShare}} {
+		if v.share < 1 || v.share > 100 {
+			return fmt.Errorf("invalid io_sched.%s %d (expecting 1 through 100)", v.name, v.share)
+		}
+	}
+	return nil
+}
+
+////////////////////
+// QuarantineConf //
+////////////////////
+
+func (c *QuarantineConf) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Interval.D() < time.Second {
+		return fmt.Errorf("invalid quarantine.interval %s (expecting at least 1s)", c.Interval)
+	}
+	if c.MaxLatency <= 0 {
+		return fmt.Errorf("invalid quarantine.max_latency %s (expecting positive)", c.MaxLatency)
+	}
+	if c.MaxErrPct <= 0 || c.MaxErrPct > 100 {
+		return fmt.Errorf("invalid quarantine.max_err_pct %d (expecting range (0, 100])", c.MaxErrPct)
+	}
+	if c.MinReqs < 1 {
+		return fmt.Errorf("invalid quarantine.min_reqs %d (expecting positive)", c.MinReqs)
+	}
+	return nil
+}
+
+/////////////
+// NFSConf //
+/////////////
+
+func (c *NFSConf) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Port <= 0 || c.Port >= 65536 {
+		return fmt.Errorf("invalid nfs.port %d (expecting range (0, 65536))", c.Port)
+	}
+	for _, name := range c.ExportList() {
+		bck := Bck{Name: name, Provider: apc.AIS}
+		if err := bck.ValidateName(); err != nil {
+			return fmt.Errorf("invalid nfs.exports %q: %v", c.Exports, err)
+		}
+	}
+	return nil
+}
+
+// nil: all ais:// buckets
+func (c *NFSConf) ExportList() (out []string) {
+	for _, bck := range strings.Split(c.Exports, ",") {
+		if bck = strings.TrimSpace(bck); bck != "" {
+			out = append(out, bck)
+		}
+	}
+	return out
+}
+
+/////////////////
+// NsQuotaConf //
+/////////////////
+
+func (c *NsQuotaConf) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.MaxBuckets < 0 {
+		return fmt.Errorf("invalid ns_quota.max_buckets %d (expecting non-negative)", c.MaxBuckets)
+	}
+	if c.MaxSize < 0 {
+		return fmt.Errorf("invalid ns_quota.max_size %s (expecting non-negative)", c.MaxSize)
+	}
+	if c.Interval.D() < 10*time.Second {
+		return fmt.Errorf("invalid ns_quota.interval %s (expecting at least 10s)", c.Interval)
+	}
+	_, err := c.overrides()
+	return err
+}
+
+// returns limits that apply to a given namespace (zero - unlimited)
+func (c *NsQuotaConf) Limits(ns *Ns) (maxBuckets int, maxSize int64) {
+	maxBuckets, maxSize = c.MaxBuckets, int64(c.MaxSize)
+	if c.Overrides == "" {
+		return maxBuckets, maxSize
+	}
+	all, _ := c.overrides() // (validated)
+	if lim, ok := all[ns.Uname()]; ok {
+		if lim[0] >= 0 {
+			maxBuckets = int(lim[0])
+		}
+		if lim[1] >= 0 {
+			maxSize = lim[1]
+		}
+	}
+	return maxBuckets, maxSize
+}
+
+// whether any namespace has its size limited
+func (c *NsQuotaConf) HasMaxSize() bool {
+	if c.MaxSize > 0 {
+		return true
+	}
+	all, _ := c.overrides()
+	for _, lim := range all {
+		if lim[1] > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ns uname => [max-buckets, max-size] (-1 when not specified)
+func (c *NsQuotaConf) overrides() (map[string][2]int64, error) {
+	var all map[string][2]int64
+	for _, s := range strings.Split(c.Overrides, ",") {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+		sns, slim, ok := strings.Cut(s, "=")
+		sbcks, ssize, ok2 := strings.Cut(slim, ":")
+		if !ok || !ok2 || sns == "" {
+			return nil, fmt.Errorf("invalid ns_quota.overrides %q (expecting \"<ns>=<max-buckets>:<max-size>\")", s)
+		}
+		ns := ParseNsUname(sns)
+		if err := ns.validate(); err != nil {
+			return nil, fmt.Errorf("invalid ns_quota.overrides %q: %v", s, err)
+		}
+		lim := [2]int64{-1, -1}
+		if sbcks != "" {
+			n, err := strconv.Atoi(sbcks)
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("invalid ns_quota.overrides %q: max buckets %q", s, sbcks)
+			}
+			lim[0] = int64(n)
+		}
+		if ssize != "" {
+			n, err := cos.ParseSize(ssize, cos.UnitsIEC)
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("invalid ns_quota.overrides %q: max size %q", s, ssize)
+			}
+			lim[1] = n
+		}
+		if all == nil {
+			all = make(map[string][2]int64, 4)
+		}
+		all[ns.Uname()] = lim
+	}
+	return all, nil
 }
 
 /////////////
@@ -1062,6 +1735,9 @@ func (c *VersionConf) Validate() error {
 	if !c.Enabled && c.ValidateWarmGet {
 		return errors.New("versioning.validate_warm_get requires versioning to be enabled")
 	}
+	if c.ValidateWarmGetAsync && !c.ValidateWarmGet {
+		return errors.New("versioning.validate_warm_get_async requires versioning.validate_warm_get")
+	}
 	return nil
 }
 
@@ -1071,9 +1747,12 @@ func (c *VersionConf) String() string {
 	}
 
 	text := "Enabled | Validate on WarmGET: "
-	if c.ValidateWarmGet {
+	switch {
+	case c.ValidateWarmGet && c.ValidateWarmGetAsync:
+		text += "yes (async)"
+	case c.ValidateWarmGet:
 		text += "yes"
-	} else {
+	default:
 		text += "no"
 	}
 
@@ -1091,7 +1770,7 @@ func (c *MirrorConf) Validate() error {
 	if c.Copies < 2 || c.Copies > 32 {
 		return fmt.Errorf("invalid mirror.copies: %d (expected value in range [2, 32])", c.Copies)
 	}
-	return nil
+	return c.WriteAck.Validate()
 }
 
 func (c *MirrorConf) ValidateAsProps(...any) error {
@@ -1106,7 +1785,22 @@ func (c *MirrorConf) String() string {
 		return "Disabled"
 	}
 
-	return fmt.Sprintf("%d copies", c.Copies)
+	if c.WriteAck.IsOne() {
+		return fmt.Sprintf("%d copies", c.Copies)
+	}
+	return fmt.Sprintf("%d copies (write ack: %s)", c.Copies, c.WriteAck)
+}
+
+// number of copies (including the object itself) to write before acknowledging PUT
+func (c *MirrorConf) AckCopies() int {
+	switch c.WriteAck {
+	case apc.WriteAckAll:
+		return int(c.Copies)
+	case apc.WriteAckQuorum:
+		return int(c.Copies)/2 + 1
+	default:
+		return 1
+	}
 }
 
 ////////////
@@ -1194,20 +1888,90 @@ func (c *ECConf) RequiredRestoreTargets() int {
 func (c *WritePolicyConf) Validate() (err error) {
 	err = c.Data.Validate()
 	if err == nil {
-		if !c.Data.IsImmediate() {
+		// (data) delayed a.k.a. write-back: applies to remote buckets with offline backend - see feat.BackendOffline
+		if !c.Data.IsImmediate() && c.Data != apc.WriteDelayed {
 			return fmt.Errorf("invalid write policy for data: %q not implemented yet", c.Data)
 		}
 		err = c.MD.Validate()
 	}
+	if err == nil {
+		err = c.Fsync.Validate()
+	}
 	return
 }
 
 func (c *WritePolicyConf) ValidateAsProps(...any) error { return c.Validate() }
 
+///////////////////
+// StatsPushConf //
+///////////////////
+
+const statsPushMaxBuffer = 100_000
+
+func (c *StatsPushConf) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if len(c.CollectorURLs()) == 0 {
+		return errors.New("stats_push.collectors: expecting at least one collector URL")
+	}
+	for _, s := range c.CollectorURLs() {
+		u, err := url.Parse(s)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid stats_push.collectors URL %q (expecting http(s)://host[:port][/path])", s)
+		}
+	}
+	if c.Interval.D() < time.Second {
+		return fmt.Errorf("invalid stats_push.interval %s (expecting at least 1s)", c.Interval)
+	}
+	if c.Timeout <= 0 {
+		return fmt.Errorf("invalid stats_push.timeout %s (expecting positive)", c.Timeout)
+	}
+	if c.BatchSize < 1 {
+		return fmt.Errorf("invalid stats_push.batch_size %d (expecting positive)", c.BatchSize)
+	}
+	if c.BufferSize < c.BatchSize || c.BufferSize > statsPushMaxBuffer {
+		return fmt.Errorf("invalid stats_push.buffer_size %d (expecting %d through %d)", c.BufferSize, c.BatchSize, statsPushMaxBuffer)
+	}
+	return nil
+}
+
+func (c *StatsPushConf) CollectorURLs() (out []string) {
+	for _, s := range strings.Split(c.Collectors, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+////////////////
+// AppendConf //
+////////////////
+
+func (c *AppendConf) Validate() error {
+	if c.Window < 0 {
+		return fmt.Errorf("invalid append.window %d (expecting non-negative size)", c.Window)
+	}
+	if c.MaxInflight < 0 {
+		return fmt.Errorf("invalid append.max_inflight %d (expecting non-negative size)", c.MaxInflight)
+	}
+	if c.Window > 0 && c.MaxInflight > 0 && c.Window > c.MaxInflight {
+		return fmt.Errorf("append.window %s cannot exceed append.max_inflight %s",
+			cos.ToSizeIEC(int64(c.Window), 0), cos.ToSizeIEC(int64(c.MaxInflight), 0))
+	}
+	if c.FlushDeadline != 0 && c.FlushDeadline.D() < time.Second {
+		return fmt.Errorf("invalid append.flush_deadline %s (expecting zero (no deadline) or >= 1s)", c.FlushDeadline)
+	}
+	return nil
+}
+
 ///////////////////
 // KeepaliveConf //
 ///////////////////
 
+const maxGossipFanout = 16
+
 func (c *KeepaliveConf) Validate() (err error) {
 	if c.Proxy.Name != "heartbeat" {
 		err = fmt.Errorf("invalid keepalivetracker.proxy.name %s", c.Proxy.Name)
@@ -1215,10 +1979,25 @@ func (c *KeepaliveConf) Validate() (err error) {
 		err = fmt.Errorf("invalid keepalivetracker.target.name %s", c.Target.Name)
 	} else if c.RetryFactor < 1 || c.RetryFactor > 10 {
 		err = fmt.Errorf("invalid keepalivetracker.retry_factor %d (expecting 1 thru 10)", c.RetryFactor)
+	} else if c.Gossip.Enabled && (c.Gossip.Fanout < 1 || c.Gossip.Fanout > maxGossipFanout) {
+		err = fmt.Errorf("invalid keepalivetracker.gossip.fanout %d (expecting 1 thru %d)", c.Gossip.Fanout, maxGossipFanout)
+	} else if c.Overload.Enabled {
+		err = c.Overload.validate(c)
 	}
 	return err
 }
 
+func (c *PrimaryOverloadConf) validate(kc *KeepaliveConf) error {
+	if c.Sustain.D() < kc.Proxy.Interval.D() {
+		return fmt.Errorf("invalid keepalivetracker.primary_overload.sustain %s (expecting at least keepalivetracker.proxy.interval %s)",
+			c.Sustain, kc.Proxy.Interval)
+	}
+	if c.MsyncBacklog < 1 {
+		return fmt.Errorf("invalid keepalivetracker.primary_overload.msync_backlog %d (expecting positive)", c.MsyncBacklog)
+	}
+	return nil
+}
+
 func KeepaliveRetryDuration(c *Config) time.Duration {
 	d := c.Timeout.CplaneOperation.D() * time.Duration(c.Keepalive.RetryFactor)
 	return min(d, c.Timeout.MaxKeepalive.D()+time.Second/2)
@@ -1240,13 +2019,60 @@ func (c *NetConf) Validate() (err error) {
 		return fmt.Errorf("invalid client_auth_tls %d (expecting range [0 - %d])", c.HTTP.ClientAuthTLS,
 			tls.RequireAndVerifyClientCert)
 	}
+	return c.QoS.Validate()
+}
+
+/////////////
+// QoSConf //
+/////////////
+
+// network QoS classes (see QoSConf)
+const (
+	QoSData = iota // (default)
+	QoSControl
+	QoSEC
+	QoSRebalance
+)
+
+func (c *QoSConf) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	for i, class := range []*QoSClass{&c.Control, &c.Data, &c.EC, &c.Rebalance} {
+		if class.DSCP < 0 || class.DSCP > 63 {
+			return fmt.Errorf("invalid net.qos class %d: dscp %d (expecting range [0, 63])", i, class.DSCP)
+		}
+		if class.Priority < 0 || class.Priority > 6 {
+			return fmt.Errorf("invalid net.qos class %d: priority %d (expecting range [0, 6])", i, class.Priority)
+		}
+	}
 	return nil
 }
 
+// returns nil when QoS is disabled
+func (c *QoSConf) Class(class int) *QoSClass {
+	if !c.Enabled {
+		return nil
+	}
+	switch class {
+	case QoSControl:
+		return &c.Control
+	case QoSEC:
+		return &c.EC
+	case QoSRebalance:
+		return &c.Rebalance
+	default:
+		return &c.Data
+	}
+}
+
 func (c *HTTPConf) Validate() error {
 	if c.ServerNameTLS != "" {
 		return fmt.Errorf("invalid domain_tls %q: expecting empty (domain names/SANs should be set in X.509 cert)", c.ServerNameTLS)
 	}
+	if (c.IntraCert == "") != (c.IntraCertKey == "") {
+		return errors.New("intra_server_crt and intra_server_key must be specified together")
+	}
 	return nil
 }
 
@@ -1665,6 +2491,9 @@ func (c *RebalanceConf) Validate() error {
 	if c.SbundleMult < 0 || c.SbundleMult > 16 {
 		return fmt.Errorf("invalid rebalance.bundle_multiplier: %v (expected range [0, 16])", c.SbundleMult)
 	}
+	if j := c.RestartGrace.D(); j != 0 && (j < 10*time.Second || j > 24*time.Hour) {
+		return fmt.Errorf("invalid rebalance.restart_grace=%s (expected range [10s, 24h])", j)
+	}
 	if !apc.IsValidCompression(c.Compression) {
 		return fmt.Errorf("invalid rebalance.compression: %q (expecting one of: %v)",
 			c.Compression, apc.SupportedCompression)
@@ -1672,6 +2501,16 @@ func (c *RebalanceConf) Validate() error {
 	return nil
 }
 
+// warm restart (see apc.ActValRmNode.WarmRestart)
+const DefaultRestartGrace = 5 * time.Minute
+
+func (c *RebalanceConf) Grace() time.Duration {
+	if c.RestartGrace == 0 {
+		return DefaultRestartGrace
+	}
+	return c.RestartGrace.D()
+}
+
 func (c *RebalanceConf) String() string {
 	if c.Enabled {
 		return "Enabled"
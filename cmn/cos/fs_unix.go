@@ -26,3 +26,12 @@ func Stat(path string) error {
 	var sys syscall.Stat_t
 	return syscall.Stat(path, &sys)
 }
+
+// size and space actually allocated on disk (in bytes)
+func AllocSize(path string) (size, alloc int64, err error) {
+	var sys syscall.Stat_t
+	if err = syscall.Stat(path, &sys); err != nil {
+		return
+	}
+	return sys.Size, sys.Blocks * 512, nil
+}
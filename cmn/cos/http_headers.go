@@ -18,6 +18,11 @@ const (
 	// mozilla.org has it though, and also https://en.wikipedia.org/wiki/List_of_archive_formats
 	ContentTar = "application/x-tar"
 
+	// line-delimited JSON (https://jsonlines.org)
+	ContentJSONL = "application/jsonl"
+	// ditto (https://github.com/ndjson/ndjson-spec)
+	ContentNDJSON = "application/x-ndjson"
+
 	// not currently used
 	ContentZip = "application/zip"
 )
@@ -36,6 +41,7 @@ const (
 	HdrContentType        = "Content-Type"
 	HdrContentTypeOptions = "X-Content-Type-Options"
 	HdrContentLength      = "Content-Length"
+	HdrContentEncoding    = "Content-Encoding"
 
 	// misc. gen
 	HdrUserAgent = "User-Agent"
@@ -44,6 +50,13 @@ const (
 	HdrServer    = "Server"
 	HdrETag      = "ETag" // Ref: https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/ETag
 
+	// appended by reverse proxy (e.g., when forwarding to primary)
+	HdrForwardedFor = "X-Forwarded-For"
+
+	// conditional PUT: only "*" (create-only, aka put-if-absent) is supported
+	// Ref: https://www.rfc-editor.org/rfc/rfc9110#section-13.1.2
+	HdrIfNoneMatch = "If-None-Match"
+
 	HdrHSTS = "Strict-Transport-Security"
 )
 
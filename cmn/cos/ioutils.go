@@ -350,6 +350,19 @@ func Close(closer io.Closer) {
 	debug.AssertNoErr(err)
 }
 
+// fsync a given file or directory (by name)
+func Fsync(name string) error {
+	fh, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	err = fh.Sync()
+	if errC := fh.Close(); err == nil {
+		err = errC
+	}
+	return err
+}
+
 func FlushClose(file *os.File) (err error) {
 	err = fflush(file)
 	debug.AssertNoErr(err)
@@ -30,6 +30,7 @@ const (
 	LowMemory                                        // ditto OOM
 	DiskFault                                        // red
 	NoMountpaths                                     // red
+	DataLoss                                         // warning: rejoined with empty mountpaths, healing via rebalance
 )
 
 func (f NodeStateFlags) IsOK() bool { return f == NodeStarted|ClusterStarted }
@@ -97,6 +98,9 @@ func (f NodeStateFlags) String() string {
 	if f&DiskFault == NoMountpaths {
 		sb.WriteString("no-mountpaths,")
 	}
+	if f&DataLoss == DataLoss {
+		sb.WriteString("data-loss,")
+	}
 	s := sb.String()
 	if s == "" {
 		err := fmt.Errorf("unknown flag %b", int64(f))
@@ -58,6 +58,7 @@ type (
 		RemoteAddr string `json:"remote_addr"`
 		Caller     string `json:"caller"`
 		Node       string `json:"node"`
+		ReqID      string `json:"req_id,omitempty"` // apc.HdrReqID
 		trace      []byte
 		Status     int `json:"status"`
 	}
@@ -69,6 +70,7 @@ type (
 	ErrRemoteBckNotFound   struct{ bck Bck }
 	ErrRemoteBucketOffline struct{ bck Bck }
 	ErrBckNotFound         struct{ bck Bck }
+	ErrBckFrozen           struct{ bck Bck }
 
 	ErrBusy struct {
 		whereOrType string
@@ -109,6 +111,20 @@ type (
 	ErrGetCap struct {
 		err error
 	}
+	ErrTooLarge struct {
+		what  string
+		size  int64
+		limit int64
+	}
+	ErrBackendOffline struct {
+		what string
+		bck  Bck
+	}
+	ErrNsQuota struct {
+		what  string
+		ns    Ns
+		limit string
+	}
 
 	ErrBucketAccessDenied struct{ errAccessDenied }
 	ErrObjectAccessDenied struct{ errAccessDenied }
@@ -233,6 +249,9 @@ type (
 		ranges []string // RFC 7233
 		size   int64    // [0, size)
 	}
+	ErrObjExists struct {
+		cname string
+	}
 )
 
 var (
@@ -373,6 +392,21 @@ func IsErrBckNotFound(err error) bool {
 	return ok
 }
 
+// ErrBckFrozen (see apc.ActFreezeBck)
+
+func NewErrBckFrozen(bck *Bck) *ErrBckFrozen {
+	return &ErrBckFrozen{bck: *bck}
+}
+
+func (e *ErrBckFrozen) Error() string {
+	return fmt.Sprintf("bucket %q is frozen (no writes until thawed)", e.bck)
+}
+
+func IsErrBckFrozen(err error) bool {
+	_, ok := err.(*ErrBckFrozen)
+	return ok
+}
+
 // ErrRemoteBucketOffline
 
 func NewErrRemoteBckOffline(bck *Bck) *ErrRemoteBucketOffline {
@@ -484,6 +518,58 @@ func IsErrCapExceeded(err error) bool {
 	return ok || cos.IsErrOOS(err) // NOTE: a superset
 }
 
+// ErrTooLarge
+
+func NewErrTooLarge(what string, size, limit int64) *ErrTooLarge {
+	return &ErrTooLarge{what: what, size: size, limit: limit}
+}
+
+func (e *ErrTooLarge) Error() string {
+	if e.size < 0 { // (size unknown upfront)
+		return fmt.Sprintf("%s: size exceeds the maximum %s", e.what, cos.ToSizeIEC(e.limit, 0))
+	}
+	return fmt.Sprintf("%s: size %s exceeds the maximum %s", e.what, cos.ToSizeIEC(e.size, 0), cos.ToSizeIEC(e.limit, 0))
+}
+
+func IsErrTooLarge(err error) bool {
+	_, ok := err.(*ErrTooLarge)
+	return ok
+}
+
+// ErrNsQuota (see NsQuotaConf)
+
+func NewErrNsQuotaBuckets(ns *Ns, limit int) *ErrNsQuota {
+	return &ErrNsQuota{what: "number of buckets", ns: *ns, limit: strconv.Itoa(limit)}
+}
+
+func NewErrNsQuotaSize(ns *Ns, limit int64) *ErrNsQuota {
+	return &ErrNsQuota{what: "total size", ns: *ns, limit: cos.ToSizeIEC(limit, 2)}
+}
+
+func (e *ErrNsQuota) Error() string {
+	return fmt.Sprintf("namespace %q: %s quota exceeded (max %s)", e.ns.Uname(), e.what, e.limit)
+}
+
+func IsErrNsQuota(err error) bool {
+	_, ok := err.(*ErrNsQuota)
+	return ok
+}
+
+// ErrBackendOffline (see feat.BackendOffline)
+
+func NewErrBackendOffline(what string, bck *Bck) *ErrBackendOffline {
+	return &ErrBackendOffline{what: what, bck: *bck}
+}
+
+func (e *ErrBackendOffline) Error() string {
+	return fmt.Sprintf("%s: remote backend of the bucket %q is offline (serving in-cluster objects only)", e.what, e.bck.Cname(""))
+}
+
+func IsErrBackendOffline(err error) bool {
+	_, ok := err.(*ErrBackendOffline)
+	return ok
+}
+
 // ErrGetCap
 
 func NewErrGetCap(err error) *ErrGetCap {
@@ -906,6 +992,18 @@ func IsErrRangeNotSatisfiable(err error) bool {
 	return ok
 }
 
+// ErrObjExists: put-if-absent (If-None-Match: *)
+// http.StatusPreconditionFailed = 412 // RFC 9110, 15.5.13
+
+func NewErrObjExists(cname string) *ErrObjExists { return &ErrObjExists{cname} }
+
+func (e *ErrObjExists) Error() string { return "object " + e.cname + " already exists" }
+
+func IsErrObjExists(err error) bool {
+	_, ok := err.(*ErrObjExists)
+	return ok
+}
+
 //
 // more is-error helpers
 //
@@ -1007,6 +1105,7 @@ func (e *ErrHTTP) init(r *http.Request, err error, ecode int) {
 		e.Method, e.URLPath = r.Method, r.URL.Path
 		e.RemoteAddr = r.RemoteAddr
 		e.Caller = r.Header.Get(apc.HdrCallerName)
+		e.ReqID = r.Header.Get(apc.HdrReqID)
 	}
 	e.Node = thisNodeName
 }
@@ -1047,6 +1146,9 @@ func (e *ErrHTTP) StringEx() (s string) {
 	if e.Caller != "" {
 		s += " (called by " + e.Caller + ")"
 	}
+	if e.ReqID != "" {
+		s += " (request " + e.ReqID + ")"
+	}
 	if len(e.trace) == 0 {
 		e._trace()
 	}
@@ -1080,6 +1182,9 @@ func (e *ErrHTTP) write(w http.ResponseWriter, r *http.Request, silent bool) {
 	hdr := w.Header()
 	hdr.Set(cos.HdrContentType, cos.ContentJSON)
 	hdr.Set(cos.HdrContentTypeOptions, "nosniff")
+	if e.ReqID != "" {
+		hdr.Set(apc.HdrReqID, e.ReqID)
+	}
 
 	berr := NewBuffer()
 	e._jsonError(berr)
@@ -1172,8 +1277,12 @@ func WriteErr(w http.ResponseWriter, r *http.Request, err error, opts ...int /*[
 		switch {
 		case isErrNotFoundExtended(err, status):
 			status = http.StatusNotFound
-		case IsErrCapExceeded(err):
+		case IsErrCapExceeded(err), IsErrNsQuota(err):
 			status = http.StatusInsufficientStorage
+		case IsErrTooLarge(err):
+			status = http.StatusRequestEntityTooLarge
+		case IsErrBackendOffline(err):
+			status = http.StatusServiceUnavailable
 		case IsErrRangeNotSatisfiable(err):
 			status = http.StatusRequestedRangeNotSatisfiable
 		case isErrUnsupp(err), isErrNotImpl(err):
@@ -38,6 +38,9 @@ const (
 	StreamingColdGET          // write and transmit cold-GET content back to user in parallel, without _finalizing_ in-cluster object
 	S3ReverseProxy            // use reverse proxy calls instead of HTTP-redirect for S3 API
 	S3UsePathStyle            // use older path-style addressing (as opposed to virtual-hosted style), e.g., https://s3.amazonaws.com/BUCKET/KEY
+	LazyStartupValidation     // upon restart, join the cluster immediately and validate objects' metadata in the background
+	BackendOffline            // (*) remote backend is unreachable: serve in-cluster objects only, queue writes iff write_policy.data=delayed
+	FixConfigDrift            // primary: upon detecting config drift (periodic.config_drift_time), reset drifted nodes to cluster config
 )
 
 var Cluster = [...]string{
@@ -56,6 +59,9 @@ var Cluster = [...]string{
 	"Streaming-Cold-GET",
 	"S3-Reverse-Proxy",
 	"S3-Use-Path-Style", // https://aws.amazon.com/blogs/aws/amazon-s3-path-deprecation-plan-the-rest-of-the-story
+	"Lazy-Startup-Validation",
+	"Backend-Offline",
+	"Fix-Config-Drift",
 	// "none" ====================
 }
 
@@ -66,6 +72,7 @@ var Bucket = [...]string{
 	"Disable-Cold-GET",
 	"Streaming-Cold-GET",
 	"S3-Use-Path-Style", // https://aws.amazon.com/blogs/aws/amazon-s3-path-deprecation-plan-the-rest-of-the-story
+	"Backend-Offline",
 	// "none" ====================
 }
 
@@ -33,6 +33,15 @@ const (
 	Vmd         = ".ais.vmd"    // vmd persistent file basename
 	Emd         = ".ais.emd"    // emd persistent file basename
 
+	// proxy: election term and history (see ais/voteterm)
+	VoteTerm = ".ais.vote"
+
+	// finished xactions (see xreg.InitHistory)
+	XactHistory = ".ais.xhist"
+
+	// pending bucket event hooks (see ais/tgttrig.go)
+	Triggers = ".ais.triggers"
+
 	// CLI config
 	CliConfig = "cli.json" // see jsp/app.go
 
@@ -49,4 +58,12 @@ const (
 	RebalanceMarker     = "rebalance"
 	NodeRestartedMarker = "node_restarted"
 	NodeRestartedPrev   = "node_restarted.prev"
+	TTLMarker           = "ttl"       // objects with expiration time (cmn.ExpiresObjMD) may exist
+	DataLossMarker      = "data_loss" // rejoined with the same ID but empty (wiped, reimaged) mountpaths
+
+	// per mountpath: xaction intent journals (see xact/journal.go)
+	JournalDir = ".ais.journal"
+
+	// per mountpath: object metadata store (see fs/lmstore.go)
+	LmetaDB = ".ais.lmeta.db"
 )
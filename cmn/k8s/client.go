@@ -30,6 +30,7 @@ type (
 		Pod(name string) (*corev1.Pod, error)
 		Pods() (*corev1.PodList, error)
 		Service(name string) (*corev1.Service, error)
+		Endpoints(name string) (*corev1.Endpoints, error)
 		Node(name string) (*corev1.Node, error)
 		Logs(podName string) ([]byte, error)
 		Health(podName string) (string, error)
@@ -182,6 +183,10 @@ func (c *defaultClient) Service(name string) (*corev1.Service, error) {
 	return c.services().Get(context.Background(), name, metav1.GetOptions{})
 }
 
+func (c *defaultClient) Endpoints(name string) (*corev1.Endpoints, error) {
+	return c.client.CoreV1().Endpoints(c.namespace).Get(context.Background(), name, metav1.GetOptions{})
+}
+
 func (c *defaultClient) Node(name string) (*corev1.Node, error) {
 	return c.client.CoreV1().Nodes().Get(context.Background(), name, metav1.GetOptions{})
 }
@@ -173,3 +173,71 @@ func (bd *BuntDriver) GetAll(collection, pattern string) (map[string]string, err
 	})
 	return values, buntToCommonErr(err, collection, "")
 }
+
+// (BuntDB-specific) multi-key updates - each executed as a single transaction
+
+// Rename moves the value from one key to another; no-op when `from` does not exist
+func (bd *BuntDriver) Rename(collection, from, to string) error {
+	pfrom, pto := makePath(collection, from), makePath(collection, to)
+	err := bd.driver.Update(func(tx *buntdb.Tx) error {
+		val, err := tx.Delete(pfrom)
+		if err != nil {
+			return err
+		}
+		_, _, err = tx.Set(pto, val, nil)
+		return err
+	})
+	if err == buntdb.ErrNotFound {
+		return nil
+	}
+	return err
+}
+
+// RenamePrefix moves all values with keys that start with `from` to the keys where
+// `from` gets replaced with `to` - all or nothing
+func (bd *BuntDriver) RenamePrefix(collection, from, to string) error {
+	var (
+		pfrom = makePath(collection, from)
+		pto   = makePath(collection, to)
+	)
+	return bd.driver.Update(func(tx *buntdb.Tx) error {
+		kvs := make(map[string]string, 16)
+		err := tx.AscendKeys(pfrom+"*", func(path, val string) bool {
+			kvs[path] = val
+			return true
+		})
+		if err != nil {
+			return err
+		}
+		for path, val := range kvs {
+			if _, err := tx.Delete(path); err != nil {
+				return err
+			}
+			if _, _, err := tx.Set(pto+path[len(pfrom):], val, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DeletePrefix deletes all values with keys that start with `prefix`
+func (bd *BuntDriver) DeletePrefix(collection, prefix string) error {
+	pprefix := makePath(collection, prefix)
+	return bd.driver.Update(func(tx *buntdb.Tx) error {
+		paths := make([]string, 0, 16)
+		err := tx.AscendKeys(pprefix+"*", func(path, _ string) bool {
+			paths = append(paths, path)
+			return true
+		})
+		if err != nil {
+			return err
+		}
+		for _, path := range paths {
+			if _, err := tx.Delete(path); err != nil && err != buntdb.ErrNotFound {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,21 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+// `what=long_reqs` (apc.WhatLongReqs): long-running client requests served by a given proxy;
+// to cancel or reprioritize, see apc.ActCancelReq and apc.ActSetReqPrio, respectively
+type LongReq struct {
+	ID       string `json:"id"`     // xaction ID (e.g., list-objects UUID)
+	Action   string `json:"action"` // enum { apc.ActList, apc.ActSummaryBck, apc.ActPromote }
+	Bck      string `json:"bck"`
+	Client   string `json:"client"` // remote address
+	Proxy    string `json:"proxy"`  // node ID
+	Priority string `json:"priority"`
+	Started  int64  `json:"started,string"` // unix nanoseconds
+	Calls    int64  `json:"calls"`          // so far (e.g., list-objects pages)
+	InFlight int    `json:"in_flight"`      // currently executing calls
+	Canceled bool   `json:"canceled,omitempty"`
+}
@@ -0,0 +1,9 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+// SO_PRIORITY is Linux-only
+func setSockPrio(int, int) {}
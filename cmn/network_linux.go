@@ -0,0 +1,17 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"syscall"
+
+	"github.com/NVIDIA/aistore/cmn/debug"
+)
+
+func setSockPrio(fd, prio int) {
+	err := syscall.SetsockoptInt(fd, syscall.SOL_SOCKET, syscall.SO_PRIORITY, prio)
+	debug.AssertNoErr(err)
+}
@@ -17,12 +17,29 @@ func (args *TransportArgs) setSockOpt(_, _ string, c syscall.RawConn) (err error
 
 func (args *TransportArgs) ConnControl(_ syscall.RawConn) (cntl func(fd uintptr)) {
 	cntl = func(fd uintptr) {
-		// NOTE: is limited by /proc/sys/net/core/rmem_max
-		err := syscall.SetsockoptInt(int(fd), syscall.SOL_SOCKET, syscall.SO_RCVBUF, args.SndRcvBufSize)
-		debug.AssertNoErr(err)
-		// NOTE: is limited by /proc/sys/net/core/wmem_max
-		err = syscall.SetsockoptInt(int(fd), syscall.SOL_SOCKET, syscall.SO_SNDBUF, args.SndRcvBufSize)
-		debug.AssertNoErr(err)
+		if args.SndRcvBufSize > 0 {
+			// NOTE: is limited by /proc/sys/net/core/rmem_max
+			err := syscall.SetsockoptInt(int(fd), syscall.SOL_SOCKET, syscall.SO_RCVBUF, args.SndRcvBufSize)
+			debug.AssertNoErr(err)
+			// NOTE: is limited by /proc/sys/net/core/wmem_max
+			err = syscall.SetsockoptInt(int(fd), syscall.SOL_SOCKET, syscall.SO_SNDBUF, args.SndRcvBufSize)
+			debug.AssertNoErr(err)
+		}
+		if args.QoS != nil {
+			setQoS(int(fd), args.QoS)
+		}
 	}
 	return
 }
+
+// DSCP occupies the upper six bits of the (former) TOS byte; the socket is either
+// IPv4 or IPv6 - hence, ignoring the error of the "other" option
+func setQoS(fd int, qos *QoSClass) {
+	tos := qos.DSCP << 2
+	err4 := syscall.SetsockoptInt(fd, syscall.IPPROTO_IP, syscall.IP_TOS, tos)
+	err6 := syscall.SetsockoptInt(fd, syscall.IPPROTO_IPV6, syscall.IPV6_TCLASS, tos)
+	debug.Assert(err4 == nil || err6 == nil, err4, err6)
+	if qos.Priority > 0 {
+		setSockPrio(fd, qos.Priority)
+	}
+}
@@ -0,0 +1,21 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+// `what=ns_usage` (apc.WhatNsUsage): ais:// buckets per namespace vs configured limits (see NsQuotaConf)
+type (
+	NsUsage struct {
+		Ns         string `json:"ns"` // namespace uname (e.g. "@#" - global)
+		Buckets    int    `json:"buckets"`
+		Size       uint64 `json:"size,string"`     // as of the most recent refresh (zero when not limited)
+		MaxBuckets int    `json:"max_buckets"`     // zero - unlimited
+		MaxSize    int64  `json:"max_size,string"` // ditto
+	}
+	NsUsageList []*NsUsage
+
+	// (internal) target's size on disk of ais:// buckets, by namespace uname
+	NsSizes map[string]uint64
+)
@@ -35,6 +35,20 @@ const (
 
 	OrigURLObjMD = "orig_url"
 
+	// object expiration time (unix nanoseconds) - PUT with apc.HdrObjTTL or apc.QparamTTL
+	ExpiresObjMD = "expires"
+
+	// written while the remote backend was offline and not yet uploaded (write-back)
+	// see also: feat.BackendOffline, write_policy.data
+	PendingPutObjMD = "pending_put"
+
+	// link (alias) object: the object it points to, e.g. "ais://@remais#ns/bucket/name" (apc.ActLinkObj)
+	LinkObjMD = "link"
+
+	// tombstone: zero-size object that marks the object deleted from a copy-on-write clone
+	// (so that the source's object does not show through); see apc.ActCloneBck
+	TombstoneObjMD = "tombstone"
+
 	// additional backend
 	LastModified = "LastModified"
 )
@@ -73,6 +73,13 @@ func (be *LsoEnt) IsVerChanged() bool { return be.Flags&apc.EntryVerChanged != 0
 func (be *LsoEnt) SetVerRemoved()     { be.Flags |= apc.EntryVerRemoved }
 func (be *LsoEnt) IsVerRemoved() bool { return be.Flags&apc.EntryVerRemoved != 0 }
 
+// see also: feat.BackendOffline
+func (be *LsoEnt) SetOffline()     { be.Flags |= apc.EntryOffline }
+func (be *LsoEnt) IsOffline() bool { return be.Flags&apc.EntryOffline != 0 }
+
+// see also: apc.ActCloneBck
+func (be *LsoEnt) IsTombstone() bool { return be.Flags&apc.EntryTombstone != 0 }
+
 func (be *LsoEnt) IsStatusOK() bool   { return be.Status() == 0 }
 func (be *LsoEnt) Status() uint16     { return be.Flags & apc.EntryStatusMask }
 func (be *LsoEnt) IsDir() bool        { return be.Flags&apc.EntryIsDir != 0 }
@@ -253,3 +260,62 @@ func HandleNoRecurs(prefix, relPath string) (*LsoEnt, error) {
 	}
 	return &LsoEnt{Name: relPath, Flags: apc.EntryIsDir}, nil
 }
+
+/////////////
+// LsoSumm //
+/////////////
+
+// list-objects in summary mode (apc.LsSummary): number of objects and their total size
+// per (virtual) subdirectory
+type (
+	LsoSumm struct {
+		Prefix string `json:"prefix"`
+		Count  int64  `json:"count,string"`
+		Size   int64  `json:"size,string"`
+	}
+	LsoSumms   []*LsoSumm
+	LsoSummMap map[string]*LsoSumm // by prefix
+)
+
+// returns the prefix under which a given object is summarized:
+// the listed prefix followed by up to `depth` '/'-terminated components of the object name
+// (objects located higher than that are accounted for in their respective parent directories)
+func LsoSummPrefix(objName, prefix string, depth int) string {
+	debug.Assert(strings.HasPrefix(objName, prefix), objName, " vs ", prefix)
+	var (
+		rest = objName[len(prefix):]
+		n    int
+	)
+	for ; depth > 0; depth-- {
+		i := strings.IndexByte(rest[n:], '/')
+		if i < 0 {
+			break
+		}
+		n += i + 1
+	}
+	return prefix + rest[:n]
+}
+
+func (m LsoSummMap) Add(prefix string, count, size int64) {
+	if e, ok := m[prefix]; ok {
+		e.Count += count
+		e.Size += size
+		return
+	}
+	m[prefix] = &LsoSumm{Prefix: prefix, Count: count, Size: size}
+}
+
+func (m LsoSummMap) Merge(summs LsoSumms) {
+	for _, e := range summs {
+		m.Add(e.Prefix, e.Count, e.Size)
+	}
+}
+
+func (m LsoSummMap) Sorted() LsoSumms {
+	summs := make(LsoSumms, 0, len(m))
+	for _, e := range m {
+		summs = append(summs, e)
+	}
+	sort.Slice(summs, func(i, j int) bool { return summs[i].Prefix < summs[j].Prefix })
+	return summs
+}
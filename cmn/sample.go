@@ -0,0 +1,65 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import "github.com/NVIDIA/aistore/api/apc"
+
+// result of the server-side sampling (apc.ActSampleBck, apc.SampleMsg)
+type SampleRes struct {
+	Labels   map[string]int64 `json:"labels,omitempty"` // ETL: label => number of sampled objects
+	Objects  int64            `json:"objects,string"`   // number of (in-cluster) objects the sample was drawn from
+	Sampled  int64            `json:"sampled,string"`   // sample size
+	Size     int64            `json:"size,string"`      // total size of the sampled objects
+	MinSize  int64            `json:"min_size,string"`  // ditto, min
+	MaxSize  int64            `json:"max_size,string"`  // ditto, max
+	MeanSize int64            `json:"mean_size,string"` // ditto, mean
+	Parsed   int64            `json:"parsed,string"`    // loaded or, with ETL, transformed and parsed successfully
+	Failed   int64            `json:"failed,string"`    // failed to load, transform, or parse
+	Err      string           `json:"error,omitempty"`  // first failure, if any
+}
+
+func (res *SampleRes) AddSize(size int64) {
+	if res.Sampled == 0 || size < res.MinSize {
+		res.MinSize = size
+	}
+	res.MaxSize = max(res.MaxSize, size)
+	res.Size += size
+	res.Sampled++
+}
+
+func (res *SampleRes) AddLabel(label string, n int64) {
+	if res.Labels == nil {
+		res.Labels = make(map[string]int64, 16)
+	}
+	if _, ok := res.Labels[label]; !ok && len(res.Labels) >= apc.SampleMaxLabels {
+		label = apc.SampleLabelOther
+	}
+	res.Labels[label] += n
+}
+
+// (proxy) aggregate targets' results
+func (res *SampleRes) Merge(other *SampleRes) {
+	if other.Sampled > 0 {
+		if res.Sampled == 0 || other.MinSize < res.MinSize {
+			res.MinSize = other.MinSize
+		}
+		res.MaxSize = max(res.MaxSize, other.MaxSize)
+	}
+	res.Objects += other.Objects
+	res.Sampled += other.Sampled
+	res.Size += other.Size
+	res.Parsed += other.Parsed
+	res.Failed += other.Failed
+	if res.Err == "" {
+		res.Err = other.Err
+	}
+	for label, n := range other.Labels {
+		res.AddLabel(label, n)
+	}
+	if res.Sampled > 0 {
+		res.MeanSize = res.Size / res.Sampled
+	}
+}
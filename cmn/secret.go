@@ -0,0 +1,96 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+)
+
+// Per-bucket cloud credentials (see ExtraProps) are stored in BMD sealed:
+// - AES-256-GCM with the key derived from the cluster-wide `auth.secret`
+// - sealing is done once, by the primary, upon create-bucket or set-bucket-props
+// - targets unseal when instantiating backend clients
+// NOTE: changing `auth.secret` makes previously sealed credentials unusable - they
+// must be set again.
+
+const sealedPrefix = "sealed:"
+
+var errNoSealKey = errors.New("cannot seal (or unseal) bucket credentials: cluster config 'auth.secret' is empty")
+
+func IsSealed(s string) bool { return strings.HasPrefix(s, sealedPrefix) }
+
+func _gcm(key string) (cipher.AEAD, error) {
+	if key == "" {
+		return nil, errNoSealKey
+	}
+	k := sha256.Sum256([]byte(key))
+	block, err := aes.NewCipher(k[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func SealSecret(plain, key string) (string, error) {
+	if plain == "" || IsSealed(plain) {
+		return plain, nil
+	}
+	gcm, err := _gcm(key)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	b := gcm.Seal(nonce, nonce, []byte(plain), nil)
+	return sealedPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func UnsealSecret(sealed, key string) (string, error) {
+	if sealed == "" {
+		return "", nil
+	}
+	if !IsSealed(sealed) {
+		return "", errors.New("bucket credentials are not sealed")
+	}
+	gcm, err := _gcm(key)
+	if err != nil {
+		return "", err
+	}
+	b, err := base64.RawURLEncoding.DecodeString(sealed[len(sealedPrefix):])
+	if err != nil {
+		return "", err
+	}
+	if len(b) < gcm.NonceSize() {
+		return "", errors.New("invalid sealed bucket credentials")
+	}
+	plain, err := gcm.Open(nil, b[:gcm.NonceSize()], b[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", errors.New("failed to unseal bucket credentials (auth.secret changed?)")
+	}
+	return string(plain), nil
+}
+
+// seal all (not yet sealed) secrets
+func (c *ExtraProps) Seal(key string) (err error) {
+	for _, s := range []*string{&c.AWS.SecretAccessKey, &c.GCP.Credentials, &c.Azure.AccountKey} {
+		if *s, err = SealSecret(*s, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *ExtraProps) HasCreds() bool {
+	return c.AWS.AccessKeyID != "" || c.GCP.Credentials != "" || c.Azure.AccountName != ""
+}
@@ -5,8 +5,11 @@
 package tests_test
 
 import (
+	"time"
+
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -184,4 +187,89 @@ var _ = Describe("API", func() {
 			),
 		)
 	})
+
+	Describe("NewBpropsToSet", func() {
+		DescribeTable("should parse and apply",
+			func(nvs map[string]string, check func(bp *cmn.Bprops)) {
+				props, err := cmn.NewBpropsToSet(nvs)
+				Expect(err).NotTo(HaveOccurred())
+				bp := &cmn.Bprops{}
+				bp.Apply(props)
+				check(bp)
+			},
+			Entry("triggers",
+				map[string]string{
+					"triggers": `[{"name": "a", "event": "put", "pattern": "*.tar", "action": "dsort", "arg": "tmpl"}]`,
+				},
+				func(bp *cmn.Bprops) {
+					Expect(bp.Triggers).To(HaveLen(1))
+					Expect(bp.Triggers.Get("a").Arg).To(Equal("tmpl"))
+				},
+			),
+			Entry("access log config",
+				map[string]string{
+					"access_log.enabled":  "true",
+					"access_log.to_bck":   "ais://logs",
+					"access_log.interval": "1m",
+				},
+				func(bp *cmn.Bprops) {
+					Expect(bp.AccessLog.Enabled).To(BeTrue())
+					Expect(bp.AccessLog.Ival()).To(Equal(time.Minute))
+					bck, err := bp.AccessLog.DstBck()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(bck.Equal(&cmn.Bck{Name: "logs", Provider: apc.AIS})).To(BeTrue())
+				},
+			),
+			Entry("payload restrictions",
+				map[string]string{
+					"payload.max_object_size": "1MiB",
+					"payload.content_types":   "image/*, application/x-tar",
+				},
+				func(bp *cmn.Bprops) {
+					Expect(int64(bp.Payload.MaxObjSize)).To(Equal(int64(cos.MiB)))
+
+					Expect(bp.Payload.CheckSize("obj", cos.MiB)).NotTo(HaveOccurred())
+					err := bp.Payload.CheckSize("obj", cos.MiB+1)
+					Expect(cmn.IsErrTooLarge(err)).To(BeTrue())
+
+					Expect(bp.Payload.CheckContentType("obj", "image/jpeg")).NotTo(HaveOccurred())
+					Expect(bp.Payload.CheckContentType("obj", "Application/X-Tar; charset=binary")).NotTo(HaveOccurred())
+					Expect(bp.Payload.CheckContentType("obj", "application/json")).To(HaveOccurred())
+					Expect(bp.Payload.CheckContentType("obj", "")).To(HaveOccurred())
+				},
+			),
+			Entry("object naming rules",
+				map[string]string{
+					"naming.max_length": "16",
+					"naming.charset":    "a-z0-9._/-",
+					"naming.prefix":     "(train|val)/",
+				},
+				func(bp *cmn.Bprops) {
+					Expect(bp.Naming.MaxLength).To(Equal(16))
+
+					Expect(bp.Naming.Check("obj", "train/a-1.tar")).NotTo(HaveOccurred())
+					Expect(bp.Naming.Check("obj", "val/b_2.tar")).NotTo(HaveOccurred())
+					Expect(bp.Naming.Check("obj", "train/aaaaaaaaaaaa")).To(HaveOccurred()) // too long
+					Expect(bp.Naming.Check("obj", "train/A.tar")).To(HaveOccurred())        // charset
+					Expect(bp.Naming.Check("obj", "test/a.tar")).To(HaveOccurred())         // prefix
+					Expect(bp.Naming.Check("obj", "x/train/a.tar")).To(HaveOccurred())      // (anchored)
+				},
+			),
+		)
+	})
+
+	Describe("Triggers", func() {
+		DescribeTable("should match objects",
+			func(pattern, objName string, match bool) {
+				tc := &cmn.TriggerConf{Name: "a", Event: apc.TriggerPut, Pattern: pattern}
+				Expect(tc.Match(apc.TriggerPut, objName)).To(Equal(match))
+				Expect(tc.Match(apc.TriggerDelete, objName)).To(BeFalse())
+			},
+			Entry("any", "", "a/b/c.tar", true),
+			Entry("basename", "*.tar", "a/b/c.tar", true),
+			Entry("basename mismatch", "*.tar", "a/b/c.tgz", false),
+			Entry("full name", "a/*/*.tar", "a/b/c.tar", true),
+			Entry("full name mismatch", "a/*.tar", "a/b/c.tar", false),
+		)
+	})
 })
@@ -0,0 +1,37 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests_test
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestBpropsDiff(t *testing.T) {
+	prev := &cmn.Bprops{
+		Provider: apc.AIS,
+		Cksum:    cmn.CksumConf{Type: cos.ChecksumXXHash},
+		Mirror:   cmn.MirrorConf{Copies: 2},
+	}
+	nprops := *prev
+	nprops.Cksum.Type = cos.ChecksumNone
+	nprops.Mirror.Enabled = true
+
+	diff := nprops.DiffFrom(prev)
+	tassert.Fatalf(t, len(diff) == 2, "expected 2 changed props, got %v", diff)
+	d, ok := diff["checksum.type"]
+	tassert.Fatalf(t, ok, "expected checksum.type in %v", diff)
+	tassert.Errorf(t, d.Old == cos.ChecksumXXHash && d.New == cos.ChecksumNone, "unexpected checksum.type diff %+v", d)
+	d, ok = diff["mirror.enabled"]
+	tassert.Fatalf(t, ok, "expected mirror.enabled in %v", diff)
+	tassert.Errorf(t, d.Old == "false" && d.New == "true", "unexpected mirror.enabled diff %+v", d)
+
+	diff = prev.DiffFrom(prev)
+	tassert.Errorf(t, len(diff) == 0, "expected no changes, got %v", diff)
+}
@@ -0,0 +1,34 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests_test
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestMergeChanges(t *testing.T) {
+	ev := func(seq int64, name string) *cmn.ChangeEvent {
+		return &cmn.ChangeEvent{Seq: seq, Op: apc.ChangePut, ObjName: name}
+	}
+	feeds := []*cmn.ChangeFeed{
+		{Events: []*cmn.ChangeEvent{ev(10, "a"), ev(30, "c"), ev(50, "e")}, Next: 60},
+		{Events: []*cmn.ChangeEvent{ev(20, "b"), ev(40, "d")}, Next: 40}, // e.g., truncated page
+		{Next: 70},
+	}
+	out := cmn.MergeChanges(feeds)
+	tassert.Errorf(t, out.Next == 40, "expected next 40, got %d", out.Next)
+	tassert.Fatalf(t, len(out.Events) == 4, "expected 4 events, got %d", len(out.Events))
+	for i, name := range []string{"a", "b", "c", "d"} {
+		tassert.Errorf(t, out.Events[i].ObjName == name, "event %d: expected %q, got %q", i, name, out.Events[i].ObjName)
+	}
+
+	out = cmn.MergeChanges([]*cmn.ChangeFeed{{Next: 100}, {Next: 90}})
+	tassert.Errorf(t, out.Next == 90 && len(out.Events) == 0, "expected no events and next 90, got %d, %d",
+		len(out.Events), out.Next)
+}
@@ -8,9 +8,11 @@ import (
 	"path/filepath"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/jsp"
 	"github.com/NVIDIA/aistore/tools/tassert"
 )
@@ -72,3 +74,44 @@ func TestValidateMpath(t *testing.T) {
 		}
 	}
 }
+
+func TestConfigDrift(t *testing.T) {
+	var (
+		auth = cmn.ClusterConfig{Version: 3}
+		live cmn.ClusterConfig
+	)
+	auth.Timeout.MaxKeepalive = cos.Duration(4 * time.Second)
+	auth.Auth.Secret = "secret"
+	live = auth
+	live.Auth.Secret = "**********" // (hidden)
+	live.LastUpdated = "now"
+	if keys := live.DriftFrom(&auth); len(keys) != 0 {
+		t.Fatalf("expected no drift, got %v", keys)
+	}
+
+	live.Timeout.MaxKeepalive = cos.Duration(8 * time.Second)
+	keys := live.DriftFrom(&auth)
+	tassert.Fatalf(t, len(keys) == 1, "expected one drifted key, got %v", keys)
+	k, ok := keys["timeout.max_keepalive"]
+	tassert.Fatalf(t, ok, "expected timeout.max_keepalive, got %v", keys)
+	tassert.Errorf(t, k.Node == "8s" && k.Cluster == "4s", "unexpected values %+v", k)
+}
+
+func TestMirrorWriteAck(t *testing.T) {
+	mconfig := cmn.MirrorConf{Enabled: true, Copies: 3}
+	for _, tc := range []struct {
+		ack    apc.WriteAck
+		copies int
+	}{
+		{apc.WriteAckDefault, 1},
+		{apc.WriteAckOne, 1},
+		{apc.WriteAckQuorum, 2},
+		{apc.WriteAckAll, 3},
+	} {
+		mconfig.WriteAck = tc.ack
+		tassert.CheckFatal(t, mconfig.Validate())
+		tassert.Errorf(t, mconfig.AckCopies() == tc.copies, "%q: expected %d copies, got %d", tc.ack, tc.copies, mconfig.AckCopies())
+	}
+	mconfig.WriteAck = "majority"
+	tassert.Errorf(t, mconfig.Validate() != nil, "expected invalid write ack %q", mconfig.WriteAck)
+}
@@ -68,6 +68,10 @@ var _ = Describe("IterFields", func() {
 					"mirror.enabled":      false,
 					"mirror.copies":       int64(0),
 					"mirror.burst_buffer": 0,
+					"mirror.write_ack":    apc.WriteAck(""),
+
+					"pin.enabled": false,
+					"pin.targets": "",
 
 					"ec.enabled":           true,
 					"ec.parity_slices":     1024,
@@ -91,17 +95,39 @@ var _ = Describe("IterFields", func() {
 					"lru.dont_evict_time":   cos.Duration(0),
 					"lru.capacity_upd_time": cos.Duration(0),
 
-					"extra.aws.cloud_region": "us-central",
-					"extra.aws.endpoint":     "",
-					"extra.aws.profile":      "",
-					"extra.aws.max_pagesize": int64(0),
+					"extra.aws.cloud_region":      "us-central",
+					"extra.aws.endpoint":          "",
+					"extra.aws.profile":           "",
+					"extra.aws.max_pagesize":      int64(0),
+					"extra.aws.access_key_id":     "",
+					"extra.aws.secret_access_key": "",
 
 					"access":   apc.AccessAttrs(0),
 					"features": feat.Flags(0),
 					"created":  int64(0),
+					"frozen":   int64(0),
+
+					"write_policy.data":  apc.WritePolicy(""),
+					"write_policy.md":    apc.WritePolicy(""),
+					"write_policy.fsync": apc.FsyncPolicy(""),
+
+					"access_log.enabled":  false,
+					"access_log.to_bck":   "",
+					"access_log.prefix":   "",
+					"access_log.interval": cos.Duration(0),
+
+					"payload.content_types":   "",
+					"payload.max_object_size": cos.SizeIEC(0),
 
-					"write_policy.data": apc.WritePolicy(""),
-					"write_policy.md":   apc.WritePolicy(""),
+					"etl.name": "",
+
+					"naming.charset":    "",
+					"naming.prefix":     "",
+					"naming.max_length": 0,
+
+					"admission.max_object_size": cos.SizeIEC(0),
+					"admission.min_hits":        0,
+					"admission.window":          cos.Duration(0),
 				},
 			),
 			Entry("list BpropsToSet fields",
@@ -127,6 +153,10 @@ var _ = Describe("IterFields", func() {
 					"mirror.enabled":      (*bool)(nil),
 					"mirror.copies":       (*int64)(nil),
 					"mirror.burst_buffer": (*int)(nil),
+					"mirror.write_ack":    (*apc.WriteAck)(nil),
+
+					"pin.enabled": (*bool)(nil),
+					"pin.targets": (*string)(nil),
 
 					"ec.enabled":           apc.Ptr(true),
 					"ec.parity_slices":     apc.Ptr(1024),
@@ -153,15 +183,46 @@ var _ = Describe("IterFields", func() {
 					"access":   apc.Ptr[apc.AccessAttrs](1024),
 					"features": apc.Ptr[feat.Flags](1024),
 
-					"write_policy.data": (*apc.WritePolicy)(nil),
-					"write_policy.md":   apc.Ptr(apc.WriteDelayed),
-
-					"extra.hdfs.ref_directory": (*string)(nil),
-					"extra.aws.cloud_region":   (*string)(nil),
-					"extra.aws.endpoint":       (*string)(nil),
-					"extra.aws.profile":        (*string)(nil),
-					"extra.aws.max_pagesize":   (*int64)(nil),
-					"extra.http.original_url":  (*string)(nil),
+					"write_policy.data":  (*apc.WritePolicy)(nil),
+					"write_policy.md":    apc.Ptr(apc.WriteDelayed),
+					"write_policy.fsync": (*apc.FsyncPolicy)(nil),
+
+					"extra.hdfs.ref_directory":    (*string)(nil),
+					"extra.aws.cloud_region":      (*string)(nil),
+					"extra.aws.endpoint":          (*string)(nil),
+					"extra.aws.profile":           (*string)(nil),
+					"extra.aws.max_pagesize":      (*int64)(nil),
+					"extra.aws.access_key_id":     (*string)(nil),
+					"extra.aws.secret_access_key": (*string)(nil),
+					"extra.gcp.project_id":        (*string)(nil),
+					"extra.gcp.credentials":       (*string)(nil),
+					"extra.gcp.user_project":      (*string)(nil),
+					"extra.gcp.kms_key_name":      (*string)(nil),
+					"extra.azure.account_name":    (*string)(nil),
+					"extra.azure.account_key":     (*string)(nil),
+					"extra.azure.endpoint":        (*string)(nil),
+					"extra.http.original_url":     (*string)(nil),
+
+					"triggers": (*cmn.Triggers)(nil),
+					"cors":     (*cmn.CORSRules)(nil),
+
+					"access_log.enabled":  (*bool)(nil),
+					"access_log.to_bck":   (*string)(nil),
+					"access_log.prefix":   (*string)(nil),
+					"access_log.interval": (*cos.Duration)(nil),
+
+					"payload.content_types":   (*string)(nil),
+					"payload.max_object_size": (*cos.SizeIEC)(nil),
+
+					"etl.name": (*string)(nil),
+
+					"naming.charset":    (*string)(nil),
+					"naming.prefix":     (*string)(nil),
+					"naming.max_length": (*int)(nil),
+
+					"admission.max_object_size": (*cos.SizeIEC)(nil),
+					"admission.min_hits":        (*int)(nil),
+					"admission.window":          (*cos.Duration)(nil),
 				},
 			),
 			Entry("check for omit tag",
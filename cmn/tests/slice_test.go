@@ -67,3 +67,33 @@ func discardFirstEntries(entries cmn.LsoEntries, n int) cmn.LsoEntries {
 
 	return entries[:len(entries)-toDiscard]
 }
+
+func TestLsoSummPrefix(t *testing.T) {
+	tests := []struct {
+		name, prefix string
+		depth        int
+		expected     string
+	}{
+		{"a/b/c.txt", "", 0, ""},
+		{"a/b/c.txt", "", 1, "a/"},
+		{"a/b/c.txt", "", 2, "a/b/"},
+		{"a/b/c.txt", "", 5, "a/b/"},
+		{"c.txt", "", 1, ""},
+		{"a/b/c.txt", "a/", 1, "a/b/"},
+		{"a/c.txt", "a/", 1, "a/"},
+		{"ab/c/d", "a", 1, "ab/"},
+	}
+	for _, test := range tests {
+		prefix := cmn.LsoSummPrefix(test.name, test.prefix, test.depth)
+		tassert.Errorf(t, prefix == test.expected, "%q (prefix %q, depth %d): expected %q, got %q",
+			test.name, test.prefix, test.depth, test.expected, prefix)
+	}
+
+	m := make(cmn.LsoSummMap)
+	m.Merge(cmn.LsoSumms{{Prefix: "b/", Count: 1, Size: 10}, {Prefix: "a/", Count: 2, Size: 20}})
+	m.Merge(cmn.LsoSumms{{Prefix: "a/", Count: 3, Size: 30}})
+	summs := m.Sorted()
+	tassert.Fatalf(t, len(summs) == 2, "expected 2 prefixes, got %d", len(summs))
+	tassert.Errorf(t, summs[0].Prefix == "a/" && summs[0].Count == 5 && summs[0].Size == 50, "unexpected %+v", summs[0])
+	tassert.Errorf(t, summs[1].Prefix == "b/" && summs[1].Count == 1 && summs[1].Size == 10, "unexpected %+v", summs[1])
+}
@@ -0,0 +1,32 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+// `what=quarantine` (apc.WhatQuarantine): targets that a given proxy does not redirect GETs to,
+// and the most recent quarantine (and restore) events (see QuarantineConf)
+type (
+	QuarantinedTarget struct {
+		ID     string `json:"id"`
+		Reason string `json:"reason"`
+		Since  int64  `json:"since,string"` // unix nanoseconds
+	}
+	QuarantineEvent struct {
+		Target string `json:"target"`
+		Event  string `json:"event"` // enum { QuarantineEvQuarantine, QuarantineEvRestore }
+		Reason string `json:"reason"`
+		Time   int64  `json:"time,string"` // unix nanoseconds
+	}
+	Quarantine struct {
+		Proxy   string               `json:"proxy"` // node ID
+		Targets []*QuarantinedTarget `json:"targets"`
+		Events  []*QuarantineEvent   `json:"events"` // oldest first
+	}
+)
+
+const (
+	QuarantineEvQuarantine = "quarantine"
+	QuarantineEvRestore    = "restore"
+)
@@ -74,6 +74,37 @@ func UncacheBck(b *meta.Bck) {
 	wg.Wait()
 }
 
+// flush dirty (delayed) metadata and access times of the bucket's cached objects, and evict
+// the latter from the cache (see apc.ActFreezeBck)
+func FlushBck(b *meta.Bck) {
+	var (
+		caches = lomCaches()
+		n      = max(sys.NumCPU()/4, 4)
+		wg     = cos.NewLimitedWaitGroup(n, len(caches))
+	)
+	for _, lcache := range caches {
+		wg.Add(1)
+		go func(cache *sync.Map) {
+			cache.Range(func(hkey, value any) bool {
+				md := value.(*lmeta)
+				bck, _ := cmn.ParseUname(*md.uname)
+				if !bck.Equal((*cmn.Bck)(b)) {
+					return true
+				}
+				if atime := md.Atime; atime < 0 {
+					flushLmeta(md, time.Unix(0, -atime)) // prefetched, not yet accessed
+				} else if md.isDirty() || md.atimefs != uint64(atime) {
+					flushLmeta(md, time.Unix(0, atime))
+				}
+				cache.Delete(hkey)
+				return true
+			})
+			wg.Done()
+		}(lcache)
+	}
+	wg.Wait()
+}
+
 // NOTE: watch https://github.com/golang/go/pull/61702 for `sync.Map.Clear`, likely Go 22
 func UncacheMountpath(mi *fs.Mountpath) {
 	for idx := range cos.MultiSyncMapCount {
@@ -199,13 +230,20 @@ func (lchk *lchk) frun(hkey, value any) bool {
 }
 
 func (lchk *lchk) flush(md *lmeta, atime time.Time) {
+	if flushLmeta(md, atime) {
+		lchk.flushColdCnt++
+	}
+}
+
+func flushLmeta(md *lmeta, atime time.Time) bool {
 	lif := LIF{uname: *md.uname, lid: md.lid}
 	lom, err := lif.LOM()
-	if err == nil {
-		lom.Lock(true)
-		lom.flushCold(md, atime)
-		lom.Unlock(true)
-		FreeLOM(lom)
-		lchk.flushColdCnt++
+	if err != nil {
+		return false
 	}
+	lom.Lock(true)
+	lom.flushCold(md, atime)
+	lom.Unlock(true)
+	FreeLOM(lom)
+	return true
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/ios"
 )
 
 //
@@ -78,10 +79,13 @@ func (lom *LOM) AddCopy(copyFQN string, mpi *fs.Mountpath) error {
 func (lom *LOM) DelCopies(copiesFQN ...string) (err error) {
 	numCopies := lom.NumCopies()
 	// 1. Delete all copies from the metadata
-	for _, copyFQN := range copiesFQN {
-		if _, ok := lom.md.copies[copyFQN]; !ok {
+	mis := make([]*fs.Mountpath, len(copiesFQN))
+	for i, copyFQN := range copiesFQN {
+		mi, ok := lom.md.copies[copyFQN]
+		if !ok {
 			return fmt.Errorf("lom %s(num: %d): copy %s does not exist", lom, numCopies, copyFQN)
 		}
+		mis[i] = mi
 		lom.delCopyMd(copyFQN)
 	}
 
@@ -92,10 +96,9 @@ func (lom *LOM) DelCopies(copiesFQN ...string) (err error) {
 	}
 
 	// 3. Remove the copies
-	for _, copyFQN := range copiesFQN {
-		if err1 := cos.RemoveFile(copyFQN); err1 != nil {
+	for i, copyFQN := range copiesFQN {
+		if err1 := mis[i].Remove(copyFQN); err1 != nil {
 			nlog.Errorln(err1) // TODO: LRU should take care of that later.
-			continue
 		}
 	}
 	return
@@ -124,7 +127,7 @@ func (lom *LOM) DelExtraCopies(fqn ...string) (removed bool, err error) {
 		if _, ok := lom.md.copies[copyFQN]; ok {
 			continue
 		}
-		if err1 := cos.RemoveFile(copyFQN); err1 != nil {
+		if err1 := mi.Remove(copyFQN); err1 != nil {
 			err = err1
 			continue
 		}
@@ -328,7 +331,7 @@ func (lom *LOM) copy2fqn(dst *LOM, buf []byte) (err error) {
 		lom.md.copies[lom.FQN], dst.md.copies[lom.FQN] = lom.mi, lom.mi
 		if err = lom.syncMetaWithCopies(); err != nil {
 			if _, ok := lom.md.copies[dst.FQN]; !ok {
-				if errRemove := os.Remove(dst.FQN); errRemove != nil && !os.IsNotExist(errRemove) {
+				if errRemove := dst.mi.Remove(dst.FQN); errRemove != nil {
 					nlog.Errorln("nested err:", errRemove)
 				}
 			}
@@ -340,7 +343,7 @@ func (lom *LOM) copy2fqn(dst *LOM, buf []byte) (err error) {
 		}
 		err = lom.Persist()
 	} else if err = dst.Persist(); err != nil {
-		if errRemove := os.Remove(dst.FQN); errRemove != nil && !os.IsNotExist(errRemove) {
+		if errRemove := dst.mi.Remove(dst.FQN); errRemove != nil {
 			nlog.Errorln("nested err:", errRemove)
 		}
 	}
@@ -375,24 +378,44 @@ func (lom *LOM) leastUtilCopy() (fqn string) {
 }
 
 // returns the least utilized mountpath that does _not_ have a copy of this `lom` yet
-// (compare with leastUtilCopy())
+// (compare with leastUtilCopy());
+// with labeled mountpaths (ios.Label), prefer labels (e.g., enclosures or controllers)
+// that do not hold a copy either - to place copies in distinct (local) failure domains
 func (lom *LOM) LeastUtilNoCopy() (mi *fs.Mountpath) {
 	var (
 		avail      = fs.GetAvail()
 		mpathUtils = fs.GetAllMpathUtils()
 		minUtil    = int64(101) // to motivate the first assignment
+		distinct   bool
 	)
 	for mpath, mpathInfo := range avail {
 		if lom.haveMpath(mpath) || mpathInfo.IsAnySet(fs.FlagWaitingDD) {
 			continue
 		}
-		if util := mpathUtils.Get(mpath); util < minUtil {
+		util := mpathUtils.Get(mpath)
+		dl := !mpathInfo.Label.IsNil() && !lom.haveLabel(mpathInfo.Label)
+		switch {
+		case dl && !distinct:
+			minUtil, mi, distinct = util, mpathInfo, true
+		case dl == distinct && util < minUtil:
 			minUtil, mi = util, mpathInfo
 		}
 	}
 	return
 }
 
+func (lom *LOM) haveLabel(label ios.Label) bool {
+	if len(lom.md.copies) == 0 {
+		return lom.mi.Label == label
+	}
+	for _, mi := range lom.md.copies {
+		if mi != nil && mi.Label == label {
+			return true
+		}
+	}
+	return false
+}
+
 func (lom *LOM) haveMpath(mpath string) bool {
 	if len(lom.md.copies) == 0 {
 		return lom.mi.Path == mpath
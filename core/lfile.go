@@ -13,6 +13,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
 )
 
 const (
@@ -93,11 +94,7 @@ func (*LOM) AppendWork(wfqn string) (fh cos.LomWriter, err error) {
 //
 
 func (lom *LOM) RemoveMain() (err error) {
-	err = cos.RemoveFile(lom.FQN)
-	if os.IsNotExist(err) {
-		err = nil
-	}
-	return err
+	return lom.mi.Remove(lom.FQN)
 }
 
 func (lom *LOM) RemoveObj(force ...bool) (err error) {
@@ -110,8 +107,11 @@ func (lom *LOM) RemoveObj(force ...bool) (err error) {
 	})
 	lom.Uncache()
 	err = lom.RemoveMain()
-	for copyFQN := range lom.md.copies {
-		if erc := cos.RemoveFile(copyFQN); erc != nil && !os.IsNotExist(erc) && err == nil {
+	for copyFQN, mi := range lom.md.copies {
+		if copyFQN == lom.FQN {
+			continue
+		}
+		if erc := mi.Remove(copyFQN); erc != nil && err == nil {
 			err = erc
 		}
 	}
@@ -123,12 +123,13 @@ func (lom *LOM) RemoveObj(force ...bool) (err error) {
 // rename
 //
 
+// (metadata follows - see fs.LmetaStore)
 func (lom *LOM) RenameMainTo(wfqn string) error {
-	return cos.Rename(lom.FQN, wfqn)
+	return lom.mi.Rename(lom.FQN, wfqn)
 }
 
 func (lom *LOM) RenameToMain(wfqn string) error {
-	return cos.Rename(wfqn, lom.FQN)
+	return lom.mi.Rename(wfqn, lom.FQN)
 }
 
 func (lom *LOM) RenameFinalize(wfqn string) error {
@@ -142,3 +143,31 @@ func (lom *LOM) RenameFinalize(wfqn string) error {
 	}
 	return nil
 }
+
+// create-only (put-if-absent): same as above but fails with ErrObjExists if the object
+// is already there; link(2) fails with EEXIST atomically, regardless of locking
+func (lom *LOM) CreateFinalize(wfqn string) error {
+	bdir := lom.mi.MakePathBck(lom.Bucket())
+	if err := cos.Stat(bdir); err != nil {
+		return fmt.Errorf("%s(bdir: %s): %w", lom, bdir, err)
+	}
+	err := os.Link(wfqn, lom.FQN)
+	if os.IsNotExist(err) {
+		// create parent dir and retry (compare w/ cos.Rename)
+		if err = cos.CreateDir(filepath.Dir(lom.FQN)); err == nil {
+			err = os.Link(wfqn, lom.FQN)
+		}
+	}
+	switch {
+	case err == nil:
+	case os.IsExist(err):
+		return cmn.NewErrObjExists(lom.Cname())
+	default:
+		T.FSHC(err, lom.Mountpath(), wfqn)
+		return cmn.NewErrFailedTo(T, "finalize", lom.Cname(), err)
+	}
+	if err := cos.RemoveFile(wfqn); err != nil {
+		nlog.Errorln("failed to remove", wfqn, "upon finalizing", lom.Cname()+":", err)
+	}
+	return nil
+}
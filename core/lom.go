@@ -9,6 +9,7 @@ import (
 	"io"
 	"os"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -185,6 +186,36 @@ func (lom *LOM) SetCustomMD(md cos.StrKVs) { lom.md.SetCustomMD(md) }
 func (lom *LOM) GetCustomKey(key string) (string, bool) { return lom.md.GetCustomKey(key) }
 func (lom *LOM) SetCustomKey(key, value string)         { lom.md.SetCustomKey(key, value) }
 
+// expiration time (unix nanoseconds) or zero if none (see cmn.ExpiresObjMD)
+func (lom *LOM) Expires() int64 {
+	v, ok := lom.md.GetCustomKey(cmn.ExpiresObjMD)
+	if !ok {
+		return 0
+	}
+	expires, err := cos.S2UnixNano(v)
+	if err != nil {
+		return 0
+	}
+	return expires
+}
+
+func (lom *LOM) IsExpired(now int64) bool {
+	expires := lom.Expires()
+	return expires > 0 && expires <= now
+}
+
+// written while the remote backend was offline and not yet uploaded (see cmn.PendingPutObjMD)
+func (lom *LOM) IsPendingPut() bool {
+	_, ok := lom.md.GetCustomKey(cmn.PendingPutObjMD)
+	return ok
+}
+
+// deleted from a copy-on-write clone (see cmn.TombstoneObjMD)
+func (lom *LOM) IsTombstone() bool {
+	_, ok := lom.md.GetCustomKey(cmn.TombstoneObjMD)
+	return ok
+}
+
 // subj to resilvering
 func (lom *LOM) IsHRW() bool {
 	p := &lom.FQN
@@ -214,9 +245,30 @@ func (lom *LOM) IsChunked(special ...bool) bool {
 	return false
 }
 
+// (advanced) primary location followed by the mountpaths of all local copies, if any;
+// e.g.: "t[VQWtTyuI]:mp[/ais/mp1, nvme0n1]; copies: /ais/mp2 /ais/mp3"
+func (lom *LOM) Locations() string {
+	loc := lom.Location()
+	if len(lom.md.copies) < 2 {
+		return loc
+	}
+	paths := make([]string, 0, len(lom.md.copies)-1)
+	for _, mi := range lom.md.copies {
+		if mi.Path != lom.mi.Path {
+			paths = append(paths, mi.Path)
+		}
+	}
+	sort.Strings(paths)
+	return loc + apc.LocationSectSepa + "copies: " + strings.Join(paths, " ")
+}
+
+// returns the primary location (see Locations above)
 func ParseObjLoc(loc string) (tname, mpname string) {
 	i := strings.IndexByte(loc, apc.LocationPropSepa[0])
 	tname, mpname = loc[:i], loc[i+1:]
+	if j := strings.Index(mpname, apc.LocationSectSepa); j > 0 {
+		mpname = mpname[:j]
+	}
 	return
 }
 
@@ -45,8 +45,9 @@ import (
 const mdCksumTyXXHash = 1
 
 // on-disk xattr names
+// (object metadata gets stored via the mountpath's fs.LmetaStore - see fs/lmstore.go)
 const (
-	XattrLOM   = "user.ais.lom"
+	XattrLOM   = fs.XattrLOM
 	xattrChunk = "user.ais.chunk"
 )
 
@@ -138,8 +139,9 @@ func (lom *LOM) lmfs(populate bool) (md *lmeta, err error) {
 		b         []byte
 		mdSize    = g.maxLmeta.Load()
 		buf, slab = g.smm.AllocSize(mdSize)
+		lms       = lom.mi.Lms()
 	)
-	b, err = fs.GetXattrBuf(lom.FQN, XattrLOM, buf)
+	b, err = lms.Get(lom.FQN, buf)
 	if err != nil {
 		slab.Free(buf)
 		if err != syscall.ERANGE {
@@ -148,7 +150,7 @@ func (lom *LOM) lmfs(populate bool) (md *lmeta, err error) {
 		debug.Assert(mdSize < xattrMaxSize)
 		// 2nd attempt: max-size
 		buf, slab = g.smm.AllocSize(xattrMaxSize)
-		b, err = fs.GetXattrBuf(lom.FQN, XattrLOM, buf)
+		b, err = lms.Get(lom.FQN, buf)
 		if err != nil {
 			slab.Free(buf)
 			return whingeLmeta(err)
@@ -188,7 +190,7 @@ func (lom *LOM) PersistMain() (err error) {
 	}
 	// write-immediate (default)
 	buf := lom.pack()
-	if err = fs.SetXattr(lom.FQN, XattrLOM, buf); err != nil {
+	if err = lom.mi.Lms().Set(lom.FQN, buf); err != nil {
 		lom.Uncache()
 		T.FSHC(err, lom.Mountpath(), lom.FQN)
 	} else {
@@ -216,7 +218,7 @@ func (lom *LOM) Persist() (err error) {
 	}
 
 	buf := lom.pack()
-	if err = fs.SetXattr(lom.FQN, XattrLOM, buf); err != nil {
+	if err = lom.mi.Lms().Set(lom.FQN, buf); err != nil {
 		lom.Uncache()
 		T.FSHC(err, lom.Mountpath(), lom.FQN)
 	} else {
@@ -235,11 +237,12 @@ func (lom *LOM) Persist() (err error) {
 func (lom *LOM) persistMdOnCopies() (copyFQN string, err error) {
 	buf := lom.pack()
 	// replicate across copies
-	for copyFQN = range lom.md.copies {
-		if copyFQN == lom.FQN {
+	for fqn, mi := range lom.md.copies {
+		if fqn == lom.FQN {
 			continue
 		}
-		if err = fs.SetXattr(copyFQN, XattrLOM, buf); err != nil {
+		if err = mi.Lms().Set(fqn, buf); err != nil {
+			copyFQN = fqn // (to delete)
 			break
 		}
 	}
@@ -260,7 +263,7 @@ func (lom *LOM) flushCold(md *lmeta, atime time.Time) {
 		return
 	}
 	buf := lom.pack()
-	if err := fs.SetXattr(lom.FQN, XattrLOM, buf); err != nil {
+	if err := lom.mi.Lms().Set(lom.FQN, buf); err != nil {
 		T.FSHC(err, lom.Mountpath(), lom.FQN)
 	}
 	g.smm.Free(buf)
@@ -59,6 +59,7 @@ func (b *Bck) MakeUname(name string) []byte { return (*cmn.Bck)(b).MakeUname(nam
 func (b *Bck) Cname(name string) string     { return (*cmn.Bck)(b).Cname(name) }
 func (b *Bck) IsEmpty() bool                { return (*cmn.Bck)(b).IsEmpty() }
 func (b *Bck) HasVersioningMD() bool        { return (*cmn.Bck)(b).HasVersioningMD() }
+func (b *Bck) IsOffline() bool              { return (*cmn.Bck)(b).IsOffline() }
 
 func (b *Bck) IsRemoteS3() bool {
 	if b.Provider == apc.AWS {
@@ -72,6 +73,7 @@ func (b *Bck) NewQuery() url.Values               { return (*cmn.Bck)(b).NewQuer
 func (b *Bck) AddToQuery(q url.Values) url.Values { return (*cmn.Bck)(b).AddToQuery(q) }
 
 func (b *Bck) Backend() *Bck { backend := (*cmn.Bck)(b).Backend(); return (*Bck)(backend) }
+func (b *Bck) CloneOf() *Bck { src := (*cmn.Bck)(b).CloneOf(); return (*Bck)(src) }
 
 func (b *Bck) AddUnameToQuery(q url.Values, uparam string) url.Values {
 	bck := (*cmn.Bck)(b)
@@ -213,6 +215,7 @@ func (b *Bck) VersionConf() cmn.VersionConf {
 	if backend := b.Backend(); backend != nil && backend.Props != nil {
 		conf := backend.Props.Versioning
 		conf.ValidateWarmGet = b.Props.Versioning.ValidateWarmGet
+		conf.ValidateWarmGetAsync = b.Props.Versioning.ValidateWarmGetAsync
 		return conf
 	}
 	return b.Props.Versioning
@@ -224,7 +227,13 @@ func (b *Bck) VersionConf() cmn.VersionConf {
 
 func (b *Bck) Allow(bit apc.AccessAttrs) error { return b.checkAccess(bit) }
 
+// modifications that pinned (read-only) buckets do not permit - see cmn.PinConf
+const pinnedDeny = apc.AcePUT | apc.AceAPPEND | apc.AceObjDELETE | apc.AceObjMOVE | apc.AceObjUpdate | apc.AcePromote
+
 func (b *Bck) checkAccess(bit apc.AccessAttrs) (err error) {
+	if b.Props.Pin.Enabled && bit&pinnedDeny != 0 {
+		return fmt.Errorf("bucket %s is pinned (read-only): %s operation is not permitted", b, apc.AccessOp(bit))
+	}
 	if b.Props.Access.Has(bit) {
 		return
 	}
@@ -30,13 +30,17 @@ type (
 	// - BMD is immutable and versioned
 	// - BMD versioning is monotonic and incremental
 	BMD struct {
-		Ext       any       `json:"ext,omitempty"`  // within meta-version extensions
-		Providers Providers `json:"providers"`      // (provider, namespace, bucket) hierarchy
-		UUID      string    `json:"uuid"`           // unique & immutable
-		Version   int64     `json:"version,string"` // gets incremented on every update
+		Ext       any                            `json:"ext,omitempty"`        // within meta-version extensions
+		Providers Providers                      `json:"providers"`            // (provider, namespace, bucket) hierarchy
+		PropsHist map[uint64][]*cmn.BpropsChange `json:"props_hist,omitempty"` // bucket props changes, by BID (bounded)
+		UUID      string                         `json:"uuid"`                 // unique & immutable
+		Version   int64                          `json:"version,string"`       // gets incremented on every update
 	}
 )
 
+// max recorded bucket props changes (per bucket)
+const MaxPropsHist = 32
+
 func (m *BMD) String() string {
 	if m == nil {
 		return "BMD <nil>"
@@ -165,6 +169,9 @@ func (m *BMD) Select(qbck *cmn.QueryBcks) cmn.Bcks {
 	}
 	m.Range(cp, nil, func(bck *Bck) bool {
 		b := bck.Bucket()
+		if b.Ns.IsTrash() && !qbck.Ns.IsTrash() {
+			return false // hidden unless explicitly requested
+		}
 		if qbck.Equal(b) || qbck.Contains(b) {
 			if len(bcks) == 0 {
 				bcks = make(cmn.Bcks, 0, 8)
@@ -254,3 +261,30 @@ func (m *BMD) getAllByName(bckName string) (all []Bck) {
 	}
 	return
 }
+
+// bucket props change history (oldest first)
+func (m *BMD) PropsHistory(bid uint64) []*cmn.BpropsChange { return m.PropsHist[bid] }
+
+// (to be called on a clone) records the change, drops the oldest ones beyond MaxPropsHist,
+// and forgets removed buckets; never modifies existing map and slices - shared with the
+// BMD that's being cloned
+func (m *BMD) AddPropsChange(bid uint64, change *cmn.BpropsChange) {
+	var (
+		prev  = m.PropsHist[bid]
+		n     = min(len(prev), MaxPropsHist-1)
+		hist  = make([]*cmn.BpropsChange, 0, n+1)
+		nhist = make(map[uint64][]*cmn.BpropsChange, len(m.PropsHist)+1)
+	)
+	hist = append(hist, prev[len(prev)-n:]...)
+	hist = append(hist, change)
+	if len(m.PropsHist) > 0 {
+		m.Range(nil, nil, func(bck *Bck) bool {
+			if h, ok := m.PropsHist[bck.Props.BID]; ok {
+				nhist[bck.Props.BID] = h
+			}
+			return false
+		})
+	}
+	nhist[bid] = hist
+	m.PropsHist = nhist
+}
@@ -151,6 +151,9 @@ type hrwList struct {
 // returns resulting subset (aka slice) that has the requested length = count.
 // Returns error if the cluster does not have enough targets.
 // If count == length of Smap.Tmap, the function returns as many targets as possible.
+//
+// When targets are labeled with failure domains (Snode.FailDom), the subset is spread
+// across domains - see spreadFailDoms below. The first target is always the HRW one.
 
 func (smap *Smap) HrwTargetList(uname *string, count int) (sis Nodes, err error) {
 	const fmterr = "%v: required %d, available %d, %s"
@@ -159,10 +162,17 @@ func (smap *Smap) HrwTargetList(uname *string, count int) (sis Nodes, err error)
 		err = fmt.Errorf(fmterr, cmn.ErrNotEnoughTargets, count, cnt, smap)
 		return
 	}
-	b := cos.UnsafeBptr(uname)
-	digest := xxhash.Checksum64S(*b, cos.MLCG32)
-	hlist := newHrwList(count)
-
+	var (
+		b      = cos.UnsafeBptr(uname)
+		digest = xxhash.Checksum64S(*b, cos.MLCG32)
+		fdoms  = smap.hasFailDoms()
+		hlist  *hrwList
+	)
+	if fdoms {
+		hlist = newHrwList(cnt) // all (active) targets in HRW order
+	} else {
+		hlist = newHrwList(count)
+	}
 	for _, tsi := range smap.Tmap {
 		cs := xoshiro256.Hash(tsi.Digest() ^ digest)
 		if tsi.InMaintOrDecomm() {
@@ -175,9 +185,94 @@ func (smap *Smap) HrwTargetList(uname *string, count int) (sis Nodes, err error)
 		err = fmt.Errorf(fmterr, cmn.ErrNotEnoughTargets, count, len(sis), smap)
 		return nil, err
 	}
+	if fdoms {
+		sis = spreadFailDoms(sis, min(count, len(sis)))
+	}
 	return sis, nil
 }
 
+func (smap *Smap) hasFailDoms() bool {
+	for _, tsi := range smap.Tmap {
+		if tsi.FailDom != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Given HRW-ordered nodes, selects `count` of them, round-robin across failure domains:
+// at each level, from each domain that has exactly `level` nodes selected so far,
+// select its next (highest weight) node. The result preserves the "prefix" property
+// of the HRW list: spreadFailDoms(sis, n) is a prefix of spreadFailDoms(sis, n+k).
+// (Unlabeled node counts as a separate failure domain of its own.)
+func spreadFailDoms(sis Nodes, count int) Nodes {
+	var (
+		out  = make(Nodes, 0, count)
+		used = make([]bool, len(sis))
+		cnts = make(map[string]int, len(sis))
+	)
+	for level := 0; len(out) < count; level++ {
+		for i, si := range sis {
+			if used[i] {
+				continue
+			}
+			fd := si.fdOrID()
+			if cnts[fd] != level {
+				continue
+			}
+			out = append(out, si)
+			used[i] = true
+			cnts[fd]++
+			if len(out) == count {
+				break
+			}
+		}
+	}
+	return out
+}
+
+// MaxPerFailDom returns the max number of the given targets that share a failure domain
+// (targets not present in the Smap are not counted)
+func (smap *Smap) MaxPerFailDom(tids []string) (maxn int) {
+	cnts := make(map[string]int, len(tids))
+	for _, tid := range tids {
+		tsi := smap.GetTarget(tid)
+		if tsi == nil {
+			continue
+		}
+		fd := tsi.fdOrID()
+		cnts[fd]++
+		maxn = max(maxn, cnts[fd])
+	}
+	return maxn
+}
+
+// FailDomSpread returns the number of failure domains (of active targets) and the max number
+// of targets per domain when selecting `count` targets (see spreadFailDoms above)
+func (smap *Smap) FailDomSpread(count int) (maxn, nfds int) {
+	var (
+		sis  = make(Nodes, 0, len(smap.Tmap))
+		fdss = make(cos.StrSet, 4)
+	)
+	for _, tsi := range smap.Tmap {
+		if tsi.InMaintOrDecomm() {
+			continue
+		}
+		sis = append(sis, tsi)
+		fdss.Add(tsi.fdOrID())
+	}
+	nfds = len(fdss)
+	if count > len(sis) {
+		return 0, nfds
+	}
+	sel := spreadFailDoms(sis, count)
+	tids := make([]string, len(sel))
+	for i, tsi := range sel {
+		tids[i] = tsi.ID()
+	}
+	return smap.MaxPerFailDom(tids), nfds
+}
+
 func newHrwList(count int) *hrwList {
 	return &hrwList{hs: make([]uint64, 0, count), sis: make(Nodes, 0, count), n: count}
 }
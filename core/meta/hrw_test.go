@@ -0,0 +1,71 @@
+// Package meta_test: unit tests for the package
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package meta_test
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/core/meta"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HrwTargetList", func() {
+	// 3 racks x 4 targets
+	newSmap := func(labeled bool) *meta.Smap {
+		smap := &meta.Smap{Tmap: make(meta.NodeMap, 12)}
+		for i := range 12 {
+			tsi := &meta.Snode{}
+			tsi.Init("t"+strconv.Itoa(i), apc.Target)
+			if labeled {
+				tsi.FailDom = "rack" + strconv.Itoa(i%3)
+			}
+			smap.Tmap[tsi.ID()] = tsi
+		}
+		return smap
+	}
+
+	It("should start with the HRW target and spread across failure domains", func() {
+		var (
+			plain   = newSmap(false)
+			labeled = newSmap(true)
+		)
+		for i := range 100 {
+			uname := fmt.Sprintf("ais/@#bck/obj-%d", i)
+			hrw, err := plain.HrwName2T([]byte(uname))
+			Expect(err).NotTo(HaveOccurred())
+
+			sis, err := labeled.HrwTargetList(&uname, 6)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sis).To(HaveLen(6))
+			Expect(sis[0].ID()).To(Equal(hrw.ID()))
+
+			tids := make([]string, 0, len(sis))
+			for _, tsi := range sis {
+				tids = append(tids, tsi.ID())
+			}
+			Expect(labeled.MaxPerFailDom(tids)).To(Equal(2))
+
+			// prefix property
+			sis3, err := labeled.HrwTargetList(&uname, 3)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sis3).To(Equal(sis[:3]))
+		}
+	})
+
+	It("should compute failure domain spread", func() {
+		smap := newSmap(true)
+		maxn, nfds := smap.FailDomSpread(7)
+		Expect(nfds).To(Equal(3))
+		Expect(maxn).To(Equal(3))
+
+		smap = newSmap(false)
+		maxn, nfds = smap.FailDomSpread(7)
+		Expect(nfds).To(Equal(12))
+		Expect(maxn).To(Equal(1))
+	})
+})
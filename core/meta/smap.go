@@ -76,7 +76,8 @@ type (
 		DaeType    string       `json:"daemon_type"`       // "target" or "proxy"
 		DaeID      string       `json:"daemon_id"`
 		name       string       // cached
-		Flags      cos.BitFlags `json:"flags"` // enum { SnodeNonElectable, SnodeIC, ... }
+		Flags      cos.BitFlags `json:"flags"`                    // enum { SnodeNonElectable, SnodeIC, ... }
+		FailDom    string       `json:"failure_domain,omitempty"` // rack, zone, etc. (env.AIS.FailureDomain)
 		idDigest   uint64       // cached
 		nmr        NetNamer     // (multihoming)
 	}
@@ -313,6 +314,17 @@ func (d *Snode) HasURL(rawURL string) bool {
 func (d *Snode) IsProxy() bool  { return d.DaeType == apc.Proxy }
 func (d *Snode) IsTarget() bool { return d.DaeType == apc.Target }
 
+// failure domain (rack, zone, etc.) or empty string when not labeled
+func (d *Snode) FailureDomain() string { return d.FailDom }
+
+// (unlabeled node is a failure domain of its own)
+func (d *Snode) fdOrID() string {
+	if d.FailDom != "" {
+		return d.FailDom
+	}
+	return "\x00" + d.ID()
+}
+
 // node flags
 func (d *Snode) InMaintOrDecomm() bool { return d.Flags.IsAnySet(SnodeMaintDecomm) }
 func (d *Snode) InMaint() bool         { return d.Flags.IsAnySet(SnodeMaint) }
@@ -479,6 +491,24 @@ func (m *Smap) CountNonElectable() (count int) {
 	return
 }
 
+// FailureDomains returns labeled failure domains, each with its (proxy and target) nodes
+func (m *Smap) FailureDomains() map[string]Nodes {
+	var fds map[string]Nodes
+	for _, nm := range []NodeMap{m.Pmap, m.Tmap} {
+		for _, si := range nm {
+			fd := si.FailureDomain()
+			if fd == "" {
+				continue
+			}
+			if fds == nil {
+				fds = make(map[string]Nodes, 4)
+			}
+			fds[fd] = append(fds[fd], si)
+		}
+	}
+	return fds
+}
+
 func (m *Smap) GetProxy(pid string) *Snode {
 	psi, ok := m.Pmap[pid]
 	if !ok {
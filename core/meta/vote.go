@@ -0,0 +1,35 @@
+// Package meta: cluster-level metadata
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package meta
+
+// election phases and outcomes (see VoteEvent)
+const (
+	VotePhasePre    = "pre-vote" // candidate: pre-vote (does not increment the term)
+	VotePhaseVote   = "vote"     // candidate: phase 1 (prepare)
+	VotePhaseCast   = "cast"     // voter: vote cast
+	VotePhaseResult = "result"   // all nodes: election result received (phase 2)
+)
+
+type (
+	// a single election-related event, as seen by a given node
+	VoteEvent struct {
+		Phase     string `json:"phase"`
+		Candidate string `json:"candidate"`
+		Primary   string `json:"primary"` // primary being replaced
+		Initiator string `json:"initiator,omitempty"`
+		Err       string `json:"err,omitempty"`
+		Term      int64  `json:"term,string"`
+		Time      int64  `json:"time,string"`
+		Yes       bool   `json:"yes"`                // vote cast (or, for candidate, won)
+		Overload  bool   `json:"overload,omitempty"` // replacing overloaded (rather than failed) primary
+	}
+	// persistent (proxies) election term and bounded history of recent elections;
+	// GET /v1/daemon?what=smapvote
+	ElectionHistory struct {
+		VotedFor string      `json:"voted_for,omitempty"` // candidate voted for in the current term
+		Events   []VoteEvent `json:"events"`
+		Term     int64       `json:"term,string"`
+	}
+)
@@ -32,6 +32,7 @@ func (*StatsTracker) AddMany(...cos.NamedVal64)
 func (*StatsTracker) RegExtMetric(*meta.Snode, string, string, *stats.Extra)    {}
 func (*StatsTracker) GetMetricNames() cos.StrKVs                                { return nil }
 func (*StatsTracker) GetStats() *stats.Node                                     { return nil }
+func (*StatsTracker) GetStatsFlt(*stats.Filter) *stats.Node                     { return nil }
 func (*StatsTracker) GetStatsV322() *stats.NodeV322                             { return nil }
 func (*StatsTracker) ResetStats(bool)                                           {}
 func (*StatsTracker) IsPrometheus() bool                                        { return false }
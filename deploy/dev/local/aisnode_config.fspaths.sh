@@ -37,7 +37,8 @@ cat > $AIS_CONF_FILE <<EOL
 	"periodic": {
 		"stats_time":        "10s",
 		"notif_time":        "30s",
-		"retry_sync_time":   "2s"
+		"retry_sync_time":   "2s",
+		"config_drift_time": "10m"
 	},
 	"timeout": {
 		"cplane_operation":     "2s",
@@ -56,6 +57,7 @@ cat > $AIS_CONF_FILE <<EOL
 		"primary_url":   "${AIS_PRIMARY_URL}",
 		"original_url":  "${AIS_PRIMARY_URL}",
 		"discovery_url": "${AIS_DISCOVERY_URL}",
+		"stream_data":   false,
 		"non_electable": ${AIS_NON_ELECTABLE:-false}
 	},
 	"space": {
@@ -121,6 +123,8 @@ cat > $AIS_CONF_FILE <<EOL
 			"use_https":         ${AIS_USE_HTTPS:-false},
 			"server_crt":        "${AIS_SERVER_CRT:-server.crt}",
 			"server_key":        "${AIS_SERVER_KEY:-server.key}",
+			"intra_server_crt":  "${AIS_INTRA_SERVER_CRT}",
+			"intra_server_key":  "${AIS_INTRA_SERVER_KEY}",
 			"domain_tls":        "",
 			"client_ca_tls":     "${AIS_CLIENT_CA_TLS}",
 			"client_auth_tls":   ${AIS_CLIENT_AUTH_TLS:-0},
@@ -128,6 +132,13 @@ cat > $AIS_CONF_FILE <<EOL
 			"read_buffer_size":  ${HTTP_READ_BUFFER_SIZE:-0},
 			"chunked_transfer":  ${AIS_HTTP_CHUNKED_TRANSFER:-true},
 			"skip_verify":       ${AIS_SKIP_VERIFY_CRT:-false}
+		},
+		"qos": {
+			"control":   {"dscp": 48, "priority": 6},
+			"data":      {"dscp": 0,  "priority": 0},
+			"ec":        {"dscp": 10, "priority": 0},
+			"rebalance": {"dscp": 8,  "priority": 0},
+			"enabled":   ${AIS_NET_QOS:-false}
 		}
 	},
 	"fshc": {
@@ -152,6 +163,15 @@ cat > $AIS_CONF_FILE <<EOL
 			"name":     "heartbeat",
 			"factor":   3
 		},
+		"gossip": {
+			"fanout":   3,
+			"enabled":  false
+		},
+		"primary_overload": {
+			"sustain":        "1m",
+			"msync_backlog":  24,
+			"enabled":        false
+		},
 		"retry_factor":   4
 	},
 	"downloader": {
@@ -172,6 +192,69 @@ cat > $AIS_CONF_FILE <<EOL
 		"compression":		"never",
 		"bundle_multiplier":	2
 	},
+	"readahead": {
+		"max_inflight":	"1GiB",
+		"max_age":	"5m",
+		"depth":	8,
+		"enabled":	false
+	},
+	"cold_get": {
+		"threshold":	"256MiB",
+		"part_size":	"16MiB",
+		"num_workers":	8,
+		"enabled":	false
+	},
+	"mmap": {
+		"budget":	"1GiB",
+		"max_obj_size":	"1MiB",
+		"min_hits":	3,
+		"enabled":	false
+	},
+	"arch_index": {
+		"max_shards":	1024,
+		"max_files":	100000,
+		"enabled":	false
+	},
+	"io_sched": {
+		"max_inflight":	32,
+		"read_share":	60,
+		"write_share":	30,
+		"bg_share":	10,
+		"enabled":	false
+	},
+	"quarantine": {
+		"interval":	"10s",
+		"max_latency":	"2s",
+		"max_err_pct":	5,
+		"min_reqs":	100,
+		"enabled":	false
+	},
+	"nfs": {
+		"port":		2049,
+		"exports":	"",
+		"write_through":	false,
+		"enabled":	false
+	},
+	"ns_quota": {
+		"max_buckets":	0,
+		"max_size":	"0B",
+		"overrides":	"",
+		"interval":	"5m",
+		"enabled":	false
+	},
+	"stats_push": {
+		"collectors":	"",
+		"interval":	"30s",
+		"timeout":	"10s",
+		"batch_size":	16,
+		"buffer_size":	2880,
+		"enabled":	false
+	},
+	"append": {
+		"window":		"64MiB",
+		"max_inflight":		"1GiB",
+		"flush_deadline":	"30m"
+	},
 	"write_policy": {
 		"data": "${WRITE_POLICY_DATA:-}",
 		"md": "${WRITE_POLICY_MD:-}"
@@ -7,6 +7,7 @@ package ec
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"sync"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -29,9 +30,11 @@ type (
 	}
 	XactBckEncode struct {
 		xact.Base
-		bck  *meta.Bck
-		wg   *sync.WaitGroup // to wait for EC finishes all objects
-		smap *meta.Smap
+		bck     *meta.Bck
+		wg      *sync.WaitGroup // to wait for EC finishes all objects
+		smap    *meta.Smap
+		journal *xact.Journal // encoded objects (to resume after restart)
+		ecTag   string        // (data, parity)
 	}
 )
 
@@ -94,10 +97,14 @@ func (r *XactBckEncode) Run(wg *sync.WaitGroup) {
 		return
 	}
 
+	r.ecTag = strconv.Itoa(bck.Props.EC.DataSlices) + ":" + strconv.Itoa(bck.Props.EC.ParitySlices)
+	r.journal = xact.OpenJournal(xact.JournalName(apc.ActECEncode, bck.Cname("")))
+
 	opts := &mpather.JgroupOpts{
 		CTs:      []string{fs.ObjectType},
 		VisitObj: r.bckEncode,
 		DoLoad:   mpather.LoadUnsafe,
+		Throttle: true, // (background; pace itself depending on disk utilization)
 	}
 	opts.Bck.Copy(r.bck.Bucket())
 	jg := mpather.NewJoggerGroup(opts, cmn.GCO.Get(), "")
@@ -114,6 +121,10 @@ func (r *XactBckEncode) Run(wg *sync.WaitGroup) {
 	}
 	r.wg.Wait() // Need to wait for all async actions to finish.
 
+	if n := r.journal.Skipped(); n > 0 {
+		nlog.Infoln(r.Name(), "skipped", n, "objects encoded prior to restart")
+	}
+	r.journal.Close(r.ErrCnt() == 0 && !r.IsAborted())
 	r.Finish()
 }
 
@@ -122,6 +133,7 @@ func (r *XactBckEncode) beforeECObj() { r.wg.Add(1) }
 func (r *XactBckEncode) afterECObj(lom *core.LOM, err error) {
 	if err == nil {
 		r.LomAdd(lom)
+		r.journal.Add(lom, r.ecTag)
 	} else if err != errSkipped {
 		nlog.Errorf("failed to erasure-code %s: %v", lom.Cname(), err)
 	}
@@ -132,7 +144,11 @@ func (r *XactBckEncode) afterECObj(lom *core.LOM, err error) {
 // Walks through all files in 'obj' directory, and calls EC.Encode for every
 // file whose HRW points to this file and the file does not have corresponding
 // metadata file in 'meta' directory
+// (objects encoded with a different (data, parity) are re-sliced by XactBckReslice)
 func (r *XactBckEncode) bckEncode(lom *core.LOM, _ []byte) error {
+	if r.journal.Skip(lom, r.ecTag) {
+		return nil
+	}
 	_, local, err := lom.HrwTarget(r.smap)
 	if err != nil {
 		nlog.Errorf("%s: %s", lom, err)
@@ -0,0 +1,231 @@
+// Package ec provides erasure coding (EC) based data protection for AIStore.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ec
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Re-slicing: upon changing (data, parity) of an erasure coded bucket, re-encode
+// all existing objects that are encoded with the previous (data, parity).
+// Runs in two throttled passes over the bucket:
+// 1. count the objects to re-encode (metadata only) - the total
+// 2. re-encode them; the new generation of slices supersedes the old one
+// Progress is reported via extended stats (see ExtECResliceStats).
+// Objects that are already encoded with the current (data, parity) are skipped,
+// so that interrupted re-slicing can be safely restarted.
+
+type (
+	rslFactory struct {
+		xreg.RenewBase
+		xctn *XactBckReslice
+	}
+	XactBckReslice struct {
+		xact.Base
+		bck      *meta.Bck
+		smap     *meta.Smap
+		wg       sync.WaitGroup // pending re-encodes
+		total    atomic.Int64   // objects to re-encode (counted in the first pass)
+		resliced atomic.Int64   // re-encoded
+		failed   atomic.Int64   // failed to re-encode
+		counted  atomic.Bool    // done counting
+	}
+	// extended x-ec-reslice statistics
+	ExtECResliceStats struct {
+		Total    int64 `json:"ec.reslice.total.n,string"` // objects to re-encode (zero until counted)
+		Resliced int64 `json:"ec.reslice.n,string"`       // re-encoded
+		Failed   int64 `json:"ec.reslice.err.n,string"`   // failed to re-encode
+		Pct      int64 `json:"ec.reslice.pct,string"`     // progress: (re-encoded + failed) vs total
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactBckReslice)(nil)
+	_ xreg.Renewable = (*rslFactory)(nil)
+)
+
+////////////////
+// rslFactory //
+////////////////
+
+func (*rslFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &rslFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *rslFactory) Start() error {
+	p.xctn = newXactBckReslice(p.Bck, p.UUID())
+	return nil
+}
+
+func (*rslFactory) Kind() string     { return apc.ActECReslice }
+func (p *rslFactory) Get() core.Xact { return p.xctn }
+
+// (data, parity) changed again - the caller aborts the previous one
+func (*rslFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) { return xreg.WprAbort, nil }
+
+////////////////////
+// XactBckReslice //
+////////////////////
+
+func newXactBckReslice(bck *meta.Bck, uuid string) (r *XactBckReslice) {
+	r = &XactBckReslice{bck: bck, smap: core.T.Sowner().Get()}
+	r.InitBase(uuid, apc.ActECReslice, bck)
+	return
+}
+
+func (r *XactBckReslice) Run(wg *sync.WaitGroup) {
+	wg.Done()
+	bck := r.bck
+	if err := bck.Init(core.T.Bowner()); err != nil {
+		r.AddErr(err)
+		r.Finish()
+		return
+	}
+	if !bck.Props.EC.Enabled {
+		r.AddErr(fmt.Errorf("%s does not have EC enabled", r.bck.Cname("")))
+		r.Finish()
+		return
+	}
+	nlog.Infoln(r.Name(), "re-slicing => (d:", bck.Props.EC.DataSlices, "p:", bck.Props.EC.ParitySlices, ")")
+
+	// 1. count
+	if err := r.walk(r.count); err != nil {
+		r.AddErr(err)
+	}
+	r.counted.Store(true)
+
+	// 2. re-encode
+	if !r.IsAborted() && r.total.Load() > 0 {
+		if err := r.walk(r.reslice); err != nil {
+			r.AddErr(err)
+		}
+		r.wg.Wait() // for all re-encodes to finish
+	}
+
+	nlog.Infoln(r.Name(), "done: re-encoded", r.resliced.Load(), "out of", r.total.Load(), "failed", r.failed.Load())
+	r.Finish()
+}
+
+func (r *XactBckReslice) walk(visit func(*core.LOM, []byte) error) (err error) {
+	opts := &mpather.JgroupOpts{
+		CTs:      []string{fs.ObjectType},
+		VisitObj: visit,
+		DoLoad:   mpather.LoadUnsafe,
+		Throttle: true, // (background; pace itself depending on disk utilization)
+	}
+	opts.Bck.Copy(r.bck.Bucket())
+	jg := mpather.NewJoggerGroup(opts, cmn.GCO.Get(), "")
+	jg.Run()
+
+	select {
+	case <-r.ChanAbort():
+		jg.Stop()
+	case <-jg.ListenFinished():
+		err = jg.Stop()
+	}
+	return err
+}
+
+// whether the object is encoded (by this target) with a different (data, parity)
+func (r *XactBckReslice) needsReslice(lom *core.LOM) bool {
+	_, local, err := lom.HrwTarget(r.smap)
+	if err != nil {
+		nlog.Errorf("%s: %s", lom, err)
+		return false
+	}
+	if !local {
+		return false
+	}
+	mdFQN, _, err := core.HrwFQN(lom.Bck().Bucket(), fs.ECMetaType, lom.ObjName)
+	if err != nil {
+		nlog.Warningf("metadata FQN generation failed %q: %v", lom, err)
+		return false
+	}
+	md, err := LoadMetadata(mdFQN)
+	if err != nil {
+		// not encoded yet (e.g., ec-encode in progress) - not re-slicing
+		if !os.IsNotExist(err) {
+			nlog.Warningf("failed to load %q: %v", mdFQN, err)
+		}
+		return false
+	}
+	return isResliced(md, &r.bck.Props.EC)
+}
+
+func isResliced(md *Metadata, conf *cmn.ECConf) bool {
+	return md.Data != conf.DataSlices || md.Parity != conf.ParitySlices
+}
+
+func (r *XactBckReslice) count(lom *core.LOM, _ []byte) error {
+	if r.needsReslice(lom) {
+		r.total.Inc()
+	}
+	return nil
+}
+
+func (r *XactBckReslice) reslice(lom *core.LOM, _ []byte) error {
+	if !r.needsReslice(lom) {
+		return nil
+	}
+	r.wg.Add(1)
+	if err := ECM.EncodeObject(lom, r.afterReslice); err != nil {
+		r.afterReslice(lom, err)
+		if err != errSkipped {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *XactBckReslice) afterReslice(lom *core.LOM, err error) {
+	switch {
+	case err == nil:
+		r.resliced.Inc()
+		r.LomAdd(lom)
+	case err == errSkipped:
+		r.total.Dec()
+	default:
+		r.failed.Inc()
+		nlog.Errorf("%s: failed to re-encode %s: %v", r.Name(), lom.Cname(), err)
+	}
+	r.wg.Done()
+}
+
+func (r *XactBckReslice) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	snap.Ext = r.extStats()
+	return
+}
+
+func (r *XactBckReslice) extStats() *ExtECResliceStats {
+	ext := &ExtECResliceStats{Resliced: r.resliced.Load(), Failed: r.failed.Load()}
+	if !r.counted.Load() {
+		return ext
+	}
+	ext.Total = r.total.Load()
+	if ext.Total == 0 {
+		ext.Pct = 100
+	} else {
+		ext.Pct = min((ext.Resliced+ext.Failed)*100/ext.Total, 100)
+	}
+	return ext
+}
@@ -0,0 +1,70 @@
+// Package ec provides erasure coding (EC) based data protection for AIStore.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ec
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+func TestIsResliced(t *testing.T) {
+	conf := &cmn.ECConf{Enabled: true, DataSlices: 2, ParitySlices: 2}
+	tests := []struct {
+		data, parity int
+		resliced     bool
+	}{
+		{2, 2, false},
+		{1, 2, true},
+		{2, 1, true},
+		{1, 1, true},
+	}
+	for _, test := range tests {
+		md := &Metadata{Data: test.data, Parity: test.parity}
+		if isResliced(md, conf) != test.resliced {
+			t.Errorf("(d=%d, p=%d) vs %+v: expected resliced=%t", test.data, test.parity, conf, test.resliced)
+		}
+	}
+}
+
+// progress: only successful re-encodes count as resliced; skipped ones do not count at all
+func TestResliceProgress(t *testing.T) {
+	cos.InitShortID(0)
+	r := &XactBckReslice{bck: meta.NewBck("reslice", apc.AIS, cmn.NsGlobal)}
+	r.InitBase(cos.GenUUID(), apc.ActECReslice, r.bck)
+
+	if ext := r.extStats(); ext.Total != 0 || ext.Pct != 0 {
+		t.Fatalf("expected no progress prior to counting, got %+v", ext)
+	}
+	r.total.Store(4)
+	r.counted.Store(true)
+
+	lom := &core.LOM{ObjName: "obj"}
+	for _, err := range []error{nil, errors.New("failed"), errSkipped} {
+		r.wg.Add(1)
+		r.afterReslice(lom, err)
+	}
+	ext := r.extStats()
+	if ext.Resliced != 1 || ext.Failed != 1 || ext.Total != 3 {
+		t.Fatalf("expected (resliced 1, failed 1, total 3), got %+v", ext)
+	}
+	if ext.Pct != 66 {
+		t.Fatalf("expected 66%% progress, got %d%%", ext.Pct)
+	}
+	if n := r.Objs(); n != 1 {
+		t.Fatalf("expected 1 re-encoded object in the xaction stats, got %d", n)
+	}
+
+	r.wg.Add(1)
+	r.afterReslice(lom, nil)
+	if ext := r.extStats(); ext.Pct != 100 {
+		t.Fatalf("expected 100%% progress, got %+v", ext)
+	}
+}
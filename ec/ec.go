@@ -105,9 +105,10 @@ import (
 //		 algorithm returns.
 
 const (
-	ActSplit   = "split"
-	ActRestore = "restore"
-	ActDelete  = "delete"
+	ActSplit     = "split"
+	ActRestore   = "restore"
+	ActReadRange = "read-range" // read byte range directly from slices (see Manager.ReadRange)
+	ActDelete    = "delete"
 
 	RespStreamName = "ec-resp"
 	ReqStreamName  = "ec-req"
@@ -130,6 +131,7 @@ type (
 		Action   string     // what to do with the object (see Act* consts)
 		ErrCh    chan error // for final EC result (used only in restore)
 		Callback core.OnFinishObj
+		rng      *RangeArgs // ActReadRange
 
 		putTime time.Time // time when the object is put into main queue
 		tm      time.Time // to measure different steps
@@ -192,6 +194,8 @@ var (
 	ErrorECDisabled = errors.New("EC is disabled for bucket")
 	ErrorNoMetafile = errors.New("no metafile")
 	ErrorNotFound   = errors.New("not found")
+
+	ErrorECReplicated = errors.New("not sliced (EC-replicated)") // (ActReadRange)
 )
 
 func Init() {
@@ -205,6 +209,7 @@ func Init() {
 	xreg.RegBckXact(&putFactory{})
 	xreg.RegBckXact(&rspFactory{})
 	xreg.RegBckXact(&encFactory{})
+	xreg.RegBckXact(&rslFactory{})
 
 	if err := initManager(); err != nil {
 		cos.ExitLog("Failed to init manager:", err)
@@ -450,7 +455,7 @@ func WriteSliceAndMeta(hdr *transport.ObjHdr, args *WriteArgs) error {
 		if err == nil {
 			return
 		}
-		if rmErr := cos.RemoveFile(ct.FQN()); rmErr != nil {
+		if rmErr := ct.Mountpath().Remove(ct.FQN()); rmErr != nil {
 			nlog.Errorf("nested error: save replica -> remove replica: %v", rmErr)
 		}
 		if rmErr := cos.RemoveFile(ctMeta.FQN()); rmErr != nil {
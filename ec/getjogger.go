@@ -112,7 +112,7 @@ func (c *getJogger) stop() {
 
 // Finalize the EC restore: report an error to a caller, do housekeeping.
 func (*getJogger) finalizeReq(req *request, err error) {
-	if err != nil {
+	if err != nil && err != ErrorECReplicated {
 		if lom, e := req.LIF.LOM(); e == nil {
 			nlog.Errorf("Error restoring %s: %v", lom, err)
 			core.FreeLOM(lom)
@@ -129,19 +129,23 @@ func (*getJogger) finalizeReq(req *request, err error) {
 }
 
 func (c *getJogger) ec(req *request) {
-	debug.Assert(req.Action == ActRestore)
+	debug.Assert(req.Action == ActRestore || req.Action == ActReadRange)
 	ctx, err := c.newCtx(req)
 	if ctx == nil {
 		debug.Assert(err != nil)
 		return
 	}
-	if err == nil {
+	switch {
+	case err != nil:
+	case req.Action == ActReadRange:
+		err = c.readRange(ctx, req.rng)
+	default:
 		err = c.restore(ctx)
 		c.parent.stats.updateDecodeTime(time.Since(req.tm), err != nil)
-	}
-	if err == nil {
-		c.parent.stats.updateObjTime(time.Since(req.putTime))
-		err = ctx.lom.Persist()
+		if err == nil {
+			c.parent.stats.updateObjTime(time.Since(req.putTime))
+			err = ctx.lom.Persist()
+		}
 	}
 	c.freeCtx(ctx)
 	c.finalizeReq(req, err)
@@ -354,20 +358,26 @@ loop: //nolint:gocritic // keeping label for readability
 
 // Main object is not found and it is clear that it was encoded. Request
 // all data and parity slices from targets in a cluster.
-func (c *getJogger) requestSlices(ctx *restoreCtx) error {
+// Optionally, `want` selects the slices (by slice ID) to request - see readRange.
+func (c *getJogger) requestSlices(ctx *restoreCtx, want func(sliceID int) bool) error {
 	var (
 		wgSlices = cos.NewTimeoutGroup()
 		sliceCnt = ctx.meta.Data + ctx.meta.Parity
 		daemons  = make([]string, 0, len(ctx.nodes)) // Targets to be requested for slices
 	)
-	ctx.slices = make([]*slice, sliceCnt)
-	ctx.idToNode = make(map[int]string)
+	if ctx.slices == nil {
+		ctx.slices = make([]*slice, sliceCnt)
+		ctx.idToNode = make(map[int]string)
+	}
 
 	for k, v := range ctx.nodes {
 		if v.SliceID < 1 || v.SliceID > sliceCnt {
 			nlog.Warningf("Node %s has invalid slice ID %d", k, v.SliceID)
 			continue
 		}
+		if want != nil && !want(v.SliceID) {
+			continue
+		}
 
 		if cmn.Rom.FastV(4, cos.SmoduleEC) {
 			nlog.Infof("Slice %s[%d] requesting from %s", ctx.lom, v.SliceID, k)
@@ -400,6 +410,9 @@ func (c *getJogger) requestSlices(ctx *restoreCtx) error {
 		}
 	}
 
+	if len(daemons) == 0 {
+		return nil
+	}
 	iReq := newIntraReq(reqGet, ctx.meta, ctx.lom.Bck())
 	iReq.isSlice = true
 	request := iReq.NewPack(g.smm)
@@ -771,7 +784,7 @@ func (c *getJogger) restoreEncoded(ctx *restoreCtx) error {
 	}
 
 	// Download all slices from the targets that have sent metadata
-	err := c.requestSlices(ctx)
+	err := c.requestSlices(ctx, nil /*all*/)
 	if err != nil {
 		c.freeDownloaded(ctx)
 		return err
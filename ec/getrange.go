@@ -0,0 +1,205 @@
+// Package ec provides erasure coding (EC) based data protection for AIStore.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ec
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/klauspost/reedsolomon"
+)
+
+// Range read of an erasure-coded (sliced) object that has no local replica:
+// - data slices are the object's consecutive parts, SliceSize(size, data) bytes each
+//   (the last one is zero-padded)
+// - the jogger requests only the data slices that contain the range; if all of them arrive
+//   intact, the range is streamed right away - no decoding
+// - otherwise, it requests the rest of the slices and reconstructs only the missing
+//   data slices that contain the range
+// - either way, the object itself is neither restored nor stored - compare w/ restoreEncoded
+
+type RangeArgs struct {
+	// called once the object's EC metadata is known - to resolve the range (given the object's size)
+	// and, e.g., set response headers; zero length - nothing to read
+	Open func(md *Metadata) (off, length int64, err error)
+	// destination
+	W io.Writer
+	// (out) bytes written to W
+	Written int64
+}
+
+func (c *getJogger) readRange(ctx *restoreCtx, args *RangeArgs) error {
+	if ctx.lom.Bprops() == nil || !ctx.lom.ECEnabled() {
+		return ErrorECDisabled
+	}
+	if err := c.requestMeta(ctx); err != nil {
+		return err
+	}
+	md := ctx.meta
+	if md.IsCopy {
+		return ErrorECReplicated
+	}
+	if len(ctx.nodes) < md.Data {
+		return fmt.Errorf("cannot read %s: too many slices missing (found %d slices, need %d or more)",
+			ctx.lom, len(ctx.nodes), md.Data)
+	}
+	off, length, err := args.Open(md)
+	if err != nil || length == 0 {
+		return err
+	}
+
+	var (
+		restored  []*slice
+		sliceSize = SliceSize(md.Size, md.Data)
+		first     = int(off / sliceSize)
+		last      = int((off + length - 1) / sliceSize)
+		valid     = make([]bool, md.Data+md.Parity)
+		needed    = func(sliceID int) bool { return sliceID-1 >= first && sliceID-1 <= last }
+		rest      = func(sliceID int) bool { return !needed(sliceID) }
+	)
+	defer c.freeDownloaded(ctx)
+
+	// 1. data slices that contain the range
+	if err := c.requestSlices(ctx, needed); err != nil {
+		return err
+	}
+	ctx.checkSlices(valid, needed)
+	missing := make([]int, 0, 2)
+	for i := first; i <= last; i++ {
+		if !valid[i] {
+			missing = append(missing, i)
+		}
+	}
+
+	// 2. when some are missing (or corrupted), reconstruct them from all the rest
+	if len(missing) > 0 {
+		if cmn.Rom.FastV(4, cos.SmoduleEC) {
+			nlog.Infof("%s: reconstructing slices %v to read [%d, %d)", ctx.lom, missing, off, off+length)
+		}
+		if err := c.requestSlices(ctx, rest); err != nil {
+			return err
+		}
+		ctx.checkSlices(valid, rest)
+		restored, err = ctx.reconstructSome(valid, missing, sliceSize)
+		defer freeSlices(restored)
+		if err != nil {
+			return err
+		}
+	}
+
+	// 3. stream the range
+	for i := first; i <= last; i++ {
+		sl := ctx.slices[i]
+		if !valid[i] {
+			sl = restored[i]
+		}
+		var (
+			base = int64(i) * sliceSize
+			from = max(off-base, 0)
+			to   = min(off+length-base, sliceSize)
+		)
+		if err := args.write(sl, from, to-from); err != nil {
+			return err
+		}
+	}
+	c.parent.ObjsAdd(1, length)
+	return nil
+}
+
+// validate (checksum) received slices
+func (ctx *restoreCtx) checkSlices(valid []bool, want func(sliceID int) bool) {
+	for i, sl := range ctx.slices {
+		if !want(i+1) || sl == nil || sl.writer == nil || sl.n == 0 {
+			continue
+		}
+		r, err := sl.openRead()
+		if err == nil {
+			err = cksumSlice(r, sl.cksum, ctx.lom.ObjName)
+			cos.Close(r)
+		}
+		if err != nil {
+			nlog.Errorf("slice %d of %s: %v", i+1, ctx.lom, err)
+			continue
+		}
+		valid[i] = true
+	}
+}
+
+// reconstruct only the `missing` (data) slices - compare w/ restoreMainObj
+func (ctx *restoreCtx) reconstructSome(valid []bool, missing []int, sliceSize int64) ([]*slice, error) {
+	var (
+		cnt      int
+		sliceCnt = ctx.meta.Data + ctx.meta.Parity
+		readers  = make([]io.Reader, sliceCnt)
+		writers  = make([]io.Writer, sliceCnt)
+		restored = make([]*slice, sliceCnt)
+		cksums   = make([]*cos.CksumHash, sliceCnt)
+	)
+	for i, ok := range valid {
+		if !ok {
+			continue
+		}
+		r, err := ctx.slices[i].openRead()
+		if err != nil {
+			return restored, err
+		}
+		defer cos.Close(r)
+		readers[i] = r
+		cnt++
+	}
+	if cnt < ctx.meta.Data {
+		return restored, fmt.Errorf("cannot read %s: too many slices missing or corrupted (have %d, need %d)",
+			ctx.lom, cnt, ctx.meta.Data)
+	}
+	for _, i := range missing {
+		if err := newSliceWriter(ctx, writers, restored, cksums, cos.ChecksumNone, i, sliceSize); err != nil {
+			return restored, err
+		}
+	}
+	stream, err := reedsolomon.NewStreamC(ctx.meta.Data, ctx.meta.Parity, true, true)
+	if err != nil {
+		return restored, err
+	}
+	err = stream.Reconstruct(readers, writers)
+	for _, w := range writers {
+		if fh, ok := w.(*os.File); ok {
+			cos.Close(fh) // (work files)
+		}
+	}
+	return restored, err
+}
+
+// open received or reconstructed slice for reading
+func (s *slice) openRead() (cos.ReadOpenCloser, error) {
+	if s.workFQN != "" {
+		return cos.NewFileHandle(s.workFQN)
+	}
+	if sgl, ok := s.writer.(*memsys.SGL); ok {
+		return memsys.NewReader(sgl), nil
+	}
+	if sgl, ok := s.obj.(*memsys.SGL); ok {
+		return memsys.NewReader(sgl), nil
+	}
+	return nil, fmt.Errorf("empty slice (%T, %T)", s.writer, s.obj)
+}
+
+func (args *RangeArgs) write(sl *slice, off, length int64) error {
+	r, err := sl.openRead()
+	if err != nil {
+		return err
+	}
+	if _, err = r.(io.Seeker).Seek(off, io.SeekStart); err == nil {
+		var n int64
+		n, err = io.CopyN(args.W, r, length)
+		args.Written += n
+	}
+	cos.Close(r)
+	return err
+}
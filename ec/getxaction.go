@@ -168,7 +168,7 @@ func (r *XactGet) dispatchRequest(req *request, lom *core.LOM) error {
 		return ErrorECDisabled
 	}
 
-	debug.Assert(req.Action == ActRestore)
+	debug.Assert(req.Action == ActRestore || req.Action == ActReadRange)
 
 	jogger, ok := r.getJoggers[lom.Mountpath().Path]
 	if !ok {
@@ -244,7 +244,7 @@ func (r *XactGet) stop() {
 // a nil value from channel but ecrunner keeps working - it reuploads all missing
 // slices or copies
 func (r *XactGet) decode(req *request, lom *core.LOM) {
-	debug.Assert(req.Action == ActRestore, "invalid action for restore: "+req.Action)
+	debug.Assert(req.Action == ActRestore || req.Action == ActReadRange, "invalid action for restore: "+req.Action)
 	r.stats.updateDecode()
 	req.putTime = time.Now()
 	req.tm = time.Now()
@@ -73,7 +73,7 @@ func (mgr *Manager) initECBundles() error {
 		}
 	}
 	var (
-		client      = transport.NewIntraDataClient()
+		client      = transport.NewIntraDataClientQoS(cmn.QoSEC)
 		config      = cmn.GCO.Get()
 		compression = config.EC.Compression
 		extraReq    = transport.Extra{Callback: cbReq, Compression: compression, Config: config}
@@ -276,6 +276,23 @@ func (mgr *Manager) RestoreObject(lom *core.LOM) error {
 	return <-errCh
 }
 
+// ReadRange reads a byte range of the object directly from its EC slices, reconstructing
+// only the slices that contain the range and are missing - see readRange.
+// Returns ErrorECReplicated if the object is not sliced (and can be restored cheaply).
+func (mgr *Manager) ReadRange(lom *core.LOM, args *RangeArgs) error {
+	if !lom.ECEnabled() {
+		return ErrorECDisabled
+	}
+	debug.Assert(lom.Mountpath() != nil && lom.Mountpath().Path != "")
+	req := allocateReq(ActReadRange, lom.LIF())
+	req.rng = args
+	errCh := make(chan error) // unbuffered
+	req.ErrCh = errCh
+	mgr.RestoreBckGetXact(lom.Bck()).decode(req, lom)
+
+	return <-errCh
+}
+
 // disableBck starts to reject new EC requests, rejects pending ones
 func (mgr *Manager) disableBck(bck *meta.Bck) {
 	mgr.RestoreBckGetXact(bck).ClearRequests()
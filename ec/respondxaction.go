@@ -121,12 +121,21 @@ func (*XactRespond) removeObjAndMeta(bck *meta.Bck, objName string) error {
 	// metafile that makes remained slices/replicas outdated and can be cleaned
 	// up later by LRU or other runner
 	for _, tp := range []string{fs.ECMetaType, fs.ObjectType, fs.ECSliceType} {
-		fqnMeta, _, err := core.HrwFQN(bck.Bucket(), tp, objName)
+		fqn, _, err := core.HrwFQN(bck.Bucket(), tp, objName)
 		if err != nil {
 			return err
 		}
-		if err := os.Remove(fqnMeta); err != nil && !os.IsNotExist(err) {
-			return fmt.Errorf("error removing %s %q: %w", tp, fqnMeta, err)
+		if tp == fs.ObjectType {
+			// replica: along with its metadata (see fs.LmetaStore)
+			var mi *fs.Mountpath
+			if mi, _, err = fs.FQN2Mpath(fqn); err == nil {
+				err = mi.Remove(fqn)
+			}
+		} else if err = os.Remove(fqn); os.IsNotExist(err) {
+			err = nil
+		}
+		if err != nil {
+			return fmt.Errorf("error removing %s %q: %w", tp, fqn, err)
 		}
 	}
 
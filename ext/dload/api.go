@@ -5,9 +5,12 @@
 package dload
 
 import (
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/textproto"
 	"path"
 	"regexp"
 	"strings"
@@ -26,6 +29,7 @@ const (
 	TypeRange   Type = "range"
 	TypeMulti   Type = "multi"
 	TypeBackend Type = "backend"
+	TypeDataset Type = "dataset" // resolved by the proxy into TypeMulti (see DatasetBody)
 )
 
 const PrefixJobID = "dnl-"
@@ -54,6 +58,7 @@ type (
 		ScheduledCnt  int       `json:"scheduled_cnt"` // tasks being processed or already processed by dispatched
 		SkippedCnt    int       `json:"skipped_cnt"`   // number of tasks skipped
 		ErrorCnt      int       `json:"error_cnt"`
+		Size          int64     `json:"size"`           // total downloaded bytes
 		Total         int       `json:"total"`          // total number of tasks, negative if unknown
 		AllDispatched bool      `json:"all_dispatched"` // if true, dispatcher has already scheduled all tasks for given job
 		Aborted       bool      `json:"aborted"`
@@ -79,6 +84,25 @@ type (
 		Timeout          string  `json:"timeout"`
 		ProgressInterval string  `json:"progress_interval"`
 		Limits           Limits  `json:"limits"`
+
+		// source (link) requests: custom headers (e.g., "Authorization", "Cookie") and
+		// PEM-encoded client certificate and key (mTLS), to download from authenticated endpoints
+		// (not persisted and not included in the job's status)
+		Headers    cos.StrKVs `json:"headers,omitempty"`
+		ClientCert string     `json:"client_cert,omitempty"`
+		ClientKey  string     `json:"client_key,omitempty"`
+
+		// post-download (optional, see post.go):
+		// - validate downloaded objects against the manifest: object name => expected size and/or checksum
+		// - transform downloaded objects in place with a named (and running) ETL
+		Manifest map[string]ManifestEntry `json:"manifest,omitempty"`
+		ETLName  string                   `json:"etl_name,omitempty"`
+	}
+
+	ManifestEntry struct {
+		CksumType string `json:"checksum_type,omitempty"` // e.g., "md5", "sha256"
+		Cksum     string `json:"checksum,omitempty"`
+		Size      int64  `json:"size,string,omitempty"`
 	}
 
 	SingleObj struct {
@@ -146,6 +170,7 @@ func (j *Job) Aggregate(rhs *Job) {
 	j.ScheduledCnt += rhs.ScheduledCnt
 	j.SkippedCnt += rhs.SkippedCnt
 	j.ErrorCnt += rhs.ErrorCnt
+	j.Size += rhs.Size
 	j.Total += rhs.Total
 	j.AllDispatched = j.AllDispatched && rhs.AllDispatched
 	j.Aborted = j.Aborted || rhs.Aborted
@@ -193,6 +218,49 @@ func (j *Job) PendingCnt() int {
 	return pending
 }
 
+// Elapsed returns job's running time so far (or total, when finished).
+func (j *Job) Elapsed() time.Duration {
+	if cos.IsTimeZero(j.StartedTime) {
+		return 0
+	}
+	if _isRunning(j.FinishedTime) {
+		return time.Since(j.StartedTime)
+	}
+	return j.FinishedTime.Sub(j.StartedTime)
+}
+
+// Throughput returns average download throughput (bytes per second).
+func (j *Job) Throughput() int64 {
+	elapsed := j.Elapsed()
+	if elapsed < time.Second {
+		return 0
+	}
+	return int64(float64(j.Size) / elapsed.Seconds())
+}
+
+// ETA estimates remaining time based on the average task completion rate;
+// returns zero when finished or not (yet) computable (e.g., total unknown).
+func (j *Job) ETA() time.Duration {
+	done := j.DoneCnt()
+	if j.Total <= 0 || done == 0 || j.JobFinished() {
+		return 0
+	}
+	pending := j.Total - done
+	if pending <= 0 {
+		return 0
+	}
+	return time.Duration(float64(j.Elapsed()) / float64(done) * float64(pending))
+}
+
+// ErrRate returns the fraction of completed tasks that have failed.
+func (j *Job) ErrRate() float64 {
+	done := j.DoneCnt()
+	if done == 0 {
+		return 0
+	}
+	return float64(j.ErrorCnt) / float64(done)
+}
+
 func (j *Job) String() string {
 	var (
 		sb       strings.Builder
@@ -301,9 +369,48 @@ func (b *Base) Validate() error {
 	if b.Limits.BytesPerHour < 0 {
 		return fmt.Errorf("'limit.bytes_per_hour' must be non-negative (got: %d)", b.Limits.BytesPerHour)
 	}
+	for name, value := range b.Headers {
+		if name == "" || strings.ContainsAny(name, " \t\r\n:") || strings.ContainsAny(value, "\r\n") {
+			return fmt.Errorf("invalid header %q", name)
+		}
+		switch textproto.CanonicalMIMEHeaderKey(name) {
+		case "Host", "Range", "Content-Length":
+			return fmt.Errorf("header %q cannot be specified", name)
+		}
+	}
+	if (b.ClientCert == "") != (b.ClientKey == "") {
+		return errors.New("client certificate and key must be specified together (or none)")
+	}
+	if b.ClientCert != "" {
+		if _, err := tls.X509KeyPair([]byte(b.ClientCert), []byte(b.ClientKey)); err != nil {
+			return fmt.Errorf("invalid client certificate: %v", err)
+		}
+	}
+	for name, e := range b.Manifest {
+		if err := e.Validate(); err != nil {
+			return fmt.Errorf("invalid manifest entry %q: %v", name, err)
+		}
+	}
 	return nil
 }
 
+// per-job http client (nil: use the default one)
+func (b *Base) client(timeout time.Duration) (*http.Client, error) {
+	if b.ClientCert == "" {
+		return nil, nil
+	}
+	cert, err := tls.X509KeyPair([]byte(b.ClientCert), []byte(b.ClientKey))
+	if err != nil {
+		return nil, err
+	}
+	transport := cmn.NewTransport(cmn.TransportArgs{Timeout: timeout})
+	transport.TLSClientConfig = &tls.Config{
+		Certificates:       []tls.Certificate{cert},
+		InsecureSkipVerify: true, //nolint:gosec // same as the default downloader's client (see cmn.NewDefaultClients)
+	}
+	return &http.Client{Transport: transport, Timeout: timeout}, nil
+}
+
 ///////////////
 // SingleObj //
 ///////////////
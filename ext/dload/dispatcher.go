@@ -54,6 +54,8 @@ type (
 		// Certification check is disabled for now and does not depend on cluster settings.
 		clientH   *http.Client
 		clientTLS *http.Client
+
+		transform TransformFunc // post-download ETL (see SetTransform)
 	}
 )
 
@@ -256,7 +258,7 @@ func (d *dispatcher) dispatchDownload(job jobif) (ok bool) {
 				if _, err := core.T.EvictObject(result.Src); err != nil {
 					task.markFailed(err.Error())
 				} else {
-					g.store.incFinished(job.ID())
+					g.store.incFinished(job.ID(), 0)
 				}
 				continue
 			}
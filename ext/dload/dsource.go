@@ -0,0 +1,263 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Dataset download (TypeDataset):
+// - the job names a dataset repository - e.g., `{"hf": "org/dataset"}` - rather than the links
+// - the proxy resolves the repository via the corresponding source plugin (manifest parsing,
+//   pagination, multi-file resolution) and starts a regular multi-download (TypeMulti)
+//   of the resulting (object name => link) set
+// - auth: the job's custom headers (Base.Headers) apply both to resolving and downloading
+//   (e.g., "Authorization: Bearer <HF token>" or Kaggle's "Authorization: Basic <user:key>")
+
+const (
+	SourceHF     = "hf"
+	SourceKaggle = "kaggle"
+	SourceGitLFS = "git_lfs"
+)
+
+const dsrcMaxFiles = 1024 * 1024
+
+type (
+	DatasetBody struct {
+		Base
+		HF       string   `json:"hf,omitempty"`       // Hugging Face dataset, e.g. "org/dataset"
+		Kaggle   string   `json:"kaggle,omitempty"`   // Kaggle dataset, e.g. "owner/dataset"
+		GitLFS   string   `json:"git_lfs,omitempty"`  // Git repository URL, e.g. "https://github.com/org/repo"
+		Revision string   `json:"revision,omitempty"` // branch, tag, or commit (Kaggle: dataset version number)
+		Files    []string `json:"files,omitempty"`    // selected files (all, if empty; required for Git LFS)
+		Prefix   string   `json:"prefix,omitempty"`   // only the files under this path in the repository
+		Subdir   string   `json:"subdir,omitempty"`   // destination virtual directory
+	}
+
+	// source plugin
+	dsource interface {
+		// resolve the dataset repository into (object name => download link) - see dsctx.add
+		resolve(dc *dsctx, repo string) error
+	}
+
+	// resolving context
+	dsctx struct {
+		ctx    context.Context
+		client *http.Client
+		hdr    http.Header
+		body   *DatasetBody
+		files  cos.StrSet
+		objs   cos.StrKVs
+	}
+)
+
+var dsources = map[string]dsource{
+	SourceHF:     &hfSource{},
+	SourceKaggle: &kaggleSource{},
+	SourceGitLFS: &lfsSource{},
+}
+
+var (
+	errNoSource   = errors.New("missing dataset source (expecting one of: hf, kaggle, git_lfs)")
+	errManySource = errors.New("dataset source must be specified only once (one of: hf, kaggle, git_lfs)")
+
+	repoRegex = regexp.MustCompile(`^[\w.-]+/[\w.-]+$`)
+)
+
+/////////////////
+// DatasetBody //
+/////////////////
+
+// returns the source name and the repository
+func (b *DatasetBody) source() (name, repo string, err error) {
+	for n, r := range map[string]string{SourceHF: b.HF, SourceKaggle: b.Kaggle, SourceGitLFS: b.GitLFS} {
+		if r == "" {
+			continue
+		}
+		if name != "" {
+			return "", "", errManySource
+		}
+		name, repo = n, r
+	}
+	if name == "" {
+		err = errNoSource
+	}
+	return name, repo, err
+}
+
+func (b *DatasetBody) Validate() error {
+	if err := b.Base.Validate(); err != nil {
+		return err
+	}
+	name, repo, err := b.source()
+	if err != nil {
+		return err
+	}
+	switch name {
+	case SourceGitLFS:
+		if !cos.IsHTTPS(repo) && !cos.IsHTTP(repo) {
+			return fmt.Errorf("invalid %s repository URL %q", name, repo)
+		}
+		if len(b.Files) == 0 {
+			return fmt.Errorf("%s: missing 'files' (a Git repository cannot be listed over HTTP)", name)
+		}
+	default:
+		if !repoRegex.MatchString(repo) {
+			return fmt.Errorf("invalid %s dataset %q (expecting \"owner/name\")", name, repo)
+		}
+	}
+	return nil
+}
+
+func (b *DatasetBody) Describe() string {
+	if b.Description != "" {
+		return b.Description
+	}
+	name, repo, _ := b.source()
+	return fmt.Sprintf("%s:%s -> %s", name, repo, b.Bck)
+}
+
+func (b *DatasetBody) revision(dflt string) string {
+	if b.Revision != "" {
+		return b.Revision
+	}
+	return dflt
+}
+
+// Expand resolves the dataset and returns the equivalent multi-download
+func (b *DatasetBody) Expand(ctx context.Context, timeout time.Duration) (*MultiBody, error) {
+	name, repo, err := b.source()
+	if err != nil {
+		return nil, err
+	}
+	client, err := b.client(timeout)
+	if err != nil {
+		return nil, err
+	}
+	if client == nil {
+		client = cmn.NewClient(cmn.TransportArgs{Timeout: timeout, UseHTTPProxyEnv: true})
+	}
+	defer client.CloseIdleConnections()
+
+	dc := &dsctx{ctx: ctx, client: client, body: b, objs: make(cos.StrKVs, 64)}
+	if len(b.Headers) > 0 {
+		dc.hdr = make(http.Header, len(b.Headers))
+		for k, v := range b.Headers {
+			dc.hdr.Set(k, v)
+		}
+	}
+	if len(b.Files) > 0 {
+		dc.files = cos.NewStrSet(b.Files...)
+	}
+	if err := dsources[name].resolve(dc, repo); err != nil {
+		return nil, fmt.Errorf("%s:%s: %w", name, repo, err)
+	}
+	objs := dc.objs
+	if len(objs) == 0 {
+		return nil, fmt.Errorf("%s:%s: no files to download (revision %q, prefix %q)", name, repo, b.Revision, b.Prefix)
+	}
+	if dc.files != nil && len(objs) < len(dc.files) {
+		for f := range dc.files {
+			if _, ok := objs[path.Join(b.Subdir, f)]; !ok {
+				return nil, fmt.Errorf("%s:%s: file %q not found", name, repo, f)
+			}
+		}
+	}
+	mb := &MultiBody{Base: b.Base, ObjectsPayload: objs}
+	mb.Description = b.Describe()
+	return mb, nil
+}
+
+///////////
+// dsctx //
+///////////
+
+// add a given repository file (path) to download, if selected
+func (dc *dsctx) add(fpath, link string) error {
+	if dc.body.Prefix != "" && !strings.HasPrefix(fpath, dc.body.Prefix) {
+		return nil
+	}
+	if dc.files != nil && !dc.files.Contains(fpath) {
+		return nil
+	}
+	if len(dc.objs) >= dsrcMaxFiles {
+		return fmt.Errorf("too many files (max %d)", dsrcMaxFiles)
+	}
+	dc.objs[path.Join(dc.body.Subdir, fpath)] = link
+	return nil
+}
+
+func (dc *dsctx) do(method, u string, body []byte, accept string) (*http.Response, error) {
+	var reader io.Reader = http.NoBody
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(dc.ctx, method, u, reader)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range dc.hdr {
+		req.Header[k] = v
+	}
+	req.Header.Set(cos.HdrAccept, accept)
+	if body != nil {
+		req.Header.Set(cos.HdrContentType, accept)
+	}
+	resp, err := dc.client.Do(req) //nolint:bodyclose // closed by the caller
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		cos.Close(resp.Body)
+		return nil, fmt.Errorf("%s %s: %s %q", method, u, resp.Status, strings.TrimSpace(string(b)))
+	}
+	return resp, nil
+}
+
+// GET JSON; returns response header (e.g., to paginate)
+func (dc *dsctx) getJSON(u string, v any) (http.Header, error) {
+	resp, err := dc.do(http.MethodGet, u, nil, cos.ContentJSON)
+	if err != nil {
+		return nil, err
+	}
+	err = jsoniter.NewDecoder(resp.Body).Decode(v)
+	cos.Close(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("GET %s: failed to decode response: %v", u, err)
+	}
+	return resp.Header, nil
+}
+
+// `Link: <url>; rel="next"` (RFC 8288)
+func nextLink(hdr http.Header) string {
+	for _, v := range hdr.Values("Link") {
+		for _, l := range strings.Split(v, ",") {
+			parts := strings.Split(l, ";")
+			if len(parts) < 2 {
+				continue
+			}
+			for _, p := range parts[1:] {
+				if strings.TrimSpace(p) == `rel="next"` {
+					return strings.Trim(strings.TrimSpace(parts[0]), "<>")
+				}
+			}
+		}
+	}
+	return ""
+}
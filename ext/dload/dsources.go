@@ -0,0 +1,262 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// dataset source plugins (see dsource.go)
+
+const (
+	hfEndpoint     = "https://huggingface.co"
+	kaggleEndpoint = "https://www.kaggle.com/api/v1"
+)
+
+const (
+	lfsMediaType  = "application/vnd.git-lfs+json"
+	lfsPointerVer = "version https://git-lfs.github.com/spec/"
+	lfsPointerMax = 1024 // (pointer files are much smaller)
+	lfsBatchSize  = 100
+)
+
+type (
+	hfSource     struct{}
+	kaggleSource struct{}
+	lfsSource    struct{}
+)
+
+// interface guard
+var (
+	_ dsource = (*hfSource)(nil)
+	_ dsource = (*kaggleSource)(nil)
+	_ dsource = (*lfsSource)(nil)
+)
+
+func escPath(p string) string { return (&url.URL{Path: p}).EscapedPath() }
+
+//////////////////
+// Hugging Face //
+//////////////////
+
+// GET /api/datasets/<repo>/tree/<revision>[/<dir>]?recursive=true (paginated via the Link header);
+// download via /datasets/<repo>/resolve/<revision>/<path> (including LFS-stored files)
+func (*hfSource) resolve(dc *dsctx, repo string) error {
+	var (
+		rev = url.PathEscape(dc.body.revision("main"))
+		u   = hfEndpoint + "/api/datasets/" + repo + "/tree/" + rev
+	)
+	if i := strings.LastIndexByte(dc.body.Prefix, '/'); i > 0 {
+		u += "/" + escPath(dc.body.Prefix[:i])
+	}
+	u += "?recursive=true"
+	for u != "" {
+		var entries []struct {
+			Type string `json:"type"` // enum { "file", "directory" }
+			Path string `json:"path"`
+		}
+		hdr, err := dc.getJSON(u, &entries)
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			if e.Type != "file" {
+				continue
+			}
+			link := hfEndpoint + "/datasets/" + repo + "/resolve/" + rev + "/" + escPath(e.Path)
+			if err := dc.add(e.Path, link); err != nil {
+				return err
+			}
+		}
+		u = nextLink(hdr)
+	}
+	return nil
+}
+
+////////////
+// Kaggle //
+////////////
+
+// GET /datasets/list/<owner>/<dataset> (paginated via `nextPageToken`);
+// download via /datasets/download/<owner>/<dataset>/<file>
+// (Kaggle may serve a large file zipped - the object then contains the archive)
+func (*kaggleSource) resolve(dc *dsctx, repo string) error {
+	var token string
+	for {
+		var (
+			q    = make(url.Values, 2)
+			page struct {
+				Files []struct {
+					Name string `json:"name"`
+				} `json:"datasetFiles"`
+				Next  string `json:"nextPageToken"`
+				Error string `json:"errorMessage"`
+			}
+		)
+		if dc.body.Revision != "" {
+			q.Set("datasetVersionNumber", dc.body.Revision)
+		}
+		query := q.Encode()
+		if token != "" {
+			q.Set("pageToken", token)
+		}
+		u := kaggleEndpoint + "/datasets/list/" + repo
+		if len(q) > 0 {
+			u += "?" + q.Encode()
+		}
+		if _, err := dc.getJSON(u, &page); err != nil {
+			return err
+		}
+		if page.Error != "" {
+			return fmt.Errorf("GET %s: %s", u, page.Error)
+		}
+		for _, f := range page.Files {
+			link := kaggleEndpoint + "/datasets/download/" + repo + "/" + escPath(f.Name)
+			if query != "" {
+				link += "?" + query
+			}
+			if err := dc.add(f.Name, link); err != nil {
+				return err
+			}
+		}
+		if page.Next == "" || page.Next == token {
+			return nil
+		}
+		token = page.Next
+	}
+}
+
+/////////////
+// Git LFS //
+/////////////
+
+type (
+	lfsPointer struct {
+		Oid  string `json:"oid"`
+		Size int64  `json:"size"`
+	}
+	lfsBatchReq struct {
+		Operation string       `json:"operation"`
+		Transfers []string     `json:"transfers"`
+		Objects   []lfsPointer `json:"objects"`
+	}
+	lfsBatchResp struct {
+		Objects []struct {
+			lfsPointer
+			Actions struct {
+				Download *struct {
+					Href string `json:"href"`
+				} `json:"download"`
+			} `json:"actions"`
+			Error *struct {
+				Message string `json:"message"`
+				Code    int    `json:"code"`
+			} `json:"error"`
+		} `json:"objects"`
+	}
+)
+
+// for each selected file: GET <repo>/raw/<revision>/<file> (GitHub-style URL); if it is
+// an LFS pointer, resolve the content via the Git LFS batch API - otherwise, download
+// the file as is
+// NOTE: batch API download links are typically signed and expire (in hours)
+func (*lfsSource) resolve(dc *dsctx, repo string) error {
+	var (
+		rev   = url.PathEscape(dc.body.revision("main"))
+		oids  = make(map[string][]string, len(dc.body.Files)) // oid => file(s)
+		ptrs  = make([]lfsPointer, 0, len(dc.body.Files))
+		files = make(cos.StrSet, len(dc.body.Files))
+	)
+	repo = strings.TrimSuffix(strings.TrimSuffix(repo, "/"), ".git")
+	for _, f := range dc.body.Files {
+		if files.Contains(f) {
+			continue
+		}
+		files.Add(f)
+		raw := repo + "/raw/" + rev + "/" + escPath(f)
+		ptr, ok, err := dc.lfsPointer(raw)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			if err := dc.add(f, raw); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, ok := oids[ptr.Oid]; !ok {
+			ptrs = append(ptrs, ptr)
+		}
+		oids[ptr.Oid] = append(oids[ptr.Oid], f)
+	}
+	for i := 0; i < len(ptrs); i += lfsBatchSize {
+		if err := dc.lfsBatch(repo+".git/info/lfs/objects/batch", ptrs[i:min(i+lfsBatchSize, len(ptrs))], oids); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// returns false if not a pointer
+func (dc *dsctx) lfsPointer(raw string) (ptr lfsPointer, ok bool, err error) {
+	resp, err := dc.do(http.MethodGet, raw, nil, "*/*")
+	if err != nil {
+		return ptr, false, err
+	}
+	b, err := io.ReadAll(io.LimitReader(resp.Body, lfsPointerMax+1))
+	cos.Close(resp.Body)
+	if err != nil || len(b) > lfsPointerMax || !strings.HasPrefix(string(b), lfsPointerVer) {
+		return ptr, false, err
+	}
+	for _, line := range strings.Split(string(b), "\n") {
+		k, v, _ := strings.Cut(strings.TrimSpace(line), " ")
+		switch k {
+		case "oid":
+			ptr.Oid = strings.TrimPrefix(v, "sha256:")
+		case "size":
+			_, err = fmt.Sscanf(v, "%d", &ptr.Size)
+		}
+	}
+	if ptr.Oid == "" || err != nil {
+		return ptr, false, fmt.Errorf("%s: invalid LFS pointer %q", raw, cos.BHead(b))
+	}
+	return ptr, true, nil
+}
+
+func (dc *dsctx) lfsBatch(u string, ptrs []lfsPointer, oids map[string][]string) error {
+	body := cos.MustMarshal(&lfsBatchReq{Operation: "download", Transfers: []string{"basic"}, Objects: ptrs})
+	resp, err := dc.do(http.MethodPost, u, body, lfsMediaType)
+	if err != nil {
+		return err
+	}
+	var out lfsBatchResp
+	err = jsoniter.NewDecoder(resp.Body).Decode(&out)
+	cos.Close(resp.Body)
+	if err != nil {
+		return fmt.Errorf("POST %s: failed to decode response: %v", u, err)
+	}
+	for i := range out.Objects {
+		o := &out.Objects[i]
+		if o.Error != nil {
+			return fmt.Errorf("LFS object %s (%v): %s (%d)", o.Oid, oids[o.Oid], o.Error.Message, o.Error.Code)
+		}
+		if o.Actions.Download == nil {
+			return fmt.Errorf("LFS object %s (%v): missing download action", o.Oid, oids[o.Oid])
+		}
+		for _, f := range oids[o.Oid] {
+			if err := dc.add(f, o.Actions.Download.Href); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
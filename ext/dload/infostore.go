@@ -65,6 +65,7 @@ func (is *infoStore) setJob(job jobif) (njob *dljob) {
 		total:       job.Len(),
 		description: job.Description(),
 		startedTime: time.Now(),
+		stats:       newJobStats(job.ID()),
 	}
 	is.Lock()
 	is.dljobs[job.ID()] = njob
@@ -72,10 +73,12 @@ func (is *infoStore) setJob(job jobif) (njob *dljob) {
 	return
 }
 
-func (is *infoStore) incFinished(id string) {
+func (is *infoStore) incFinished(id string, size int64) {
 	dljob, err := is.getJob(id)
 	debug.AssertNoErr(err)
 	dljob.finishedCnt.Inc()
+	dljob.size.Add(size)
+	dljob.stats.finished(dljob, size)
 }
 
 func (is *infoStore) incSkipped(id string) {
@@ -83,6 +86,7 @@ func (is *infoStore) incSkipped(id string) {
 	debug.AssertNoErr(err)
 	dljob.skippedCnt.Inc()
 	dljob.finishedCnt.Inc()
+	dljob.stats.skipped(dljob)
 }
 
 func (is *infoStore) incScheduled(id string) {
@@ -95,6 +99,7 @@ func (is *infoStore) incErrorCnt(id string) {
 	dljob, err := is.getJob(id)
 	debug.AssertNoErr(err)
 	dljob.errorCnt.Inc()
+	dljob.stats.failed(dljob)
 }
 
 func (is *infoStore) setAllDispatched(id string, dispatched bool) {
@@ -110,6 +115,7 @@ func (is *infoStore) markFinished(id string) (error, bool /*aborted*/) {
 		return err, false
 	}
 	dljob.finishedTime.Store(time.Now())
+	dljob.stats.update(dljob) // (zero ETA)
 	return dljob.valid(), dljob.aborted.Load()
 }
 
@@ -7,6 +7,7 @@ package dload
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"path"
 	"strings"
 	"time"
@@ -68,6 +69,12 @@ type (
 		// via tryAcquire and release
 		throttler() *throttler
 
+		// apply job's source options to the request to download a given link
+		srcReq(req *http.Request) *http.Client
+
+		// post-download validation and/or transformation (see post.go)
+		postDl(lom *core.LOM, timeout time.Duration) error
+
 		// job cleanup
 		cleanup()
 	}
@@ -76,6 +83,10 @@ type (
 		bck         *meta.Bck
 		notif       *NotifDownload
 		xdl         *Xact
+		hdr         http.Header  // custom source request headers
+		client      *http.Client // per-job (nil: default)
+		manifest    map[string]ManifestEntry
+		etlName     string
 		id          string
 		description string
 		timeout     time.Duration
@@ -123,6 +134,8 @@ type (
 		scheduledCnt  atomic.Int32
 		skippedCnt    atomic.Int32
 		errorCnt      atomic.Int32
+		size          atomic.Int64 // total downloaded bytes
+		stats         *jobStats
 		total         int
 		aborted       atomic.Bool
 		allDispatched atomic.Bool
@@ -133,13 +146,14 @@ type (
 // baseDlJob //
 ///////////////
 
-func (j *baseDlJob) init(id string, bck *meta.Bck, timeout, desc string, limits Limits, xdl *Xact) {
+func (j *baseDlJob) init(id string, bck *meta.Bck, base *Base, desc string, xdl *Xact) (err error) {
 	// TODO: this might be inaccurate if we download 1 or 2 objects because then
 	//  other targets will have limits but will not use them.
+	limits := base.Limits
 	if limits.BytesPerHour > 0 {
 		limits.BytesPerHour /= core.T.Sowner().Get().CountActiveTs()
 	}
-	td, _ := time.ParseDuration(timeout)
+	td, _ := time.ParseDuration(base.Timeout)
 	{
 		j.id = id
 		j.bck = bck
@@ -147,7 +161,17 @@ func (j *baseDlJob) init(id string, bck *meta.Bck, timeout, desc string, limits
 		j.description = desc
 		j.throt.init(limits)
 		j.xdl = xdl
+		j.manifest = base.Manifest
+		j.etlName = base.ETLName
+	}
+	if len(base.Headers) > 0 {
+		j.hdr = make(http.Header, len(base.Headers))
+		for name, value := range base.Headers {
+			j.hdr.Set(name, value)
+		}
 	}
+	j.client, err = base.client(cmn.GCO.Get().Client.TimeoutLong.D())
+	return err
 }
 
 func (j *baseDlJob) ID() string             { return j.id }
@@ -190,6 +214,16 @@ func (j *baseDlJob) ActiveStats() (*StatusResp, error) {
 func (*baseDlJob) checkObj(string) bool    { debug.Assert(false); return false }
 func (j *baseDlJob) throttler() *throttler { return &j.throt }
 
+func (j *baseDlJob) srcReq(req *http.Request) *http.Client {
+	for name, values := range j.hdr {
+		req.Header[name] = values
+	}
+	if j.client != nil {
+		return j.client
+	}
+	return clientForURL(req.URL.String())
+}
+
 func (j *baseDlJob) cleanup() {
 	j.throttler().stop()
 	err, aborted := g.store.markFinished(j.ID())
@@ -235,7 +269,9 @@ func newMultiDlJob(id string, bck *meta.Bck, payload *MultiBody, xdl *Xact) (mj
 	var objs cos.StrKVs
 
 	mj = &multiDlJob{}
-	mj.baseDlJob.init(id, bck, payload.Timeout, payload.Describe(), payload.Limits, xdl)
+	if err = mj.baseDlJob.init(id, bck, &payload.Base, payload.Describe(), xdl); err != nil {
+		return nil, err
+	}
 
 	if objs, err = payload.ExtractPayload(); err != nil {
 		return nil, err
@@ -250,7 +286,9 @@ func newSingleDlJob(id string, bck *meta.Bck, payload *SingleBody, xdl *Xact) (s
 	var objs cos.StrKVs
 
 	sj = &singleDlJob{}
-	sj.baseDlJob.init(id, bck, payload.Timeout, payload.Describe(), payload.Limits, xdl)
+	if err = sj.baseDlJob.init(id, bck, &payload.Base, payload.Describe(), xdl); err != nil {
+		return nil, err
+	}
 
 	if objs, err = payload.ExtractPayload(); err != nil {
 		return nil, err
@@ -273,7 +311,9 @@ func newRangeDlJob(id string, bck *meta.Bck, payload *RangeBody, xdl *Xact) (rj
 	if rj.pt, err = cos.ParseBashTemplate(payload.Template); err != nil {
 		return nil, err
 	}
-	rj.baseDlJob.init(id, bck, payload.Timeout, payload.Describe(), payload.Limits, xdl)
+	if err = rj.baseDlJob.init(id, bck, &payload.Base, payload.Describe(), xdl); err != nil {
+		return nil, err
+	}
 
 	if rj.count, err = countObjects(rj.pt, payload.Subdir, rj.bck); err != nil {
 		return nil, err
@@ -336,7 +376,9 @@ func newBackendDlJob(id string, bck *meta.Bck, payload *BackendBody, xdl *Xact)
 		return nil, errors.New("bucket download does not support HTTP buckets")
 	}
 	bj = &backendDlJob{}
-	bj.baseDlJob.init(id, bck, payload.Timeout, payload.Describe(), payload.Limits, xdl)
+	if err = bj.baseDlJob.init(id, bck, &payload.Base, payload.Describe(), xdl); err != nil {
+		return nil, err
+	}
 	{
 		bj.sync = payload.Sync
 		bj.prefix = payload.Prefix
@@ -420,6 +462,7 @@ func (j *dljob) clone() Job {
 		ScheduledCnt:  int(j.scheduledCnt.Load()),
 		SkippedCnt:    int(j.skippedCnt.Load()),
 		ErrorCnt:      int(j.errorCnt.Load()),
+		Size:          j.size.Load(),
 		Total:         j.total,
 		AllDispatched: j.allDispatched.Load(),
 		Aborted:       j.aborted.Load(),
@@ -0,0 +1,114 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/core"
+)
+
+// Post-download step (optional, see Base.Manifest and Base.ETLName) - runs on each
+// successfully downloaded object, in this order:
+// - validate the object's size and/or checksum against its manifest entry, if any;
+//   objects that are not listed in the manifest are not validated
+// - transform the object in place with a named ETL
+// Objects that fail either step remain stored as downloaded (or transformed) and get
+// counted as errors, with the reason listed in the job's errors (see StatusResp.Errs).
+
+// transforms a given object with a named ETL; provided by the target (to avoid
+// this package's dependency on ext/etl)
+type TransformFunc func(lom *core.LOM, etlName string, timeout time.Duration) (cos.ReadCloseSizer, error)
+
+func SetTransform(f TransformFunc) { g.transform = f }
+
+// returns non-nil error if the object fails validation or transformation
+func (j *baseDlJob) postDl(lom *core.LOM, timeout time.Duration) error {
+	if e, ok := j.manifest[lom.ObjName]; ok {
+		if err := e.validate(lom); err != nil {
+			return fmt.Errorf("validation failed: %v", err)
+		}
+	}
+	if j.etlName == "" {
+		return nil
+	}
+	if err := j.transform(lom, timeout); err != nil {
+		return fmt.Errorf("etl %q failed: %v", j.etlName, err)
+	}
+	return nil
+}
+
+func (j *baseDlJob) transform(lom *core.LOM, timeout time.Duration) error {
+	debug.Assert(g.transform != nil)
+	r, err := g.transform(lom, j.etlName, timeout)
+	if err != nil {
+		return err
+	}
+	params := core.AllocPutParams()
+	{
+		params.WorkTag = "dl-etl"
+		params.Reader = r
+		params.OWT = cmn.OwtTransform
+		params.Atime = time.Now()
+		params.Size = r.Size()
+		params.Xact = j.xdl
+	}
+	err = core.T.PutObject(lom, params)
+	core.FreePutParams(params)
+	if err != nil {
+		return err
+	}
+	return lom.Load(true /*cache it*/, false /*locked*/)
+}
+
+///////////////////
+// ManifestEntry //
+///////////////////
+
+func (e *ManifestEntry) Validate() error {
+	if e.Size < 0 {
+		return fmt.Errorf("invalid size %d", e.Size)
+	}
+	if e.Cksum == "" {
+		if e.CksumType != "" {
+			return fmt.Errorf("checksum type %q without checksum value", e.CksumType)
+		}
+		return nil
+	}
+	if e.CksumType == "" || e.CksumType == cos.ChecksumNone {
+		return fmt.Errorf("checksum %q without checksum type", e.Cksum)
+	}
+	return cos.ValidateCksumType(e.CksumType)
+}
+
+func (e *ManifestEntry) validate(lom *core.LOM) error {
+	if e.Size > 0 && lom.Lsize() != e.Size {
+		return fmt.Errorf("%s size %d differs from the manifest's %d", lom.Cname(), lom.Lsize(), e.Size)
+	}
+	if e.Cksum == "" {
+		return nil
+	}
+	expected := cos.NewCksum(e.CksumType, e.Cksum)
+	if cksum := lom.Checksum(); cksum.Type() == e.CksumType && cksum.Value() != "" {
+		if !cksum.Equal(expected) {
+			return cos.NewErrDataCksum(cksum, expected, lom.Cname())
+		}
+		return nil
+	}
+	lom.Lock(false)
+	cksumH, err := lom.ComputeCksum(e.CksumType)
+	lom.Unlock(false)
+	if err != nil {
+		return err
+	}
+	if !cksumH.Equal(expected) {
+		return cos.NewErrDataCksum(&cksumH.Cksum, expected, lom.Cname())
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Per-job accounting, in addition to the aggregated "dl.*" metrics:
+// - exported as target metrics "dl.<JOB-ID>.*" (Prometheus: "dl_job_*" labeled with job=<JOB-ID>)
+//   and, therefore, included in the node stats (GetWhatStats)
+// - number of downloaded objects and failures, throughput (cumulative size via REST API),
+//   and two gauges that get updated upon each completed task: ETA (seconds) and error rate (percent)
+//   - see Job.ETA and Job.ErrRate
+
+type jobStats struct {
+	cnt, errs, bps, eta, errRate string // metric names
+}
+
+func newJobStats(id string) *jobStats {
+	prefix := "dl." + id + "."
+	js := &jobStats{cnt: prefix + "n", errs: prefix + "err.n", bps: prefix + "bps", eta: prefix + "eta", errRate: prefix + "err.pct"}
+	if g.tstats == nil {
+		return js // (unit tests)
+	}
+	var (
+		snode  = core.T.Snode()
+		labels = cos.StrKVs{"job": id}
+	)
+	g.tstats.RegExtMetric(snode, js.cnt, stats.KindCounter,
+		&stats.Extra{
+			Help:    "download job: total number of finished (downloaded) objects",
+			StrName: "dl_job_count",
+			Labels:  labels,
+		},
+	)
+	g.tstats.RegExtMetric(snode, js.errs, stats.KindCounter,
+		&stats.Extra{
+			Help:    "download job: total number of failed downloads",
+			StrName: "dl_job_err_count",
+			Labels:  labels,
+		},
+	)
+	g.tstats.RegExtMetric(snode, js.bps, stats.KindThroughput,
+		&stats.Extra{
+			Help:    "download job: average throughput (MB/s) over the last periodic.stats_time interval",
+			StrName: "dl_job_mbps",
+			Labels:  labels,
+		},
+	)
+	g.tstats.RegExtMetric(snode, js.eta, stats.KindGauge,
+		&stats.Extra{
+			Help:    "download job: estimated time to completion (seconds); zero when finished or unknown",
+			StrName: "dl_job_eta_seconds",
+			Labels:  labels,
+		},
+	)
+	g.tstats.RegExtMetric(snode, js.errRate, stats.KindGauge,
+		&stats.Extra{
+			Help:    "download job: percentage of completed tasks that have failed",
+			StrName: "dl_job_err_pct",
+			Labels:  labels,
+		},
+	)
+	return js
+}
+
+func (js *jobStats) finished(dljob *dljob, size int64) {
+	js.update(dljob, cos.NamedVal64{Name: js.cnt, Value: 1}, cos.NamedVal64{Name: js.bps, Value: size})
+}
+
+func (js *jobStats) failed(dljob *dljob) { js.update(dljob, cos.NamedVal64{Name: js.errs, Value: 1}) }
+
+func (js *jobStats) skipped(dljob *dljob) { js.update(dljob) }
+
+// (gauges are set rather than added - see stats.KindGauge)
+func (js *jobStats) update(dljob *dljob, nvs ...cos.NamedVal64) {
+	if g.tstats == nil {
+		return
+	}
+	job := dljob.clone()
+	nvs = append(nvs,
+		cos.NamedVal64{Name: js.eta, Value: int64(job.ETA().Seconds())},
+		cos.NamedVal64{Name: js.errRate, Value: int64(job.ErrRate() * 100)},
+	)
+	g.tstats.AddMany(nvs...)
+}
@@ -0,0 +1,93 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// records registered metrics and their values
+type statsRec struct {
+	mock.StatsTracker
+	kinds  map[string]string
+	labels map[string]cos.StrKVs
+	vals   map[string]int64
+}
+
+func (r *statsRec) RegExtMetric(_ *meta.Snode, name, kind string, extra *stats.Extra) {
+	r.kinds[name] = kind
+	r.labels[name] = extra.Labels
+}
+
+func (r *statsRec) AddMany(nvs ...cos.NamedVal64) {
+	for _, nv := range nvs {
+		if r.kinds[nv.Name] == stats.KindGauge {
+			r.vals[nv.Name] = nv.Value
+		} else {
+			r.vals[nv.Name] += nv.Value
+		}
+	}
+}
+
+func TestJobStats(t *testing.T) {
+	const id = PrefixJobID + "test"
+	rec := &statsRec{kinds: map[string]string{}, labels: map[string]cos.StrKVs{}, vals: map[string]int64{}}
+	saved := g.tstats
+	g.tstats = rec
+	defer func() { g.tstats = saved }()
+	core.T = mock.NewTarget(mock.NewBaseBownerMock())
+
+	dljob := &dljob{id: id, total: 10, startedTime: time.Now().Add(-10 * time.Second)}
+	dljob.stats = newJobStats(id)
+	js := dljob.stats
+	for _, name := range []string{js.cnt, js.errs, js.bps, js.eta, js.errRate} {
+		if _, ok := rec.kinds[name]; !ok {
+			t.Fatalf("metric %q not registered", name)
+		}
+		if rec.labels[name]["job"] != id {
+			t.Fatalf("metric %q: expected label job=%s, got %v", name, id, rec.labels[name])
+		}
+	}
+
+	// 4 downloaded and 1 failed out of 10 in 10s
+	for range 4 {
+		dljob.finishedCnt.Inc()
+		dljob.size.Add(cos.MiB)
+		js.finished(dljob, cos.MiB)
+	}
+	dljob.errorCnt.Inc()
+	js.failed(dljob)
+
+	if n := rec.vals[js.cnt]; n != 4 {
+		t.Errorf("expected 4 downloaded, got %d", n)
+	}
+	if n := rec.vals[js.errs]; n != 1 {
+		t.Errorf("expected 1 failed, got %d", n)
+	}
+	if n := rec.vals[js.bps]; n != 4*cos.MiB {
+		t.Errorf("expected %d bytes, got %d", 4*cos.MiB, n)
+	}
+	if pct := rec.vals[js.errRate]; pct != 20 {
+		t.Errorf("expected error rate 20%%, got %d%%", pct)
+	}
+	if eta := rec.vals[js.eta]; eta < 9 || eta > 11 {
+		t.Errorf("expected ETA ~10s, got %ds", eta)
+	}
+
+	// finished: zero ETA
+	dljob.aborted.Store(true)
+	dljob.finishedTime.Store(time.Now())
+	js.update(dljob)
+	if eta := rec.vals[js.eta]; eta != 0 {
+		t.Errorf("expected zero ETA when finished, got %ds", eta)
+	}
+}
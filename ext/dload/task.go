@@ -89,18 +89,24 @@ func (task *singleTask) download(lom *core.LOM) {
 	}
 	task.ended.Store(time.Now())
 
+	if err == nil {
+		err = task.job.postDl(lom, task.initialTimeout())
+	}
 	if err != nil {
 		task.markFailed(err.Error())
 		return
 	}
 
-	g.store.incFinished(task.jobID())
+	size := task.currentSize.Load()
+	g.store.incFinished(task.jobID(), size)
 
 	g.tstats.AddMany(
-		cos.NamedVal64{Name: stats.DownloadSize, Value: task.currentSize.Load()},
+		cos.NamedVal64{Name: stats.DownloadCount, Value: 1},
+		cos.NamedVal64{Name: stats.DownloadSize, Value: size},
+		cos.NamedVal64{Name: stats.DownloadThroughput, Value: size},
 		cos.NamedVal64{Name: stats.DownloadLatency, Value: int64(task.ended.Load().Sub(task.started.Load()))},
 	)
-	task.xdl.ObjsAdd(1, task.currentSize.Load())
+	task.xdl.ObjsAdd(1, size)
 }
 
 func (task *singleTask) _dlocal(lom *core.LOM, timeout time.Duration) (bool /*err is fatal*/, error) {
@@ -120,7 +126,8 @@ func (task *singleTask) _dlocal(lom *core.LOM, timeout time.Duration) (bool /*er
 		req.Header.Add("User-Agent", gcsUA)
 	}
 
-	resp, err := clientForURL(task.obj.link).Do(req) //nolint:bodyclose // cos.Close
+	client := task.job.srcReq(req)
+	resp, err := client.Do(req) //nolint:bodyclose // cos.Close
 	if err != nil {
 		return false, err
 	}
@@ -159,6 +159,8 @@ func ParseStartRequest(bck *meta.Bck, id string, dlb Body, xdl *Xact) (jobif, er
 			return nil, err
 		}
 		return newSingleDlJob(id, bck, dp, xdl)
+	case TypeDataset:
+		return nil, errors.New("dataset download must be resolved (into multi-download) by the proxy")
 	default:
 		return nil, errors.New("input does not match any of the supported formats (single, range, multi, backend)")
 	}
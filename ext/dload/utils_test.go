@@ -44,6 +44,30 @@ func TestNormalizeObjName(t *testing.T) {
 	}
 }
 
+func TestManifestEntryValidate(t *testing.T) {
+	tests := []struct {
+		entry dload.ManifestEntry
+		fail  bool
+	}{
+		{dload.ManifestEntry{Size: 1024}, false},
+		{dload.ManifestEntry{CksumType: cos.ChecksumMD5, Cksum: "d41d8cd98f00b204e9800998ecf8427e"}, false},
+		{dload.ManifestEntry{CksumType: cos.ChecksumSHA256, Cksum: "abc", Size: 3}, false},
+		{dload.ManifestEntry{Size: -1}, true},
+		{dload.ManifestEntry{Cksum: "abc"}, true},
+		{dload.ManifestEntry{CksumType: cos.ChecksumMD5}, true},
+		{dload.ManifestEntry{CksumType: cos.ChecksumNone, Cksum: "abc"}, true},
+		{dload.ManifestEntry{CksumType: "crc64", Cksum: "abc"}, true},
+	}
+	for _, test := range tests {
+		err := test.entry.Validate()
+		if test.fail && err == nil {
+			t.Errorf("%+v: expected error", test.entry)
+		} else if !test.fail && err != nil {
+			t.Errorf("%+v: unexpected error: %v", test.entry, err)
+		}
+	}
+}
+
 func TestCompareObject(t *testing.T) {
 	tools.CheckSkip(t, &tools.SkipTestArgs{Long: true})
 	var (
@@ -114,3 +138,59 @@ func prepareObject(t *testing.T) *core.LOM {
 	tassert.CheckFatal(t, err)
 	return lom
 }
+
+func TestJobProgress(t *testing.T) {
+	now := time.Now()
+	job := &dload.Job{
+		StartedTime:  now.Add(-10 * time.Second),
+		FinishedTime: now,
+		FinishedCnt:  8,
+		ErrorCnt:     2,
+		ScheduledCnt: 10,
+		Total:        10,
+		Size:         100 * cos.MiB,
+		Aborted:      true,
+	}
+	if bps := job.Throughput(); bps != 10*cos.MiB {
+		t.Errorf("expected throughput %d, got %d", 10*cos.MiB, bps)
+	}
+	if rate := job.ErrRate(); rate != 0.2 {
+		t.Errorf("expected error rate 0.2, got %f", rate)
+	}
+	if eta := job.ETA(); eta != 0 {
+		t.Errorf("expected zero ETA (finished), got %v", eta)
+	}
+
+	// running: half done in 10s => another 10s to go
+	job.FinishedTime, job.Aborted = time.Time{}, false
+	job.Total = 20
+	eta := job.ETA()
+	tassert.Errorf(t, eta > 9*time.Second && eta < 11*time.Second, "expected ETA ~10s, got %v", eta)
+}
+
+func TestDatasetBodyValidate(t *testing.T) {
+	bck := cmn.Bck{Name: "dst", Provider: apc.AIS}
+	tests := []struct {
+		body  dload.DatasetBody
+		valid bool
+	}{
+		{dload.DatasetBody{HF: "org/dataset"}, true},
+		{dload.DatasetBody{Kaggle: "owner/dataset", Revision: "3"}, true},
+		{dload.DatasetBody{GitLFS: "https://github.com/org/repo", Files: []string{"data/train.parquet"}}, true},
+		{dload.DatasetBody{}, false},
+		{dload.DatasetBody{HF: "org/dataset", Kaggle: "owner/dataset"}, false},
+		{dload.DatasetBody{HF: "dataset"}, false},
+		{dload.DatasetBody{Kaggle: "owner/dataset/extra"}, false},
+		{dload.DatasetBody{GitLFS: "https://github.com/org/repo"}, false},
+		{dload.DatasetBody{GitLFS: "github.com/org/repo", Files: []string{"a"}}, false},
+	}
+	for _, test := range tests {
+		test.body.Bck = bck
+		err := test.body.Validate()
+		if test.valid {
+			tassert.CheckError(t, err)
+		} else {
+			tassert.Errorf(t, err != nil, "expected %+v to fail validation", test.body)
+		}
+	}
+}
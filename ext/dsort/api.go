@@ -43,6 +43,21 @@ type Algorithm struct {
 	ContentKeyType string `json:"content_key_type"`
 }
 
+// Optional record filters, to clean up the dataset while resharding it
+type RecordFilter struct {
+	// keep only the archived files (record objects) with matching names, e.g. `\.(jpg|cls)$`
+	// (the regex is matched against the full name of the file in its input shard)
+	NameRegex string `json:"name_regex,omitempty" yaml:"name_regex,omitempty"`
+
+	// keep only the archived files of (uncompressed) size within bounds, e.g. "1KiB", "10MiB"
+	MinSize string `json:"min_size,omitempty" yaml:"min_size,omitempty"`
+	MaxSize string `json:"max_size,omitempty" yaml:"max_size,omitempty"`
+
+	// keep only the first record of all the records that have the same (sorting) key;
+	// the key is determined by the algorithm (e.g., content of the "extension" file for Content)
+	DedupByKey bool `json:"dedup_by_key,omitempty" yaml:"dedup_by_key,omitempty"`
+}
+
 // RequestSpec defines the user specification for requests to the endpoint /v1/sort.
 type RequestSpec struct {
 	// Required
@@ -73,10 +88,16 @@ type RequestSpec struct {
 	ExtractConcMaxLimit int `json:"extract_concurrency_max_limit" yaml:"extract_concurrency_max_limit"`
 	// Default: calcMaxLimit()
 	CreateConcMaxLimit int `json:"create_concurrency_max_limit" yaml:"create_concurrency_max_limit"`
+	// Default: no filtering
+	Filter RecordFilter `json:"filter,omitempty" yaml:"filter,omitempty"`
 
 	// debug
 	DsorterType string `json:"dsorter_type"`
 	DryRun      bool   `json:"dry_run"` // Default: false
 
 	Config cmn.DsortConf
+
+	// Optional: name of the stored (cluster-wide) job template to start from;
+	// all fields specified in this request override the template's
+	JobTemplate string `json:"job_template,omitempty" yaml:"job_template,omitempty"`
 }
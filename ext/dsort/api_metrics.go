@@ -67,6 +67,8 @@ type (
 		ExtractedSize int64 `json:"extracted_size,string"`
 		// ExtractedRecordCnt - number of records extracted from all shards.
 		ExtractedRecordCnt int64 `json:"extracted_record_count,string"`
+		// SkippedRecordCnt - number of archived files filtered out during extraction (see RecordFilter).
+		SkippedRecordCnt int64 `json:"skipped_record_count,string"`
 		// ExtractedToDiskCnt describes number of shards extracted to the disk. To
 		// compute the number shards extracted to memory just subtract it from
 		// ExtractedCnt.
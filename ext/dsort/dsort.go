@@ -546,6 +546,11 @@ func (m *Manager) participateInRecordDistribution(targetOrder meta.Nodes) (curre
 		m.recm.MergeEnqueuedRecords()
 	}
 
+	if m.Pars.Filter.DedupByKey {
+		if n := m.recm.Records.Dedup(); n > 0 {
+			nlog.Infof("%s: %s removed %d records with duplicate keys", core.T, m.ManagerUUID, n)
+		}
+	}
 	err = sortRecords(m.recm.Records, m.Pars.Algorithm)
 	m.dsorter.postRecordDistribution()
 	return true, err
@@ -991,6 +996,7 @@ func (es *extractShard) _do(lom *core.LOM) error {
 	metrics := es.metrics
 	metrics.mu.Lock()
 	metrics.ExtractedRecordCnt += int64(extractedCount)
+	metrics.SkippedRecordCnt = m.recm.Skipped()
 	metrics.ExtractedCnt++
 	if metrics.ExtractedCnt == 1 && extractedCount > 0 {
 		// After extracting the _first_ shard estimate how much memory
@@ -617,7 +617,7 @@ func (es *dsmExtractShard) do() error {
 	ds, shard := es.ds, es.shard
 	defer ds.creationPhase.adjuster.read.releaseGoroutineSema()
 
-	bck := meta.NewBck(ds.m.Pars.OutputBck.Name, ds.m.Pars.OutputBck.Provider, cmn.NsGlobal)
+	bck := meta.CloneBck(&ds.m.Pars.OutputBck) // (including namespace, e.g. remote AIS)
 	if err := bck.Init(core.T.Bowner()); err != nil {
 		return err
 	}
@@ -470,7 +470,11 @@ func (m *Manager) setRW() (err error) {
 		m.shardRW = shard.NopRW(m.shardRW)
 	}
 
-	m.recm = shard.NewRecordManager(m.Pars.InputBck, m.shardRW, ke, m.onDupRecs)
+	filter, err := m.Pars.Filter.recordFilter()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	m.recm = shard.NewRecordManager(m.Pars.InputBck, m.shardRW, ke, m.onDupRecs, filter)
 	return nil
 }
 
@@ -637,11 +641,16 @@ func (m *Manager) recvShard(hdr *transport.ObjHdr, objReader io.Reader, err erro
 		params.Cksum = nil
 		params.Atime = started
 		params.Size = hdr.ObjAttrs.Size
+		if lom.Bck().IsRemote() {
+			// remote output bucket: the sender has already written this shard
+			// to the remote backend - here, we only store it locally (at its HRW location)
+			params.OWT = cmn.OwtRebalance
+		}
 	}
 	erp := core.T.PutObject(lom, params)
 	core.FreePutParams(params)
 	if erp != nil {
-		m.abort(err)
+		m.abort(erp)
 		return erp
 	}
 	return nil
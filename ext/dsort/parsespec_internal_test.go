@@ -290,6 +290,62 @@ var _ = Describe("RequestSpec", func() {
 			_, err = rs.parse()
 			Expect(err).ShouldNot(HaveOccurred())
 		})
+
+		It("should apply job template and keep the request's overrides", func() {
+			tmpl := &RequestSpec{
+				InputExtension:  archive.ExtTar,
+				OutputFormat:    "shard-{0..9}",
+				OutputShardSize: "10KB",
+				Algorithm:       Algorithm{Kind: Shuffle, Seed: "17"},
+				Config:          cmn.DsortConf{MissingShards: cmn.IgnoreReaction, SbundleMult: 2},
+			}
+			rs := RequestSpec{
+				InputBck:        cmn.Bck{Name: "test"},
+				InputFormat:     newInputFormat("prefix-{0010..0111..2}-suffix"),
+				OutputShardSize: "20KB",
+				Config:          cmn.DsortConf{SbundleMult: 4},
+				JobTemplate:     "shuffle-tar",
+			}
+			rs.ApplyTemplate(tmpl)
+			pars, err := rs.parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(pars.InputBck.Name).To(Equal("test"))
+			Expect(pars.InputExtension).To(Equal(archive.ExtTar))
+			Expect(pars.OutputShardSize).To(BeEquivalentTo(20 * cos.KiB))
+			Expect(pars.Algorithm.Kind).To(Equal(Shuffle))
+			Expect(pars.Algorithm.Seed).To(Equal("17"))
+			Expect(pars.MissingShards).To(Equal(cmn.IgnoreReaction))
+			Expect(pars.SbundleMult).To(Equal(4))
+		})
+
+		It("should parse spec with record filters", func() {
+			rs := RequestSpec{
+				InputBck:        cmn.Bck{Name: "test"},
+				InputExtension:  archive.ExtTar,
+				InputFormat:     newInputFormat("prefix-{0010..0111}-suffix"),
+				OutputFormat:    "prefix-{0010..0111}-suffix",
+				OutputShardSize: "10KB",
+				Filter:          RecordFilter{NameRegex: `\.(jpg|cls)$`, MinSize: "1KiB", MaxSize: "1MiB", DedupByKey: true},
+			}
+			pars, err := rs.parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(pars.Filter.MinSize).To(BeEquivalentTo(cos.KiB))
+			Expect(pars.Filter.MaxSize).To(BeEquivalentTo(cos.MiB))
+			Expect(pars.Filter.DedupByKey).To(BeTrue())
+
+			filter, err := pars.Filter.recordFilter()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(filter.NameRe.MatchString("a/b/0001.jpg")).To(BeTrue())
+			Expect(filter.NameRe.MatchString("a/b/0001.txt")).To(BeFalse())
+
+			rs.Filter = RecordFilter{DedupByKey: true}
+			pars, err = rs.parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			filter, err = pars.Filter.recordFilter()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(filter).To(BeNil())
+		})
 	})
 
 	Context("request specs which shall NOT pass", func() {
@@ -327,6 +383,17 @@ var _ = Describe("RequestSpec", func() {
 			Expect(err).Should(MatchError(&cmn.ErrInvalidBackendProvider{}))
 		})
 
+		It("should fail due to HTTP output bucket", func() {
+			rs := RequestSpec{
+				InputBck:       cmn.Bck{Provider: apc.AIS, Name: "test"},
+				OutputBck:      cmn.Bck{Provider: apc.HTTP, Name: "test"},
+				InputExtension: ".txt",
+				Algorithm:      Algorithm{Kind: None},
+			}
+			_, err := rs.parse()
+			Expect(err).Should(HaveOccurred())
+		})
+
 		It("should fail due to start after end in input format", func() {
 			rs := RequestSpec{
 				InputBck:        cmn.Bck{Name: "test"},
@@ -478,6 +545,25 @@ var _ = Describe("RequestSpec", func() {
 			Expect(err).Should(HaveOccurred())
 		})
 
+		It("should fail due to invalid record filters", func() {
+			for _, filter := range []RecordFilter{
+				{NameRegex: "[0-9"},
+				{MinSize: "abc"},
+				{MinSize: "1MiB", MaxSize: "1KiB"},
+			} {
+				rs := RequestSpec{
+					InputBck:        cmn.Bck{Name: "test"},
+					InputExtension:  archive.ExtTar,
+					InputFormat:     newInputFormat("prefix-{0010..0111}-suffix"),
+					OutputFormat:    "prefix-{0010..0111}-suffix",
+					OutputShardSize: "10KB",
+					Filter:          filter,
+				}
+				_, err := rs.parse()
+				Expect(err).Should(HaveOccurred())
+			}
+		})
+
 		It("should fail when output shard size is empty and output format is %06d", func() {
 			rs := RequestSpec{
 				InputBck:       cmn.Bck{Name: "test"},
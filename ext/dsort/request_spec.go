@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"math"
 	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -35,6 +36,13 @@ type ParsedReq struct {
 	pars      *parsedReqSpec
 }
 
+type parsedFilter struct {
+	NameRegex  string `json:"name_regex"`
+	MinSize    int64  `json:"min_size,string"`
+	MaxSize    int64  `json:"max_size,string"`
+	DedupByKey bool   `json:"dedup_by_key"`
+}
+
 type parsedReqSpec struct {
 	InputBck            cmn.Bck               `json:"input_bck"`
 	Description         string                `json:"description"`
@@ -52,6 +60,7 @@ type parsedReqSpec struct {
 	ExtractConcMaxLimit int                   `json:"extract_concurrency_max_limit"`
 	CreateConcMaxLimit  int                   `json:"create_concurrency_max_limit"`
 	SbundleMult         int                   `json:"bundle_multiplier"`
+	Filter              parsedFilter          `json:"filter"`
 
 	// debug
 	DsorterType string `json:"dsorter_type"`
@@ -71,6 +80,90 @@ func (rs *RequestSpec) ParseCtx() (*ParsedReq, error) {
 	return &ParsedReq{pars.InputBck, pars.OutputBck, pars}, err
 }
 
+// ApplyTemplate fills in all the fields that are not specified (zero) in this request
+// with the values of the named job template (see rs.JobTemplate and cmn.ClusterConfig.DsortTemplates)
+func (rs *RequestSpec) ApplyTemplate(tmpl *RequestSpec) {
+	if rs.InputBck.IsEmpty() {
+		rs.InputBck = tmpl.InputBck
+	}
+	if rs.InputFormat.Template == "" && len(rs.InputFormat.ObjNames) == 0 {
+		rs.InputFormat = tmpl.InputFormat
+	}
+	if rs.OutputFormat == "" {
+		rs.OutputFormat = tmpl.OutputFormat
+	}
+	if rs.OutputShardSize == "" {
+		rs.OutputShardSize = tmpl.OutputShardSize
+	}
+	if rs.InputExtension == "" {
+		rs.InputExtension = tmpl.InputExtension
+	}
+	if rs.OutputExtension == "" {
+		rs.OutputExtension = tmpl.OutputExtension
+	}
+	if rs.Description == "" {
+		rs.Description = tmpl.Description
+	}
+	if rs.OutputBck.IsEmpty() {
+		rs.OutputBck = tmpl.OutputBck
+	}
+	if rs.Algorithm == (Algorithm{}) {
+		rs.Algorithm = tmpl.Algorithm
+	}
+	if rs.OrderFileURL == "" {
+		rs.OrderFileURL = tmpl.OrderFileURL
+	}
+	if rs.OrderFileSep == "" {
+		rs.OrderFileSep = tmpl.OrderFileSep
+	}
+	if rs.MaxMemUsage == "" {
+		rs.MaxMemUsage = tmpl.MaxMemUsage
+	}
+	if rs.ExtractConcMaxLimit == 0 {
+		rs.ExtractConcMaxLimit = tmpl.ExtractConcMaxLimit
+	}
+	if rs.CreateConcMaxLimit == 0 {
+		rs.CreateConcMaxLimit = tmpl.CreateConcMaxLimit
+	}
+	if rs.Filter == (RecordFilter{}) {
+		rs.Filter = tmpl.Filter
+	}
+	if rs.DsorterType == "" {
+		rs.DsorterType = tmpl.DsorterType
+	}
+	rs.DryRun = rs.DryRun || tmpl.DryRun
+
+	// config overrides, one by one
+	cfg, tcfg := &rs.Config, &tmpl.Config
+	if cfg.DuplicatedRecords == "" {
+		cfg.DuplicatedRecords = tcfg.DuplicatedRecords
+	}
+	if cfg.MissingShards == "" {
+		cfg.MissingShards = tcfg.MissingShards
+	}
+	if cfg.EKMMalformedLine == "" {
+		cfg.EKMMalformedLine = tcfg.EKMMalformedLine
+	}
+	if cfg.EKMMissingKey == "" {
+		cfg.EKMMissingKey = tcfg.EKMMissingKey
+	}
+	if cfg.DefaultMaxMemUsage == "" {
+		cfg.DefaultMaxMemUsage = tcfg.DefaultMaxMemUsage
+	}
+	if cfg.CallTimeout == 0 {
+		cfg.CallTimeout = tcfg.CallTimeout
+	}
+	if cfg.DsorterMemThreshold == "" {
+		cfg.DsorterMemThreshold = tcfg.DsorterMemThreshold
+	}
+	if cfg.Compression == "" {
+		cfg.Compression = tcfg.Compression
+	}
+	if cfg.SbundleMult == 0 {
+		cfg.SbundleMult = tcfg.SbundleMult
+	}
+}
+
 func (rs *RequestSpec) parse() (*parsedReqSpec, error) {
 	var (
 		cfg  = cmn.GCO.Get().Dsort
@@ -110,6 +203,9 @@ func (rs *RequestSpec) parse() (*parsedReqSpec, error) {
 		if err := rs.OutputBck.Validate(); err != nil {
 			return pars, specErr("output_bck", err)
 		}
+		if pars.OutputBck.IsHTTP() {
+			return pars, specErr("output_bck", fmt.Errorf("cannot write to HTTP bucket %q", pars.OutputBck.String()))
+		}
 	}
 
 	// input format
@@ -208,6 +304,12 @@ func (rs *RequestSpec) parse() (*parsedReqSpec, error) {
 
 	pars.ExtractConcMaxLimit = rs.ExtractConcMaxLimit
 	pars.CreateConcMaxLimit = rs.CreateConcMaxLimit
+
+	// record filters
+	if pars.Filter, err = parseFilter(&rs.Filter); err != nil {
+		return nil, specErr("filter", err)
+	}
+
 	pars.DsorterType = rs.DsorterType
 	pars.DryRun = rs.DryRun
 
@@ -242,6 +344,48 @@ func (rs *RequestSpec) parse() (*parsedReqSpec, error) {
 	return pars, nil
 }
 
+func parseFilter(rf *RecordFilter) (pf parsedFilter, err error) {
+	pf.NameRegex, pf.DedupByKey = rf.NameRegex, rf.DedupByKey
+	if rf.NameRegex != "" {
+		if _, err = regexp.Compile(rf.NameRegex); err != nil {
+			return pf, err
+		}
+	}
+	if rf.MinSize != "" {
+		if pf.MinSize, err = cos.ParseSize(rf.MinSize, cos.UnitsIEC); err != nil {
+			return pf, err
+		}
+	}
+	if rf.MaxSize != "" {
+		if pf.MaxSize, err = cos.ParseSize(rf.MaxSize, cos.UnitsIEC); err != nil {
+			return pf, err
+		}
+	}
+	if pf.MinSize < 0 || pf.MaxSize < 0 {
+		return pf, fmt.Errorf("invalid negative size bound (%q, %q)", rf.MinSize, rf.MaxSize)
+	}
+	if pf.MaxSize > 0 && pf.MinSize > pf.MaxSize {
+		return pf, fmt.Errorf("min_size %q is greater than max_size %q", rf.MinSize, rf.MaxSize)
+	}
+	return pf, nil
+}
+
+// nil when there's nothing to filter during extraction (compare w/ DedupByKey)
+func (pf *parsedFilter) recordFilter() (*shard.RecordFilter, error) {
+	if pf.NameRegex == "" && pf.MinSize == 0 && pf.MaxSize == 0 {
+		return nil, nil
+	}
+	f := &shard.RecordFilter{MinSize: pf.MinSize, MaxSize: pf.MaxSize}
+	if pf.NameRegex != "" {
+		re, err := regexp.Compile(pf.NameRegex)
+		if err != nil {
+			return nil, err
+		}
+		f.NameRe = re
+	}
+	return f, nil
+}
+
 func parseAlgorithm(alg Algorithm) (*Algorithm, error) {
 	if !cos.StringInSlice(alg.Kind, algorithms) {
 		return nil, fmt.Errorf(fmtErrInvalidAlg, algorithms)
@@ -0,0 +1,50 @@
+// Package shard provides Extract(shard), Create(shard), and associated methods
+// across all suppported archival formats (see cmn/archive/mime.go)
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package shard
+
+import "regexp"
+
+// RecordFilter is applied during extraction, to each archived file (record object) in each input shard;
+// the files that do not pass are neither extracted nor sorted, and don't make it into output shards
+type RecordFilter struct {
+	NameRe  *regexp.Regexp // keep only the files with matching names (the full name in the shard)
+	MinSize int64          // keep only the files of size >= MinSize
+	MaxSize int64          // (0 - unlimited)
+}
+
+func (f *RecordFilter) skip(name string, size int64) bool {
+	if size < f.MinSize || (f.MaxSize > 0 && size > f.MaxSize) {
+		return true
+	}
+	return f.NameRe != nil && !f.NameRe.MatchString(name)
+}
+
+// Dedup keeps only the first record of all records with the same key; records without keys
+// (e.g., records missing content key file) are always kept. Returns the number of removed records.
+// NOTE: must be called after all records are gathered and before sorting (see sortRecords).
+func (r *Records) Dedup() (n int) {
+	r.Lock()
+	var (
+		seen = make(map[any]struct{}, len(r.arr))
+		arr  = r.arr[:0]
+	)
+	for _, record := range r.arr {
+		if record.Key != nil {
+			if _, ok := seen[record.Key]; ok {
+				delete(r.m, record.Name)
+				r.totalObjectCount -= len(record.Objects)
+				n++
+				continue
+			}
+			seen[record.Key] = struct{}{}
+		}
+		arr = append(arr, record)
+	}
+	clear(r.arr[len(arr):])
+	r.arr = arr
+	r.Unlock()
+	return n
+}
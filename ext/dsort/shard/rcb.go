@@ -47,7 +47,13 @@ func (c *rcbCtx) xtar(_ string, reader cos.ReadCloseSizer, hdr any) (bool /*stop
 	header, ok := hdr.(*tar.Header)
 	debug.Assert(ok)
 
-	bmeta := cos.MustMarshal(header)
+	skip := c.extractor.Skip(header.Name, header.Size)
+	if skip && c.tw != nil {
+		// tar.gz and tar.lz4: offsets are computed in the (rewritten) tar that won't include this one
+		reader.Close()
+		return false, nil
+	}
+
 	c.offset += c.parent.MetadataSize()
 	if header.Format == tar.FormatPAX {
 		// When dealing with `tar.FormatPAX` we also need to take into
@@ -66,6 +72,13 @@ func (c *rcbCtx) xtar(_ string, reader cos.ReadCloseSizer, hdr any) (bool /*stop
 		// the size, so we must estimate it by ourselves...
 		c.offset += sz
 	}
+	if skip {
+		reader.Close()
+		c.offset += cos.CeilAlignInt64(header.Size, archive.TarBlockSize) // (ditto)
+		return false, nil
+	}
+
+	bmeta := cos.MustMarshal(header)
 	args := extractRecordArgs{
 		shardName:  c.shardName,
 		recordName: header.Name,
@@ -108,6 +121,10 @@ func (c *rcbCtx) xzip(_ string, reader cos.ReadCloseSizer, hdr any) (bool /*stop
 	header, ok := hdr.(*zip.FileHeader)
 	debug.Assert(ok)
 
+	if c.extractor.Skip(header.Name, int64(header.UncompressedSize64)) {
+		reader.Close()
+		return false, nil
+	}
 	metadata := zipFileHeader{
 		Name:    header.Name,
 		Comment: header.Comment,
@@ -14,6 +14,7 @@ import (
 	"sync"
 
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
@@ -56,6 +57,7 @@ type (
 
 	RecordExtractor interface {
 		RecordWithBuffer(args *extractRecordArgs) (int64, error)
+		Skip(recordName string, size int64) bool // filtered out (see RecordFilter)
 	}
 
 	RecordManager struct {
@@ -65,8 +67,10 @@ type (
 
 		extractCreator  RW
 		keyExtractor    KeyExtractor
+		filter          *RecordFilter // optional
 		contents        *sync.Map
-		extractionPaths *sync.Map // Keys correspond to all paths to record contents on disk.
+		extractionPaths *sync.Map    // Keys correspond to all paths to record contents on disk.
+		skipped         atomic.Int64 // number of filtered-out record objects
 
 		enqueued struct {
 			mu      sync.Mutex
@@ -79,18 +83,30 @@ type (
 // RecordManager //
 ///////////////////
 
-func NewRecordManager(bck cmn.Bck, extractCreator RW, keyExtractor KeyExtractor, onDupRecs func(string) error) *RecordManager {
+func NewRecordManager(bck cmn.Bck, extractCreator RW, keyExtractor KeyExtractor, onDupRecs func(string) error,
+	filter *RecordFilter) *RecordManager {
 	return &RecordManager{
 		Records:             NewRecords(1000),
 		bck:                 bck,
 		onDuplicatedRecords: onDupRecs,
 		extractCreator:      extractCreator,
 		keyExtractor:        keyExtractor,
+		filter:              filter,
 		contents:            &sync.Map{},
 		extractionPaths:     &sync.Map{},
 	}
 }
 
+func (recm *RecordManager) Skip(recordName string, size int64) bool {
+	if recm.filter == nil || !recm.filter.skip(recordName, size) {
+		return false
+	}
+	recm.skipped.Inc()
+	return true
+}
+
+func (recm *RecordManager) Skipped() int64 { return recm.skipped.Load() }
+
 func (recm *RecordManager) RecordWithBuffer(args *extractRecordArgs) (size int64, err error) {
 	var (
 		storeType        string
@@ -6,6 +6,8 @@
 package shard_test
 
 import (
+	"fmt"
+
 	"github.com/NVIDIA/aistore/ext/dsort/shard"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -150,4 +152,29 @@ var _ = Describe("Records", func() {
 			Expect(records.All()[0].TotalSize()).To(BeEquivalentTo(objectSize))
 		})
 	})
+
+	Context("dedup", func() {
+		It("should keep only the first record with a given key", func() {
+			records := shard.NewRecords(0)
+			for i, key := range []any{"a", "b", "a", nil, nil, "c", "b"} {
+				records.Insert(&shard.Record{
+					Key:     key,
+					Name:    fmt.Sprintf("record-%d", i),
+					Objects: []*shard.RecordObj{{Size: objectSize, Extension: ".txt"}},
+				})
+			}
+			Expect(records.Dedup()).To(Equal(2))
+			Expect(records.Len()).To(Equal(5))
+			Expect(records.TotalObjectCount()).To(Equal(5))
+
+			names := make([]string, 0, records.Len())
+			for _, r := range records.All() {
+				names = append(names, r.Name)
+			}
+			Expect(names).To(Equal([]string{"record-0", "record-1", "record-3", "record-4", "record-5"}))
+
+			_, exists := records.Find("record-2")
+			Expect(exists).To(BeFalse())
+		})
+	})
 })
@@ -17,6 +17,7 @@ import (
 	"github.com/NVIDIA/aistore/ext/etl/runtime"
 	jsoniter "github.com/json-iterator/go"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/client-go/kubernetes/scheme"
 )
 
@@ -71,6 +72,14 @@ type (
 		CommTypeX string       `json:"communication"` // enum commTypes
 		ArgTypeX  string       `json:"argument"`      // enum argTypes
 		Timeout   cos.Duration `json:"timeout"`
+		Resources Resources    `json:"resources"` // optional
+	}
+	// CPU and memory limits of the ETL container (one per target), in K8s quantity
+	// format, e.g. "500m" (CPU) or "1Gi" (memory); override the limits in the pod spec,
+	// if any (see etlBootstrapper._setResources)
+	Resources struct {
+		CPU    string `json:"cpu,omitempty"`
+		Memory string `json:"memory,omitempty"`
 	}
 	InitSpecMsg struct {
 		InitMsgBase
@@ -98,6 +107,12 @@ type (
 	}
 )
 
+// Info.Status
+const (
+	StatusRunning  = "running"
+	StatusDegraded = "degraded" // failing health checks (and possibly being restarted)
+)
+
 type (
 	InfoList []Info
 	Info     struct {
@@ -106,6 +121,10 @@ type (
 		ObjCount int64  `json:"obj_count"`
 		InBytes  int64  `json:"in_bytes"`
 		OutBytes int64  `json:"out_bytes"`
+		Status   string `json:"status"` // enum { StatusRunning, StatusDegraded }
+		Restarts int64  `json:"restarts,omitempty"`
+		// average transform time (see acct)
+		Latency cos.Duration `json:"avg_latency,omitempty"`
 	}
 
 	LogsByTarget []Logs
@@ -215,6 +234,10 @@ func (m *InitMsgBase) validate(detail string) error {
 		return cmn.NewErrETL(errCtx, "%v [%s]", err, detail)
 	}
 
+	if err := m.Resources.validate(); err != nil {
+		return cmn.NewErrETL(errCtx, "%v [%s]", err, detail)
+	}
+
 	// NOTE: default comm-type
 	if m.CommType() == "" {
 		cos.Infof("Warning: empty comm-type, defaulting to %q", Hpush)
@@ -297,6 +320,22 @@ func (m *InitSpecMsg) Validate() (err error) {
 	return nil
 }
 
+func (res *Resources) validate() error {
+	for _, v := range []struct{ name, val string }{{"cpu", res.CPU}, {"memory", res.Memory}} {
+		if v.val == "" {
+			continue
+		}
+		q, err := resource.ParseQuantity(v.val)
+		if err != nil {
+			return fmt.Errorf("invalid %s limit %q: %v", v.name, v.val, err)
+		}
+		if q.Sign() <= 0 {
+			return fmt.Errorf("invalid %s limit %q: must be positive", v.name, v.val)
+		}
+	}
+	return nil
+}
+
 func ParsePodSpec(errCtx *cmn.ETLErrCtx, spec []byte) (*corev1.Pod, error) {
 	obj, _, err := scheme.Codecs.UniversalDeserializer().Decode(spec, nil, nil)
 	if err != nil {
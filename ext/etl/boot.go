@@ -19,6 +19,7 @@ import (
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/xact/xreg"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 )
@@ -31,6 +32,8 @@ type etlBootstrapper struct {
 	config *cmn.Config
 	msg    InitSpecMsg
 	env    map[string]string
+	hlth   *health // (carried over when restarting)
+	acct   *acct   // (ditto)
 
 	// runtime
 	xctn            core.Xact
@@ -41,6 +44,40 @@ type etlBootstrapper struct {
 	originalCommand []string
 }
 
+// deploy (re)creates ETL service and pod, waits for the pod to become ready,
+// and connects; returns non-empty names of the entities it attempted to create
+func (b *etlBootstrapper) deploy() (podName, svcName string, err error) {
+	// Parse spec template and fill Pod object with necessary fields.
+	if err = b.createPodSpec(); err != nil {
+		return
+	}
+
+	b.createServiceSpec()
+
+	// 1. Cleanup previously started entities, if any.
+	errCleanup := cleanupEntities(b.errCtx, b.pod.Name, b.svc.Name)
+	debug.AssertNoErr(errCleanup)
+
+	// 2. Creating service.
+	svcName = b.svc.GetName()
+	if err = b.createEntity(k8s.Svc); err != nil {
+		return
+	}
+	// 3. Creating pod.
+	podName = b.pod.GetName()
+	if err = b.createEntity(k8s.Pod); err != nil {
+		return
+	}
+	if err = b.waitPodReady(); err != nil {
+		return
+	}
+	if cmn.Rom.FastV(4, cos.SmoduleETL) {
+		nlog.Infof("pod %q is ready, %+v, %s", podName, b.msg, b.errCtx)
+	}
+	err = b.setupConnection()
+	return
+}
+
 func (b *etlBootstrapper) createPodSpec() (err error) {
 	if b.pod, err = ParsePodSpec(b.errCtx, b.msg.Spec); err != nil {
 		return
@@ -73,6 +110,9 @@ func (b *etlBootstrapper) _prepSpec() (err error) {
 	b._updReady()
 
 	b._setPodEnv()
+	if err = b._setResources(); err != nil {
+		return
+	}
 
 	if cmn.Rom.FastV(4, cos.SmoduleETL) {
 		nlog.Infof("prep pod spec: %s, %+v", b.msg.String(), b.errCtx)
@@ -351,6 +391,33 @@ func (b *etlBootstrapper) _setPodEnv() {
 	}
 }
 
+// Sets the container's CPU and memory limits as per InitMsgBase.Resources (if specified);
+// requests, if any, cannot exceed the limits (when not specified, K8s defaults them to the limits).
+func (b *etlBootstrapper) _setResources() error {
+	res := &b.msg.Resources
+	if res.CPU == "" && res.Memory == "" {
+		return nil
+	}
+	c := &b.pod.Spec.Containers[0]
+	for name, val := range map[corev1.ResourceName]string{corev1.ResourceCPU: res.CPU, corev1.ResourceMemory: res.Memory} {
+		if val == "" {
+			continue
+		}
+		q, err := resource.ParseQuantity(val)
+		if err != nil {
+			return cmn.NewErrETL(b.errCtx, "invalid %s limit %q: %v", name, val, err)
+		}
+		if c.Resources.Limits == nil {
+			c.Resources.Limits = make(corev1.ResourceList, 2)
+		}
+		c.Resources.Limits[name] = q
+		if req, ok := c.Resources.Requests[name]; ok && req.Cmp(q) > 0 {
+			c.Resources.Requests[name] = q
+		}
+	}
+	return nil
+}
+
 func (b *etlBootstrapper) _getHost() (string, error) {
 	client, err := k8s.GetClient()
 	if err != nil {
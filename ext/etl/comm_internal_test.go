@@ -7,6 +7,7 @@ package etl
 import (
 	cryptorand "crypto/rand"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -41,6 +42,8 @@ var _ = Describe("CommunicatorTest", func() {
 		transformerServer *httptest.Server
 		targetServer      *httptest.Server
 		proxyServer       *httptest.Server
+		sect              *Section
+		objFQN            string
 
 		dataSize      = int64(cos.MiB * 50)
 		transformData = make([]byte, dataSize)
@@ -79,18 +82,29 @@ var _ = Describe("CommunicatorTest", func() {
 		Expect(err).NotTo(HaveOccurred())
 		err = createRandomFile(lom.FQN, dataSize)
 		Expect(err).NotTo(HaveOccurred())
+		objFQN = lom.FQN
+		sect = nil
 		lom.SetAtimeUnix(time.Now().UnixNano())
 		lom.SetSize(dataSize)
 		err = lom.Persist()
 		Expect(err).NotTo(HaveOccurred())
 
 		// Initialize the HTTP servers.
-		transformerServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		transformerServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if sect != nil && r.Method == http.MethodPut {
+				// echo what's been pushed (read it all first: the server may close
+				// the request body once the response starts going out)
+				b, err := io.ReadAll(r.Body)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = w.Write(b)
+				Expect(err).NotTo(HaveOccurred())
+				return
+			}
 			_, err := w.Write(transformData)
 			Expect(err).NotTo(HaveOccurred())
 		}))
 		targetServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			err := comm.InlineTransform(w, r, lom)
+			err := comm.InlineTransform(w, r, lom, sect)
 			Expect(err).NotTo(HaveOccurred())
 		}))
 		proxyServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -139,6 +153,30 @@ var _ = Describe("CommunicatorTest", func() {
 			Expect(b).To(Equal(transformData))
 		})
 	}
+
+	It("should push only the requested byte range "+Hpush, func() {
+		pod := &corev1.Pod{}
+		pod.SetName("somename")
+
+		boot := &etlBootstrapper{
+			msg:  InitSpecMsg{InitMsgBase: InitMsgBase{CommTypeX: Hpush}},
+			pod:  pod,
+			uri:  transformerServer.URL,
+			xctn: mock.NewXact(apc.ActETLInline),
+		}
+		comm = newCommunicator(nil, boot)
+		sect = &Section{Offset: cos.KiB, Length: 4 * cos.KiB}
+
+		resp, err := http.Get(proxyServer.URL)
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+
+		b, err := cos.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		data, err := os.ReadFile(objFQN)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(b).To(Equal(data[sect.Offset : sect.Offset+sect.Length]))
+	})
 })
 
 // Creates a file with random content.
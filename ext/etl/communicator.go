@@ -16,8 +16,10 @@ import (
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
@@ -29,6 +31,7 @@ type (
 		ObjCount() int64
 		InBytes() int64
 		OutBytes() int64
+		Latency() time.Duration // average transform time
 	}
 
 	// Communicator is responsible for managing communications with local ETL container.
@@ -46,7 +49,9 @@ type (
 		// InlineTransform uses one of the two ETL container endpoints:
 		//  - Method "PUT", Path "/"
 		//  - Method "GET", Path "/bucket/object"
-		InlineTransform(w http.ResponseWriter, r *http.Request, lom *core.LOM) error
+		// Non-nil section (GET with Range or archpath) limits the transformation to
+		// the requested part of the object (see Section)
+		InlineTransform(w http.ResponseWriter, r *http.Request, lom *core.LOM, sect *Section) error
 
 		// OfflineTransform is driven by `OfflineDP` to provide offline transformation, as it were
 		// Implementations include:
@@ -58,7 +63,13 @@ type (
 
 		Stop()
 
+		// Degraded returns non-nil error when the ETL pod keeps failing health checks
+		// (see health.go)
+		Degraded() error
+
 		CommStats
+
+		bootstrapper() *etlBootstrapper
 	}
 
 	baseComm struct {
@@ -77,6 +88,31 @@ type (
 		rp *httputil.ReverseProxy
 	}
 
+	// Section selects the part of the object to transform: either a byte range
+	// or a single archived file (e.g., one record of a shard).
+	// - Hpush: only the selected bytes get pushed to the transformer;
+	// - Hpull, Hrev: the request's Range header and archpath query are forwarded
+	//   to the transformer, to use when reading the object.
+	// Either way, the transformer's output is returned in its entirety.
+	Section struct {
+		Archpath string // apc.QparamArchpath
+		Archmime string // apc.QparamArchmime (optional)
+		Offset   int64  // byte range (cos.HdrRange) ...
+		Length   int64  // ... when non-zero
+	}
+
+	// archived file that, when closed, also closes the archive (see Section)
+	archSection struct {
+		cos.ReadCloseSizer
+		fh *cos.FileHandle
+	}
+
+	// counts bytes written in response (Hrev)
+	cntWriter struct {
+		http.ResponseWriter
+		n int64
+	}
+
 	// TODO: Generalize and move to `cos` package
 	cbWriter struct {
 		w       io.Writer
@@ -90,7 +126,8 @@ var (
 	_ Communicator = (*redirectComm)(nil)
 	_ Communicator = (*revProxyComm)(nil)
 
-	_ io.Writer = (*cbWriter)(nil)
+	_ io.Writer           = (*cbWriter)(nil)
+	_ http.ResponseWriter = (*cntWriter)(nil)
 )
 
 //////////////
@@ -151,6 +188,8 @@ func (c *baseComm) ObjCount() int64 { return c.boot.xctn.Objs() }
 func (c *baseComm) InBytes() int64  { return c.boot.xctn.InBytes() }
 func (c *baseComm) OutBytes() int64 { return c.boot.xctn.OutBytes() }
 
+func (c *baseComm) Latency() time.Duration { return c.boot.acct.latency() }
+
 func (c *baseComm) Stop() { c.boot.xctn.Finish() }
 
 func (c *baseComm) getWithTimeout(url string, size int64, timeout time.Duration) (r cos.ReadCloseSizer, err error) {
@@ -159,9 +198,11 @@ func (c *baseComm) getWithTimeout(url string, size int64, timeout time.Duration)
 	}
 
 	var (
-		req    *http.Request
-		resp   *http.Response
-		cancel func()
+		req     *http.Request
+		resp    *http.Response
+		cancel  func()
+		in      int64
+		started = mono.NanoTime()
 	)
 	if timeout != 0 {
 		var ctx context.Context
@@ -181,15 +222,19 @@ func (c *baseComm) getWithTimeout(url string, size int64, timeout time.Duration)
 	}
 
 	return cos.NewReaderWithArgs(cos.ReaderArgs{
-		R:      resp.Body,
-		Size:   resp.ContentLength,
-		ReadCb: func(n int, _ error) { c.boot.xctn.InObjsAdd(0, int64(n)) },
+		R:    resp.Body,
+		Size: resp.ContentLength,
+		ReadCb: func(n int, _ error) {
+			c.boot.xctn.InObjsAdd(0, int64(n))
+			in += int64(n)
+		},
 		DeferCb: func() {
 			if cancel != nil {
 				cancel()
 			}
 			c.boot.xctn.InObjsAdd(1, 0)
 			c.boot.xctn.OutObjsAdd(1, size) // see also: `coi.objsAdd`
+			c.boot.acct.add(size, in, started)
 		},
 	}), nil
 }
@@ -198,14 +243,14 @@ func (c *baseComm) getWithTimeout(url string, size int64, timeout time.Duration)
 // pushComm: implements (Hpush | HpushStdin)
 //////////////
 
-func (pc *pushComm) doRequest(lom *core.LOM, timeout time.Duration) (r cos.ReadCloseSizer, err error) {
+func (pc *pushComm) doRequest(lom *core.LOM, timeout time.Duration, sect *Section) (r cos.ReadCloseSizer, err error) {
 	if err := lom.InitBck(lom.Bucket()); err != nil {
 		return nil, err
 	}
 
 	var ecode int
 	lom.Lock(false)
-	r, ecode, err = pc.do(lom, timeout)
+	r, ecode, err = pc.do(lom, timeout, sect)
 	lom.Unlock(false)
 
 	if err != nil && cos.IsNotExist(err, ecode) && lom.Bucket().IsRemote() {
@@ -214,19 +259,20 @@ func (pc *pushComm) doRequest(lom *core.LOM, timeout time.Duration) (r cos.ReadC
 			return nil, err
 		}
 		lom.Lock(false)
-		r, _, err = pc.do(lom, timeout)
+		r, _, err = pc.do(lom, timeout, sect)
 		lom.Unlock(false)
 	}
 	return
 }
 
-func (pc *pushComm) do(lom *core.LOM, timeout time.Duration) (_ cos.ReadCloseSizer, ecode int, err error) {
+func (pc *pushComm) do(lom *core.LOM, timeout time.Duration, sect *Section) (_ cos.ReadCloseSizer, ecode int, err error) {
 	var (
 		body   io.ReadCloser
 		cancel func()
 		req    *http.Request
 		resp   *http.Response
 		u      string
+		in     int64
 	)
 	if err := pc.boot.xctn.AbortErr(); err != nil {
 		return nil, 0, err
@@ -234,8 +280,10 @@ func (pc *pushComm) do(lom *core.LOM, timeout time.Duration) (_ cos.ReadCloseSiz
 	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
 		return nil, 0, err
 	}
-	size := lom.Lsize()
-
+	var (
+		size    = lom.Lsize()
+		started = mono.NanoTime()
+	)
 	switch pc.boot.msg.ArgTypeX {
 	case ArgTypeDefault, ArgTypeURL:
 		// to remove the following assert (and the corresponding limitation):
@@ -244,12 +292,23 @@ func (pc *pushComm) do(lom *core.LOM, timeout time.Duration) (_ cos.ReadCloseSiz
 		debug.Assertf(lom.Bck().Ns.IsGlobal(), lom.Bck().Cname("")+" - bucket with namespace")
 		u = pc.boot.uri + "/" + lom.Bck().Name + "/" + lom.ObjName
 
+		if sect != nil {
+			body, size, err = sect.open(lom)
+			if err != nil {
+				return nil, 0, err
+			}
+			break
+		}
 		fh, err := cos.NewFileHandle(lom.FQN)
 		if err != nil {
 			return nil, 0, err
 		}
 		body = fh
 	case ArgTypeFQN:
+		if sect != nil {
+			// the transformer reads the file directly
+			return nil, 0, cmn.NewErrUnsupp("transform a section ("+sect.String()+") of", lom.Cname()+" via "+ArgTypeFQN)
+		}
 		body = http.NoBody
 		u = cos.JoinPath(pc.boot.uri, url.PathEscape(lom.FQN)) // compare w/ rc.redirectURL()
 	default:
@@ -293,22 +352,26 @@ finish:
 		return nil, ecode, err
 	}
 	args := cos.ReaderArgs{
-		R:      resp.Body,
-		Size:   resp.ContentLength,
-		ReadCb: func(n int, _ error) { pc.boot.xctn.InObjsAdd(0, int64(n)) },
+		R:    resp.Body,
+		Size: resp.ContentLength,
+		ReadCb: func(n int, _ error) {
+			pc.boot.xctn.InObjsAdd(0, int64(n))
+			in += int64(n)
+		},
 		DeferCb: func() {
 			if cancel != nil {
 				cancel()
 			}
 			pc.boot.xctn.InObjsAdd(1, 0)
 			pc.boot.xctn.OutObjsAdd(1, size) // see also: `coi.objsAdd`
+			pc.boot.acct.add(size, in, started)
 		},
 	}
 	return cos.NewReaderWithArgs(args), 0, nil
 }
 
-func (pc *pushComm) InlineTransform(w http.ResponseWriter, _ *http.Request, lom *core.LOM) error {
-	r, err := pc.doRequest(lom, 0 /*timeout*/)
+func (pc *pushComm) InlineTransform(w http.ResponseWriter, _ *http.Request, lom *core.LOM, sect *Section) error {
+	r, err := pc.doRequest(lom, 0 /*timeout*/, sect)
 	if err != nil {
 		return err
 	}
@@ -330,7 +393,7 @@ func (pc *pushComm) InlineTransform(w http.ResponseWriter, _ *http.Request, lom
 
 func (pc *pushComm) OfflineTransform(lom *core.LOM, timeout time.Duration) (r cos.ReadCloseSizer, err error) {
 	clone := *lom
-	r, err = pc.doRequest(&clone, timeout)
+	r, err = pc.doRequest(&clone, timeout, nil)
 	if err == nil && cmn.Rom.FastV(5, cos.SmoduleETL) {
 		nlog.Infoln(Hpush, clone.Cname(), err)
 	}
@@ -341,7 +404,7 @@ func (pc *pushComm) OfflineTransform(lom *core.LOM, timeout time.Duration) (r co
 // redirectComm: implements Hpull
 //////////////////
 
-func (rc *redirectComm) InlineTransform(w http.ResponseWriter, r *http.Request, lom *core.LOM) error {
+func (rc *redirectComm) InlineTransform(w http.ResponseWriter, r *http.Request, lom *core.LOM, sect *Section) error {
 	if err := rc.boot.xctn.AbortErr(); err != nil {
 		return err
 	}
@@ -349,10 +412,23 @@ func (rc *redirectComm) InlineTransform(w http.ResponseWriter, r *http.Request,
 	if err != nil {
 		return err
 	}
+	if sect != nil {
+		size = sect.size(size)
+	}
 	if size > 0 {
 		rc.boot.xctn.OutObjsAdd(1, size)
 	}
-	http.Redirect(w, r, rc.redirectURL(lom), http.StatusTemporaryRedirect)
+	rc.boot.acct.add(size, 0, 0 /*transformer responds directly to the client*/)
+	u := rc.redirectURL(lom)
+	if sect != nil && sect.Archpath != "" {
+		q := url.Values{apc.QparamArchpath: []string{sect.Archpath}}
+		if sect.Archmime != "" {
+			q.Set(apc.QparamArchmime, sect.Archmime)
+		}
+		u += "?" + q.Encode()
+	}
+	// (the client resends Range header upon redirect)
+	http.Redirect(w, r, u, http.StatusTemporaryRedirect)
 
 	if cmn.Rom.FastV(5, cos.SmoduleETL) {
 		nlog.Infoln(Hpull, lom.Cname())
@@ -391,19 +467,28 @@ func (rc *redirectComm) OfflineTransform(lom *core.LOM, timeout time.Duration) (
 // revProxyComm: implements Hrev
 //////////////////
 
-func (rp *revProxyComm) InlineTransform(w http.ResponseWriter, r *http.Request, lom *core.LOM) error {
+// (Range header and archpath query, if present, are forwarded as is - see pruneQuery)
+func (rp *revProxyComm) InlineTransform(w http.ResponseWriter, r *http.Request, lom *core.LOM, sect *Section) error {
 	size, err := lomLoad(lom)
 	if err != nil {
 		return err
 	}
+	if sect != nil {
+		size = sect.size(size)
+	}
 	if size > 0 {
 		rp.boot.xctn.OutObjsAdd(1, size)
 	}
-	path := transformerPath(lom)
-
+	var (
+		path    = transformerPath(lom)
+		started = mono.NanoTime()
+		cw      = &cntWriter{ResponseWriter: w}
+	)
 	r.URL.Path, _ = url.PathUnescape(path) // `Path` must be unescaped otherwise it will be escaped again.
 	r.URL.RawPath = path                   // `RawPath` should be escaped version of `Path`.
-	rp.rp.ServeHTTP(w, r)
+	rp.rp.ServeHTTP(cw, r)
+
+	rp.boot.acct.add(size, cw.n, started)
 
 	return nil
 }
@@ -423,6 +508,64 @@ func (rp *revProxyComm) OfflineTransform(lom *core.LOM, timeout time.Duration) (
 	return r, err
 }
 
+/////////////
+// Section //
+/////////////
+
+func (sect *Section) String() string {
+	if sect.Archpath != "" {
+		return apc.QparamArchpath + "=" + sect.Archpath
+	}
+	return fmt.Sprintf("bytes=%d-%d", sect.Offset, sect.Offset+sect.Length-1)
+}
+
+// (byte range only - archived file size is unknown until the archive is read)
+func (sect *Section) size(total int64) int64 {
+	if sect.Archpath == "" && sect.Length > 0 {
+		return sect.Length
+	}
+	return total
+}
+
+// open the selected section of the (loaded and locked) object
+func (sect *Section) open(lom *core.LOM) (io.ReadCloser, int64, error) {
+	if sect.Archpath == "" {
+		fsh, err := cos.NewFileSectionHandle(lom.FQN, sect.Offset, sect.Length)
+		if err != nil {
+			return nil, 0, err
+		}
+		return fsh, sect.Length, nil
+	}
+	fh, err := cos.NewFileHandle(lom.FQN)
+	if err != nil {
+		return nil, 0, err
+	}
+	mime, err := archive.MimeFile(fh, core.T.ByteMM(), sect.Archmime, lom.ObjName)
+	if err != nil {
+		cos.Close(fh)
+		return nil, 0, err
+	}
+	ar, err := archive.NewReader(mime, fh, lom.Lsize())
+	if err != nil {
+		cos.Close(fh)
+		return nil, 0, fmt.Errorf("failed to open %s: %w", lom.Cname(), err)
+	}
+	csl, err := ar.ReadOne(sect.Archpath)
+	if err == nil && csl == nil {
+		err = cos.NewErrNotFound(core.T, sect.Archpath+" in "+lom.Cname())
+	}
+	if err != nil {
+		cos.Close(fh)
+		return nil, 0, err
+	}
+	return &archSection{csl, fh}, csl.Size(), nil
+}
+
+func (a *archSection) Close() error {
+	cos.Close(a.ReadCloseSizer)
+	return a.fh.Close()
+}
+
 //////////////
 // cbWriter //
 //////////////
@@ -433,6 +576,19 @@ func (cw *cbWriter) Write(b []byte) (n int, err error) {
 	return
 }
 
+///////////////
+// cntWriter //
+///////////////
+
+func (cw *cntWriter) Write(b []byte) (n int, err error) {
+	n, err = cw.ResponseWriter.Write(b)
+	cw.n += int64(n)
+	return
+}
+
+// (used by http.ResponseController to flush)
+func (cw *cntWriter) Unwrap() http.ResponseWriter { return cw.ResponseWriter }
+
 //
 // utils
 //
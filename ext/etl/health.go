@@ -0,0 +1,155 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/k8s"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/hk"
+)
+
+// Periodic (housekeeping) health probing of the local ETL pods:
+// - pod readiness (as per the pod's `readinessProbe`, which is required) is checked every `healthIval`;
+// - upon `maxHealthFails` consecutive failures the ETL is marked degraded
+//   and its pod (and service) get recreated - up to `maxRestarts` times;
+// - a degraded ETL fails inline transforms explicitly (503) and shows up as such
+//   in the ETL listing;
+// - successful restart clears the "degraded" state but keeps the restart count.
+
+const (
+	healthIval     = 10 * time.Second
+	maxHealthFails = 3
+	maxRestarts    = 3
+)
+
+type health struct {
+	fails      atomic.Int32
+	restarts   atomic.Int32
+	degraded   atomic.Bool
+	restarting atomic.Bool
+}
+
+var (
+	healthOnce sync.Once
+	probing    atomic.Bool
+)
+
+func (h *health) status() string {
+	if h.degraded.Load() {
+		return StatusDegraded
+	}
+	return StatusRunning
+}
+
+func regHealth() {
+	healthOnce.Do(func() {
+		hk.Reg("etl-health"+hk.NameSuffix, probeAll, healthIval)
+	})
+}
+
+// (housekeeping callback; k8s API calls may take time - hence, the goroutine)
+func probeAll() time.Duration {
+	if probing.CAS(false, true) {
+		go func() {
+			for _, c := range reg.all() {
+				probe(c)
+			}
+			probing.Store(false)
+		}()
+	}
+	return healthIval
+}
+
+func probe(c Communicator) {
+	var (
+		boot = c.bootstrapper()
+		h    = boot.hlth
+	)
+	if h.restarting.Load() {
+		return
+	}
+	client, err := k8s.GetClient()
+	if err != nil {
+		return
+	}
+	ready, err := checkPodReady(client, c.PodName())
+	if ready {
+		h.fails.Store(0)
+		return
+	}
+	n := h.fails.Inc()
+	if err == nil {
+		err = fmt.Errorf("pod %q not ready", c.PodName())
+	}
+	nlog.Warningf("%s: health check failed (%d/%d): %v", c, n, maxHealthFails, err)
+	if n < maxHealthFails {
+		return
+	}
+	if !h.degraded.Swap(true) {
+		nlog.Errorln(c.String(), "is now degraded")
+	}
+	if h.restarts.Load() >= maxRestarts {
+		return
+	}
+	h.restarting.Store(true)
+	go restart(c, boot)
+}
+
+// recreate ETL pod and service, reconnect, and replace the communicator -
+// all the while keeping the same xaction (and its stats)
+func restart(c Communicator, prev *etlBootstrapper) {
+	var (
+		h     = prev.hlth
+		name  = prev.msg.IDX
+		boot  = &etlBootstrapper{errCtx: &cmn.ETLErrCtx{TID: core.T.SID(), ETLName: name}, config: cmn.GCO.Get()}
+		nrest = h.restarts.Inc()
+	)
+	defer h.restarting.Store(false)
+
+	nlog.Warningf("%s: restarting (%d/%d)", c, nrest, maxRestarts)
+	boot.msg, boot.env, boot.hlth, boot.acct = prev.msg, prev.env, h, prev.acct
+	podName, svcName, err := boot.deploy()
+	if err != nil {
+		nlog.Errorln(c.String(), "failed to restart:", err)
+		if errV := cleanupEntities(boot.errCtx, podName, svcName); errV != nil {
+			nlog.Errorln(errV)
+		}
+		return
+	}
+	boot.xctn = prev.xctn
+
+	comm := newCommunicator(newAborter(name), boot)
+	if !reg.replace(name, c, comm) {
+		// stopped in the meantime
+		if errV := cleanupEntities(boot.errCtx, podName, svcName); errV != nil {
+			nlog.Errorln(errV)
+		}
+		return
+	}
+	core.T.Sowner().Listeners().Unreg(c)
+	core.T.Sowner().Listeners().Reg(comm)
+
+	h.fails.Store(0)
+	h.degraded.Store(false)
+	nlog.Infoln(comm.String(), "restarted")
+}
+
+// (see Communicator.Degraded)
+func (c *baseComm) Degraded() error {
+	if !c.boot.hlth.degraded.Load() {
+		return nil
+	}
+	return cmn.NewErrETL(c.boot.errCtx, "is degraded (pod %q failed health checks, restarts: %d)",
+		c.PodName(), c.boot.hlth.restarts.Load())
+}
+
+func (c *baseComm) bootstrapper() *etlBootstrapper { return c.boot }
@@ -48,6 +48,27 @@ func (r *registry) get(name string) (c Communicator, exists bool) {
 	return
 }
 
+// replace `prev` with `c` unless `prev` has been meanwhile removed (or replaced)
+func (r *registry) replace(name string, prev, c Communicator) (ok bool) {
+	r.mtx.Lock()
+	if cur, exists := r.m[name]; exists && cur == prev {
+		r.m[name] = c
+		ok = true
+	}
+	r.mtx.Unlock()
+	return
+}
+
+func (r *registry) all() []Communicator {
+	r.mtx.RLock()
+	comms := make([]Communicator, 0, len(r.m))
+	for _, c := range r.m {
+		comms = append(comms, c)
+	}
+	r.mtx.RUnlock()
+	return comms
+}
+
 func (r *registry) del(name string) (c Communicator) {
 	var ok bool
 	debug.Assert(name != "")
@@ -63,12 +84,16 @@ func (r *registry) list() []Info {
 	r.mtx.RLock()
 	etls := make([]Info, 0, len(r.m))
 	for name, comm := range r.m {
+		h := comm.bootstrapper().hlth
 		etls = append(etls, Info{
 			Name:     name,
 			XactID:   comm.Xact().ID(),
 			ObjCount: comm.ObjCount(),
 			InBytes:  comm.InBytes(),
 			OutBytes: comm.OutBytes(),
+			Status:   h.status(),
+			Restarts: int64(h.restarts.Load()),
+			Latency:  cos.Duration(comm.Latency()),
 		})
 	}
 	r.mtx.RUnlock()
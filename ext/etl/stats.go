@@ -0,0 +1,124 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Per-ETL accounting:
+// - number of transformed objects (or sections thereof), bytes sent to and received from
+//   the transformer, and cumulative transform time - all by ETL name
+// - cumulative across pod restarts and ETL re-inits (compare w/ xaction stats)
+// - exported as target metrics "etl.<NAME>.*" (Prometheus: "etl_*" labeled with etl=<NAME>),
+//   and shown in the ETL listing (see Info)
+// - with hpull:// the transformer responds directly to the client, and so neither
+//   received bytes nor transform time are known
+
+type acct struct {
+	cnt, ns, in, out string // metric names
+	n, total         atomic.Int64
+}
+
+var (
+	tstats stats.Tracker
+	accts  = make(map[string]*acct, 4)
+	acctMu sync.Mutex
+)
+
+func Tinit(t stats.Tracker) { tstats = t }
+
+func getAcct(name string) *acct {
+	acctMu.Lock()
+	a, ok := accts[name]
+	if !ok {
+		a = newAcct(name)
+		accts[name] = a
+	}
+	acctMu.Unlock()
+	return a
+}
+
+func newAcct(name string) *acct {
+	prefix := "etl." + name + "."
+	a := &acct{cnt: prefix + "n", ns: prefix + "ns.total", in: prefix + "in.size", out: prefix + "out.size"}
+	if tstats == nil {
+		return a // (unit tests)
+	}
+	var (
+		snode  = core.T.Snode()
+		labels = cos.StrKVs{"etl": name}
+	)
+	tstats.RegExtMetric(snode, a.cnt, stats.KindCounter,
+		&stats.Extra{
+			Help:    "ETL: total number of transformed objects (or their parts)",
+			StrName: "etl_transform_count",
+			Labels:  labels,
+		},
+	)
+	tstats.RegExtMetric(snode, a.ns, stats.KindTotal,
+		&stats.Extra{
+			Help:    "ETL: total cumulative time (nanoseconds) to transform objects, from request to the end of response",
+			StrName: "etl_transform_ns_total",
+			Labels:  labels,
+		},
+	)
+	tstats.RegExtMetric(snode, a.out, stats.KindSize,
+		&stats.Extra{
+			Help:    "ETL: total cumulative size (bytes) sent to the transformer",
+			StrName: "etl_out_bytes_total",
+			Labels:  labels,
+		},
+	)
+	tstats.RegExtMetric(snode, a.in, stats.KindSize,
+		&stats.Extra{
+			Help:    "ETL: total cumulative size (bytes) received from the transformer",
+			StrName: "etl_in_bytes_total",
+			Labels:  labels,
+		},
+	)
+	return a
+}
+
+// zero `started` - transform time unknown
+func (a *acct) add(out, in, started int64) {
+	if a == nil {
+		return
+	}
+	var ns int64
+	if started != 0 {
+		ns = mono.SinceNano(started)
+		a.n.Inc()
+		a.total.Add(ns)
+	}
+	if tstats == nil {
+		return
+	}
+	tstats.AddMany(
+		cos.NamedVal64{Name: a.cnt, Value: 1},
+		cos.NamedVal64{Name: a.ns, Value: ns},
+		cos.NamedVal64{Name: a.out, Value: out},
+		cos.NamedVal64{Name: a.in, Value: in},
+	)
+}
+
+// average transform time
+func (a *acct) latency() time.Duration {
+	if a == nil {
+		return 0
+	}
+	n := a.n.Load()
+	if n == 0 {
+		return 0
+	}
+	return time.Duration(a.total.Load() / n)
+}
@@ -250,37 +250,11 @@ func start(msg *InitSpecMsg, xid string, opts StartOpts, config *cmn.Config) (er
 	debug.Assert(k8s.NodeName != "") // checked above
 
 	errCtx = &cmn.ETLErrCtx{TID: core.T.SID(), ETLName: msg.IDX}
-	boot := &etlBootstrapper{errCtx: errCtx, config: config, env: opts.Env}
+	boot := &etlBootstrapper{errCtx: errCtx, config: config, env: opts.Env, hlth: &health{}}
+	boot.acct = getAcct(msg.IDX)
 	boot.msg = *msg
 
-	// Parse spec template and fill Pod object with necessary fields.
-	if err = boot.createPodSpec(); err != nil {
-		return
-	}
-
-	boot.createServiceSpec()
-
-	// 1. Cleanup previously started entities, if any.
-	errCleanup := cleanupEntities(errCtx, boot.pod.Name, boot.svc.Name)
-	debug.AssertNoErr(errCleanup)
-
-	// 2. Creating service.
-	svcName = boot.svc.GetName()
-	if err = boot.createEntity(k8s.Svc); err != nil {
-		return
-	}
-	// 3. Creating pod.
-	podName = boot.pod.GetName()
-	if err = boot.createEntity(k8s.Pod); err != nil {
-		return
-	}
-	if err = boot.waitPodReady(); err != nil {
-		return
-	}
-	if cmn.Rom.FastV(4, cos.SmoduleETL) {
-		nlog.Infof("pod %q is ready, %+v, %s", podName, msg, boot.errCtx)
-	}
-	if err = boot.setupConnection(); err != nil {
+	if podName, svcName, err = boot.deploy(); err != nil {
 		return
 	}
 
@@ -292,6 +266,7 @@ func start(msg *InitSpecMsg, xid string, opts StartOpts, config *cmn.Config) (er
 		return
 	}
 	core.T.Sowner().Listeners().Reg(comm)
+	regHealth()
 	return
 }
 
@@ -33,6 +33,7 @@ const (
 	WorkfilePut          = "put"            // object PUT
 	WorkfileCopy         = "copy"           // copy object
 	WorkfileAppend       = "append"         // APPEND to object (as file)
+	WorkfilePatch        = "patch"          // PATCH (byte-range PUT) object
 	WorkfileAppendToArch = "append-to-arch" // APPEND to existing archive
 	WorkfileCreateArch   = "create-arch"    // CREATE multi-object archive
 )
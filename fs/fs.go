@@ -64,6 +64,8 @@ type (
 		flags      uint64    // bit flags (set/get atomic)
 		PathDigest uint64    // (HRW logic)
 		capacity   Capacity
+		lms        LmetaStore // object metadata store (nil => default)
+		iosched    iosched    // per-mountpath IO scheduling (see iosched.go)
 	}
 	MPI map[string]*Mountpath
 
@@ -426,6 +428,10 @@ func (mi *Mountpath) _addEnabled(tid string, avail MPI, config *cmn.Config) erro
 	if err != nil {
 		return err
 	}
+	if err := mi.initLms(config); err != nil {
+		mfs.ios.RemoveMpath(mi.Path, config.TestingEnv())
+		return err
+	}
 	if tid != "" && config.WritePolicy.MD != apc.WriteNever {
 		if err := mi.SetDaemonIDXattr(tid); err != nil {
 			return err
@@ -806,6 +812,7 @@ func Remove(mpath string, cb ...func()) (*Mountpath, error) {
 	}
 	moveMarkers(availableCopy, mi)
 	putAvailMPI(availableCopy)
+	mi.closeLms()
 	if availCnt > 0 && len(cb) > 0 {
 		cb[0]()
 	}
@@ -981,6 +988,7 @@ func DestroyBucket(op string, bck *cmn.Bck, bid uint64) (err error) {
 			nlog.Errorf("%s %q: failed to rm dir %q: %v", op, bck, dir, errMv)
 			mfs.hc.FSHC(errMv, mi, "")
 		} else {
+			mi.Lms().DelDir(dir)
 			n++
 		}
 	}
@@ -1005,6 +1013,12 @@ func RenameBucketDirs(bckFrom, bckTo *cmn.Bck) (err error) {
 		if err = os.Rename(fromPath, toPath); err != nil {
 			break
 		}
+		if err = mi.Lms().RenameDir(fromPath, toPath); err != nil {
+			if erd := os.Rename(toPath, fromPath); erd != nil {
+				nlog.Errorln(erd)
+			}
+			break
+		}
 		renamed = append(renamed, mi)
 	}
 
@@ -1017,6 +1031,8 @@ func RenameBucketDirs(bckFrom, bckTo *cmn.Bck) (err error) {
 		if erd := os.Rename(fromPath, toPath); erd != nil {
 			nlog.Errorln(erd)
 			mfs.hc.FSHC(erd, mi, "")
+		} else if erd := mi.Lms().RenameDir(fromPath, toPath); erd != nil {
+			nlog.Errorln(erd)
 		}
 	}
 	return
@@ -0,0 +1,124 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+// Per-mountpath IO scheduler (cmn.IOSchedConf):
+// - three classes: user reads (GET), user writes (PUT), and background IO (rebalance, resilver,
+//   mirroring, EC encoding, bucket copy/transform, and other mountpath joggers)
+// - "IO operation" is reading or writing a single object, or - for background - visiting one
+// - at most io_sched.max_inflight IO operations run on a given mountpath at the same time;
+//   the rest wait in per-class FIFO queues
+// - a freed slot goes to the waiting class with the smallest virtual time (stride scheduling):
+//   under contention, each class gets its share of slots; uncontended, any class can use all
+//   slots, and idle classes do not accumulate credit
+// - background IO always gets at least its share and, therefore, never starves
+// - on the other hand, background IO never takes the last slot: it may (indirectly) depend on
+//   user IO - e.g., ETL that reads the object it transforms via regular GET
+// - NOTE: nested IO (e.g., background job that writes) is not scheduled - to avoid deadlocks
+
+type IOClass int
+
+const (
+	IORead IOClass = iota
+	IOWrite
+	IOBg
+	numIOClasses
+)
+
+const iostride = 1 << 20 // class stride = iostride / share
+
+type iosched struct {
+	waiting [numIOClasses][]chan struct{}
+	pass    [numIOClasses]int64 // virtual time
+	vtime   int64               // virtual time of the most recently dispatched
+	busy    int
+	busyBg  int
+	mu      sync.Mutex
+}
+
+// returns false when scheduling is disabled - nothing to end
+func (mi *Mountpath) BeginIO(class IOClass) bool {
+	conf := &cmn.GCO.Get().IOSched
+	if !conf.Enabled {
+		return false
+	}
+	s := &mi.iosched
+	s.mu.Lock()
+	if s.busy < conf.MaxInflight && s.next(conf) < 0 && s.eligible(class, conf) {
+		s.dispatch(class, conf)
+		s.mu.Unlock()
+		return true
+	}
+	ch := make(chan struct{})
+	if len(s.waiting[class]) == 0 {
+		s.pass[class] = max(s.pass[class], s.vtime) // (no credit for idling)
+	}
+	s.waiting[class] = append(s.waiting[class], ch)
+	s.mu.Unlock()
+
+	<-ch
+	return true
+}
+
+// (iff BeginIO returned true)
+func (mi *Mountpath) EndIO(class IOClass) {
+	conf := &cmn.GCO.Get().IOSched
+	s := &mi.iosched
+	s.mu.Lock()
+	s.busy--
+	if class == IOBg {
+		s.busyBg--
+	}
+	for !conf.Enabled || s.busy < conf.MaxInflight {
+		class := s.next(conf)
+		if class < 0 {
+			break
+		}
+		ch := s.waiting[class][0]
+		s.waiting[class] = s.waiting[class][1:]
+		s.dispatch(class, conf)
+		close(ch)
+	}
+	s.mu.Unlock()
+}
+
+// waiting (and eligible) class with the smallest virtual time, or -1
+func (s *iosched) next(conf *cmn.IOSchedConf) IOClass {
+	next := IOClass(-1)
+	for class := range numIOClasses {
+		if len(s.waiting[class]) > 0 && (next < 0 || s.pass[class] < s.pass[next]) && s.eligible(class, conf) {
+			next = class
+		}
+	}
+	return next
+}
+
+func (s *iosched) eligible(class IOClass, conf *cmn.IOSchedConf) bool {
+	return class != IOBg || !conf.Enabled || s.busyBg < conf.MaxInflight-1
+}
+
+func (s *iosched) dispatch(class IOClass, conf *cmn.IOSchedConf) {
+	var share int
+	switch class {
+	case IORead:
+		share = conf.ReadShare
+	case IOWrite:
+		share = conf.WriteShare
+	default:
+		share = conf.BgShare
+	}
+	s.busy++
+	if class == IOBg {
+		s.busyBg++
+	}
+	s.vtime = s.pass[class]
+	s.pass[class] += iostride / int64(max(share, 1))
+}
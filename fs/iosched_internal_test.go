@@ -0,0 +1,75 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+func TestIOSchedShares(t *testing.T) {
+	var (
+		s    iosched
+		conf = &cmn.IOSchedConf{MaxInflight: 1000, ReadShare: 60, WriteShare: 30, BgShare: 10, Enabled: true}
+		cnt  [numIOClasses]int
+	)
+	for class := range numIOClasses {
+		for range 100 {
+			s.waiting[class] = append(s.waiting[class], make(chan struct{}))
+		}
+	}
+	for range 100 {
+		class := s.next(conf)
+		s.waiting[class] = s.waiting[class][1:]
+		s.dispatch(class, conf)
+		cnt[class]++
+	}
+	if cnt[IORead] != 60 || cnt[IOWrite] != 30 || cnt[IOBg] != 10 {
+		t.Fatalf("expected 60/30/10, got %v", cnt)
+	}
+}
+
+func TestIOSchedWait(t *testing.T) {
+	config := cmn.GCO.BeginUpdate()
+	config.IOSched = cmn.IOSchedConf{MaxInflight: 2, ReadShare: 60, WriteShare: 30, BgShare: 10, Enabled: true}
+	cmn.GCO.CommitUpdate(config)
+	defer func() {
+		config := cmn.GCO.BeginUpdate()
+		config.IOSched.Enabled = false
+		cmn.GCO.CommitUpdate(config)
+	}()
+
+	mi := &Mountpath{}
+	if !mi.BeginIO(IOBg) {
+		t.Fatal("expected scheduled IO")
+	}
+	// background never takes the last slot
+	done := make(chan struct{})
+	go func() {
+		mi.BeginIO(IOBg)
+		close(done)
+	}()
+	select {
+	case <-done:
+		t.Fatal("expected to wait for the slot")
+	case <-time.After(50 * time.Millisecond):
+	}
+	// user IO does get it
+	mi.BeginIO(IORead)
+	mi.EndIO(IORead)
+
+	mi.EndIO(IOBg)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected to get the slot")
+	}
+	mi.EndIO(IOBg)
+	if mi.iosched.busy != 0 || mi.iosched.busyBg != 0 {
+		t.Fatalf("expected zero in-flight, got %d (%d)", mi.iosched.busy, mi.iosched.busyBg)
+	}
+}
@@ -0,0 +1,200 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/kvdb"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// Object (LOM) metadata store - one per mountpath:
+// - cmn.LmetaStoreXattr (default): metadata is stored as the object's extended attribute (XattrLOM)
+// - cmn.LmetaStoreKVDB: embedded key-value DB (fname.LmetaDB) at the root of the mountpath;
+//   keys are mountpath-relative FQNs; all lookups are in-memory (no getxattr/setxattr syscalls)
+//
+// Either way, object data remains stored as a regular file (FQN) - the store
+// is only about per-object metadata (storing data as raw extents is not implemented).
+// Selection: local config (cmn.LocalConfig.LmetaStores), upon adding or enabling mountpath.
+//
+// Notes:
+// - kvdb is memory-resident: memory usage grows linearly with the number of objects
+//   on the mountpath (see docs/configuration.md)
+// - renaming and removing objects' files must go through Mountpath.Rename and .Remove
+//   (or LOM methods), so that the metadata follows
+// - multi-key updates (bucket rename and destroy) are transactional
+
+// on-disk xattr name
+const XattrLOM = "user.ais.lom"
+
+const lmetaColl = "lom"
+
+type (
+	LmetaStore interface {
+		Kind() string
+		// returns syscall.ERANGE when `buf` is too small, and IsErrXattrNotFound error when not found
+		Get(fqn string, buf []byte) ([]byte, error)
+		Set(fqn string, b []byte) error
+		Del(fqn string)
+		Rename(from, to string) error
+		// bucket level
+		DelDir(dir string)
+		RenameDir(from, to string) error
+		Close() error
+	}
+	xattrLms struct{}
+	kvLms    struct {
+		db   *kvdb.BuntDriver
+		root string
+	}
+)
+
+// interface guard
+var (
+	_ LmetaStore = (*xattrLms)(nil)
+	_ LmetaStore = (*kvLms)(nil)
+)
+
+var defaultLms LmetaStore = &xattrLms{}
+
+func (mi *Mountpath) Lms() LmetaStore {
+	if mi == nil || mi.lms == nil {
+		return defaultLms
+	}
+	return mi.lms
+}
+
+// (under mfs lock)
+func (mi *Mountpath) initLms(config *cmn.Config) error {
+	kind := config.LocalConfig.LmetaStores[mi.Path]
+	if mi.lms != nil {
+		debug.Assert(kind == mi.lms.Kind() || kind == "")
+		return nil
+	}
+	switch kind {
+	case "", cmn.LmetaStoreXattr:
+		return nil
+	case cmn.LmetaStoreKVDB:
+		db, err := kvdb.NewBuntDB(filepath.Join(mi.Path, fname.LmetaDB))
+		if err != nil {
+			return fmt.Errorf("%s: failed to open object metadata store: %w", mi, err)
+		}
+		mi.lms = &kvLms{db: db, root: mi.Path}
+		nlog.Infoln(mi.String(), "object metadata store:", kind)
+		return nil
+	default:
+		return fmt.Errorf("%s: invalid object metadata store %q", mi, kind)
+	}
+}
+
+func (mi *Mountpath) closeLms() {
+	if mi.lms == nil {
+		return
+	}
+	if err := mi.lms.Close(); err != nil {
+		nlog.Errorln(mi.String(), "failed to close object metadata store:", err)
+	}
+	mi.lms = nil
+}
+
+// rename file within the mountpath, along with its metadata (if any)
+func (mi *Mountpath) Rename(from, to string) error {
+	if err := cos.Rename(from, to); err != nil {
+		return err
+	}
+	if err := mi.Lms().Rename(from, to); err != nil {
+		if errV := os.Rename(to, from); errV != nil {
+			nlog.Errorln("failed to rename back", to, "=>", from, "[", errV, "]")
+		}
+		return err
+	}
+	return nil
+}
+
+// remove file along with its metadata (if any)
+func (mi *Mountpath) Remove(fqn string) error {
+	err := cos.RemoveFile(fqn)
+	if err == nil {
+		mi.Lms().Del(fqn)
+	}
+	return err
+}
+
+//////////////
+// xattrLms //
+//////////////
+
+func (*xattrLms) Kind() string { return cmn.LmetaStoreXattr }
+
+func (*xattrLms) Get(fqn string, buf []byte) ([]byte, error) { return GetXattrBuf(fqn, XattrLOM, buf) }
+func (*xattrLms) Set(fqn string, b []byte) error             { return SetXattr(fqn, XattrLOM, b) }
+
+// (removed together with the file or directory)
+func (*xattrLms) Del(string)                     {}
+func (*xattrLms) Rename(string, string) error    { return nil }
+func (*xattrLms) DelDir(string)                  {}
+func (*xattrLms) RenameDir(string, string) error { return nil }
+func (*xattrLms) Close() error                   { return nil }
+
+///////////
+// kvLms //
+///////////
+
+func (*kvLms) Kind() string { return cmn.LmetaStoreKVDB }
+
+func (kv *kvLms) key(fqn string) string {
+	debug.Assert(strings.HasPrefix(fqn, kv.root), fqn, " vs ", kv.root)
+	return fqn[len(kv.root):]
+}
+
+func (kv *kvLms) Get(fqn string, buf []byte) ([]byte, error) {
+	s, err := kv.db.GetString(lmetaColl, kv.key(fqn))
+	if err != nil {
+		if cos.IsNotExist(err, 0) {
+			return nil, os.ErrNotExist
+		}
+		return nil, err
+	}
+	if len(s) > len(buf) {
+		return nil, syscall.ERANGE
+	}
+	n := copy(buf, s)
+	return buf[:n], nil
+}
+
+func (kv *kvLms) Set(fqn string, b []byte) error {
+	return kv.db.SetString(lmetaColl, kv.key(fqn), string(b))
+}
+
+func (kv *kvLms) Del(fqn string) {
+	if err := kv.db.Delete(lmetaColl, kv.key(fqn)); err != nil && !cos.IsNotExist(err, 0) {
+		nlog.Errorln("failed to delete", fqn, "metadata:", err)
+	}
+}
+
+func (kv *kvLms) Rename(from, to string) error {
+	return kv.db.Rename(lmetaColl, kv.key(from), kv.key(to))
+}
+
+func (kv *kvLms) DelDir(dir string) {
+	if err := kv.db.DeletePrefix(lmetaColl, kv.key(dir)+cos.PathSeparator); err != nil {
+		nlog.Errorln("failed to delete", dir, "metadata:", err)
+	}
+}
+
+func (kv *kvLms) RenameDir(from, to string) error {
+	return kv.db.RenamePrefix(lmetaColl, kv.key(from)+cos.PathSeparator, kv.key(to)+cos.PathSeparator)
+}
+
+func (kv *kvLms) Close() error { return kv.db.Close() }
@@ -0,0 +1,147 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs_test
+
+import (
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestLmetaStoreKVDB(t *testing.T) {
+	initFS()
+	mpath := t.TempDir()
+
+	config := cmn.GCO.BeginUpdate()
+	config.LocalConfig.LmetaStores = cos.StrKVs{mpath: cmn.LmetaStoreKVDB}
+	cmn.GCO.CommitUpdate(config)
+	defer func() {
+		config := cmn.GCO.BeginUpdate()
+		config.LocalConfig.LmetaStores = nil
+		cmn.GCO.CommitUpdate(config)
+	}()
+
+	mi, err := fs.Add(mpath, "daeID")
+	tassert.CheckFatal(t, err)
+	defer fs.Remove(mpath)
+
+	lms := mi.Lms()
+	tassert.Fatalf(t, lms.Kind() == cmn.LmetaStoreKVDB, "expected %q, got %q", cmn.LmetaStoreKVDB, lms.Kind())
+
+	var (
+		from = cmn.Bck{Name: "from", Provider: apc.AIS, Ns: cmn.NsGlobal}
+		to   = cmn.Bck{Name: "to", Provider: apc.AIS, Ns: cmn.NsGlobal}
+		fqn  = filepath.Join(mi.MakePathBck(&from), "obj")
+		md   = []byte("lom-metadata")
+		buf  = make([]byte, 64)
+	)
+	_, err = lms.Get(fqn, buf)
+	tassert.Errorf(t, cos.IsErrXattrNotFound(err), "expected not-found, got %v", err)
+
+	tassert.CheckFatal(t, lms.Set(fqn, md))
+	b, err := lms.Get(fqn, buf)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, string(b) == string(md), "expected %q, got %q", md, b)
+
+	_, err = lms.Get(fqn, buf[:4])
+	tassert.Errorf(t, err == syscall.ERANGE, "expected ERANGE, got %v", err)
+
+	// bucket rename
+	tassert.CheckFatal(t, lms.RenameDir(mi.MakePathBck(&from), mi.MakePathBck(&to)))
+	_, err = lms.Get(fqn, buf)
+	tassert.Errorf(t, cos.IsErrXattrNotFound(err), "expected not-found after rename, got %v", err)
+	fqn = filepath.Join(mi.MakePathBck(&to), "obj")
+	b, err = lms.Get(fqn, buf)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, string(b) == string(md), "expected %q, got %q", md, b)
+
+	lms.Del(fqn)
+	_, err = lms.Get(fqn, buf)
+	tassert.Errorf(t, cos.IsErrXattrNotFound(err), "expected not-found after delete, got %v", err)
+}
+
+func TestLmetaStoreRenameRemove(t *testing.T) {
+	initFS()
+	mpath := t.TempDir()
+
+	config := cmn.GCO.BeginUpdate()
+	config.LocalConfig.LmetaStores = cos.StrKVs{mpath: cmn.LmetaStoreKVDB}
+	cmn.GCO.CommitUpdate(config)
+	defer func() {
+		config := cmn.GCO.BeginUpdate()
+		config.LocalConfig.LmetaStores = nil
+		cmn.GCO.CommitUpdate(config)
+	}()
+
+	mi, err := fs.Add(mpath, "daeID")
+	tassert.CheckFatal(t, err)
+	defer fs.Remove(mpath)
+
+	var (
+		lms  = mi.Lms()
+		bck  = cmn.Bck{Name: "bck", Provider: apc.AIS, Ns: cmn.NsGlobal}
+		to   = cmn.Bck{Name: "to", Provider: apc.AIS, Ns: cmn.NsGlobal}
+		fqn  = filepath.Join(mi.MakePathBck(&bck), "obj")
+		wfqn = filepath.Join(mi.MakePathBck(&bck), "work")
+		md   = []byte("lom-metadata")
+		buf  = make([]byte, 64)
+	)
+	create := func(fqn string) {
+		f, err := cos.CreateFile(fqn)
+		tassert.CheckFatal(t, err)
+		f.Close()
+		tassert.CheckFatal(t, lms.Set(fqn, md))
+	}
+	exists := func(fqn string, expected bool) {
+		_, err := lms.Get(fqn, buf)
+		switch {
+		case expected && err != nil:
+			t.Fatalf("%s: expected metadata, got %v", fqn, err)
+		case !expected && !cos.IsErrXattrNotFound(err):
+			t.Fatalf("%s: expected no metadata, got %v", fqn, err)
+		}
+		erc := cos.Stat(fqn)
+		tassert.Fatalf(t, (erc == nil) == expected, "%s: exists=%t, expected %t", fqn, erc == nil, expected)
+	}
+
+	// rename (e.g., rebalance moving conflicting slice aside and back)
+	create(fqn)
+	tassert.CheckFatal(t, mi.Rename(fqn, wfqn))
+	exists(fqn, false)
+	exists(wfqn, true)
+	tassert.CheckFatal(t, mi.Rename(wfqn, fqn))
+	exists(wfqn, false)
+	exists(fqn, true)
+
+	// remove (e.g., eviction, cleanup); removing nonexistent is not an error
+	tassert.CheckFatal(t, mi.Remove(fqn))
+	exists(fqn, false)
+	tassert.CheckFatal(t, mi.Remove(fqn))
+
+	// bucket rename and destroy: all keys at once
+	const num = 100
+	for i := range num {
+		tassert.CheckFatal(t, lms.Set(filepath.Join(mi.MakePathBck(&bck), "d", strconv.Itoa(i)), md))
+	}
+	tassert.CheckFatal(t, lms.RenameDir(mi.MakePathBck(&bck), mi.MakePathBck(&to)))
+	for i := range num {
+		_, err := lms.Get(filepath.Join(mi.MakePathBck(&bck), "d", strconv.Itoa(i)), buf)
+		tassert.Fatalf(t, cos.IsErrXattrNotFound(err), "%d: expected renamed, got %v", i, err)
+		_, err = lms.Get(filepath.Join(mi.MakePathBck(&to), "d", strconv.Itoa(i)), buf)
+		tassert.CheckFatal(t, err)
+	}
+	lms.DelDir(mi.MakePathBck(&to))
+	for i := range num {
+		_, err := lms.Get(filepath.Join(mi.MakePathBck(&to), "d", strconv.Itoa(i)), buf)
+		tassert.Fatalf(t, cos.IsErrXattrNotFound(err), "%d: expected deleted, got %v", i, err)
+	}
+}
@@ -372,6 +372,9 @@ func (j *jogger) visitFQN(fqn string, buf []byte) error {
 			return nil
 		}
 	}
+	if j.mi.BeginIO(fs.IOBg) {
+		defer j.mi.EndIO(fs.IOBg)
+	}
 
 	switch ct.ContentType() {
 	case fs.ObjectType:
@@ -93,6 +93,13 @@ func newWorker(opts *WorkerGroupOpts, mi *fs.Mountpath) (w *worker) {
 	return
 }
 
+func (w *worker) callback(lom *core.LOM, buf []byte) {
+	if w.mi.BeginIO(fs.IOBg) {
+		defer w.mi.EndIO(fs.IOBg)
+	}
+	w.opts.Callback(lom, buf)
+}
+
 func (w *worker) work() error {
 	var buf []byte
 	if w.opts.Slab != nil {
@@ -107,7 +114,7 @@ func (w *worker) work() error {
 				break
 			}
 			if err = lom.Load(false /*cache it*/, false); err == nil {
-				w.opts.Callback(lom, buf)
+				w.callback(lom, buf)
 			} else {
 				core.FreeLOM(lom)
 			}
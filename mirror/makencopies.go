@@ -10,6 +10,7 @@ import (
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
@@ -30,12 +31,29 @@ type (
 	}
 
 	// mncXact runs in a background, traverses all local mountpaths, and makes sure
-	// the bucket is N-way replicated (where N >= 1).
+	// the bucket (or its part - see apc.MNCMsg.Prefix) is N-way replicated (where N >= 1).
+	// Objects that already have the requested number of copies are skipped (and counted) without
+	// locking or any other work - which is why re-running interrupted mnc resumes it, in effect.
+	// The rest get only the missing copies added (or the extra ones removed).
 	mncXact struct {
-		p *mncFactory
+		p     *mncFactory
+		bwlim xact.Bwlim
+		stats struct {
+			skipped atomic.Int64
+			added   atomic.Int64
+			removed atomic.Int64
+		}
 		xact.BckJog
 		_nam, _str string
 	}
+
+	// progress (see Snap)
+	MNCStats struct {
+		Prefix  string `json:"prefix,omitempty"`
+		Skipped int64  `json:"skipped,string"` // already had the requested number of copies
+		Added   int64  `json:"added,string"`   // number of added copies
+		Removed int64  `json:"removed,string"` // number of removed copies
+	}
 )
 
 // interface guard
@@ -64,6 +82,11 @@ func (*mncFactory) Kind() string     { return apc.ActMakeNCopies }
 func (p *mncFactory) Get() core.Xact { return p.xctn }
 
 func (p *mncFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (wpr xreg.WPR, err error) {
+	if p.args.Reconfig {
+		// the new number of copies is what counts; the work done so far (by the previous one)
+		// gets skipped
+		return xreg.WprAbort, nil
+	}
 	err = fmt.Errorf("%s is currently running, cannot start a new %q", prevEntry.Get(), p.Str(p.Kind()))
 	return
 }
@@ -82,12 +105,19 @@ func newMNC(p *mncFactory, slab *memsys.Slab) (r *mncXact) {
 		Slab:     slab,
 		DoLoad:   mpather.LoadUnsafe,
 		Throttle: true,
+		Prefix:   p.args.Prefix,
 	}
 	mpopts.Bck.Copy(p.Bck.Bucket())
 	r.BckJog.Init(p.UUID(), apc.ActMakeNCopies, p.Bck, mpopts, cmn.GCO.Get())
+	if p.args.MaxThroughput > 0 {
+		r.bwlim.Init(p.args.MaxThroughput)
+	}
 
 	// name
 	s := fmt.Sprintf("-%s-copies-%d", r.p.args.Tag, r.p.args.Copies)
+	if p.args.Prefix != "" {
+		s += "-prefix-" + p.args.Prefix
+	}
 	r._nam = r.Base.Name() + s
 	r._str = r.Base.String() + s
 	return r
@@ -107,6 +137,8 @@ func (r *mncXact) Run(wg *sync.WaitGroup) {
 	if err != nil {
 		r.AddErr(err)
 	}
+	nlog.Infoln(r.Name(), "skipped:", r.stats.skipped.Load(), "added:", r.stats.added.Load(),
+		"removed:", r.stats.removed.Load())
 	r.Finish()
 }
 
@@ -118,6 +150,7 @@ func (r *mncXact) visitObj(lom *core.LOM, buf []byte) (err error) {
 	)
 	switch {
 	case n == copies:
+		r.stats.skipped.Inc()
 		return nil
 	case n > copies:
 		lom.Lock(true)
@@ -127,6 +160,9 @@ func (r *mncXact) visitObj(lom *core.LOM, buf []byte) (err error) {
 		lom.Lock(true)
 		size, err = addCopies(lom, copies, buf)
 		lom.Unlock(true)
+		if err == nil && r.bwlim.Enabled() {
+			r.bwlim.Pace(size)
+		}
 	}
 
 	if err != nil {
@@ -146,8 +182,19 @@ func (r *mncXact) visitObj(lom *core.LOM, buf []byte) (err error) {
 		return
 	}
 
+	// (the copies may have changed in the meantime - see addCopies, delCopies)
+	nn := lom.NumCopies()
+	if nn == n {
+		r.stats.skipped.Inc()
+		return nil
+	}
 	if cmn.Rom.FastV(5, cos.SmoduleMirror) {
-		nlog.Infof("%s: %s, copies %d=>%d, size=%d", r.Base.Name(), lom.Cname(), n, copies, size)
+		nlog.Infof("%s: %s, copies %d=>%d, size=%d", r.Base.Name(), lom.Cname(), n, nn, size)
+	}
+	if nn > n {
+		r.stats.added.Add(int64(nn - n))
+	} else {
+		r.stats.removed.Add(int64(n - nn))
 	}
 	r.ObjsAdd(1, size)
 	if cnt := r.Objs(); cnt%128 == 0 { // TODO: configurable
@@ -166,6 +213,12 @@ func (r *mncXact) Snap() (snap *core.Snap) {
 	snap = &core.Snap{}
 	r.ToSnap(snap)
 
+	snap.Ext = &MNCStats{
+		Prefix:  r.p.args.Prefix,
+		Skipped: r.stats.skipped.Load(),
+		Added:   r.stats.added.Load(),
+		Removed: r.stats.removed.Load(),
+	}
 	snap.IdleX = r.IsIdle()
 	return
 }
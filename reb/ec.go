@@ -195,13 +195,51 @@ func (reb *Reb) saveCTToDisk(ntfn *stageNtfn, hdr *transport.ObjHdr, data io.Rea
 // has a slice of the same generation with different ID
 func (*Reb) renameAsWorkFile(ct *core.CT) (string, error) {
 	fqn := ct.Make(fs.WorkfileType)
-	// Using os.Rename is safe as both CT and Workfile on the same mountpath
-	if err := os.Rename(ct.FQN(), fqn); err != nil {
+	// both CT and workfile are on the same mountpath
+	if err := ct.Mountpath().Rename(ct.FQN(), fqn); err != nil {
 		return "", err
 	}
 	return fqn, nil
 }
 
+// Failure domains: when (upon topology change) the object's slices and replicas are
+// less spread across domains than they could be (see meta.HrwTargetList), re-encode the
+// object - the new generation of slices supersedes the old one.
+func (*Reb) respreadEC(ct *core.CT, md *ec.Metadata, smap *meta.Smap) {
+	if len(md.Daemons) < 2 || len(smap.FailureDomains()) == 0 {
+		return
+	}
+	tids := make([]string, 0, len(md.Daemons))
+	for tid := range md.Daemons {
+		tids = append(tids, tid)
+	}
+	curr := smap.MaxPerFailDom(tids)
+	expected, err := smap.HrwTargetList(ct.UnamePtr(), len(md.Daemons))
+	if err != nil {
+		return
+	}
+	for i, tsi := range expected {
+		tids[i] = tsi.ID()
+	}
+	if curr <= smap.MaxPerFailDom(tids) {
+		return
+	}
+
+	lom := core.AllocLOM(ct.ObjectName())
+	if err := lom.InitBck(ct.Bucket()); err != nil {
+		core.FreeLOM(lom)
+		return
+	}
+	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+		nlog.Warningln("failed to load", lom.Cname(), "to re-spread across failure domains:", err)
+	} else if err := ec.ECM.EncodeObject(lom, nil); err != nil && err != ec.ErrorECDisabled {
+		nlog.Warningln("failed to re-encode", lom.Cname(), "across failure domains:", err)
+	} else if cmn.Rom.FastV(4, cos.SmoduleReb) {
+		nlog.Infoln("re-encoding", lom.Cname(), "to spread across failure domains")
+	}
+	core.FreeLOM(lom)
+}
+
 // Find a target that has either an obsolete slice or no slice of the object.
 // Used to resolve the conflict: this target is the "main" one (has a full
 // replica) but it also stores a slice of the object. So, the existing slice
@@ -277,7 +315,7 @@ func (reb *Reb) renameLocalCT(req *stageNtfn, ct *core.CT, md *ec.Metadata) (
 		return
 	}
 	if moveTo, err = reb.findEmptyTarget(md, ct, req.daemonID); err != nil {
-		if errMv := os.Rename(workFQN, ct.FQN()); errMv != nil {
+		if errMv := ct.Mountpath().Rename(workFQN, ct.FQN()); errMv != nil {
 			nlog.Errorf("Error restoring slice: %v", errMv)
 		}
 	}
@@ -318,9 +356,13 @@ func (reb *Reb) walkEC(fqn string, de fs.DirEntry) error {
 
 	smap := reb.smap.Load()
 	hrwTarget, err := smap.HrwHash2T(ct.Digest())
-	if err != nil || hrwTarget.ID() == core.T.SID() {
+	if err != nil {
 		return err
 	}
+	if hrwTarget.ID() == core.T.SID() {
+		reb.respreadEC(ct, md, smap)
+		return nil
+	}
 
 	// check if both slice/replica and metafile exist
 	isReplica := md.SliceID == 0
@@ -32,12 +32,15 @@ func IsGFN() bool {
 	return gfn.gon.Load() || gfn.exp.Load() > mono.NanoTime()
 }
 
-func OnTimedGFN() {
+func OnTimedGFN() { OnTimedGFNFor(timedDuration) }
+
+// same as above with a given (longer) duration, e.g. to cover warm restart's grace period
+func OnTimedGFNFor(d time.Duration) {
 	if gfn.gon.Load() {
 		return
 	}
 	act := "updated"
-	exp := mono.NanoTime() + timedDuration.Nanoseconds()
+	exp := mono.NanoTime() + max(d, timedDuration).Nanoseconds()
 	if gfn.exp.Swap(exp) == 0 {
 		act = "on"
 	}
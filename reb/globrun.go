@@ -72,6 +72,7 @@ type (
 	Reb struct {
 		smap      ratomic.Pointer[meta.Smap] // next smap (new that'll become current after rebalance)
 		xreb      ratomic.Pointer[xs.Rebalance]
+		journal   ratomic.Pointer[xact.Journal] // acknowledged migrations (to resume after restart)
 		dm        *bundle.DataMover
 		pushes    *bundle.Streams // broadcast notifications
 		filterGFN *prob.Filter
@@ -122,7 +123,7 @@ func New(config *cmn.Config) *Reb {
 			filterGFN: prob.NewDefaultFilter(),
 			stages:    newNodeStages(),
 		}
-		cargs = cmn.TransportArgs{Timeout: config.Client.Timeout.D()}
+		cargs = cmn.TransportArgs{Timeout: config.Client.Timeout.D(), QoS: config.Net.QoS.Class(cmn.QoSRebalance)}
 	)
 	if config.Net.HTTP.UseHTTPS {
 		reb.ecClient = cmn.NewIntraClientTLS(cargs, config)
@@ -134,6 +135,7 @@ func New(config *cmn.Config) *Reb {
 		Config:      config,
 		Compression: config.Rebalance.Compression,
 		Multiplier:  config.Rebalance.SbundleMult,
+		QoS:         cmn.QoSRebalance,
 	}
 	dm, err := bundle.NewDataMover(trname, reb.recvObj, cmn.OwtRebalance, dmExtra)
 	if err != nil {
@@ -213,6 +215,7 @@ func (reb *Reb) RunRebalance(smap *meta.Smap, id int64, notif *xact.NotifXact, t
 		reb.semaCh.Release()
 		fs.RemoveMarker(fname.RebalanceMarker)
 		fs.RemoveMarker(fname.NodeRestartedPrev)
+		fs.RemoveMarker(fname.DataLossMarker)
 		reb.xctn().Finish()
 		return
 	}
@@ -470,7 +473,7 @@ func (reb *Reb) beginStreams(config *cmn.Config) {
 		Multiplier: config.Rebalance.SbundleMult,
 		Extra:      &transport.Extra{SenderID: xreb.ID(), Config: config},
 	}
-	reb.pushes = bundle.New(transport.NewIntraDataClient(), pushArgs)
+	reb.pushes = bundle.New(transport.NewIntraDataClientQoS(cmn.QoSControl), pushArgs) // (stage notifications)
 
 	reb.laterx.Store(false)
 	reb.inQueue.Store(0)
@@ -527,6 +530,8 @@ func (reb *Reb) runNoEC(rargs *rebArgs) error {
 		nlog.Errorln(logHdr, "rx-ready num-fail", errCnt) // unlikely
 	}
 
+	reb.journal.Store(xact.OpenJournal(apc.ActRebalance))
+
 	wg := &sync.WaitGroup{}
 	ver := rargs.smap.Version
 	for _, mi := range rargs.apaths {
@@ -712,15 +717,27 @@ func (reb *Reb) fini(rargs *rebArgs, logHdr string, err error) {
 		nlog.Infof("finishing rebalance (reb_args: %s)", reb.logHdr(rargs.id, rargs.smap))
 	}
 	// prior to closing the streams
-	if q := reb.quiesce(rargs, rargs.config.Transport.QuiesceTime.D(), reb.nodesQuiescent); q != core.QuiAborted {
+	q := reb.quiesce(rargs, rargs.config.Transport.QuiesceTime.D(), reb.nodesQuiescent)
+	if q != core.QuiAborted {
 		if errM := fs.RemoveMarker(fname.RebalanceMarker); errM == nil {
 			nlog.Infof("%s: %s removed marker ok", core.T, reb.xctn())
 		}
 		_ = fs.RemoveMarker(fname.NodeRestartedPrev)
+		if fs.MarkerExists(fname.DataLossMarker) {
+			if errM := fs.RemoveMarker(fname.DataLossMarker); errM == nil {
+				nlog.Infof("%s: %s restored lost data (removed marker)", core.T, reb.xctn())
+			}
+		}
 	}
 	reb.endStreams(err)
 	reb.filterGFN.Reset()
 	xreb := reb.xctn()
+	if j := reb.journal.Swap(nil); j != nil {
+		if n := j.Skipped(); n > 0 {
+			nlog.Infoln(logHdr, "skipped", n, "objects migrated prior to restart")
+		}
+		j.Close(q != core.QuiAborted && err == nil && !xreb.IsAborted())
+	}
 	xreb.ToStats(&stats)
 	if stats.Objs > 0 || stats.OutObjs > 0 || stats.InObjs > 0 {
 		s, e := jsoniter.MarshalIndent(&stats, "", " ")
@@ -797,6 +814,9 @@ func (rj *rebJogger) visitObj(fqn string, de fs.DirEntry) error {
 	if de.IsDir() {
 		return nil
 	}
+	if mi := rj.opts.Mi; mi.BeginIO(fs.IOBg) {
+		defer mi.EndIO(fs.IOBg)
+	}
 	lom := core.AllocLOM(fqn)
 	err := rj._lwalk(lom, fqn)
 	if err != nil {
@@ -840,11 +860,16 @@ func (rj *rebJogger) _lwalk(lom *core.LOM, fqn string) error {
 	if roc, err = _getReader(lom); err != nil {
 		return err
 	}
+	// skip objects migrated (and acknowledged) prior to node restart
+	if rj.m.journal.Load().Skip(lom, tsi.ID()) {
+		cos.Close(roc)
+		return cmn.ErrSkip
+	}
 
 	// transmit (unlock via transport completion => roc.Close)
 	rj.m.addLomAck(lom)
 	if err := rj.doSend(lom, tsi, roc); err != nil {
-		rj.m.delLomAck(lom, 0, false /*free LOM*/)
+		rj.m.delLomAck(lom, 0, "" /*not acknowledged*/)
 		return err
 	}
 
@@ -223,7 +223,7 @@ func (reb *Reb) recvRegularAck(hdr *transport.ObjHdr, unpacker *cos.ByteUnpack)
 	// No immediate file deletion: let LRU cleanup the "misplaced" object
 	// TODO: mark the object "Deleted"
 
-	reb.delLomAck(lom, ack.rebID, true /*free pending (orig) transmitted LOM*/)
+	reb.delLomAck(lom, ack.rebID, ack.daemonID)
 	core.FreeLOM(lom)
 	return nil
 }
@@ -294,11 +294,11 @@ func (reb *Reb) receiveCT(req *stageNtfn, hdr *transport.ObjHdr, reader io.Reade
 	// Save received CT to local drives
 	err = reb.saveCTToDisk(req, hdr, reader)
 	if err != nil {
-		if errRm := os.Remove(ct.FQN()); errRm != nil {
+		if errRm := ct.Mountpath().Remove(ct.FQN()); errRm != nil {
 			nlog.Errorf("Failed to remove %s: %v", ct.FQN(), errRm)
 		}
 		if moveTo != nil {
-			if errMv := os.Rename(workFQN, ct.FQN()); errMv != nil {
+			if errMv := ct.Mountpath().Rename(workFQN, ct.FQN()); errMv != nil {
 				nlog.Errorf("Error restoring slice: %v", errMv)
 			}
 		}
@@ -153,7 +153,8 @@ func (reb *Reb) addLomAck(lom *core.LOM) {
 	lomAck.mu.Unlock()
 }
 
-func (reb *Reb) delLomAck(lom *core.LOM, rebID int64, freeLOM bool) {
+// non-empty `acker`: acknowledged - free pending (orig) transmitted LOM
+func (reb *Reb) delLomAck(lom *core.LOM, rebID int64, acker string) {
 	if rebID != 0 && rebID != reb.rebID.Load() {
 		return
 	}
@@ -162,10 +163,11 @@ func (reb *Reb) delLomAck(lom *core.LOM, rebID int64, freeLOM bool) {
 	if rebID == 0 || rebID == reb.rebID.Load() {
 		if lomOrig, ok := lomAck.q[lom.Uname()]; ok {
 			delete(lomAck.q, lom.Uname())
-			if freeLOM {
+			if acker != "" {
 				// counting acknowledged migrations (as initiator)
 				xreb := reb.xctn()
 				xreb.ObjsAdd(1, lomOrig.Lsize())
+				reb.journal.Load().Add(lomOrig, acker)
 
 				core.FreeLOM(lomOrig)
 			}
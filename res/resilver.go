@@ -40,6 +40,7 @@ type (
 		UUID              string
 		Notif             *xact.NotifXact
 		Rmi               *fs.Mountpath
+		Ami               *fs.Mountpath // attached: move onto it (only) the objects that now belong there
 		Action            string
 		PostDD            func(rmi *fs.Mountpath, action string, xres *xs.Resilver, err error)
 		SkipGlobMisplaced bool
@@ -48,6 +49,7 @@ type (
 	joggerCtx struct {
 		xres   *xs.Resilver
 		config *cmn.Config
+		ami    *fs.Mountpath
 	}
 )
 
@@ -100,7 +102,7 @@ func (res *Res) RunResilver(args Args) {
 		jg        *mpather.Jgroup
 		slab, err = core.T.PageMM().GetSlab(memsys.MaxPageSlabSize)
 		config    = cmn.GCO.Get()
-		jctx      = &joggerCtx{xres: xres, config: config}
+		jctx      = &joggerCtx{xres: xres, config: config, ami: args.Ami}
 
 		opts = &mpather.JgroupOpts{
 			CTs:                   []string{fs.ObjectType, fs.ECSliceType},
@@ -118,9 +120,14 @@ func (res *Res) RunResilver(args Args) {
 		nlog.Infof("%s, action %q, jogger->(%q)", xres.Name(), args.Action, args.Rmi)
 	} else {
 		jg = mpather.NewJoggerGroup(opts, config, "")
-		if args.Rmi != nil {
+		switch {
+		case args.Rmi != nil:
 			nlog.Infof("%s, action %q, rmi %s, num %d", xres.Name(), args.Action, args.Rmi, jg.Num())
-		} else {
+		case args.Ami != nil:
+			nlog.Infof("%s, action %q, ami %s, num %d", xres.Name(), args.Action, args.Ami, jg.Num())
+		case args.Action != "":
+			nlog.Infof("%s, action %q, num %d", xres.Name(), args.Action, jg.Num())
+		default:
 			nlog.Infof("%s, num %d", xres.Name(), jg.Num())
 		}
 	}
@@ -197,7 +204,7 @@ func (jg *joggerCtx) _mvSlice(ct *core.CT, buf []byte) {
 		}
 	}
 	errMeta := os.Remove(srcMetaFQN)
-	errSlice := os.Remove(ct.FQN())
+	errSlice := ct.Mountpath().Remove(ct.FQN())
 	if errMeta != nil || errSlice != nil {
 		nlog.Warningf("Failed to cleanup %q: %v, %v", ct.FQN(), errSlice, errMeta)
 	}
@@ -236,6 +243,9 @@ func (jg *joggerCtx) visitObj(lom *core.LOM, buf []byte) (errHrw error) {
 		size   int64
 		copied bool
 	)
+	if jg.ami != nil && !jg.ontoAmi(lom) {
+		return nil
+	}
 	if !lom.TryLock(true) { // NOTE: skipping busy
 		time.Sleep(time.Second >> 1)
 		if !lom.TryLock(true) {
@@ -357,6 +367,15 @@ ret:
 	return nil
 }
 
+// attached mountpath: HRW places onto it a proportional share of objects while
+// the remaining objects stay where they are - nothing to do about the latter
+func (jg *joggerCtx) ontoAmi(lom *core.LOM) bool {
+	if lom.Mountpath().Path == jg.ami.Path {
+		return false
+	}
+	return *lom.HrwFQN == jg.ami.MakePathFQN(lom.Bucket(), fs.ObjectType, lom.ObjName)
+}
+
 func (*joggerCtx) fixHrw(lom *core.LOM, mi *fs.Mountpath, buf []byte) (hlom *core.LOM, err error) {
 	if err = lom.Copy(mi, buf); err != nil {
 		return
@@ -0,0 +1,60 @@
+// Package res_test - resilver unit tests.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package res_test
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/res"
+	"github.com/NVIDIA/aistore/tools"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact/xreg"
+	"github.com/NVIDIA/aistore/xact/xs"
+)
+
+func init() {
+	config := cmn.GCO.BeginUpdate()
+	config.ConfigDir = "/tmp/ais-tests"
+	cmn.GCO.CommitUpdate(config)
+
+	xreg.Init()
+	xs.Xreg(false)
+}
+
+// attached mountpath receives the objects that HRW now places onto it; all other objects stay in place
+func TestResilverAttach(t *testing.T) {
+	const numObjs = 100
+	out := tools.PrepareObjects(t, tools.ObjectsDesc{
+		CTs:           []tools.ContentTypeDesc{{Type: fs.ObjectType, ContentCnt: numObjs}},
+		MountpathsCnt: 2,
+		ObjectSize:    cos.KiB,
+	})
+	ami, err := fs.Add(t.TempDir(), "daeID")
+	tassert.CheckFatal(t, err)
+	cos.InitShortID(0)
+
+	res.New().RunResilver(res.Args{UUID: cos.GenUUID(), Action: apc.ActMountpathAttach, Ami: ami})
+
+	var moved int
+	for _, fqn := range out.FQNs[fs.ObjectType] {
+		lom := &core.LOM{}
+		tassert.CheckFatal(t, lom.InitFQN(fqn, nil))
+		hrwFQN := *lom.HrwFQN
+		onto := hrwFQN == ami.MakePathFQN(lom.Bucket(), fs.ObjectType, lom.ObjName)
+		if onto {
+			moved++
+			tassert.Errorf(t, cos.Stat(hrwFQN) == nil, "expected %s to be moved onto %s", lom, ami)
+		} else {
+			tassert.Errorf(t, fqn == hrwFQN, "expected %s to remain in place (%q vs %q)", lom, fqn, hrwFQN)
+			tassert.Errorf(t, cos.Stat(fqn) == nil, "expected %q to exist", fqn)
+		}
+	}
+	tassert.Errorf(t, moved > 0, "expected a share of %d objects to be moved onto %s", numObjs, ami)
+}
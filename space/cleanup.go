@@ -561,9 +561,9 @@ func (j *clnJ) rmLeftovers() (size int64, err error) {
 			)
 			lom := core.AllocLOM(mlom.ObjName) // yes placed
 			if lom.InitBck(&j.bck) != nil {
-				removed = os.Remove(fqn) == nil
+				removed = mlom.Mountpath().Remove(fqn) == nil
 			} else if lom.FromFS() != nil {
-				removed = os.Remove(fqn) == nil
+				removed = mlom.Mountpath().Remove(fqn) == nil
 			} else {
 				removed, _ = lom.DelExtraCopies(fqn)
 			}
@@ -588,7 +588,7 @@ func (j *clnJ) rmLeftovers() (size int64, err error) {
 		if cos.Stat(metaFQN) == nil {
 			continue
 		}
-		if os.Remove(ct.FQN()) == nil {
+		if ct.Mountpath().Remove(ct.FQN()) == nil {
 			fevicted++
 			bevicted += ct.Lsize()
 			if err = j.yieldTerm(); err != nil {
@@ -21,7 +21,7 @@ const (
 	KindCounter            = "counter"
 	KindTotal              = "total"
 	KindSize               = "size"
-	KindGauge              = "gauge"
+	KindGauge              = "gauge" // Add and AddMany set (rather than add) the value
 	KindSpecial            = "special"
 	KindComputedThroughput = "compbw" // disk read/write throughput
 	// compound (+ semantics)
@@ -40,7 +40,8 @@ type (
 		IncErr(metric string)
 
 		GetStats() *Node
-		GetStatsV322() *NodeV322 // [backward compatibility]
+		GetStatsFlt(flt *Filter) *Node // (see Filter below)
+		GetStatsV322() *NodeV322       // [backward compatibility]
 
 		ResetStats(errorsOnly bool)
 		GetMetricNames() cos.StrKVs // (name, kind) pairs
@@ -57,14 +58,27 @@ type (
 		Snode   *meta.Snode `json:"snode"`
 		Tracker copyTracker `json:"tracker"`
 		Tcdf    fs.Tcdf     `json:"capacity"`
+		Now     int64       `json:"now,string,omitempty"` // when filtered: node's wall-clock time, to use as the next `Filter.Since`
 	}
 	Cluster struct {
 		Proxy  *Node            `json:"proxy"`
 		Target map[string]*Node `json:"target"`
+		Next   string           `json:"next,omitempty"` // paginated: continuation token (apc.QparamPageToken)
 	}
 	ClusterRaw struct {
 		Proxy  *Node           `json:"proxy"`
 		Target cos.JSONRawMsgs `json:"target"`
+		Next   string          `json:"next,omitempty"`
+	}
+
+	// Filter reduces the size of the (periodically scraped) node stats:
+	// - Names: metric names; a name that ends with '*' is a prefix (e.g. "get.*", "disk.*")
+	// - Since: only metrics that changed after a given time (Unix nanoseconds);
+	//   changes are tracked at `periodic.stats_time` granularity
+	// See also: apc.QparamMetrics, apc.QparamSince
+	Filter struct {
+		Names []string
+		Since int64
 	}
 
 	// (includes stats.Node and more; NOTE: direct API call w/ no proxying)
@@ -87,6 +101,23 @@ type (
 	}
 )
 
+////////////
+// Filter //
+////////////
+
+func (flt *Filter) match(name string) bool {
+	for _, s := range flt.Names {
+		if l := len(s); l > 0 && s[l-1] == '*' {
+			if strings.HasPrefix(name, s[:l-1]) {
+				return true
+			}
+		} else if name == s {
+			return true
+		}
+	}
+	return false
+}
+
 // [backward compatibility]: includes v3.22 cdf* structures
 type (
 	NodeV322 struct {
@@ -136,6 +167,8 @@ func LatencyToCounter(latency string) string {
 		return ListCount
 	case AppendLatency:
 		return AppendCount
+	case PutFsyncLatency:
+		return PutFsyncCount
 	}
 	// 2. filter out
 	if !strings.Contains(latency, "get.") && !strings.Contains(latency, "put.") {
@@ -14,6 +14,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	ratomic "sync/atomic"
 	"time"
 
@@ -136,7 +137,13 @@ type (
 		prev      string      // prev ctracker.write
 		next      int64       // mono.Nano
 		mem       sys.MemStat
+		push      pusher // (see push.go)
 		startedUp atomic.Bool
+		chg       struct {
+			prev  copyTracker
+			mtime map[string]int64 // metric name => (wall-clock) time of the last observed change
+			mu    sync.Mutex
+		}
 	}
 )
 
@@ -385,6 +392,7 @@ waitStartup:
 	goMaxProcs := runtime.GOMAXPROCS(0)
 	nlog.Infof("Starting %s", r.Name())
 	hk.Reg(r.Name()+"-logs"+hk.NameSuffix, recycleLogs, maxLogSizeCheckTime)
+	r.push.init(r)
 
 	statsTime := config.Periodic.StatsTime.D() // (NOTE: not to confuse with config.Log.StatsTime)
 	r.ticker = time.NewTicker(statsTime)
@@ -404,6 +412,7 @@ waitStartup:
 			now := mono.NanoTime()
 			config = cmn.GCO.Get()
 			logger.log(now, time.Duration(now-startTime) /*uptime*/, config)
+			r.trackChanges()
 			checkNumGorHigh = _checkGor(now, checkNumGorHigh, goMaxProcs)
 
 			if statsTime != config.Periodic.StatsTime.D() {
@@ -461,6 +470,52 @@ func (r *runner) GetStats() *Node {
 	return &Node{Tracker: ctracker}
 }
 
+func (r *runner) GetStatsFlt(flt *Filter) *Node {
+	ds := r.GetStats()
+	if flt == nil {
+		return ds
+	}
+	if len(flt.Names) > 0 {
+		for name := range ds.Tracker {
+			if !flt.match(name) {
+				delete(ds.Tracker, name)
+			}
+		}
+	}
+	if flt.Since > 0 {
+		r.chg.mu.Lock()
+		for name := range ds.Tracker {
+			// (not tracked yet - include)
+			if mtime, ok := r.chg.mtime[name]; ok && mtime <= flt.Since {
+				delete(ds.Tracker, name)
+			}
+		}
+		r.chg.mu.Unlock()
+	}
+	ds.Now = time.Now().UnixNano()
+	return ds
+}
+
+// (see Filter.Since)
+func (r *runner) trackChanges() {
+	var (
+		now  = time.Now().UnixNano()
+		curr = make(copyTracker, max(len(r.chg.prev), 48))
+	)
+	r.core.copyCumulative(curr)
+	r.chg.mu.Lock()
+	if r.chg.mtime == nil {
+		r.chg.mtime = make(map[string]int64, len(curr))
+	}
+	for name, v := range curr {
+		if prev, ok := r.chg.prev[name]; !ok || prev.Value != v.Value {
+			r.chg.mtime[name] = now
+		}
+	}
+	r.chg.prev = curr
+	r.chg.mu.Unlock()
+}
+
 func (r *runner) GetStatsV322() (out *NodeV322) {
 	ds := r.GetStats()
 
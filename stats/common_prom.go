@@ -104,6 +104,8 @@ func (s *coreStats) update(nv cos.NamedVal64) {
 		ratomic.AddInt64(&v.cumulative, nv.Value)
 	case KindCounter, KindSize, KindTotal:
 		ratomic.AddInt64(&v.Value, nv.Value)
+	case KindGauge:
+		ratomic.StoreInt64(&v.Value, nv.Value) // (set)
 	default:
 		debug.Assert(false, v.kind)
 	}
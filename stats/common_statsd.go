@@ -144,6 +144,8 @@ func (s *coreStats) update(nv cos.NamedVal64) {
 			s.statsdC.Send(v.label.comm+"."+nv.NameSuffix,
 				1, metric{Type: statsd.Counter, Name: "count", Value: nv.Value})
 		}
+	case KindGauge:
+		ratomic.StoreInt64(&v.Value, nv.Value) // (set)
 	default:
 		debug.Assert(false, v.kind)
 	}
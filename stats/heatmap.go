@@ -0,0 +1,210 @@
+// Package stats provides methods and functionality to register, track, log,
+// and StatsD-notify statistics that, for the most part, include "counter" and "latency" kinds.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package stats
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/hk"
+)
+
+// Object access heatmap: per-bucket, per-prefix GET counters (target only).
+// - prefix is the object name up to and including the first '/' (or empty for
+//   objects at the bucket's root); the number of tracked prefixes is bounded
+//   on a per-bucket basis - the rest get accounted under the empty prefix
+// - the counters get rolled up hourly, to maintain the last (sliding) 24 hours
+// - prefixes that were not accessed for more than heatIdleGC get dropped
+// - in memory: the heatmap does not survive restarts
+// See also: apc.WhatHeatmap
+
+const (
+	heatMaxPrefixes = 4096
+	heatHours       = 24
+	heatRollupIval  = time.Hour
+	heatIdleGC      = 7 * 24 * time.Hour
+)
+
+type (
+	// REST API
+	HeatEntry struct {
+		Bck        cmn.Bck `json:"bck"`
+		Prefix     string  `json:"prefix"`
+		Hits       int64   `json:"hits"`        // total since (target) startup
+		LastHour   int64   `json:"last_hour"`   // since the most recent (hourly) rollup
+		LastDay    int64   `json:"last_day"`    // last 24 hours (current hour included)
+		LastAccess int64   `json:"last_access"` // unix time (nanoseconds)
+	}
+	Heatmap []*HeatEntry
+
+	heatCell struct {
+		cur   atomic.Int64 // not yet rolled up
+		last  atomic.Int64 // last access
+		total int64
+		hours [heatHours - 1]int64 // (closed) hourly slots - ring
+	}
+	bckHeat struct {
+		bck      cmn.Bck
+		prefixes map[string]*heatCell
+		mu       sync.RWMutex
+	}
+	heatmap struct {
+		bcks map[uint64]*bckHeat // by bucket ID (BID)
+		mu   sync.RWMutex
+		hour int // most recent slot in the heatCell.hours ring
+	}
+)
+
+var hmap heatmap
+
+func initHeatmap() {
+	hmap.bcks = make(map[uint64]*bckHeat, 16)
+	hk.Reg("heatmap"+hk.NameSuffix, hmap.rollup, heatRollupIval)
+}
+
+// GET(object) datapath
+func HeatAdd(bid uint64, bck *cmn.Bck, objName string, now int64) {
+	var prefix string
+	if i := strings.IndexByte(objName, '/'); i >= 0 {
+		prefix = objName[:i+1]
+	}
+	hmap.mu.RLock()
+	bh, ok := hmap.bcks[bid]
+	hmap.mu.RUnlock()
+	if !ok {
+		hmap.mu.Lock()
+		if bh, ok = hmap.bcks[bid]; !ok {
+			bh = &bckHeat{
+				bck:      cmn.Bck{Name: bck.Name, Provider: bck.Provider, Ns: bck.Ns},
+				prefixes: make(map[string]*heatCell, 16),
+			}
+			hmap.bcks[bid] = bh
+		}
+		hmap.mu.Unlock()
+	}
+	bh.add(prefix, now)
+}
+
+func (bh *bckHeat) add(prefix string, now int64) {
+	bh.mu.RLock()
+	cell, ok := bh.prefixes[prefix]
+	bh.mu.RUnlock()
+	if !ok {
+		bh.mu.Lock()
+		if cell, ok = bh.prefixes[prefix]; !ok {
+			if len(bh.prefixes) >= heatMaxPrefixes {
+				prefix = "" // (see above)
+				cell = bh.prefixes[prefix]
+			}
+			if cell == nil {
+				cell = &heatCell{}
+				bh.prefixes[strings.Clone(prefix)] = cell
+			}
+		}
+		bh.mu.Unlock()
+	}
+	cell.cur.Inc()
+	cell.last.Store(now)
+}
+
+func (hm *heatmap) rollup() time.Duration {
+	now := time.Now().UnixNano()
+	hm.mu.Lock()
+	hm.hour = (hm.hour + 1) % (heatHours - 1)
+	hour := hm.hour
+	for bid, bh := range hm.bcks {
+		bh.mu.Lock()
+		for prefix, cell := range bh.prefixes {
+			n := cell.cur.Swap(0)
+			cell.total += n
+			cell.hours[hour] = n
+			if n == 0 && time.Duration(now-cell.last.Load()) > heatIdleGC {
+				delete(bh.prefixes, prefix)
+			}
+		}
+		if len(bh.prefixes) == 0 {
+			delete(hm.bcks, bid)
+		}
+		bh.mu.Unlock()
+	}
+	hm.mu.Unlock()
+	return heatRollupIval
+}
+
+// (empty bucket: all buckets)
+func GetHeatmap(bck *cmn.Bck) (out Heatmap) {
+	hmap.mu.RLock()
+	for _, bh := range hmap.bcks {
+		if !bck.IsEmpty() && !_sameBck(bck, &bh.bck) {
+			continue
+		}
+		bh.mu.RLock()
+		for prefix, cell := range bh.prefixes {
+			e := &HeatEntry{Bck: bh.bck, Prefix: prefix, LastAccess: cell.last.Load()}
+			e.LastHour = cell.cur.Load()
+			e.Hits = cell.total + e.LastHour
+			e.LastDay = e.LastHour
+			for _, n := range cell.hours {
+				e.LastDay += n
+			}
+			out = append(out, e)
+		}
+		bh.mu.RUnlock()
+	}
+	hmap.mu.RUnlock()
+	out.Sort()
+	return out
+}
+
+func _sameBck(bck, other *cmn.Bck) bool {
+	return (bck.Name == "" || bck.Name == other.Name) && bck.Ns == other.Ns &&
+		(bck.Provider == "" || bck.Provider == other.Provider)
+}
+
+/////////////
+// Heatmap //
+/////////////
+
+// coldest first: by last 24 hours, then total hits, then last access
+func (hm Heatmap) Sort() {
+	sort.Slice(hm, func(i, j int) bool {
+		a, b := hm[i], hm[j]
+		switch {
+		case a.LastDay != b.LastDay:
+			return a.LastDay < b.LastDay
+		case a.Hits != b.Hits:
+			return a.Hits < b.Hits
+		default:
+			return a.LastAccess < b.LastAccess
+		}
+	})
+}
+
+// merge per-target heatmaps into a single cluster-wide one
+func (hm Heatmap) Merge(other Heatmap) Heatmap {
+	idx := make(map[string]*HeatEntry, len(hm))
+	for _, e := range hm {
+		idx[e.Bck.Cname(e.Prefix)] = e
+	}
+	for _, e := range other {
+		key := e.Bck.Cname(e.Prefix)
+		if x, ok := idx[key]; ok {
+			x.Hits += e.Hits
+			x.LastHour += e.LastHour
+			x.LastDay += e.LastDay
+			x.LastAccess = max(x.LastAccess, e.LastAccess)
+			continue
+		}
+		c := *e
+		idx[key] = &c
+		hm = append(hm, &c)
+	}
+	return hm
+}
@@ -14,11 +14,26 @@ import (
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
 )
 
-const numProxyStats = 24 // approx. initial
+const numProxyStats = 32 // approx. initial
 
-// NOTE: currently, proxy's stats == common and hardcoded
+// proxy-only metrics: object data streamed through the proxy (see cmn.ProxyConf.StreamData)
+const (
+	StreamCount    = "stream.n"
+	ErrStreamCount = errPrefix + StreamCount
+
+	// KindThroughput
+	StreamRecvThroughput = "stream.recv.bps" // from clients (e.g., PUT)
+	StreamSentThroughput = "stream.sent.bps" // to clients (e.g., GET)
+
+	// same as above via `.cumulative`
+	StreamRecvSize = "stream.recv.size"
+	StreamSentSize = "stream.sent.size"
+)
+
+// NOTE: currently, proxy's stats == common (and hardcoded) + streaming
 
 type Prunner struct {
 	runner
@@ -43,6 +58,7 @@ func (r *Prunner) Init(p core.Node) *atomic.Bool {
 	r.core.init(numProxyStats)
 
 	r.regCommon(p.Snode()) // common metrics
+	r.regStream(p.Snode())
 
 	r.core.statsTime = cmn.GCO.Get().Periodic.StatsTime.D()
 	r.ctracker = make(copyTracker, numProxyStats)
@@ -56,6 +72,39 @@ func (r *Prunner) Init(p core.Node) *atomic.Bool {
 	return &r.runner.startedUp
 }
 
+func (r *Prunner) regStream(snode *meta.Snode) {
+	r.reg(snode, StreamCount, KindCounter,
+		&Extra{
+			Help: "total number of object requests streamed through this proxy (in lieu of redirecting to targets)",
+		},
+	)
+	r.reg(snode, ErrStreamCount, KindCounter,
+		&Extra{
+			Help: "total number of failures to stream object requests through this proxy",
+		},
+	)
+	r.reg(snode, StreamRecvThroughput, KindThroughput,
+		&Extra{
+			Help: "streaming: average throughput (MB/s) from clients over the last periodic.stats_time interval",
+		},
+	)
+	r.reg(snode, StreamSentThroughput, KindThroughput,
+		&Extra{
+			Help: "streaming: average throughput (MB/s) to clients over the last periodic.stats_time interval",
+		},
+	)
+	r.reg(snode, StreamRecvSize, KindSize,
+		&Extra{
+			Help: "streaming: total cumulative size (bytes) received from clients",
+		},
+	)
+	r.reg(snode, StreamSentSize, KindSize,
+		&Extra{
+			Help: "streaming: total cumulative size (bytes) sent to clients",
+		},
+	)
+}
+
 //
 // statsLogger interface impl
 //
@@ -0,0 +1,200 @@
+// Package stats provides methods and functionality to register, track, log,
+// and StatsD-notify statistics that, for the most part, include "counter" and "latency" kinds.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package stats
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/hk"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Push-based metric streaming (cmn.StatsPushConf) - for environments where scraping
+// each and every node is impractical:
+// - every stats_push.interval, the node takes a snapshot of all its metrics and appends it
+//   to each collector's queue
+// - each collector has its own queue: (up to) stats_push.batch_size snapshots per POST, in order
+// - a collector that fails (network error or non-2xx status) keeps accumulating snapshots,
+//   up to stats_push.buffer_size; beyond that, the oldest get dropped
+// - the next interval resumes pushing from the oldest buffered snapshot
+// - pushing runs in its own goroutine and never blocks housekeeping; at most one push round
+//   is in progress at any given time
+// - collectors removed from the configuration get dropped along with their queues
+// - request body: PushMsg (JSON), see below
+
+const (
+	pushIdleIval = time.Minute // (disabled)
+	pushMaxResp  = 4 * cos.KiB // (drained)
+)
+
+type (
+	// REST API: body of the POST request (to collector)
+	PushMsg struct {
+		Node    string        `json:"node"` // node ID
+		Role    string        `json:"role"` // apc.Proxy | apc.Target
+		Samples []*PushSample `json:"samples"`
+	}
+	PushSample struct {
+		Tracker copyTracker `json:"tracker"`
+		Time    int64       `json:"time,string"` // unix nanoseconds
+	}
+
+	pushQueue struct {
+		samples []*PushSample
+		dropped int64 // since the last successful push
+		failed  bool
+	}
+	pusher struct {
+		r       *runner
+		client  *http.Client
+		timeout time.Duration
+		queues  map[string]*pushQueue // by collector URL
+		busy    atomic.Bool
+		mu      sync.Mutex
+	}
+)
+
+func (ps *pusher) init(r *runner) {
+	ps.r = r
+	ps.queues = make(map[string]*pushQueue, 2)
+	hk.Reg(r.Name()+"-push"+hk.NameSuffix, ps.housekeep, pushIdleIval)
+}
+
+func (ps *pusher) housekeep() time.Duration {
+	conf := &cmn.GCO.Get().StatsPush
+	if !conf.Enabled {
+		ps.mu.Lock()
+		clear(ps.queues)
+		ps.mu.Unlock()
+		return pushIdleIval
+	}
+	sample := &PushSample{Tracker: make(copyTracker, 48), Time: time.Now().UnixNano()}
+	ps.r.core.copyCumulative(sample.Tracker)
+
+	urls := conf.CollectorURLs()
+	ps.mu.Lock()
+	for u := range ps.queues {
+		if !cos.StringInSlice(u, urls) {
+			delete(ps.queues, u)
+		}
+	}
+	for _, u := range urls {
+		q, ok := ps.queues[u]
+		if !ok {
+			q = &pushQueue{samples: make([]*PushSample, 0, conf.BatchSize)}
+			ps.queues[u] = q
+		}
+		q.samples = append(q.samples, sample)
+		if n := len(q.samples) - conf.BufferSize; n > 0 {
+			q.samples = q.samples[n:]
+			q.dropped += int64(n)
+		}
+	}
+	ps.mu.Unlock()
+
+	if ps.busy.CAS(false, true) {
+		go ps.push(conf, &cmn.GCO.Get().Net.HTTP)
+	}
+	return conf.Interval.D()
+}
+
+func (ps *pusher) push(conf *cmn.StatsPushConf, httpConf *cmn.HTTPConf) {
+	if ps.client == nil || ps.timeout != conf.Timeout.D() {
+		// https collectors: same CA and verification as intra-cluster
+		ps.timeout = conf.Timeout.D()
+		sargs := cmn.TLSArgs{ClientCA: httpConf.ClientCA, SkipVerify: httpConf.SkipVerifyCrt}
+		ps.client = cmn.NewClientTLS(cmn.TransportArgs{Timeout: ps.timeout}, sargs)
+	}
+	ps.mu.Lock()
+	urls := make([]string, 0, len(ps.queues))
+	for u := range ps.queues {
+		urls = append(urls, u)
+	}
+	ps.mu.Unlock()
+
+	for _, u := range urls {
+		ps.drain(u, conf.BatchSize)
+	}
+	ps.busy.Store(false)
+}
+
+// push (in batches) until the queue is empty or the collector fails
+func (ps *pusher) drain(u string, batchSize int) {
+	snode := ps.r.node.Snode()
+	for {
+		ps.mu.Lock()
+		q, ok := ps.queues[u]
+		if !ok || len(q.samples) == 0 {
+			ps.mu.Unlock()
+			return
+		}
+		batch := q.samples[:min(batchSize, len(q.samples))]
+		ps.mu.Unlock()
+
+		msg := PushMsg{Node: snode.ID(), Role: snode.Type(), Samples: batch}
+		err := ps.post(u, &msg)
+
+		ps.mu.Lock()
+		if q != ps.queues[u] {
+			ps.mu.Unlock()
+			return // removed in the meantime
+		}
+		if err != nil {
+			if !q.failed {
+				nlog.Warningln(ps.r.Name()+": failed to push metrics to", u+":", err, "- buffering")
+				q.failed = true
+			}
+			ps.mu.Unlock()
+			return
+		}
+		// (the queue may have been trimmed in the meantime - see housekeep)
+		last := batch[len(batch)-1]
+		for i, sample := range q.samples {
+			if sample == last {
+				q.samples = q.samples[i+1:]
+				break
+			}
+		}
+		if q.failed {
+			nlog.Infoln(ps.r.Name()+": resumed pushing metrics to", u, "(dropped samples:", q.dropped, ")")
+			q.failed, q.dropped = false, 0
+		}
+		ps.mu.Unlock()
+	}
+}
+
+func (ps *pusher) post(u string, msg *PushMsg) error {
+	b, err := jsoniter.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, u, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(cos.HdrContentType, cos.ContentJSON)
+	req.Header.Set(apc.HdrNodeID, msg.Node)
+	resp, err := ps.client.Do(req) //nolint:bodyclose // closed below
+	if err != nil {
+		return err
+	}
+	io.Copy(io.Discard, io.LimitReader(resp.Body, pushMaxResp)) //nolint:errcheck // (best effort)
+	resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("status %d", resp.StatusCode)
+	}
+	return nil
+}
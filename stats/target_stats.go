@@ -46,6 +46,31 @@ const (
 	VerChangeCount = "ver.change.n"
 	VerChangeSize  = "ver.change.size"
 
+	// readahead (see cmn.ReadaheadConf)
+	ReadaheadCount     = "readahead.n"
+	ReadaheadSize      = "readahead.size"
+	ReadaheadHitCount  = "readahead.hit.n"
+	ReadaheadMissCount = "readahead.miss.n"
+
+	// memory-mapped read path (see cmn.MmapConf)
+	MmapHitCount  = "get.mmap.hit.n"
+	MmapMissCount = "get.mmap.miss.n"
+
+	// GET archived files via TAR index (see cmn.ArchIndexConf)
+	ArchIdxHitCount  = "get.archidx.hit.n"
+	ArchIdxMissCount = "get.archidx.miss.n"
+
+	// fsync upon finalizing new objects (see apc.FsyncPolicy)
+	PutFsyncCount = "put.fsync.n"
+
+	// APPEND flow control (see cmn.AppendConf)
+	AppendThrottleCount = "append.throttle.n"
+	AppendGCCount       = "append.gc.n"
+
+	// cold GET admission (see cmn.AdmissionConf)
+	GetBypassCount = "get.bypass.n"
+	GetBypassSize  = "get.bypass.size"
+
 	// errors
 	ErrCksumCount = errPrefix + "cksum.n"
 	ErrCksumSize  = errPrefix + "cksum.size"
@@ -64,6 +89,7 @@ const (
 	AppendLatency      = "append.ns"
 	GetRedirLatency    = "get.redir.ns"
 	PutRedirLatency    = "put.redir.ns"
+	PutFsyncLatency    = "put.fsync.ns"
 	DownloadLatency    = "dl.ns"
 
 	// Dsort
@@ -75,11 +101,13 @@ const (
 	DsortExtractShardSize    = "dsort.extract.shard.size" // uncompressed
 
 	// Downloader
-	DownloadSize = "dl.size"
+	DownloadSize  = "dl.size"
+	DownloadCount = "dl.n"
 
 	// KindThroughput
-	GetThroughput = "get.bps" // bytes per second
-	PutThroughput = "put.bps" // ditto
+	GetThroughput      = "get.bps" // bytes per second
+	PutThroughput      = "put.bps" // ditto
+	DownloadThroughput = "dl.bps"  // ditto
 
 	// same as above via `.cumulative`
 	GetSize = "get.size"
@@ -170,6 +198,8 @@ func (r *Trunner) Init() *atomic.Bool {
 	r.xallRun.Running = make([]string, 16)
 	r.xallRun.Idle = make([]string, 16)
 
+	initHeatmap()
+
 	return &r.runner.startedUp
 }
 
@@ -269,6 +299,71 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 			Help: "total cumulative size (bytes) of objects that were updated out-of-band across all backends combined",
 		},
 	)
+	r.reg(snode, ReadaheadCount, KindCounter,
+		&Extra{
+			Help: "readahead: number of objects prefetched ahead of (predicted) sequential GETs",
+		},
+	)
+	r.reg(snode, ReadaheadSize, KindSize,
+		&Extra{
+			Help: "readahead: total cumulative size (bytes) of prefetched objects",
+		},
+	)
+	r.reg(snode, ReadaheadHitCount, KindCounter,
+		&Extra{
+			Help: "readahead: number of prefetched objects subsequently read (GET) within readahead.max_age",
+		},
+	)
+	r.reg(snode, ReadaheadMissCount, KindCounter,
+		&Extra{
+			Help: "readahead: number of prefetched objects that were not read within readahead.max_age",
+		},
+	)
+	r.reg(snode, MmapHitCount, KindCounter,
+		&Extra{
+			Help: "mmap: number of GETs served from memory-mapped (hot small) objects",
+		},
+	)
+	r.reg(snode, MmapMissCount, KindCounter,
+		&Extra{
+			Help: "mmap: number of GETs of small objects (within mmap.max_obj_size) that were not memory-mapped",
+		},
+	)
+	r.reg(snode, ArchIdxHitCount, KindCounter,
+		&Extra{
+			Help: "arch-index: number of GETs of archived files read directly at their (indexed) offsets",
+		},
+	)
+	r.reg(snode, ArchIdxMissCount, KindCounter,
+		&Extra{
+			Help: "arch-index: number of GETs of archived files that required scanning TAR shard",
+		},
+	)
+	r.reg(snode, PutFsyncCount, KindCounter,
+		&Extra{
+			Help: "number of new objects fsync-ed as per bucket's write_policy.fsync",
+		},
+	)
+	r.reg(snode, AppendThrottleCount, KindCounter,
+		&Extra{
+			Help: "APPEND(object): number of requests rejected upon exceeding append.max_inflight (429 Too Many Requests)",
+		},
+	)
+	r.reg(snode, AppendGCCount, KindCounter,
+		&Extra{
+			Help: "APPEND(object): number of abandoned sessions (not flushed within append.flush_deadline) that were garbage-collected",
+		},
+	)
+	r.reg(snode, GetBypassCount, KindCounter,
+		&Extra{
+			Help: "cold GET: number of remote objects served without being stored in the cluster (as per bucket's admission policy)",
+		},
+	)
+	r.reg(snode, GetBypassSize, KindSize,
+		&Extra{
+			Help: "cold GET: total size (bytes) of remote objects served without being stored in the cluster",
+		},
+	)
 	r.reg(snode, RemoteDeletedDelCount, KindCounter,
 		&Extra{
 			Help: "number of out-of-band deletes (by a 3rd party remote DELETE(object) from outside this cluster)",
@@ -285,6 +380,11 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 			Help: "PUT: total cumulative time (nanoseconds)",
 		},
 	)
+	r.reg(snode, PutFsyncLatency, KindLatency,
+		&Extra{
+			Help: "PUT: average fsync time (milliseconds) over the last periodic.stats_time interval (see write_policy.fsync)",
+		},
+	)
 	r.reg(snode, AppendLatency, KindLatency,
 		&Extra{
 			Help: "APPEND(object): average time (milliseconds) over the last periodic.stats_time interval",
@@ -390,6 +490,16 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 			Help: "total time it took to execute dowload requests (milliseconds)",
 		},
 	)
+	r.reg(snode, DownloadCount, KindCounter,
+		&Extra{
+			Help: "total number of downloaded objects",
+		},
+	)
+	r.reg(snode, DownloadThroughput, KindThroughput,
+		&Extra{
+			Help: "download: average throughput (MB/s) over the last periodic.stats_time interval",
+		},
+	)
 
 	// dsort
 	r.reg(snode, DsortCreationReqCount, KindCounter,
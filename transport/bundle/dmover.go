@@ -58,6 +58,7 @@ type (
 		Multiplier  int
 		SizePDU     int32
 		MaxHdrSize  int32
+		QoS         int // network QoS class of the data streams (see cmn.QoSConf); acks are always cmn.QoSControl
 	}
 )
 
@@ -86,7 +87,7 @@ func NewDataMover(trname string, recvCB transport.RecvObj, owt cmn.OWT, extra Ex
 	if dm.data.net == "" {
 		dm.data.net = cmn.NetIntraData
 	}
-	dm.data.client = transport.NewIntraDataClient()
+	dm.data.client = transport.NewIntraDataClientQoS(extra.QoS)
 	// ack
 	if dm.ack.net == "" {
 		dm.ack.net = cmn.NetIntraControl
@@ -96,7 +97,7 @@ func NewDataMover(trname string, recvCB transport.RecvObj, owt cmn.OWT, extra Ex
 		return dm, nil
 	}
 	dm.ack.trname = "ack." + trname
-	dm.ack.client = transport.NewIntraDataClient()
+	dm.ack.client = transport.NewIntraDataClientQoS(cmn.QoSControl)
 	return dm, nil
 }
 
@@ -12,6 +12,7 @@ import (
 	"net"
 	"net/http"
 	"strconv"
+	"syscall"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -30,12 +31,27 @@ type Client interface {
 func whichClient() string { return "fasthttp" }
 
 // overriding fasthttp default `const DefaultDialTimeout = 3 * time.Second`
+const dialTout = 10 * time.Second
+
 func dialTimeout(addr string) (net.Conn, error) {
-	return fasthttp.DialTimeout(addr, 10*time.Second)
+	return fasthttp.DialTimeout(addr, dialTout)
+}
+
+// same as above, with DSCP marking and socket priority
+func qosDial(qos *cmn.QoSClass) fasthttp.DialFunc {
+	args := cmn.TransportArgs{QoS: qos}
+	dialer := &net.Dialer{
+		Timeout: dialTout,
+		Control: func(_, _ string, c syscall.RawConn) error { return c.Control(args.ConnControl(c)) },
+	}
+	return func(addr string) (net.Conn, error) { return dialer.Dial("tcp", addr) }
 }
 
 // intra-cluster networking: fasthttp client
-func NewIntraDataClient() Client {
+func NewIntraDataClient() Client { return NewIntraDataClientQoS(cmn.QoSData) }
+
+// same as above, for a given network QoS class (see cmn.QoSConf)
+func NewIntraDataClientQoS(class int) Client {
 	config := cmn.GCO.Get()
 
 	// compare with ais/httpcommon.go
@@ -51,6 +67,9 @@ func NewIntraDataClient() Client {
 		ReadBufferSize:  rbuf,
 		WriteBufferSize: wbuf,
 	}
+	if qos := config.Net.QoS.Class(class); qos != nil {
+		cl.Dial = qosDial(qos)
+	}
 	if config.Net.HTTP.UseHTTPS {
 		tlsConfig, err := cmn.NewTLS(config.Net.HTTP.ToTLS())
 		if err != nil {
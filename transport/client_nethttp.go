@@ -27,7 +27,10 @@ type Client interface {
 func whichClient() string { return "net/http" }
 
 // intra-cluster networking: net/http client
-func NewIntraDataClient() (client *http.Client) {
+func NewIntraDataClient() *http.Client { return NewIntraDataClientQoS(cmn.QoSData) }
+
+// same as above, for a given network QoS class (see cmn.QoSConf)
+func NewIntraDataClientQoS(class int) (client *http.Client) {
 	config := cmn.GCO.Get()
 
 	// compare with ais/hcommon.go
@@ -46,6 +49,7 @@ func NewIntraDataClient() (client *http.Client) {
 		SndRcvBufSize:   tcpbuf,
 		WriteBufferSize: wbuf,
 		ReadBufferSize:  rbuf,
+		QoS:             config.Net.QoS.Class(class),
 	}
 	if config.Net.HTTP.UseHTTPS {
 		client = cmn.NewClientTLS(cargs, config.Net.HTTP.ToTLS())
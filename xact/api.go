@@ -77,6 +77,13 @@ type (
 
 	// primarily: `api.QueryXactionSnaps`
 	MultiSnap map[string][]*core.Snap // by target ID (tid)
+
+	// `api.QueryXactionSnapsStream`: one (JSON) line per target
+	TargetSnaps struct {
+		TID   string       `json:"tid"`
+		Snaps []*core.Snap `json:"snaps,omitempty"`
+		Err   string       `json:"error,omitempty"` // target failed to respond
+	}
 )
 
 type (
@@ -219,6 +226,16 @@ var Table = map[string]Descriptor{
 		RefreshCap:     true,
 		ConflictRebRes: true,
 	},
+	apc.ActECReslice: {
+		DisplayName:    "ec-reslice",
+		Scope:          ScopeB,
+		Access:         apc.AccessRW,
+		Startable:      false, // (is started upon changing bucket's EC data/parity)
+		Metasync:       true,
+		RefreshCap:     true,
+		ConflictRebRes: true,
+		ExtendedStats:  true,
+	},
 	apc.ActMakeNCopies: {
 		DisplayName: "mirror",
 		Scope:       ScopeB,
@@ -259,7 +276,12 @@ var Table = map[string]Descriptor{
 
 	// cache management, internal usage
 	apc.ActLoadLomCache:   {DisplayName: "warm-up-metadata", Scope: ScopeB, Startable: true},
+	apc.ActValidateLomMD:  {DisplayName: "validate-metadata", Scope: ScopeT, Startable: true},
+	apc.ActExpireObjs:     {DisplayName: "ttl-cleanup", Scope: ScopeT, Startable: true},
+	apc.ActCompactBck:     {DisplayName: "compact", Scope: ScopeB, Startable: true, RefreshCap: true},
+	apc.ActPinBck:         {DisplayName: "pin", Scope: ScopeB, Startable: true, RefreshCap: true},
 	apc.ActInvalListCache: {Scope: ScopeB, Access: apc.AceObjLIST, Startable: false},
+	apc.ActSampleBck:      {DisplayName: "sample", Scope: ScopeB, Access: apc.AceGET, Startable: false},
 }
 
 func IsValidKind(kind string) bool {
@@ -52,6 +52,7 @@ type (
 		Xact        core.Xact
 		Interrupted bool // (rebalance | resilver) interrupted
 		Restarted   bool // node restarted
+		DataLoss    bool // node rejoined with the same ID and empty mountpaths (see fname.DataLossMarker)
 	}
 )
 
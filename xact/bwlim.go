@@ -0,0 +1,41 @@
+// Package xact provides core functionality for the AIStore eXtended Actions (xactions).
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xact
+
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/mono"
+)
+
+// bandwidth limiter (e.g., apc.CopyBckMsg.MaxThroughput): paces the callers (e.g., mountpath joggers)
+// so that the total number of bytes processed since the start does not exceed rate * elapsed
+
+const bwlimMaxSleep = time.Second // at a time (in re: abort)
+
+type Bwlim struct {
+	started int64 // mono
+	rate    int64 // bytes per second
+	total   atomic.Int64
+}
+
+func (b *Bwlim) Init(rate int64) {
+	b.rate = rate
+	b.started = mono.NanoTime()
+}
+
+func (b *Bwlim) Enabled() bool { return b.rate > 0 }
+
+func (b *Bwlim) Pace(size int64) {
+	if size <= 0 {
+		return
+	}
+	total := b.total.Add(size)
+	expected := time.Duration(float64(total) / float64(b.rate) * float64(time.Second))
+	if d := expected - mono.Since(b.started); d > 0 {
+		time.Sleep(min(d, bwlimMaxSleep))
+	}
+}
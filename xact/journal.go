@@ -0,0 +1,197 @@
+// Package xact provides core functionality for the AIStore eXtended Actions (xactions).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xact
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/OneOfOne/xxhash"
+)
+
+// Intent journal - to resume (rather than restart from scratch) a job interrupted by node crash:
+// - a resumable xaction (rebalance, ec-encode, copy-bucket) records the objects it has handled -
+//   one line per object on the object's mountpath: uname, destination, size, and checksum
+// - the journal is removed once the xaction finishes successfully; otherwise (crash, abort)
+//   it remains, and the next run of the same job loads it to skip the objects that were already
+//   handled (and have not changed since)
+// - the same job means the same journal name, e.g. copy-bucket from A to B (see JournalName)
+// - writes are buffered and flushed every `jflushCnt` entries (no fsync); a crash may lose
+//   the tail - which only means redoing the corresponding work
+// - journals older than `jmaxAge` are considered stale and get removed
+
+const (
+	jflushCnt = 256
+	jmaxAge   = 24 * time.Hour
+)
+
+type (
+	Journal struct {
+		done    map[string]string // loaded: uname => tag
+		files   map[string]*jfile // by mountpath
+		name    string
+		skipped atomic.Int64
+	}
+	jfile struct {
+		fh    *os.File
+		w     *bufio.Writer
+		fpath string
+		n     int
+		mu    sync.Mutex
+	}
+)
+
+// e.g. JournalName(apc.ActCopyBck, from.Cname(""), to.Cname(""))
+func JournalName(kind string, keys ...string) string {
+	digest := xxhash.Checksum64S(cos.UnsafeB(strings.Join(keys, "|")), cos.MLCG32)
+	return kind + "-" + strconv.FormatUint(digest, 36)
+}
+
+// load the previous (interrupted) journal, if any
+func OpenJournal(name string) *Journal {
+	var (
+		avail = fs.GetAvail()
+		j     = &Journal{name: name, files: make(map[string]*jfile, len(avail))}
+	)
+	for _, mi := range avail {
+		fpath := filepath.Join(mi.Path, fname.JournalDir, name)
+		j.files[mi.Path] = &jfile{fpath: fpath}
+		j.load(fpath)
+	}
+	if len(j.done) > 0 {
+		nlog.Infoln(core.T.String()+": resuming", name, "- loaded", len(j.done), "journaled entries")
+	}
+	return j
+}
+
+func (j *Journal) load(fpath string) {
+	finfo, err := os.Stat(fpath)
+	if err != nil {
+		return
+	}
+	if time.Since(finfo.ModTime()) > jmaxAge {
+		nlog.Infoln("removing stale journal", fpath)
+		_ = cos.RemoveFile(fpath)
+		return
+	}
+	fh, err := os.Open(fpath)
+	if err != nil {
+		nlog.Errorln("failed to open journal:", err)
+		return
+	}
+	if j.done == nil {
+		j.done = make(map[string]string, 1024)
+	}
+	scanner := bufio.NewScanner(fh)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.LastIndexByte(line, '\t'); i > 0 {
+			j.done[line[:i]] = line[i+1:]
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		nlog.Warningln("journal", fpath, "is truncated:", err)
+	}
+	cos.Close(fh)
+}
+
+// (the object must be loaded)
+func _jtag(lom *core.LOM, dst string) string {
+	return dst + " " + strconv.FormatInt(lom.Lsize(), 10) + " " + lom.Checksum().Value()
+}
+
+// whether the object was handled by the interrupted run (and has not changed since)
+func (j *Journal) Skip(lom *core.LOM, dst string) bool {
+	if j == nil || j.done == nil {
+		return false
+	}
+	tag, ok := j.done[lom.Uname()]
+	if !ok || tag != _jtag(lom, dst) {
+		return false
+	}
+	j.skipped.Inc()
+	return true
+}
+
+func (j *Journal) Skipped() int64 { return j.skipped.Load() }
+
+// record the (loaded) object as handled
+func (j *Journal) Add(lom *core.LOM, dst string) {
+	if j == nil {
+		return
+	}
+	jf, ok := j.files[lom.Mountpath().Path]
+	if !ok {
+		return // (mountpath added in the meantime)
+	}
+	jf.mu.Lock()
+	if err := jf.write(lom.Uname() + "\t" + _jtag(lom, dst) + "\n"); err != nil {
+		nlog.Errorln("failed to journal", lom.Cname(), "["+j.name+"]:", err)
+	}
+	jf.mu.Unlock()
+}
+
+// ok: finished successfully, remove the journal
+func (j *Journal) Close(ok bool) {
+	if j == nil {
+		return
+	}
+	for _, jf := range j.files {
+		jf.mu.Lock()
+		jf.close()
+		if ok {
+			if err := cos.RemoveFile(jf.fpath); err != nil {
+				nlog.Errorln("failed to remove journal:", err)
+			}
+		}
+		jf.mu.Unlock()
+	}
+	j.done = nil
+}
+
+///////////
+// jfile //
+///////////
+
+func (jf *jfile) write(line string) (err error) {
+	if jf.fh == nil {
+		if err = cos.CreateDir(filepath.Dir(jf.fpath)); err != nil {
+			return err
+		}
+		if jf.fh, err = os.OpenFile(jf.fpath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, cos.PermRWR); err != nil {
+			return err
+		}
+		jf.w = bufio.NewWriter(jf.fh)
+	}
+	if _, err = jf.w.WriteString(line); err != nil {
+		return err
+	}
+	if jf.n++; jf.n%jflushCnt == 0 {
+		err = jf.w.Flush()
+	}
+	return err
+}
+
+func (jf *jfile) close() {
+	if jf.fh == nil {
+		return
+	}
+	if err := jf.w.Flush(); err != nil {
+		nlog.Errorln("failed to flush journal:", err)
+	}
+	cos.Close(jf.fh)
+	jf.fh, jf.w = nil, nil
+}
@@ -6,6 +6,7 @@ package xreg
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -41,9 +42,17 @@ type (
 		RebID   string
 		Phase   string
 	}
+	SampleArgs struct {
+		DP            core.DP // ETL (optional)
+		Msg           *apc.SampleMsg
+		CommitTimeout time.Duration // (zero: default)
+	}
 	MNCArgs struct {
-		Tag    string
-		Copies int
+		Tag           string
+		Prefix        string // (see apc.MNCMsg)
+		Copies        int
+		MaxThroughput int64 // bytes per second (0 - unlimited)
+		Reconfig      bool  // bucket's mirroring reconfigured (set-props): supersedes the one in progress
 	}
 	LsoArgs struct {
 		Msg *apc.LsoMsg
@@ -73,6 +82,10 @@ func RenewECEncode(bck *meta.Bck, uuid, phase string) RenewRes {
 	return RenewBucketXact(apc.ActECEncode, bck, Args{Custom: &ECEncodeArgs{Phase: phase}, UUID: uuid})
 }
 
+func RenewECReslice(bck *meta.Bck, uuid string) RenewRes {
+	return RenewBucketXact(apc.ActECReslice, bck, Args{UUID: uuid})
+}
+
 func RenewMakeNCopies(uuid, tag string) {
 	var (
 		cfg      = cmn.GCO.Get()
@@ -81,7 +94,7 @@ func RenewMakeNCopies(uuid, tag string) {
 	)
 	bmd.Range(&provider, nil, func(bck *meta.Bck) bool {
 		if bck.Props.Mirror.Enabled {
-			rns := RenewBckMakeNCopies(bck, uuid, tag, int(bck.Props.Mirror.Copies))
+			rns := RenewBckMakeNCopies(bck, uuid, &MNCArgs{Tag: tag, Copies: int(bck.Props.Mirror.Copies)})
 			if rns.Err == nil && !rns.IsRunning() {
 				xact.GoRunW(rns.Entry.Get())
 			}
@@ -93,7 +106,7 @@ func RenewMakeNCopies(uuid, tag string) {
 		ns := cfg.Backend.Providers[name]
 		bmd.Range(&name, &ns, func(bck *meta.Bck) bool {
 			if bck.Props.Mirror.Enabled {
-				rns := RenewBckMakeNCopies(bck, uuid, tag, int(bck.Props.Mirror.Copies))
+				rns := RenewBckMakeNCopies(bck, uuid, &MNCArgs{Tag: tag, Copies: int(bck.Props.Mirror.Copies)})
 				if rns.Err == nil && !rns.IsRunning() {
 					xact.GoRunW(rns.Entry.Get())
 				}
@@ -103,8 +116,8 @@ func RenewMakeNCopies(uuid, tag string) {
 	}
 }
 
-func RenewBckMakeNCopies(bck *meta.Bck, uuid, tag string, copies int) (res RenewRes) {
-	e := dreg.bckXacts[apc.ActMakeNCopies].New(Args{Custom: &MNCArgs{tag, copies}, UUID: uuid}, bck)
+func RenewBckMakeNCopies(bck *meta.Bck, uuid string, args *MNCArgs) (res RenewRes) {
+	e := dreg.bckXacts[apc.ActMakeNCopies].New(Args{Custom: args, UUID: uuid}, bck)
 	return dreg.renew(e, bck)
 }
 
@@ -116,6 +129,19 @@ func RenewBckLoadLomCache(uuid string, bck *meta.Bck) RenewRes {
 	return RenewBucketXact(apc.ActLoadLomCache, bck, Args{UUID: uuid})
 }
 
+// force: rewrite all objects (see xs.XactCompact)
+func RenewBckCompact(uuid string, bck *meta.Bck, force bool) RenewRes {
+	return RenewBucketXact(apc.ActCompactBck, bck, Args{UUID: uuid, Custom: force})
+}
+
+func RenewBckPin(uuid string, bck *meta.Bck) RenewRes {
+	return RenewBucketXact(apc.ActPinBck, bck, Args{UUID: uuid})
+}
+
+func RenewSample(uuid string, bck *meta.Bck, args *SampleArgs) RenewRes {
+	return RenewBucketXact(apc.ActSampleBck, bck, Args{UUID: uuid, Custom: args})
+}
+
 func RenewPutMirror(lom *core.LOM) RenewRes {
 	return RenewBucketXact(apc.ActPutCopies, lom.Bck(), Args{Custom: lom})
 }
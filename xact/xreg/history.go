@@ -0,0 +1,94 @@
+// Package xreg provides registry and (renew, find) functions for AIS eXtended Actions (xactions).
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xreg
+
+import (
+	"os"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+)
+
+// Bounded (FIFO) history of finished xactions - final snapshots without
+// kind-specific extensions. Unlike the registry (see hkDelOld) the history
+// survives restarts: it gets persisted upon change (see hkPruneActive).
+
+const histMaxEntries = 1024
+
+type history struct {
+	fpath   string
+	entries []*core.Snap // oldest first
+	mtx     sync.Mutex
+	dirty   bool
+}
+
+var hist history
+
+// load previously persisted history, if any; must be called prior to RegWithHK
+func InitHistory(fpath string) {
+	hist.fpath = fpath
+	if _, err := jsp.Load(fpath, &hist.entries, jsp.Options{Checksum: true}); err != nil && !os.IsNotExist(err) {
+		nlog.Warningln("failed to load xaction history:", err)
+		hist.entries = nil
+	}
+}
+
+// finished xactions matching a given bucket (empty bucket: all)
+func History(bck *cmn.Bck) []*core.Snap {
+	hist.mtx.Lock()
+	out := make([]*core.Snap, 0, len(hist.entries))
+	for _, snap := range hist.entries {
+		if bck.IsEmpty() || _sameBck(bck, &snap.Bck) || _sameBck(bck, &snap.SrcBck) || _sameBck(bck, &snap.DstBck) {
+			out = append(out, snap)
+		}
+	}
+	hist.mtx.Unlock()
+	return out
+}
+
+func _sameBck(bck, other *cmn.Bck) bool {
+	return (bck.Name == "" || bck.Name == other.Name) && bck.Ns == other.Ns &&
+		(bck.Provider == "" || bck.Provider == other.Provider)
+}
+
+func (h *history) add(xctn core.Xact) {
+	if xctn.Kind() == apc.ActList {
+		return // too many and too short-lived
+	}
+	snap := xctn.Snap()
+	snap.Ext = nil
+	h.mtx.Lock()
+	if len(h.entries) >= histMaxEntries {
+		n := copy(h.entries, h.entries[len(h.entries)-histMaxEntries+1:])
+		clear(h.entries[n:])
+		h.entries = h.entries[:n]
+	}
+	h.entries = append(h.entries, snap)
+	h.dirty = true
+	h.mtx.Unlock()
+}
+
+func (h *history) persist() {
+	if h.fpath == "" {
+		return
+	}
+	h.mtx.Lock()
+	if !h.dirty {
+		h.mtx.Unlock()
+		return
+	}
+	entries := make([]*core.Snap, len(h.entries))
+	copy(entries, h.entries)
+	h.dirty = false
+	h.mtx.Unlock()
+
+	if err := jsp.Save(h.fpath, entries, jsp.Options{Checksum: true}, nil); err != nil {
+		nlog.Errorln("failed to persist xaction history:", err)
+	}
+}
@@ -20,6 +20,7 @@ func GetRebMarked() (out xact.Marked) {
 	} else {
 		out.Interrupted = fs.MarkerExists(fname.RebalanceMarker)
 		out.Restarted = fs.MarkerExists(fname.NodeRestartedPrev)
+		out.DataLoss = fs.MarkerExists(fname.DataLossMarker)
 	}
 	return
 }
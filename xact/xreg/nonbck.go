@@ -44,6 +44,17 @@ func RenewStoreCleanup(id string) RenewRes {
 	return dreg.renew(e, nil)
 }
 
+func RenewValidateLomMD(id string) RenewRes {
+	e := dreg.nonbckXacts[apc.ActValidateLomMD].New(Args{UUID: id}, nil)
+	return dreg.renew(e, nil)
+}
+
+// `next` is called upon completion with the earliest (not yet reached) expiration time, if any
+func RenewExpireObjs(id string, next func(int64)) RenewRes {
+	e := dreg.nonbckXacts[apc.ActExpireObjs].New(Args{UUID: id, Custom: next}, nil)
+	return dreg.renew(e, nil)
+}
+
 func RenewDownloader(xid string, bck *meta.Bck) RenewRes {
 	e := dreg.nonbckXacts[apc.ActDownload].New(Args{UUID: xid, Custom: bck}, nil)
 	return dreg.renew(e, nil)
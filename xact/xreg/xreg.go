@@ -209,6 +209,32 @@ func (e *entries) getAllRunning(inout *core.AllRunningInOut, periodic bool) {
 
 func GetRunning(flt Flt) Renewable { return dreg.getRunning(flt) }
 
+// returns any running xaction that is not idle (ie., has work in progress), if exists
+func GetBusy() core.Xact {
+	e := &dreg.entries
+	e.mtx.RLock()
+	defer e.mtx.RUnlock()
+	for _, entry := range e.active {
+		if xctn := entry.Get(); xctn.Running() && !xctn.Snap().IdleX {
+			return xctn
+		}
+	}
+	return nil
+}
+
+// ditto, all of them
+func GetAllBusy() (out []core.Xact) {
+	e := &dreg.entries
+	e.mtx.RLock()
+	for _, entry := range e.active {
+		if xctn := entry.Get(); xctn.Running() && !xctn.Snap().IdleX {
+			out = append(out, xctn)
+		}
+	}
+	e.mtx.RUnlock()
+	return out
+}
+
 func (r *registry) getRunning(flt Flt) (entry Renewable) {
 	e := &r.entries
 	e.mtx.RLock()
@@ -396,7 +422,10 @@ func (r *registry) hkPruneActive() time.Duration {
 	if r.finDelta.Swap(0) == 0 {
 		return hk.PruneActiveIval
 	}
-	e := &r.entries
+	var (
+		e        = &r.entries
+		finished = make([]core.Xact, 0, 8)
+	)
 	e.mtx.Lock()
 	l := len(e.active)
 	for i := 0; i < l; i++ {
@@ -404,12 +433,19 @@ func (r *registry) hkPruneActive() time.Duration {
 		if !entry.Get().Finished() {
 			continue
 		}
+		finished = append(finished, entry.Get())
 		copy(e.active[i:], e.active[i+1:])
 		i--
 		l--
 		e.active = e.active[:l]
 	}
 	e.mtx.Unlock()
+
+	// (not under lock - see matchingXactsStats)
+	for _, xctn := range finished {
+		hist.add(xctn)
+	}
+	hist.persist()
 	return hk.PruneActiveIval
 }
 
@@ -0,0 +1,231 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Compact a bucket, mountpath by mountpath:
+// - rewrite (sequentially) objects that occupy on-disk space in excess of their size
+//   (e.g., after in-place overwrites) or, with 'force', all objects - to consolidate
+//   fragmented storage;
+// - remove empty directories left behind by deleted objects.
+// Reports reclaimed bytes per mountpath (see CompactStats).
+
+const (
+	compactMinSlack  = cos.MiB // rewrite when allocated exceeds size by at least this much...
+	compactSlackFrac = 8       // ...and by at least 1/8 of the size
+)
+
+type (
+	compactFactory struct {
+		xreg.RenewBase
+		xctn *XactCompact
+	}
+	XactCompact struct {
+		reclaimed map[string]*atomic.Int64 // per mountpath
+		xact.BckJog
+		rewritten atomic.Int64
+		dirs      atomic.Int64
+		force     bool
+	}
+	CompactStats struct {
+		Reclaimed map[string]int64 `json:"reclaimed"` // bytes, per mountpath
+		Rewritten int64            `json:"rewritten,string"`
+		Dirs      int64            `json:"dirs,string"` // removed empty directories
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactCompact)(nil)
+	_ xreg.Renewable = (*compactFactory)(nil)
+)
+
+////////////////////
+// compactFactory //
+////////////////////
+
+func (*compactFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &compactFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *compactFactory) Start() error {
+	force, ok := p.Args.Custom.(bool)
+	debug.Assert(ok)
+	p.xctn = newXactCompact(p.UUID(), p.Bck, force)
+	go p.xctn.Run(nil)
+	return nil
+}
+
+func (*compactFactory) Kind() string     { return apc.ActCompactBck }
+func (p *compactFactory) Get() core.Xact { return p.xctn }
+
+func (*compactFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprUse, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+}
+
+/////////////////
+// XactCompact //
+/////////////////
+
+func newXactCompact(uuid string, bck *meta.Bck, force bool) (r *XactCompact) {
+	avail := fs.GetAvail()
+	r = &XactCompact{reclaimed: make(map[string]*atomic.Int64, len(avail)), force: force}
+	for mpath := range avail {
+		r.reclaimed[mpath] = &atomic.Int64{}
+	}
+	slab, err := core.T.PageMM().GetSlab(memsys.MaxPageSlabSize)
+	debug.AssertNoErr(err)
+	mpopts := &mpather.JgroupOpts{
+		CTs:      []string{fs.ObjectType},
+		VisitObj: r.visitObj,
+		Slab:     slab,
+		Throttle: true, // low priority
+	}
+	mpopts.Bck.Copy(bck.Bucket())
+	r.BckJog.Init(uuid, apc.ActCompactBck, bck, mpopts, cmn.GCO.Get())
+	return
+}
+
+func (r *XactCompact) Run(*sync.WaitGroup) {
+	nlog.Infoln(r.Name(), "force", r.force)
+	r.BckJog.Run()
+	err := r.BckJog.Wait()
+	if err != nil {
+		r.AddErr(err)
+	} else {
+		for _, mi := range fs.GetAvail() {
+			if r.IsAborted() {
+				break
+			}
+			r.rmEmptyDirs(mi)
+		}
+	}
+	nlog.Infoln(r.Name(), "done:", r.rewritten.Load(), "rewritten,", r.dirs.Load(), "empty dirs removed")
+	r.Finish()
+}
+
+func (r *XactCompact) visitObj(lom *core.LOM, buf []byte) error {
+	if !r.force {
+		size, alloc, err := cos.AllocSize(lom.FQN)
+		if err != nil || alloc-size < max(compactMinSlack, size/compactSlackFrac) {
+			return nil
+		}
+	}
+	if !lom.TryLock(true) {
+		return nil // busy - skip it
+	}
+	err := r.rewrite(lom, buf)
+	lom.Unlock(true)
+	if err != nil && !os.IsNotExist(err) {
+		r.AddErr(err, 4, cos.SmoduleXs)
+	}
+	return nil
+}
+
+// (under wlock)
+func (r *XactCompact) rewrite(lom *core.LOM, buf []byte) error {
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return nil
+	}
+	_, before, err := cos.AllocSize(lom.FQN)
+	if err != nil {
+		return err
+	}
+	wfqn := fs.CSM.Gen(lom, fs.WorkfileType, "compact")
+	if _, _, err := cos.CopyFile(lom.FQN, wfqn, buf, cos.ChecksumNone); err != nil {
+		if errRm := cos.RemoveFile(wfqn); errRm != nil {
+			nlog.Errorln(r.Name(), "nested error:", errRm)
+		}
+		return err
+	}
+	if err := lom.RenameFinalize(wfqn); err != nil {
+		return err
+	}
+	if err := lom.PersistMain(); err != nil { // (xattr)
+		return err
+	}
+	_, after, err := cos.AllocSize(lom.FQN)
+	if err != nil {
+		return err
+	}
+	r.rewritten.Inc()
+	r.ObjsAdd(1, lom.Lsize())
+	if before > after {
+		r._reclaimed(lom.Mountpath().Path, before-after)
+	}
+	return nil
+}
+
+func (r *XactCompact) rmEmptyDirs(mi *fs.Mountpath) {
+	root := mi.MakePathCT(r.Bck().Bucket(), fs.ObjectType)
+	r._rmEmpty(root, root, mi)
+}
+
+// depth-first; returns true if the directory is empty (and was removed, unless root)
+func (r *XactCompact) _rmEmpty(dir, root string, mi *fs.Mountpath) bool {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false
+	}
+	empty := true
+	for _, e := range entries {
+		if !e.IsDir() || !r._rmEmpty(filepath.Join(dir, e.Name()), root, mi) {
+			empty = false
+		}
+	}
+	if !empty || dir == root {
+		return empty
+	}
+	_, alloc, _ := cos.AllocSize(dir)
+	if err := os.Remove(dir); err != nil {
+		return false // (e.g., PUT in the meantime)
+	}
+	r.dirs.Inc()
+	r._reclaimed(mi.Path, alloc)
+	return true
+}
+
+func (r *XactCompact) _reclaimed(mpath string, n int64) {
+	if cnt, ok := r.reclaimed[mpath]; ok { // (mountpath added at runtime - not counting)
+		cnt.Add(n)
+	}
+}
+
+func (r *XactCompact) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	ext := &CompactStats{
+		Reclaimed: make(map[string]int64, len(r.reclaimed)),
+		Rewritten: r.rewritten.Load(),
+		Dirs:      r.dirs.Load(),
+	}
+	for mpath, n := range r.reclaimed {
+		ext.Reclaimed[mpath] = n.Load()
+	}
+	snap.Ext = ext
+	snap.IdleX = r.IsIdle()
+	return
+}
@@ -6,6 +6,7 @@
 package xs
 
 import (
+	"fmt"
 	"path/filepath"
 	"sync"
 
@@ -128,9 +129,10 @@ func (r *XactDirPromote) walk(fqn string, de fs.DirEntry) error {
 			ObjName:      objName,
 			OverwriteDst: args.OverwriteDst,
 			DeleteSrc:    args.DeleteSrc,
+			HardLink:     args.HardLink,
+			Verify:       args.Verify,
 		},
 	}
-	// TODO: continue-on-error (unify w/ x-archive)
 	ecode, err := core.T.Promote(&params)
 	if cos.IsNotExist(err, ecode) {
 		err = nil
@@ -139,6 +141,11 @@ func (r *XactDirPromote) walk(fqn string, de fs.DirEntry) error {
 		nlog.Infof("%s: %s => %s (over=%t, del=%t, share=%t): %v", r.Base.Name(), fqn, bck.Cname(objName),
 			args.OverwriteDst, args.DeleteSrc, r.confirmedFshare, err)
 	}
+	if err != nil && args.ContinueOnError {
+		// report this file and keep going
+		r.AddErr(fmt.Errorf("%s => %s: %w", fqn, bck.Cname(objName), err), 0)
+		err = nil
+	}
 	return err
 }
 
@@ -0,0 +1,77 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// fails to promote a given object; records the rest
+type prmTarget struct {
+	*mock.TargetMock
+	fail     string
+	promoted []string
+}
+
+func (t *prmTarget) Promote(params *core.PromoteParams) (int, error) {
+	if params.ObjName == t.fail {
+		return 0, errors.New("failed to promote " + params.ObjName)
+	}
+	t.promoted = append(t.promoted, params.ObjName)
+	return 0, nil
+}
+
+func TestDirPromoteContinueOnError(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a", "b", "c"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(name), cos.PermRWR); err != nil {
+			t.Fatal(err)
+		}
+	}
+	bck := meta.NewBck("prm", apc.AIS, cmn.NsGlobal)
+	for _, coer := range []bool{false, true} {
+		tgt := &prmTarget{TargetMock: mock.NewTarget(mock.NewBaseBownerMock()), fail: "b"}
+		core.T = tgt
+
+		args := &apc.PromoteArgs{SrcFQN: dir, ContinueOnError: coer}
+		r := &XactDirPromote{p: &proFactory{args: args}}
+		r.InitBase(cos.GenUUID(), apc.ActPromote, bck)
+		r.Config = cmn.GCO.Get()
+
+		err := fs.WalkDir(dir, r.walk)
+		if !coer {
+			// stops at the first failure
+			if err == nil {
+				t.Fatal("expecting error")
+			}
+			if len(tgt.promoted) != 1 || tgt.promoted[0] != "a" {
+				t.Fatalf("expecting to promote only %q, got %v", "a", tgt.promoted)
+			}
+			continue
+		}
+		// keeps going, reports the failed file
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(tgt.promoted) != 2 || tgt.promoted[0] != "a" || tgt.promoted[1] != "c" {
+			t.Fatalf("expecting to promote %q and %q, got %v", "a", "c", tgt.promoted)
+		}
+		if n := r.ErrCnt(); n != 1 {
+			t.Fatalf("expecting 1 reported error, got %d (%v)", n, r.Err())
+		}
+	}
+}
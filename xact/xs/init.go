@@ -27,9 +27,14 @@ func Xreg(xeleOnly bool) {
 	xreg.RegBckXact(&prfFactory{})
 
 	xreg.RegNonBckXact(&nsummFactory{})
+	xreg.RegNonBckXact(&lvdFactory{})
+	xreg.RegNonBckXact(&ttlFactory{})
 
 	xreg.RegBckXact(&proFactory{})
 	xreg.RegBckXact(&llcFactory{})
+	xreg.RegBckXact(&compactFactory{})
+	xreg.RegBckXact(&pinFactory{})
+	xreg.RegBckXact(&sampleFactory{})
 
 	xreg.RegBckXact(&tcbFactory{kind: apc.ActCopyBck})
 	xreg.RegBckXact(&tcbFactory{kind: apc.ActETLBck})
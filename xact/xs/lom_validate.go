@@ -0,0 +1,131 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"os"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Validate (and, when possible, repair) metadata of all locally stored objects.
+// Runs in the background, throttled by disk utilization, which is why it is used
+// to take eager metadata scanning out of the target's startup path
+// (see feat.LazyStartupValidation).
+
+type (
+	lvdFactory struct {
+		xreg.RenewBase
+		xctn *XactLVD
+	}
+	XactLVD struct {
+		xact.BckJog
+		repaired atomic.Int64
+		removed  atomic.Int64
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactLVD)(nil)
+	_ xreg.Renewable = (*lvdFactory)(nil)
+)
+
+////////////////
+// lvdFactory //
+////////////////
+
+func (*lvdFactory) New(args xreg.Args, _ *meta.Bck) xreg.Renewable {
+	return &lvdFactory{RenewBase: xreg.RenewBase{Args: args}}
+}
+
+func (p *lvdFactory) Start() error {
+	p.xctn = newXactLVD(p.UUID())
+	go p.xctn.Run(nil)
+	return nil
+}
+
+func (*lvdFactory) Kind() string     { return apc.ActValidateLomMD }
+func (p *lvdFactory) Get() core.Xact { return p.xctn }
+
+func (*lvdFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprUse, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+}
+
+/////////////
+// XactLVD //
+/////////////
+
+func newXactLVD(uuid string) (r *XactLVD) {
+	r = &XactLVD{}
+	mpopts := &mpather.JgroupOpts{
+		CTs:      []string{fs.ObjectType},
+		VisitObj: r.visitObj, // (loads and validates)
+		Throttle: true,       // low priority
+	}
+	r.BckJog.Init(uuid, apc.ActValidateLomMD, nil /*all buckets*/, mpopts, cmn.GCO.Get())
+	return
+}
+
+func (r *XactLVD) Run(*sync.WaitGroup) {
+	nlog.Infoln(r.Name())
+	r.BckJog.Run()
+	err := r.BckJog.Wait()
+	if err != nil {
+		r.AddErr(err)
+	}
+	nlog.Infoln(r.Name(), "done: repaired", r.repaired.Load(), "removed", r.removed.Load())
+	r.Finish()
+}
+
+func (r *XactLVD) visitObj(lom *core.LOM, _ []byte) error {
+	err := lom.Load(false /*cache it*/, false /*locked*/)
+	if err == nil {
+		if !lom.IsCopy() {
+			r.ObjsAdd(1, lom.Lsize())
+		}
+		return nil
+	}
+	switch {
+	case os.IsNotExist(err) || cmn.IsErrObjNought(err):
+		// removed in the meantime
+	case cmn.IsErrLmetaCorrupted(err) || cmn.IsErrLmetaNotFound(err):
+		// try to recover from an (intact) copy, if any; otherwise, remove
+		if lom.RestoreToLocation() {
+			r.repaired.Inc()
+			nlog.Warningln(r.Name(), "restored", lom.Cname(), "from copy:", err)
+			return nil
+		}
+		if errRm := lom.RemoveMain(); errRm != nil {
+			r.AddErr(errRm)
+			return nil
+		}
+		r.removed.Inc()
+		nlog.Errorln(r.Name(), "removed", lom.Cname(), "with invalid metadata:", err)
+	default:
+		r.AddErr(err)
+		core.T.FSHC(err, lom.Mountpath(), lom.FQN)
+	}
+	return nil
+}
+
+func (r *XactLVD) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
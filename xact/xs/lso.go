@@ -15,6 +15,7 @@ import (
 	"runtime"
 	"sort"
 	"sync"
+	ratomic "sync/atomic"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -50,20 +51,23 @@ type (
 		token     string           // continuation token -> last responded page
 		nextToken string           // next continuation token -> next pages
 		lastPage  cmn.LsoEntries   // last page (contents)
-		walk      struct {
-			pageCh       chan *cmn.LsoEnt // channel to accumulate listed object entries
-			stopCh       *cos.StopCh      // to abort bucket walk
-			wi           *walkInfo        // walking context and state
-			wg           sync.WaitGroup   // wait until this walk finishes
-			done         bool             // done walking (indication)
-			wor          bool             // wantOnlyRemote
-			dontPopulate bool             // when listing remote obj-s: don't include local MD (in re: LsDonAddRemote)
-			this         bool             // r.msg.SID == core.T.SID(): true when this target does remote paging
-		}
+		walk      lsoWalk
+		snap      *snapJournal // (apc.LsSnapshot)
 		streamingX
 		lensgl int64
 		ctx    *core.LsoInvCtx
 	}
+	lsoWalk struct {
+		pageCh       chan *cmn.LsoEnt          // channel to accumulate listed object entries
+		stopCh       *cos.StopCh               // to abort bucket walk
+		wi           *walkInfo                 // walking context and state
+		wg           *sync.WaitGroup           // wait until this walk finishes
+		owner        *ratomic.Pointer[LsoXact] // x-list that currently owns the walk (see lsoCursor)
+		done         bool                      // done walking (indication)
+		wor          bool                      // wantOnlyRemote
+		dontPopulate bool                      // when listing remote obj-s: don't include local MD (in re: LsDonAddRemote)
+		this         bool                      // r.msg.SID == core.T.SID(): true when this target does remote paging
+	}
 	LsoRsp struct {
 		Err    error
 		Lst    *cmn.LsoRes
@@ -79,6 +83,9 @@ const (
 var (
 	errStopped = errors.New("stopped")
 	ErrGone    = errors.New("gone")
+
+	// (apc.LsSnapshot) continuation request that cannot be served from the original snapshot
+	ErrSnapshotGone = errors.New("list-objects snapshot is gone, must restart listing")
 )
 
 // interface guard
@@ -107,6 +114,11 @@ func (p *lsoFactory) Start() (err error) {
 	if err = cmn.ValidatePrefix(p.msg.Prefix); err != nil {
 		return err
 	}
+	if p.msg.IsFlagSet(apc.LsSnapshot) && p.msg.ContinuationToken != "" {
+		// the snapshot this listing started with is no longer here
+		// (idle timeout, restart, or this target joined in the middle)
+		return fmt.Errorf("%w [%s, %q]", ErrSnapshotGone, p.msg.UUID, p.msg.ContinuationToken)
+	}
 
 	r.lastPage = allocLsoEntries()
 	r.stopCh.Init()
@@ -141,6 +153,10 @@ func (p *lsoFactory) Start() (err error) {
 		if cos.IsParseBool(p.hdr.Get(apc.HdrInventory)) && r.walk.this {
 			r.ctx = &core.LsoInvCtx{Name: p.hdr.Get(apc.HdrInvName), ID: p.hdr.Get(apc.HdrInvID)}
 		}
+	} else if p.msg.IsFlagSet(apc.LsSnapshot) {
+		if r.snap, err = newSnapJournal(p.Bck, p.msg); err != nil {
+			return err
+		}
 	}
 
 	p.xctn = r
@@ -178,7 +194,10 @@ func (p *lsoFactory) beginStreams(r *LsoXact) (err error) {
 func (r *LsoXact) Run(wg *sync.WaitGroup) {
 	wg.Done()
 
-	if !r.listRemote() {
+	if !r.listRemote() && !cursors.resume(r) {
+		if r.snap != nil {
+			r.snap.waitCut(r.ChanAbort())
+		}
 		r.initWalk()
 	}
 loop:
@@ -241,12 +260,18 @@ func (r *LsoXact) stop() {
 		}
 	} else {
 		r.DemandBase.Stop()
-		r.walk.stopCh.Close()
-		r.walk.wg.Wait()
+		if !cursors.park(r) {
+			r.walk.stopCh.Close()
+			r.walk.wg.Wait()
+		}
 		r.lastmsg()
 		r.Finish()
 	}
 
+	if r.snap != nil {
+		r.snap.fini()
+		r.snap = nil
+	}
 	if r.lastPage != nil {
 		freeLsoEntries(r.lastPage)
 		r.lastPage = nil
@@ -310,15 +335,25 @@ func (r *LsoXact) listRemote() bool { return r.p.Bck.IsRemote() && !r.msg.IsFlag
 
 // Start `fs.WalkBck`, so that by the time we read the next page `r.pageCh` is already populated.
 func (r *LsoXact) initWalk() {
+	if r.walk.owner == nil {
+		r.walk.owner = &ratomic.Pointer[LsoXact]{}
+		r.walk.owner.Store(r)
+	}
+	msg := r.msg.Clone()
 	r.walk.pageCh = make(chan *cmn.LsoEnt, pageChSize)
 	r.walk.done = false
 	r.walk.stopCh = cos.NewStopCh()
+	r.walk.wi = newWalkInfo(msg, r.lomAdd)
+	r.walk.wg = &sync.WaitGroup{}
 	r.walk.wg.Add(1)
 
-	go r.doWalk(r.msg.Clone())
+	go r.doWalk(r.walk.wg)
 	runtime.Gosched()
 }
 
+// the walk may outlive the x-list that started it - count visited objects on behalf of the current owner
+func (r *LsoXact) lomAdd(lom *core.LOM) { r.walk.owner.Load().LomAdd(lom) }
+
 func (r *LsoXact) Do(msg *apc.LsoMsg) *LsoRsp {
 	// The guarantee here is that we either put something on the channel and our
 	// request will be processed (since the `msgCh` is unbuffered) or we receive
@@ -345,6 +380,9 @@ func (r *LsoXact) doPage() *LsoRsp {
 		return &LsoRsp{Lst: page, Status: http.StatusOK}
 	}
 
+	if r.msg.IsFlagSet(apc.LsSnapshot) {
+		return r.snapPage()
+	}
 	if r.msg.ContinuationToken == "" || r.msg.ContinuationToken != r.token {
 		r.nextPageA()
 	}
@@ -364,6 +402,56 @@ func (r *LsoXact) doPage() *LsoRsp {
 	return &LsoRsp{Lst: page, Status: http.StatusOK}
 }
 
+// apc.LsSnapshot: upon the first page, walk the bucket to completion and merge the result
+// with the objects changed after the cut (see lso_snap.go) - all pages
+// (including repeated and out-of-order ones) are then served from the same snapshot
+func (r *LsoXact) snapPage() *LsoRsp {
+	if !r.walk.done {
+		if err := r.snapWalk(); err != nil {
+			return &LsoRsp{Status: snapStatus(err), Err: err}
+		}
+	}
+	var (
+		cnt  = r.msg.PageSize
+		idx  = r.findToken(r.msg.ContinuationToken)
+		lst  = r.lastPage[idx:]
+		page = &cmn.LsoRes{UUID: r.msg.UUID}
+	)
+	r.token = r.msg.ContinuationToken
+	if int64(len(lst)) > cnt {
+		page.Entries = lst[:cnt]
+		page.ContinuationToken = page.Entries[cnt-1].Name
+	} else {
+		page.Entries = lst
+		r.resetIdle()
+	}
+	return &LsoRsp{Lst: page, Status: http.StatusOK}
+}
+
+func (r *LsoXact) snapWalk() (err error) {
+	for obj := range r.walk.pageCh {
+		r.lastPage = append(r.lastPage, obj)
+		if err = r.snap.checkCap(len(r.lastPage)); err != nil {
+			r.walk.stopCh.Close()
+			r.walk.wg.Wait() // (the walk closes pageCh)
+			break
+		}
+	}
+	r.walk.done = true
+	prev := r.snap.fini()
+	if err == nil {
+		err = r.Err()
+	}
+	if err != nil {
+		r.gcLastPage(0, len(r.lastPage))
+		r.lastPage = r.lastPage[:0]
+		r.Abort(err)
+		return err
+	}
+	r.lastPage = snapMerge(r.lastPage, prev)
+	return nil
+}
+
 // `ais show job` will report the sum of non-replicated obj numbers and
 // sum of obj sizes - for all visited objects
 // Returns the index of the first object in the page that follows the continuation `token`
@@ -557,8 +645,8 @@ func (r *LsoXact) shiftLastPage(token string) {
 	r.lastPage = r.lastPage[:l-j]
 }
 
-func (r *LsoXact) doWalk(msg *apc.LsoMsg) {
-	r.walk.wi = newWalkInfo(msg, r.LomAdd)
+func (r *LsoXact) doWalk(wg *sync.WaitGroup) {
+	msg := r.walk.wi.lsmsg()
 	opts := &fs.WalkBckOpts{
 		WalkOpts: fs.WalkOpts{CTs: []string{fs.ObjectType}, Callback: r.cb, Prefix: msg.Prefix, Sorted: true},
 	}
@@ -566,11 +654,11 @@ func (r *LsoXact) doWalk(msg *apc.LsoMsg) {
 	opts.ValidateCb = r.validateCb
 	if err := fs.WalkBck(opts); err != nil {
 		if err != filepath.SkipDir && err != errStopped {
-			r.AddErr(err, 0)
+			r.walk.owner.Load().AddErr(err, 0)
 		}
 	}
 	close(r.walk.pageCh)
-	r.walk.wg.Done()
+	wg.Done()
 }
 
 func (r *LsoXact) validateCb(fqn string, de fs.DirEntry) error {
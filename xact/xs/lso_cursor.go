@@ -0,0 +1,184 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+)
+
+// Persistent (per list-objects UUID) traversal cursors:
+// - x-list that goes idle in the middle of listing in-cluster objects does not abort its bucket walk;
+//   instead, it "parks" the walk (along with the last page and continuation tokens)
+// - the next x-list with the same UUID resumes the parked walk (see resume below), thus continuing
+//   from where the previous one stopped - as opposed to re-walking the bucket and skipping all
+//   entries up to the requested continuation token
+// - a parked cursor is dropped when it expires (lsoCursorTTL), when there are too many of them
+//   (lsoMaxCursors), or when it cannot be resumed (different Smap, older continuation token, etc.)
+
+const (
+	lsoCursorTTL  = 5 * time.Minute
+	lsoCursorIval = time.Minute
+	lsoMaxCursors = 64
+)
+
+type (
+	lsoCursor struct {
+		bck       *meta.Bck
+		msg       *apc.LsoMsg
+		walk      lsoWalk
+		lastPage  cmn.LsoEntries
+		token     string
+		nextToken string
+		parked    int64 // mono-time
+	}
+	lsoCursors struct {
+		m    map[string]*lsoCursor // by list-objects UUID
+		mu   sync.Mutex
+		once sync.Once
+	}
+)
+
+var cursors lsoCursors
+
+func (lc *lsoCursors) init() {
+	lc.m = make(map[string]*lsoCursor, 4)
+	hk.Reg("lso-cursors"+hk.NameSuffix, lc.housekeep, lsoCursorIval)
+}
+
+// upon idle stop
+func (lc *lsoCursors) park(r *LsoXact) bool {
+	switch {
+	case r.IsAborted() || nlog.Stopping():
+		return false
+	case r.msg.IsFlagSet(apc.LsSnapshot):
+		return false
+	case r.walk.done && len(r.lastPage) == 0:
+		return false // nothing left to list
+	}
+	lc.once.Do(lc.init)
+
+	c := &lsoCursor{
+		bck:       r.Bck(),
+		msg:       r.msg.Clone(),
+		walk:      r.walk,
+		lastPage:  r.lastPage,
+		token:     r.token,
+		nextToken: r.nextToken,
+		parked:    mono.NanoTime(),
+	}
+	r.lastPage = nil // ownership transferred
+
+	var evicted []*lsoCursor
+	lc.mu.Lock()
+	if prev, ok := lc.m[r.msg.UUID]; ok {
+		evicted = append(evicted, prev)
+	}
+	lc.m[r.msg.UUID] = c
+	for len(lc.m) > lsoMaxCursors {
+		uuid := lc._oldest()
+		evicted = append(evicted, lc.m[uuid])
+		delete(lc.m, uuid)
+	}
+	lc.mu.Unlock()
+
+	for _, c := range evicted {
+		c.close()
+	}
+	return true
+}
+
+// upon (new) x-list start
+func (lc *lsoCursors) resume(r *LsoXact) bool {
+	lc.mu.Lock()
+	c, ok := lc.m[r.msg.UUID]
+	if ok {
+		delete(lc.m, r.msg.UUID)
+	}
+	lc.mu.Unlock()
+	if !ok {
+		return false
+	}
+	if !c.canResume(r) {
+		c.close()
+		return false
+	}
+
+	r.walk.pageCh, r.walk.stopCh, r.walk.wi, r.walk.wg = c.walk.pageCh, c.walk.stopCh, c.walk.wi, c.walk.wg
+	r.walk.owner, r.walk.done = c.walk.owner, c.walk.done
+	r.walk.owner.Store(r)
+
+	freeLsoEntries(r.lastPage)
+	r.lastPage, r.token, r.nextToken = c.lastPage, c.token, c.nextToken
+	return true
+}
+
+func (lc *lsoCursors) housekeep() time.Duration {
+	var (
+		expired []*lsoCursor
+		now     = mono.NanoTime()
+	)
+	lc.mu.Lock()
+	for uuid, c := range lc.m {
+		if time.Duration(now-c.parked) > lsoCursorTTL {
+			expired = append(expired, c)
+			delete(lc.m, uuid)
+		}
+	}
+	lc.mu.Unlock()
+
+	for _, c := range expired {
+		c.close()
+	}
+	return lsoCursorIval
+}
+
+func (lc *lsoCursors) _oldest() (uuid string) {
+	var oldest int64
+	for id, c := range lc.m {
+		if uuid == "" || c.parked < oldest {
+			uuid, oldest = id, c.parked
+		}
+	}
+	return uuid
+}
+
+///////////////
+// lsoCursor //
+///////////////
+
+func (c *lsoCursor) canResume(r *LsoXact) bool {
+	msg := r.msg
+	if msg.Prefix != c.msg.Prefix || msg.Flags != c.msg.Flags || msg.Props != c.msg.Props || msg.StartAfter != c.msg.StartAfter {
+		return false
+	}
+	if !c.bck.Equal(r.Bck(), true /*same BID*/, true /*same backend*/) {
+		return false
+	}
+	// the walk (via walkInfo) keeps the Smap it started with
+	if c.walk.wi.smap.Version != core.T.Sowner().Get().Version {
+		return false
+	}
+	// can only go forward
+	return msg.ContinuationToken != "" && cmn.TokenGreaterEQ(msg.ContinuationToken, c.token)
+}
+
+func (c *lsoCursor) close() {
+	c.walk.stopCh.Close()
+	c.walk.wg.Wait()
+	if c.lastPage != nil {
+		freeLsoEntries(c.lastPage)
+		c.lastPage = nil
+	}
+}
@@ -0,0 +1,288 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	ratomic "sync/atomic"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Point-in-time listing (apc.LsSnapshot):
+// - the proxy stamps the first page with a cluster-wide cut (apc.LsoMsg.SnapTime) that is
+//   slightly in the future, so that all targets receive it before the cut
+// - each target registers a journal upon receipt (a late target fails the listing with 410),
+//   waits for the cut, and then walks its part of the bucket to completion
+// - PUTs and DELETEs that commit after the cut get journaled - the first one per object
+//   records its pre-cut entry (nil when the object did not exist)
+// - walked entries are then merged with the journal (see snapMerge), so that the resulting
+//   snapshot reflects the state of the bucket as of the cut - on all targets
+// - the snapshot is kept in memory: the number of entries (including journaled ones) is
+//   capped by maxSnapEntries; extreme memory pressure also fails the listing
+// NOTE: the cut is wall-clock time - assuming (reasonably) synchronized clocks
+
+const (
+	maxSnapEntries = 4 * 1024 * 1024 // max listed + journaled entries per target
+	snapCheckEvery = 4096            // check memory pressure every so many walked entries
+)
+
+type (
+	snapJournal struct {
+		bck  *meta.Bck
+		wi   *walkInfo              // (props to list; no callback)
+		prev map[string]*cmn.LsoEnt // object name => pre-cut entry (nil: did not exist)
+		cut  int64                  // unix nano
+		mu   sync.Mutex
+		over bool // journaled more than maxSnapEntries
+	}
+	snapJournals struct {
+		m  map[string][]*snapJournal // by bucket
+		mu sync.RWMutex
+		n  ratomic.Int32
+	}
+
+	// pending PUT or DELETE of an object that is being listed as a point-in-time snapshot
+	SnapRec struct {
+		name string
+		js   []*snapJournal
+		ens  []*cmn.LsoEnt // the respective pre-cut entries
+	}
+)
+
+var (
+	ErrSnapshotTooLarge = errors.New("list-objects snapshot is too large")
+
+	snaps = snapJournals{m: make(map[string][]*snapJournal, 4)}
+)
+
+// upon receipt of the first page
+func newSnapJournal(bck *meta.Bck, msg *apc.LsoMsg) (*snapJournal, error) {
+	now := time.Now().UnixNano()
+	cut := msg.SnapTime
+	if cut == 0 {
+		cut = now
+	} else if now > cut {
+		// all other targets may have already taken the cut
+		return nil, fmt.Errorf("%w [%s]: missed the cut by %v", ErrSnapshotGone, msg.UUID, time.Duration(now-cut))
+	}
+	lsmsg := msg.Clone()
+	lsmsg.ClearFlag(apc.LsVerChanged) // (with objects write-locked - see SnapPre)
+	j := &snapJournal{
+		bck:  bck,
+		wi:   newWalkInfo(lsmsg, noopCb),
+		prev: make(map[string]*cmn.LsoEnt),
+		cut:  cut,
+	}
+	snaps.reg(j)
+	return j, nil
+}
+
+// wait for the cut prior to walking
+func (j *snapJournal) waitCut(abortCh <-chan error) {
+	d := time.Duration(j.cut - time.Now().UnixNano())
+	if d <= 0 {
+		return
+	}
+	timer := time.NewTimer(d)
+	select {
+	case <-timer.C:
+	case <-abortCh:
+		timer.Stop()
+	}
+}
+
+func (j *snapJournal) match(name string) bool {
+	msg := j.wi.msg
+	if !cmn.ObjHasPrefix(name, msg.Prefix) || name <= msg.StartAfter {
+		return false
+	}
+	if msg.IsFlagSet(apc.LsNoRecursion) {
+		// same nesting level (see cmn.HandleNoRecurs)
+		dir := msg.Prefix[:strings.LastIndexByte(msg.Prefix, '/')+1]
+		return !strings.Contains(name[len(dir):], cos.PathSeparator)
+	}
+	return true
+}
+
+// entry to list, with the object loaded and locked
+func (j *snapJournal) ent(lom *core.LOM) *cmn.LsoEnt {
+	switch {
+	case lom.IsExpired(time.Now().UnixNano()):
+		return nil
+	case lom.IsTombstone():
+		return &cmn.LsoEnt{Name: lom.ObjName, Flags: apc.LocOK | apc.EntryTombstone}
+	}
+	return j.wi.ls(lom, apc.LocOK)
+}
+
+// first post-cut change wins; subsequent ones do not change the pre-cut entry
+func (j *snapJournal) record(name string, en *cmn.LsoEnt, now int64) {
+	if now < j.cut {
+		return // the walk that starts after the cut will see it
+	}
+	j.mu.Lock()
+	if _, ok := j.prev[name]; !ok {
+		if len(j.prev) >= maxSnapEntries {
+			j.over = true
+		} else {
+			j.prev[name] = en
+		}
+	}
+	j.mu.Unlock()
+}
+
+// walked `cnt` entries so far
+func (j *snapJournal) checkCap(cnt int) error {
+	j.mu.Lock()
+	n, over := cnt+len(j.prev), j.over
+	j.mu.Unlock()
+	if over || n > maxSnapEntries {
+		return fmt.Errorf("%w: %s, %d entries (max %d)", ErrSnapshotTooLarge, j.bck.Cname(""), n, maxSnapEntries)
+	}
+	if cnt%snapCheckEvery == 0 {
+		if core.T.PageMM().Pressure() >= memsys.PressureExtreme {
+			return fmt.Errorf("%w: %s, %d entries, extreme memory pressure", ErrSnapshotTooLarge, j.bck.Cname(""), n)
+		}
+	}
+	return nil
+}
+
+// done walking: stop journaling and return the journaled entries
+func (j *snapJournal) fini() map[string]*cmn.LsoEnt {
+	snaps.unreg(j)
+	j.mu.Lock()
+	prev := j.prev
+	j.prev = nil
+	j.mu.Unlock()
+	return prev
+}
+
+// walked (sorted) entries + journal => snapshot as of the cut
+func snapMerge(lst cmn.LsoEntries, prev map[string]*cmn.LsoEnt) cmn.LsoEntries {
+	if len(prev) == 0 {
+		return lst
+	}
+	out := lst[:0]
+	for _, en := range lst {
+		if _, ok := prev[en.Name]; !ok {
+			out = append(out, en)
+		}
+	}
+	for _, en := range prev {
+		if en != nil {
+			out = append(out, en)
+		}
+	}
+	cmn.SortLso(out)
+	return out
+}
+
+//////////////////
+// snapJournals //
+//////////////////
+
+func (sj *snapJournals) reg(j *snapJournal) {
+	uname := string(j.bck.MakeUname(""))
+	sj.mu.Lock()
+	sj.m[uname] = append(sj.m[uname], j)
+	sj.n.Add(1)
+	sj.mu.Unlock()
+}
+
+func (sj *snapJournals) unreg(j *snapJournal) {
+	uname := string(j.bck.MakeUname(""))
+	sj.mu.Lock()
+	js := sj.m[uname]
+	for i := range js {
+		if js[i] != j {
+			continue
+		}
+		js = append(js[:i], js[i+1:]...)
+		if len(js) == 0 {
+			delete(sj.m, uname)
+		} else {
+			sj.m[uname] = js
+		}
+		sj.n.Add(-1)
+		break
+	}
+	sj.mu.Unlock()
+}
+
+func (sj *snapJournals) get(lom *core.LOM) (js []*snapJournal) {
+	uname := string(lom.Bck().MakeUname(""))
+	sj.mu.RLock()
+	for _, j := range sj.m[uname] {
+		if j.match(lom.ObjName) {
+			js = append(js, j)
+		}
+	}
+	sj.mu.RUnlock()
+	return js
+}
+
+/////////////
+// SnapRec //
+/////////////
+
+// To be called with the object write-locked, prior to committing its PUT (`deleting` false)
+// or DELETE (`deleting` true, with `lom` loaded). Returns nil when there are no point-in-time
+// listings of the object's bucket in progress.
+func SnapPre(lom *core.LOM, deleting bool) *SnapRec {
+	if snaps.n.Load() == 0 {
+		return nil
+	}
+	js := snaps.get(lom)
+	if len(js) == 0 {
+		return nil
+	}
+	cur := lom
+	if !deleting {
+		// the object that is about to be overwritten, if exists
+		cur = core.AllocLOM(lom.ObjName)
+		defer core.FreeLOM(cur)
+		if cur.InitBck(lom.Bucket()) != nil || cur.Load(false /*cache it*/, true /*locked*/) != nil {
+			cur = nil
+		}
+	}
+	rec := &SnapRec{name: lom.ObjName, js: js, ens: make([]*cmn.LsoEnt, len(js))}
+	if cur != nil {
+		for i, j := range js {
+			rec.ens[i] = j.ent(cur)
+		}
+	}
+	return rec
+}
+
+// upon successful commit
+func (rec *SnapRec) Commit() {
+	if rec == nil {
+		return
+	}
+	now := time.Now().UnixNano()
+	for i, j := range rec.js {
+		j.record(rec.name, rec.ens[i], now)
+	}
+}
+
+// (see LsoXact.snapPage)
+func snapStatus(err error) int {
+	if errors.Is(err, ErrSnapshotTooLarge) {
+		return http.StatusInsufficientStorage
+	}
+	return http.StatusInternalServerError
+}
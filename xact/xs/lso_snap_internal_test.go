@@ -0,0 +1,119 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+func testSnapJournal(cut int64, lsmsg *apc.LsoMsg) *snapJournal {
+	return &snapJournal{
+		bck:  meta.NewBck("snap", apc.AIS, cmn.NsGlobal),
+		wi:   &walkInfo{msg: lsmsg},
+		prev: make(map[string]*cmn.LsoEnt),
+		cut:  cut,
+	}
+}
+
+func TestSnapMerge(t *testing.T) {
+	var (
+		lst  = cmn.LsoEntries{{Name: "a"}, {Name: "b", Size: 2}, {Name: "c"}, {Name: "d"}}
+		prev = map[string]*cmn.LsoEnt{
+			"b": {Name: "b", Size: 1}, // overwritten after the cut
+			"c": nil,                  // created after the cut
+			"e": {Name: "e"},          // deleted after the cut
+			"f": nil,                  // created and deleted after the cut
+		}
+		expected = []string{"a", "b", "d", "e"}
+	)
+	out := snapMerge(lst, prev)
+	if len(out) != len(expected) {
+		t.Fatalf("expected %v, got %d entries", expected, len(out))
+	}
+	for i, en := range out {
+		if en.Name != expected[i] {
+			t.Fatalf("entry #%d: expected %q, got %q", i, expected[i], en.Name)
+		}
+	}
+	if out[1].Size != 1 {
+		t.Errorf("expected pre-cut entry of %q (size 1), got size %d", out[1].Name, out[1].Size)
+	}
+}
+
+func TestSnapJournalRecord(t *testing.T) {
+	now := time.Now().UnixNano()
+	j := testSnapJournal(now, &apc.LsoMsg{})
+
+	j.record("pre", &cmn.LsoEnt{Name: "pre"}, now-1) // before the cut: the walk will see it
+	if _, ok := j.prev["pre"]; ok {
+		t.Fatalf("unexpected journaled change prior to the cut")
+	}
+
+	// first post-cut change wins
+	j.record("obj", &cmn.LsoEnt{Name: "obj", Size: 1}, now)
+	j.record("obj", &cmn.LsoEnt{Name: "obj", Size: 2}, now+1)
+	j.record("new", nil, now+1)
+	j.record("new", &cmn.LsoEnt{Name: "new"}, now+2)
+
+	if en := j.prev["obj"]; en == nil || en.Size != 1 {
+		t.Fatalf("expected pre-cut entry (size 1), got %+v", en)
+	}
+	if en, ok := j.prev["new"]; !ok || en != nil {
+		t.Fatalf("expected object that did not exist at the cut, got %+v", en)
+	}
+}
+
+func TestSnapJournalMatch(t *testing.T) {
+	tests := []struct {
+		lsmsg *apc.LsoMsg
+		name  string
+		match bool
+	}{
+		{&apc.LsoMsg{}, "a/b/c", true},
+		{&apc.LsoMsg{Prefix: "a/"}, "a/b/c", true},
+		{&apc.LsoMsg{Prefix: "a/"}, "b/c", false},
+		{&apc.LsoMsg{StartAfter: "a/b"}, "a/a", false},
+		{&apc.LsoMsg{Prefix: "a/", Flags: apc.LsNoRecursion}, "a/b", true},
+		{&apc.LsoMsg{Prefix: "a/", Flags: apc.LsNoRecursion}, "a/b/c", false},
+		{&apc.LsoMsg{Flags: apc.LsNoRecursion}, "a", true},
+		{&apc.LsoMsg{Flags: apc.LsNoRecursion}, "a/b", false},
+	}
+	for _, test := range tests {
+		j := testSnapJournal(0, test.lsmsg)
+		if match := j.match(test.name); match != test.match {
+			t.Errorf("%+v, %q: expected match=%t", test.lsmsg, test.name, test.match)
+		}
+	}
+}
+
+func TestSnapJournalCap(t *testing.T) {
+	j := testSnapJournal(0, &apc.LsoMsg{})
+	if err := j.checkCap(1); err != nil {
+		t.Fatal(err)
+	}
+	// journaled entries count as well
+	for _, name := range []string{"a", "b", "c"} {
+		j.record(name, nil, 1)
+	}
+	if err := j.checkCap(maxSnapEntries - 3); err != nil {
+		t.Fatal(err)
+	}
+	if err := j.checkCap(maxSnapEntries - 1); !errors.Is(err, ErrSnapshotTooLarge) {
+		t.Fatalf("expected %v, got %v", ErrSnapshotTooLarge, err)
+	}
+	// journal overflow
+	j = testSnapJournal(0, &apc.LsoMsg{})
+	j.over = true
+	if err := j.checkCap(1); !errors.Is(err, ErrSnapshotTooLarge) {
+		t.Fatalf("expected %v, got %v", ErrSnapshotTooLarge, err)
+	}
+}
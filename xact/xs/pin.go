@@ -0,0 +1,172 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Replicate pinned bucket (see cmn.PinConf): each target pushes the objects it owns (by HRW)
+// to all pinned targets that do not have them yet. Objects not replicated for whatever reason
+// (e.g., written while pinning) get replicated on demand, upon the first read.
+
+type (
+	pinFactory struct {
+		xreg.RenewBase
+		xctn *XactPin
+	}
+	XactPin struct {
+		config *cmn.Config
+		smap   *meta.Smap
+		xact.BckJog
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactPin)(nil)
+	_ xreg.Renewable = (*pinFactory)(nil)
+)
+
+////////////////
+// pinFactory //
+////////////////
+
+func (*pinFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &pinFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *pinFactory) Start() error {
+	p.xctn = newXactPin(p.UUID(), p.Bck)
+	go p.xctn.Run(nil)
+	return nil
+}
+
+func (*pinFactory) Kind() string     { return apc.ActPinBck }
+func (p *pinFactory) Get() core.Xact { return p.xctn }
+
+func (*pinFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprUse, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+}
+
+/////////////
+// XactPin //
+/////////////
+
+func newXactPin(uuid string, bck *meta.Bck) (r *XactPin) {
+	r = &XactPin{config: cmn.GCO.Get(), smap: core.T.Sowner().Get()}
+	mpopts := &mpather.JgroupOpts{
+		CTs:      []string{fs.ObjectType},
+		VisitObj: r.visitObj,
+		DoLoad:   mpather.Load,
+		Throttle: true,
+	}
+	mpopts.Bck.Copy(bck.Bucket())
+	r.BckJog.Init(uuid, apc.ActPinBck, bck, mpopts, r.config)
+	return
+}
+
+func (r *XactPin) Run(*sync.WaitGroup) {
+	nlog.Infoln(r.Name())
+	r.BckJog.Run()
+	err := r.BckJog.Wait()
+	if err != nil {
+		r.AddErr(err)
+	}
+	r.Finish()
+}
+
+func (r *XactPin) visitObj(lom *core.LOM, _ []byte) error {
+	pin := &lom.Bprops().Pin
+	if !pin.Enabled {
+		return cmn.NewErrAborted(r.Name(), "unpinned", nil)
+	}
+	owner, local, err := lom.HrwTarget(r.smap)
+	if err != nil {
+		return err
+	}
+	if !local || lom.IsCopy() {
+		return nil // (is itself a replica)
+	}
+	for _, tsi := range r.smap.Tmap {
+		if tsi.ID() == owner.ID() || tsi.InMaintOrDecomm() || !pin.Has(tsi.ID()) {
+			continue
+		}
+		if core.T.HeadObjT2T(lom, tsi) {
+			continue
+		}
+		if err := r.send(lom, tsi); err != nil {
+			r.AddErr(err, 4, cos.SmoduleXs)
+			continue
+		}
+		r.OutObjsAdd(1, lom.Lsize())
+	}
+	return nil
+}
+
+// t2t PUT (compare with ais/copyOI.put)
+func (r *XactPin) send(lom *core.LOM, tsi *meta.Snode) error {
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	fh, err := cos.NewFileHandle(lom.FQN)
+	if err != nil {
+		return err
+	}
+	var (
+		bck   = lom.Bck()
+		hdr   = make(http.Header, 8)
+		query = bck.NewQuery()
+	)
+	cmn.ToHeader(lom.ObjAttrs(), hdr, lom.Lsize())
+	hdr.Set(apc.HdrT2TPutterID, core.T.SID())
+	query.Set(apc.QparamOWT, cmn.OwtRebalance.ToS()) // same as GFN: replica, as is
+	query.Set(apc.QparamUUID, r.ID())
+	reqArgs := cmn.HreqArgs{
+		Method: http.MethodPut,
+		Base:   tsi.URL(cmn.NetIntraData),
+		Path:   apc.URLPathObjects.Join(bck.Name, lom.ObjName),
+		Query:  query,
+		Header: hdr,
+		BodyR:  fh,
+	}
+	req, _, cancel, err := reqArgs.ReqWithTimeout(r.config.Timeout.SendFile.D())
+	if err != nil {
+		cos.Close(fh)
+		return err
+	}
+	defer cancel()
+	resp, err := core.T.DataClient().Do(req) //nolint:bodyclose // cos.DrainReader and close below
+	if err != nil {
+		return cmn.NewErrFailedTo(r, "replicate "+lom.Cname()+" to", tsi, err)
+	}
+	cos.DrainReader(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: failed to replicate %s to %s: status %d", r, lom.Cname(), tsi, resp.StatusCode)
+	}
+	return nil
+}
+
+func (r *XactPin) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
@@ -0,0 +1,295 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Random sample of the bucket's objects (apc.ActSampleBck), in two phases (see ais/prxsample.go):
+// - begin: walk local objects and draw a uniform random sample (reservoir) of up to the requested size;
+//   report the number of objects the sample was drawn from
+// - commit: given this target's share of the total, process a random subset of the reservoir -
+//   with ETL (see xreg.SampleArgs), transform and parse; otherwise, load the objects' metadata
+//   (sizes) without reading the objects
+// Aborts itself if not committed in time (e.g., the proxy failed in between).
+
+const (
+	sampleWorkers    = 4
+	sampleMaxETLOut  = cos.MiB         // max size of the transformer's response (to parse)
+	sampleDfltCommit = 5 * time.Minute // see also xreg.SampleArgs.CommitTimeout
+)
+
+type (
+	sampleFactory struct {
+		xreg.RenewBase
+		xctn *XactSample
+	}
+	XactSample struct {
+		args    *xreg.SampleArgs
+		walkErr error
+		walked  chan struct{}
+		shareCh chan int
+		done    chan struct{}
+		fqns    []string // reservoir
+		res     cmn.SampleRes
+		xact.Base
+		mu sync.Mutex
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactSample)(nil)
+	_ xreg.Renewable = (*sampleFactory)(nil)
+)
+
+///////////////////
+// sampleFactory //
+///////////////////
+
+func (*sampleFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &sampleFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *sampleFactory) Start() error {
+	args := p.Args.Custom.(*xreg.SampleArgs)
+	p.xctn = newXactSample(p.UUID(), p.Bck, args)
+	go p.xctn.Run(nil)
+	return nil
+}
+
+func (*sampleFactory) Kind() string     { return apc.ActSampleBck }
+func (p *sampleFactory) Get() core.Xact { return p.xctn }
+
+func (*sampleFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprKeepAndStartNew, nil
+}
+
+////////////////
+// XactSample //
+////////////////
+
+func newXactSample(uuid string, bck *meta.Bck, args *xreg.SampleArgs) (r *XactSample) {
+	r = &XactSample{
+		args:    args,
+		walked:  make(chan struct{}),
+		shareCh: make(chan int, 1),
+		done:    make(chan struct{}),
+		fqns:    make([]string, 0, args.Msg.Count),
+	}
+	r.InitBase(uuid, apc.ActSampleBck, bck)
+	return
+}
+
+func (r *XactSample) Run(*sync.WaitGroup) {
+	nlog.Infoln(r.Name())
+	started := mono.NanoTime()
+	r.walkErr = r.walk()
+	close(r.walked)
+	if r.walkErr != nil {
+		r.AddErr(r.walkErr)
+	} else {
+		timeout := r.args.CommitTimeout
+		if timeout == 0 {
+			// other targets may take as long to walk their objects
+			timeout = max(sampleDfltCommit, mono.Since(started))
+		}
+		select {
+		case n := <-r.shareCh:
+			r.run(n)
+		case <-r.ChanAbort():
+		case <-time.After(timeout):
+			r.Abort(fmt.Errorf("%s: not committed within %v", r.Name(), timeout))
+		}
+	}
+	close(r.done)
+	r.Finish()
+}
+
+// begin: wait for the walk to complete; return the number of local objects
+func (r *XactSample) Walked() (int64, error) {
+	select {
+	case <-r.walked:
+		return r.res.Objects, r.walkErr
+	case <-r.ChanAbort():
+		return 0, r.AbortErr()
+	}
+}
+
+// commit: process `n` objects out of the reservoir; return the results
+func (r *XactSample) Commit(n int) (*cmn.SampleRes, error) {
+	select {
+	case r.shareCh <- n: // (buffered)
+	case <-r.done:
+	}
+	<-r.done
+	if err := r.AbortErr(); err != nil {
+		return nil, err
+	}
+	if r.walkErr != nil {
+		return nil, r.walkErr
+	}
+	return &r.res, nil
+}
+
+// reservoir sampling: one pass over all local objects, uniform sample of (up to) msg.Count
+func (r *XactSample) walk() error {
+	for _, mi := range fs.GetAvail() {
+		opts := &fs.WalkOpts{Mi: mi, CTs: []string{fs.ObjectType}, Callback: r.cb}
+		opts.Bck.Copy(r.Bck().Bucket())
+		if err := fs.Walk(opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *XactSample) cb(fqn string, de fs.DirEntry) error {
+	if r.IsAborted() {
+		return r.AbortErr()
+	}
+	if de.IsDir() {
+		return nil
+	}
+	lom := core.AllocLOM("")
+	err := lom.InitFQN(fqn, r.Bck().Bucket())
+	skip := err != nil || !lom.IsHRW() || !strings.HasPrefix(lom.ObjName, r.args.Msg.Prefix) // (copies and work files)
+	core.FreeLOM(lom)
+	if skip {
+		return nil
+	}
+	r.res.Objects++
+	if len(r.fqns) < r.args.Msg.Count {
+		r.fqns = append(r.fqns, fqn)
+	} else if i := rand.Int64N(r.res.Objects); i < int64(r.args.Msg.Count) {
+		r.fqns[i] = fqn
+	}
+	return nil
+}
+
+// random subset of the reservoir (which is itself a uniform sample)
+func (r *XactSample) run(n int) {
+	rand.Shuffle(len(r.fqns), func(i, j int) { r.fqns[i], r.fqns[j] = r.fqns[j], r.fqns[i] })
+	fqns := r.fqns[:min(n, len(r.fqns))]
+	var (
+		wg   sync.WaitGroup
+		work = make(chan string, len(fqns))
+	)
+	for _, fqn := range fqns {
+		work <- fqn
+	}
+	close(work)
+	for range min(sampleWorkers, len(fqns)) {
+		wg.Add(1)
+		go func() {
+			for fqn := range work {
+				if r.IsAborted() {
+					break
+				}
+				r.do(fqn)
+			}
+			wg.Done()
+		}()
+	}
+	wg.Wait()
+	if r.res.Sampled > 0 {
+		r.res.MeanSize = r.res.Size / r.res.Sampled
+	}
+}
+
+func (r *XactSample) do(fqn string) {
+	lom := core.AllocLOM("")
+	size, label, err := r._do(lom, fqn)
+	core.FreeLOM(lom)
+	if size < 0 {
+		return // deleted in the meantime
+	}
+	r.ObjsAdd(1, size)
+
+	r.mu.Lock()
+	r.res.AddSize(size)
+	if err != nil {
+		r.res.Failed++
+		if r.res.Err == "" {
+			r.res.Err = err.Error()
+		}
+	} else {
+		r.res.Parsed++
+		if label != "" {
+			r.res.AddLabel(label, 1)
+		}
+	}
+	r.mu.Unlock()
+}
+
+// returns object size (negative when not found), ETL label, and error
+func (r *XactSample) _do(lom *core.LOM, fqn string) (int64, string, error) {
+	if err := lom.InitFQN(fqn, r.Bck().Bucket()); err != nil {
+		return -1, "", nil
+	}
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if cos.IsNotExist(err, 0) {
+			return -1, "", nil
+		}
+		return 0, "", err
+	}
+	size := lom.Lsize()
+	if r.args.DP == nil {
+		return size, "", nil // (no reading)
+	}
+
+	reader, _, err := r.args.DP.Reader(lom, false /*latest*/, false /*sync*/)
+	if err != nil {
+		return size, "", fmt.Errorf("%s: failed to transform %s: %v", r.args.Msg.ETLName, lom.Cname(), err)
+	}
+	b, err := io.ReadAll(io.LimitReader(reader, sampleMaxETLOut))
+	cos.Close(reader)
+	if err != nil {
+		return size, "", err
+	}
+	var out struct {
+		Label any `json:"label"`
+	}
+	if err := jsoniter.Unmarshal(b, &out); err != nil {
+		return size, "", fmt.Errorf("%s: failed to parse transformed %s: %v", r.args.Msg.ETLName, lom.Cname(), err)
+	}
+	switch v := out.Label.(type) {
+	case nil:
+		return size, "", nil
+	case string:
+		return size, v, nil
+	default:
+		return size, fmt.Sprint(v), nil
+	}
+}
+
+func (r *XactSample) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
@@ -44,6 +44,8 @@ type (
 		rxlast atomic.Int64 // finishing
 		xact.BckJog
 		prune    prune
+		bwlim    xact.Bwlim
+		journal  *xact.Journal // copied objects (to resume after restart)
 		nam, str string
 		wg       sync.WaitGroup // starting up
 		refc     atomic.Int32   // finishing
@@ -180,6 +182,16 @@ func newTCB(p *tcbFactory, slab *memsys.Slab, config *cmn.Config, smap *meta.Sma
 	mpopts.Bck.Copy(p.args.BckFrom.Bucket())
 	r.BckJog.Init(p.UUID(), p.kind, p.args.BckTo, mpopts, config)
 
+	if !p.args.Msg.DryRun {
+		r.bwlim.Init(int64(p.args.Msg.MaxThroughput))
+	}
+
+	// plain copy only: neither transformation nor (remote) versioning
+	if p.kind == apc.ActCopyBck && !p.args.Msg.DryRun && !p.args.Msg.LatestVer && !p.args.Msg.Sync {
+		r.journal = xact.OpenJournal(xact.JournalName(p.kind, p.args.BckFrom.Cname(""), p.args.BckTo.Cname(""),
+			p.args.Msg.Prefix, p.args.Msg.Prepend))
+	}
+
 	if p.args.Msg.Sync {
 		debug.Assert(p.args.Msg.Prepend == "", p.args.Msg.Prepend) // validated (cli, P)
 		{
@@ -230,6 +242,12 @@ func (r *XactTCB) Run(wg *sync.WaitGroup) {
 	if r.p.args.Msg.Sync {
 		r.prune.wait()
 	}
+	if r.journal != nil {
+		if n := r.journal.Skipped(); n > 0 {
+			nlog.Infoln(r.Name(), "skipped", n, "objects copied prior to restart")
+		}
+		r.journal.Close(err == nil && r.ErrCnt() == 0 && !r.IsAborted())
+	}
 	r.Finish()
 }
 
@@ -264,6 +282,9 @@ func (r *XactTCB) do(lom *core.LOM, buf []byte) (err error) {
 	if cmn.Rom.FastV(5, cos.SmoduleXs) {
 		nlog.Infoln(r.Base.Name()+":", lom.Cname(), "=>", args.BckTo.Cname(toName))
 	}
+	if r.journal.Skip(lom, toName) {
+		return nil
+	}
 	coiParams := core.AllocCOI()
 	{
 		coiParams.DP = args.DP
@@ -277,13 +298,17 @@ func (r *XactTCB) do(lom *core.LOM, buf []byte) (err error) {
 		coiParams.LatestVer = args.Msg.LatestVer
 		coiParams.Sync = args.Msg.Sync
 	}
-	_, err = core.T.CopyObject(lom, r.dm, coiParams)
+	size, err := core.T.CopyObject(lom, r.dm, coiParams)
 	core.FreeCOI(coiParams)
 	switch {
 	case err == nil:
+		if r.bwlim.Enabled() {
+			r.bwlim.Pace(size)
+		}
 		if args.Msg.Sync {
 			r.prune.filter.Insert(cos.UnsafeB(lom.Uname()))
 		}
+		r.journal.Add(lom, toName)
 	case cos.IsNotExist(err, 0):
 		// do nothing
 	case cos.IsErrOOS(err):
@@ -46,8 +46,9 @@ type (
 		owt cmn.OWT
 	}
 	tcowi struct {
-		r   *XactTCObjs
-		msg *cmn.TCObjsMsg
+		r     *XactTCObjs
+		msg   *cmn.TCObjsMsg
+		bwlim xact.Bwlim
 		// finishing
 		refc atomic.Int32
 	}
@@ -136,6 +137,9 @@ func (r *XactTCObjs) Snap() (snap *core.Snap) {
 
 func (r *XactTCObjs) Begin(msg *cmn.TCObjsMsg) {
 	wi := &tcowi{r: r, msg: msg}
+	if !msg.DryRun {
+		wi.bwlim.Init(int64(msg.MaxThroughput))
+	}
 	r.pending.mtx.Lock()
 	r.pending.m[msg.TxnUUID] = wi
 	r.wiCnt.Inc()
@@ -330,9 +334,12 @@ func (wi *tcowi) do(lom *core.LOM, lrit *lriterator) {
 		coiParams.LatestVer = wi.msg.LatestVer
 		coiParams.Sync = wi.msg.Sync
 	}
-	_, err := core.T.CopyObject(lom, wi.r.p.dm, coiParams)
+	size, err := core.T.CopyObject(lom, wi.r.p.dm, coiParams)
 	core.FreeCOI(coiParams)
 	slab.Free(buf)
+	if err == nil && wi.bwlim.Enabled() {
+		wi.bwlim.Pace(size)
+	}
 
 	if err != nil {
 		if !cos.IsNotExist(err, 0) || lrit.lrp == lrpList {
@@ -0,0 +1,154 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Delete locally stored objects that have expired (see cmn.ExpiresObjMD).
+// Runs in the background, throttled by disk utilization; upon completion reports
+// the earliest expiration time (if any) of the objects that remain.
+
+type (
+	ttlFactory struct {
+		xreg.RenewBase
+		xctn *XactTTL
+	}
+	XactTTL struct {
+		next    func(int64)
+		started int64
+		xact.BckJog
+		earliest atomic.Int64
+		expired  atomic.Int64
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactTTL)(nil)
+	_ xreg.Renewable = (*ttlFactory)(nil)
+)
+
+////////////////
+// ttlFactory //
+////////////////
+
+func (*ttlFactory) New(args xreg.Args, _ *meta.Bck) xreg.Renewable {
+	return &ttlFactory{RenewBase: xreg.RenewBase{Args: args}}
+}
+
+func (p *ttlFactory) Start() error {
+	next, ok := p.Args.Custom.(func(int64))
+	debug.Assert(ok)
+	p.xctn = newXactTTL(p.UUID(), next)
+	go p.xctn.Run(nil)
+	return nil
+}
+
+func (*ttlFactory) Kind() string     { return apc.ActExpireObjs }
+func (p *ttlFactory) Get() core.Xact { return p.xctn }
+
+func (*ttlFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprUse, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+}
+
+/////////////
+// XactTTL //
+/////////////
+
+func newXactTTL(uuid string, next func(int64)) (r *XactTTL) {
+	r = &XactTTL{next: next, started: time.Now().UnixNano()}
+	mpopts := &mpather.JgroupOpts{
+		CTs:      []string{fs.ObjectType},
+		VisitObj: r.visitObj,
+		Throttle: true, // low priority
+	}
+	r.BckJog.Init(uuid, apc.ActExpireObjs, nil /*all buckets*/, mpopts, cmn.GCO.Get())
+	return
+}
+
+func (r *XactTTL) Run(*sync.WaitGroup) {
+	nlog.Infoln(r.Name())
+	r.BckJog.Run()
+	err := r.BckJog.Wait()
+	if err != nil {
+		r.AddErr(err)
+	}
+	earliest := r.earliest.Load()
+	if err != nil {
+		earliest = r.started // incomplete: must run again
+	}
+	r.next(earliest)
+	nlog.Infoln(r.Name(), "done: expired", r.expired.Load())
+	r.Finish()
+}
+
+func (r *XactTTL) visitObj(lom *core.LOM, _ []byte) error {
+	if lom.IsCopy() {
+		return nil // (removed together with the main replica)
+	}
+	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+		return nil // (removed in the meantime or otherwise not loadable - not our concern)
+	}
+	var (
+		expires = lom.Expires()
+		now     = time.Now().UnixNano()
+	)
+	if expires == 0 {
+		return nil
+	}
+	if expires > now {
+		// remember the earliest
+		for {
+			earliest := r.earliest.Load()
+			if earliest != 0 && earliest <= expires {
+				break
+			}
+			if r.earliest.CAS(earliest, expires) {
+				break
+			}
+		}
+		return nil
+	}
+	// (re-check under write lock - may have been overwritten in the meantime)
+	deleted, err := core.T.DeleteExpired(lom, now)
+	if err != nil {
+		r.AddErr(err)
+		return nil
+	}
+	if !deleted {
+		return nil
+	}
+	r.expired.Inc()
+	r.ObjsAdd(1, lom.Lsize())
+	if cmn.Rom.FastV(4, cos.SmoduleXs) {
+		nlog.Infoln(r.Name(), "expired", lom.Cname())
+	}
+	return nil
+}
+
+func (r *XactTTL) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
@@ -7,12 +7,18 @@ package xs
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/ec"
+	"github.com/NVIDIA/aistore/fs"
 )
 
 // `apc.LsoMsg` flags
@@ -63,7 +69,10 @@ func (wi *walkInfo) setWanted(e *cmn.LsoEnt, lom *core.LOM) {
 		case apc.GetPropsAtime:
 			e.Atime = cos.FormatNanoTime(lom.AtimeUnix(), wi.msg.TimeFormat)
 		case apc.GetPropsLocation:
-			e.Location = lom.Location()
+			e.Location = lom.Locations()
+			if lom.ECEnabled() {
+				e.Location += ecLocation(lom)
+			}
 		case apc.GetPropsCopies:
 			e.Copies = int16(lom.NumCopies())
 
@@ -90,3 +99,29 @@ func (wi *walkInfo) setWanted(e *cmn.LsoEnt, lom *core.LOM) {
 		}
 	}
 }
+
+// EC section of the location: all targets that hold the object's full replica (slice 0) or slices,
+// as per the local metafile; e.g.: "; ec: t[ABC]=0 t[DEF]=1 t[XYZ]=2"
+func ecLocation(lom *core.LOM) string {
+	fqn := lom.Mountpath().MakePathFQN(lom.Bucket(), fs.ECMetaType, lom.ObjName)
+	md, err := ec.LoadMetadata(fqn)
+	if err != nil || len(md.Daemons) == 0 {
+		return "" // not (yet) encoded, or no metafile
+	}
+	tids := make([]string, 0, len(md.Daemons))
+	for tid := range md.Daemons {
+		tids = append(tids, tid)
+	}
+	sort.Slice(tids, func(i, j int) bool { return md.Daemons[tids[i]] < md.Daemons[tids[j]] })
+
+	var sb strings.Builder
+	sb.WriteString(apc.LocationSectSepa)
+	sb.WriteString("ec:")
+	for _, tid := range tids {
+		sb.WriteByte(' ')
+		sb.WriteString(meta.Tname(tid))
+		sb.WriteByte('=')
+		sb.WriteString(strconv.Itoa(int(md.Daemons[tid])))
+	}
+	return sb.String()
+}
@@ -8,6 +8,7 @@ package xs
 import (
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -86,6 +87,9 @@ func (wi *walkInfo) match(objName string) bool {
 // new entry to be added to the listed page (note: slow path)
 func (wi *walkInfo) ls(lom *core.LOM, status uint16) (e *cmn.LsoEnt) {
 	e = &cmn.LsoEnt{Name: lom.ObjName, Flags: status | apc.EntryIsCached}
+	if lom.Bck().IsOffline() {
+		e.SetOffline()
+	}
 	if wi.msg.IsFlagSet(apc.LsVerChanged) {
 		checkRemoteMD(lom, e)
 	}
@@ -151,7 +155,8 @@ func (wi *walkInfo) _cb(lom *core.LOM, fqn string) (*cmn.LsoEnt, error) {
 	}
 
 	// shortcut #1: name-only optimizes-out loading md (NOTE: won't show misplaced and copies)
-	if wi.msg.IsFlagSet(apc.LsNameOnly) {
+	// (except copy-on-write clones - need to tell tombstones)
+	if wi.msg.IsFlagSet(apc.LsNameOnly) && lom.Bck().CloneOf() == nil {
 		if !isOK(status) {
 			return nil, nil
 		}
@@ -164,6 +169,16 @@ func (wi *walkInfo) _cb(lom *core.LOM, fqn string) (*cmn.LsoEnt, error) {
 		}
 		return nil, err
 	}
+	if lom.IsExpired(time.Now().UnixNano()) {
+		return nil, nil // expired, not yet deleted (see apc.ActExpireObjs)
+	}
+	if lom.IsTombstone() {
+		if !isOK(status) {
+			return nil, nil
+		}
+		// for the proxy to hide the source's object (see apc.ActCloneBck)
+		return &cmn.LsoEnt{Name: lom.ObjName, Flags: status | apc.EntryTombstone}, nil
+	}
 	if local && lom.IsCopy() {
 		// still may change below
 		status = apc.LocIsCopy
@@ -14,12 +14,15 @@ import (
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/core/mock"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/space"
+	"github.com/NVIDIA/aistore/tools"
 	"github.com/NVIDIA/aistore/tools/tassert"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/NVIDIA/aistore/xact/xreg"
@@ -39,6 +42,7 @@ func init() {
 	xreg.Init()
 	xs.Xreg(false)
 	fs.TestNew(nil)
+	hk.TestInit()
 }
 
 // Smoke tests for xactions
@@ -209,6 +213,196 @@ func TestXactionAbortBuckets(t *testing.T) {
 	tassert.Errorf(t, xactBck.IsAborted(), "AbortAllGlobal: expected bucket xaction to be aborted")
 }
 
+// objects with corrupted metadata (and no copies) get removed, the rest remain intact
+func TestXactionValidateLomMD(t *testing.T) {
+	const (
+		numObjs    = 10
+		numCorrupt = 3
+	)
+	out := tools.PrepareObjects(t, tools.ObjectsDesc{
+		CTs:           []tools.ContentTypeDesc{{Type: fs.ObjectType, ContentCnt: numObjs}},
+		MountpathsCnt: 2,
+		ObjectSize:    cos.KiB,
+	})
+	xreg.TestReset()
+	xs.Xreg(false)
+	defer xreg.AbortAll(nil)
+	cos.InitShortID(0)
+
+	fqns := out.FQNs[fs.ObjectType]
+	for _, fqn := range fqns[:numCorrupt] {
+		lom := &core.LOM{}
+		tassert.CheckFatal(t, lom.InitFQN(fqn, nil))
+		lom.Uncache()
+		tassert.CheckFatal(t, fs.SetXattr(fqn, fs.XattrLOM, []byte("garbage")))
+	}
+
+	rns := xreg.RenewValidateLomMD(cos.GenUUID())
+	tassert.CheckFatal(t, rns.Err)
+	xctn := rns.Entry.Get()
+	for deadline := time.Now().Add(10 * time.Second); !xctn.Finished(); time.Sleep(10 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatalf("%s: timed out", xctn)
+		}
+	}
+
+	for i, fqn := range fqns {
+		_, err := os.Stat(fqn)
+		if i < numCorrupt {
+			tassert.Errorf(t, os.IsNotExist(err), "expected %q to be removed, got %v", fqn, err)
+		} else {
+			tassert.Errorf(t, err == nil, "expected %q to remain, got %v", fqn, err)
+		}
+	}
+	snap := xctn.Snap()
+	tassert.Errorf(t, snap.Err == "", "%s: unexpected error %s", xctn, snap.Err)
+	tassert.Errorf(t, snap.Stats.Objs == numObjs-numCorrupt, "expected %d validated objects, got %d",
+		numObjs-numCorrupt, snap.Stats.Objs)
+}
+
+type sowner struct{ smap *meta.Smap }
+
+func (so *sowner) Get() *meta.Smap            { return so.smap }
+func (*sowner) Listeners() meta.SmapListeners { return nil }
+
+// objects deleted after the cut are still listed; those deleted before it are not
+func TestXactionLsoSnapshot(t *testing.T) {
+	const numObjs = 10
+	out := tools.PrepareObjects(t, tools.ObjectsDesc{
+		CTs:           []tools.ContentTypeDesc{{Type: fs.ObjectType, ContentCnt: numObjs}},
+		MountpathsCnt: 2,
+		ObjectSize:    cos.KiB,
+	})
+	tsi := &meta.Snode{}
+	tsi.Init(core.T.SID(), apc.Target)
+	smap := &meta.Smap{Tmap: meta.NodeMap{tsi.ID(): tsi}}
+	smap.InitDigests()
+	core.T.(*mock.TargetMock).SO = &sowner{smap}
+
+	xreg.TestReset()
+	xs.Xreg(false)
+	defer xreg.AbortAll(nil)
+	cos.InitShortID(0)
+
+	var (
+		bck   = meta.CloneBck(&out.Bck)
+		fqns  = out.FQNs[fs.ObjectType]
+		names = make([]string, 0, numObjs)
+		del   = func(fqn string) {
+			lom := &core.LOM{}
+			tassert.CheckFatal(t, lom.InitFQN(fqn, nil))
+			lom.Lock(true)
+			defer lom.Unlock(true)
+			tassert.CheckFatal(t, lom.Load(false, true))
+			snap := xs.SnapPre(lom, true /*deleting*/)
+			tassert.CheckFatal(t, lom.RemoveObj())
+			snap.Commit()
+		}
+	)
+	for _, fqn := range fqns {
+		lom := &core.LOM{}
+		tassert.CheckFatal(t, lom.InitFQN(fqn, nil))
+		names = append(names, lom.ObjName)
+	}
+
+	// late to the cut
+	msg := &apc.LsoMsg{UUID: cos.GenUUID(), Flags: apc.LsSnapshot, SnapTime: time.Now().Add(-time.Second).UnixNano()}
+	rns := xreg.RenewLso(bck, msg.UUID, msg, nil)
+	tassert.Fatalf(t, errors.Is(rns.Err, xs.ErrSnapshotGone), "expected %v, got %v", xs.ErrSnapshotGone, rns.Err)
+
+	cut := time.Now().Add(time.Second)
+	msg = &apc.LsoMsg{UUID: cos.GenUUID(), Flags: apc.LsSnapshot, PageSize: 2 * numObjs, SnapTime: cut.UnixNano()}
+	rns = xreg.RenewLso(bck, msg.UUID, msg, nil)
+	tassert.CheckFatal(t, rns.Err)
+	xctn := rns.Entry.Get()
+	xact.GoRunW(xctn)
+
+	del(fqns[0]) // before the cut
+	time.Sleep(time.Until(cut) + 10*time.Millisecond)
+	del(fqns[1]) // after
+	del(fqns[2])
+
+	resp := xctn.(*xs.LsoXact).Do(msg)
+	tassert.CheckFatal(t, resp.Err)
+	listed := make(cos.StrSet, len(resp.Lst.Entries))
+	for _, en := range resp.Lst.Entries {
+		listed.Add(en.Name)
+	}
+	tassert.Errorf(t, len(listed) == numObjs-1, "expected %d listed objects, got %d", numObjs-1, len(listed))
+	tassert.Errorf(t, !listed.Contains(names[0]), "object %q deleted prior to the cut must not be listed", names[0])
+	for _, name := range names[1:] {
+		tassert.Errorf(t, listed.Contains(name), "expected %q to be listed", name)
+	}
+}
+
+// transforms every object into the same label
+type labelDP struct{ calls atomic.Int32 }
+
+func (dp *labelDP) Reader(*core.LOM, bool, bool) (cos.ReadOpenCloser, cos.OAH, error) {
+	dp.calls.Add(1)
+	return cos.NewByteHandle([]byte(`{"label": "cat"}`)), nil, nil
+}
+
+func TestXactionSample(t *testing.T) {
+	const numObjs = 10
+	out := tools.PrepareObjects(t, tools.ObjectsDesc{
+		CTs:           []tools.ContentTypeDesc{{Type: fs.ObjectType, ContentCnt: numObjs}},
+		MountpathsCnt: 2,
+		ObjectSize:    cos.KiB,
+	})
+	xreg.TestReset()
+	xs.Xreg(false)
+	defer xreg.AbortAll(nil)
+	cos.InitShortID(0)
+
+	var (
+		bck    = meta.CloneBck(&out.Bck)
+		sample = func(args *xreg.SampleArgs) *xs.XactSample {
+			rns := xreg.RenewSample(cos.GenUUID(), bck, args)
+			tassert.CheckFatal(t, rns.Err)
+			r := rns.Entry.Get().(*xs.XactSample)
+			n, err := r.Walked()
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, n == numObjs, "expected %d objects, got %d", numObjs, n)
+			return r
+		}
+	)
+
+	// no ETL: loads metadata only
+	r := sample(&xreg.SampleArgs{Msg: &apc.SampleMsg{Count: 5}})
+	res, err := r.Commit(3)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, res.Sampled == 3 && res.Parsed == 3 && res.Failed == 0, "unexpected result %+v", res)
+	tassert.Errorf(t, res.Size == 3*cos.KiB && res.MeanSize == cos.KiB, "unexpected sizes %+v", res)
+	tassert.Errorf(t, len(res.Labels) == 0, "unexpected labels %v", res.Labels)
+	snap := r.Snap()
+	tassert.Errorf(t, snap.Stats.Objs == 3, "expected 3 sampled objects, got %d", snap.Stats.Objs)
+
+	// ETL: transforms and parses, the share capped by the reservoir
+	dp := &labelDP{}
+	r = sample(&xreg.SampleArgs{DP: dp, Msg: &apc.SampleMsg{Count: 4, ETLName: "labeler"}})
+	res, err = r.Commit(numObjs)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, res.Sampled == 4 && res.Parsed == 4, "unexpected result %+v", res)
+	tassert.Errorf(t, res.Labels["cat"] == 4, "expected 4 labeled objects, got %v", res.Labels)
+	tassert.Errorf(t, dp.calls.Load() == 4, "expected 4 transforms, got %d", dp.calls.Load())
+
+	// aborted (e.g., canceled) prior to commit
+	r = sample(&xreg.SampleArgs{Msg: &apc.SampleMsg{Count: 5}})
+	r.Abort(cmn.ErrXactUserAbort)
+	_, err = r.Commit(5)
+	tassert.Errorf(t, errors.Is(err, cmn.ErrXactUserAbort), "expected %v, got %v", cmn.ErrXactUserAbort, err)
+
+	// never committed
+	r = sample(&xreg.SampleArgs{Msg: &apc.SampleMsg{Count: 5}, CommitTimeout: 10 * time.Millisecond})
+	for deadline := time.Now().Add(10 * time.Second); !r.Finished(); time.Sleep(10 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatalf("%s: timed out", r)
+		}
+	}
+	tassert.Errorf(t, r.IsAborted(), "%s: expected to abort when not committed in time", r)
+}
+
 // TODO: extend this to include all cases of the Query
 func TestXactionQueryFinished(t *testing.T) {
 	type testConfig struct {